@@ -93,7 +93,7 @@ func runTrigger(
 
 	fmt.Fprintln(cmd.ErrOrStderr(), "Triggering build...")
 
-	resp, err := workload.TriggerArtifactBuild(artifactID)
+	resp, err := workload.TriggerArtifactBuild(cmd.Context(), artifactID)
 	if err != nil {
 		return err
 	}
@@ -141,7 +141,7 @@ func waitForAllBuilds(
 	for _, buildID := range buildIDs {
 		fmt.Fprintf(cmd.ErrOrStderr(), "Waiting for build %s...\n", buildID)
 
-		build, werr := workload.WaitForBuild(artifactID, buildID, poll.Interval, poll.Timeout, nil)
+		build, werr := workload.WaitForBuild(cmd.Context(), artifactID, buildID, poll.Interval, poll.Timeout, nil)
 		if werr != nil && firstWaitErr == nil {
 			firstWaitErr = werr
 		}
@@ -152,7 +152,7 @@ func waitForAllBuilds(
 			continue
 		}
 
-		summary, serr := workload.BuildSummaryFor(build, workload.DefaultBuildLogTail)
+		summary, serr := workload.BuildSummaryFor(cmd.Context(), build, workload.DefaultBuildLogTail)
 		if serr != nil && firstWaitErr == nil {
 			firstWaitErr = serr
 		}
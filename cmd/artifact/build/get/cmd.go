@@ -91,7 +91,7 @@ func runGet(
 
 	fmt.Fprintf(cmd.ErrOrStderr(), "Fetching build %s...\n", buildID)
 
-	build, err := workload.GetArtifactBuild(artifactID, buildID)
+	build, err := workload.GetArtifactBuild(cmd.Context(), artifactID, buildID)
 	if err != nil {
 		return err
 	}
@@ -105,7 +105,7 @@ func runGet(
 	if !workload.IsTerminalBuildStatus(build.Status) {
 		fmt.Fprintf(cmd.ErrOrStderr(), "Waiting for build %s...\n", buildID)
 
-		build, waitErr = workload.WaitForBuild(artifactID, buildID, poll.Interval, poll.Timeout, nil)
+		build, waitErr = workload.WaitForBuild(cmd.Context(), artifactID, buildID, poll.Interval, poll.Timeout, nil)
 
 		if build == nil {
 			// WaitForBuild errored before its first successful GET; render a
@@ -117,7 +117,7 @@ func runGet(
 		}
 	}
 
-	summary, serr := workload.BuildSummaryFor(build, workload.DefaultBuildLogTail)
+	summary, serr := workload.BuildSummaryFor(cmd.Context(), build, workload.DefaultBuildLogTail)
 	if serr != nil {
 		_ = workload.RenderBuildSummary(outputFormat, summary)
 
@@ -58,7 +58,7 @@ Examples:
 				return err
 			}
 
-			builds, err := workload.ListArtifactBuilds(artifactID, limit)
+			builds, err := workload.ListArtifactBuilds(cmd.Context(), artifactID, limit)
 			if err != nil {
 				return err
 			}
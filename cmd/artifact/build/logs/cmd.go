@@ -76,7 +76,7 @@ Examples:
 				return err
 			}
 
-			entries, err := workload.GetArtifactBuildLogs(artifactID, buildID)
+			entries, err := workload.GetArtifactBuildLogs(cmd.Context(), artifactID, buildID)
 			if err != nil {
 				return err
 			}
@@ -0,0 +1,209 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package checkignore implements `dr artifact code check-ignore`: which of
+// the given paths sync excludes, and with -v the rule that decided.
+package checkignore
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/datarobot/cli/internal/cli"
+	"github.com/datarobot/cli/internal/outputformat"
+	"github.com/datarobot/cli/internal/telemetry"
+	"github.com/datarobot/cli/internal/workload/ignore"
+	"github.com/spf13/cobra"
+)
+
+// systemSource stands in for the file name when a hardcoded exclude decided.
+const systemSource = "(system)"
+
+// result is one path's answer, and the shape --output-format json emits.
+type result struct {
+	Path string `json:"path"`
+	ignore.Decision
+}
+
+func Cmd() *cobra.Command {
+	var outputFormat outputformat.OutputFormat
+
+	c := &cobra.Command{
+		Use:          "check-ignore [-v] <path>...",
+		Short:        "Show which paths sync excludes, and why.",
+		SilenceUsage: true,
+		Args:         cobra.MinimumNArgs(1),
+		Long: `Print each given path that sync excludes, the way 'git check-ignore'
+does. Paths are relative to the current directory and must lie inside the
+project directory.
+
+Exclusions come from the hardcoded system excludes (the state directory,
+.git) and from .wapiignore files in gitignore syntax: the one at the project
+root and any in subdirectories, each applying below the directory it is in.
+A deeper file overrides a shallower one, and a '!' pattern re-includes what
+an earlier pattern excluded, though never a path inside an excluded
+directory. A line reading '#!include:.gitignore' in the root .wapiignore
+makes every .gitignore count too, read just before the .wapiignore beside it.
+
+With -v, each line names the file, line and pattern that decided, as
+'<source>:<line>:<pattern><TAB><path>'. A path kept in by a '!' pattern is
+shown too, since the pattern matched. With -n, paths no pattern matched are
+listed as '::<TAB><path>'.
+
+Exits 0 when at least one path is excluded and 1 when none is.
+
+Example:
+  dr artifact code check-ignore build/out.bin
+  dr artifact code check-ignore -v src/__pycache__ .env
+  dr artifact code check-ignore -v -n $(git ls-files)`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			outputFormat = outputformat.GetFormat(cmd)
+
+			return runCheckIgnore(cmd, args, outputFormat)
+		},
+	}
+
+	outputformat.AddFlag(c, &outputFormat)
+
+	c.Flags().String("dir", "", "Project directory (default: current directory).")
+	// Shadows the global --verbose for this command, as git's -v does: what
+	// is asked for here is the deciding rule, not more logging.
+	c.Flags().BoolP("verbose", "v", false, "Show the file, line and pattern that decided.")
+	c.Flags().BoolP("non-matching", "n", false, "With -v, also list paths no pattern matched.")
+
+	telemetry.TrackWith(c, func(cmd *cobra.Command, args []string) map[string]any {
+		verbose, _ := cmd.Flags().GetBool("verbose")
+
+		return map[string]any{
+			"paths":         len(args),
+			"verbose":       verbose,
+			"output_format": string(outputFormat),
+		}
+	})
+
+	return c
+}
+
+func runCheckIgnore(cmd *cobra.Command, args []string, outputFormat outputformat.OutputFormat) error {
+	dirFlag, _ := cmd.Flags().GetString("dir")
+	verbose, _ := cmd.Flags().GetBool("verbose")
+	nonMatching, _ := cmd.Flags().GetBool("non-matching")
+
+	if nonMatching && !verbose {
+		return errors.New("--non-matching is only valid with --verbose")
+	}
+
+	if dirFlag == "" {
+		dirFlag = "."
+	}
+
+	dir, err := filepath.Abs(dirFlag)
+	if err != nil {
+		return fmt.Errorf("resolve dir %s: %w", dirFlag, err)
+	}
+
+	matcher, err := ignore.New(dir)
+	if err != nil {
+		return err
+	}
+
+	results := make([]result, 0, len(args))
+
+	for _, arg := range args {
+		rel, isDir, err := relativePath(dir, arg)
+		if err != nil {
+			return err
+		}
+
+		results = append(results, result{Path: arg, Decision: matcher.Explain(rel, isDir)})
+	}
+
+	if err := matcher.Err(); err != nil {
+		return err
+	}
+
+	if outputFormat == outputformat.OutputFormatJSON {
+		enc := json.NewEncoder(cmd.OutOrStdout())
+		enc.SetIndent("", "  ")
+
+		if err := enc.Encode(results); err != nil {
+			return err
+		}
+	} else {
+		renderText(cmd.OutOrStdout(), results, verbose, nonMatching)
+	}
+
+	for _, r := range results {
+		if r.Ignored {
+			return nil
+		}
+	}
+
+	return &cli.ExitError{Code: 1, Err: errors.New("none of the given paths is excluded from sync")}
+}
+
+// relativePath maps arg, relative to the working directory, onto the
+// slash-separated path the matcher takes. A trailing separator, or an
+// existing directory, asks about a directory.
+func relativePath(dir, arg string) (string, bool, error) {
+	abs, err := filepath.Abs(arg)
+	if err != nil {
+		return "", false, fmt.Errorf("resolve %s: %w", arg, err)
+	}
+
+	rel, err := filepath.Rel(dir, abs)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", false, fmt.Errorf("%s is outside the project directory %s", arg, dir)
+	}
+
+	isDir := strings.HasSuffix(arg, "/") || strings.HasSuffix(arg, string(filepath.Separator))
+
+	if info, err := os.Stat(abs); err == nil && info.IsDir() {
+		isDir = true
+	}
+
+	if rel == "." {
+		rel = ""
+	}
+
+	return filepath.ToSlash(rel), isDir, nil
+}
+
+func renderText(out io.Writer, results []result, verbose, nonMatching bool) {
+	for _, r := range results {
+		switch {
+		case verbose && r.Matched():
+			source := r.Source
+			if r.System {
+				source = systemSource
+			}
+
+			line := ""
+			if r.Line > 0 {
+				line = fmt.Sprint(r.Line)
+			}
+
+			fmt.Fprintf(out, "%s:%s:%s\t%s\n", source, line, r.Pattern, r.Path)
+		case verbose && nonMatching:
+			fmt.Fprintf(out, "::\t%s\n", r.Path)
+		case !verbose && r.Ignored:
+			fmt.Fprintln(out, r.Path)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checkignore
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/datarobot/cli/internal/cli"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func project(t *testing.T) string {
+	t.Helper()
+
+	// Resolved, so a symlinked temp dir (macOS) agrees with the working
+	// directory the paths are taken relative to.
+	dir, err := filepath.EvalSymlinks(t.TempDir())
+	require.NoError(t, err)
+
+	for rel, content := range map[string]string{
+		".wapiignore":     "*.log\nbuild/\n",
+		"sub/.wapiignore": "!debug.log\n",
+	} {
+		path := filepath.Join(dir, filepath.FromSlash(rel))
+
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	}
+
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "build"), 0o755))
+
+	return dir
+}
+
+func runCmd(t *testing.T, args ...string) (string, error) {
+	t.Helper()
+
+	cmd := Cmd()
+
+	var out bytes.Buffer
+
+	cmd.SetOut(&out)
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs(args)
+
+	err := cmd.Execute()
+
+	return out.String(), err
+}
+
+func TestCheckIgnore_ListsExcludedPaths(t *testing.T) {
+	dir := project(t)
+	t.Chdir(dir)
+
+	out, err := runCmd(t, "app.log", "app.py", "build", "sub/debug.log")
+	require.NoError(t, err)
+	assert.Equal(t, "app.log\nbuild\n", out)
+}
+
+func TestCheckIgnore_Verbose(t *testing.T) {
+	dir := project(t)
+	t.Chdir(filepath.Join(dir, "sub"))
+
+	out, err := runCmd(t, "-v", "--dir", dir, "debug.log", "other.log", "../.git/HEAD", "../build/x.o")
+	require.NoError(t, err)
+	assert.Equal(t,
+		"sub/.wapiignore:1:!debug.log\tdebug.log\n"+
+			".wapiignore:1:*.log\tother.log\n"+
+			"(system)::.git\t../.git/HEAD\n"+
+			".wapiignore:2:build/\t../build/x.o\n",
+		out)
+}
+
+func TestCheckIgnore_NonMatching(t *testing.T) {
+	dir := project(t)
+	t.Chdir(dir)
+
+	out, err := runCmd(t, "-v", "-n", "app.log", "app.py")
+	require.NoError(t, err)
+	assert.Equal(t, ".wapiignore:1:*.log\tapp.log\n::\tapp.py\n", out)
+
+	_, err = runCmd(t, "-n", "app.py")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--verbose")
+}
+
+func TestCheckIgnore_NothingExcludedExitsOne(t *testing.T) {
+	dir := project(t)
+	t.Chdir(dir)
+
+	out, err := runCmd(t, "app.py", "sub/debug.log")
+
+	var exitErr *cli.ExitError
+
+	require.ErrorAs(t, err, &exitErr)
+	assert.Equal(t, 1, exitErr.Code)
+	assert.Empty(t, out)
+}
+
+func TestCheckIgnore_OutsideProject(t *testing.T) {
+	dir := project(t)
+
+	_, err := runCmd(t, "--dir", dir, filepath.Join(t.TempDir(), "x"))
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "outside the project directory")
+}
+
+func TestCheckIgnore_JSON(t *testing.T) {
+	dir := project(t)
+	t.Chdir(dir)
+
+	out, err := runCmd(t, "--output-format", "json", "app.log", "app.py")
+	require.NoError(t, err)
+
+	var got []map[string]any
+
+	require.NoError(t, json.Unmarshal([]byte(out), &got))
+	require.Len(t, got, 2)
+	assert.Equal(t, map[string]any{
+		"path": "app.log", "ignored": true, "source": ".wapiignore", "line": float64(1), "pattern": "*.log",
+	}, got[0])
+	assert.Equal(t, map[string]any{"path": "app.py", "ignored": false}, got[1])
+}
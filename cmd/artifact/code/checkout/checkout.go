@@ -15,6 +15,7 @@
 package checkout
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -26,6 +27,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/datarobot/cli/cmd/artifact/code/internal/catalogversion"
 	"github.com/datarobot/cli/internal/drapi"
 	"github.com/datarobot/cli/internal/drapi/filesapi"
 	"github.com/datarobot/cli/internal/outputformat"
@@ -52,15 +54,15 @@ type checkoutMeta struct {
 	TotalSize    int64     `json:"totalSize"`
 }
 
-func runDownload(out io.Writer, format outputformat.OutputFormat, dir, verArg string, deps Deps) error {
+func runDownload(ctx context.Context, out io.Writer, format outputformat.OutputFormat, dir, verArg string, deps Deps) error {
 	startedAt := time.Now()
 
-	pre, err := preflight(dir, verArg, deps)
+	pre, err := preflight(ctx, dir, verArg, deps)
 	if err != nil {
 		return err
 	}
 
-	files, err := deps.Files.AllFiles(pre.catalogID, pre.versionID)
+	files, err := deps.Files.AllFiles(ctx, pre.catalogID, pre.versionID)
 	if err != nil {
 		return fmt.Errorf("list files for version %s: %w", pre.versionID, err)
 	}
@@ -81,7 +83,7 @@ func runDownload(out io.Writer, format outputformat.OutputFormat, dir, verArg st
 	// reserved for the result document.
 	fmt.Fprintf(os.Stderr, "Downloading %d file(s)…\n", len(files))
 
-	if err := stageAndInstall(deps.Files, pre, parent, files, totalSize, startedAt); err != nil {
+	if err := stageAndInstall(ctx, deps.Files, pre, parent, files, totalSize, startedAt); err != nil {
 		return err
 	}
 
@@ -95,7 +97,7 @@ func runDownload(out io.Writer, format outputformat.OutputFormat, dir, verArg st
 // stageAndInstall downloads files into a sibling temp dir, writes metadata,
 // and atomically swaps it into place. The temp dir is removed on any failure
 // before the swap; after a successful swap it has been renamed away.
-func stageAndInstall(c filesapi.Client, pre preflightResult, parent string, files map[string]filesapi.FileMeta, totalSize int64, startedAt time.Time) error {
+func stageAndInstall(ctx context.Context, c filesapi.Client, pre preflightResult, parent string, files map[string]filesapi.FileMeta, totalSize int64, startedAt time.Time) error {
 	// Dot-prefix so listCheckoutNames skips it; sibling of finalDir so the swap rename is intra-filesystem.
 	tempDir, err := os.MkdirTemp(parent, ".tmp-"+pre.versionID+"-")
 	if err != nil {
@@ -110,7 +112,7 @@ func stageAndInstall(c filesapi.Client, pre preflightResult, parent string, file
 		}
 	}()
 
-	if err := downloadAll(c, pre.catalogID, pre.versionID, tempDir, files); err != nil {
+	if err := downloadAll(ctx, c, pre.catalogID, pre.versionID, tempDir, files); err != nil {
 		return err
 	}
 
@@ -197,7 +199,7 @@ type preflightResult struct {
 	checkoutDir string
 }
 
-func preflight(dir, verArg string, deps Deps) (preflightResult, error) {
+func preflight(ctx context.Context, dir, verArg string, deps Deps) (preflightResult, error) {
 	cfg, err := wapi.LoadConfig(dir)
 	if err != nil {
 		return preflightResult{}, fmt.Errorf("read %s: %w", wapi.ConfigPath(dir), err)
@@ -207,11 +209,11 @@ func preflight(dir, verArg string, deps Deps) (preflightResult, error) {
 		return preflightResult{}, errors.New("no code has been synced yet. Run 'dr artifact code sync' first")
 	}
 
-	if err := probeArtifact(deps.GetArtifact, cfg.ArtifactID); err != nil {
+	if err := probeArtifact(ctx, deps.GetArtifact, cfg.ArtifactID); err != nil {
 		return preflightResult{}, err
 	}
 
-	versionID, err := resolveVersion(deps.Files, *cfg.CatalogID, verArg)
+	versionID, err := catalogversion.Resolve(ctx, deps.Files, *cfg.CatalogID, verArg)
 	if err != nil {
 		return preflightResult{}, err
 	}
@@ -237,8 +239,8 @@ func prepareCheckoutsParent(parent string, totalSize int64) error {
 
 // probeArtifact surfaces a 404 before any download work begins. The artifact
 // payload is discarded; preflight only needs to confirm the ID resolves.
-func probeArtifact(get func(string) (*workload.Artifact, error), artifactID string) error {
-	if _, err := get(artifactID); err != nil {
+func probeArtifact(ctx context.Context, get func(context.Context, string) (*workload.Artifact, error), artifactID string) error {
+	if _, err := get(ctx, artifactID); err != nil {
 		var httpErr *drapi.HTTPError
 		if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusNotFound {
 			return fmt.Errorf("artifact %s not found", artifactID)
@@ -250,40 +252,7 @@ func probeArtifact(get func(string) (*workload.Artifact, error), artifactID stri
 	return nil
 }
 
-// Accepts the full ID or any unique prefix.
-func resolveVersion(c filesapi.Client, catalogID, arg string) (string, error) {
-	if arg == "" {
-		return "", errors.New("version argument is empty")
-	}
-
-	versions, err := c.ListVersions(catalogID, 0)
-	if err != nil {
-		return "", fmt.Errorf("list versions: %w", err)
-	}
-
-	var matches []string
-
-	for _, v := range versions {
-		if v.ID == arg {
-			return v.ID, nil
-		}
-
-		if strings.HasPrefix(v.ID, arg) {
-			matches = append(matches, v.ID)
-		}
-	}
-
-	switch len(matches) {
-	case 0:
-		return "", fmt.Errorf("version %q not found", arg)
-	case 1:
-		return matches[0], nil
-	default:
-		return "", fmt.Errorf("version prefix %q is ambiguous — matches %d versions; use more characters", arg, len(matches))
-	}
-}
-
-func downloadAll(c filesapi.Client, catalogID, versionID, checkoutDir string, files map[string]filesapi.FileMeta) error {
+func downloadAll(ctx context.Context, c filesapi.Client, catalogID, versionID, checkoutDir string, files map[string]filesapi.FileMeta) error {
 	paths := make([]string, 0, len(files))
 	for p := range files {
 		paths = append(paths, p)
@@ -298,7 +267,7 @@ func downloadAll(c filesapi.Client, catalogID, versionID, checkoutDir string, fi
 	}
 
 	for _, path := range paths {
-		if err := downloadOne(c, catalogID, versionID, checkoutDir, path); err != nil {
+		if err := downloadOne(ctx, c, catalogID, versionID, checkoutDir, path); err != nil {
 			return err
 		}
 	}
@@ -307,7 +276,7 @@ func downloadAll(c filesapi.Client, catalogID, versionID, checkoutDir string, fi
 }
 
 // Server-side hashes are not re-verified: TLS covers transit corruption and the snapshot is read-only.
-func downloadOne(c filesapi.Client, catalogID, versionID, checkoutDir, path string) error {
+func downloadOne(ctx context.Context, c filesapi.Client, catalogID, versionID, checkoutDir, path string) error {
 	dst := filepath.Join(checkoutDir, filepath.FromSlash(path))
 
 	if err := os.MkdirAll(filepath.Dir(dst), checkoutDirPerm); err != nil {
@@ -319,7 +288,7 @@ func downloadOne(c filesapi.Client, catalogID, versionID, checkoutDir, path stri
 		return fmt.Errorf("create %s: %w", path, err)
 	}
 
-	_, _, err = c.DownloadFile(catalogID, versionID, path, out)
+	_, _, err = c.DownloadFile(ctx, catalogID, versionID, path, out)
 	if cerr := out.Close(); err == nil {
 		err = cerr
 	}
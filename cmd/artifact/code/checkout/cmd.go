@@ -15,6 +15,7 @@
 package checkout
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -32,7 +33,7 @@ import (
 )
 
 type Deps struct {
-	GetArtifact   func(string) (*workload.Artifact, error)
+	GetArtifact   func(context.Context, string) (*workload.Artifact, error)
 	Files         filesapi.Client
 	PromptDir     dirprompt.PromptFunc
 	PromptVersion dirprompt.PromptNoDefaultFunc
@@ -136,7 +137,7 @@ func runCheckout(cmd *cobra.Command, args []string, outputFormat outputformat.Ou
 		return err
 	}
 
-	return runDownload(cmd.OutOrStdout(), outputFormat, dir, verArg, deps)
+	return runDownload(cmd.Context(), cmd.OutOrStdout(), outputFormat, dir, verArg, deps)
 }
 
 func resolveProjectDir(dirFlag string, yes bool, prompt dirprompt.PromptFunc) (string, error) {
@@ -16,6 +16,7 @@ package checkout
 
 import (
 	"bytes"
+	"context"
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
@@ -51,11 +52,11 @@ type fakeClient struct {
 	downloadCalls int
 }
 
-func (f *fakeClient) ListVersions(_ string, _ int) ([]filesapi.CatalogVersion, error) {
+func (f *fakeClient) ListVersions(_ context.Context, _ string, _ int) ([]filesapi.CatalogVersion, error) {
 	return f.versions, nil
 }
 
-func (f *fakeClient) AllFiles(_, _ string) (map[string]filesapi.FileMeta, error) {
+func (f *fakeClient) AllFiles(_ context.Context, _, _ string) (map[string]filesapi.FileMeta, error) {
 	f.mu.Lock()
 	defer f.mu.Unlock()
 
@@ -75,7 +76,7 @@ func (f *fakeClient) AllFiles(_, _ string) (map[string]filesapi.FileMeta, error)
 	return out, nil
 }
 
-func (f *fakeClient) DownloadFile(_, _, path string, w io.Writer) (string, int64, error) {
+func (f *fakeClient) DownloadFile(_ context.Context, _, _, path string, w io.Writer) (string, int64, error) {
 	f.mu.Lock()
 	f.downloadCalls++
 	err := f.downloadErr
@@ -103,28 +104,35 @@ func (f *fakeClient) callCounts() (all, dl int) {
 }
 
 // Panicking stubs for the rest of filesapi.Client.
-func (*fakeClient) CreateCatalog() (*filesapi.CatalogResp, error)                { panic("unused") }
-func (*fakeClient) CreateStage(string) (*filesapi.StageResp, error)              { panic("unused") }
-func (*fakeClient) UploadToStage(string, string, string, int64, io.Reader) error { panic("unused") }
-func (*fakeClient) ApplyStage(string, string, string) (*filesapi.ApplyStageResp, error) {
+func (*fakeClient) CreateCatalog(context.Context) (*filesapi.CatalogResp, error) { panic("unused") }
+
+func (*fakeClient) CreateStage(context.Context, string) (*filesapi.StageResp, error) { panic("unused") }
+
+func (*fakeClient) UploadToStage(context.Context, string, string, string, int64, io.Reader) error {
 	panic("unused")
 }
 
-func (*fakeClient) UploadFromZipNew(string, int64, io.Reader) (*filesapi.FromFileResp, error) {
+func (*fakeClient) ApplyStage(context.Context, string, string, string) (*filesapi.ApplyStageResp, error) {
 	panic("unused")
 }
 
-func (*fakeClient) UploadFromZipExisting(string, string, string, int64, io.Reader) (*filesapi.FromFileResp, error) {
+func (*fakeClient) UploadFromZipNew(context.Context, string, int64, io.Reader) (*filesapi.FromFileResp, error) {
 	panic("unused")
 }
-func (*fakeClient) PollStatus(string) (*filesapi.StatusResp, error) { panic("unused") }
-func (*fakeClient) DeleteFiles(string, []string) (*filesapi.DeleteFilesResp, error) {
+
+func (*fakeClient) UploadFromZipExisting(context.Context, string, string, string, int64, io.Reader) (*filesapi.FromFileResp, error) {
+	panic("unused")
+}
+
+func (*fakeClient) PollStatus(context.Context, string) (*filesapi.StatusResp, error) { panic("unused") }
+
+func (*fakeClient) DeleteFiles(context.Context, string, []string) (*filesapi.DeleteFilesResp, error) {
 	panic("unused")
 }
 
 func fakeDeps(art *workload.Artifact, fc *fakeClient) Deps {
 	return Deps{
-		GetArtifact: func(_ string) (*workload.Artifact, error) { return art, nil },
+		GetArtifact: func(_ context.Context, _ string) (*workload.Artifact, error) { return art, nil },
 		Files:       fc,
 	}
 }
@@ -171,7 +179,7 @@ func newTestCmd(t *testing.T, dir string, deps Deps, args []string) (*cobra.Comm
 const (
 	verA = "abcdef1234567890abcdef1234567890"
 	// verAmbiguous shares verA's 8-char prefix "abcdef12", so a query for that
-	// prefix matches both — resolveVersion must reject it as ambiguous.
+	// prefix matches both — catalogversion.Resolve must reject it as ambiguous.
 	verAmbiguous = "abcdef12fffffffabcdef12fffffff00"
 	verC         = "11112222333344445555666677778888"
 )
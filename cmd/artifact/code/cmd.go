@@ -15,9 +15,14 @@
 package code
 
 import (
+	"github.com/datarobot/cli/cmd/artifact/code/checkignore"
 	"github.com/datarobot/cli/cmd/artifact/code/checkout"
+	"github.com/datarobot/cli/cmd/artifact/code/codelog"
 	"github.com/datarobot/cli/cmd/artifact/code/codesync"
+	"github.com/datarobot/cli/cmd/artifact/code/diff"
 	initcmd "github.com/datarobot/cli/cmd/artifact/code/init"
+	"github.com/datarobot/cli/cmd/artifact/code/revert"
+	"github.com/datarobot/cli/cmd/artifact/code/status"
 	"github.com/datarobot/cli/cmd/artifact/code/versions"
 	"github.com/spf13/cobra"
 )
@@ -39,9 +44,15 @@ Subcommands:
   init       Link a directory to an existing artifact and lay down the
              state directory. Required before any other 'code' command.
   sync       Push local edits and pull remote changes.
+  status     Show files changed since the last sync, without the network.
+  log        Show the sync history of the project directory.
+  diff       Show unified diffs between the working tree and versions.
+  check-ignore
+             Show which paths sync excludes, and the rule that decided.
   versions   List catalog versions for the linked artifact.
   checkout   Download a prior version into
              '.datarobot/workload/.checkouts/' for read-only inspection.
+  revert     Publish the files of a prior version as a new version.
 
 Artifacts must already exist before running 'init'. Create them via
 'dr artifact create' or in the DataRobot UI — these commands
@@ -51,13 +62,21 @@ Example:
   dr artifact code init art-abc-123
   dr artifact code init art-abc-123 --dir ./service
   dr artifact code sync
-  dr artifact code sync --dry-run`,
+  dr artifact code sync --dry-run
+  dr artifact code status
+  dr artifact code diff
+  dr artifact code revert 1a2b3c4d --dry-run`,
 	}
 
 	cmd.AddCommand(initcmd.Cmd())
 	cmd.AddCommand(codesync.Cmd())
+	cmd.AddCommand(status.Cmd())
+	cmd.AddCommand(codelog.Cmd())
+	cmd.AddCommand(diff.Cmd())
+	cmd.AddCommand(checkignore.Cmd())
 	cmd.AddCommand(versions.Cmd())
 	cmd.AddCommand(checkout.Cmd())
+	cmd.AddCommand(revert.Cmd())
 
 	return cmd
 }
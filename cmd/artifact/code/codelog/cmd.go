@@ -0,0 +1,212 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package codelog implements `dr artifact code log`: the operations recorded
+// in the project's history.log, newest first. Named "codelog" (rather than
+// "log") to avoid colliding with internal/log.
+package codelog
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/datarobot/cli/cmd/artifact/code/internal/format"
+	"github.com/datarobot/cli/internal/outputformat"
+	"github.com/datarobot/cli/internal/telemetry"
+	"github.com/datarobot/cli/internal/workload/wapi"
+	"github.com/datarobot/cli/tui"
+	"github.com/spf13/cobra"
+)
+
+const timestampFormat = "2006-01-02 15:04:05 UTC"
+
+// leadingKeys are printed in their own columns; everything else an entry
+// carries follows as key=value, so an operation added later is rendered
+// without this command learning its shape.
+var leadingKeys = []string{"ts", "op", "duration"}
+
+func Cmd() *cobra.Command {
+	var outputFormat outputformat.OutputFormat
+
+	c := &cobra.Command{
+		Use:          "log",
+		Short:        "Show the sync history of this project directory.",
+		SilenceUsage: true,
+		Args:         cobra.NoArgs,
+		Long: `Show the operations recorded in '.datarobot/workload/history.log',
+newest first: each init, sync and checkout with what it moved and how long
+it took.
+
+The log rotates at 1 MB into history.log.1, and both files are read, so the
+listing covers everything still on disk. Nothing is sent over the network.
+
+Run 'dr artifact code init <artifact-id>' first to link a project
+directory to an artifact.
+
+Example:
+  dr artifact code log
+  dr artifact code log --limit 5
+  dr artifact code log --output-format json`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			outputFormat = outputformat.GetFormat(cmd)
+
+			return runLog(cmd, outputFormat)
+		},
+	}
+
+	outputformat.AddFlag(c, &outputFormat)
+
+	c.Flags().String("dir", "", "Project directory (default: current directory).")
+	c.Flags().Int("limit", 0, "Show at most this many entries (default: all).")
+
+	telemetry.TrackWith(c, func(cmd *cobra.Command, _ []string) map[string]any {
+		limit, _ := cmd.Flags().GetInt("limit")
+
+		return map[string]any{
+			"limit":         limit,
+			"output_format": string(outputFormat),
+		}
+	})
+
+	return c
+}
+
+func runLog(cmd *cobra.Command, outputFormat outputformat.OutputFormat) error {
+	dirFlag, _ := cmd.Flags().GetString("dir")
+	limit, _ := cmd.Flags().GetInt("limit")
+
+	if limit < 0 {
+		return fmt.Errorf("invalid --limit %d: must not be negative", limit)
+	}
+
+	if dirFlag == "" {
+		dirFlag = "."
+	}
+
+	dir, err := filepath.Abs(dirFlag)
+	if err != nil {
+		return fmt.Errorf("resolve dir %s: %w", dirFlag, err)
+	}
+
+	format.StateNotice(cmd.ErrOrStderr(), wapi.EnsureMigrated(dir))
+
+	entries, err := wapi.ReadHistory(dir)
+	if err != nil {
+		if errors.Is(err, wapi.ErrNotInitialized) {
+			return errors.New("not linked to an artifact. Run 'dr artifact code init <id>' first")
+		}
+
+		return err
+	}
+
+	slices.Reverse(entries)
+
+	if limit > 0 && len(entries) > limit {
+		entries = entries[:limit]
+	}
+
+	if outputFormat == outputformat.OutputFormatJSON {
+		if entries == nil {
+			entries = []wapi.HistoryEntry{}
+		}
+
+		enc := json.NewEncoder(cmd.OutOrStdout())
+		enc.SetIndent("", "  ")
+
+		return enc.Encode(entries)
+	}
+
+	renderText(cmd.OutOrStdout(), entries)
+
+	return nil
+}
+
+func renderText(out io.Writer, entries []wapi.HistoryEntry) {
+	if len(entries) == 0 {
+		fmt.Fprintln(out, "No history recorded.")
+
+		return
+	}
+
+	for _, e := range entries {
+		line := fmt.Sprintf("%s  %-9s", timestamp(e["ts"]), text(e["op"]))
+
+		if rest := details(e); rest != "" {
+			line += "  " + rest
+		}
+
+		if d := text(e["duration"]); d != "" {
+			line += "  " + tui.DimStyle.Render("("+d+")")
+		}
+
+		fmt.Fprintln(out, line)
+	}
+}
+
+// timestamp renders ts in UTC, or as written when it is not RFC 3339.
+func timestamp(v any) string {
+	raw := text(v)
+
+	t, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		if raw == "" {
+			return fmt.Sprintf("%-23s", "—")
+		}
+
+		return fmt.Sprintf("%-23s", raw)
+	}
+
+	return t.UTC().Format(timestampFormat)
+}
+
+// details is every field outside the leading columns, sorted by key. A null
+// is left out, since it says only that there was nothing to record.
+func details(e wapi.HistoryEntry) string {
+	keys := make([]string, 0, len(e))
+
+	for k, v := range e {
+		if v != nil && !slices.Contains(leadingKeys, k) {
+			keys = append(keys, k)
+		}
+	}
+
+	sort.Strings(keys)
+
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		parts = append(parts, k+"="+text(e[k]))
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// text renders a decoded JSON value. Numbers arrive as float64, and %v
+// prints a whole one without a fraction.
+func text(v any) string {
+	switch typed := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return typed
+	default:
+		return fmt.Sprintf("%v", typed)
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codelog
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/datarobot/cli/internal/workload/wapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func linkedProject(t *testing.T, entries ...wapi.HistoryEntry) string {
+	t.Helper()
+
+	dir := t.TempDir()
+
+	require.NoError(t, wapi.Initialize(dir, wapi.InitOptions{ArtifactID: "art-1", CatalogID: "cat-1"}))
+
+	for _, e := range entries {
+		require.NoError(t, wapi.AppendHistory(dir, e))
+	}
+
+	return dir
+}
+
+func runCmd(t *testing.T, args ...string) (string, error) {
+	t.Helper()
+
+	cmd := Cmd()
+
+	var out bytes.Buffer
+
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs(args)
+
+	err := cmd.Execute()
+
+	return out.String(), err
+}
+
+func TestLog_NotLinked(t *testing.T) {
+	_, err := runCmd(t, "--dir", t.TempDir())
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "dr artifact code init")
+}
+
+func TestLog_NewestFirstWithDetails(t *testing.T) {
+	dir := linkedProject(t,
+		wapi.HistoryEntry{"ts": "2026-10-01T09:00:00Z", "op": "sync", "uploaded": 3, "duration": "1.2s"},
+		wapi.HistoryEntry{"ts": "2026-10-02T10:30:00Z", "op": "checkout", "version": "ver-2", "note": nil},
+	)
+
+	out, err := runCmd(t, "--dir", dir)
+	require.NoError(t, err)
+
+	checkout := bytes.Index([]byte(out), []byte("checkout"))
+	sync := bytes.Index([]byte(out), []byte("sync"))
+
+	require.NotEqual(t, -1, checkout)
+	require.NotEqual(t, -1, sync)
+	assert.Less(t, checkout, sync, "newest entry first")
+
+	assert.Contains(t, out, "2026-10-02 10:30:00 UTC")
+	assert.Contains(t, out, "version=ver-2")
+	assert.Contains(t, out, "uploaded=3")
+	assert.Contains(t, out, "(1.2s)")
+	assert.NotContains(t, out, "note=")
+}
+
+func TestLog_Limit(t *testing.T) {
+	dir := linkedProject(t,
+		wapi.HistoryEntry{"ts": "2026-10-01T09:00:00Z", "op": "init"},
+		wapi.HistoryEntry{"ts": "2026-10-02T09:00:00Z", "op": "sync"},
+	)
+
+	out, err := runCmd(t, "--dir", dir, "--limit", "1", "--output-format", "json")
+	require.NoError(t, err)
+
+	var got []map[string]any
+
+	require.NoError(t, json.Unmarshal([]byte(out), &got))
+	require.Len(t, got, 1)
+	assert.Equal(t, "sync", got[0]["op"])
+}
+
+func TestLog_EmptyHistory(t *testing.T) {
+	dir := linkedProject(t)
+
+	// Initialize records itself; a project from before the log existed has none.
+	require.NoError(t, os.Remove(filepath.Join(dir, ".datarobot", "workload", "history.log")))
+
+	out, err := runCmd(t, "--dir", dir, "--output-format", "json")
+	require.NoError(t, err)
+	assert.JSONEq(t, "[]", out)
+}
+
+func TestLog_NegativeLimit(t *testing.T) {
+	_, err := runCmd(t, "--dir", t.TempDir(), "--limit", "-1")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--limit")
+}
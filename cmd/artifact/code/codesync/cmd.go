@@ -19,9 +19,11 @@
 package codesync
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
+	"time"
 
 	"github.com/datarobot/cli/cmd/artifact/code/internal/dirprompt"
 	"github.com/datarobot/cli/cmd/artifact/code/internal/format"
@@ -42,12 +44,12 @@ import (
 // drives. Defined here so cmd_test.go can substitute a fake without
 // piling test seams onto the engine package.
 type engineRunner interface {
-	Plan() (*sync.SyncPlan, error)
-	Execute(*sync.SyncPlan) (*sync.Result, error)
+	Plan(ctx context.Context) (*sync.SyncPlan, error)
+	Execute(ctx context.Context, plan *sync.SyncPlan) (*sync.Result, error)
 	Close() error
 	StaleRollbackRestored() bool
 	StateMigrationNotice() string
-	Fetcher() display.ContentFetcher
+	Fetcher(ctx context.Context) display.ContentFetcher
 }
 
 // realEngine adapts *sync.Engine to engineRunner. Only Fetcher needs an
@@ -56,7 +58,9 @@ type engineRunner interface {
 // satisfaction is invariant over return types.
 type realEngine struct{ *sync.Engine }
 
-func (r realEngine) Fetcher() display.ContentFetcher { return r.Engine.Fetcher() }
+func (r realEngine) Fetcher(ctx context.Context) display.ContentFetcher {
+	return r.Engine.Fetcher(ctx)
+}
 
 // Deps holds the externally-injected collaborators for the sync
 // command. Tests build a Deps with fakes and pass it to cmdWithDeps;
@@ -64,6 +68,14 @@ func (r realEngine) Fetcher() display.ContentFetcher { return r.Engine.Fetcher()
 type Deps struct {
 	NewEngine func(dir string, opts sync.Options) (engineRunner, error)
 	ReadLine  func() (string, error)
+
+	// ResolveConflicts asks the user about each conflict the merge left
+	// unsettled and records the answers on the plan. It reports false when
+	// the user aborted the sync. Nil skips the step.
+	ResolveConflicts func(plan *sync.SyncPlan, fetcher display.ContentFetcher) (bool, error)
+
+	// NewWatcher starts the filesystem watch behind --watch.
+	NewWatcher func(dir string, debounce time.Duration) (changeWatcher, error)
 }
 
 // runFlags is the parsed view of the boolean flags that gate
@@ -73,6 +85,11 @@ type runFlags struct {
 	DryRun bool
 	Diff   bool
 	Yes    bool
+	Watch  bool
+
+	// Strategy is the --strategy value; empty when the flag was not given,
+	// which merges and then asks about what the merge could not settle.
+	Strategy sync.Strategy
 }
 
 func defaultDeps() Deps {
@@ -85,7 +102,11 @@ func defaultDeps() Deps {
 
 			return realEngine{e}, nil
 		},
-		ReadLine: reader.ReadString,
+		ReadLine:         reader.ReadString,
+		ResolveConflicts: resolveConflictsTUI,
+		NewWatcher: func(dir string, debounce time.Duration) (changeWatcher, error) {
+			return sync.NewWatcher(dir, debounce)
+		},
 	}
 }
 
@@ -108,10 +129,28 @@ func cmdWithDeps(deps Deps) *cobra.Command {
 		SilenceUsage: true,
 		Args:         cobra.NoArgs,
 		Long: `Synchronize the linked DataRobot artifact with the project
-directory. Computes a three-way diff against the last known state,
-auto-resolves any conflicts (remote wins; your version is saved as a
-*.LOCAL.<timestamp> copy), and applies the resulting plan in a single
-versioned step.
+directory. Computes a three-way diff against the last known state and
+applies the resulting plan in a single versioned step.
+
+A file changed on both sides is three-way merged against the version
+last synced. A clean merge is written and uploaded. A merge with
+conflicting hunks opens a per-file prompt: keep mine, keep theirs,
+open $EDITOR, or view the diff. Hunks left unresolved stay in the file
+between conflict markers and are not uploaded; the next sync refuses
+to run until the markers are gone. Binary files and edits to a file
+deleted remotely cannot be merged: unless you choose a side, the remote
+version wins and yours is saved as a *.LOCAL.<timestamp> copy.
+
+--strategy settles every conflict without prompting: "ours" keeps and
+uploads your version, "theirs" takes the remote one, and "merge" merges
+and leaves conflict markers where it has to.
+
+--watch keeps running and syncs again whenever files change locally,
+once a burst of edits has settled. Paths .wapiignore excludes are not
+watched. Clean merges go through on their own; a conflict that needs a
+decision pauses the watch until 'dr artifact code sync' settles it,
+unless --strategy already says how. The project stays locked against
+other syncs while the watch runs. Press Ctrl+C to stop.
 
 Use --dry-run to preview the plan without writing anything; --diff to
 also print per-file unified diffs. Both modes exit before any remote
@@ -126,6 +165,8 @@ Example:
   dr artifact code sync --dry-run
   dr artifact code sync --diff
   dr artifact code sync --yes
+  dr artifact code sync --strategy=theirs
+  dr artifact code sync --watch
   dr artifact code sync --output-format json`,
 		PreRunE: auth.EnsureAuthenticatedE,
 		RunE: func(cmd *cobra.Command, _ []string) error {
@@ -141,7 +182,11 @@ Example:
 	c.Flags().Bool("dry-run", false, "Show plan, no writes.")
 	c.Flags().Bool("diff", false, "Show plan + per-file unified diffs, no writes.")
 	c.Flags().BoolP("yes", "y", false, "Skip interactive prompts; auto-confirm.")
+	c.Flags().String("strategy", "", "Settle conflicts without prompting: ours, theirs, or merge.")
+	c.Flags().Bool("watch", false, "Keep running and sync whenever local files change.")
 	c.MarkFlagsMutuallyExclusive("dry-run", "diff")
+	c.MarkFlagsMutuallyExclusive("watch", "dry-run")
+	c.MarkFlagsMutuallyExclusive("watch", "diff")
 
 	telemetry.TrackWith(c, func(cmd *cobra.Command, _ []string) map[string]any {
 		flags := parseRunFlags(cmd)
@@ -150,6 +195,8 @@ Example:
 			"dry_run":       flags.DryRun,
 			"diff":          flags.Diff,
 			"yes":           flags.Yes,
+			"watch":         flags.Watch,
+			"strategy":      string(flags.Strategy),
 			"output_format": string(outputFormat),
 		}
 	})
@@ -160,6 +207,19 @@ Example:
 func runSync(cmd *cobra.Command, outputFormat outputformat.OutputFormat, deps Deps) error {
 	flags := parseRunFlags(cmd)
 
+	if raw, _ := cmd.Flags().GetString("strategy"); raw != "" {
+		strategy, err := sync.ParseStrategy(raw)
+		if err != nil {
+			return err
+		}
+
+		flags.Strategy = strategy
+	}
+
+	if flags.Watch && outputFormat == outputformat.OutputFormatJSON {
+		return errors.New("--watch does not support --output-format json")
+	}
+
 	dirFlag, _ := cmd.Flags().GetString("dir")
 
 	dir, err := dirprompt.ResolveDir(dirFlag, flags.Yes, dirprompt.AskWithDefault)
@@ -171,7 +231,16 @@ func runSync(cmd *cobra.Command, outputFormat outputformat.OutputFormat, deps De
 		return errors.New("not linked: run 'dr artifact code init <artifact-id>' first")
 	}
 
-	engine, err := deps.NewEngine(dir, sync.Options{DryRun: flags.DryRun, ShowDiffs: flags.Diff, Yes: flags.Yes})
+	if flags.Watch {
+		return runWatch(cmd, dir, flags, deps)
+	}
+
+	engine, err := deps.NewEngine(dir, sync.Options{
+		DryRun:    flags.DryRun,
+		ShowDiffs: flags.Diff,
+		Yes:       flags.Yes,
+		Strategy:  flags.Strategy,
+	})
 	if err != nil {
 		return err
 	}
@@ -182,7 +251,7 @@ func runSync(cmd *cobra.Command, outputFormat outputformat.OutputFormat, deps De
 		}
 	}()
 
-	plan, err := engine.Plan()
+	plan, err := engine.Plan(cmd.Context())
 	if err != nil {
 		return err
 	}
@@ -203,11 +272,13 @@ func parseRunFlags(cmd *cobra.Command) runFlags {
 	yesFlag, _ := cmd.Flags().GetBool("yes")
 	dryRun, _ := cmd.Flags().GetBool("dry-run")
 	diff, _ := cmd.Flags().GetBool("diff")
+	watch, _ := cmd.Flags().GetBool("watch")
 
 	return runFlags{
 		DryRun: dryRun,
 		Diff:   diff,
 		Yes:    yesFlag || viperx.GetBool("yes"),
+		Watch:  watch,
 	}
 }
 
@@ -218,7 +289,7 @@ func finishSync(cmd *cobra.Command, engine engineRunner, plan *sync.SyncPlan, ou
 	out := cmd.OutOrStdout()
 
 	if outputFormat == outputformat.OutputFormatJSON {
-		return finishJSON(engine, plan, out, flags)
+		return finishJSON(cmd.Context(), engine, plan, out, flags)
 	}
 
 	if err := renderHumanPlan(cmd, engine, plan, flags.Diff); err != nil {
@@ -229,7 +300,7 @@ func finishSync(cmd *cobra.Command, engine engineRunner, plan *sync.SyncPlan, ou
 		return nil
 	}
 
-	if shouldPromptConflicts(plan, flags.Yes) {
+	if shouldPromptConflicts(plan, flags) {
 		choice, err := promptConflictMenu(cmd, engine, plan, deps.ReadLine)
 		if err != nil {
 			return err
@@ -240,7 +311,20 @@ func finishSync(cmd *cobra.Command, engine engineRunner, plan *sync.SyncPlan, ou
 		}
 	}
 
-	result, err := engine.Execute(plan)
+	if shouldAskResolutions(plan, flags) && deps.ResolveConflicts != nil {
+		proceed, err := deps.ResolveConflicts(plan, engine.Fetcher(cmd.Context()))
+		if err != nil {
+			return err
+		}
+
+		if !proceed {
+			fmt.Fprintln(cmd.ErrOrStderr(), "Sync aborted; nothing was changed.")
+
+			return nil
+		}
+	}
+
+	result, err := engine.Execute(cmd.Context(), plan)
 	if err != nil {
 		return err
 	}
@@ -260,23 +344,41 @@ func renderHumanPlan(cmd *cobra.Command, engine engineRunner, plan *sync.SyncPla
 		return nil
 	}
 
-	return display.PrintDiffs(out, plan, engine.Fetcher())
+	return display.PrintDiffs(out, plan, engine.Fetcher(cmd.Context()))
 }
 
 // shouldPromptConflicts encapsulates the decision: prompt only when
-// the user has not passed --yes and the plan actually has conflicts.
-func shouldPromptConflicts(plan *sync.SyncPlan, yes bool) bool {
-	return !yes && plan.HasConflicts()
+// the user has not passed --yes or --strategy and the plan actually has
+// conflicts. An explicit strategy already says how conflicts resolve.
+func shouldPromptConflicts(plan *sync.SyncPlan, flags runFlags) bool {
+	return !flags.Yes && flags.Strategy == "" && plan.HasConflicts()
+}
+
+// shouldAskResolutions reports whether any conflict is left for the
+// per-file prompt: never under --yes or --strategy.
+func shouldAskResolutions(plan *sync.SyncPlan, flags runFlags) bool {
+	if flags.Yes || flags.Strategy != "" {
+		return false
+	}
+
+	for _, fa := range plan.Conflicts {
+		if fa.NeedsDecision() {
+			return true
+		}
+	}
+
+	return false
 }
 
 // finishJSON is the --output-format=json analogue of finishSync. The
 // plan is always emitted; if neither --dry-run nor --diff is set and
 // the plan does not require explicit confirmation, an Execute runs
 // and the Result is emitted as a second JSON document. Conflicts
-// without --yes are treated like the human-path quit branch: the
-// plan is emitted and no Execute is run, so callers can inspect the
-// plan and re-invoke with --yes if they want to proceed.
-func finishJSON(engine engineRunner, plan *sync.SyncPlan, out io.Writer, flags runFlags) error {
+// without --yes or --strategy are treated like the human-path quit
+// branch: the plan is emitted and no Execute is run, so callers can
+// inspect the plan and re-invoke with --yes or --strategy to proceed.
+// The per-file conflict prompt never runs in JSON mode.
+func finishJSON(ctx context.Context, engine engineRunner, plan *sync.SyncPlan, out io.Writer, flags runFlags) error {
 	if err := display.RenderPlanJSON(out, plan); err != nil {
 		return err
 	}
@@ -285,11 +387,11 @@ func finishJSON(engine engineRunner, plan *sync.SyncPlan, out io.Writer, flags r
 		return nil
 	}
 
-	if shouldPromptConflicts(plan, flags.Yes) {
+	if shouldPromptConflicts(plan, flags) {
 		return nil
 	}
 
-	result, err := engine.Execute(plan)
+	result, err := engine.Execute(ctx, plan)
 	if err != nil {
 		return err
 	}
@@ -16,6 +16,7 @@ package codesync
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"io"
 	"testing"
@@ -46,9 +47,9 @@ type fakeEngine struct {
 	closed   bool
 }
 
-func (f *fakeEngine) Plan() (*sync.SyncPlan, error) { return f.plan, f.planErr }
+func (f *fakeEngine) Plan(context.Context) (*sync.SyncPlan, error) { return f.plan, f.planErr }
 
-func (f *fakeEngine) Execute(_ *sync.SyncPlan) (*sync.Result, error) {
+func (f *fakeEngine) Execute(_ context.Context, _ *sync.SyncPlan) (*sync.Result, error) {
 	f.executed = true
 
 	return f.result, f.executeErr
@@ -64,7 +65,7 @@ func (f *fakeEngine) StaleRollbackRestored() bool { return f.stale }
 
 func (f *fakeEngine) StateMigrationNotice() string { return f.migrationNote }
 
-func (f *fakeEngine) Fetcher() display.ContentFetcher { return f.fetcher }
+func (f *fakeEngine) Fetcher(context.Context) display.ContentFetcher { return f.fetcher }
 
 // fakeEngineDeps returns a Deps that hands fe back from NewEngine and
 // has no reader configured. Tests that need to drive the conflict menu
@@ -413,3 +414,110 @@ func TestRunE_CloseError_NotSurfaced(t *testing.T) {
 	require.NoError(t, err, "Close errors must be swallowed at the cmd boundary")
 	assert.True(t, fe.closed)
 }
+
+// TestRunE_StrategySkipsPrompts: --strategy settles conflicts without the
+// menu or the per-file prompt, and reaches the engine as Options.Strategy.
+func TestRunE_StrategySkipsPrompts(t *testing.T) {
+	dir := t.TempDir()
+	linkProject(t, dir)
+
+	fe := &fakeEngine{
+		plan: &sync.SyncPlan{Conflicts: []sync.FileAction{
+			{Path: "x.py", Classification: sync.ClsConflict, Action: sync.ActConflictCopy},
+		}},
+		result: &sync.Result{NewVersion: "v3", ConflictCount: 1},
+	}
+
+	var got sync.Options
+
+	deps := Deps{
+		NewEngine: func(_ string, opts sync.Options) (engineRunner, error) {
+			got = opts
+
+			return fe, nil
+		},
+		ResolveConflicts: func(*sync.SyncPlan, display.ContentFetcher) (bool, error) {
+			t.Fatal("--strategy must not prompt per file")
+
+			return false, nil
+		},
+	}
+
+	flags := map[string]string{"dir": dir, "strategy": "Theirs"}
+
+	_, _, _, err := runWithDeps(t, deps, flags)
+	require.NoError(t, err)
+	assert.True(t, fe.executed)
+	assert.Equal(t, sync.StrategyTheirs, got.Strategy)
+}
+
+// TestRunE_InvalidStrategy: an unknown --strategy fails before planning.
+func TestRunE_InvalidStrategy(t *testing.T) {
+	dir := t.TempDir()
+	linkProject(t, dir)
+
+	fe := &fakeEngine{plan: &sync.SyncPlan{}}
+
+	flags := map[string]string{"dir": dir, "strategy": "both"}
+
+	_, _, _, err := runWithDeps(t, fakeEngineDeps(fe), flags)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid strategy")
+	assert.False(t, fe.executed)
+}
+
+// TestRunE_ResolveConflictsAbort: after Enter at the menu, the per-file
+// prompt runs for conflicts the merge left open; aborting it skips Execute.
+func TestRunE_ResolveConflictsAbort(t *testing.T) {
+	dir := t.TempDir()
+	linkProject(t, dir)
+
+	fe := &fakeEngine{plan: &sync.SyncPlan{Conflicts: []sync.FileAction{
+		{Path: "x.py", Classification: sync.ClsConflict, Resolution: sync.ResolveMarkers, ConflictHunks: 1},
+		{Path: "y.py", Classification: sync.ClsConflict, Resolution: sync.ResolveMerged},
+	}}}
+	deps := fakeEngineDeps(fe)
+	deps.ReadLine = stubReader("")
+
+	var asked int
+
+	deps.ResolveConflicts = func(*sync.SyncPlan, display.ContentFetcher) (bool, error) {
+		asked++
+
+		return false, nil
+	}
+
+	flags := map[string]string{"dir": dir}
+
+	_, _, stderr, err := runWithDeps(t, deps, flags)
+	require.NoError(t, err)
+	assert.Equal(t, 1, asked)
+	assert.False(t, fe.executed, "aborted prompt; Execute must not run")
+	assert.Contains(t, stderr.String(), "Sync aborted")
+}
+
+// TestRunE_ResolveConflictsSkippedWhenMerged: conflicts the merge settled
+// need no per-file answer.
+func TestRunE_ResolveConflictsSkippedWhenMerged(t *testing.T) {
+	dir := t.TempDir()
+	linkProject(t, dir)
+
+	fe := &fakeEngine{
+		plan: &sync.SyncPlan{Conflicts: []sync.FileAction{
+			{Path: "y.py", Classification: sync.ClsConflict, Resolution: sync.ResolveMerged},
+		}},
+		result: &sync.Result{NewVersion: "v3", Merged: []string{"y.py"}},
+	}
+	deps := fakeEngineDeps(fe)
+	deps.ReadLine = stubReader("")
+	deps.ResolveConflicts = func(*sync.SyncPlan, display.ContentFetcher) (bool, error) {
+		t.Fatal("nothing left to decide")
+
+		return false, nil
+	}
+
+	_, stdout, _, err := runWithDeps(t, deps, map[string]string{"dir": dir})
+	require.NoError(t, err)
+	assert.True(t, fe.executed)
+	assert.Contains(t, stdout.String(), "y.py")
+}
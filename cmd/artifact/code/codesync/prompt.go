@@ -66,7 +66,7 @@ func promptConflictMenu(cmd *cobra.Command, engine engineRunner, plan *sync.Sync
 		case "":
 			return promptSync, nil
 		case "d":
-			if err := display.PrintDiffs(cmd.OutOrStdout(), plan, engine.Fetcher()); err != nil {
+			if err := display.PrintDiffs(cmd.OutOrStdout(), plan, engine.Fetcher(cmd.Context())); err != nil {
 				return promptQuit, err
 			}
 			// loop and re-prompt
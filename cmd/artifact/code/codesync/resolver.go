@@ -0,0 +1,356 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codesync
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/aymanbagabas/go-udiff"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/datarobot/cli/internal/config/viperx"
+	"github.com/datarobot/cli/internal/misc/reader"
+	"github.com/datarobot/cli/internal/workload/sync"
+	"github.com/datarobot/cli/internal/workload/sync/display"
+	"github.com/datarobot/cli/internal/workload/sync/merge"
+	"github.com/datarobot/cli/tui"
+)
+
+// resolveConflictsTUI is the production Deps.ResolveConflicts. Without a
+// terminal there is nobody to ask, so every conflict stays as the merge
+// left it.
+func resolveConflictsTUI(plan *sync.SyncPlan, fetcher display.ContentFetcher) (bool, error) {
+	if !reader.IsStdinTerminal() {
+		return true, nil
+	}
+
+	final, err := tui.Run(newConflictModel(plan, fetcher), tea.WithAltScreen())
+	if err != nil {
+		return false, fmt.Errorf("conflict prompt: %w", err)
+	}
+
+	m, ok := tui.Unwrap(final).(conflictModel)
+
+	return ok && m.done, nil
+}
+
+type (
+	diffLoadedMsg struct {
+		text string
+		err  error
+	}
+	editedMsg struct {
+		content []byte
+		err     error
+	}
+)
+
+// conflictModel walks the conflicts the merge could not settle one file at
+// a time. Every answer is recorded on the plan straight away; done is set
+// only once the last file is answered, so quitting early aborts the sync.
+type conflictModel struct {
+	plan    *sync.SyncPlan
+	fetcher display.ContentFetcher
+	paths   []string
+	index   int
+	note    string
+	done    bool
+
+	viewing bool
+	diff    viewport.Model
+}
+
+func newConflictModel(plan *sync.SyncPlan, fetcher display.ContentFetcher) conflictModel {
+	var paths []string
+
+	for _, fa := range plan.Conflicts {
+		if fa.NeedsDecision() {
+			paths = append(paths, fa.Path)
+		}
+	}
+
+	return conflictModel{
+		plan:    plan,
+		fetcher: fetcher,
+		paths:   paths,
+		done:    len(paths) == 0,
+		diff:    viewport.New(80, 20),
+	}
+}
+
+func (m conflictModel) Init() tea.Cmd {
+	if m.done {
+		return tea.Quit
+	}
+
+	return nil
+}
+
+// current returns the plan row being asked about.
+func (m conflictModel) current() sync.FileAction {
+	for _, fa := range m.plan.Conflicts {
+		if fa.Path == m.paths[m.index] {
+			return fa
+		}
+	}
+
+	return sync.FileAction{Path: m.paths[m.index]}
+}
+
+func (m conflictModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.diff.Width = msg.Width
+		m.diff.Height = max(msg.Height-4, 1)
+	case diffLoadedMsg:
+		if msg.err != nil {
+			m.note = msg.err.Error()
+
+			return m, nil
+		}
+
+		m.diff.SetContent(msg.text)
+		m.diff.GotoTop()
+		m.viewing = true
+	case editedMsg:
+		return m.afterEdit(msg)
+	case tea.KeyMsg:
+		if m.viewing {
+			return m.updateDiff(msg)
+		}
+
+		return m.updateChoice(msg)
+	}
+
+	return m, nil
+}
+
+func (m conflictModel) updateDiff(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "d", "q", "esc":
+		m.viewing = false
+
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+
+	m.diff, cmd = m.diff.Update(msg)
+
+	return m, cmd
+}
+
+func (m conflictModel) updateChoice(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	fa := m.current()
+
+	switch msg.String() {
+	case "m":
+		return m.settle(sync.ResolveOurs, nil)
+	case "t":
+		return m.settle(sync.ResolveTheirs, nil)
+	case "e":
+		if fa.Merged == nil {
+			m.note = "This file cannot be merged in an editor; keep one side instead."
+
+			return m, nil
+		}
+
+		return m, openMergeEditor(fa)
+	case "d":
+		return m, m.loadDiff(fa)
+	case "s":
+		return m.next()
+	case "q", "esc":
+		return m, tea.Quit
+	}
+
+	return m, nil
+}
+
+func (m conflictModel) settle(r sync.Resolution, content []byte) (tea.Model, tea.Cmd) {
+	if err := m.plan.Resolve(m.paths[m.index], r, content); err != nil {
+		m.note = err.Error()
+
+		return m, nil
+	}
+
+	return m.next()
+}
+
+func (m conflictModel) next() (tea.Model, tea.Cmd) {
+	m.note = ""
+	m.index++
+
+	if m.index == len(m.paths) {
+		m.done = true
+
+		return m, tea.Quit
+	}
+
+	return m, nil
+}
+
+// afterEdit takes what the editor saved. Content free of conflict markers
+// settles the file; content that still has some stays on it, so the user
+// can edit again, pick a side, or leave the markers in the file.
+func (m conflictModel) afterEdit(msg editedMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		m.note = msg.err.Error()
+
+		return m, nil
+	}
+
+	if !merge.HasConflictMarkers(msg.content) {
+		return m.settle(sync.ResolveMerged, msg.content)
+	}
+
+	if err := m.plan.Resolve(m.paths[m.index], sync.ResolveMarkers, msg.content); err != nil {
+		m.note = err.Error()
+
+		return m, nil
+	}
+
+	m.note = "Conflict markers remain. Edit again, pick a side, or press s to leave them in the file."
+
+	return m, nil
+}
+
+func (m conflictModel) loadDiff(fa sync.FileAction) tea.Cmd {
+	fetcher := m.fetcher
+
+	return func() tea.Msg {
+		local, err := fetcher.LocalContent(fa.Path)
+		if err != nil {
+			return diffLoadedMsg{err: err}
+		}
+
+		var remote []byte
+
+		if fa.RemoteHash != "" {
+			if remote, err = fetcher.RemoteContent(fa.Path); err != nil {
+				return diffLoadedMsg{err: err}
+			}
+		}
+
+		if !merge.IsText(local) || !merge.IsText(remote) {
+			return diffLoadedMsg{text: "Binary files differ."}
+		}
+
+		return diffLoadedMsg{text: udiff.Unified(fa.Path+" (local)", fa.Path+" (remote)", string(local), string(remote))}
+	}
+}
+
+// openMergeEditor hands the merged content, markers and all, to the user's
+// editor in a temporary file named like the original so syntax highlighting
+// still applies, and reads back whatever they saved.
+func openMergeEditor(fa sync.FileAction) tea.Cmd {
+	file, err := os.CreateTemp("", "datarobot-merge-*"+filepath.Ext(fa.Path))
+	if err != nil {
+		return func() tea.Msg { return editedMsg{err: fmt.Errorf("cannot open an editor: %w", err)} }
+	}
+
+	name := file.Name()
+
+	if _, err := file.Write(fa.Merged); err != nil {
+		_ = file.Close()
+		_ = os.Remove(name)
+
+		return func() tea.Msg { return editedMsg{err: fmt.Errorf("cannot open an editor: %w", err)} }
+	}
+
+	_ = file.Close()
+
+	editor := strings.Fields(viperx.GetString("external-editor"))
+	if len(editor) == 0 {
+		editor = []string{"vi"}
+	}
+
+	return tea.ExecProcess(exec.Command(editor[0], append(editor[1:], name)...), func(runErr error) tea.Msg {
+		defer func() { _ = os.Remove(name) }()
+
+		if runErr != nil {
+			return editedMsg{err: fmt.Errorf("%s exited with an error: %w", editor[0], runErr)}
+		}
+
+		content, err := os.ReadFile(name)
+		if err != nil {
+			return editedMsg{err: fmt.Errorf("cannot read back the edited file: %w", err)}
+		}
+
+		return editedMsg{content: content}
+	})
+}
+
+func (m conflictModel) View() string {
+	if m.done {
+		return ""
+	}
+
+	fa := m.current()
+	title := fmt.Sprintf("Resolve conflicts (%d of %d): %s", m.index+1, len(m.paths), fa.Path)
+
+	var sb strings.Builder
+
+	sb.WriteString(tui.TitleStyle.Render(title))
+	sb.WriteString("\n")
+
+	if m.viewing {
+		sb.WriteString(m.diff.View())
+		sb.WriteString("\n")
+		sb.WriteString(tui.DimStyle.Render("↑/↓ scroll · d/esc back"))
+
+		return sb.String()
+	}
+
+	sb.WriteString(tui.BaseTextStyle.Render("  " + describeConflict(fa)))
+	sb.WriteString("\n\n")
+	sb.WriteString(tui.BaseTextStyle.Render("  [m] Keep mine   [t] Keep theirs   [e] Open $EDITOR   [d] View diff"))
+	sb.WriteString("\n")
+	sb.WriteString(tui.BaseTextStyle.Render("  [s] Leave as is (" + display.ResolutionNote(fa) + ")   [q] Abort sync"))
+	sb.WriteString("\n")
+
+	if m.note != "" {
+		sb.WriteString("\n")
+		sb.WriteString(tui.WarnStyle.Render("  " + m.note))
+		sb.WriteString("\n")
+	}
+
+	return sb.String()
+}
+
+// describeConflict says how the two sides diverged and why the merge could
+// not settle it.
+func describeConflict(fa sync.FileAction) string {
+	var what string
+
+	switch fa.Classification {
+	case sync.ClsAddConflict:
+		what = "Added locally and remotely with different content."
+	case sync.ClsDelEditConflict:
+		return "Changed locally, deleted remotely. Keeping theirs deletes your copy."
+	default:
+		what = "Changed locally and remotely."
+	}
+
+	if fa.Resolution == sync.ResolveMarkers {
+		return fmt.Sprintf("%s The merge left %d conflicting hunk(s).", what, fa.ConflictHunks)
+	}
+
+	return what + " It cannot be merged line by line."
+}
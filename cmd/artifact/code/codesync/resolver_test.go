@@ -0,0 +1,111 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codesync
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/datarobot/cli/internal/workload/sync"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func press(t *testing.T, m conflictModel, key string) (conflictModel, tea.Cmd) {
+	t.Helper()
+
+	next, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)})
+
+	got, ok := next.(conflictModel)
+	require.True(t, ok)
+
+	return got, cmd
+}
+
+func TestConflictModel_AnswersEveryFile(t *testing.T) {
+	plan := &sync.SyncPlan{Conflicts: []sync.FileAction{
+		{Path: "a.py", Classification: sync.ClsConflict},
+		{Path: "b.py", Classification: sync.ClsConflict, Resolution: sync.ResolveMerged},
+		{Path: "c.py", Classification: sync.ClsConflict, Resolution: sync.ResolveMarkers},
+		{Path: "d.py", Classification: sync.ClsConflict},
+	}}
+
+	m := newConflictModel(plan, &stubFetcher{})
+	require.Equal(t, []string{"a.py", "c.py", "d.py"}, m.paths, "only unsettled conflicts are asked about")
+	assert.Contains(t, m.View(), "a.py")
+
+	m, _ = press(t, m, "m")
+	m, _ = press(t, m, "t")
+	m, cmd := press(t, m, "s")
+
+	assert.True(t, m.done)
+	require.NotNil(t, cmd)
+	assert.Equal(t, sync.ResolveOurs, plan.Conflicts[0].Resolution)
+	assert.Equal(t, sync.ResolveTheirs, plan.Conflicts[2].Resolution)
+	assert.Equal(t, sync.ResolveRemoteCopy, plan.Conflicts[3].Resolution, "s leaves the file as it is")
+}
+
+func TestConflictModel_QuitAborts(t *testing.T) {
+	plan := &sync.SyncPlan{Conflicts: []sync.FileAction{
+		{Path: "a.py", Classification: sync.ClsConflict},
+	}}
+
+	m, cmd := press(t, newConflictModel(plan, &stubFetcher{}), "q")
+
+	assert.False(t, m.done)
+	assert.NotNil(t, cmd)
+	assert.Equal(t, sync.ResolveRemoteCopy, plan.Conflicts[0].Resolution)
+}
+
+func TestConflictModel_EditedContent(t *testing.T) {
+	plan := &sync.SyncPlan{Conflicts: []sync.FileAction{
+		{Path: "a.py", Classification: sync.ClsConflict, Resolution: sync.ResolveMarkers, Merged: []byte("<<<<<<< local\n")},
+		{Path: "b.py", Classification: sync.ClsConflict},
+	}}
+
+	m := newConflictModel(plan, &stubFetcher{})
+
+	next, _ := m.Update(editedMsg{content: []byte("<<<<<<< local\nx\n=======\ny\n>>>>>>> remote\n")})
+	m = next.(conflictModel)
+	assert.Equal(t, 0, m.index, "markers left; stay on the file")
+	assert.NotEmpty(t, m.note)
+
+	next, _ = m.Update(editedMsg{content: []byte("x\ny\n")})
+	m = next.(conflictModel)
+	assert.Equal(t, 1, m.index)
+	assert.Equal(t, sync.ResolveMerged, plan.Conflicts[0].Resolution)
+	assert.Equal(t, "x\ny\n", string(plan.Conflicts[0].Merged))
+}
+
+func TestConflictModel_Diff(t *testing.T) {
+	plan := &sync.SyncPlan{Conflicts: []sync.FileAction{
+		{Path: "a.py", Classification: sync.ClsConflict, RemoteHash: "h"},
+	}}
+	fetcher := &stubFetcher{
+		local:  map[string]string{"a.py": "local\n"},
+		remote: map[string]string{"a.py": "remote\n"},
+	}
+
+	m, cmd := press(t, newConflictModel(plan, fetcher), "d")
+	require.NotNil(t, cmd)
+
+	next, _ := m.Update(cmd())
+	m = next.(conflictModel)
+	assert.True(t, m.viewing)
+	assert.Contains(t, m.View(), "+remote")
+
+	m, _ = press(t, m, "d")
+	assert.False(t, m.viewing)
+}
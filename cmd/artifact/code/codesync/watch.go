@@ -0,0 +1,314 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codesync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/datarobot/cli/cmd/artifact/code/internal/format"
+	"github.com/datarobot/cli/internal/log"
+	"github.com/datarobot/cli/internal/workload/sync"
+	"github.com/datarobot/cli/internal/workload/sync/display"
+	"github.com/datarobot/cli/internal/workload/wapi"
+	"github.com/datarobot/cli/tui"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+// watchDebounce is how long the project has to be quiet before a burst of
+// edits is synced.
+const watchDebounce = 500 * time.Millisecond
+
+// changeWatcher is the subset of *sync.Watcher the watch loop drives.
+type changeWatcher interface {
+	Wait(ctx context.Context) ([]string, error)
+	Close() error
+}
+
+// watchSession is one `sync --watch` run. It holds the project's SyncLock
+// from start to exit, except while paused on conflicts: then the lock is
+// let go so `dr artifact code sync` can run in another terminal to settle
+// them, and taken back on the next change.
+type watchSession struct {
+	dir    string
+	flags  runFlags
+	deps   Deps
+	status *statusLine
+	errOut io.Writer
+
+	lock   *sync.SyncLock
+	paused string // the conflicts last reported, so a repeat pause stays quiet
+}
+
+// runWatch syncs once, then again after every debounced batch of local
+// edits, until the command's context is cancelled (Ctrl+C).
+func runWatch(cmd *cobra.Command, dir string, flags runFlags, deps Deps) error {
+	format.StateNotice(cmd.ErrOrStderr(), wapi.EnsureMigrated(dir))
+
+	lock, err := sync.AcquireSyncLock(dir)
+	if err != nil {
+		return err
+	}
+
+	s := &watchSession{
+		dir:    dir,
+		flags:  flags,
+		deps:   deps,
+		status: newStatusLine(cmd.OutOrStdout()),
+		errOut: cmd.ErrOrStderr(),
+		lock:   lock,
+	}
+
+	defer s.releaseLock()
+
+	watcher, err := deps.NewWatcher(dir, watchDebounce)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if cerr := watcher.Close(); cerr != nil {
+			log.Debug("sync watcher close returned error", "err", cerr)
+		}
+	}()
+
+	ctx := cmd.Context()
+
+	s.pass(ctx, nil)
+
+	for {
+		changed, err := watcher.Wait(ctx)
+		if err != nil {
+			if ctx.Err() != nil || errors.Is(err, context.Canceled) {
+				s.status.Log(tui.DimStyle.Render("Stopped watching."))
+
+				return nil
+			}
+
+			s.status.Close()
+
+			return fmt.Errorf("watch %s: %w", dir, err)
+		}
+
+		s.pass(ctx, changed)
+	}
+}
+
+// pass runs one Plan/Execute cycle. Nothing here ends the session: a
+// failed sync is reported and the next change tries again.
+func (s *watchSession) pass(ctx context.Context, changed []string) {
+	if s.lock == nil {
+		lock, err := sync.AcquireSyncLock(s.dir)
+		if err != nil {
+			s.status.Set(tui.WarnStyle.Render("Waiting: another sync is running on this project"))
+
+			return
+		}
+
+		s.lock = lock
+	}
+
+	s.status.Progress("Syncing " + describeChanges(changed) + "…")
+
+	engine, err := s.deps.NewEngine(s.dir, sync.Options{Yes: true, Strategy: s.flags.Strategy, Lock: s.lock})
+	if err != nil {
+		s.fail(err)
+
+		return
+	}
+
+	defer func() {
+		if cerr := engine.Close(); cerr != nil {
+			log.Debug("sync engine close returned error", "err", cerr)
+		}
+	}()
+
+	plan, err := engine.Plan(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			s.fail(err)
+		}
+
+		return
+	}
+
+	if engine.StaleRollbackRestored() {
+		s.status.Log(tui.DimStyle.Render("Recovered from interrupted sync. Working tree restored."))
+	}
+
+	if plan.IsEmpty() {
+		s.paused = ""
+		s.status.Set(stamp() + "Up to date · watching")
+
+		return
+	}
+
+	if open := undecidedConflicts(plan, s.flags); len(open) > 0 {
+		s.pause(open)
+
+		return
+	}
+
+	result, err := engine.Execute(ctx, plan)
+	if err != nil {
+		if ctx.Err() == nil {
+			s.fail(err)
+		}
+
+		return
+	}
+
+	s.paused = ""
+
+	for _, p := range result.ConflictCopies {
+		s.status.Log(tui.DimStyle.Render("Conflict copy saved: " + p))
+	}
+
+	for _, p := range result.Unresolved {
+		s.status.Log(tui.WarnStyle.Render("Conflict markers left in " + p + "; remove them to keep syncing"))
+	}
+
+	s.status.Set(stamp() + tui.SuccessStyle.Render("Synced "+display.ResultSummary(result)) + " · watching")
+}
+
+// pause stops syncing until the conflicts are settled elsewhere. The lock
+// is released so the interactive sync can take it.
+func (s *watchSession) pause(open []string) {
+	s.releaseLock()
+
+	key := strings.Join(open, "\x00")
+	if key != s.paused {
+		s.paused = key
+		s.status.Log(tui.WarnStyle.Render(fmt.Sprintf(
+			"Paused: %d conflict(s) need a decision: %s. Run 'dr artifact code sync' to settle them; watching resumes with the next change.",
+			len(open), strings.Join(open, ", "))))
+	}
+
+	s.status.Set(stamp() + tui.WarnStyle.Render("Paused on conflicts"))
+}
+
+func (s *watchSession) fail(err error) {
+	s.status.Log(tui.ErrorStyle.Render("Sync failed: " + err.Error()))
+	s.status.Set(stamp() + "Watching; the next change retries")
+}
+
+func (s *watchSession) releaseLock() {
+	if s.lock == nil {
+		return
+	}
+
+	if err := s.lock.Release(); err != nil {
+		log.Warn("failed to release sync lock", "err", err, "project", s.dir)
+	}
+
+	s.lock = nil
+}
+
+// undecidedConflicts lists the conflicts watch mode will not settle on its
+// own. With --strategy every conflict has an answer; without it, only
+// clean merges go through unattended.
+func undecidedConflicts(plan *sync.SyncPlan, flags runFlags) []string {
+	if flags.Strategy != "" {
+		return nil
+	}
+
+	var open []string
+
+	for _, fa := range plan.Conflicts {
+		if fa.NeedsDecision() {
+			open = append(open, fa.Path)
+		}
+	}
+
+	return open
+}
+
+// describeChanges names the first changed path and counts the rest.
+func describeChanges(changed []string) string {
+	switch len(changed) {
+	case 0:
+		return "project"
+	case 1:
+		return changed[0]
+	default:
+		return fmt.Sprintf("%s and %d more", changed[0], len(changed)-1)
+	}
+}
+
+func stamp() string {
+	return tui.DimStyle.Render(time.Now().Format("15:04:05")) + " "
+}
+
+// statusLine is watch mode's compact, rolling output. On a terminal each
+// status overwrites the last one in place and Log lines scroll above it;
+// elsewhere every status is a plain line and progress is not shown.
+type statusLine struct {
+	w     io.Writer
+	tty   bool
+	shown bool
+}
+
+func newStatusLine(w io.Writer) *statusLine {
+	f, ok := w.(*os.File)
+
+	return &statusLine{w: w, tty: ok && term.IsTerminal(int(f.Fd()))} //nolint:gosec // uintptr and int are same size on supported platforms
+}
+
+// Set replaces the rolling status.
+func (s *statusLine) Set(text string) {
+	if !s.tty {
+		fmt.Fprintln(s.w, text)
+
+		return
+	}
+
+	fmt.Fprint(s.w, "\r\033[K"+text)
+
+	s.shown = true
+}
+
+// Progress is a status worth showing only while it is current.
+func (s *statusLine) Progress(text string) {
+	if s.tty {
+		s.Set(text)
+	}
+}
+
+// Log prints text on its own line; the rolling status is redrawn by the
+// next Set.
+func (s *statusLine) Log(text string) {
+	if s.tty && s.shown {
+		fmt.Fprint(s.w, "\r\033[K")
+
+		s.shown = false
+	}
+
+	fmt.Fprintln(s.w, text)
+}
+
+// Close ends the rolling line so the shell prompt starts on a fresh one.
+func (s *statusLine) Close() {
+	if s.tty && s.shown {
+		fmt.Fprintln(s.w)
+
+		s.shown = false
+	}
+}
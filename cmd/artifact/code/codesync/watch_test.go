@@ -0,0 +1,169 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codesync
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/datarobot/cli/internal/workload/sync"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeWatcher hands out canned batches, then reports cancellation as if
+// the user pressed Ctrl+C.
+type fakeWatcher struct {
+	batches [][]string
+	closed  bool
+}
+
+func (f *fakeWatcher) Wait(context.Context) ([]string, error) {
+	if len(f.batches) == 0 {
+		return nil, context.Canceled
+	}
+
+	batch := f.batches[0]
+	f.batches = f.batches[1:]
+
+	return batch, nil
+}
+
+func (f *fakeWatcher) Close() error {
+	f.closed = true
+
+	return nil
+}
+
+// countingEngine counts Plan and Execute calls across watch passes.
+type countingEngine struct {
+	fakeEngine
+
+	plans, executes int
+}
+
+func (c *countingEngine) Plan(ctx context.Context) (*sync.SyncPlan, error) {
+	c.plans++
+
+	return c.fakeEngine.Plan(ctx)
+}
+
+func (c *countingEngine) Execute(ctx context.Context, plan *sync.SyncPlan) (*sync.Result, error) {
+	c.executes++
+
+	return c.fakeEngine.Execute(ctx, plan)
+}
+
+func watchDeps(fe engineRunner, fw *fakeWatcher, opts *[]sync.Options) Deps {
+	return Deps{
+		NewEngine: func(_ string, o sync.Options) (engineRunner, error) {
+			*opts = append(*opts, o)
+
+			return fe, nil
+		},
+		NewWatcher: func(string, time.Duration) (changeWatcher, error) { return fw, nil },
+	}
+}
+
+func TestWatch_SyncsEveryBatchUnderOneLock(t *testing.T) {
+	dir := t.TempDir()
+	linkProject(t, dir)
+
+	ce := &countingEngine{fakeEngine: fakeEngine{
+		plan:   &sync.SyncPlan{Uploads: []sync.FileAction{{Path: "agent.py"}}},
+		result: &sync.Result{NewVersion: "v2", UploadedCount: 1},
+	}}
+	fw := &fakeWatcher{batches: [][]string{{"agent.py"}, {"a.py", "b.py"}}}
+
+	var opts []sync.Options
+
+	_, stdout, _, err := runWithDeps(t, watchDeps(ce, fw, &opts), map[string]string{"dir": dir, "watch": "true"})
+	require.NoError(t, err)
+
+	assert.Equal(t, 3, ce.executes, "the initial pass plus one per batch")
+	require.Len(t, opts, 3)
+
+	for _, o := range opts {
+		assert.NotNil(t, o.Lock, "every pass runs under the session's lock")
+		assert.Same(t, opts[0].Lock, o.Lock)
+		assert.True(t, o.Yes)
+	}
+
+	assert.True(t, fw.closed)
+	assert.Contains(t, stdout.String(), "Synced")
+	assert.Contains(t, stdout.String(), "Stopped watching.")
+
+	lock, err := sync.AcquireSyncLock(dir)
+	require.NoError(t, err, "the lock must be released on exit")
+	require.NoError(t, lock.Release())
+}
+
+func TestWatch_PausesOnConflicts(t *testing.T) {
+	dir := t.TempDir()
+	linkProject(t, dir)
+
+	ce := &countingEngine{fakeEngine: fakeEngine{
+		plan: &sync.SyncPlan{Conflicts: []sync.FileAction{
+			{Path: "x.py", Classification: sync.ClsConflict, Resolution: sync.ResolveMarkers, ConflictHunks: 1},
+		}},
+	}}
+	fw := &fakeWatcher{batches: [][]string{{"y.py"}}}
+
+	var opts []sync.Options
+
+	_, stdout, _, err := runWithDeps(t, watchDeps(ce, fw, &opts), map[string]string{"dir": dir, "watch": "true"})
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, ce.plans)
+	assert.Zero(t, ce.executes, "a conflict that needs a decision must not be synced")
+	assert.Equal(t, 1, strings.Count(stdout.String(), "Paused: 1 conflict(s) need a decision: x.py"), "a repeated pause is reported once")
+}
+
+func TestWatch_StrategySettlesConflicts(t *testing.T) {
+	dir := t.TempDir()
+	linkProject(t, dir)
+
+	ce := &countingEngine{fakeEngine: fakeEngine{
+		plan: &sync.SyncPlan{Conflicts: []sync.FileAction{
+			{Path: "x.py", Classification: sync.ClsConflict, Resolution: sync.ResolveOurs},
+		}},
+		result: &sync.Result{NewVersion: "v2", UploadedCount: 1},
+	}}
+
+	var opts []sync.Options
+
+	flags := map[string]string{"dir": dir, "watch": "true", "strategy": "ours"}
+
+	_, _, _, err := runWithDeps(t, watchDeps(ce, &fakeWatcher{}, &opts), flags)
+	require.NoError(t, err)
+	assert.Equal(t, 1, ce.executes)
+	assert.Equal(t, sync.StrategyOurs, opts[0].Strategy)
+}
+
+func TestWatch_RejectsPreviewAndJSON(t *testing.T) {
+	dir := t.TempDir()
+	linkProject(t, dir)
+
+	deps := watchDeps(&fakeEngine{}, &fakeWatcher{}, new([]sync.Options))
+
+	_, _, _, err := runWithDeps(t, deps, map[string]string{"dir": dir, "watch": "true", "dry-run": "true"})
+	require.Error(t, err)
+
+	_, _, _, err = runWithDeps(t, deps, map[string]string{"dir": dir, "watch": "true", "output-format": "json"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--watch")
+}
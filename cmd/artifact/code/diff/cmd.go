@@ -0,0 +1,188 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package diff implements `dr artifact code diff`: unified diffs between the
+// working tree and catalog versions of the artifact's code.
+package diff
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/datarobot/cli/cmd/artifact/code/internal/format"
+	"github.com/datarobot/cli/internal/auth"
+	"github.com/datarobot/cli/internal/drapi/filesapi"
+	"github.com/datarobot/cli/internal/outputformat"
+	"github.com/datarobot/cli/internal/telemetry"
+	"github.com/datarobot/cli/internal/workload/wapi"
+	"github.com/spf13/cobra"
+)
+
+type Deps struct {
+	Files filesapi.Client
+}
+
+func defaultDeps() Deps {
+	return Deps{
+		Files: filesapi.New(),
+	}
+}
+
+func Cmd() *cobra.Command {
+	return cmdWithDeps(defaultDeps())
+}
+
+func cmdWithDeps(deps Deps) *cobra.Command {
+	var outputFormat outputformat.OutputFormat
+
+	c := &cobra.Command{
+		Use:          "diff [<verA>] [<verB>]",
+		Short:        "Show unified diffs between the working tree and catalog versions.",
+		SilenceUsage: true,
+		Args:         cobra.MaximumNArgs(2),
+		Long: `Show what differs between two states of the artifact's code, as unified
+diffs:
+
+  no arguments     the last synced version against the working tree
+  <verA>           version verA against the working tree
+  <verA> <verB>    version verA against version verB
+
+Versions may be full IDs or any unique prefix; 'dr artifact code versions'
+lists them. The working tree honors .wapiignore exactly as sync does.
+Files are compared by content hash, so only the ones that differ are
+downloaded. Binary files are reported as differing without a diff.
+
+Run 'dr artifact code init <artifact-id>' first to link a project
+directory to an artifact.
+
+Example:
+  dr artifact code diff
+  dr artifact code diff abcdef12
+  dr artifact code diff abcdef12 98765432
+  dr artifact code diff --name-only --output-format json`,
+		PreRunE: auth.EnsureAuthenticatedE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			outputFormat = outputformat.GetFormat(cmd)
+
+			return runDiff(cmd, args, outputFormat, deps)
+		},
+	}
+
+	outputformat.AddFlag(c, &outputFormat)
+
+	c.Flags().String("dir", "", "Project directory (default: current directory).")
+	c.Flags().Bool("name-only", false, "List the changed files without their diffs.")
+
+	telemetry.TrackWith(c, func(cmd *cobra.Command, args []string) map[string]any {
+		nameOnly, _ := cmd.Flags().GetBool("name-only")
+
+		return map[string]any{
+			"versions":      len(args),
+			"name_only":     nameOnly,
+			"output_format": string(outputFormat),
+		}
+	})
+
+	return c
+}
+
+func runDiff(cmd *cobra.Command, args []string, outputFormat outputformat.OutputFormat, deps Deps) error {
+	dirFlag, _ := cmd.Flags().GetString("dir")
+	nameOnly, _ := cmd.Flags().GetBool("name-only")
+
+	if dirFlag == "" {
+		dirFlag = "."
+	}
+
+	dir, err := filepath.Abs(dirFlag)
+	if err != nil {
+		return fmt.Errorf("resolve dir %s: %w", dirFlag, err)
+	}
+
+	format.StateNotice(cmd.ErrOrStderr(), wapi.EnsureMigrated(dir))
+
+	if !wapi.Exists(dir) {
+		return errors.New("not linked to an artifact. Run 'dr artifact code init <id>' first")
+	}
+
+	from, to, err := resolveTrees(cmd.Context(), dir, args, deps)
+	if err != nil {
+		return err
+	}
+
+	files, err := compare(cmd.Context(), from, to, nameOnly)
+	if err != nil {
+		return err
+	}
+
+	r := result{From: from.label(), To: to.label(), Files: files}
+
+	if outputFormat == outputformat.OutputFormatJSON {
+		return renderJSON(cmd.OutOrStdout(), r)
+	}
+
+	renderText(cmd.OutOrStdout(), r, nameOnly)
+
+	return nil
+}
+
+// resolveTrees maps the positional arguments onto the two sides of the diff.
+func resolveTrees(ctx context.Context, dir string, args []string, deps Deps) (tree, tree, error) {
+	cfg, err := wapi.LoadConfig(dir)
+	if err != nil {
+		return nil, nil, fmt.Errorf("read %s: %w", wapi.ConfigPath(dir), err)
+	}
+
+	if cfg.CatalogID == nil || *cfg.CatalogID == "" {
+		return nil, nil, errors.New("no code has been synced yet. Run 'dr artifact code sync' first")
+	}
+
+	catalogID := *cfg.CatalogID
+
+	switch len(args) {
+	case 0:
+		if cfg.LastSyncedVersionID == nil || *cfg.LastSyncedVersionID == "" {
+			return nil, nil, errors.New("no code has been synced yet. Run 'dr artifact code sync' first")
+		}
+
+		from, err := newVersionTree(ctx, deps.Files, catalogID, *cfg.LastSyncedVersionID)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		to, err := newWorkingTree(dir)
+
+		return from, to, err
+	case 1:
+		from, err := resolveVersionTree(ctx, deps.Files, catalogID, args[0])
+		if err != nil {
+			return nil, nil, err
+		}
+
+		to, err := newWorkingTree(dir)
+
+		return from, to, err
+	default:
+		from, err := resolveVersionTree(ctx, deps.Files, catalogID, args[0])
+		if err != nil {
+			return nil, nil, err
+		}
+
+		to, err := resolveVersionTree(ctx, deps.Files, catalogID, args[1])
+
+		return from, to, err
+	}
+}
@@ -0,0 +1,265 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diff
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/datarobot/cli/internal/drapi/filesapi"
+	"github.com/datarobot/cli/internal/workload/wapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeClient serves catalog versions from memory. Only the three methods
+// diff uses are implemented; the rest panic.
+type fakeClient struct {
+	versions map[string]map[string]string
+
+	downloads []string
+}
+
+func (f *fakeClient) ListVersions(_ context.Context, _ string, _ int) ([]filesapi.CatalogVersion, error) {
+	out := make([]filesapi.CatalogVersion, 0, len(f.versions))
+	for id := range f.versions {
+		out = append(out, filesapi.CatalogVersion{ID: id})
+	}
+
+	return out, nil
+}
+
+func (f *fakeClient) AllFiles(_ context.Context, _, versionID string) (map[string]filesapi.FileMeta, error) {
+	files, ok := f.versions[versionID]
+	if !ok {
+		return nil, errors.New("no such version")
+	}
+
+	out := make(map[string]filesapi.FileMeta, len(files))
+
+	for path, content := range files {
+		sum := sha256.Sum256([]byte(content))
+		out[path] = filesapi.FileMeta{Hash: hex.EncodeToString(sum[:]), Size: int64(len(content))}
+	}
+
+	return out, nil
+}
+
+func (f *fakeClient) DownloadFile(_ context.Context, _, versionID, path string, w io.Writer) (string, int64, error) {
+	f.downloads = append(f.downloads, versionID+":"+path)
+
+	content, ok := f.versions[versionID][path]
+	if !ok {
+		return "", 0, errors.New("not found")
+	}
+
+	n, err := io.WriteString(w, content)
+
+	return "", int64(n), err
+}
+
+// Panicking stubs for the rest of filesapi.Client.
+func (*fakeClient) CreateCatalog(context.Context) (*filesapi.CatalogResp, error) { panic("unused") }
+
+func (*fakeClient) CreateStage(context.Context, string) (*filesapi.StageResp, error) { panic("unused") }
+
+func (*fakeClient) UploadToStage(context.Context, string, string, string, int64, io.Reader) error {
+	panic("unused")
+}
+
+func (*fakeClient) ApplyStage(context.Context, string, string, string) (*filesapi.ApplyStageResp, error) {
+	panic("unused")
+}
+
+func (*fakeClient) UploadFromZipNew(context.Context, string, int64, io.Reader) (*filesapi.FromFileResp, error) {
+	panic("unused")
+}
+
+func (*fakeClient) UploadFromZipExisting(context.Context, string, string, string, int64, io.Reader) (*filesapi.FromFileResp, error) {
+	panic("unused")
+}
+
+func (*fakeClient) PollStatus(context.Context, string) (*filesapi.StatusResp, error) { panic("unused") }
+
+func (*fakeClient) DeleteFiles(context.Context, string, []string) (*filesapi.DeleteFilesResp, error) {
+	panic("unused")
+}
+
+// linkedProject writes files into a directory linked to catalog cat-abc-123
+// and last synced to ver-1111111111.
+func linkedProject(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+
+	require.NoError(t, wapi.Initialize(dir, wapi.InitOptions{
+		ArtifactID: "art-abc-123", CatalogID: "cat-abc-123", LastSyncedVersionID: "ver-1111111111",
+	}))
+
+	for rel, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(rel))
+
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	}
+
+	return dir
+}
+
+// withIgnore returns files plus the .wapiignore Initialize wrote into dir,
+// so a version can match the working tree exactly.
+func withIgnore(t *testing.T, dir string, files map[string]string) map[string]string {
+	t.Helper()
+
+	data, err := os.ReadFile(filepath.Join(dir, ".wapiignore"))
+	require.NoError(t, err)
+
+	out := map[string]string{".wapiignore": string(data)}
+	for k, v := range files {
+		out[k] = v
+	}
+
+	return out
+}
+
+func runCmd(t *testing.T, fc *fakeClient, args ...string) (string, error) {
+	t.Helper()
+
+	cmd := cmdWithDeps(Deps{Files: fc})
+	cmd.PreRunE = nil
+
+	var out bytes.Buffer
+
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs(args)
+
+	err := cmd.Execute()
+
+	return out.String(), err
+}
+
+func TestDiff_NotLinked(t *testing.T) {
+	_, err := runCmd(t, &fakeClient{}, "--dir", t.TempDir())
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "dr artifact code init")
+}
+
+func TestDiff_WorkingTreeAgainstLastSync(t *testing.T) {
+	dir := linkedProject(t, map[string]string{"app.py": "print('hi')\nprint('bye')\n", "new.txt": "new\n"})
+	fc := &fakeClient{versions: map[string]map[string]string{
+		"ver-1111111111": withIgnore(t, dir, map[string]string{"app.py": "print('hi')\n", "gone.txt": "old\n"}),
+	}}
+
+	out, err := runCmd(t, fc, "--dir", dir)
+	require.NoError(t, err)
+
+	assert.Contains(t, out, "--- app.py (ver-1111111111)")
+	assert.Contains(t, out, "+++ app.py (working tree)")
+	assert.Contains(t, out, "+print('bye')")
+	assert.Contains(t, out, "-old")
+	assert.Contains(t, out, "+new")
+	assert.NotContains(t, out, ".wapiignore", "unchanged files are not shown")
+}
+
+func TestDiff_OnlyChangedFilesDownloaded(t *testing.T) {
+	dir := linkedProject(t, map[string]string{"same.txt": "same\n", "app.py": "b\n"})
+	fc := &fakeClient{versions: map[string]map[string]string{
+		"ver-1111111111": withIgnore(t, dir, map[string]string{"same.txt": "same\n", "app.py": "a\n"}),
+	}}
+
+	_, err := runCmd(t, fc, "--dir", dir)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ver-1111111111:app.py"}, fc.downloads)
+}
+
+func TestDiff_TwoVersionsByPrefix(t *testing.T) {
+	dir := linkedProject(t, nil)
+	fc := &fakeClient{versions: map[string]map[string]string{
+		"ver-1111111111": {"app.py": "a\n"},
+		"ver-2222222222": {"app.py": "b\n"},
+	}}
+
+	out, err := runCmd(t, fc, "--dir", dir, "ver-1", "ver-2", "--output-format", "json")
+	require.NoError(t, err)
+
+	var got result
+
+	require.NoError(t, json.Unmarshal([]byte(out), &got))
+	assert.Equal(t, "ver-1111111111", got.From)
+	assert.Equal(t, "ver-2222222222", got.To)
+	require.Len(t, got.Files, 1)
+	assert.Equal(t, StatusModified, got.Files[0].Status)
+	assert.Contains(t, got.Files[0].Diff, "-a\n+b\n")
+}
+
+func TestDiff_NameOnlySkipsDownloads(t *testing.T) {
+	dir := linkedProject(t, nil)
+	fc := &fakeClient{versions: map[string]map[string]string{
+		"ver-1111111111": {"app.py": "a\n", "old.py": "x\n"},
+		"ver-2222222222": {"app.py": "b\n"},
+	}}
+
+	out, err := runCmd(t, fc, "--dir", dir, "ver-1", "ver-2", "--name-only")
+	require.NoError(t, err)
+
+	assert.Contains(t, out, "modified:  app.py")
+	assert.Contains(t, out, "deleted:   old.py")
+	assert.Empty(t, fc.downloads)
+}
+
+func TestDiff_BinaryFile(t *testing.T) {
+	dir := linkedProject(t, nil)
+	fc := &fakeClient{versions: map[string]map[string]string{
+		"ver-1111111111": {"model.bin": "a\x00b"},
+		"ver-2222222222": {"model.bin": "a\x00c"},
+	}}
+
+	out, err := runCmd(t, fc, "--dir", dir, "ver-1", "ver-2")
+	require.NoError(t, err)
+	assert.Contains(t, out, "Binary file model.bin modified.")
+}
+
+func TestDiff_NoDifferences(t *testing.T) {
+	dir := linkedProject(t, map[string]string{"app.py": "a\n"})
+	fc := &fakeClient{versions: map[string]map[string]string{
+		"ver-1111111111": withIgnore(t, dir, map[string]string{"app.py": "a\n"}),
+	}}
+
+	out, err := runCmd(t, fc, "--dir", dir)
+	require.NoError(t, err)
+	assert.Contains(t, out, "No differences between version ver-1111 and the working tree.")
+}
+
+func TestDiff_UnsafeServerPath(t *testing.T) {
+	dir := linkedProject(t, nil)
+	fc := &fakeClient{versions: map[string]map[string]string{
+		"ver-1111111111": {"../escape": "x"},
+	}}
+
+	_, err := runCmd(t, fc, "--dir", dir)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unsafe path")
+}
@@ -0,0 +1,269 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diff
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/aymanbagabas/go-udiff"
+	"github.com/datarobot/cli/cmd/artifact/code/internal/catalogversion"
+	"github.com/datarobot/cli/internal/drapi/filesapi"
+	"github.com/datarobot/cli/internal/workload/fileops"
+	"github.com/datarobot/cli/internal/workload/sync"
+)
+
+// Statuses a changed file can have, read from the first side to the second.
+const (
+	StatusAdded    = "added"
+	StatusDeleted  = "deleted"
+	StatusModified = "modified"
+)
+
+// workingTreeLabel names the project directory side in labels and JSON.
+const workingTreeLabel = "working tree"
+
+// binarySniffLen is how much of a file is searched for a NUL byte, the same
+// heuristic git uses to decide a file is not text.
+const binarySniffLen = 8000
+
+// tree is one side of a diff: a set of files by content hash, and a way to
+// read any one of them.
+type tree interface {
+	label() string
+	files() map[string]string
+	read(ctx context.Context, path string) ([]byte, error)
+}
+
+// versionTree is a catalog version, listed up front and downloaded a file at
+// a time, so only files that differ cost a transfer.
+type versionTree struct {
+	client    filesapi.Client
+	catalogID string
+	versionID string
+	hashes    map[string]string
+}
+
+func resolveVersionTree(ctx context.Context, c filesapi.Client, catalogID, arg string) (tree, error) {
+	versionID, err := catalogversion.Resolve(ctx, c, catalogID, arg)
+	if err != nil {
+		return nil, err
+	}
+
+	return newVersionTree(ctx, c, catalogID, versionID)
+}
+
+func newVersionTree(ctx context.Context, c filesapi.Client, catalogID, versionID string) (tree, error) {
+	listed, err := c.AllFiles(ctx, catalogID, versionID)
+	if err != nil {
+		return nil, fmt.Errorf("list files for version %s: %w", versionID, err)
+	}
+
+	hashes := make(map[string]string, len(listed))
+
+	for path, meta := range listed {
+		if err := fileops.SafeRelPath(path); err != nil {
+			return nil, fmt.Errorf("server returned unsafe path %q: %w", path, err)
+		}
+
+		hashes[path] = meta.Hash
+	}
+
+	return &versionTree{client: c, catalogID: catalogID, versionID: versionID, hashes: hashes}, nil
+}
+
+func (t *versionTree) label() string { return t.versionID }
+
+func (t *versionTree) files() map[string]string { return t.hashes }
+
+func (t *versionTree) read(ctx context.Context, path string) ([]byte, error) {
+	var buf bytes.Buffer
+
+	if _, _, err := t.client.DownloadFile(ctx, t.catalogID, t.versionID, path, &buf); err != nil {
+		return nil, fmt.Errorf("download %s from version %s: %w", path, t.versionID, err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// workingTree is the project directory, scanned the way sync scans it.
+type workingTree struct {
+	dir    string
+	hashes map[string]string
+}
+
+func newWorkingTree(dir string) (tree, error) {
+	local, err := sync.ScanLocal(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	hashes := make(map[string]string, len(local))
+	for path, entry := range local {
+		hashes[path] = entry.Hash
+	}
+
+	return &workingTree{dir: dir, hashes: hashes}, nil
+}
+
+func (*workingTree) label() string { return workingTreeLabel }
+
+func (t *workingTree) files() map[string]string { return t.hashes }
+
+func (t *workingTree) read(_ context.Context, path string) ([]byte, error) {
+	data, err := os.ReadFile(filepath.Join(t.dir, filepath.FromSlash(path)))
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %w", path, err)
+	}
+
+	return data, nil
+}
+
+// fileDiff is one changed file. Diff is empty for a binary file and with
+// --name-only.
+type fileDiff struct {
+	Path   string `json:"path"`
+	Status string `json:"status"`
+	Binary bool   `json:"binary"`
+	Diff   string `json:"diff,omitempty"`
+}
+
+// result is the stable shape --output-format json emits.
+type result struct {
+	From  string     `json:"from"`
+	To    string     `json:"to"`
+	Files []fileDiff `json:"files"`
+}
+
+// compare lists the files whose hashes differ between from and to, sorted by
+// path, and reads both sides of each to diff them unless nameOnly is set.
+func compare(ctx context.Context, from, to tree, nameOnly bool) ([]fileDiff, error) {
+	a, b := from.files(), to.files()
+
+	changed := []fileDiff{}
+
+	for path, hash := range a {
+		other, ok := b[path]
+
+		switch {
+		case !ok:
+			changed = append(changed, fileDiff{Path: path, Status: StatusDeleted})
+		case other != hash:
+			changed = append(changed, fileDiff{Path: path, Status: StatusModified})
+		}
+	}
+
+	for path := range b {
+		if _, ok := a[path]; !ok {
+			changed = append(changed, fileDiff{Path: path, Status: StatusAdded})
+		}
+	}
+
+	sort.Slice(changed, func(i, j int) bool { return changed[i].Path < changed[j].Path })
+
+	if nameOnly {
+		return changed, nil
+	}
+
+	for i := range changed {
+		if err := fill(ctx, from, to, &changed[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	return changed, nil
+}
+
+// fill reads the sides of f that exist and renders the diff between them. A
+// missing side diffs as empty, which is how an added or deleted file reads.
+func fill(ctx context.Context, from, to tree, f *fileDiff) error {
+	var before, after []byte
+
+	if f.Status != StatusAdded {
+		data, err := from.read(ctx, f.Path)
+		if err != nil {
+			return err
+		}
+
+		before = data
+	}
+
+	if f.Status != StatusDeleted {
+		data, err := to.read(ctx, f.Path)
+		if err != nil {
+			return err
+		}
+
+		after = data
+	}
+
+	if isBinary(before) || isBinary(after) {
+		f.Binary = true
+
+		return nil
+	}
+
+	f.Diff = udiff.Unified(
+		fmt.Sprintf("%s (%s)", f.Path, from.label()),
+		fmt.Sprintf("%s (%s)", f.Path, to.label()),
+		string(before), string(after))
+
+	return nil
+}
+
+func isBinary(data []byte) bool {
+	return bytes.IndexByte(data[:min(len(data), binarySniffLen)], 0) >= 0
+}
+
+func renderJSON(out io.Writer, r result) error {
+	enc := json.NewEncoder(out)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(r)
+}
+
+func renderText(out io.Writer, r result, nameOnly bool) {
+	if len(r.Files) == 0 {
+		fmt.Fprintf(out, "No differences between %s and %s.\n", shortLabel(r.From), shortLabel(r.To))
+
+		return
+	}
+
+	for _, f := range r.Files {
+		switch {
+		case nameOnly:
+			fmt.Fprintf(out, "%-10s %s\n", f.Status+":", f.Path)
+		case f.Binary:
+			fmt.Fprintf(out, "Binary file %s %s.\n", f.Path, f.Status)
+		default:
+			fmt.Fprint(out, f.Diff)
+		}
+	}
+}
+
+// shortLabel abbreviates a version ID the way the other code commands do.
+func shortLabel(label string) string {
+	if label == workingTreeLabel {
+		return "the working tree"
+	}
+
+	return "version " + sync.ShortVer(label)
+}
@@ -15,6 +15,7 @@
 package initcmd
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -114,7 +115,7 @@ func runInit(cmd *cobra.Command, args []string, outputFormat outputformat.Output
 		return err
 	}
 
-	art, err := fetchArtifact(artifactID)
+	art, err := fetchArtifact(cmd.Context(), artifactID)
 	if err != nil {
 		return err
 	}
@@ -133,8 +134,8 @@ func runInit(cmd *cobra.Command, args []string, outputFormat outputformat.Output
 	return renderInitResult(outputFormat, newInitResult(*art, dir))
 }
 
-func fetchArtifact(artifactID string) (*workload.Artifact, error) {
-	art, err := getArtifactFn(artifactID)
+func fetchArtifact(ctx context.Context, artifactID string) (*workload.Artifact, error) {
+	art, err := getArtifactFn(ctx, artifactID)
 	if err != nil {
 		var httpErr *drapi.HTTPError
 		if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusNotFound {
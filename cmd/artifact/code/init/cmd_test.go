@@ -15,6 +15,7 @@
 package initcmd
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"os"
@@ -31,7 +32,7 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-func withFakeArtifact(t *testing.T, fn func(string) (*workload.Artifact, error)) {
+func withFakeArtifact(t *testing.T, fn func(context.Context, string) (*workload.Artifact, error)) {
 	t.Helper()
 
 	orig := getArtifactFn
@@ -87,7 +88,7 @@ func TestCmd_TooManyArgs(t *testing.T) {
 func TestRunE_ExistingCodeArtifact_EndToEnd(t *testing.T) {
 	tmp := t.TempDir()
 
-	withFakeArtifact(t, func(id string) (*workload.Artifact, error) {
+	withFakeArtifact(t, func(_ context.Context, id string) (*workload.Artifact, error) {
 		return fakeArtifact(id, "my-agent", "DRAFT", &workload.DatarobotCodeRef{
 			CatalogID:        "cat-xyz-789",
 			CatalogVersionID: "fedcba0987654321",
@@ -131,7 +132,7 @@ func TestRunE_ExistingCodeArtifact_EndToEnd(t *testing.T) {
 func TestRunE_EmptyArtifact_EndToEnd(t *testing.T) {
 	tmp := t.TempDir()
 
-	withFakeArtifact(t, func(id string) (*workload.Artifact, error) {
+	withFakeArtifact(t, func(_ context.Context, id string) (*workload.Artifact, error) {
 		return fakeArtifact(id, "blank-artifact", "DRAFT", nil), nil
 	})
 
@@ -153,7 +154,7 @@ func TestRunE_EmptyArtifact_EndToEnd(t *testing.T) {
 func TestRunE_LockedArtifact(t *testing.T) {
 	tmp := t.TempDir()
 
-	withFakeArtifact(t, func(id string) (*workload.Artifact, error) {
+	withFakeArtifact(t, func(_ context.Context, id string) (*workload.Artifact, error) {
 		return fakeArtifact(id, "registered", "LOCKED", nil), nil
 	})
 
@@ -169,7 +170,7 @@ func TestRunE_LockedArtifact(t *testing.T) {
 func TestRunE_NotFound(t *testing.T) {
 	tmp := t.TempDir()
 
-	withFakeArtifact(t, func(_ string) (*workload.Artifact, error) {
+	withFakeArtifact(t, func(_ context.Context, _ string) (*workload.Artifact, error) {
 		return nil, &drapi.HTTPError{StatusCode: 404, URL: "test"}
 	})
 
@@ -189,7 +190,7 @@ func TestRunE_AlreadyLinked(t *testing.T) {
 		ArtifactID: "art-existing-999",
 	}))
 
-	withFakeArtifact(t, func(_ string) (*workload.Artifact, error) {
+	withFakeArtifact(t, func(_ context.Context, _ string) (*workload.Artifact, error) {
 		t.Fatal("getArtifactFn must not be called when project is already linked")
 
 		return nil, nil
@@ -209,7 +210,7 @@ func TestRunE_AlreadyLinked(t *testing.T) {
 func TestRunE_YesWithoutID(t *testing.T) {
 	tmp := t.TempDir()
 
-	withFakeArtifact(t, func(_ string) (*workload.Artifact, error) {
+	withFakeArtifact(t, func(_ context.Context, _ string) (*workload.Artifact, error) {
 		t.Fatal("getArtifactFn must not be called when --yes lacks an ID")
 
 		return nil, nil
@@ -234,7 +235,7 @@ func TestCmd_InvalidOutputFormat(t *testing.T) {
 func TestRunE_ExistingCodeArtifact_JSONOutput(t *testing.T) {
 	tmp := t.TempDir()
 
-	withFakeArtifact(t, func(id string) (*workload.Artifact, error) {
+	withFakeArtifact(t, func(_ context.Context, id string) (*workload.Artifact, error) {
 		return fakeArtifact(id, "my-agent", "DRAFT", &workload.DatarobotCodeRef{
 			CatalogID:        "cat-xyz-789",
 			CatalogVersionID: "fedcba0987654321",
@@ -262,7 +263,7 @@ func TestRunE_ExistingCodeArtifact_JSONOutput(t *testing.T) {
 func TestRunE_EmptyArtifact_JSONOutput(t *testing.T) {
 	tmp := t.TempDir()
 
-	withFakeArtifact(t, func(id string) (*workload.Artifact, error) {
+	withFakeArtifact(t, func(_ context.Context, id string) (*workload.Artifact, error) {
 		return fakeArtifact(id, "blank-artifact", "DRAFT", nil), nil
 	})
 
@@ -286,7 +287,7 @@ func TestRunE_DrapiNon404Passes(t *testing.T) {
 
 	upstream := errors.New("connection refused")
 
-	withFakeArtifact(t, func(_ string) (*workload.Artifact, error) {
+	withFakeArtifact(t, func(_ context.Context, _ string) (*workload.Artifact, error) {
 		return nil, upstream
 	})
 
@@ -302,7 +303,7 @@ func TestRunE_NonInteractiveEnvVar(t *testing.T) {
 
 	t.Setenv("DATAROBOT_CLI_NON_INTERACTIVE", "true")
 
-	withFakeArtifact(t, func(id string) (*workload.Artifact, error) {
+	withFakeArtifact(t, func(_ context.Context, id string) (*workload.Artifact, error) {
 		return fakeArtifact(id, "my-agent", "DRAFT", nil), nil
 	})
 
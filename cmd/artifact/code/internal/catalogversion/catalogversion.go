@@ -0,0 +1,60 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+
+// Package catalogversion resolves the version argument the workload-code
+// commands take: a full catalog version ID or any unique prefix of one.
+package catalogversion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/datarobot/cli/internal/drapi/filesapi"
+)
+
+// Resolve accepts the full ID or any unique prefix.
+func Resolve(ctx context.Context, c filesapi.Client, catalogID, arg string) (string, error) {
+	if arg == "" {
+		return "", errors.New("version argument is empty")
+	}
+
+	versions, err := c.ListVersions(ctx, catalogID, 0)
+	if err != nil {
+		return "", fmt.Errorf("list versions: %w", err)
+	}
+
+	var matches []string
+
+	for _, v := range versions {
+		if v.ID == arg {
+			return v.ID, nil
+		}
+
+		if strings.HasPrefix(v.ID, arg) {
+			matches = append(matches, v.ID)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("version %q not found", arg)
+	case 1:
+		return matches[0], nil
+	default:
+		return "", fmt.Errorf("version prefix %q is ambiguous — matches %d versions; use more characters", arg, len(matches))
+	}
+}
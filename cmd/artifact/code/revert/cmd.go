@@ -0,0 +1,282 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package revert implements `dr artifact code revert`: publish the files of
+// an earlier catalog version as a new version of the artifact's code.
+package revert
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/datarobot/cli/cmd/artifact/code/internal/catalogversion"
+	"github.com/datarobot/cli/cmd/artifact/code/internal/dirprompt"
+	"github.com/datarobot/cli/cmd/artifact/code/internal/format"
+	"github.com/datarobot/cli/internal/auth"
+	"github.com/datarobot/cli/internal/config/viperx"
+	"github.com/datarobot/cli/internal/drapi/filesapi"
+	"github.com/datarobot/cli/internal/log"
+	"github.com/datarobot/cli/internal/misc/reader"
+	"github.com/datarobot/cli/internal/outputformat"
+	"github.com/datarobot/cli/internal/telemetry"
+	"github.com/datarobot/cli/internal/workload/sync"
+	"github.com/datarobot/cli/internal/workload/sync/display"
+	"github.com/datarobot/cli/internal/workload/wapi"
+	"github.com/datarobot/cli/tui"
+	"github.com/spf13/cobra"
+)
+
+// engineRunner is the subset of *sync.Engine the revert command drives.
+type engineRunner interface {
+	Plan(ctx context.Context) (*sync.SyncPlan, error)
+	Execute(ctx context.Context, plan *sync.SyncPlan) (*sync.Result, error)
+	Close() error
+	StaleRollbackRestored() bool
+	StateMigrationNotice() string
+}
+
+// Deps holds the externally-injected collaborators for the revert command.
+type Deps struct {
+	NewEngine func(dir string, opts sync.Options) (engineRunner, error)
+	Files     filesapi.Client
+	PromptDir dirprompt.PromptFunc
+	ReadLine  func() (string, error)
+}
+
+func defaultDeps() Deps {
+	return Deps{
+		NewEngine: func(dir string, opts sync.Options) (engineRunner, error) {
+			e, err := sync.New(dir, opts)
+			if err != nil {
+				return nil, err
+			}
+
+			return e, nil
+		},
+		Files:     filesapi.New(),
+		PromptDir: dirprompt.AskWithDefault,
+		ReadLine:  reader.ReadString,
+	}
+}
+
+func init() {
+	// --yes is read directly from cobra; only the env var binds to viper
+	_ = viperx.BindEnv("yes", "DATAROBOT_CLI_NON_INTERACTIVE")
+}
+
+// Cmd returns the cobra.Command for `dr artifact code revert`.
+func Cmd() *cobra.Command {
+	return cmdWithDeps(defaultDeps())
+}
+
+func cmdWithDeps(deps Deps) *cobra.Command {
+	var outputFormat outputformat.OutputFormat
+
+	c := &cobra.Command{
+		Use:          "revert <ver>",
+		Short:        "Publish the files of an earlier version as a new version.",
+		SilenceUsage: true,
+		Args:         cobra.ExactArgs(1),
+		Long: `Roll the artifact's code back to an earlier catalog version. The
+files that differ from the current version are rewritten in the project
+directory, uploaded, and removed remotely, and the result becomes a new
+version the artifact points at. Versions in between are kept, so a revert
+can itself be reverted.
+
+The version argument may be a full version ID or any unique prefix; see
+'dr artifact code versions'. The project must be in sync first: revert
+refuses to run with local changes, or when the artifact has moved on
+since the last sync.
+
+Use --dry-run to preview the plan without writing anything. Without
+--yes, the plan is shown and you are asked to confirm. With
+--output-format json the plan is printed, and the revert runs only
+with --yes.
+
+Example:
+  dr artifact code revert 1a2b3c4d
+  dr artifact code revert 1a2b3c4d --dry-run
+  dr artifact code revert 1a2b3c4d --yes
+  dr artifact code revert 1a2b3c4d --output-format json --yes`,
+		PreRunE: auth.EnsureAuthenticatedE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			outputFormat = outputformat.GetFormat(cmd)
+
+			return runRevert(cmd, args[0], outputFormat, deps)
+		},
+	}
+
+	outputformat.AddFlag(c, &outputFormat)
+
+	c.Flags().String("dir", "", "Project directory (default: current directory).")
+	c.Flags().Bool("dry-run", false, "Show plan, no writes.")
+	c.Flags().BoolP("yes", "y", false, "Skip interactive prompts; auto-confirm.")
+
+	telemetry.TrackWith(c, func(cmd *cobra.Command, _ []string) map[string]any {
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+		return map[string]any{
+			"dry_run":       dryRun,
+			"yes":           yesFlag(cmd),
+			"output_format": string(outputFormat),
+		}
+	})
+
+	return c
+}
+
+// yesFlag folds the DATAROBOT_CLI_NON_INTERACTIVE override into --yes.
+func yesFlag(cmd *cobra.Command) bool {
+	yes, _ := cmd.Flags().GetBool("yes")
+
+	return yes || viperx.GetBool("yes")
+}
+
+func runRevert(cmd *cobra.Command, verArg string, outputFormat outputformat.OutputFormat, deps Deps) error {
+	yes := yesFlag(cmd)
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	dirFlag, _ := cmd.Flags().GetString("dir")
+
+	dir, err := dirprompt.ResolveDir(dirFlag, yes, deps.PromptDir)
+	if err != nil {
+		return err
+	}
+
+	if !wapi.Exists(dir) {
+		return errors.New("not linked to an artifact. Run 'dr artifact code init <id>' first")
+	}
+
+	target, err := resolveTarget(cmd.Context(), dir, verArg, deps.Files)
+	if err != nil {
+		return err
+	}
+
+	engine, err := deps.NewEngine(dir, sync.Options{DryRun: dryRun, Yes: yes, RevertTo: target})
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if cerr := engine.Close(); cerr != nil {
+			log.Debug("revert engine close returned error", "err", cerr)
+		}
+	}()
+
+	plan, err := engine.Plan(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	format.StateNotice(cmd.ErrOrStderr(), engine.StateMigrationNotice())
+
+	if engine.StaleRollbackRestored() {
+		fmt.Fprintln(cmd.ErrOrStderr(), tui.DimStyle.Render("Recovered from interrupted sync. Working tree restored."))
+	}
+
+	if outputFormat == outputformat.OutputFormatJSON {
+		return finishJSON(cmd.Context(), engine, plan, cmd.OutOrStdout(), dryRun || !yes)
+	}
+
+	return finishHuman(cmd, engine, plan, target, dryRun, yes, deps.ReadLine)
+}
+
+// resolveTarget expands a version ID or prefix against the catalog the
+// project last synced to.
+func resolveTarget(ctx context.Context, dir, verArg string, files filesapi.Client) (string, error) {
+	cfg, err := wapi.LoadConfig(dir)
+	if err != nil {
+		return "", fmt.Errorf("read %s: %w", wapi.ConfigPath(dir), err)
+	}
+
+	if cfg.CatalogID == nil || *cfg.CatalogID == "" {
+		return "", errors.New("no code has been synced yet. Run 'dr artifact code sync' first")
+	}
+
+	return catalogversion.Resolve(ctx, files, *cfg.CatalogID, verArg)
+}
+
+func finishHuman(cmd *cobra.Command, engine engineRunner, plan *sync.SyncPlan, target string, dryRun, yes bool, readLine func() (string, error)) error {
+	out := cmd.OutOrStdout()
+
+	if plan.IsEmpty() {
+		fmt.Fprintf(out, "Version %s has the same files as the current version. Nothing to revert.\n", sync.ShortVer(target))
+
+		return nil
+	}
+
+	if err := display.PrintPlan(out, plan); err != nil {
+		return err
+	}
+
+	if dryRun {
+		return nil
+	}
+
+	if !yes && !confirm(cmd.ErrOrStderr(), readLine) {
+		fmt.Fprintln(cmd.ErrOrStderr(), "Revert aborted; nothing was changed.")
+
+		return nil
+	}
+
+	result, err := engine.Execute(cmd.Context(), plan)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(out, tui.SuccessStyle.Render(fmt.Sprintf("Reverted to %s: %s", sync.ShortVer(target), display.ResultSummary(result))))
+
+	return nil
+}
+
+// confirm asks whether to go ahead with the plan just printed. Anything but
+// an empty line or "y" declines, and so does a read error: a revert nobody
+// could confirm does not run.
+func confirm(w io.Writer, readLine func() (string, error)) bool {
+	fmt.Fprint(w, "  [Enter] Revert  [q] Abort: ")
+
+	raw, err := readLine()
+	if err != nil {
+		if !errors.Is(err, io.EOF) {
+			log.Debug("revert prompt read failed", "err", err)
+		}
+
+		return false
+	}
+
+	answer := strings.TrimSpace(strings.ToLower(raw))
+
+	return answer == "" || answer == "y"
+}
+
+// finishJSON prints the plan and, unless previewOnly, runs it and prints
+// the result as a second document, as the sync command does.
+func finishJSON(ctx context.Context, engine engineRunner, plan *sync.SyncPlan, out io.Writer, previewOnly bool) error {
+	if err := display.RenderPlanJSON(out, plan); err != nil {
+		return err
+	}
+
+	if previewOnly || plan.IsEmpty() {
+		return nil
+	}
+
+	result, err := engine.Execute(ctx, plan)
+	if err != nil {
+		return err
+	}
+
+	return display.RenderResultJSON(out, result)
+}
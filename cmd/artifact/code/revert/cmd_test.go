@@ -0,0 +1,212 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package revert
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"testing"
+
+	"github.com/datarobot/cli/internal/drapi/filesapi"
+	"github.com/datarobot/cli/internal/workload/sync"
+	"github.com/datarobot/cli/internal/workload/wapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeEngine records the options it was built with and whether Execute ran.
+type fakeEngine struct {
+	plan   *sync.SyncPlan
+	result *sync.Result
+
+	opts     sync.Options
+	executed bool
+}
+
+func (f *fakeEngine) Plan(context.Context) (*sync.SyncPlan, error) { return f.plan, nil }
+
+func (f *fakeEngine) Execute(context.Context, *sync.SyncPlan) (*sync.Result, error) {
+	f.executed = true
+
+	return f.result, nil
+}
+
+func (f *fakeEngine) Close() error { return nil }
+
+func (f *fakeEngine) StaleRollbackRestored() bool { return false }
+
+func (f *fakeEngine) StateMigrationNotice() string { return "" }
+
+// fakeClient lists versions; revert resolves its argument and nothing more.
+type fakeClient struct {
+	filesapi.Client
+
+	versions []string
+}
+
+func (f *fakeClient) ListVersions(context.Context, string, int) ([]filesapi.CatalogVersion, error) {
+	out := make([]filesapi.CatalogVersion, 0, len(f.versions))
+	for _, id := range f.versions {
+		out = append(out, filesapi.CatalogVersion{ID: id})
+	}
+
+	return out, nil
+}
+
+func revertPlan() *sync.SyncPlan {
+	plan := &sync.SyncPlan{OldVersionShort: "bbbbbbbb"}
+	plan.Append(sync.FileAction{Path: "agent.py", Classification: sync.ClsLocalModified, Action: sync.ActUploadModify, LocalSize: 3})
+	plan.Append(sync.FileAction{Path: "new.py", Classification: sync.ClsLocalDeleted, Action: sync.ActUploadDelete})
+
+	return plan
+}
+
+// linkedProject returns a directory linked to catalog cat-1.
+func linkedProject(t *testing.T) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	require.NoError(t, wapi.Initialize(dir, wapi.InitOptions{ArtifactID: "art-1"}))
+
+	cfg, err := wapi.LoadConfig(dir)
+	require.NoError(t, err)
+
+	cid := "cat-1"
+	cfg.CatalogID = &cid
+	require.NoError(t, wapi.SaveConfig(dir, cfg))
+
+	return dir
+}
+
+func run(t *testing.T, fe *fakeEngine, input string, args ...string) (string, string, error) {
+	t.Helper()
+
+	deps := Deps{
+		NewEngine: func(_ string, opts sync.Options) (engineRunner, error) {
+			fe.opts = opts
+
+			return fe, nil
+		},
+		Files: &fakeClient{versions: []string{"aaaaaaaa1111", "bbbbbbbb2222"}},
+		ReadLine: func() (string, error) {
+			if input == "" {
+				return "", io.EOF
+			}
+
+			return input, nil
+		},
+	}
+
+	var stdout, stderr bytes.Buffer
+
+	c := cmdWithDeps(deps)
+	c.PreRunE = nil
+	c.SetArgs(args)
+	c.SetOut(&stdout)
+	c.SetErr(&stderr)
+
+	err := c.Execute()
+
+	return stdout.String(), stderr.String(), err
+}
+
+func TestRevert_ResolvesPrefixAndExecutesWithYes(t *testing.T) {
+	dir := linkedProject(t)
+	fe := &fakeEngine{plan: revertPlan(), result: &sync.Result{OldVersion: "bbbbbbbb2222", NewVersion: "cccccccc3333", UploadedCount: 1, DeletedCount: 1}}
+
+	out, _, err := run(t, fe, "", "aaaa", "--dir", dir, "--yes")
+	require.NoError(t, err)
+
+	assert.Equal(t, "aaaaaaaa1111", fe.opts.RevertTo)
+	assert.True(t, fe.executed)
+	assert.Contains(t, out, "agent.py")
+	assert.Contains(t, out, "Reverted to aaaaaaaa")
+	assert.Contains(t, out, "cccccccc")
+}
+
+func TestRevert_DryRunDoesNotExecute(t *testing.T) {
+	dir := linkedProject(t)
+	fe := &fakeEngine{plan: revertPlan()}
+
+	out, _, err := run(t, fe, "", "aaaaaaaa1111", "--dir", dir, "--dry-run", "--yes")
+	require.NoError(t, err)
+
+	assert.True(t, fe.opts.DryRun)
+	assert.False(t, fe.executed)
+	assert.Contains(t, out, "Sync plan:")
+	assert.Contains(t, out, "new.py")
+}
+
+func TestRevert_PromptDeclined(t *testing.T) {
+	dir := linkedProject(t)
+	fe := &fakeEngine{plan: revertPlan()}
+
+	_, stderr, err := run(t, fe, "q\n", "aaaaaaaa1111", "--dir", dir)
+	require.NoError(t, err)
+
+	assert.False(t, fe.executed)
+	assert.Contains(t, stderr, "Revert aborted")
+}
+
+func TestRevert_PromptAccepted(t *testing.T) {
+	dir := linkedProject(t)
+	fe := &fakeEngine{plan: revertPlan(), result: &sync.Result{NewVersion: "cccccccc3333"}}
+
+	_, _, err := run(t, fe, "\n", "aaaaaaaa1111", "--dir", dir)
+	require.NoError(t, err)
+
+	assert.True(t, fe.executed)
+}
+
+func TestRevert_EmptyPlan(t *testing.T) {
+	dir := linkedProject(t)
+	fe := &fakeEngine{plan: &sync.SyncPlan{}}
+
+	out, _, err := run(t, fe, "", "aaaaaaaa1111", "--dir", dir, "--yes")
+	require.NoError(t, err)
+
+	assert.False(t, fe.executed)
+	assert.Contains(t, out, "Nothing to revert")
+}
+
+func TestRevert_JSONNeedsYesToExecute(t *testing.T) {
+	dir := linkedProject(t)
+	fe := &fakeEngine{plan: revertPlan()}
+
+	out, _, err := run(t, fe, "", "aaaaaaaa1111", "--dir", dir, "--output-format", "json")
+	require.NoError(t, err)
+
+	assert.False(t, fe.executed)
+
+	var plan map[string]any
+	require.NoError(t, json.Unmarshal([]byte(out), &plan))
+	assert.Contains(t, plan, "uploads")
+}
+
+func TestRevert_UnknownVersion(t *testing.T) {
+	dir := linkedProject(t)
+
+	_, _, err := run(t, &fakeEngine{}, "", "ffff", "--dir", dir, "--yes")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not found")
+}
+
+func TestRevert_NotLinked(t *testing.T) {
+	_, _, err := run(t, &fakeEngine{}, "", "aaaa", "--dir", t.TempDir(), "--yes")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not linked")
+}
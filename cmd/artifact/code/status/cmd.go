@@ -0,0 +1,237 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package status implements `dr artifact code status`: what changed in the
+// working tree since the last sync, read from local state alone.
+package status
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/datarobot/cli/cmd/artifact/code/internal/format"
+	"github.com/datarobot/cli/internal/outputformat"
+	"github.com/datarobot/cli/internal/telemetry"
+	"github.com/datarobot/cli/internal/workload/sync"
+	"github.com/datarobot/cli/internal/workload/wapi"
+	"github.com/spf13/cobra"
+)
+
+const (
+	timestampFormat = "2006-01-02 15:04 UTC"
+)
+
+// result is the command's answer, and with its JSON tags the stable shape
+// --output-format json emits. The lists are empty rather than null.
+type result struct {
+	ArtifactID      string     `json:"artifactId"`
+	CatalogID       string     `json:"catalogId"`
+	SyncedVersionID string     `json:"syncedVersionId"`
+	SyncedAt        *time.Time `json:"syncedAt"`
+	Modified        []string   `json:"modified"`
+	Added           []string   `json:"added"`
+	Deleted         []string   `json:"deleted"`
+	Unresolved      []string   `json:"unresolved"`
+}
+
+// clean reports whether the working tree matches the last sync.
+func (r result) clean() bool {
+	return len(r.Modified)+len(r.Added)+len(r.Deleted)+len(r.Unresolved) == 0
+}
+
+func Cmd() *cobra.Command {
+	var outputFormat outputformat.OutputFormat
+
+	c := &cobra.Command{
+		Use:          "status",
+		Short:        "Show files changed since the last sync.",
+		SilenceUsage: true,
+		Args:         cobra.NoArgs,
+		Long: `Show the files in the project directory that were modified, added or
+deleted since the last sync, the way 'git status' does.
+
+The comparison is against the BASE manifest in '.datarobot/workload/', the
+snapshot recorded by the last successful sync, and honors .wapiignore
+exactly as sync does. Nothing is sent over the network, so changes pushed
+to the artifact from elsewhere do not show here; 'dr artifact code sync
+--dry-run' reports both sides. Files the last sync left with conflict
+markers are listed separately, because sync refuses to run until they are
+resolved.
+
+Run 'dr artifact code init <artifact-id>' first to link a project
+directory to an artifact.
+
+Example:
+  dr artifact code status
+  dr artifact code status --dir ./service
+  dr artifact code status --output-format json`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			outputFormat = outputformat.GetFormat(cmd)
+
+			return runStatus(cmd, outputFormat)
+		},
+	}
+
+	outputformat.AddFlag(c, &outputFormat)
+
+	c.Flags().String("dir", "", "Project directory (default: current directory).")
+
+	telemetry.TrackWith(c, func(_ *cobra.Command, _ []string) map[string]any {
+		return map[string]any{
+			"output_format": string(outputFormat),
+		}
+	})
+
+	return c
+}
+
+func runStatus(cmd *cobra.Command, outputFormat outputformat.OutputFormat) error {
+	dirFlag, _ := cmd.Flags().GetString("dir")
+	if dirFlag == "" {
+		dirFlag = "."
+	}
+
+	dir, err := filepath.Abs(dirFlag)
+	if err != nil {
+		return fmt.Errorf("resolve dir %s: %w", dirFlag, err)
+	}
+
+	format.StateNotice(cmd.ErrOrStderr(), wapi.EnsureMigrated(dir))
+
+	r, err := compute(dir)
+	if err != nil {
+		return err
+	}
+
+	if outputFormat == outputformat.OutputFormatJSON {
+		enc := json.NewEncoder(cmd.OutOrStdout())
+		enc.SetIndent("", "  ")
+
+		return enc.Encode(r)
+	}
+
+	renderText(cmd.OutOrStdout(), r)
+
+	return nil
+}
+
+// compute reads the linked state and compares the working tree against BASE.
+func compute(dir string) (result, error) {
+	if !wapi.Exists(dir) {
+		return result{}, errors.New("not linked to an artifact. Run 'dr artifact code init <id>' first")
+	}
+
+	cfg, err := wapi.LoadConfig(dir)
+	if err != nil {
+		return result{}, fmt.Errorf("read %s: %w", wapi.ConfigPath(dir), err)
+	}
+
+	base, err := wapi.LoadManifest(dir)
+	if err != nil {
+		return result{}, fmt.Errorf("read manifest.json in %s: %w", wapi.Dir(dir), err)
+	}
+
+	local, err := sync.ScanLocal(dir)
+	if err != nil {
+		return result{}, err
+	}
+
+	r := result{
+		ArtifactID:      cfg.ArtifactID,
+		CatalogID:       deref(cfg.CatalogID),
+		SyncedVersionID: deref(cfg.LastSyncedVersionID),
+		SyncedAt:        base.SyncedAt,
+		Modified:        []string{},
+		Added:           []string{},
+		Deleted:         []string{},
+		Unresolved:      append([]string{}, base.Unresolved...),
+	}
+
+	for path, entry := range local {
+		was, ok := base.Files[path]
+
+		switch {
+		case !ok:
+			r.Added = append(r.Added, path)
+		case was.Hash != entry.Hash:
+			r.Modified = append(r.Modified, path)
+		}
+	}
+
+	for path := range base.Files {
+		if _, ok := local[path]; !ok {
+			r.Deleted = append(r.Deleted, path)
+		}
+	}
+
+	sort.Strings(r.Modified)
+	sort.Strings(r.Added)
+	sort.Strings(r.Deleted)
+	sort.Strings(r.Unresolved)
+
+	return r, nil
+}
+
+func renderText(out io.Writer, r result) {
+	switch {
+	case r.SyncedVersionID == "":
+		fmt.Fprintf(out, "Artifact %s, not synced yet.\n", r.ArtifactID)
+	case r.SyncedAt != nil:
+		fmt.Fprintf(out, "Artifact %s, last synced to version %s at %s.\n",
+			r.ArtifactID, sync.ShortVer(r.SyncedVersionID), r.SyncedAt.UTC().Format(timestampFormat))
+	default:
+		fmt.Fprintf(out, "Artifact %s, last synced to version %s.\n", r.ArtifactID, sync.ShortVer(r.SyncedVersionID))
+	}
+
+	if r.clean() {
+		fmt.Fprintln(out, "\nNothing to sync: the working tree matches the last sync.")
+
+		return
+	}
+
+	if n := len(r.Modified) + len(r.Added) + len(r.Deleted); n > 0 {
+		fmt.Fprintln(out, "\nChanges since the last sync:")
+
+		printPaths(out, "modified:", r.Modified)
+		printPaths(out, "added:", r.Added)
+		printPaths(out, "deleted:", r.Deleted)
+	}
+
+	if len(r.Unresolved) > 0 {
+		fmt.Fprintln(out, "\nUnresolved conflicts (remove the markers, then sync):")
+
+		printPaths(out, "conflict:", r.Unresolved)
+	}
+
+	fmt.Fprintln(out, "\nRun 'dr artifact code diff' to see the changes, 'dr artifact code sync' to push them.")
+}
+
+func printPaths(out io.Writer, label string, paths []string) {
+	for _, p := range paths {
+		fmt.Fprintf(out, "  %-10s %s\n", label, p)
+	}
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
+}
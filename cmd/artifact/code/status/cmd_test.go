@@ -0,0 +1,160 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package status
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/datarobot/cli/internal/workload/fileops"
+	"github.com/datarobot/cli/internal/workload/wapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// syncedProject links a directory and records BASE as if a sync had just
+// uploaded files, then returns the directory.
+func syncedProject(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+
+	require.NoError(t, wapi.Initialize(dir, wapi.InitOptions{
+		ArtifactID: "art-abc-123", CatalogID: "cat-abc-123", LastSyncedVersionID: "ver-0123456789",
+	}))
+
+	syncedAt := time.Date(2026, 10, 2, 12, 0, 0, 0, time.UTC)
+	version := "ver-0123456789"
+	base := wapi.Manifest{
+		Version: wapi.ManifestVersion, SyncedAt: &syncedAt, SyncedVersionID: &version,
+		Files: map[string]wapi.FileMeta{},
+	}
+
+	for rel, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(rel))
+
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+
+		hash, size, err := fileops.HashFile(path)
+		require.NoError(t, err)
+
+		base.Files[rel] = wapi.FileMeta{Hash: hash, Size: size}
+	}
+
+	// Initialize dropped a .wapiignore, and a sync uploads it like any file.
+	hash, size, err := fileops.HashFile(filepath.Join(dir, ".wapiignore"))
+	require.NoError(t, err)
+
+	base.Files[".wapiignore"] = wapi.FileMeta{Hash: hash, Size: size}
+
+	require.NoError(t, wapi.SaveManifest(dir, base))
+
+	return dir
+}
+
+func runCmd(t *testing.T, args ...string) (string, error) {
+	t.Helper()
+
+	cmd := Cmd()
+
+	var out bytes.Buffer
+
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs(args)
+
+	err := cmd.Execute()
+
+	return out.String(), err
+}
+
+func TestStatus_NotLinked(t *testing.T) {
+	_, err := runCmd(t, "--dir", t.TempDir())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "dr artifact code init")
+}
+
+func TestStatus_Clean(t *testing.T) {
+	dir := syncedProject(t, map[string]string{"app.py": "print(1)\n"})
+
+	out, err := runCmd(t, "--dir", dir)
+	require.NoError(t, err)
+
+	assert.Contains(t, out, "last synced to version ver-0123 at 2026-10-02 12:00 UTC")
+	assert.Contains(t, out, "Nothing to sync")
+}
+
+func TestStatus_ListsEachKindOfChange(t *testing.T) {
+	dir := syncedProject(t, map[string]string{
+		"app.py":     "print(1)\n",
+		"lib/old.py": "x = 1\n",
+		"same.txt":   "same\n",
+	})
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "app.py"), []byte("print(2)\n"), 0o644))
+	require.NoError(t, os.Remove(filepath.Join(dir, "lib", "old.py")))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "new.py"), []byte("y = 2\n"), 0o644))
+
+	out, err := runCmd(t, "--dir", dir)
+	require.NoError(t, err)
+
+	assert.Contains(t, out, "modified:  app.py")
+	assert.Contains(t, out, "added:     new.py")
+	assert.Contains(t, out, "deleted:   lib/old.py")
+	assert.NotContains(t, out, "same.txt")
+}
+
+// .wapiignore decides what status sees exactly as it decides what sync
+// uploads, so an ignored file is never reported as added. The edited
+// .wapiignore itself is a change like any other.
+func TestStatus_HonorsWapiignore(t *testing.T) {
+	dir := syncedProject(t, map[string]string{"app.py": "print(1)\n"})
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".wapiignore"), []byte("*.log\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "debug.log"), []byte("noise\n"), 0o644))
+
+	out, err := runCmd(t, "--dir", dir, "--output-format", "json")
+	require.NoError(t, err)
+
+	var r result
+
+	require.NoError(t, json.Unmarshal([]byte(out), &r))
+
+	assert.Empty(t, r.Added)
+	assert.Equal(t, []string{".wapiignore"}, r.Modified)
+	assert.Equal(t, "art-abc-123", r.ArtifactID)
+	assert.Equal(t, "ver-0123456789", r.SyncedVersionID)
+}
+
+func TestStatus_ReportsUnresolvedConflicts(t *testing.T) {
+	dir := syncedProject(t, map[string]string{"app.py": "print(1)\n"})
+
+	base, err := wapi.LoadManifest(dir)
+	require.NoError(t, err)
+
+	base.Unresolved = []string{"app.py"}
+	require.NoError(t, wapi.SaveManifest(dir, base))
+
+	out, err := runCmd(t, "--dir", dir)
+	require.NoError(t, err)
+
+	assert.Contains(t, out, "Unresolved conflicts")
+	assert.Contains(t, out, "conflict:  app.py")
+}
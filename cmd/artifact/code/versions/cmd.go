@@ -15,6 +15,7 @@
 package versions
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -36,7 +37,7 @@ import (
 // command. Tests build a Deps with fakes and pass it to cmdWithDeps;
 // production callers go through Cmd() which uses defaultDeps().
 type Deps struct {
-	GetArtifact func(string) (*workload.Artifact, error)
+	GetArtifact func(context.Context, string) (*workload.Artifact, error)
 	Files       filesapi.Client
 }
 
@@ -121,7 +122,7 @@ func runVersions(cmd *cobra.Command, outputFormat outputformat.OutputFormat, dep
 		return err
 	}
 
-	v, err := buildView(cfg, limit, deps)
+	v, err := buildView(cmd.Context(), cfg, limit, deps)
 	if err != nil {
 		return err
 	}
@@ -160,8 +161,8 @@ func loadProjectConfig(absDir string) (wapi.Config, error) {
 	return cfg, nil
 }
 
-func buildView(cfg wapi.Config, limit int, deps Deps) (view, error) {
-	art, err := deps.GetArtifact(cfg.ArtifactID)
+func buildView(ctx context.Context, cfg wapi.Config, limit int, deps Deps) (view, error) {
+	art, err := deps.GetArtifact(ctx, cfg.ArtifactID)
 	if err != nil {
 		var httpErr *drapi.HTTPError
 		if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusNotFound {
@@ -171,7 +172,7 @@ func buildView(cfg wapi.Config, limit int, deps Deps) (view, error) {
 		return view{}, fmt.Errorf("fetch artifact %s: %w", cfg.ArtifactID, err)
 	}
 
-	versions, err := deps.Files.ListVersions(*cfg.CatalogID, limit)
+	versions, err := deps.Files.ListVersions(ctx, *cfg.CatalogID, limit)
 	if err != nil {
 		var httpErr *drapi.HTTPError
 		if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusNotFound {
@@ -16,6 +16,7 @@ package versions
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"io"
@@ -41,7 +42,7 @@ type fakeClient struct {
 	listCalls  int
 }
 
-func (f *fakeClient) ListVersions(catalogID string, limit int) ([]filesapi.CatalogVersion, error) {
+func (f *fakeClient) ListVersions(_ context.Context, catalogID string, limit int) ([]filesapi.CatalogVersion, error) {
 	f.listCalls++
 	f.gotCatalog = catalogID
 	f.gotLimit = limit
@@ -50,37 +51,39 @@ func (f *fakeClient) ListVersions(catalogID string, limit int) ([]filesapi.Catal
 }
 
 // Unused interface methods.
-func (*fakeClient) CreateCatalog() (*filesapi.CatalogResp, error) { panic("unused") }
+func (*fakeClient) CreateCatalog(context.Context) (*filesapi.CatalogResp, error) { panic("unused") }
 
-func (*fakeClient) CreateStage(string) (*filesapi.StageResp, error) {
+func (*fakeClient) CreateStage(context.Context, string) (*filesapi.StageResp, error) {
 	panic("unused")
 }
 
-func (*fakeClient) UploadToStage(string, string, string, int64, io.Reader) error {
+func (*fakeClient) UploadToStage(context.Context, string, string, string, int64, io.Reader) error {
 	panic("unused")
 }
 
-func (*fakeClient) ApplyStage(string, string, string) (*filesapi.ApplyStageResp, error) {
+func (*fakeClient) ApplyStage(context.Context, string, string, string) (*filesapi.ApplyStageResp, error) {
 	panic("unused")
 }
 
-func (*fakeClient) UploadFromZipNew(string, int64, io.Reader) (*filesapi.FromFileResp, error) {
+func (*fakeClient) UploadFromZipNew(context.Context, string, int64, io.Reader) (*filesapi.FromFileResp, error) {
 	panic("unused")
 }
 
-func (*fakeClient) UploadFromZipExisting(string, string, string, int64, io.Reader) (*filesapi.FromFileResp, error) {
+func (*fakeClient) UploadFromZipExisting(context.Context, string, string, string, int64, io.Reader) (*filesapi.FromFileResp, error) {
 	panic("unused")
 }
-func (*fakeClient) PollStatus(string) (*filesapi.StatusResp, error) { panic("unused") }
-func (*fakeClient) AllFiles(string, string) (map[string]filesapi.FileMeta, error) {
+
+func (*fakeClient) PollStatus(context.Context, string) (*filesapi.StatusResp, error) { panic("unused") }
+
+func (*fakeClient) AllFiles(context.Context, string, string) (map[string]filesapi.FileMeta, error) {
 	panic("unused")
 }
 
-func (*fakeClient) DownloadFile(string, string, string, io.Writer) (string, int64, error) {
+func (*fakeClient) DownloadFile(context.Context, string, string, string, io.Writer) (string, int64, error) {
 	panic("unused")
 }
 
-func (*fakeClient) DeleteFiles(string, []string) (*filesapi.DeleteFilesResp, error) {
+func (*fakeClient) DeleteFiles(context.Context, string, []string) (*filesapi.DeleteFilesResp, error) {
 	panic("unused")
 }
 
@@ -89,7 +92,7 @@ func (*fakeClient) DeleteFiles(string, []string) (*filesapi.DeleteFilesResp, err
 // Deps inline instead.
 func fakeDeps(art *workload.Artifact, fc *fakeClient) Deps {
 	return Deps{
-		GetArtifact: func(_ string) (*workload.Artifact, error) { return art, nil },
+		GetArtifact: func(_ context.Context, _ string) (*workload.Artifact, error) { return art, nil },
 		Files:       fc,
 	}
 }
@@ -281,7 +284,7 @@ func TestVersions_ArtifactNotFoundSpecialized(t *testing.T) {
 	dir := initLinkedDir(t, "cat-1", "")
 
 	deps := Deps{
-		GetArtifact: func(_ string) (*workload.Artifact, error) {
+		GetArtifact: func(_ context.Context, _ string) (*workload.Artifact, error) {
 			return nil, &drapi.HTTPError{StatusCode: http.StatusNotFound, URL: "test"}
 		},
 		Files: &fakeClient{},
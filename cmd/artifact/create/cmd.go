@@ -102,7 +102,7 @@ Example:
 				return err
 			}
 
-			artifact, err := workload.CreateArtifact(payload)
+			artifact, err := workload.CreateArtifact(cmd.Context(), payload)
 			if err != nil {
 				return err
 			}
@@ -59,7 +59,7 @@ Example:
 				return err
 			}
 
-			if err := workload.DeleteArtifact(args[0]); err != nil {
+			if err := workload.DeleteArtifact(cmd.Context(), args[0]); err != nil {
 				return handleDeleteError(err, args[0])
 			}
 
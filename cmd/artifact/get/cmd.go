@@ -45,7 +45,7 @@ Example:
 		RunE: func(cmd *cobra.Command, args []string) error {
 			outputFormat = outputformat.GetFormat(cmd)
 
-			artifact, err := workload.GetArtifact(args[0])
+			artifact, err := workload.GetArtifact(cmd.Context(), args[0])
 			if err != nil {
 				return err
 			}
@@ -57,7 +57,7 @@ Example:
 				return fmt.Errorf("invalid --limit %d: must be positive", limit)
 			}
 
-			artifacts, err := workload.ListArtifacts(limit, status)
+			artifacts, err := workload.ListArtifacts(cmd.Context(), limit, status)
 			if err != nil {
 				return err
 			}
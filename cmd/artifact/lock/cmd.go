@@ -50,7 +50,7 @@ Example:
 		RunE: func(cmd *cobra.Command, args []string) error {
 			outputFormat = outputformat.GetFormat(cmd)
 
-			artifact, err := workload.LockArtifact(args[0])
+			artifact, err := workload.LockArtifact(cmd.Context(), args[0])
 			if err != nil {
 				return err
 			}
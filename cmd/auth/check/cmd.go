@@ -53,12 +53,33 @@ func checkCLICredentials(w io.Writer) bool {
 		return false
 	}
 
-	// Fall back to config file credentials
+	// Fall back to config file credentials, which belong to the active profile
+	profile := config.ActiveProfile()
+
+	fmt.Fprint(w, tui.BaseTextStyle.Render("Profile: "))
+	fmt.Fprintln(w, tui.InfoStyle.Render(profile))
+
+	exists, err := config.ProfileExists(profile)
+	if err != nil {
+		fmt.Fprintln(w, tui.BaseTextStyle.Render("❌ Failed to read profiles: "+err.Error()))
+
+		return false
+	}
+
+	if !exists {
+		fmt.Fprintln(w, tui.BaseTextStyle.Render("❌ Profile "+profile+" does not exist."))
+		fmt.Fprint(w, tui.BaseTextStyle.Render("Run "))
+		fmt.Fprint(w, tui.InfoStyle.Render("dr auth profile list"))
+		fmt.Fprintln(w, tui.BaseTextStyle.Render(" to see the existing profiles."))
+
+		return false
+	}
+
 	datarobotHost := config.GetBaseURL()
 	if datarobotHost == "" {
 		fmt.Fprintln(w, tui.BaseTextStyle.Render("❌ No DataRobot URL configured."))
 		fmt.Fprint(w, tui.BaseTextStyle.Render("Run "))
-		fmt.Fprint(w, tui.InfoStyle.Render("dr auth set-url"))
+		fmt.Fprint(w, tui.InfoStyle.Render("dr auth set-url"+profileFlag(profile)))
 		fmt.Fprintln(w, tui.BaseTextStyle.Render(" to configure your DataRobot URL."))
 
 		allValid = false
@@ -73,7 +94,7 @@ func checkCLICredentials(w io.Writer) bool {
 		} else {
 			fmt.Fprintln(w, tui.BaseTextStyle.Render("❌ No valid API key found in CLI config."))
 			fmt.Fprint(w, tui.BaseTextStyle.Render("Run "))
-			fmt.Fprint(w, tui.InfoStyle.Render("dr auth login"))
+			fmt.Fprint(w, tui.InfoStyle.Render("dr auth login"+profileFlag(profile)))
 			fmt.Fprintln(w, tui.BaseTextStyle.Render(" to authenticate."))
 		}
 
@@ -85,6 +106,16 @@ func checkCLICredentials(w io.Writer) bool {
 	return allValid
 }
 
+// profileFlag returns the --profile argument that targets profile, or "" for
+// the default profile, so suggested commands act on the profile being checked.
+func profileFlag(profile string) string {
+	if profile == config.DefaultProfile {
+		return ""
+	}
+
+	return " --profile " + profile
+}
+
 func printDotenvMissingError() {
 	fmt.Println(tui.BaseTextStyle.Render("⚠️ No '.env' file found in repository."))
 	fmt.Print(tui.BaseTextStyle.Render("Run "))
@@ -242,7 +273,9 @@ func Cmd() *cobra.Command {
 		SilenceUsage:  true,
 		Long: `Verify that your DataRobot credentials are properly configured and valid.
 
-If you're in a project directory with a '.env' file, this will check those credentials.`,
+If you're in a project directory with a '.env' file, this will check those credentials.
+Otherwise it checks the stored credentials of the active profile and reports which
+profile that is (see 'dr auth profile').`,
 		RunE: RunE,
 	}
 }
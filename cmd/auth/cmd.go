@@ -19,6 +19,7 @@ import (
 	"github.com/datarobot/cli/cmd/auth/export"
 	"github.com/datarobot/cli/cmd/auth/login"
 	"github.com/datarobot/cli/cmd/auth/logout"
+	"github.com/datarobot/cli/cmd/auth/profile"
 	"github.com/datarobot/cli/cmd/auth/seturl"
 	"github.com/datarobot/cli/internal/version"
 	"github.com/spf13/cobra"
@@ -36,6 +37,7 @@ Manage your DataRobot credentials and connection settings:
   • Log in using OAuth authentication
   • Log out and clear stored credentials
   • Export your credentials as environment variables
+  • Keep named profiles for several DataRobot instances
 
 🚀 Quick start: dr auth set-url && dr auth login`,
 	}
@@ -45,6 +47,7 @@ Manage your DataRobot credentials and connection settings:
 		export.Cmd(),
 		login.Cmd(),
 		logout.Cmd(),
+		profile.Cmd(),
 		seturl.Cmd(),
 	)
 
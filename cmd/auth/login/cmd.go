@@ -122,7 +122,9 @@ If the browser cannot be opened, the CLI prints a link to open yourself. Pass
 --no-browser to skip the browser launch entirely, which is useful over SSH.`,
 		SilenceErrors: true,
 		SilenceUsage:  true,
-		RunE:          RunE,
+		// --profile may name a new profile: logging in creates it.
+		Annotations: map[string]string{config.CreatesProfileAnnotationKey: "true"},
+		RunE:        RunE,
 	}
 
 	// Read directly from cobra rather than binding to viper: this is a transient
@@ -0,0 +1,51 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package profile
+
+import (
+	"github.com/datarobot/cli/cmd/auth/profile/del"
+	"github.com/datarobot/cli/cmd/auth/profile/list"
+	"github.com/datarobot/cli/cmd/auth/profile/use"
+	"github.com/spf13/cobra"
+)
+
+func Cmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "profile",
+		Aliases: []string{"profiles"},
+		Short:   "🗂️  Manage named connection profiles",
+		Long: `Manage named connection profiles, each with its own DataRobot URL and API key.
+
+Profiles let you keep credentials for several DataRobot instances (for example
+staging, production, and an on-prem cluster) and switch between them without
+logging in again:
+
+  dr auth login --profile prod     # create or refresh the "prod" profile
+  dr auth profile use prod         # make it the current profile
+  dr --profile staging auth check  # use another profile for one command
+
+The profile for a command is chosen by --profile, then the DATAROBOT_PROFILE
+environment variable, then the current profile. The "default" profile is the
+URL and API key stored before profiles existed.`,
+	}
+
+	cmd.AddCommand(
+		list.Cmd(),
+		use.Cmd(),
+		del.Cmd(),
+	)
+
+	return cmd
+}
@@ -0,0 +1,53 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package del implements the `dr auth profile delete` verb. The directory
+// is named `del` rather than `delete` because the latter shadows Go's
+// built-in delete() function in importing files.
+
+package del
+
+import (
+	"fmt"
+
+	"github.com/datarobot/cli/internal/config"
+	"github.com/datarobot/cli/tui"
+	"github.com/spf13/cobra"
+)
+
+func Cmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete <name>",
+		Short: "🗑️  Delete a connection profile",
+		Long: `Delete a stored profile along with its URL and API key.
+
+Deleting the current profile makes the default profile current again. The
+default profile itself cannot be deleted; use 'dr auth logout' to clear its
+API key.
+
+Example:
+  dr auth profile delete staging`,
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		RunE: func(_ *cobra.Command, args []string) error {
+			if err := config.DeleteProfile(args[0]); err != nil {
+				return err
+			}
+
+			fmt.Println(tui.BaseTextStyle.Render("Deleted profile: " + args[0]))
+
+			return nil
+		},
+	}
+}
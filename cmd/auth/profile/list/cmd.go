@@ -0,0 +1,111 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package list
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+	"github.com/datarobot/cli/internal/config"
+	"github.com/datarobot/cli/internal/outputformat"
+	"github.com/datarobot/cli/tui"
+	"github.com/spf13/cobra"
+)
+
+func Cmd() *cobra.Command {
+	var outputFormat outputformat.OutputFormat
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "📋 List connection profiles",
+		Long: `List the stored connection profiles. The active profile is marked with '*'.
+
+Tokens are never printed; the TOKEN column only shows whether one is stored.`,
+		Args: cobra.NoArgs,
+		RunE: runList,
+	}
+
+	outputformat.AddFlag(cmd, &outputFormat)
+
+	return cmd
+}
+
+func runList(cmd *cobra.Command, _ []string) error {
+	profiles, err := config.ListProfiles()
+	if err != nil {
+		return err
+	}
+
+	if outputformat.GetFormat(cmd) == outputformat.OutputFormatJSON {
+		return outputformat.PrintJSONEnvelope(os.Stdout, "profiles", profiles)
+	}
+
+	if len(profiles) == 0 {
+		fmt.Println("No profiles configured.")
+		fmt.Println()
+		fmt.Print("Run ")
+		fmt.Print(tui.InfoStyle.Render("dr auth login --profile <name>"))
+		fmt.Println(" to create one.")
+
+		return nil
+	}
+
+	printProfilesTable(profiles)
+
+	return nil
+}
+
+func printProfilesTable(profiles []config.Profile) {
+	nameStyle := tui.BaseTextStyle.
+		Foreground(tui.GetAdaptiveColor(tui.DrPurple, tui.DrPurpleDark)).
+		Padding(0, 1)
+
+	cellStyle := tui.BaseTextStyle.Padding(0, 1)
+
+	t := table.New().
+		Border(lipgloss.RoundedBorder()).
+		BorderStyle(tui.TableBorderStyle).
+		StyleFunc(func(_, col int) lipgloss.Style {
+			if col == 1 {
+				return nameStyle
+			}
+
+			return cellStyle
+		}).
+		Headers("", "NAME", "ENDPOINT", "TOKEN")
+
+	for _, p := range profiles {
+		marker := ""
+		if p.Active {
+			marker = "*"
+		}
+
+		endpoint := p.Endpoint
+		if endpoint == "" {
+			endpoint = "-"
+		}
+
+		token := "none"
+		if p.HasToken {
+			token = "stored"
+		}
+
+		t.Row(marker, p.Name, endpoint, token)
+	}
+
+	_, _ = fmt.Fprintln(os.Stdout, t.Render())
+}
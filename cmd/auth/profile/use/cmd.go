@@ -0,0 +1,49 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package use
+
+import (
+	"fmt"
+
+	"github.com/datarobot/cli/internal/config"
+	"github.com/datarobot/cli/tui"
+	"github.com/spf13/cobra"
+)
+
+func Cmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "use <name>",
+		Short: "🔀 Switch the current connection profile",
+		Long: `Make a stored profile the current one, so later commands use its URL and
+API key. Use "default" to switch back to the unnamed profile.
+
+--profile and DATAROBOT_PROFILE still override the current profile for a
+single command.
+
+Example:
+  dr auth profile use prod`,
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		RunE: func(_ *cobra.Command, args []string) error {
+			if err := config.UseProfile(args[0]); err != nil {
+				return err
+			}
+
+			fmt.Println(tui.BaseTextStyle.Render("Switched to profile: " + args[0]))
+
+			return nil
+		},
+	}
+}
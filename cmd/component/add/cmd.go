@@ -112,7 +112,7 @@ func loadComponentDefaults(dataFilePath string) *config.ComponentDefaults {
 
 func addComponents(repoURLs []string, componentConfig *config.ComponentDefaults, cliData map[string]interface{}) error {
 	for _, repoURL := range repoURLs {
-		if component, ok := copier.ComponentDetailsByShortName[repoURL]; ok {
+		if component, ok := copier.Components().ByShortName(repoURL); ok {
 			repoURL = component.RepoURL
 		}
 
@@ -138,15 +138,32 @@ func addComponents(repoURLs []string, componentConfig *config.ComponentDefaults,
 	return nil
 }
 
-func Cmd() *cobra.Command {
-	names := strings.Join(copier.EnabledShortNames, ", ")
+// completeComponentNames offers the catalog's short names. Completion runs on
+// every TAB, so it only reads the cached index, never fetching it.
+func completeComponentNames(_ *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	var names []string
+
+	for _, name := range copier.CachedComponents().EnabledShortNames() {
+		if strings.HasPrefix(name, toComplete) {
+			names = append(names, name)
+		}
+	}
 
+	return names, cobra.ShellCompDirectiveNoFileComp
+}
+
+func Cmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:           fmt.Sprintf("add [%s or component_url]", names),
-		Short:         "➕ Add a component",
-		PreRunE:       PreRunE,
-		RunE:          RunE,
-		SilenceErrors: true,
+		Use:   "add [component_name or component_url]",
+		Short: "➕ Add a component",
+		Long: `Add a component by its name in the component catalog, or by the URL of its repository.
+
+The catalog is read from the published component index, or from the index the
+component-index setting names. See 'dr component list --available' for the names.`,
+		PreRunE:           PreRunE,
+		RunE:              RunE,
+		ValidArgsFunction: completeComponentNames,
+		SilenceErrors:     true,
 	}
 
 	cmd.Flags().StringArrayVarP(&addFlags.DataArgs, "data", "d", []string{}, "Provide answer data in key=value format (can be specified multiple times)")
@@ -17,6 +17,7 @@ package component
 import (
 	"github.com/datarobot/cli/cmd/component/add"
 	"github.com/datarobot/cli/cmd/component/list"
+	"github.com/datarobot/cli/cmd/component/remove"
 	"github.com/datarobot/cli/cmd/component/update"
 	"github.com/spf13/cobra"
 )
@@ -32,6 +33,7 @@ func Cmd() *cobra.Command {
 	cmd.AddCommand(
 		add.Cmd(),
 		list.Cmd(),
+		remove.Cmd(),
 		update.Cmd(),
 	)
 
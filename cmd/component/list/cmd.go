@@ -33,7 +33,19 @@ type ComponentOutput struct {
 	Repo string `json:"repo"`
 }
 
+// AvailableComponentOutput is the JSON representation of a catalog component
+// for --available --output-format json.
+type AvailableComponentOutput struct {
+	ShortName string `json:"short_name"`
+	Name      string `json:"name"`
+	Repo      string `json:"repo"`
+}
+
 func runE(cmd *cobra.Command, _ []string) error {
+	if available, _ := cmd.Flags().GetBool("available"); available {
+		return runAvailable(cmd, copier.Components().Enabled())
+	}
+
 	answers, err := copier.AnswersFromPath(".", false)
 	if err != nil {
 		return err
@@ -101,6 +113,52 @@ func printComponentsTable(answers []copier.Answers) error {
 	return nil
 }
 
+func runAvailable(cmd *cobra.Command, components []copier.Details) error {
+	if outputformat.GetFormat(cmd) == outputformat.OutputFormatJSON {
+		return outputformat.PrintJSONEnvelope(os.Stdout, "components", toAvailableOutputs(components))
+	}
+
+	if len(components) == 0 {
+		fmt.Println("No components available.")
+		return nil
+	}
+
+	t := table.New().
+		Border(lipgloss.RoundedBorder()).
+		BorderStyle(tui.TableBorderStyle).
+		StyleFunc(func(_, col int) lipgloss.Style {
+			if col == 0 {
+				return tui.BaseTextStyle.
+					Foreground(tui.GetAdaptiveColor(tui.DrPurple, tui.DrPurpleDark)).
+					Padding(0, 1)
+			}
+
+			return tui.DimStyle.Padding(0, 1)
+		}).
+		Headers("NAME", "COMPONENT", "REPO")
+
+	for _, c := range components {
+		t.Row(c.ShortName, c.Name, c.RepoURL)
+	}
+
+	_, _ = fmt.Fprintln(os.Stdout, t.Render())
+
+	return nil
+}
+
+func toAvailableOutputs(components []copier.Details) []AvailableComponentOutput {
+	outputs := make([]AvailableComponentOutput, len(components))
+	for i, c := range components {
+		outputs[i] = AvailableComponentOutput{
+			ShortName: c.ShortName,
+			Name:      c.Name,
+			Repo:      c.RepoURL,
+		}
+	}
+
+	return outputs
+}
+
 func Cmd() *cobra.Command {
 	var outputFormat outputformat.OutputFormat
 
@@ -111,6 +169,7 @@ func Cmd() *cobra.Command {
 	}
 
 	outputformat.AddFlag(cmd, &outputFormat)
+	cmd.Flags().Bool("available", false, "List the components in the catalog that can be added, instead of the installed ones")
 
 	return cmd
 }
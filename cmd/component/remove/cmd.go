@@ -0,0 +1,297 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remove
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/datarobot/cli/cmd/helpers"
+	"github.com/datarobot/cli/cmd/task/compose"
+	"github.com/datarobot/cli/internal/config/viperx"
+	"github.com/datarobot/cli/internal/copier"
+	"github.com/datarobot/cli/internal/misc/reader"
+	"github.com/datarobot/cli/internal/repo"
+	"github.com/datarobot/cli/internal/telemetry"
+	"github.com/datarobot/cli/tui"
+	"github.com/spf13/cobra"
+)
+
+// Deps are the steps remove takes outside the repository, replaced in tests.
+type Deps struct {
+	// Answers lists the installed components.
+	Answers func() ([]copier.Answers, error)
+	// Render generates a component into dir as its answers file records it.
+	Render func(answers copier.Answers, dir string) error
+	// Compose regenerates the composed Taskfile.
+	Compose         func() error
+	StdinIsTerminal func() bool
+}
+
+func defaultDeps() Deps {
+	return Deps{
+		Answers: func() ([]copier.Answers, error) { return copier.AnswersFromPath(".", true) },
+		Render:  copier.ExecRender,
+		Compose: func() error {
+			return compose.Cmd().RunE(nil, nil)
+		},
+		StdinIsTerminal: reader.IsStdinTerminal,
+	}
+}
+
+func PreRunE(_ *cobra.Command, _ []string) error {
+	if !repo.IsInRepoRoot() {
+		return errors.New("You must be in the repository root directory.")
+	}
+
+	return nil
+}
+
+func Cmd() *cobra.Command {
+	return cmdWithDeps(defaultDeps())
+}
+
+func cmdWithDeps(deps Deps) *cobra.Command {
+	var dryRun, force bool
+
+	cmd := &cobra.Command{
+		Use:   "remove <component>",
+		Short: "➖ Remove an installed component",
+		Long: `Remove an installed component and the files it generated.
+
+The component is named by its name in the catalog, its repository URL, or its
+answers file, as 'dr component list' shows them. Its files are found by
+generating it again, in a temporary directory, from the template commit and
+answers its answers file records. Files still as the component wrote them are
+deleted. Files edited since are kept, with a warning, unless --force is given.
+Files another installed component generates too are always kept. The
+composed Taskfile is regenerated afterwards.
+
+Without --yes the command asks for confirmation.
+
+Example:
+  dr component remove react --dry-run
+  dr component remove .datarobot/answers/react-frontend_web.yml --yes`,
+		Aliases:      []string{"rm"},
+		Args:         cobra.ExactArgs(1),
+		PreRunE:      PreRunE,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runRemove(cmd, deps, args[0], dryRun, force)
+		},
+	}
+
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Show what would be removed without removing anything.")
+	cmd.Flags().BoolVarP(&force, "force", "f", false, "Also remove files edited since the component generated them.")
+	cmd.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt.")
+
+	// Bind only the env var (DATAROBOT_CLI_NON_INTERACTIVE) to viper, as
+	// `dr workload delete` does, so --yes never persists to drconfig.yaml.
+	_ = viperx.BindEnv("yes", "DATAROBOT_CLI_NON_INTERACTIVE")
+
+	telemetry.TrackWith(cmd, func(_ *cobra.Command, args []string) map[string]any {
+		return map[string]any{
+			"component_name": telemetry.FirstArg(args),
+			"dry_run":        dryRun,
+			"force":          force,
+		}
+	})
+
+	return cmd
+}
+
+func runRemove(cmd *cobra.Command, deps Deps, name string, dryRun, force bool) error {
+	installed, err := deps.Answers()
+	if err != nil {
+		return err
+	}
+
+	target, others, err := findComponent(installed, name)
+	if err != nil {
+		return err
+	}
+
+	out := cmd.OutOrStdout()
+	label := componentLabel(target)
+
+	fmt.Fprintln(out, tui.DimStyle.Render("Finding the files "+label+" generated..."))
+
+	plan, err := planRemoval(deps, target, others)
+	if err != nil {
+		return err
+	}
+
+	printPlan(out, label, plan, force)
+
+	if dryRun {
+		return nil
+	}
+
+	confirmed, err := confirmRemove(cmd, deps, label)
+	if err != nil || !confirmed {
+		return err
+	}
+
+	removed, err := plan.Apply(".", force)
+	if err != nil {
+		return fmt.Errorf("removing %s: %w", label, err)
+	}
+
+	fmt.Fprintln(out, tui.BaseTextStyle.Render(fmt.Sprintf("Component %s removed (%d files deleted).", label, len(removed))))
+
+	return deps.Compose()
+}
+
+// findComponent returns the installed component name refers to, and the
+// others. name is matched against the catalog short name, the repository
+// URL, the answers file path, and the answers file name without extension.
+func findComponent(installed []copier.Answers, name string) (copier.Answers, []copier.Answers, error) {
+	var matches, others []copier.Answers
+
+	for _, answers := range installed {
+		if matchesComponent(answers, name) {
+			matches = append(matches, answers)
+		} else {
+			others = append(others, answers)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return copier.Answers{}, nil, fmt.Errorf("component %q is not installed; see 'dr component list'", name)
+	case 1:
+		return matches[0], others, nil
+	}
+
+	files := make([]string, len(matches))
+	for i, answers := range matches {
+		files[i] = answers.FileName
+	}
+
+	return copier.Answers{}, nil, fmt.Errorf("%q matches %d installed components; name one by its answers file: %s",
+		name, len(matches), strings.Join(files, ", "))
+}
+
+func matchesComponent(answers copier.Answers, name string) bool {
+	base := filepath.Base(answers.FileName)
+
+	return (answers.ComponentDetails.ShortName != "" && answers.ComponentDetails.ShortName == name) ||
+		answers.Repo == name ||
+		filepath.Clean(name) == filepath.Clean(answers.FileName) ||
+		name == strings.TrimSuffix(base, filepath.Ext(base))
+}
+
+func componentLabel(answers copier.Answers) string {
+	if answers.ComponentDetails.Name != "" {
+		return answers.ComponentDetails.Name
+	}
+
+	return answers.FileName
+}
+
+// planRemoval renders target and every other installed component into a
+// temporary directory and compares them with the repository.
+func planRemoval(deps Deps, target copier.Answers, others []copier.Answers) (copier.Removal, error) {
+	tmp, err := os.MkdirTemp("", "dr-component-remove-")
+	if err != nil {
+		return copier.Removal{}, err
+	}
+	defer os.RemoveAll(tmp)
+
+	rendered := filepath.Join(tmp, "target")
+
+	if err := deps.Render(target, rendered); err != nil {
+		return copier.Removal{}, fmt.Errorf("generating %s to find its files: %w", componentLabel(target), err)
+	}
+
+	shared := map[string]bool{}
+
+	for i, other := range others {
+		dir := filepath.Join(tmp, strconv.Itoa(i))
+
+		if err := deps.Render(other, dir); err != nil {
+			return copier.Removal{}, fmt.Errorf("generating %s to find the files it shares: %w", componentLabel(other), err)
+		}
+
+		files, err := copier.GeneratedFiles(dir)
+		if err != nil {
+			return copier.Removal{}, err
+		}
+
+		for rel := range files {
+			shared[rel] = true
+		}
+	}
+
+	return copier.PlanRemoval(".", target.FileName, rendered, shared)
+}
+
+func printPlan(out io.Writer, label string, plan copier.Removal, force bool) {
+	deleted := append([]string{plan.AnswersFile}, plan.Unchanged...)
+	if force {
+		deleted = append(deleted, plan.Modified...)
+	}
+
+	fmt.Fprintln(out, tui.SubTitleStyle.Render("Removing "+label+" deletes:"))
+
+	for _, rel := range deleted {
+		fmt.Fprintln(out, "  "+rel)
+	}
+
+	if len(plan.Modified) > 0 && !force {
+		fmt.Fprintln(out, tui.WarnStyle.Render("These files were edited since the component generated them and are kept (--force removes them too):"))
+
+		for _, rel := range plan.Modified {
+			fmt.Fprintln(out, "  "+rel)
+		}
+	}
+
+	if len(plan.Shared) > 0 {
+		fmt.Fprintln(out, tui.DimStyle.Render("These files are kept because another installed component generates them too:"))
+
+		for _, rel := range plan.Shared {
+			fmt.Fprintln(out, tui.DimStyle.Render("  "+rel))
+		}
+	}
+}
+
+// confirmRemove returns (true, nil) when the removal may proceed, like
+// `dr workload delete` does.
+func confirmRemove(cmd *cobra.Command, deps Deps, label string) (bool, error) {
+	yesFlag, _ := cmd.Flags().GetBool("yes")
+	if yesFlag || viperx.GetBool("yes") {
+		return true, nil
+	}
+
+	if !deps.StdinIsTerminal() {
+		return false, errors.New("confirmation required: pass --yes (or set DATAROBOT_CLI_NON_INTERACTIVE=1) to remove without a prompt")
+	}
+
+	confirmed, err := helpers.Confirm(cmd.OutOrStdout(), cmd.InOrStdin(), "Remove "+label+"? [y/N] ")
+	if err != nil {
+		return false, err
+	}
+
+	if !confirmed {
+		fmt.Fprintln(cmd.OutOrStdout(), tui.DimStyle.Render("Aborted."))
+	}
+
+	return confirmed, nil
+}
@@ -0,0 +1,213 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remove
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/datarobot/cli/internal/config/viperx"
+	"github.com/datarobot/cli/internal/copier"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	reactAnswers = copier.Answers{
+		FileName:         ".datarobot/answers/react-frontend_web.yml",
+		ComponentDetails: copier.Details{Name: "React", ShortName: "react"},
+		Repo:             "https://github.com/datarobot/af-component-react.git",
+	}
+	agentAnswers = copier.Answers{
+		FileName:         ".datarobot/answers/agent-writer_agent.yml",
+		ComponentDetails: copier.Details{Name: "Agent", ShortName: "agent"},
+		Repo:             "https://github.com/datarobot-community/af-component-agent.git",
+	}
+)
+
+// generated is what each fake component renders.
+var generated = map[string]map[string]string{
+	reactAnswers.FileName: {
+		reactAnswers.FileName:     "_commit: v1\n",
+		"frontend_web/index.html": "<html>",
+		"frontend_web/app.tsx":    "original",
+		"Taskfile.yml":            "tasks",
+	},
+	agentAnswers.FileName: {
+		agentAnswers.FileName:   "_commit: v1\n",
+		"writer_agent/agent.py": "agent",
+		"Taskfile.yml":          "tasks",
+	},
+}
+
+type testEnv struct {
+	deps     Deps
+	rendered []string
+	composed bool
+}
+
+func writeFiles(t *testing.T, root string, files map[string]string) {
+	t.Helper()
+
+	for rel, content := range files {
+		path := filepath.Join(root, rel)
+
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	}
+}
+
+// newTestEnv makes a repository holding both components, with one edit to
+// React's app.tsx, and changes into it.
+func newTestEnv(t *testing.T) *testEnv {
+	t.Helper()
+
+	t.Cleanup(viperx.Reset)
+
+	root := t.TempDir()
+	t.Chdir(root)
+
+	writeFiles(t, root, generated[reactAnswers.FileName])
+	writeFiles(t, root, generated[agentAnswers.FileName])
+	writeFiles(t, root, map[string]string{"frontend_web/app.tsx": "edited"})
+
+	env := &testEnv{}
+	env.deps = Deps{
+		Answers: func() ([]copier.Answers, error) {
+			return []copier.Answers{agentAnswers, reactAnswers}, nil
+		},
+		Render: func(answers copier.Answers, dir string) error {
+			env.rendered = append(env.rendered, answers.FileName)
+			writeFiles(t, dir, generated[answers.FileName])
+
+			return nil
+		},
+		Compose: func() error {
+			env.composed = true
+
+			return nil
+		},
+		StdinIsTerminal: func() bool { return false },
+	}
+
+	return env
+}
+
+func runCmd(t *testing.T, deps Deps, stdin string, args ...string) (string, error) {
+	t.Helper()
+
+	cmd := cmdWithDeps(deps)
+	cmd.PreRunE = nil
+
+	var stdout bytes.Buffer
+
+	cmd.SetOut(&stdout)
+	cmd.SetErr(&stdout)
+	cmd.SetIn(strings.NewReader(stdin))
+	cmd.SetArgs(args)
+
+	err := cmd.Execute()
+
+	return stdout.String(), err
+}
+
+func TestRemove_KeepsEditedAndSharedFiles(t *testing.T) {
+	env := newTestEnv(t)
+
+	stdout, err := runCmd(t, env.deps, "", "react", "--yes")
+	require.NoError(t, err)
+
+	assert.NoFileExists(t, reactAnswers.FileName)
+	assert.NoFileExists(t, "frontend_web/index.html")
+	assert.FileExists(t, "frontend_web/app.tsx")
+	assert.FileExists(t, "Taskfile.yml")
+	assert.FileExists(t, "writer_agent/agent.py")
+	assert.FileExists(t, agentAnswers.FileName)
+
+	assert.Contains(t, stdout, "edited since the component generated them")
+	assert.Contains(t, stdout, "another installed component generates them too")
+	assert.Contains(t, stdout, "Component React removed (2 files deleted).")
+	assert.Equal(t, []string{reactAnswers.FileName, agentAnswers.FileName}, env.rendered)
+	assert.True(t, env.composed)
+}
+
+func TestRemove_Force(t *testing.T) {
+	env := newTestEnv(t)
+
+	_, err := runCmd(t, env.deps, "", reactAnswers.FileName, "--yes", "--force")
+	require.NoError(t, err)
+
+	assert.NoDirExists(t, "frontend_web")
+	assert.FileExists(t, "Taskfile.yml")
+}
+
+func TestRemove_DryRun(t *testing.T) {
+	env := newTestEnv(t)
+
+	stdout, err := runCmd(t, env.deps, "", "react-frontend_web", "--dry-run")
+	require.NoError(t, err)
+
+	assert.Contains(t, stdout, "frontend_web/index.html")
+	assert.FileExists(t, "frontend_web/index.html")
+	assert.FileExists(t, reactAnswers.FileName)
+	assert.False(t, env.composed)
+}
+
+func TestRemove_Confirmation(t *testing.T) {
+	env := newTestEnv(t)
+
+	_, err := runCmd(t, env.deps, "", "react")
+	require.ErrorContains(t, err, "confirmation required")
+
+	env.deps.StdinIsTerminal = func() bool { return true }
+
+	stdout, err := runCmd(t, env.deps, "n\n", "react")
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "Aborted.")
+	assert.FileExists(t, reactAnswers.FileName)
+
+	_, err = runCmd(t, env.deps, "y\n", "react")
+	require.NoError(t, err)
+	assert.NoFileExists(t, reactAnswers.FileName)
+}
+
+func TestRemove_Errors(t *testing.T) {
+	env := newTestEnv(t)
+
+	_, err := runCmd(t, env.deps, "", "fastapi", "--yes")
+	require.ErrorContains(t, err, `component "fastapi" is not installed`)
+
+	second := reactAnswers
+	second.FileName = ".datarobot/answers/react-admin.yml"
+	env.deps.Answers = func() ([]copier.Answers, error) {
+		return []copier.Answers{reactAnswers, second}, nil
+	}
+
+	_, err = runCmd(t, env.deps, "", "react", "--yes")
+	require.ErrorContains(t, err, `"react" matches 2 installed components`)
+
+	env.deps.Answers = func() ([]copier.Answers, error) {
+		return []copier.Answers{reactAnswers}, nil
+	}
+	env.deps.Render = func(copier.Answers, string) error { return errors.New("uvx: not found") }
+
+	_, err = runCmd(t, env.deps, "", "react", "--yes")
+	require.ErrorContains(t, err, "generating React to find its files: uvx: not found")
+	assert.FileExists(t, reactAnswers.FileName)
+}
@@ -133,7 +133,7 @@ func (am AddModel) getSelectedRepoURLs() []string {
 
 func (am AddModel) loadComponents() tea.Cmd {
 	return func() tea.Msg {
-		details := copier.EnabledComponents
+		details := copier.Components().Enabled()
 
 		items := make([]list.Item, 0, len(details))
 		first := true
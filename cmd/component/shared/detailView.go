@@ -59,7 +59,7 @@ func (m UpdateModel) getComponentDetailContent() string {
 
 	item := m.list.VisibleItems()[m.list.Index()].(ListItem)
 	selectedComponent := item.component
-	selectedComponentDetails := copier.ComponentDetailsByURL[selectedComponent.Repo]
+	selectedComponentDetails, _ := copier.Components().ByURL(selectedComponent.Repo)
 
 	style := "light"
 	if lipgloss.HasDarkBackground() {
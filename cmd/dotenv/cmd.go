@@ -95,7 +95,7 @@ var EditCmd = &cobra.Command{
 			contents:   contents,
 			SuccessCmd: tea.Quit,
 		}
-		_, err = tui.Run(m, tea.WithAltScreen(), tea.WithContext(cmd.Context()))
+		_, err = runWizard(cmd.Context(), m, tea.WithAltScreen())
 
 		return err
 	},
@@ -201,13 +201,13 @@ This wizard will help you:
 		needsPulumi, pulumiLoggedIn, needsPassphrase := CheckPulumiSetup(repositoryRoot, variables)
 		m.ConfigureFromPulumiCheck(needsPulumi, pulumiLoggedIn, needsPassphrase, yes)
 
-		finalModel, err := tui.Run(m, tea.WithAltScreen(), tea.WithContext(cmd.Context()))
+		finalModel, err := runWizard(cmd.Context(), m, tea.WithAltScreen())
 		if err != nil {
 			return err
 		}
 
 		// Check if the model has an error (e.g., from Pulumi login failure)
-		// The model is wrapped by tui.Run(), so we need to unwrap it
+		// The model is wrapped by runWizard, so we need to unwrap it
 		if m, ok := tui.Unwrap(finalModel).(Model); ok {
 			if m.err != nil {
 				return m.err
@@ -337,6 +337,12 @@ var ValidateCmd = &cobra.Command{
 			fmt.Println("\nValidation errors:")
 
 			for _, valResult := range result.Results {
+				if !valResult.Valid && valResult.Value != "" {
+					printInvalidValue(valResult, varStyle)
+
+					continue
+				}
+
 				if !valResult.Valid {
 					fmt.Printf("\n%s: Required variable %s is not set\n",
 						tui.ErrorStyle.Render("Error"), varStyle.Render(valResult.Field))
@@ -352,8 +358,26 @@ var ValidateCmd = &cobra.Command{
 			return cli.ErrSilent
 		}
 
-		fmt.Println("\nValidation passed: all required variables are set.")
+		fmt.Println("\nValidation passed: all required variables are set and valid.")
 
 		return nil
 	},
 }
+
+// printInvalidValue reports a variable that is set to a value its prompt does
+// not accept, pointing at the '.env' line the value came from when it has one.
+func printInvalidValue(valResult envbuilder.ValidationResult, varStyle lipgloss.Style) {
+	field := varStyle.Render(valResult.Field)
+
+	if valResult.Line > 0 {
+		field += fmt.Sprintf(" ('.env' line %d)", valResult.Line)
+	}
+
+	fmt.Printf("\n%s: %s: %s\n", tui.ErrorStyle.Render("Error"), field, valResult.Message)
+
+	if valResult.Help != "" {
+		fmt.Printf("  Description: %s\n", valResult.Help)
+	}
+
+	fmt.Println("  Fix this value in your '.env' file or run `dr dotenv edit` to change it.")
+}
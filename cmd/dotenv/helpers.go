@@ -15,6 +15,7 @@
 package dotenv
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
@@ -126,7 +127,7 @@ func ValidateAndEditIfNeeded() error {
 		SuccessCmd: tea.Quit,
 	}
 
-	_, err = tui.Run(m, tea.WithAltScreen())
+	_, err = runWizard(context.Background(), m, tea.WithAltScreen())
 	if err != nil {
 		fmt.Println()
 		fmt.Println(tui.ErrorStyle.Render("⚠️  Configuration update incomplete"))
@@ -187,6 +187,7 @@ func (m Model) updateCurrentPrompt() (tea.Model, tea.Cmd) {
 	var cmd tea.Cmd
 
 	m.currentPrompt, cmd = newPromptModel(m.prompts[m.currentPromptIndex], promptFinishedCmd)
+	m.currentPrompt.baseDir = filepath.Dir(m.DotenvFile)
 
 	return m, cmd
 }
@@ -446,7 +447,13 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) { //nolint: cyclop
 		case tea.KeyMsg:
 			switch keypress := msg.String(); keypress {
 			case keyExit:
+				// Esc first clears a search in the current picker.
+				if m.currentPrompt.searching() {
+					break
+				}
+
 				m.screen = listScreen
+
 				return m, nil
 			case keyBack:
 				return m.moveToPreviousPrompt()
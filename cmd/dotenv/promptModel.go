@@ -15,6 +15,7 @@
 package dotenv
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -42,6 +43,11 @@ type promptModel struct {
 	successCmd tea.Cmd
 	loading    bool
 	spinner    tui.Loading
+	// baseDir resolves relative paths typed into a path prompt; it is the
+	// directory holding the .env file.
+	baseDir string
+	// notice explains why a source prompt fell back to a text input.
+	notice string
 }
 
 var (
@@ -69,7 +75,17 @@ type llmCatalogLoadedMsg struct {
 
 type item envbuilder.PromptOption
 
+// FilterValue is what the list's search matches: the displayed name and the
+// value, so a source entry is found by its name or its ID.
 func (i item) FilterValue() string {
+	if i.Value != "" && i.Value != i.Name {
+		return i.Name + " " + i.Value
+	}
+
+	return i.Name
+}
+
+func (i item) value() string {
 	if i.Value != "" {
 		return i.Value
 	}
@@ -113,10 +129,14 @@ func (d itemDelegate) Render(w io.Writer, m list.Model, index int, listItem list
 }
 
 func newPromptModel(prompt envbuilder.UserPrompt, successCmd tea.Cmd) (promptModel, tea.Cmd) {
-	if prompt.Type == "llmgw_catalog" {
+	if prompt.Type == envbuilder.PromptTypeLLMGWCatalog {
 		return newLLMListPromptAsync(prompt, successCmd)
 	}
 
+	if prompt.Source != "" {
+		return newSourcePromptAsync(prompt, successCmd)
+	}
+
 	if len(prompt.Options) == 0 {
 		return newTextInputPrompt(prompt, successCmd)
 	}
@@ -135,12 +155,14 @@ func newLLMListPromptAsync(prompt envbuilder.UserPrompt, successCmd tea.Cmd) (pr
 	return pm, tea.Batch(pm.spinner.Init(), loadLLMCatalogCmd())
 }
 
+// loadLLMCatalogCmd fetches the catalog off the UI goroutine. Quitting the
+// wizard cancels the fetch; see fetchCmd.
 func loadLLMCatalogCmd() tea.Cmd {
-	return func() tea.Msg {
-		llms, err := drapi.GetLLMs()
+	return fetchCmd(func(ctx context.Context) tea.Msg {
+		llms, err := drapi.GetLLMs(ctx)
 
 		return llmCatalogLoadedMsg{llms: llms, err: err}
-	}
+	})
 }
 
 func newTextInputPrompt(prompt envbuilder.UserPrompt, successCmd tea.Cmd) (promptModel, tea.Cmd) {
@@ -219,7 +241,7 @@ func llmsToPromptOptions(llms []drapi.LLM) []envbuilder.PromptOption {
 }
 
 func newLLMListPrompt(prompt envbuilder.UserPrompt, successCmd tea.Cmd) (promptModel, tea.Cmd) {
-	llms, err := drapi.GetLLMs()
+	llms, err := drapi.GetLLMs(context.Background())
 	if err != nil {
 		return llmErrorPrompt(prompt, successCmd, err), nil
 	}
@@ -301,7 +323,7 @@ func (pm promptModel) GetValues() []string {
 
 		for i := range items {
 			if itm := items[i].(item); itm.Checked {
-				values = append(values, itm.FilterValue())
+				values = append(values, itm.value())
 			}
 		}
 
@@ -312,7 +334,7 @@ func (pm promptModel) GetValues() []string {
 		return nil
 	}
 
-	return []string{current.FilterValue()}
+	return []string{current.value()}
 }
 
 func (pm promptModel) Update(msg tea.Msg) (promptModel, tea.Cmd) { //nolint:cyclop
@@ -329,6 +351,11 @@ func (pm promptModel) Update(msg tea.Msg) (promptModel, tea.Cmd) { //nolint:cycl
 			nextPrompt, cmd := llmListPromptFromCatalog(pm.prompt, pm.successCmd, msg.llms)
 			nextPrompt.loading = false
 
+			return nextPrompt, cmd
+		case sourceLoadedMsg:
+			nextPrompt, cmd := sourcePrompt(pm.prompt, pm.successCmd, msg.options, msg.err)
+			nextPrompt.baseDir = pm.baseDir
+
 			return nextPrompt, cmd
 		case spinner.TickMsg:
 			var cmd tea.Cmd
@@ -344,6 +371,12 @@ func (pm promptModel) Update(msg tea.Msg) (promptModel, tea.Cmd) { //nolint:cycl
 	if len(pm.prompt.Options) > 0 {
 		switch msg := msg.(type) {
 		case tea.KeyMsg:
+			// While the search is being typed, keys belong to the search:
+			// enter applies it rather than answering the prompt.
+			if pm.list.FilterState() == list.Filtering {
+				break
+			}
+
 			switch keypress := msg.String(); keypress {
 			case " ":
 				// toggle checkbox, don't submit
@@ -376,9 +409,15 @@ func (pm promptModel) Update(msg tea.Msg) (promptModel, tea.Cmd) { //nolint:cycl
 	return pm, cmd
 }
 
+// searching reports whether a picker's search is being typed or applied, when
+// esc belongs to the search rather than to the wizard.
+func (pm promptModel) searching() bool {
+	return len(pm.prompt.Options) > 0 && pm.list.FilterState() != list.Unfiltered
+}
+
 func (pm promptModel) toggleCurrent() (promptModel, tea.Cmd) {
 	items := pm.list.Items()
-	currentItem := items[pm.list.Index()].(item)
+	currentItem := items[pm.list.GlobalIndex()].(item)
 
 	if !pm.prompt.Multiple {
 		return pm, nil
@@ -392,7 +431,7 @@ func (pm promptModel) toggleCurrent() (promptModel, tea.Cmd) {
 		}
 	} else {
 		currentItem.Checked = !currentItem.Checked
-		items[pm.list.Index()] = currentItem
+		items[pm.list.GlobalIndex()] = currentItem
 	}
 
 	cmd := pm.list.SetItems(items)
@@ -410,9 +449,24 @@ func (pm promptModel) submitList() (promptModel, tea.Cmd) {
 	return pm, nil
 }
 
+// inputError reports why the typed value does not fit the prompt's type and
+// constraints. It is checked on every render, so the message follows the input
+// as the user types.
+func (pm promptModel) inputError() error {
+	if len(pm.prompt.Options) > 0 || pm.prompt.Type.String() == typeError {
+		return nil
+	}
+
+	return pm.prompt.ValidateValue(strings.TrimSpace(pm.input.Value()), pm.baseDir)
+}
+
 func (pm promptModel) submitInput() (promptModel, tea.Cmd) {
 	pm.Values = pm.GetValues()
 
+	if pm.inputError() != nil {
+		return pm, nil
+	}
+
 	if pm.prompt.Optional || len(pm.Values[0]) > 0 {
 		return pm, pm.successCmd
 	}
@@ -420,6 +474,14 @@ func (pm promptModel) submitInput() (promptModel, tea.Cmd) {
 	return pm, nil
 }
 
+func (pm promptModel) loadingText() string {
+	if pm.prompt.Source != "" {
+		return fmt.Sprintf("Fetching %s from DataRobot...", sourceLabel(pm.prompt.Source))
+	}
+
+	return loadingLLMsMsg
+}
+
 func (pm promptModel) View() string {
 	var sb strings.Builder
 
@@ -427,7 +489,7 @@ func (pm promptModel) View() string {
 	sb.WriteString("\n\n")
 
 	if pm.loading {
-		sb.WriteString(tui.InfoStyle.Render(pm.spinner.View() + " " + loadingLLMsMsg))
+		sb.WriteString(tui.InfoStyle.Render(pm.spinner.View() + " " + pm.loadingText()))
 		sb.WriteString("\n\n")
 		sb.WriteString(tui.DimStyle.Render("ctrl-p back to previous"))
 
@@ -442,6 +504,11 @@ func (pm promptModel) View() string {
 
 	sb.WriteString("\n")
 
+	if pm.notice != "" {
+		sb.WriteString(tui.WarnStyle.Render(pm.notice))
+		sb.WriteString("\n")
+	}
+
 	if pm.prompt.Default != "" {
 		sb.WriteString(tui.BaseTextStyle.Render(fmt.Sprintf("Default: %v", pm.prompt.Default)))
 		sb.WriteString("\n\n")
@@ -454,11 +521,22 @@ func (pm promptModel) View() string {
 		if pm.prompt.Multiple {
 			sb.WriteString(tui.DimStyle.Render("space to toggle • enter to answer • "))
 		}
+
+		if pm.prompt.Source != "" {
+			sb.WriteString(tui.DimStyle.Render("/ to search • "))
+		}
 	} else if pm.prompt.Type.String() == typeError {
 		sb.WriteString("\n\n")
 	} else {
 		sb.WriteString(pm.input.View())
-		sb.WriteString("\n\n")
+		sb.WriteString("\n")
+
+		if err := pm.inputError(); err != nil {
+			sb.WriteString(tui.ErrorStyle.Render("  ✗ Value " + err.Error()))
+			sb.WriteString("\n")
+		}
+
+		sb.WriteString("\n")
 	}
 
 	sb.WriteString(tui.DimStyle.Render("ctrl-p back to previous"))
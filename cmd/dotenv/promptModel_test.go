@@ -22,6 +22,7 @@ import (
 	"testing"
 
 	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
 	"github.com/datarobot/cli/internal/config"
 	"github.com/datarobot/cli/internal/config/viperx"
 	"github.com/datarobot/cli/internal/drapi"
@@ -315,3 +316,30 @@ func TestPromptModelView_MultipleChoice(t *testing.T) {
 
 	assert.Contains(t, view, "space to toggle")
 }
+
+// TestPromptModel_InvalidInputBlocksSubmit verifies that a value that does not
+// fit the prompt's type is reported as it is typed and cannot be submitted.
+func TestPromptModel_InvalidInputBlocksSubmit(t *testing.T) {
+	one, maxPort := 1.0, 65535.0
+
+	pm, _ := newTextInputPrompt(envbuilder.UserPrompt{
+		Type: envbuilder.PromptTypeInt,
+		Env:  "APP_PORT",
+		Min:  &one,
+		Max:  &maxPort,
+	}, func() tea.Msg { return nil })
+
+	pm.input.SetValue("99999")
+
+	assert.Contains(t, pm.View(), "✗ Value must be between 1 and 65535")
+
+	pm, cmd := pm.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Nil(t, cmd, "an invalid value is not submitted")
+
+	pm.input.SetValue("8080")
+
+	assert.NotContains(t, pm.View(), "✗")
+
+	_, cmd = pm.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.NotNil(t, cmd)
+}
@@ -0,0 +1,311 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dotenv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/datarobot/cli/internal/drapi"
+	"github.com/datarobot/cli/internal/envbuilder"
+	"github.com/datarobot/cli/internal/log"
+	"github.com/datarobot/cli/internal/workload"
+	"github.com/datarobot/cli/tui"
+)
+
+// sourceLimit bounds how many entries a source picker offers. Past a few
+// hundred a list stops being something to scroll, and the search finds the
+// entry by name long before that.
+const sourceLimit = 500
+
+type sourceLoadedMsg struct {
+	options []envbuilder.PromptOption
+	err     error
+}
+
+// errSourceEmpty is a source that answered with nothing to pick from.
+var errSourceEmpty = errors.New("none found")
+
+// sourceFetchers fill the options of a prompt with a source: key, each option
+// named for people and valued with the ID that goes into the .env file. Tests
+// replace them to stand in for the API.
+var sourceFetchers = map[envbuilder.PromptSource]func(context.Context) ([]envbuilder.PromptOption, error){
+	envbuilder.PromptSourceLLMs:                  fetchLLMOptions,
+	envbuilder.PromptSourceDeployments:           fetchDeploymentOptions,
+	envbuilder.PromptSourceCredentials:           fetchCredentialOptions,
+	envbuilder.PromptSourceExecutionEnvironments: fetchExecutionEnvironmentOptions,
+}
+
+// sourceCache keeps each source's options for the rest of the run, so going
+// back to a prompt, or a second prompt on the same source, shows the picker at
+// once. Failures are not cached: revisiting the prompt tries again.
+var (
+	sourceCacheMu sync.Mutex
+	sourceCache   = map[envbuilder.PromptSource][]envbuilder.PromptOption{}
+)
+
+func cachedSourceOptions(source envbuilder.PromptSource) ([]envbuilder.PromptOption, bool) {
+	sourceCacheMu.Lock()
+	defer sourceCacheMu.Unlock()
+
+	options, ok := sourceCache[source]
+
+	return options, ok
+}
+
+func loadSourceOptions(ctx context.Context, source envbuilder.PromptSource) ([]envbuilder.PromptOption, error) {
+	if options, ok := cachedSourceOptions(source); ok {
+		return options, nil
+	}
+
+	fetch, ok := sourceFetchers[source]
+	if !ok {
+		return nil, fmt.Errorf("unknown source %q", source)
+	}
+
+	options, err := fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(options) == 0 {
+		return nil, errSourceEmpty
+	}
+
+	sourceCacheMu.Lock()
+	sourceCache[source] = options
+	sourceCacheMu.Unlock()
+
+	return options, nil
+}
+
+// fetchGroup tracks the API fetches a wizard's commands run off the UI
+// goroutine. The fetches log through internal/log, whose stderr logger
+// tui.Run swaps back when the wizard exits, so they must not outlive it: stop
+// cancels them and waits, and no fetch starts after it.
+type fetchGroup struct {
+	mu     sync.Mutex
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func newFetchGroup(ctx context.Context) *fetchGroup {
+	ctx, cancel := context.WithCancel(ctx)
+
+	return &fetchGroup{ctx: ctx, cancel: cancel}
+}
+
+// start registers a fetch and returns its context, or false once the group
+// is stopped. A started fetch calls done when it returns.
+func (g *fetchGroup) start() (context.Context, bool) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.ctx.Err() != nil {
+		return nil, false
+	}
+
+	g.wg.Add(1)
+
+	return g.ctx, true
+}
+
+func (g *fetchGroup) done() {
+	g.wg.Done()
+}
+
+func (g *fetchGroup) stop() {
+	g.mu.Lock()
+	g.cancel()
+	g.mu.Unlock()
+
+	g.wg.Wait()
+}
+
+// wizardFetches is the fetch group of the running wizard. runWizard replaces
+// it for each run; outside one, fetches run uncancelled.
+var wizardFetches = newFetchGroup(context.Background())
+
+// runWizard runs a dotenv TUI with tui.RunWithCleanup, stopping the fetches
+// it started before stderr logging comes back.
+func runWizard(ctx context.Context, m tea.Model, opts ...tea.ProgramOption) (tea.Model, error) {
+	fetches := newFetchGroup(ctx)
+	wizardFetches = fetches
+
+	return tui.RunWithCleanup(m, fetches.stop, append(opts, tea.WithContext(ctx))...)
+}
+
+// fetchCmd runs fetch off the UI goroutine as part of the wizard's fetch
+// group. A fetch asked for after the wizard quit never starts.
+func fetchCmd(fetch func(context.Context) tea.Msg) tea.Cmd {
+	fetches := wizardFetches
+
+	return func() tea.Msg {
+		ctx, ok := fetches.start()
+		if !ok {
+			return nil
+		}
+
+		defer fetches.done()
+
+		return fetch(ctx)
+	}
+}
+
+// loadSourceCmd fetches a source off the UI goroutine.
+func loadSourceCmd(source envbuilder.PromptSource) tea.Cmd {
+	return fetchCmd(func(ctx context.Context) tea.Msg {
+		options, err := loadSourceOptions(ctx, source)
+
+		return sourceLoadedMsg{options: options, err: err}
+	})
+}
+
+// newSourcePromptAsync shows a spinner while the prompt's source loads, or the
+// picker straight away when the source is already cached.
+func newSourcePromptAsync(prompt envbuilder.UserPrompt, successCmd tea.Cmd) (promptModel, tea.Cmd) {
+	if options, ok := cachedSourceOptions(prompt.Source); ok {
+		return sourcePrompt(prompt, successCmd, options, nil)
+	}
+
+	pm := promptModel{
+		prompt:     prompt,
+		successCmd: successCmd,
+		loading:    true,
+		spinner:    tui.NewLoading(),
+	}
+
+	return pm, tea.Batch(pm.spinner.Init(), loadSourceCmd(prompt.Source))
+}
+
+// sourcePrompt is the picker over a loaded source. When the source could not
+// be loaded, it falls back to a text input for the ID, saying why, so the
+// wizard still works offline or without access to the listing.
+func sourcePrompt(prompt envbuilder.UserPrompt, successCmd tea.Cmd, options []envbuilder.PromptOption, err error) (promptModel, tea.Cmd) {
+	if err != nil {
+		log.Warnf("Could not load %s for %s: %s", sourceLabel(prompt.Source), prompt.VarName(), err)
+
+		pm, cmd := newTextInputPrompt(prompt, successCmd)
+		pm.notice = fmt.Sprintf("Could not load %s from DataRobot (%s). Enter the ID instead.", sourceLabel(prompt.Source), err)
+
+		return pm, cmd
+	}
+
+	prompt.Options = withCurrentValue(options, prompt.Value, prompt.Multiple)
+
+	return newListPrompt(prompt, successCmd)
+}
+
+// withCurrentValue keeps a value already in the .env file selectable when the
+// source no longer lists it, so confirming the prompt does not drop it.
+func withCurrentValue(options []envbuilder.PromptOption, value string, multiple bool) []envbuilder.PromptOption {
+	if value == "" || multiple {
+		return options
+	}
+
+	if slices.ContainsFunc(options, func(o envbuilder.PromptOption) bool { return o.Value == value }) {
+		return options
+	}
+
+	current := envbuilder.PromptOption{Name: value + " (current value)", Value: value}
+
+	return append([]envbuilder.PromptOption{current}, options...)
+}
+
+func sourceLabel(source envbuilder.PromptSource) string {
+	switch source {
+	case envbuilder.PromptSourceLLMs:
+		return "LLMs"
+	case envbuilder.PromptSourceExecutionEnvironments:
+		return "execution environments"
+	default:
+		return string(source)
+	}
+}
+
+// sourceOption names an entry "name (id)", so entries sharing a name can
+// still be told apart, and searching finds either.
+func sourceOption(name, id string) envbuilder.PromptOption {
+	if name == "" || name == id {
+		return envbuilder.PromptOption{Name: id, Value: id}
+	}
+
+	return envbuilder.PromptOption{Name: fmt.Sprintf("%s (%s)", name, id), Value: id}
+}
+
+func fetchLLMOptions(ctx context.Context) ([]envbuilder.PromptOption, error) {
+	llms, err := drapi.GetLLMsAndDeployed(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	options := make([]envbuilder.PromptOption, 0, len(llms.LLMs))
+
+	for _, llm := range llms.LLMs {
+		options = append(options, sourceOption(llm.Name, llm.LlmID))
+	}
+
+	return options, nil
+}
+
+func fetchDeploymentOptions(ctx context.Context) ([]envbuilder.PromptOption, error) {
+	deployments, err := drapi.ListDeployments(ctx, sourceLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	options := make([]envbuilder.PromptOption, 0, len(deployments))
+
+	for _, d := range deployments {
+		options = append(options, sourceOption(d.Label, d.ID))
+	}
+
+	return options, nil
+}
+
+func fetchCredentialOptions(ctx context.Context) ([]envbuilder.PromptOption, error) {
+	credentials, err := workload.ListCredentials(ctx, sourceLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	options := make([]envbuilder.PromptOption, 0, len(credentials))
+
+	for _, c := range credentials {
+		options = append(options, sourceOption(c.Name, c.CredentialID))
+	}
+
+	return options, nil
+}
+
+func fetchExecutionEnvironmentOptions(ctx context.Context) ([]envbuilder.PromptOption, error) {
+	environments, err := workload.ListExecutionEnvironments(ctx, sourceLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	options := make([]envbuilder.PromptOption, 0, len(environments))
+
+	for _, e := range environments {
+		options = append(options, sourceOption(e.Name, e.ID))
+	}
+
+	return options, nil
+}
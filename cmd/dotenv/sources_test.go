@@ -0,0 +1,198 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dotenv
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/datarobot/cli/internal/envbuilder"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testDeploymentOptions = []envbuilder.PromptOption{
+	sourceOption("Support bot", "dep-1"),
+	sourceOption("Churn model", "dep-2"),
+}
+
+// stubSource replaces a source's fetcher and empties the cache for the test,
+// returning a counter of how often the source was fetched.
+func stubSource(t *testing.T, source envbuilder.PromptSource, options []envbuilder.PromptOption, err error) *int {
+	t.Helper()
+
+	calls := 0
+	previous := sourceFetchers[source]
+
+	sourceFetchers[source] = func(context.Context) ([]envbuilder.PromptOption, error) {
+		calls++
+
+		return options, err
+	}
+
+	clearSourceCache()
+
+	t.Cleanup(func() {
+		sourceFetchers[source] = previous
+
+		clearSourceCache()
+	})
+
+	return &calls
+}
+
+func clearSourceCache() {
+	sourceCacheMu.Lock()
+	defer sourceCacheMu.Unlock()
+
+	clear(sourceCache)
+}
+
+// loadSourcePrompt runs the prompt's fetch the way the wizard does and returns
+// the prompt it turns into.
+func loadSourcePrompt(t *testing.T, prompt envbuilder.UserPrompt) promptModel {
+	t.Helper()
+
+	pm, _ := newPromptModel(prompt, func() tea.Msg { return nil })
+	require.True(t, pm.loading)
+	assert.Contains(t, pm.View(), "Fetching deployments from DataRobot...")
+
+	pm, _ = pm.Update(loadSourceCmd(prompt.Source)())
+
+	return pm
+}
+
+func TestSourcePrompt_OffersTheSourceAndStoresTheID(t *testing.T) {
+	stubSource(t, envbuilder.PromptSourceDeployments, testDeploymentOptions, nil)
+
+	pm := loadSourcePrompt(t, envbuilder.UserPrompt{Env: "DEPLOYMENT_ID", Source: envbuilder.PromptSourceDeployments})
+
+	require.False(t, pm.loading)
+
+	view := pm.View()
+	assert.Contains(t, view, "Support bot (dep-1)")
+	assert.Contains(t, view, "/ to search")
+
+	pm, _ = pm.Update(tea.KeyMsg{Type: tea.KeyDown})
+	pm, cmd := pm.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	assert.NotNil(t, cmd)
+	assert.Equal(t, []string{"dep-2"}, pm.Values)
+}
+
+func TestSourcePrompt_SearchesByName(t *testing.T) {
+	stubSource(t, envbuilder.PromptSourceDeployments, testDeploymentOptions, nil)
+
+	pm := loadSourcePrompt(t, envbuilder.UserPrompt{Env: "DEPLOYMENT_ID", Source: envbuilder.PromptSourceDeployments})
+
+	pm, _ = pm.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("/")})
+	assert.True(t, pm.searching())
+
+	// Enter while the search is typed applies it rather than answering.
+	pm, _ = pm.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Nil(t, pm.Values)
+
+	pm.list.SetFilterText("churn")
+	assert.True(t, pm.searching())
+
+	pm, cmd := pm.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	assert.NotNil(t, cmd)
+	assert.Equal(t, []string{"dep-2"}, pm.Values)
+}
+
+func TestSourcePrompt_FallsBackToTextInput(t *testing.T) {
+	stubSource(t, envbuilder.PromptSourceDeployments, nil, errors.New("dial tcp: no route to host"))
+
+	pm := loadSourcePrompt(t, envbuilder.UserPrompt{Env: "DEPLOYMENT_ID", Source: envbuilder.PromptSourceDeployments, Value: "dep-9"})
+
+	assert.Empty(t, pm.prompt.Options)
+	assert.Contains(t, pm.View(), "Could not load deployments from DataRobot (dial tcp: no route to host). Enter the ID instead.")
+	assert.Equal(t, "dep-9", pm.input.Value())
+
+	_, cmd := pm.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.NotNil(t, cmd)
+}
+
+func TestSourcePrompt_EmptySourceFallsBack(t *testing.T) {
+	stubSource(t, envbuilder.PromptSourceDeployments, nil, nil)
+
+	pm := loadSourcePrompt(t, envbuilder.UserPrompt{Env: "DEPLOYMENT_ID", Source: envbuilder.PromptSourceDeployments})
+
+	assert.Contains(t, pm.View(), "(none found)")
+}
+
+func TestSourcePrompt_CachesTheSource(t *testing.T) {
+	calls := stubSource(t, envbuilder.PromptSourceDeployments, testDeploymentOptions, nil)
+	prompt := envbuilder.UserPrompt{Env: "DEPLOYMENT_ID", Source: envbuilder.PromptSourceDeployments}
+
+	loadSourcePrompt(t, prompt)
+
+	pm, cmd := newPromptModel(prompt, nil)
+
+	assert.False(t, pm.loading, "a cached source shows the picker at once")
+	assert.NotNil(t, cmd)
+	assert.Len(t, pm.prompt.Options, 2)
+	assert.Equal(t, 1, *calls)
+}
+
+func TestSourcePrompt_KeepsACurrentValueTheSourceLacks(t *testing.T) {
+	stubSource(t, envbuilder.PromptSourceDeployments, testDeploymentOptions, nil)
+
+	pm := loadSourcePrompt(t, envbuilder.UserPrompt{Env: "DEPLOYMENT_ID", Source: envbuilder.PromptSourceDeployments, Value: "dep-old"})
+
+	assert.Contains(t, pm.View(), "dep-old (current value)")
+	assert.Equal(t, []string{"dep-old"}, pm.GetValues())
+}
+
+// Quitting the wizard cancels its fetches and waits for them, so none is left
+// logging as tui.Run restores the stderr logger, and none starts afterwards.
+func TestFetchGroup_StopCancelsAndWaitsForFetches(t *testing.T) {
+	fetches := newFetchGroup(context.Background())
+
+	previous := wizardFetches
+	wizardFetches = fetches
+
+	t.Cleanup(func() { wizardFetches = previous })
+
+	started := make(chan struct{})
+	finished := false
+
+	running := fetchCmd(func(ctx context.Context) tea.Msg {
+		close(started)
+		<-ctx.Done()
+
+		finished = true
+
+		return nil
+	})
+
+	go running()
+
+	<-started
+	fetches.stop()
+
+	assert.True(t, finished, "stop returns only once the running fetch has")
+
+	late := fetchCmd(func(context.Context) tea.Msg {
+		t.Error("a fetch started after the wizard quit")
+
+		return nil
+	})
+
+	assert.Nil(t, late())
+}
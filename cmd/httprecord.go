@@ -0,0 +1,77 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+
+	"github.com/datarobot/cli/internal/config"
+	"github.com/datarobot/cli/internal/config/viperx"
+	"github.com/datarobot/cli/internal/httprecord"
+	"github.com/datarobot/cli/internal/log"
+	"github.com/spf13/cobra"
+)
+
+// setupHTTPRecording puts a recorder or replayer for --record-http or
+// --replay-http in front of http.DefaultTransport. Every DataRobot API client
+// ends up there: drapi's retrying transport resolves it per attempt, and
+// token verification uses it directly. Must run after setupTLS, which replaces
+// http.DefaultTransport itself. A no-op when neither flag is set.
+func setupHTTPRecording() error {
+	recordPath := viperx.GetString("record-http")
+	replayPath := viperx.GetString("replay-http")
+
+	switch {
+	case recordPath != "" && replayPath != "":
+		return errors.New("--record-http and --replay-http cannot be used together")
+	case recordPath != "":
+		recorder := &httprecord.Recorder{Base: http.DefaultTransport, InScope: isDataRobotRequest}
+		http.DefaultTransport = recorder
+
+		// Finalizers run whether the command succeeds or fails, and a failing
+		// session is the one worth keeping.
+		cobra.OnFinalize(func() {
+			if err := recorder.Save(recordPath); err != nil {
+				log.Error(err)
+				return
+			}
+
+			log.Infof("Recorded %d HTTP requests to %s", recorder.Len(), recordPath)
+		})
+	case replayPath != "":
+		replayer, err := httprecord.LoadReplayer(replayPath)
+		if err != nil {
+			return err
+		}
+
+		replayer.Base = http.DefaultTransport
+		replayer.InScope = isDataRobotRequest
+		http.DefaultTransport = replayer
+	}
+
+	return nil
+}
+
+// isDataRobotRequest reports whether req is for the configured DataRobot
+// endpoint. Other traffic, such as plugin registry fetches and telemetry, is
+// neither recorded nor replayed. The endpoint is read per request because
+// `dr auth login` can set it mid-command.
+func isDataRobotRequest(req *http.Request) bool {
+	base, err := url.Parse(config.GetBaseURL())
+
+	return err == nil && base.Host != "" && base.Host == req.URL.Host
+}
@@ -0,0 +1,72 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/datarobot/cli/internal/config"
+	"github.com/datarobot/cli/internal/config/viperx"
+	"github.com/datarobot/cli/internal/httprecord"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetupHTTPRecording(t *testing.T) {
+	original := http.DefaultTransport
+
+	t.Cleanup(func() {
+		http.DefaultTransport = original
+
+		viperx.Reset()
+	})
+
+	viperx.Set("record-http", "a.har")
+	viperx.Set("replay-http", "b.har")
+	require.EqualError(t, setupHTTPRecording(), "--record-http and --replay-http cannot be used together")
+	assert.Same(t, original, http.DefaultTransport)
+
+	path := filepath.Join(t.TempDir(), "session.har")
+	require.NoError(t, os.WriteFile(path, []byte(`{"log": {"entries": []}}`), 0o600))
+
+	viperx.Set("record-http", "")
+	viperx.Set("replay-http", path)
+	require.NoError(t, setupHTTPRecording())
+
+	replayer, ok := http.DefaultTransport.(*httprecord.Replayer)
+	require.True(t, ok)
+	assert.Same(t, original, replayer.Base)
+}
+
+func TestIsDataRobotRequest(t *testing.T) {
+	t.Cleanup(viperx.Reset)
+
+	viperx.Set(config.DataRobotURL, "https://app.datarobot.com/api/v2")
+
+	for url, want := range map[string]bool{
+		"https://app.datarobot.com/api/v2/version/":    true,
+		"https://cli.datarobot.com/plugins/index.json": false,
+		"https://api2.amplitude.com/2/httpapi":         false,
+	} {
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, url, nil)
+		require.NoError(t, err)
+
+		assert.Equal(t, want, isDataRobotRequest(req), url)
+	}
+}
@@ -13,12 +13,13 @@
 // limitations under the License.
 
 // Package pollflags centralizes the --wait, --poll-interval, and
-// --poll-timeout flags shared between the long-running build commands
-// (`dr artifact build create` and `dr artifact build get`). Single source
-// of truth for the flag names and registration so the commands cannot drift
-// out of sync; poll defaults and the --wait help text vary per command via
-// RegisterWithDefaults. PositiveDuration is also reused by `dr workload logs
-// --poll-interval` so its non-positive rejection stays consistent.
+// --poll-timeout flags shared between the long-running commands (`dr
+// artifact build create`, `dr artifact build get`, and the `dr pipeline run`
+// commands that follow a run). Single source of truth for the flag names and
+// registration so the commands cannot drift out of sync; poll defaults and
+// the --wait help text vary per command via RegisterWithDefaults.
+// PositiveDuration is also reused by `dr workload logs --poll-interval` so
+// its non-positive rejection stays consistent.
 
 package pollflags
 
@@ -103,6 +104,14 @@ func Register(cmd *cobra.Command, s *Set) *Set {
 // like running that are not terminal).
 func RegisterWithDefaults(cmd *cobra.Command, s *Set, interval, timeout time.Duration, waitUsage string) *Set {
 	cmd.Flags().BoolVar(&s.Wait, "wait", false, waitUsage)
+
+	return RegisterPolling(cmd, s, interval, timeout)
+}
+
+// RegisterPolling adds only the hidden --poll-interval and --poll-timeout,
+// for commands that always poll and so have no --wait to opt in with
+// (e.g. `dr pipeline run watch`). s.Wait is left untouched.
+func RegisterPolling(cmd *cobra.Command, s *Set, interval, timeout time.Duration) *Set {
 	cmd.Flags().Var(PositiveDuration(&s.Interval, interval), "poll-interval", "Interval between status polls.")
 	cmd.Flags().Var(PositiveDuration(&s.Timeout, timeout), "poll-timeout", "Maximum time to wait before giving up.")
 	_ = cmd.Flags().MarkHidden("poll-interval")
@@ -68,3 +68,16 @@ func TestRegisterWithDefaults_ParsesPositiveDurations(t *testing.T) {
 	assert.Equal(t, 100*time.Millisecond, s.Interval)
 	assert.Equal(t, 30*time.Second, s.Timeout)
 }
+
+func TestRegisterPolling_NoWaitFlag(t *testing.T) {
+	cmd := &cobra.Command{Use: "x"}
+
+	var s Set
+
+	RegisterPolling(cmd, &s, time.Second, time.Hour)
+
+	assert.Nil(t, cmd.Flags().Lookup("wait"))
+	assert.Equal(t, time.Second, s.Interval)
+	assert.Equal(t, time.Hour, s.Timeout)
+	assert.True(t, cmd.Flags().Lookup("poll-timeout").Hidden)
+}
@@ -0,0 +1,54 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package typedconfirm is the one question `dr workload up` and
+// `dr workload rollback` ask before touching production: type the workload's
+// name back. Shared so the two cannot come to disagree about what counts as
+// an answer.
+package typedconfirm
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// Prompt asks a question that only the exact expected word answers.
+//
+// It goes to stderr and reads a single line, like everything else the deploy
+// says: stdout is the endpoint, or one JSON document, and a question printed
+// into it would break whatever is parsing it. A y/n would not do here, since
+// the one thing this is asked about is rolling production.
+//
+// Callers hand it over only on an interactive run, so it never has to decide
+// whether prompting is allowed. An answer that cannot be read at all is a no,
+// which leaves the workload running what it was running.
+func Prompt(cmd *cobra.Command) func(question, want string) (bool, error) {
+	return func(question, want string) (bool, error) {
+		fmt.Fprint(cmd.ErrOrStderr(), question)
+
+		scanner := bufio.NewScanner(cmd.InOrStdin())
+		if !scanner.Scan() {
+			if err := scanner.Err(); err != nil {
+				return false, fmt.Errorf("cannot read the answer: %w", err)
+			}
+
+			return false, nil
+		}
+
+		return strings.TrimSpace(scanner.Text()) == want, nil
+	}
+}
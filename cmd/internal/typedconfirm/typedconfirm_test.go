@@ -0,0 +1,58 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typedconfirm
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Only the exact name answers. The question goes to stderr because stdout
+// carries the endpoint.
+func TestPrompt_AcceptsOnlyTheName(t *testing.T) {
+	cases := []struct {
+		typed string
+		want  bool
+	}{
+		{"my-app\n", true},
+		{"  my-app  \n", true},
+		{"y\n", false},
+		{"My-App\n", false},
+		{"\n", false},
+		{"", false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.typed, func(t *testing.T) {
+			cmd := &cobra.Command{}
+
+			var errOut bytes.Buffer
+
+			cmd.SetErr(&errOut)
+			cmd.SetIn(strings.NewReader(c.typed))
+
+			agreed, err := Prompt(cmd)("Type the workload name: ", "my-app")
+			require.NoError(t, err)
+
+			assert.Equal(t, c.want, agreed)
+			assert.Contains(t, errOut.String(), "Type the workload name: ")
+		})
+	}
+}
@@ -0,0 +1,265 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	selectcmd "github.com/datarobot/cli/cmd/llm-gateway/select"
+	"github.com/datarobot/cli/internal/auth"
+	"github.com/datarobot/cli/internal/config"
+	"github.com/datarobot/cli/internal/config/viperx"
+	"github.com/datarobot/cli/internal/drapi"
+	"github.com/datarobot/cli/internal/llmgateway"
+	"github.com/datarobot/cli/internal/misc/reader"
+	"github.com/datarobot/cli/internal/outputformat"
+	"github.com/datarobot/cli/internal/telemetry"
+	"github.com/datarobot/cli/tui"
+	"github.com/spf13/cobra"
+)
+
+// ReplyOutput is the JSON representation of a one-shot reply for
+// --output-format json.
+type ReplyOutput struct {
+	LLMID        string            `json:"llm_id"`
+	Content      string            `json:"content"`
+	Usage        *llmgateway.Usage `json:"usage"`
+	FirstTokenMS int64             `json:"first_token_ms"`
+	LatencyMS    int64             `json:"latency_ms"`
+}
+
+// Deps holds the externally-injected collaborators for the chat command.
+type Deps struct {
+	LoadLLMs        func(ctx context.Context) ([]drapi.LLM, error)
+	EndpointURL     func(path string) (string, error)
+	Client          completer
+	Pick            func(llms []drapi.LLM) (string, error)
+	Stdin           io.Reader
+	StdinIsTerminal func() bool
+	RunREPL         func(ctx context.Context, s *session, savePath string) error
+}
+
+func defaultDeps() Deps {
+	return Deps{
+		LoadLLMs:    llmgateway.LoadLLMs,
+		EndpointURL: config.GetEndpointURL,
+		Client:      llmgateway.NewClient(drapi.AuthorizeRequest, drapi.NewHTTPClient(0)),
+		Pick: func(llms []drapi.LLM) (string, error) {
+			return selectcmd.RunPicker(llms, "Select an LLM to chat with")
+		},
+		Stdin:           os.Stdin,
+		StdinIsTerminal: reader.IsStdinTerminal,
+		RunREPL:         runREPL,
+	}
+}
+
+type options struct {
+	system       string
+	prompt       string
+	savePath     string
+	outputFormat outputformat.OutputFormat
+}
+
+func Cmd() *cobra.Command {
+	return cmdWithDeps(defaultDeps())
+}
+
+func cmdWithDeps(deps Deps) *cobra.Command {
+	var opts options
+
+	cmd := &cobra.Command{
+		Use:   "chat [llm-id]",
+		Short: "Chat with an LLM",
+		Long: `Chat with a gateway or deployed LLM to check that it answers.
+
+The LLM is the one given by ID or name, else the default chosen with
+'dr llm-gateway select'; with neither, a picker lists the available LLMs.
+
+In a terminal this opens an interactive chat. Replies stream in as they
+are generated and every turn is sent with the conversation so far. Each
+reply is followed by its token usage and latency. Type /help in the chat
+for its commands.
+
+With --prompt, or with a prompt piped on stdin, one reply is streamed to
+stdout and the command exits. Given both, the piped text is appended to
+--prompt.
+
+Example:
+  dr llm-gateway chat
+  dr llm-gateway chat azure/gpt-4o --system "Answer in one sentence."
+  dr llm-gateway chat --prompt "Say hello"
+  git diff | dr llm-gateway chat -p "Write a commit message for this diff"`,
+		Args:         cobra.MaximumNArgs(1),
+		SilenceUsage: true,
+		PreRunE:      auth.EnsureAuthenticatedE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			arg := ""
+			if len(args) == 1 {
+				arg = args[0]
+			}
+
+			return runChat(cmd, arg, opts, deps)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.system, "system", "s", "", "System prompt for the conversation")
+	cmd.Flags().StringVarP(&opts.prompt, "prompt", "p", "", "Send one prompt, print the reply, and exit")
+	cmd.Flags().StringVar(&opts.savePath, "save", "", "Save the transcript as JSON to this file when the chat ends")
+	outputformat.AddFlag(cmd, &opts.outputFormat)
+
+	telemetry.TrackWith(cmd, func(_ *cobra.Command, args []string) map[string]any {
+		return map[string]any{
+			"direct":        len(args) == 1,
+			"one_shot":      opts.prompt != "",
+			"system":        opts.system != "",
+			"output_format": string(opts.outputFormat),
+		}
+	})
+
+	return cmd
+}
+
+func runChat(cmd *cobra.Command, arg string, opts options, deps Deps) error {
+	ctx := cmd.Context()
+	interactive := opts.prompt == "" && deps.StdinIsTerminal()
+
+	if interactive && outputformat.GetFormat(cmd) == outputformat.OutputFormatJSON {
+		return errors.New("--output-format json needs --prompt or a prompt on stdin")
+	}
+
+	catalog := llmgateway.NewCatalog(deps.LoadLLMs, deps.EndpointURL)
+
+	route, err := resolveRoute(ctx, catalog, arg, interactive, deps.Pick)
+	if err != nil {
+		return err
+	}
+
+	s := newSession(route, deps.Client, opts.system)
+
+	if interactive {
+		return deps.RunREPL(ctx, s, opts.savePath)
+	}
+
+	prompt, err := readPrompt(opts.prompt, deps)
+	if err != nil {
+		return err
+	}
+
+	return runOnce(cmd, s, prompt, opts.savePath)
+}
+
+// resolveRoute finds the LLM to chat with: arg, then the default LLM, then
+// the picker when there is a terminal to show it in.
+func resolveRoute(ctx context.Context, catalog *llmgateway.Catalog, arg string, interactive bool, pick func([]drapi.LLM) (string, error)) (llmgateway.Route, error) {
+	model := arg
+	if model == "" {
+		model = viperx.GetString(config.DefaultLLMID)
+	}
+
+	if model == "" {
+		if !interactive {
+			return llmgateway.Route{}, errors.New("no LLM given and no default LLM selected; pass an llm-id or run 'dr llm-gateway select'")
+		}
+
+		llms, err := catalog.LLMs(ctx)
+		if err != nil {
+			return llmgateway.Route{}, err
+		}
+
+		if model, err = pick(llms); err != nil {
+			return llmgateway.Route{}, err
+		}
+	}
+
+	route, err := catalog.Resolve(ctx, model)
+	if errors.Is(err, llmgateway.ErrModelNotFound) {
+		return llmgateway.Route{}, fmt.Errorf("%w; see 'dr llm-gateway list'", err)
+	}
+
+	return route, err
+}
+
+// readPrompt joins --prompt and whatever is piped on stdin.
+func readPrompt(flagPrompt string, deps Deps) (string, error) {
+	parts := []string{}
+
+	if p := strings.TrimSpace(flagPrompt); p != "" {
+		parts = append(parts, p)
+	}
+
+	if !deps.StdinIsTerminal() {
+		piped, err := io.ReadAll(deps.Stdin)
+		if err != nil {
+			return "", fmt.Errorf("read prompt from stdin: %w", err)
+		}
+
+		if p := strings.TrimSpace(string(piped)); p != "" {
+			parts = append(parts, p)
+		}
+	}
+
+	if len(parts) == 0 {
+		return "", errors.New("nothing to send: pass --prompt or pipe a prompt on stdin")
+	}
+
+	return strings.Join(parts, "\n\n"), nil
+}
+
+// runOnce sends a single prompt. In text mode the reply streams to stdout
+// and the stats go to stderr, so the reply can be piped on cleanly.
+func runOnce(cmd *cobra.Command, s *session, prompt, savePath string) error {
+	out := cmd.OutOrStdout()
+	jsonOutput := outputformat.GetFormat(cmd) == outputformat.OutputFormatJSON
+
+	var onDelta func(string)
+
+	if !jsonOutput {
+		onDelta = func(d string) { fmt.Fprint(out, d) }
+	}
+
+	reply, err := s.send(cmd.Context(), prompt, onDelta)
+	if err != nil {
+		return err
+	}
+
+	if savePath != "" {
+		if err := s.save(savePath); err != nil {
+			return err
+		}
+	}
+
+	if jsonOutput {
+		return outputformat.PrintJSONEnvelope(out, "reply", ReplyOutput{
+			LLMID:        s.route.LLM.LlmID,
+			Content:      reply.Content,
+			Usage:        reply.Usage,
+			FirstTokenMS: reply.FirstToken.Milliseconds(),
+			LatencyMS:    reply.Latency.Milliseconds(),
+		})
+	}
+
+	if !strings.HasSuffix(reply.Content, "\n") {
+		fmt.Fprintln(out)
+	}
+
+	fmt.Fprintln(cmd.ErrOrStderr(), tui.DimStyle.Render(s.route.LLM.LlmID+" · "+formatStats(reply)))
+
+	return nil
+}
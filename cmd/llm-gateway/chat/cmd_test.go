@@ -0,0 +1,245 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/datarobot/cli/internal/config"
+	"github.com/datarobot/cli/internal/config/viperx"
+	"github.com/datarobot/cli/internal/drapi"
+	"github.com/datarobot/cli/internal/llmgateway"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testLLMs = []drapi.LLM{
+	{LlmID: "azure/gpt-4o", Name: "GPT-4o", Provider: "azure", Kind: drapi.LLMKindGateway},
+	{LlmID: "dep-1", Name: "support-bot", Kind: drapi.LLMKindDeployed, DeploymentID: "dep-1"},
+}
+
+// fakeCompleter answers every prompt with its reply, streamed in two pieces,
+// and records the messages each call sent.
+type fakeCompleter struct {
+	reply string
+	err   error
+	calls [][]llmgateway.Message
+	model string
+}
+
+func (f *fakeCompleter) Complete(_ context.Context, route llmgateway.Route, messages []llmgateway.Message, onDelta func(string)) (llmgateway.Reply, error) {
+	f.calls = append(f.calls, messages)
+	f.model = route.Model
+
+	if f.err != nil {
+		return llmgateway.Reply{}, f.err
+	}
+
+	if onDelta != nil {
+		half := len(f.reply) / 2
+		onDelta(f.reply[:half])
+		onDelta(f.reply[half:])
+	}
+
+	return llmgateway.Reply{
+		Content:    f.reply,
+		Usage:      &llmgateway.Usage{PromptTokens: 5, CompletionTokens: 2, TotalTokens: 7},
+		FirstToken: 120 * time.Millisecond,
+		Latency:    time.Second,
+	}, nil
+}
+
+type testEnv struct {
+	deps   Deps
+	client *fakeCompleter
+	picked []drapi.LLM
+	repl   *session
+}
+
+func newTestEnv(t *testing.T, stdin string, terminal bool) *testEnv {
+	t.Helper()
+
+	t.Cleanup(viperx.Reset)
+
+	env := &testEnv{client: &fakeCompleter{reply: "Hello there"}}
+
+	env.deps = Deps{
+		LoadLLMs: func(context.Context) ([]drapi.LLM, error) { return testLLMs, nil },
+		EndpointURL: func(path string) (string, error) {
+			return "https://app.example.com" + path, nil
+		},
+		Client: env.client,
+		Pick: func(llms []drapi.LLM) (string, error) {
+			env.picked = llms
+
+			return "dep-1", nil
+		},
+		Stdin:           strings.NewReader(stdin),
+		StdinIsTerminal: func() bool { return terminal },
+		RunREPL: func(_ context.Context, s *session, _ string) error {
+			env.repl = s
+
+			return nil
+		},
+	}
+
+	return env
+}
+
+func runCmd(t *testing.T, deps Deps, args ...string) (string, string, error) {
+	t.Helper()
+
+	cmd := cmdWithDeps(deps)
+	cmd.PreRunE = nil
+
+	var stdout, stderr bytes.Buffer
+
+	cmd.SetOut(&stdout)
+	cmd.SetErr(&stderr)
+	cmd.SetArgs(args)
+	cmd.SetContext(context.Background())
+
+	err := cmd.Execute()
+
+	return stdout.String(), stderr.String(), err
+}
+
+func TestChat_OneShotStreamsReply(t *testing.T) {
+	env := newTestEnv(t, "", true)
+
+	stdout, stderr, err := runCmd(t, env.deps, "GPT-4o", "--prompt", "Say hello", "--system", "Be brief.")
+	require.NoError(t, err)
+
+	assert.Equal(t, "Hello there\n", stdout)
+	assert.Contains(t, stderr, "azure/gpt-4o · 5+2 tokens · first token 120ms · 1s")
+	assert.Equal(t, "azure/gpt-4o", env.client.model)
+	assert.Equal(t, []llmgateway.Message{
+		{Role: llmgateway.RoleSystem, Content: "Be brief."},
+		{Role: llmgateway.RoleUser, Content: "Say hello"},
+	}, env.client.calls[0])
+}
+
+func TestChat_OneShotJoinsPromptAndStdin(t *testing.T) {
+	env := newTestEnv(t, "diff --git a/x b/x\n", false)
+	viperx.Set(config.DefaultLLMID, "dep-1")
+
+	_, _, err := runCmd(t, env.deps, "-p", "Write a commit message")
+	require.NoError(t, err)
+
+	require.Len(t, env.client.calls, 1)
+	assert.Equal(t, "Write a commit message\n\ndiff --git a/x b/x", env.client.calls[0][0].Content)
+	assert.Equal(t, "datarobot-deployed-llm", env.client.model)
+}
+
+func TestChat_OneShotJSON(t *testing.T) {
+	env := newTestEnv(t, "Say hello", false)
+
+	stdout, _, err := runCmd(t, env.deps, "azure/gpt-4o", "--output-format", "json")
+	require.NoError(t, err)
+
+	var got struct {
+		Reply ReplyOutput `json:"reply"`
+	}
+
+	require.NoError(t, json.Unmarshal([]byte(stdout), &got))
+	assert.Equal(t, "azure/gpt-4o", got.Reply.LLMID)
+	assert.Equal(t, "Hello there", got.Reply.Content)
+	assert.Equal(t, 7, got.Reply.Usage.TotalTokens)
+	assert.Equal(t, int64(1000), got.Reply.LatencyMS)
+}
+
+func TestChat_OneShotSavesTranscript(t *testing.T) {
+	env := newTestEnv(t, "", true)
+	path := filepath.Join(t.TempDir(), "chat.json")
+
+	_, _, err := runCmd(t, env.deps, "azure/gpt-4o", "-p", "Hi", "-s", "Be brief.", "--save", path)
+	require.NoError(t, err)
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	var transcript Transcript
+
+	require.NoError(t, json.Unmarshal(data, &transcript))
+	assert.Equal(t, "azure/gpt-4o", transcript.LLMID)
+	assert.Equal(t, "Be brief.", transcript.System)
+	require.Len(t, transcript.Messages, 2)
+	assert.Equal(t, "Hello there", transcript.Messages[1].Content)
+	assert.Equal(t, int64(1000), transcript.Messages[1].LatencyMS)
+	assert.Equal(t, 7, transcript.Usage.TotalTokens)
+}
+
+func TestChat_Errors(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		stdin    string
+		terminal bool
+		args     []string
+		want     string
+	}{
+		{"no model without a terminal", "Hi", false, nil, "no LLM given and no default LLM selected"},
+		{"unknown model", "", true, []string{"nope", "-p", "Hi"}, `model not found: "nope"; see 'dr llm-gateway list'`},
+		{"empty prompt", "  \n", false, []string{"azure/gpt-4o"}, "nothing to send"},
+		{"json without a prompt", "", true, []string{"azure/gpt-4o", "--output-format", "json"}, "--output-format json needs --prompt"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			env := newTestEnv(t, tc.stdin, tc.terminal)
+
+			_, _, err := runCmd(t, env.deps, tc.args...)
+			require.ErrorContains(t, err, tc.want)
+			assert.Empty(t, env.client.calls)
+		})
+	}
+}
+
+func TestChat_FailedReply(t *testing.T) {
+	env := newTestEnv(t, "", true)
+	env.client.err = errors.New("LLM request failed: 429 Too Many Requests: slow down")
+
+	stdout, _, err := runCmd(t, env.deps, "azure/gpt-4o", "-p", "Hi")
+	require.ErrorContains(t, err, "slow down")
+	assert.Empty(t, stdout)
+}
+
+func TestChat_InteractivePicksWithoutDefault(t *testing.T) {
+	env := newTestEnv(t, "", true)
+
+	_, _, err := runCmd(t, env.deps, "--system", "Be brief.")
+	require.NoError(t, err)
+
+	assert.Equal(t, testLLMs, env.picked)
+	require.NotNil(t, env.repl)
+	assert.Equal(t, "dep-1", env.repl.route.LLM.LlmID)
+	assert.Equal(t, "Be brief.", env.repl.system)
+}
+
+func TestChat_InteractiveUsesDefault(t *testing.T) {
+	env := newTestEnv(t, "", true)
+	viperx.Set(config.DefaultLLMID, "azure/gpt-4o")
+
+	_, _, err := runCmd(t, env.deps)
+	require.NoError(t, err)
+
+	assert.Nil(t, env.picked)
+	assert.Equal(t, "azure/gpt-4o", env.repl.route.LLM.LlmID)
+}
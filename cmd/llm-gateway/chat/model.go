@@ -0,0 +1,257 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/datarobot/cli/internal/llmgateway"
+	"github.com/datarobot/cli/tui"
+)
+
+const helpText = `/system <text>  set the system prompt; /system alone clears it
+/clear          start a new conversation, keeping the system prompt
+/save [file]    save the transcript as JSON
+/exit           leave the chat (also Ctrl+D on an empty line, or Ctrl+C)`
+
+var modelNameStyle = tui.BaseTextStyle.Bold(true)
+
+// deltaMsg carries a piece of the reply being streamed.
+type deltaMsg string
+
+// replyMsg ends a turn. Stopped is set when the user cancelled it with Esc.
+type replyMsg struct {
+	reply   llmgateway.Reply
+	err     error
+	stopped bool
+}
+
+// chatModel is the interactive chat. Finished turns are printed above the
+// program with tea.Println, so they stay in the terminal's scrollback; the
+// view holds only the reply being streamed or the input line.
+type chatModel struct {
+	ctx      context.Context
+	session  *session
+	savePath string
+	now      func() time.Time
+
+	input     textinput.Model
+	streaming bool
+	partial   string
+	cancel    context.CancelFunc
+	events    chan tea.Msg
+}
+
+func newChatModel(ctx context.Context, s *session, savePath string) chatModel {
+	ti := textinput.New()
+	ti.Prompt = "› "
+	ti.PromptStyle = tui.InfoStyle
+	ti.Placeholder = "Send a message"
+	ti.Focus()
+
+	return chatModel{ctx: ctx, session: s, savePath: savePath, now: time.Now, input: ti}
+}
+
+// runREPL runs the interactive chat until the user leaves, then saves the
+// transcript if savePath is set.
+func runREPL(ctx context.Context, s *session, savePath string) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	fmt.Println(modelNameStyle.Render("Chatting with "+s.route.LLM.Name) + " " + tui.DimStyle.Render("("+s.route.LLM.LlmID+")"))
+
+	if s.system != "" {
+		fmt.Println(tui.DimStyle.Render("System prompt: " + s.system))
+	}
+
+	fmt.Println()
+
+	if _, err := tui.Run(newChatModel(ctx, s, savePath)); err != nil {
+		return err
+	}
+
+	if savePath == "" || len(s.messages) == 0 {
+		return nil
+	}
+
+	if err := s.save(savePath); err != nil {
+		return err
+	}
+
+	fmt.Println(tui.SuccessStyle.Render("Transcript saved to " + savePath))
+
+	return nil
+}
+
+func (m chatModel) Init() tea.Cmd {
+	return textinput.Blink
+}
+
+func (m chatModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.input.Width = max(msg.Width-4, 20)
+
+		return m, nil
+
+	case deltaMsg:
+		m.partial += string(msg)
+
+		return m, waitForEvent(m.events)
+
+	case replyMsg:
+		return m.finishTurn(msg)
+
+	case tea.KeyMsg:
+		if m.streaming {
+			if msg.Type == tea.KeyEsc {
+				m.cancel()
+			}
+
+			return m, nil
+		}
+
+		switch msg.Type {
+		case tea.KeyEnter:
+			return m.submit()
+		case tea.KeyCtrlD:
+			if m.input.Value() == "" {
+				return m, tea.Quit
+			}
+		}
+	}
+
+	var cmd tea.Cmd
+
+	m.input, cmd = m.input.Update(msg)
+
+	return m, cmd
+}
+
+func (m chatModel) View() string {
+	if m.streaming {
+		return modelNameStyle.Render(m.session.route.LLM.Name) + "\n" + m.partial + "\n" +
+			tui.HintStyle.Render("Esc to stop")
+	}
+
+	return m.input.View() + "\n" + tui.HintStyle.Render("Enter to send · /help for commands · Ctrl+C to quit")
+}
+
+func (m chatModel) submit() (tea.Model, tea.Cmd) {
+	text := strings.TrimSpace(m.input.Value())
+	m.input.Reset()
+
+	if text == "" {
+		return m, nil
+	}
+
+	if strings.HasPrefix(text, "/") {
+		return m.runCommand(text)
+	}
+
+	turnCtx, cancel := context.WithCancel(m.ctx)
+	events := make(chan tea.Msg)
+
+	m.streaming, m.partial, m.cancel, m.events = true, "", cancel, events
+
+	go func() {
+		defer cancel()
+
+		reply, err := m.session.send(turnCtx, text, func(d string) {
+			select {
+			case events <- deltaMsg(d):
+			case <-turnCtx.Done():
+			}
+		})
+
+		// The turn context is cancelled by Esc as well as on exit; only the
+		// chat's own context going away means nobody is left to read this.
+		select {
+		case events <- replyMsg{reply: reply, err: err, stopped: turnCtx.Err() != nil}:
+		case <-m.ctx.Done():
+		}
+	}()
+
+	return m, tea.Sequence(tea.Println(m.input.PromptStyle.Render(m.input.Prompt)+text), waitForEvent(events))
+}
+
+func waitForEvent(events chan tea.Msg) tea.Cmd {
+	return func() tea.Msg {
+		return <-events
+	}
+}
+
+func (m chatModel) finishTurn(msg replyMsg) (tea.Model, tea.Cmd) {
+	partial := m.partial
+	m.streaming, m.partial, m.cancel, m.events = false, "", nil, nil
+
+	name := modelNameStyle.Render(m.session.route.LLM.Name)
+
+	switch {
+	case msg.stopped:
+		return m, tea.Println(name + "\n" + partial + "\n" +
+			tui.WarnStyle.Render("Stopped.") + " " + tui.DimStyle.Render("The reply was not kept in the conversation.") + "\n")
+	case msg.err != nil:
+		return m, tea.Println(tui.ErrorStyle.Render("Error: ") + msg.err.Error() + "\n")
+	}
+
+	return m, tea.Println(name + "\n" + msg.reply.Content + "\n" + tui.DimStyle.Render(formatStats(msg.reply)) + "\n")
+}
+
+func (m chatModel) runCommand(line string) (tea.Model, tea.Cmd) {
+	name, arg, _ := strings.Cut(line, " ")
+	arg = strings.TrimSpace(arg)
+
+	switch name {
+	case "/exit", "/quit":
+		return m, tea.Quit
+	case "/help":
+		return m, tea.Println(tui.DimStyle.Render(helpText))
+	case "/clear":
+		m.session.reset()
+
+		return m, tea.Println(tui.InfoStyle.Render("Started a new conversation."))
+	case "/system":
+		m.session.system = arg
+
+		if arg == "" {
+			return m, tea.Println(tui.InfoStyle.Render("System prompt cleared."))
+		}
+
+		return m, tea.Println(tui.InfoStyle.Render("System prompt set for the next message."))
+	case "/save":
+		path := arg
+		if path == "" {
+			path = m.savePath
+		}
+
+		if path == "" {
+			path = "chat-" + m.now().Format("20060102-150405") + ".json"
+		}
+
+		if err := m.session.save(path); err != nil {
+			return m, tea.Println(tui.ErrorStyle.Render("Error: ") + err.Error())
+		}
+
+		return m, tea.Println(tui.SuccessStyle.Render("Transcript saved to " + path))
+	}
+
+	return m, tea.Println(tui.ErrorStyle.Render("Unknown command "+name) + tui.DimStyle.Render("; type /help"))
+}
@@ -0,0 +1,146 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/datarobot/cli/internal/llmgateway"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// blockingCompleter streams one piece and then waits to be cancelled.
+type blockingCompleter struct{}
+
+func (blockingCompleter) Complete(ctx context.Context, _ llmgateway.Route, _ []llmgateway.Message, onDelta func(string)) (llmgateway.Reply, error) {
+	onDelta("Hel")
+	<-ctx.Done()
+
+	return llmgateway.Reply{}, ctx.Err()
+}
+
+func newTestModel(t *testing.T, client completer) chatModel {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	route := llmgateway.Route{LLM: testLLMs[0], Model: testLLMs[0].LlmID}
+
+	return newChatModel(ctx, newSession(route, client, ""), "")
+}
+
+// typeLine enters text at the prompt and presses Enter.
+func typeLine(m chatModel, text string) (chatModel, tea.Cmd) {
+	next, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(text)})
+	next, cmd := next.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	return next.(chatModel), cmd
+}
+
+// nextEvent feeds the next event of the turn in flight to the model.
+func nextEvent(t *testing.T, m chatModel) (chatModel, tea.Msg) {
+	t.Helper()
+
+	require.NotNil(t, m.events, "no turn in flight")
+
+	msg := waitForEvent(m.events)()
+	next, _ := m.Update(msg)
+
+	return next.(chatModel), msg
+}
+
+func TestChatModel_StreamsTurnAndKeepsHistory(t *testing.T) {
+	m := newTestModel(t, &fakeCompleter{reply: "Hello there"})
+
+	m, _ = typeLine(m, "Hi")
+	require.True(t, m.streaming)
+
+	m, _ = nextEvent(t, m)
+	assert.Equal(t, "Hello", m.partial)
+	assert.Contains(t, m.View(), "Hello")
+	assert.Contains(t, m.View(), "Esc to stop")
+
+	m, _ = nextEvent(t, m)
+	m, msg := nextEvent(t, m)
+	require.IsType(t, replyMsg{}, msg)
+
+	assert.False(t, m.streaming)
+	assert.Empty(t, m.partial)
+	require.Len(t, m.session.messages, 2)
+	assert.Equal(t, "Hello there", m.session.messages[1].Content)
+}
+
+func TestChatModel_EscStopsTurn(t *testing.T) {
+	m := newTestModel(t, blockingCompleter{})
+
+	m, _ = typeLine(m, "Hi")
+	m, _ = nextEvent(t, m)
+	assert.Equal(t, "Hel", m.partial)
+
+	next, _ := m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	m, msg := nextEvent(t, next.(chatModel))
+
+	reply, ok := msg.(replyMsg)
+	require.True(t, ok)
+	assert.True(t, reply.stopped)
+	assert.False(t, m.streaming)
+	assert.Empty(t, m.session.messages, "a stopped turn is not kept")
+}
+
+func TestChatModel_Commands(t *testing.T) {
+	m := newTestModel(t, &fakeCompleter{reply: "ok"})
+	m.session.messages = []TranscriptMessage{{Role: llmgateway.RoleUser, Content: "Hi"}}
+
+	m, _ = typeLine(m, "/system Answer in French.")
+	assert.Equal(t, "Answer in French.", m.session.system)
+
+	path := filepath.Join(t.TempDir(), "t.json")
+	m, _ = typeLine(m, "/save "+path)
+	assert.FileExists(t, path)
+
+	m, _ = typeLine(m, "/clear")
+	assert.Empty(t, m.session.messages)
+	assert.Equal(t, "Answer in French.", m.session.system, "/clear keeps the system prompt")
+
+	m, cmd := typeLine(m, "/bogus")
+	assert.False(t, isQuit(cmd))
+	assert.False(t, m.streaming)
+
+	_, cmd = typeLine(m, "/exit")
+	assert.True(t, isQuit(cmd))
+}
+
+func TestChatModel_CtrlDQuitsOnEmptyLine(t *testing.T) {
+	m := newTestModel(t, &fakeCompleter{})
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyCtrlD})
+	assert.True(t, isQuit(cmd))
+}
+
+// isQuit invokes cmd and reports whether it produced a tea.QuitMsg.
+func isQuit(cmd tea.Cmd) bool {
+	if cmd == nil {
+		return false
+	}
+
+	_, ok := cmd().(tea.QuitMsg)
+
+	return ok
+}
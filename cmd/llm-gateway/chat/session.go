@@ -0,0 +1,156 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/datarobot/cli/internal/llmgateway"
+)
+
+// completer sends one chat completion; *llmgateway.Client implements it.
+type completer interface {
+	Complete(ctx context.Context, route llmgateway.Route, messages []llmgateway.Message, onDelta func(string)) (llmgateway.Reply, error)
+}
+
+// Transcript is the JSON a chat is saved as.
+type Transcript struct {
+	LLMID     string              `json:"llm_id"`
+	Name      string              `json:"name"`
+	StartedAt time.Time           `json:"started_at"`
+	System    string              `json:"system,omitempty"`
+	Messages  []TranscriptMessage `json:"messages"`
+	Usage     llmgateway.Usage    `json:"usage"`
+}
+
+// TranscriptMessage is one message of a Transcript. Replies carry their
+// token usage and latency.
+type TranscriptMessage struct {
+	Role      string            `json:"role"`
+	Content   string            `json:"content"`
+	Usage     *llmgateway.Usage `json:"usage,omitempty"`
+	LatencyMS int64             `json:"latency_ms,omitempty"`
+}
+
+// session is one conversation with one model. Each prompt is sent with the
+// whole history, so the model sees every earlier turn.
+type session struct {
+	route     llmgateway.Route
+	client    completer
+	system    string
+	messages  []TranscriptMessage
+	startedAt time.Time
+}
+
+func newSession(route llmgateway.Route, client completer, system string) *session {
+	return &session{route: route, client: client, system: system, startedAt: time.Now()}
+}
+
+// send asks the model to answer prompt, streaming the answer to onDelta. A
+// failed or cancelled turn is dropped from the history, so it can be retried.
+func (s *session) send(ctx context.Context, prompt string, onDelta func(string)) (llmgateway.Reply, error) {
+	messages := make([]llmgateway.Message, 0, len(s.messages)+2)
+
+	if s.system != "" {
+		messages = append(messages, llmgateway.Message{Role: llmgateway.RoleSystem, Content: s.system})
+	}
+
+	for _, m := range s.messages {
+		messages = append(messages, llmgateway.Message{Role: m.Role, Content: m.Content})
+	}
+
+	messages = append(messages, llmgateway.Message{Role: llmgateway.RoleUser, Content: prompt})
+
+	reply, err := s.client.Complete(ctx, s.route, messages, onDelta)
+	if err != nil {
+		return reply, err
+	}
+
+	s.messages = append(s.messages,
+		TranscriptMessage{Role: llmgateway.RoleUser, Content: prompt},
+		TranscriptMessage{
+			Role:      llmgateway.RoleAssistant,
+			Content:   reply.Content,
+			Usage:     reply.Usage,
+			LatencyMS: reply.Latency.Milliseconds(),
+		},
+	)
+
+	return reply, nil
+}
+
+// reset forgets the conversation but keeps the system prompt.
+func (s *session) reset() {
+	s.messages = nil
+}
+
+func (s *session) transcript() Transcript {
+	t := Transcript{
+		LLMID:     s.route.LLM.LlmID,
+		Name:      s.route.LLM.Name,
+		StartedAt: s.startedAt.UTC(),
+		System:    s.system,
+		Messages:  s.messages,
+	}
+
+	if t.Messages == nil {
+		t.Messages = []TranscriptMessage{}
+	}
+
+	for _, m := range s.messages {
+		if m.Usage != nil {
+			t.Usage.PromptTokens += m.Usage.PromptTokens
+			t.Usage.CompletionTokens += m.Usage.CompletionTokens
+			t.Usage.TotalTokens += m.Usage.TotalTokens
+		}
+	}
+
+	return t
+}
+
+// save writes the transcript to path as indented JSON.
+func (s *session) save(path string) error {
+	data, err := json.MarshalIndent(s.transcript(), "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(path, append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("save transcript: %w", err)
+	}
+
+	return nil
+}
+
+// formatStats renders a reply's token usage and timing, e.g.
+// "12+48 tokens · first token 310ms · 1.2s".
+func formatStats(reply llmgateway.Reply) string {
+	tokens := "tokens not reported"
+	if reply.Usage != nil {
+		tokens = fmt.Sprintf("%d+%d tokens", reply.Usage.PromptTokens, reply.Usage.CompletionTokens)
+	}
+
+	stats := tokens
+
+	if reply.FirstToken > 0 {
+		stats += " · first token " + reply.FirstToken.Round(time.Millisecond).String()
+	}
+
+	return stats + " · " + reply.Latency.Round(time.Millisecond).String()
+}
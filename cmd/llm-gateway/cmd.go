@@ -15,8 +15,10 @@
 package llmgateway
 
 import (
+	"github.com/datarobot/cli/cmd/llm-gateway/chat"
 	"github.com/datarobot/cli/cmd/llm-gateway/list"
 	selectcmd "github.com/datarobot/cli/cmd/llm-gateway/select"
+	"github.com/datarobot/cli/cmd/llm-gateway/serve"
 	"github.com/spf13/cobra"
 )
 
@@ -28,7 +30,7 @@ func Cmd() *cobra.Command {
 		Short:   "Manage LLM Gateway models",
 	}
 
-	cmd.AddCommand(list.Cmd(), selectcmd.Cmd())
+	cmd.AddCommand(list.Cmd(), selectcmd.Cmd(), serve.Cmd(), chat.Cmd())
 
 	return cmd
 }
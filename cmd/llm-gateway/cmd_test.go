@@ -43,6 +43,8 @@ func TestCmd_Subcommands(t *testing.T) {
 
 	assert.True(t, names["list"], "expected 'list' subcommand")
 	assert.True(t, names["select"], "expected 'select' subcommand")
+	assert.True(t, names["serve"], "expected 'serve' subcommand")
+	assert.True(t, names["chat"], "expected 'chat' subcommand")
 }
 
 func TestCmd_GroupID(t *testing.T) {
@@ -61,7 +61,7 @@ func Cmd() *cobra.Command {
 		SilenceUsage: true,
 		PreRunE:      auth.EnsureAuthenticatedE,
 		RunE: func(cmd *cobra.Command, _ []string) error {
-			llmList, err := fetchLLMs(source)
+			llmList, err := fetchLLMs(cmd.Context(), source)
 			if err != nil {
 				return err
 			}
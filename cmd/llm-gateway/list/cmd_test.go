@@ -16,6 +16,7 @@ package list
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -565,7 +566,7 @@ func TestFetchLLMs_CountMatchesRowsAcrossSources(t *testing.T) {
 	for _, source := range []Source{SourceGateway, SourceDeployed, SourceAll} {
 		catalogPage = 0
 
-		got, err := fetchLLMs(source)
+		got, err := fetchLLMs(context.Background(), source)
 		require.NoError(t, err, source)
 
 		assert.Equal(t, len(got.LLMs), got.Count, "Count for --source %s", source)
@@ -15,6 +15,7 @@
 package list
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/datarobot/cli/internal/drapi"
@@ -63,9 +64,9 @@ func (s *Source) Type() string {
 // list. The union path can fall back on the other source; here there is no
 // remainder to show, and "no models" for an unreachable catalog reads as an
 // empty instance.
-func fetchLLMs(source Source) (*drapi.LLMList, error) {
+func fetchLLMs(ctx context.Context, source Source) (*drapi.LLMList, error) {
 	if source == SourceGateway {
-		gateway, err := drapi.GetLLMs()
+		gateway, err := drapi.GetLLMs(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -79,7 +80,7 @@ func fetchLLMs(source Source) (*drapi.LLMList, error) {
 	}
 
 	if source == SourceDeployed {
-		deployed, err := drapi.GetDeployedLLMs()
+		deployed, err := drapi.GetDeployedLLMs(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -88,5 +89,5 @@ func fetchLLMs(source Source) (*drapi.LLMList, error) {
 	}
 
 	// SourceAll, and a zero value that never went through Set.
-	return drapi.GetLLMsAndDeployed()
+	return drapi.GetLLMsAndDeployed(ctx)
 }
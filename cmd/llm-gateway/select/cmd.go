@@ -37,7 +37,7 @@ func Cmd() *cobra.Command {
 		SilenceUsage: true,
 		PreRunE:      auth.EnsureAuthenticatedE,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			llmList, err := drapi.GetLLMsAndDeployed()
+			llmList, err := drapi.GetLLMsAndDeployed(cmd.Context())
 			if err != nil {
 				return err
 			}
@@ -47,7 +47,7 @@ func Cmd() *cobra.Command {
 			if len(args) == 1 {
 				chosenID, err = findByID(llmList.LLMs, args[0])
 			} else {
-				chosenID, err = runPicker(llmList.LLMs)
+				chosenID, err = RunPicker(llmList.LLMs, "Select Default LLM")
 			}
 
 			if err != nil {
@@ -92,12 +92,15 @@ func findByID(llms []drapi.LLM, id string) (string, error) {
 	return "", fmt.Errorf("LLM %q not found", id)
 }
 
-func runPicker(llms []drapi.LLM) (string, error) {
+// RunPicker shows the LLM picker under title and returns the chosen LLM ID.
+// Other llm-gateway commands use it to ask for a model when none is given.
+func RunPicker(llms []drapi.LLM, title string) (string, error) {
 	if len(llms) == 0 {
 		return "", errors.New("no active LLMs available")
 	}
 
 	m := NewPickerModel(llms)
+	m.list.Title = title
 
 	finalModel, err := tui.Run(m, tea.WithAltScreen())
 	if err != nil {
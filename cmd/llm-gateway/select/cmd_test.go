@@ -187,10 +187,10 @@ func TestSelectCmd_DirectArg_APIError(t *testing.T) {
 	assert.Error(t, err)
 }
 
-// --- runPicker edge case (no TUI) ---
+// --- RunPicker edge case (no TUI) ---
 
 func TestRunPicker_EmptyCatalog(t *testing.T) {
-	_, err := runPicker(nil)
+	_, err := RunPicker(nil, "Select Default LLM")
 
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "no active LLMs")
@@ -0,0 +1,231 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serve
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/datarobot/cli/internal/auth"
+	"github.com/datarobot/cli/internal/config"
+	"github.com/datarobot/cli/internal/config/viperx"
+	"github.com/datarobot/cli/internal/drapi"
+	"github.com/datarobot/cli/internal/llmgateway"
+	"github.com/datarobot/cli/internal/log"
+	"github.com/datarobot/cli/internal/telemetry"
+	"github.com/datarobot/cli/tui"
+	"github.com/spf13/cobra"
+)
+
+// DefaultPort is where `serve` listens unless --port says otherwise.
+const DefaultPort = 4000
+
+// shutdownTimeout is how long in-flight requests get to finish after
+// Ctrl+C. Streams longer than that are cut.
+const shutdownTimeout = 5 * time.Second
+
+func Cmd() *cobra.Command {
+	var (
+		host        string
+		port        int
+		model       string
+		allowRemote bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "serve",
+		Short: "Run a local OpenAI-compatible server for DataRobot LLMs",
+		Long: `Run a local HTTP server that speaks the OpenAI chat completions API and
+forwards each request to a DataRobot LLM with your CLI credentials.
+
+The request's "model" picks the LLM: any ID or name 'dr llm-gateway list'
+shows. Gateway models go through the LLM Gateway; deployed LLMs go to
+their deployment. Requests without a model use --model, or the LLM chosen
+with 'dr llm-gateway select'. Streaming responses are passed through as
+they arrive, and each request is logged with its status, latency and
+token usage.
+
+Every caller acts as you: requests are forwarded with your DataRobot
+credentials whoever sends them. The server therefore listens on
+127.0.0.1 only, and refuses a --host other machines can reach unless
+--allow-remote is also given.
+
+Point any OpenAI SDK at the server; the API key it sends is ignored:
+  export OPENAI_BASE_URL=http://127.0.0.1:4000/v1
+  export OPENAI_API_KEY=unused
+
+Streamed responses report token usage only when the request sets
+stream_options.include_usage.
+
+Example:
+  dr llm-gateway serve
+  dr llm-gateway serve --port 8080 --model azure/gpt-4o`,
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		PreRunE:      auth.EnsureAuthenticatedE,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if model == "" {
+				model = viperx.GetString(config.DefaultLLMID)
+			}
+
+			if !isLoopback(host) {
+				if !allowRemote {
+					return fmt.Errorf("--host %s lets other machines call DataRobot as you; pass --allow-remote to listen on it anyway", host)
+				}
+
+				fmt.Fprintln(cmd.ErrOrStderr(), tui.WarnStyle.Render(
+					"WARNING: listening on "+host+". Anyone who can reach this port calls DataRobot with your credentials."))
+			}
+
+			listener, err := net.Listen("tcp", net.JoinHostPort(host, strconv.Itoa(port)))
+			if err != nil {
+				return fmt.Errorf("listen on %s:%d: %w", host, port, err)
+			}
+
+			catalog := llmgateway.NewCatalog(llmgateway.LoadLLMs, config.GetEndpointURL)
+
+			return serve(cmd.Context(), listener, host, catalog, model, cmd.OutOrStdout(), cmd.ErrOrStderr())
+		},
+	}
+
+	cmd.Flags().StringVar(&host, "host", "127.0.0.1", "Address to listen on; every caller acts as the logged-in user")
+	cmd.Flags().BoolVar(&allowRemote, "allow-remote", false, "Allow a --host other machines can reach")
+	cmd.Flags().IntVarP(&port, "port", "p", DefaultPort, "Port to listen on")
+	cmd.Flags().StringVar(&model, "model", "", "LLM for requests that name none (default: the selected LLM)")
+
+	telemetry.TrackWith(cmd, func(_ *cobra.Command, _ []string) map[string]any {
+		return map[string]any{
+			"port":          port,
+			"default_model": model != "",
+			"allow_remote":  allowRemote,
+		}
+	})
+
+	return cmd
+}
+
+// isLoopback reports whether host only accepts connections from this
+// machine.
+func isLoopback(host string) bool {
+	if strings.EqualFold(host, "localhost") {
+		return true
+	}
+
+	ip := net.ParseIP(strings.Trim(host, "[]"))
+
+	return ip != nil && ip.IsLoopback()
+}
+
+// serve runs the proxy on listener, which is bound to host, until ctx is
+// cancelled. The catalog is loaded before the first request so bad
+// credentials fail here rather than on every call.
+func serve(ctx context.Context, listener net.Listener, host string, catalog *llmgateway.Catalog, model string, out, logOut io.Writer) error {
+	if _, err := catalog.LLMs(ctx); err != nil {
+		_ = listener.Close()
+
+		return err
+	}
+
+	if model != "" {
+		if _, err := catalog.Resolve(ctx, model); err != nil {
+			_ = listener.Close()
+
+			return fmt.Errorf("default model: %w", err)
+		}
+	}
+
+	server := &http.Server{
+		Handler: llmgateway.NewProxy(llmgateway.ProxyOptions{
+			Catalog:      catalog,
+			Host:         host,
+			DefaultModel: model,
+			Authorize:    drapi.AuthorizeRequest,
+			Client:       drapi.NewHTTPClient(0),
+			OnRequest: func(r llmgateway.Request) {
+				fmt.Fprintln(logOut, formatRequest(time.Now(), r))
+			},
+		}),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	baseURL := "http://" + listener.Addr().String() + "/v1"
+
+	fmt.Fprintln(out, tui.SuccessStyle.Render("Serving DataRobot LLMs at "+baseURL))
+
+	if model != "" {
+		fmt.Fprintln(out, tui.DimStyle.Render("Default model: "+model))
+	}
+
+	fmt.Fprintln(out, tui.HintStyle.Render("Set OPENAI_BASE_URL="+baseURL+" and any OPENAI_API_KEY. Press Ctrl+C to stop."))
+
+	errCh := make(chan error, 1)
+
+	go func() {
+		errCh <- server.Serve(listener)
+	}()
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		log.Debug("llm-gateway server shutdown", "err", err)
+	}
+
+	if err := <-errCh; err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+
+	return nil
+}
+
+// formatRequest renders the log line for one forwarded request.
+func formatRequest(at time.Time, r llmgateway.Request) string {
+	status := strconv.Itoa(r.Status)
+	if r.Status >= http.StatusBadRequest {
+		status = tui.ErrorStyle.Render(status)
+	}
+
+	tokens := "-"
+	if r.Usage != nil {
+		tokens = fmt.Sprintf("%d+%d tokens", r.Usage.PromptTokens, r.Usage.CompletionTokens)
+	}
+
+	mode := "sync"
+	if r.Stream {
+		mode = "stream"
+	}
+
+	line := fmt.Sprintf("%s  %s  %s  %s  %s  %s",
+		at.Format(time.TimeOnly), status, r.Model, mode, tokens, r.Latency.Round(time.Millisecond))
+
+	if r.Err != nil {
+		line += "  " + tui.DimStyle.Render(r.Err.Error())
+	}
+
+	return line
+}
@@ -0,0 +1,167 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serve
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/datarobot/cli/internal/drapi"
+	"github.com/datarobot/cli/internal/llmgateway"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// syncBuffer is a bytes.Buffer safe to write from the server's handlers
+// while the test reads it.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.buf.String()
+}
+
+func TestServe_ForwardsWithCLITokenAndStops(t *testing.T) {
+	drapi.SetToken("dr-token")
+	t.Cleanup(func() { drapi.SetToken("") })
+
+	var gotAuth string
+
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"choices":[],"usage":{"prompt_tokens":3,"completion_tokens":4,"total_tokens":7}}`)
+	}))
+	t.Cleanup(upstream.Close)
+
+	catalog := llmgateway.NewCatalog(
+		func(context.Context) ([]drapi.LLM, error) {
+			return []drapi.LLM{{LlmID: "azure/gpt-4o", Kind: drapi.LLMKindGateway}}, nil
+		},
+		func(path string) (string, error) { return upstream.URL + path, nil },
+	)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var out, logOut syncBuffer
+
+	done := make(chan error, 1)
+
+	go func() { done <- serve(ctx, listener, "127.0.0.1", catalog, "azure/gpt-4o", &out, &logOut) }()
+
+	url := "http://" + listener.Addr().String() + "/v1/chat/completions"
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, url, strings.NewReader(`{"messages":[]}`))
+	require.NoError(t, err)
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "Bearer dr-token", gotAuth)
+
+	cancel()
+
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(10 * time.Second):
+		t.Fatal("serve did not stop after cancel")
+	}
+
+	assert.Contains(t, out.String(), "/v1")
+	assert.Contains(t, logOut.String(), "azure/gpt-4o")
+	assert.Contains(t, logOut.String(), "3+4 tokens")
+}
+
+func TestServe_UnknownDefaultModel(t *testing.T) {
+	catalog := llmgateway.NewCatalog(
+		func(context.Context) ([]drapi.LLM, error) { return nil, nil },
+		func(path string) (string, error) { return path, nil },
+	)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	err = serve(context.Background(), listener, "127.0.0.1", catalog, "missing", &bytes.Buffer{}, &bytes.Buffer{})
+	require.ErrorIs(t, err, llmgateway.ErrModelNotFound)
+}
+
+func TestFormatRequest(t *testing.T) {
+	at := time.Date(2026, 1, 2, 15, 4, 5, 0, time.UTC)
+
+	line := formatRequest(at, llmgateway.Request{
+		Model:   "support-bot",
+		Stream:  true,
+		Status:  http.StatusOK,
+		Latency: 1234 * time.Millisecond,
+	})
+
+	assert.Equal(t, "15:04:05  200  support-bot  stream  -  1.234s", line)
+}
+
+func TestIsLoopback(t *testing.T) {
+	for host, want := range map[string]bool{
+		"127.0.0.1": true,
+		"127.0.0.2": true,
+		"localhost": true,
+		"::1":       true,
+		"[::1]":     true,
+		"0.0.0.0":   false,
+		"::":        false,
+		"10.0.0.5":  false,
+		"gpu-box":   false,
+	} {
+		assert.Equal(t, want, isLoopback(host), host)
+	}
+}
+
+func TestCmd_RemoteHostNeedsAllowRemote(t *testing.T) {
+	cmd := Cmd()
+	cmd.PreRunE = nil
+	cmd.SetArgs([]string{"--host", "0.0.0.0", "--port", "0"})
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	err := cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--allow-remote")
+}
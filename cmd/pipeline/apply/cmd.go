@@ -0,0 +1,199 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package apply implements `dr pipeline apply`: read a pipeline bundle, plan
+// what differs from the server, and create or update only that. The command
+// is the shell; the reconcile lives in internal/pipeline/apply.
+package apply
+
+import (
+	"fmt"
+
+	"github.com/datarobot/cli/internal/auth"
+	"github.com/datarobot/cli/internal/outputformat"
+	"github.com/datarobot/cli/internal/pipeline/apply"
+	"github.com/datarobot/cli/internal/telemetry"
+	"github.com/spf13/cobra"
+)
+
+// Seams, swapped by this package's tests so the shell can be checked
+// without a network.
+var (
+	buildFn = apply.Build
+	applyFn = apply.Apply
+)
+
+// applyResult is the stable JSON shape emitted by --output-format json.
+type applyResult struct {
+	PipelineID string            `json:"pipelineId"`
+	ImageID    string            `json:"imageId,omitempty"`
+	Version    *int              `json:"version"`
+	Inputs     map[string]string `json:"inputs"`
+	Schedules  map[string]string `json:"schedules"`
+	StateFile  string            `json:"stateFile"`
+	DryRun     bool              `json:"dryRun"`
+	Plan       []apply.Step      `json:"plan"`
+}
+
+func Cmd() *cobra.Command {
+	var (
+		outputFormat outputformat.OutputFormat
+		file         string
+		dryRun       bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "apply -f <bundle.yaml>",
+		Short: "Create or update a pipeline, its image, inputs and schedules from a bundle",
+		Long: `Read a pipeline bundle, compare it with what the pipelines service holds,
+and create or update only what differs.
+
+A bundle is one YAML file describing the pipeline's source file, its
+execution image, its named input sets and its schedules:
+
+  name: churn-scoring
+  description: Nightly churn scoring
+  source: pipeline.py            # relative to the bundle
+  image:
+    pythonVersion: "3.12"
+    pip: [pandas==2.2.0]
+    conda: [numpy]               # or {channels: [...], dependencies: [...]}
+    gpu: false
+  lock: true                     # required for schedules; cannot be undone
+  inputs:
+    default: {rows: 1000}
+  schedules:
+    nightly: {cron: "0 2 * * *", timezone: UTC, input: default}
+
+The plan is printed, then carried out. Nothing to do is "Already up to date"
+and exit 0; --dry-run prints the plan and stops.
+
+The ids of what the bundle created are recorded in
+.datarobot/pipeline/<bundle>.state.json beside the bundle, and every later
+apply compares against the objects those ids name. A locked pipeline's source
+can no longer change, and a changed payload for a locked input set becomes a
+new input set. Removing an input or schedule from the bundle stops managing
+it; it is not deleted.
+
+Example:
+  dr pipeline apply -f pipeline.yaml
+  dr pipeline apply -f pipeline.yaml --dry-run
+  dr pipeline apply -f pipeline.yaml --output-format json`,
+		Args:         cobra.NoArgs,
+		PreRunE:      auth.EnsureAuthenticatedE,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			outputFormat = outputformat.GetFormat(cmd)
+
+			return run(cmd, file, dryRun, outputFormat)
+		},
+	}
+
+	outputformat.AddFlag(cmd, &outputFormat)
+
+	cmd.Flags().StringVarP(&file, "file", "f", "", "Pipeline bundle file")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Print the plan and change nothing")
+	_ = cmd.MarkFlagRequired("file")
+
+	telemetry.TrackWith(cmd, func(_ *cobra.Command, _ []string) map[string]any {
+		return map[string]any{
+			"dry_run":       dryRun,
+			"output_format": string(outputFormat),
+		}
+	})
+
+	return cmd
+}
+
+// run plans and applies the bundle. The plan and the progress go to stderr; stdout carries the pipeline id,
+// or one JSON document.
+func run(cmd *cobra.Command, file string, dryRun bool, format outputformat.OutputFormat) error {
+	bundle, err := apply.LoadBundle(file)
+	if err != nil {
+		return err
+	}
+
+	statePath := apply.StatePath(bundle.Path)
+
+	state, err := apply.LoadState(statePath)
+	if err != nil {
+		return err
+	}
+
+	plan, err := buildFn(cmd.Context(), bundle, state)
+	if err != nil {
+		return err
+	}
+
+	stderr := cmd.ErrOrStderr()
+
+	if err := apply.Render(stderr, bundle, state, plan); err != nil {
+		return err
+	}
+
+	result := apply.Result{State: state, Version: plan.Version}
+
+	if !dryRun && !plan.Empty() {
+		fmt.Fprintln(stderr)
+
+		result, err = applyFn(cmd.Context(), bundle, state, plan, apply.Options{
+			StatePath: statePath,
+			Progress:  stderr,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if format == outputformat.OutputFormatJSON {
+		return outputformat.PrintJSONEnvelope(cmd.OutOrStdout(), "apply", toJSON(result, plan, statePath, dryRun))
+	}
+
+	if dryRun {
+		fmt.Fprintln(stderr, "\nDry run: nothing was changed.")
+
+		return nil
+	}
+
+	fmt.Fprintln(cmd.OutOrStdout(), result.State.PipelineID)
+
+	return nil
+}
+
+func toJSON(result apply.Result, plan apply.Plan, statePath string, dryRun bool) applyResult {
+	out := applyResult{
+		PipelineID: result.State.PipelineID,
+		ImageID:    result.State.ImageID,
+		Version:    result.Version,
+		Inputs:     map[string]string{},
+		Schedules:  map[string]string{},
+		StateFile:  statePath,
+		DryRun:     dryRun,
+		Plan:       plan.Steps,
+	}
+
+	for name, in := range result.State.Inputs {
+		out.Inputs[name] = in.ID
+	}
+
+	for name, s := range result.State.Schedules {
+		out.Schedules[name] = s.ID
+	}
+
+	if out.Plan == nil {
+		out.Plan = []apply.Step{}
+	}
+
+	return out
+}
@@ -0,0 +1,154 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apply
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/datarobot/cli/internal/pipeline/apply"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeBundle writes a minimal bundle and returns its path.
+func writeBundle(t *testing.T) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "pipeline.yaml")
+
+	require.NoError(t, os.WriteFile(path, []byte("name: p\nsource: pipeline.py\ninputs:\n  default: {}\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "pipeline.py"), []byte("pass\n"), 0o644))
+
+	return path
+}
+
+// stub replaces the plan and the apply for the test's duration. The apply
+// records that it ran and returns a state with ids.
+func stub(t *testing.T, plan apply.Plan) *bool {
+	t.Helper()
+
+	applied := false
+	prevBuild, prevApply := buildFn, applyFn
+
+	t.Cleanup(func() { buildFn, applyFn = prevBuild, prevApply })
+
+	buildFn = func(context.Context, *apply.Bundle, apply.State) (apply.Plan, error) {
+		return plan, nil
+	}
+
+	applyFn = func(context.Context, *apply.Bundle, apply.State, apply.Plan, apply.Options) (apply.Result, error) {
+		applied = true
+
+		return apply.Result{State: apply.State{
+			PipelineID: "pl-1",
+			Inputs:     map[string]apply.InputState{"default": {ID: "in-1"}},
+		}}, nil
+	}
+
+	return &applied
+}
+
+func runCmd(t *testing.T, args ...string) (string, string, error) {
+	t.Helper()
+
+	var stdout, stderr bytes.Buffer
+
+	cmd := Cmd()
+	cmd.SetArgs(args)
+	cmd.SetOut(&stdout)
+	cmd.SetErr(&stderr)
+	cmd.PreRunE = nil
+
+	err := cmd.Execute()
+
+	return stdout.String(), stderr.String(), err
+}
+
+var createPlan = apply.Plan{Steps: []apply.Step{
+	{Kind: apply.KindPipeline, Name: "p", Op: apply.OpCreate},
+	{Kind: apply.KindInput, Name: "default", Op: apply.OpCreate},
+}}
+
+func TestCmd_RequiresFile(t *testing.T) {
+	_, _, err := runCmd(t)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `"file" not set`)
+}
+
+func TestCmd_Apply(t *testing.T) {
+	applied := stub(t, createPlan)
+
+	stdout, stderr, err := runCmd(t, "-f", writeBundle(t))
+	require.NoError(t, err)
+
+	assert.True(t, *applied)
+	assert.Equal(t, "pl-1\n", stdout, "stdout carries only the pipeline id")
+	assert.Contains(t, stderr, "pipeline   p will be created")
+}
+
+func TestCmd_DryRunChangesNothing(t *testing.T) {
+	applied := stub(t, createPlan)
+
+	stdout, stderr, err := runCmd(t, "-f", writeBundle(t), "--dry-run")
+	require.NoError(t, err)
+
+	assert.False(t, *applied)
+	assert.Empty(t, stdout)
+	assert.Contains(t, stderr, "Dry run: nothing was changed.")
+}
+
+func TestCmd_UpToDateSkipsApply(t *testing.T) {
+	applied := stub(t, apply.Plan{})
+
+	_, stderr, err := runCmd(t, "-f", writeBundle(t))
+	require.NoError(t, err)
+
+	assert.False(t, *applied)
+	assert.Contains(t, stderr, "Already up to date")
+}
+
+func TestCmd_JSON(t *testing.T) {
+	stub(t, createPlan)
+
+	stdout, _, err := runCmd(t, "-f", writeBundle(t), "--output-format", "json")
+	require.NoError(t, err)
+
+	var parsed struct {
+		Apply applyResult `json:"apply"`
+	}
+
+	require.NoError(t, json.Unmarshal([]byte(stdout), &parsed))
+	assert.Equal(t, "pl-1", parsed.Apply.PipelineID)
+	assert.Equal(t, map[string]string{"default": "in-1"}, parsed.Apply.Inputs)
+	assert.Len(t, parsed.Apply.Plan, 2)
+	assert.Contains(t, parsed.Apply.StateFile, filepath.Join(".datarobot", "pipeline", "pipeline.state.json"))
+}
+
+func TestCmd_InvalidBundle(t *testing.T) {
+	stub(t, createPlan)
+
+	path := filepath.Join(t.TempDir(), "bad.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("source: x.py\n"), 0o644))
+
+	_, _, err := runCmd(t, "-f", path)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "name is required")
+}
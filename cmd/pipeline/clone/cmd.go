@@ -57,7 +57,7 @@ Example:
 				return errors.New("--name must not be blank")
 			}
 
-			result, err := pipeline.ClonePipeline(args[0], name)
+			result, err := pipeline.ClonePipeline(cmd.Context(), args[0], name)
 			if err != nil {
 				return fmt.Errorf("clone pipeline: %w", err)
 			}
@@ -15,6 +15,7 @@
 package pipeline
 
 import (
+	"github.com/datarobot/cli/cmd/pipeline/apply"
 	"github.com/datarobot/cli/cmd/pipeline/clone"
 	"github.com/datarobot/cli/cmd/pipeline/create"
 	"github.com/datarobot/cli/cmd/pipeline/del"
@@ -68,6 +69,7 @@ input payloads, runs, and recurring schedules.`,
 		image.Cmd(),
 		source.Cmd(),
 		task.Cmd(),
+		apply.Cmd(),
 	)
 
 	return cmd
@@ -66,6 +66,7 @@ func TestCmd_HasExpectedSubcommands(t *testing.T) {
 		"run":      false,
 		"input":    false,
 		"schedule": false,
+		"apply":    false,
 	}
 
 	for _, sub := range cmd.Commands() {
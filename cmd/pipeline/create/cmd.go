@@ -76,7 +76,7 @@ Example:
 				return fmt.Errorf("resolve file path: %w", err)
 			}
 
-			result, err := pipeline.CreatePipeline(filePath, description, name, mode, imageID)
+			result, err := pipeline.CreatePipeline(cmd.Context(), filePath, description, name, mode, imageID)
 			if err != nil {
 				return fmt.Errorf("create pipeline: %w", err)
 			}
@@ -43,8 +43,8 @@ Example:
 		Args:         cobra.ExactArgs(1),
 		PreRunE:      auth.EnsureAuthenticatedE,
 		SilenceUsage: true,
-		RunE: func(_ *cobra.Command, args []string) error {
-			err := pipeline.DeletePipeline(args[0])
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := pipeline.DeletePipeline(cmd.Context(), args[0])
 			if err != nil {
 				return handleDeleteError(err, args[0])
 			}
@@ -47,7 +47,7 @@ Example:
 		RunE: func(cmd *cobra.Command, args []string) error {
 			outputFormat = outputformat.GetFormat(cmd)
 
-			result, err := pipeline.GetPipeline(args[0])
+			result, err := pipeline.GetPipeline(cmd.Context(), args[0])
 			if err != nil {
 				return handleGetError(err, args[0], outputFormat)
 			}
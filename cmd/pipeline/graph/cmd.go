@@ -38,6 +38,8 @@ func Cmd() *cobra.Command {
 	var (
 		flags        scopeflag.Flags
 		outputFormat outputformat.OutputFormat
+		graphFormat  = pipeline.GraphFormatTable
+		runID        string
 	)
 
 	cmd := &cobra.Command{
@@ -46,6 +48,15 @@ func Cmd() *cobra.Command {
 		Long: `Display the pipeline/task graph (DAG) as either a JSON payload
 (for visualisation tooling) or a human-readable summary.
 
+--format chooses the human-readable rendering:
+  - table   -> node and edge tables (default)
+  - dot     -> Graphviz DOT, e.g. for design docs
+  - mermaid -> Mermaid flowchart, e.g. for READMEs
+  - ascii   -> layered drawing with task names and parameter counts
+
+With --run=<run-id>, every node shows the status of its task execution in
+that run; dot, mermaid and ascii also colour the nodes by status.
+
 Scope is selected from the --scope/--version flags:
   - no flags                   -> draft graph (latest version)
   - --version=N                -> locked graph for version N (scope auto-set)
@@ -54,19 +65,31 @@ Scope is selected from the --scope/--version flags:
 
 Example:
   dr pipeline graph --pipeline <id>
-  dr pipeline graph --pipeline <id> --version=2 --output-format json`,
+  dr pipeline graph --pipeline <id> --version=2 --output-format json
+  dr pipeline graph --pipeline <id> --format mermaid > graph.mmd
+  dr pipeline graph --pipeline <id> --format ascii --run <run-id>`,
 		Args:         cobra.NoArgs,
 		PreRunE:      auth.EnsureAuthenticatedE,
 		SilenceUsage: true,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			outputFormat = outputformat.GetFormat(cmd)
 
+			if outputFormat == outputformat.OutputFormatJSON {
+				if graphFormat != pipeline.GraphFormatTable {
+					return errors.New("--format cannot be combined with --output-format json")
+				}
+
+				if runID != "" {
+					return errors.New("--run cannot be combined with --output-format json")
+				}
+			}
+
 			scope, version, err := flags.Resolve(cmd)
 			if err != nil {
 				return fmt.Errorf(errmsg.ResolveScope, err)
 			}
 
-			result, err := pipeline.GetGraph(flags.PipelineID, scope, version)
+			result, err := pipeline.GetGraph(cmd.Context(), flags.PipelineID, scope, version)
 			if err != nil {
 				return handleGraphError(err, flags.PipelineID, outputFormat)
 			}
@@ -75,7 +98,18 @@ Example:
 				return printGraphJSON(*result)
 			}
 
-			printGraphHuman(*result)
+			var statuses map[int]string
+
+			if runID != "" {
+				tasks, err := pipeline.ListTaskExecutions(cmd.Context(), flags.PipelineID, runID)
+				if err != nil {
+					return err
+				}
+
+				statuses = pipeline.GraphNodeStatuses(tasks)
+			}
+
+			printGraph(graphFormat, *result, statuses)
 
 			return nil
 		},
@@ -83,6 +117,9 @@ Example:
 
 	outputformat.AddFlag(cmd, &outputFormat)
 
+	cmd.Flags().Var(&graphFormat, "format", "Graph rendering (table, dot, mermaid, ascii)")
+	cmd.Flags().StringVar(&runID, "run", "", "Run (dispatch) ID whose task statuses colour the nodes")
+
 	flags.Bind(cmd)
 	_ = cmd.MarkFlagRequired("pipeline")
 
@@ -90,6 +127,8 @@ Example:
 		return map[string]any{
 			"pipeline_id":   flags.PipelineID,
 			"output_format": string(outputFormat),
+			"graph_format":  string(graphFormat),
+			"with_run":      runID != "",
 		}
 	})
 
@@ -123,7 +162,28 @@ func printGraphJSON(g pipeline.Graph) error {
 	return nil
 }
 
-func printGraphHuman(g pipeline.Graph) {
+// printGraph writes g in one of the human-readable graph formats. statuses
+// is nil unless --run was given.
+func printGraph(format pipeline.GraphFormat, g pipeline.Graph, statuses map[int]string) {
+	switch format {
+	case pipeline.GraphFormatDOT:
+		fmt.Print(pipeline.GraphDOT(g, statuses))
+	case pipeline.GraphFormatMermaid:
+		fmt.Print(pipeline.GraphMermaid(g, statuses))
+	case pipeline.GraphFormatASCII:
+		if len(g.Nodes) == 0 {
+			fmt.Println(tui.DimStyle.Render("No nodes"))
+
+			return
+		}
+
+		fmt.Print(pipeline.GraphASCII(g, statuses))
+	default:
+		printGraphHuman(g, statuses)
+	}
+}
+
+func printGraphHuman(g pipeline.Graph, statuses map[int]string) {
 	fmt.Println(tui.BaseTextStyle.Render("Pipeline: " + g.Pipeline.Name))
 
 	if len(g.Nodes) == 0 {
@@ -137,7 +197,12 @@ func printGraphHuman(g pipeline.Graph) {
 
 	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
 
-	fmt.Fprintln(writer, "  ID\tTYPE\tNAME\tTASK ID")
+	header := "  ID\tTYPE\tNAME\tTASK ID"
+	if statuses != nil {
+		header += "\tSTATUS"
+	}
+
+	fmt.Fprintln(writer, header)
 
 	for _, n := range g.Nodes {
 		taskID := "—"
@@ -146,7 +211,18 @@ func printGraphHuman(g pipeline.Graph) {
 			taskID = strconv.Itoa(*n.TaskID)
 		}
 
-		fmt.Fprintf(writer, "  %d\t%s\t%s\t%s\n", n.ID, n.Type, n.Name, taskID)
+		row := fmt.Sprintf("  %d\t%s\t%s\t%s", n.ID, n.Type, n.Name, taskID)
+
+		if statuses != nil {
+			status, ok := statuses[n.ID]
+			if !ok {
+				status = "—"
+			}
+
+			row += "\t" + status
+		}
+
+		fmt.Fprintln(writer, row)
 	}
 
 	_ = writer.Flush()
@@ -59,7 +59,7 @@ func TestPrintGraphJSON(t *testing.T) {
 
 func TestPrintGraphHuman(t *testing.T) {
 	output := testutil.CaptureStdout(t, func() {
-		printGraphHuman(sampleGraph())
+		printGraphHuman(sampleGraph(), nil)
 	})
 
 	assert.Contains(t, output, "Pipeline: wf")
@@ -68,9 +68,34 @@ func TestPrintGraphHuman(t *testing.T) {
 	assert.Contains(t, output, "step1")
 }
 
+func TestPrintGraphHuman_WithRunStatuses(t *testing.T) {
+	output := testutil.CaptureStdout(t, func() {
+		printGraphHuman(sampleGraph(), map[int]string{1: pipeline.RunStatusFailed})
+	})
+
+	assert.Contains(t, output, "STATUS")
+	assert.Contains(t, output, "FAILED")
+}
+
+func TestPrintGraph_Formats(t *testing.T) {
+	cases := map[pipeline.GraphFormat]string{
+		pipeline.GraphFormatDOT:     "n0 -> n1;",
+		pipeline.GraphFormatMermaid: "n0 --> n1",
+		pipeline.GraphFormatASCII:   "│ step1",
+	}
+
+	for format, want := range cases {
+		output := testutil.CaptureStdout(t, func() {
+			printGraph(format, sampleGraph(), nil)
+		})
+
+		assert.Contains(t, output, want, format)
+	}
+}
+
 func TestPrintGraphHuman_EmptyGraph(t *testing.T) {
 	output := testutil.CaptureStdout(t, func() {
-		printGraphHuman(pipeline.Graph{Pipeline: pipeline.GraphPipeline{Name: "empty"}})
+		printGraphHuman(pipeline.Graph{Pipeline: pipeline.GraphPipeline{Name: "empty"}}, nil)
 	})
 
 	assert.Contains(t, output, "No nodes")
@@ -132,3 +157,32 @@ func TestCmd_RejectsBadOutput(t *testing.T) {
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "invalid output format")
 }
+
+func TestCmd_RejectsBadGraphFormat(t *testing.T) {
+	cmd := Cmd()
+	cmd.SetArgs([]string{"--pipeline", "p", "--format", "svg"})
+	cmd.SetOut(io.Discard)
+	cmd.SetErr(io.Discard)
+	cmd.PreRunE = nil
+
+	err := cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid graph format")
+}
+
+func TestCmd_RejectsGraphFormatWithJSON(t *testing.T) {
+	for _, args := range [][]string{
+		{"--pipeline", "p", "--format", "dot", "--output-format", "json"},
+		{"--pipeline", "p", "--run", "r", "--output-format", "json"},
+	} {
+		cmd := Cmd()
+		cmd.SetArgs(args)
+		cmd.SetOut(io.Discard)
+		cmd.SetErr(io.Discard)
+		cmd.PreRunE = nil
+
+		err := cmd.Execute()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot be combined with --output-format json")
+	}
+}
@@ -78,7 +78,7 @@ Example:
 			}
 
 			// --gpu is canonical; --nvidia is a deprecated alias for it.
-			result, err := pipeline.CreateImage(name, description, pip, conda, pythonVersion, baseImage, gpu || nvidia)
+			result, err := pipeline.CreateImage(cmd.Context(), name, description, pip, conda, pythonVersion, baseImage, gpu || nvidia)
 			if err != nil {
 				return fmt.Errorf("create image: %w", err)
 			}
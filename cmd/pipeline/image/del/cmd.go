@@ -47,8 +47,8 @@ Example:
 		Args:         cobra.ExactArgs(1),
 		PreRunE:      auth.EnsureAuthenticatedE,
 		SilenceUsage: true,
-		RunE: func(_ *cobra.Command, args []string) error {
-			err := pipeline.DeleteImage(args[0])
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := pipeline.DeleteImage(cmd.Context(), args[0])
 			if err != nil {
 				return handleDeleteError(err, args[0])
 			}
@@ -50,7 +50,7 @@ Example:
 
 			imageID := args[0]
 
-			img, err := pipeline.GetImage(imageID)
+			img, err := pipeline.GetImage(cmd.Context(), imageID)
 			if err != nil {
 				return handleImageError(err, imageID, outputFormat)
 			}
@@ -49,7 +49,7 @@ Example:
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			outputFormat = outputformat.GetFormat(cmd)
 
-			items, err := pipeline.ListImages(offset, limit)
+			items, err := pipeline.ListImages(cmd.Context(), offset, limit)
 			if err != nil {
 				return fmt.Errorf("list images: %w", err)
 			}
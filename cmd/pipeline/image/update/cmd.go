@@ -74,7 +74,7 @@ Example:
 			}
 
 			// --gpu is canonical; --nvidia is a deprecated alias for it.
-			result, err := pipeline.UpdateImage(args[0], pip, conda, pythonVersion, baseImage, gpu || nvidia)
+			result, err := pipeline.UpdateImage(cmd.Context(), args[0], pip, conda, pythonVersion, baseImage, gpu || nvidia)
 			if err != nil {
 				return fmt.Errorf("update image: %w", err)
 			}
@@ -45,13 +45,13 @@ Example:
 		Args:         cobra.ExactArgs(1),
 		PreRunE:      auth.EnsureAuthenticatedE,
 		SilenceUsage: true,
-		RunE: func(_ *cobra.Command, args []string) error {
+		RunE: func(cmd *cobra.Command, args []string) error {
 			version, err := strconv.Atoi(args[0])
 			if err != nil || version <= 0 {
 				return fmt.Errorf("invalid version: %q (expected a positive integer)", args[0])
 			}
 
-			err = pipeline.DeleteImageVersion(imageID, version)
+			err = pipeline.DeleteImageVersion(cmd.Context(), imageID, version)
 			if err != nil {
 				return handleDeleteError(err, imageID, args[0])
 			}
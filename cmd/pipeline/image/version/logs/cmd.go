@@ -43,13 +43,13 @@ Example:
 		Args:         cobra.ExactArgs(1),
 		SilenceUsage: true,
 		PreRunE:      auth.EnsureAuthenticatedE,
-		RunE: func(_ *cobra.Command, args []string) error {
+		RunE: func(cmd *cobra.Command, args []string) error {
 			version, err := strconv.Atoi(args[0])
 			if err != nil || version <= 0 {
 				return fmt.Errorf("invalid version: %q (expected a positive integer)", args[0])
 			}
 
-			resp, err := pipeline.GetImageBuildLogs(imageID, version)
+			resp, err := pipeline.GetImageBuildLogs(cmd.Context(), imageID, version)
 			if err != nil {
 				var httpErr *drapi.HTTPError
 
@@ -73,7 +73,7 @@ Example:
 				return fmt.Errorf("resolve payload: %w", err)
 			}
 
-			result, err := pipeline.CreateInput(flags.PipelineID, scope, version, payload)
+			result, err := pipeline.CreateInput(cmd.Context(), flags.PipelineID, scope, version, payload)
 			if err != nil {
 				return fmt.Errorf("create input: %w", err)
 			}
@@ -53,7 +53,7 @@ Example:
 				return fmt.Errorf(errmsg.ResolveScope, err)
 			}
 
-			err = pipeline.DeleteInput(flags.PipelineID, scope, version, args[0])
+			err = pipeline.DeleteInput(cmd.Context(), flags.PipelineID, scope, version, args[0])
 			if err != nil {
 				return handleDeleteError(err, args[0])
 			}
@@ -59,7 +59,7 @@ Example:
 				return fmt.Errorf(errmsg.ResolveScope, err)
 			}
 
-			result, err := pipeline.GetInput(flags.PipelineID, scope, version, args[0])
+			result, err := pipeline.GetInput(cmd.Context(), flags.PipelineID, scope, version, args[0])
 			if err != nil {
 				return handleGetError(err, args[0], outputFormat)
 			}
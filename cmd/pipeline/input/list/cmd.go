@@ -60,7 +60,7 @@ Example:
 				return fmt.Errorf(errmsg.ResolveScope, err)
 			}
 
-			items, err := pipeline.ListInputs(flags.PipelineID, scope, version, offset, limit)
+			items, err := pipeline.ListInputs(cmd.Context(), flags.PipelineID, scope, version, offset, limit)
 			if err != nil {
 				return fmt.Errorf("list inputs: %w", err)
 			}
@@ -56,7 +56,7 @@ Example:
 				return fmt.Errorf("resolve payload: %w", err)
 			}
 
-			result, err := pipeline.UpdateInput(pipelineID, inputID, payload)
+			result, err := pipeline.UpdateInput(cmd.Context(), pipelineID, inputID, payload)
 			if err != nil {
 				return fmt.Errorf("update input: %w", err)
 			}
@@ -55,7 +55,7 @@ Example:
 				return fmt.Errorf("invalid mode: %s (supported: draft, locked)", mode)
 			}
 
-			list, err := pipeline.ListPipelines(mode, search, offset, limit)
+			list, err := pipeline.ListPipelines(cmd.Context(), mode, search, offset, limit)
 			if err != nil {
 				return fmt.Errorf("list pipelines: %w", err)
 			}
@@ -42,7 +42,7 @@ Example:
 		RunE: func(cmd *cobra.Command, args []string) error {
 			outputFormat = outputformat.GetFormat(cmd)
 
-			result, err := pipeline.LockPipeline(args[0])
+			result, err := pipeline.LockPipeline(cmd.Context(), args[0])
 			if err != nil {
 				return fmt.Errorf("lock pipeline: %w", err)
 			}
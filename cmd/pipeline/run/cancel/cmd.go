@@ -56,7 +56,7 @@ Example:
 				return fmt.Errorf(errmsg.ResolveScope, err)
 			}
 
-			err = pipeline.CancelRun(flags.PipelineID, scope, version, args[0])
+			err = pipeline.CancelRun(cmd.Context(), flags.PipelineID, scope, version, args[0])
 			if err != nil {
 				return runutil.HandleRunNotFoundError(err, args[0], outputformat.OutputFormatText)
 			}
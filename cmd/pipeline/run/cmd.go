@@ -21,6 +21,7 @@ import (
 	"github.com/datarobot/cli/cmd/pipeline/run/list"
 	"github.com/datarobot/cli/cmd/pipeline/run/status"
 	"github.com/datarobot/cli/cmd/pipeline/run/task"
+	"github.com/datarobot/cli/cmd/pipeline/run/watch"
 	"github.com/spf13/cobra"
 )
 
@@ -43,6 +44,7 @@ When --version is supplied, the locked scope is selected automatically.`,
 		list.Cmd(),
 		get.Cmd(),
 		status.Cmd(),
+		watch.Cmd(),
 		cancel.Cmd(),
 		task.Cmd(),
 	)
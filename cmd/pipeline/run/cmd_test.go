@@ -28,6 +28,7 @@ func TestCmd_RegistersAllVerbs(t *testing.T) {
 		"list":   false,
 		"get":    false,
 		"status": false,
+		"watch":  false,
 		"cancel": false,
 		"task":   false,
 	}
@@ -18,6 +18,8 @@ import (
 	"fmt"
 
 	"github.com/datarobot/cli/cmd/internal/errmsg"
+	"github.com/datarobot/cli/cmd/internal/pollflags"
+	"github.com/datarobot/cli/cmd/pipeline/run/runutil"
 	"github.com/datarobot/cli/cmd/pipeline/scopeflag"
 	"github.com/datarobot/cli/internal/auth"
 	"github.com/datarobot/cli/internal/outputformat"
@@ -31,6 +33,8 @@ func Cmd() *cobra.Command {
 		flags        scopeflag.Flags
 		inputID      string
 		imageID      string
+		follow       bool
+		poll         pollflags.Set
 		outputFormat outputformat.OutputFormat
 	)
 
@@ -40,12 +44,22 @@ func Cmd() *cobra.Command {
 		Long: `Trigger a new run (single execution) of a pipeline.
 
 The run is created in PENDING state. Use ` + "`dr pipeline run get`" + `
-or ` + "`dr pipeline run status`" + ` to follow its progress.
+or ` + "`dr pipeline run status`" + ` to follow its progress, or:
+
+  --wait    stay until the run finishes, reporting status changes and
+            tasks as they start and finish
+  --follow  as --wait, and also stream each task's log lines, prefixed
+            with the task name
+
+With either, --output-format json prints one JSON event per line.
+
+` + runutil.ExitCodesHelp + `
 
 Example:
   dr pipeline run create --pipeline <id> --input <input-id>
   dr pipeline run create --pipeline <id> --input <input-id> --image <image-id>
-  dr pipeline run create --pipeline <id> --version=2 --input <input-id> --output-format json`,
+  dr pipeline run create --pipeline <id> --version=2 --input <input-id> --output-format json
+  dr pipeline run create --pipeline <id> --input <input-id> --image <image-id> --follow`,
 		Args:         cobra.NoArgs,
 		PreRunE:      auth.EnsureAuthenticatedE,
 		SilenceUsage: true,
@@ -57,12 +71,23 @@ Example:
 				return fmt.Errorf(errmsg.ResolveScope, err)
 			}
 
-			result, err := pipeline.CreateRun(flags.PipelineID, scope, version, inputID, imageID)
+			result, err := pipeline.CreateRun(cmd.Context(), flags.PipelineID, scope, version, inputID, imageID)
 			if err != nil {
 				return fmt.Errorf("create run: %w", err)
 			}
 
-			return pipeline.RenderRun(outputFormat, *result)
+			if !poll.Wait && !follow {
+				return pipeline.RenderRun(outputFormat, *result)
+			}
+
+			created := pipeline.RunEvent{Type: pipeline.RunEventCreated, RunID: result.RunID, Status: result.Status, Time: result.CreatedAt}
+			if err := pipeline.RenderRunEvent(outputFormat, created); err != nil {
+				return err
+			}
+
+			target := runutil.Target{PipelineID: flags.PipelineID, Scope: scope, Version: version, RunID: result.RunID}
+
+			return runutil.Follow(cmd, outputFormat, target, poll, follow)
 		},
 	}
 
@@ -74,12 +99,17 @@ Example:
 	_ = cmd.MarkFlagRequired("input")
 	cmd.Flags().StringVar(&imageID, "image", "", "Execution image ID for this run")
 	_ = cmd.MarkFlagRequired("image")
+	pollflags.RegisterWithDefaults(cmd, &poll, runutil.RunPollInterval, runutil.RunPollTimeout,
+		"Wait until the run finishes; the exit code reflects how it ended.")
+	cmd.Flags().BoolVarP(&follow, "follow", "f", false, "Wait until the run finishes, streaming task logs.")
 
 	telemetry.TrackWith(cmd, func(_ *cobra.Command, _ []string) map[string]any {
 		return map[string]any{
 			"pipeline_id":   flags.PipelineID,
 			"scope":         flags.Scope,
 			"version":       flags.Version,
+			"wait":          poll.Wait,
+			"follow":        follow,
 			"output_format": string(outputFormat),
 		}
 	})
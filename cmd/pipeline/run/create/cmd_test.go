@@ -67,7 +67,13 @@ func TestCmd_RejectsBadScopeCombo(t *testing.T) {
 func TestCmd_HasExpectedFlags(t *testing.T) {
 	cmd := Cmd()
 
-	for _, name := range []string{"pipeline", "scope", "version", "input", "image", "output-format"} {
+	for _, name := range []string{"pipeline", "scope", "version", "input", "image", "wait", "follow", "poll-interval", "poll-timeout", "output-format"} {
 		assert.NotNilf(t, cmd.Flags().Lookup(name), "expected --%s flag", name)
 	}
 }
+
+func TestCmd_RejectsNonPositivePollInterval(t *testing.T) {
+	err := runCmd(t, "--pipeline", "p", "--input", "in-1", "--image", "img-1", "--wait", "--poll-interval", "0s")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "must be a positive duration")
+}
@@ -57,7 +57,7 @@ Example:
 				return fmt.Errorf(errmsg.ResolveScope, err)
 			}
 
-			result, err := pipeline.GetRun(flags.PipelineID, scope, version, args[0])
+			result, err := pipeline.GetRun(cmd.Context(), flags.PipelineID, scope, version, args[0])
 			if err != nil {
 				return runutil.HandleRunNotFoundError(err, args[0], outputFormat)
 			}
@@ -53,7 +53,7 @@ Example:
 				return fmt.Errorf(errmsg.ResolveScope, err)
 			}
 
-			items, err := pipeline.ListRuns(flags.PipelineID, scope, version, offset, limit)
+			items, err := pipeline.ListRuns(cmd.Context(), flags.PipelineID, scope, version, offset, limit)
 			if err != nil {
 				return fmt.Errorf("list runs: %w", err)
 			}
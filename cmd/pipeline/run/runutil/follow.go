@@ -0,0 +1,120 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runutil
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/datarobot/cli/cmd/internal/pollflags"
+	"github.com/datarobot/cli/internal/cli"
+	"github.com/datarobot/cli/internal/outputformat"
+	"github.com/datarobot/cli/internal/pipeline"
+	"github.com/datarobot/cli/tui"
+	"github.com/spf13/cobra"
+)
+
+// Poll defaults for following a run. Runs change state faster than image
+// builds settle, but a pipeline can go on for hours.
+const (
+	RunPollInterval = 3 * time.Second
+	RunPollTimeout  = 2 * time.Hour
+)
+
+// Exit codes of a followed run, so scripts can tell the outcomes apart.
+// COMPLETED exits 0.
+const (
+	ExitRunFailed    = 1
+	ExitRunErrored   = 2
+	ExitRunCancelled = 3
+	ExitRunTimedOut  = 4
+
+	// ExitRunInterrupted is the shell's 128+SIGINT: Ctrl+C stopped
+	// following, and the run keeps going.
+	ExitRunInterrupted = 130
+)
+
+// watchRunFn is stubbed in tests.
+var watchRunFn = pipeline.WatchRun
+
+// ExitCodesHelp documents the exit codes for the commands that follow a
+// run, so their help text cannot drift apart.
+const ExitCodesHelp = `Exit codes:
+  0    the run COMPLETED
+  1    the run FAILED (or the command itself failed)
+  2    the run ERRORED
+  3    the run was CANCELLED
+  4    --poll-timeout elapsed before the run finished
+  130  Ctrl+C stopped following; the run keeps going`
+
+// Target identifies the run to follow.
+type Target struct {
+	PipelineID string
+	Scope      pipeline.Scope
+	Version    *int
+	RunID      string
+}
+
+// Follow watches a run until it finishes, rendering each event as it
+// happens, and returns an error carrying the exit code of how it ended.
+// Ctrl+C stops following without touching the run and exits 130.
+func Follow(cmd *cobra.Command, format outputformat.OutputFormat, target Target, poll pollflags.Set, logs bool) error {
+	status, err := watchRunFn(cmd.Context(), target.PipelineID, target.Scope, target.Version, target.RunID, pipeline.WatchOptions{
+		Interval: poll.Interval,
+		Timeout:  poll.Timeout,
+		Logs:     logs,
+		OnEvent: func(e pipeline.RunEvent) error {
+			return pipeline.RenderRunEvent(format, e)
+		},
+		OnWarn: func(msg string) {
+			fmt.Fprintln(cmd.ErrOrStderr(), "warning: "+msg)
+		},
+	})
+
+	switch {
+	case err == nil:
+		return RunResult(target.RunID, status)
+	case errors.Is(err, pipeline.ErrRunWaitTimeout):
+		return &cli.ExitError{Code: ExitRunTimedOut, Err: err}
+	case cmd.Context().Err() != nil:
+		fmt.Fprintln(cmd.ErrOrStderr(), tui.DimStyle.Render(fmt.Sprintf(
+			"Stopped following run %s; it keeps running. Resume with: dr pipeline run watch --pipeline %s %s",
+			target.RunID, target.PipelineID, target.RunID)))
+
+		return &cli.ExitError{Code: ExitRunInterrupted, Err: fmt.Errorf("stopped following run %s: %w", target.RunID, cmd.Context().Err())}
+	default:
+		return err
+	}
+}
+
+// RunResult maps a terminal run status to the command's result: nil for
+// COMPLETED, otherwise an error with the status's exit code.
+func RunResult(runID, status string) error {
+	var code int
+
+	switch status {
+	case pipeline.RunStatusCompleted:
+		return nil
+	case pipeline.RunStatusErrored:
+		code = ExitRunErrored
+	case pipeline.RunStatusCancelled:
+		code = ExitRunCancelled
+	default:
+		code = ExitRunFailed
+	}
+
+	return &cli.ExitError{Code: code, Err: fmt.Errorf("run %s ended with status %s", runID, status)}
+}
@@ -0,0 +1,80 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runutil
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/datarobot/cli/cmd/internal/pollflags"
+	"github.com/datarobot/cli/internal/cli"
+	"github.com/datarobot/cli/internal/outputformat"
+	"github.com/datarobot/cli/internal/pipeline"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunResult_ExitCodes(t *testing.T) {
+	require.NoError(t, RunResult("d-1", pipeline.RunStatusCompleted))
+
+	for status, want := range map[string]int{
+		pipeline.RunStatusFailed:    ExitRunFailed,
+		pipeline.RunStatusErrored:   ExitRunErrored,
+		pipeline.RunStatusCancelled: ExitRunCancelled,
+	} {
+		err := RunResult("d-1", status)
+		require.Error(t, err, status)
+		assert.Equal(t, want, cli.ExitCode(err), status)
+		assert.Contains(t, err.Error(), "d-1 ended with status "+status)
+	}
+}
+
+func TestFollow_InterruptedExits130(t *testing.T) {
+	prev := watchRunFn
+
+	t.Cleanup(func() { watchRunFn = prev })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	watching := make(chan struct{})
+
+	watchRunFn = func(ctx context.Context, _ string, _ pipeline.Scope, _ *int, _ string, _ pipeline.WatchOptions) (string, error) {
+		close(watching)
+		<-ctx.Done()
+
+		return "", ctx.Err()
+	}
+
+	go func() {
+		<-watching
+		cancel()
+	}()
+
+	var stderr bytes.Buffer
+
+	cmd := &cobra.Command{}
+	cmd.SetContext(ctx)
+	cmd.SetErr(&stderr)
+
+	err := Follow(cmd, outputformat.OutputFormatJSON, Target{PipelineID: "p-1", RunID: "d-1"}, pollflags.Set{}, false)
+
+	require.Error(t, err)
+	assert.Equal(t, ExitRunInterrupted, cli.ExitCode(err))
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Contains(t, stderr.String(), "dr pipeline run watch --pipeline p-1 d-1")
+}
@@ -57,7 +57,7 @@ Example:
 				return fmt.Errorf(errmsg.ResolveScope, err)
 			}
 
-			result, err := pipeline.GetRunStatus(flags.PipelineID, scope, version, args[0])
+			result, err := pipeline.GetRunStatus(cmd.Context(), flags.PipelineID, scope, version, args[0])
 			if err != nil {
 				return runutil.HandleRunNotFoundError(err, args[0], outputFormat)
 			}
@@ -74,7 +74,7 @@ Example:
 				node = &nodeID
 			}
 
-			task, err := pipeline.GetTaskExecution(pipelineID, runID, taskID, node)
+			task, err := pipeline.GetTaskExecution(cmd.Context(), pipelineID, runID, taskID, node)
 			if err != nil {
 				return handleNotFound(err, args[0], outputFormat)
 			}
@@ -57,7 +57,7 @@ Example:
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			outputFormat = outputformat.GetFormat(cmd)
 
-			tasks, err := pipeline.ListTaskExecutions(pipelineID, runID)
+			tasks, err := pipeline.ListTaskExecutions(cmd.Context(), pipelineID, runID)
 			if err != nil {
 				return fmt.Errorf("list task executions: %w", err)
 			}
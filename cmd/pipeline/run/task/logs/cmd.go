@@ -15,6 +15,7 @@
 package logs
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strconv"
@@ -80,7 +81,7 @@ Example:
 			}
 
 			if stream != "" {
-				return fetchDurableLog(pipelineID, runID, taskID, node, stream, verbosity, outputFormat)
+				return fetchDurableLog(cmd.Context(), pipelineID, runID, taskID, node, stream, verbosity, outputFormat)
 			}
 
 			var tail *int
@@ -89,7 +90,7 @@ Example:
 				tail = &tailLines
 			}
 
-			return fetchLiveLogs(pipelineID, runID, taskID, node, tail, verbosity, outputFormat)
+			return fetchLiveLogs(cmd.Context(), pipelineID, runID, taskID, node, tail, verbosity, outputFormat)
 		},
 	}
 
@@ -117,8 +118,8 @@ Example:
 	return cmd
 }
 
-func fetchLiveLogs(pipelineID, runID string, taskID int, nodeID, tailLines *int, verbosity string, format outputformat.OutputFormat) error {
-	logs, err := pipeline.GetTaskLogs(pipelineID, runID, taskID, nodeID, tailLines, verbosity)
+func fetchLiveLogs(ctx context.Context, pipelineID, runID string, taskID int, nodeID, tailLines *int, verbosity string, format outputformat.OutputFormat) error {
+	logs, err := pipeline.GetTaskLogs(ctx, pipelineID, runID, taskID, nodeID, tailLines, verbosity)
 	if err != nil {
 		return fmt.Errorf("get task logs: %w", err)
 	}
@@ -139,8 +140,8 @@ func fetchLiveLogs(pipelineID, runID string, taskID int, nodeID, tailLines *int,
 	return nil
 }
 
-func fetchDurableLog(pipelineID, runID string, taskID int, nodeID *int, stream, verbosity string, format outputformat.OutputFormat) error {
-	log, err := pipeline.GetTaskDurableLog(pipelineID, runID, taskID, nodeID, stream, verbosity)
+func fetchDurableLog(ctx context.Context, pipelineID, runID string, taskID int, nodeID *int, stream, verbosity string, format outputformat.OutputFormat) error {
+	log, err := pipeline.GetTaskDurableLog(ctx, pipelineID, runID, taskID, nodeID, stream, verbosity)
 	if err != nil {
 		return fmt.Errorf("get task durable log: %w", err)
 	}
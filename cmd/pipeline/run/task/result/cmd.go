@@ -75,7 +75,7 @@ Example:
 				node = &nodeID
 			}
 
-			res, err := pipeline.GetTaskResult(pipelineID, runID, taskID, node)
+			res, err := pipeline.GetTaskResult(cmd.Context(), pipelineID, runID, taskID, node)
 			if err != nil {
 				return fmt.Errorf("get task result: %w", err)
 			}
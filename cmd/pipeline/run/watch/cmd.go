@@ -0,0 +1,97 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package watch
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/datarobot/cli/cmd/internal/errmsg"
+	"github.com/datarobot/cli/cmd/internal/pollflags"
+	"github.com/datarobot/cli/cmd/pipeline/run/runutil"
+	"github.com/datarobot/cli/cmd/pipeline/scopeflag"
+	"github.com/datarobot/cli/internal/auth"
+	"github.com/datarobot/cli/internal/outputformat"
+	"github.com/datarobot/cli/internal/telemetry"
+	"github.com/spf13/cobra"
+)
+
+func Cmd() *cobra.Command {
+	var (
+		flags        scopeflag.Flags
+		logs         bool
+		poll         pollflags.Set
+		outputFormat outputformat.OutputFormat
+	)
+
+	cmd := &cobra.Command{
+		Use:   "watch <run-id>",
+		Short: "Follow a pipeline run until it finishes",
+		Long: `Follow an existing run until it reaches a terminal state.
+
+Status changes and tasks starting and finishing are printed as they
+happen, with each task's log lines interleaved and prefixed with the task
+name. Pass --logs=false for status and task changes only. With
+--output-format json, each event is one JSON object per line.
+
+Press Ctrl+C to stop watching; the run itself keeps going.
+
+` + runutil.ExitCodesHelp + `
+
+Example:
+  dr pipeline run watch --pipeline <id> <run-id>
+  dr pipeline run watch --pipeline <id> --version=2 <run-id> --logs=false
+  dr pipeline run watch --pipeline <id> <run-id> --output-format json`,
+		Args:         cobra.ExactArgs(1),
+		PreRunE:      auth.EnsureAuthenticatedE,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			outputFormat = outputformat.GetFormat(cmd)
+
+			if flags.PipelineID == "" {
+				return errors.New("--pipeline is required")
+			}
+
+			scope, version, err := flags.Resolve(cmd)
+			if err != nil {
+				return fmt.Errorf(errmsg.ResolveScope, err)
+			}
+
+			target := runutil.Target{PipelineID: flags.PipelineID, Scope: scope, Version: version, RunID: args[0]}
+
+			return runutil.Follow(cmd, outputFormat, target, poll, logs)
+		},
+	}
+
+	outputformat.AddFlag(cmd, &outputFormat)
+
+	flags.Bind(cmd)
+	_ = cmd.MarkFlagRequired("pipeline")
+	cmd.Flags().BoolVar(&logs, "logs", true, "Stream each task's log lines")
+	pollflags.RegisterPolling(cmd, &poll, runutil.RunPollInterval, runutil.RunPollTimeout)
+
+	telemetry.TrackWith(cmd, func(_ *cobra.Command, args []string) map[string]any {
+		return map[string]any{
+			"pipeline_id":   flags.PipelineID,
+			"run_id":        telemetry.FirstArg(args),
+			"scope":         flags.Scope,
+			"version":       flags.Version,
+			"logs":          logs,
+			"output_format": string(outputFormat),
+		}
+	})
+
+	return cmd
+}
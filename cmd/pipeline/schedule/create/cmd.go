@@ -71,7 +71,7 @@ Example:
 				Timezone:          timezone,
 			}
 
-			result, err := pipeline.CreateSchedule(pipelineID, body)
+			result, err := pipeline.CreateSchedule(cmd.Context(), pipelineID, body)
 			if err != nil {
 				return fmt.Errorf("create schedule: %w", err)
 			}
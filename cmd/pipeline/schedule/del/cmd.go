@@ -44,8 +44,8 @@ Example:
 		Args:         cobra.ExactArgs(1),
 		PreRunE:      auth.EnsureAuthenticatedE,
 		SilenceUsage: true,
-		RunE: func(_ *cobra.Command, args []string) error {
-			err := pipeline.DeleteSchedule(pipelineID, args[0])
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := pipeline.DeleteSchedule(cmd.Context(), pipelineID, args[0])
 			if err != nil {
 				return handleDeleteError(err, args[0])
 			}
@@ -48,7 +48,7 @@ Example:
 		RunE: func(cmd *cobra.Command, args []string) error {
 			outputFormat = outputformat.GetFormat(cmd)
 
-			result, err := pipeline.GetSchedule(pipelineID, args[0])
+			result, err := pipeline.GetSchedule(cmd.Context(), pipelineID, args[0])
 			if err != nil {
 				return handleGetError(err, args[0], outputFormat)
 			}
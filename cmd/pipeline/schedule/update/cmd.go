@@ -55,7 +55,7 @@ Example:
 				return err
 			}
 
-			result, err := pipeline.UpdateSchedule(pipelineID, args[0], body)
+			result, err := pipeline.UpdateSchedule(cmd.Context(), pipelineID, args[0], body)
 			if err != nil {
 				return fmt.Errorf("update schedule: %w", err)
 			}
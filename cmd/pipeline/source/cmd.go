@@ -63,7 +63,7 @@ Example:
 				return fmt.Errorf(errmsg.ResolveScope, err)
 			}
 
-			result, err := pipeline.GetPipelineSource(flags.PipelineID, scope, version)
+			result, err := pipeline.GetPipelineSource(cmd.Context(), flags.PipelineID, scope, version)
 			if err != nil {
 				return handleSourceError(err, flags.PipelineID, outputFormat)
 			}
@@ -76,7 +76,7 @@ Example:
 				return fmt.Errorf(errmsg.ResolveScope, err)
 			}
 
-			result, err := pipeline.GetTask(flags.PipelineID, scope, version, taskID)
+			result, err := pipeline.GetTask(cmd.Context(), flags.PipelineID, scope, version, taskID)
 			if err != nil {
 				return handleTaskNotFoundError(err, args[0], outputFormat)
 			}
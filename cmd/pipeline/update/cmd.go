@@ -70,7 +70,7 @@ Example:
 				return errors.New("at least one of a file, --name, --description, or --image must be specified")
 			}
 
-			result, err := pipeline.UpdatePipeline(pipelineID, filePath, imageID, name, description)
+			result, err := pipeline.UpdatePipeline(cmd.Context(), pipelineID, filePath, imageID, name, description)
 			if err != nil {
 				return fmt.Errorf("update pipeline: %w", err)
 			}
@@ -54,7 +54,7 @@ Example:
 				return fmt.Errorf("invalid version: %q (expected a positive integer)", args[0])
 			}
 
-			result, err := pipeline.GetVersion(pipelineID, versionID)
+			result, err := pipeline.GetVersion(cmd.Context(), pipelineID, versionID)
 			if err != nil {
 				return handleGetError(err, args[0], outputFormat)
 			}
@@ -46,7 +46,7 @@ Example:
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			outputFormat = outputformat.GetFormat(cmd)
 
-			items, err := pipeline.ListVersions(pipelineID, offset, limit)
+			items, err := pipeline.ListVersions(cmd.Context(), pipelineID, offset, limit)
 			if err != nil {
 				return fmt.Errorf("list versions: %w", err)
 			}
@@ -109,6 +109,14 @@ func createPluginCommand(p internalPlugin.DiscoveredPlugin) *cobra.Command {
 		},
 	}
 
+	// Without flag parsing cobra knows nothing about the plugin's arguments,
+	// so a plugin that can complete them answers for itself.
+	if manifest.Completion {
+		cmd.ValidArgsFunction = func(pluginCmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+			return internalPlugin.CompletePlugin(pluginCmd.Context(), p, args, toComplete, pluginCmd.Root().PersistentFlags())
+		}
+	}
+
 	telemetry.TrackPlugin(cmd, manifest.Version)
 
 	return cmd
@@ -195,6 +203,8 @@ func askYesNo() bool {
 }
 
 // performPluginUpdate runs the backup → install → validate cycle for a plugin update.
+// It never installs unsigned updates: an update installs when its archive is
+// signed, or the registry index listing its checksum is, by a trusted key.
 func performPluginUpdate(result *internalPlugin.UpdateCheckResult) {
 	err := shared.RunPluginUpdate(
 		result.PluginName,
@@ -202,6 +212,7 @@ func performPluginUpdate(result *internalPlugin.UpdateCheckResult) {
 		result.RegistryPlugin,
 		*result.LatestVersion,
 		result.BaseURL,
+		false,
 	)
 	if err != nil {
 		fmt.Println(tui.ErrorStyle.Render(fmt.Sprintf("✗ Failed to update %s: %v", result.PluginName, err)))
@@ -15,11 +15,17 @@
 package plugin
 
 import (
+	"bytes"
+	"os"
 	"path/filepath"
+	"runtime"
 	"testing"
 
+	internalPlugin "github.com/datarobot/cli/internal/plugin"
 	"github.com/datarobot/cli/internal/testutil"
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestIsManagedPlugin(t *testing.T) {
@@ -59,3 +65,51 @@ func TestIsManagedPlugin(t *testing.T) {
 		assert.False(t, isManagedPlugin(pathPlugin))
 	})
 }
+
+func TestPluginCommandCompletion(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("completion script is POSIX shell")
+	}
+
+	script := filepath.Join(t.TempDir(), "dr-deployer")
+	require.NoError(t, os.WriteFile(script, []byte("#!/bin/sh\n"+
+		"[ \"$1\" = \"__complete\" ] || exit 1\n"+
+		"echo \"staging\"\n"+
+		"echo \"prod\"\n"+
+		"echo :4\n"), 0o755))
+
+	complete := func(t *testing.T, manifest internalPlugin.PluginManifest) string {
+		t.Helper()
+
+		root := &cobra.Command{Use: "dr"}
+		root.AddGroup(&cobra.Group{ID: "plugin", Title: "Plugin Commands:"})
+		root.AddCommand(createPluginCommand(internalPlugin.DiscoveredPlugin{Manifest: manifest, Executable: script}))
+
+		var out bytes.Buffer
+
+		root.SetOut(&out)
+		root.SetErr(&bytes.Buffer{})
+		root.SetArgs([]string{cobra.ShellCompRequestCmd, manifest.Name, "deploy", ""})
+
+		require.NoError(t, root.Execute())
+
+		return out.String()
+	}
+
+	t.Run("plugin declaring completion answers for its arguments", func(t *testing.T) {
+		out := complete(t, internalPlugin.PluginManifest{
+			BasicPluginManifest: internalPlugin.BasicPluginManifest{Name: "deployer"},
+			Completion:          true,
+		})
+
+		assert.Equal(t, "staging\nprod\n:4\n", out)
+	})
+
+	t.Run("plugin without completion keeps the default", func(t *testing.T) {
+		out := complete(t, internalPlugin.PluginManifest{
+			BasicPluginManifest: internalPlugin.BasicPluginManifest{Name: "plain"},
+		})
+
+		assert.Equal(t, ":0\n", out)
+	})
+}
@@ -36,6 +36,7 @@ var (
 	filePath          string
 	pluginURL         string
 	yesFlag           bool
+	allowUnsigned     bool
 )
 
 func Cmd() *cobra.Command {
@@ -52,6 +53,11 @@ Use --version to specify a version constraint:
   - Minimum: >=1.0.0
   - Latest: latest (default)
 
+Registry installs are verified against the archive's detached signature,
+using the public keys listed under plugin.trusted_keys in drconfig.yaml. A
+plugin whose signature is missing or does not verify is refused unless
+--allow-unsigned is given.
+
 Use --file to install directly from a local .tar.xz archive instead of the registry.
 Use --url to install directly from an HTTP/HTTPS URL instead of the registry.`,
 		Example: `  dr plugin install assist
@@ -72,11 +78,14 @@ Use --url to install directly from an HTTP/HTTPS URL instead of the registry.`,
 	cmd.Flags().StringVar(&filePath, "file", "", "Install from a local .tar.xz archive instead of the registry")
 	cmd.Flags().StringVar(&pluginURL, "url", "", "Install from an HTTP/HTTPS URL instead of the registry")
 	cmd.Flags().BoolVarP(&yesFlag, "yes", "y", false, `Assume "yes" when prompted to install plugin dependencies.`)
+	cmd.Flags().BoolVar(&allowUnsigned, "allow-unsigned", false, "Install a registry plugin whose signature is missing or does not verify")
 
 	// Mark mutually exclusive flags
 	cmd.MarkFlagsMutuallyExclusive("list", "versions", "version", "file", "url")
 	cmd.MarkFlagsMutuallyExclusive("file", "registry-url")
 	cmd.MarkFlagsMutuallyExclusive("url", "registry-url")
+	cmd.MarkFlagsMutuallyExclusive("file", "allow-unsigned")
+	cmd.MarkFlagsMutuallyExclusive("url", "allow-unsigned")
 
 	_ = viperx.BindEnv("yes", "DATAROBOT_CLI_NON_INTERACTIVE")
 
@@ -172,7 +181,7 @@ func runInstallFromRegistry(args []string) error {
 
 	if err := tui.RunWithSpinner(
 		fmt.Sprintf("Installing %s %s…", pluginEntry.Name, version.Version),
-		func() error { return plugin.InstallPlugin(pluginEntry, *version, baseURL) },
+		func() error { return plugin.InstallPlugin(pluginEntry, *version, baseURL, allowUnsigned) },
 	); err != nil {
 		return fmt.Errorf("failed to install plugin: %w", err)
 	}
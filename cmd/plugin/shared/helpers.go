@@ -36,8 +36,8 @@ func NormalizeRegistryURL(url string) string {
 
 // RunPluginUpdate performs the backup → install → validate → rollback cycle
 // for upgrading a managed plugin. It returns nil only when the update succeeds
-// and validation passes.
-func RunPluginUpdate(pluginName, _ string, entry plugin.RegistryPlugin, version plugin.RegistryVersion, baseURL string) error {
+// and validation passes. allowUnsigned is passed to plugin.InstallPlugin.
+func RunPluginUpdate(pluginName, _ string, entry plugin.RegistryPlugin, version plugin.RegistryVersion, baseURL string, allowUnsigned bool) error {
 	backupPath, err := plugin.BackupPlugin(pluginName)
 	if err != nil {
 		return fmt.Errorf("backup %s: %w", pluginName, err)
@@ -45,7 +45,7 @@ func RunPluginUpdate(pluginName, _ string, entry plugin.RegistryPlugin, version
 
 	defer plugin.CleanupBackup(backupPath)
 
-	if err := plugin.InstallPlugin(entry, version, baseURL); err != nil {
+	if err := plugin.InstallPlugin(entry, version, baseURL, allowUnsigned); err != nil {
 		rollbackErr := rollbackPlugin(pluginName, backupPath)
 		if rollbackErr != nil {
 			return errors.Join(
@@ -27,8 +27,9 @@ import (
 )
 
 var (
-	registryURL string
-	checkAll    bool
+	registryURL   string
+	checkAll      bool
+	allowUnsigned bool
 )
 
 func Cmd() *cobra.Command {
@@ -46,6 +47,7 @@ If no plugin name is provided with --all, checks all installed plugins for updat
 
 	cmd.Flags().StringVar(&registryURL, "registry-url", plugin.PluginRegistryURL, "URL of the plugin registry")
 	cmd.Flags().BoolVar(&checkAll, "all", false, "Update all installed plugins")
+	cmd.Flags().BoolVar(&allowUnsigned, "allow-unsigned", false, "Install updates whose signature is missing or does not verify")
 
 	telemetry.TrackWith(cmd, func(_ *cobra.Command, args []string) map[string]any {
 		return map[string]any{
@@ -169,7 +171,7 @@ func updateSinglePlugin(p plugin.InstalledPlugin, registry *plugin.PluginRegistr
 	if err := tui.RunWithSpinner(
 		fmt.Sprintf("Updating %s from %s to %s…", p.Name, p.Version, latestVersion.Version),
 		func() error {
-			return shared.RunPluginUpdate(p.Name, p.Version, pluginEntry, *latestVersion, baseURL)
+			return shared.RunPluginUpdate(p.Name, p.Version, pluginEntry, *latestVersion, baseURL, allowUnsigned)
 		},
 	); err != nil {
 		fmt.Println(tui.ErrorStyle.Render(fmt.Sprintf("✗ Failed to update %s: %v", p.Name, err)))
@@ -109,6 +109,10 @@ using pre-built templates. Get from idea to production in minutes, not hours.
 				return err
 			}
 
+			if err := setupHTTPRecording(); err != nil {
+				return err
+			}
+
 			// Initialize telemetry client
 			// Always collect common properties for logging (even in dry-run mode),
 			// but only send to Amplitude if enabled.
@@ -235,6 +239,7 @@ func init() {
 	RootCmd.PersistentFlags().Bool("debug", false, "debug output")
 	RootCmd.PersistentFlags().Bool("all-commands", false, "display all available commands and their flags in tree format")
 	RootCmd.PersistentFlags().Bool(config.SkipAuthKey, false, "skip authentication checks (for advanced users)")
+	RootCmd.PersistentFlags().String(config.ProfileKey, "", "use the named auth profile (default: the current profile)")
 	RootCmd.PersistentFlags().Bool("force-interactive", false, "force setup wizards to run even if already completed")
 	RootCmd.PersistentFlags().Duration("plugin-discovery-timeout", 2*time.Second, "timeout for plugin discovery (0s disables)")
 	RootCmd.PersistentFlags().Duration("plugin-update-check-interval", internalPlugin.DefaultUpdateCheckInterval, "cooldown between plugin update checks (0s disables)")
@@ -246,6 +251,10 @@ func init() {
 	RootCmd.PersistentFlags().String("ca-cert", "", "path to a PEM-encoded CA certificate bundle")
 	registerExportWindowsCertsFlag(RootCmd.Command)
 
+	// HTTP session capture, for bug reports and offline regression tests.
+	RootCmd.PersistentFlags().String("record-http", "", "record DataRobot API requests and responses to a HAR `file`, with secrets redacted")
+	RootCmd.PersistentFlags().String("replay-http", "", "answer DataRobot API requests from a HAR `file` written by --record-http instead of the network")
+
 	outputformat.AddPersistentFlag(RootCmd.Command, &rootOutputFormat)
 
 	// Universal flags: bound to viper AND forwarded to plugin subprocesses as DATAROBOT_CLI_* env vars.
@@ -259,11 +268,14 @@ func init() {
 	// Non-universal flags: bound to viper only.
 	_ = viperx.BindPFlag("config", RootCmd.PersistentFlags().Lookup("config"))
 	_ = viperx.BindPFlag(config.SkipAuthKey, RootCmd.PersistentFlags().Lookup(config.SkipAuthKey))
+	_ = viperx.BindPFlag(config.ProfileKey, RootCmd.PersistentFlags().Lookup(config.ProfileKey))
 	_ = viperx.BindPFlag("force-interactive", RootCmd.PersistentFlags().Lookup("force-interactive"))
 	_ = viperx.BindPFlag("plugin-discovery-timeout", RootCmd.PersistentFlags().Lookup("plugin-discovery-timeout"))
 	_ = viperx.BindPFlag("plugin-update-check-interval", RootCmd.PersistentFlags().Lookup("plugin-update-check-interval"))
 	_ = viperx.BindPFlag("skip-plugin-update-check", RootCmd.PersistentFlags().Lookup("skip-plugin-update-check"))
 	_ = viperx.BindPFlag("output-format", RootCmd.PersistentFlags().Lookup("output-format"))
+	_ = viperx.BindPFlag("record-http", RootCmd.PersistentFlags().Lookup("record-http"))
+	_ = viperx.BindPFlag("replay-http", RootCmd.PersistentFlags().Lookup("replay-http"))
 
 	// Add command groups (plugin group added conditionally by registerPluginCommands)
 	RootCmd.AddGroup(
@@ -377,7 +389,7 @@ func setUnknownArgGuards(root *cobra.Command) {
 
 // initializeConfig initializes the configuration by reading from
 // various sources such as environment variables and config files.
-func initializeConfig(_ *cobra.Command) error {
+func initializeConfig(cmd *cobra.Command) error {
 	var err error
 
 	// Set up Viper to process environment variables
@@ -400,6 +412,9 @@ func initializeConfig(_ *cobra.Command) error {
 
 	_ = viperx.BindEnv(config.APIConsumerTrackingEnabled, "DATAROBOT_API_CONSUMER_TRACKING_ENABLED")
 
+	// DATAROBOT_PROFILE selects an auth profile the way --profile does.
+	_ = viperx.BindEnv(config.ProfileKey, "DATAROBOT_PROFILE")
+
 	// If DATAROBOT_CLI_CONFIG is set and no explicit --config flag was provided,
 	// use the environment variable value
 	if configFilePath == "" {
@@ -414,6 +429,26 @@ func initializeConfig(_ *cobra.Command) error {
 		return fmt.Errorf("Failed to read config file: %w", err)
 	}
 
+	// A failed migration leaves the plaintext tokens where they were, so the
+	// command can still run; it is retried on the next invocation.
+	if err := config.MigratePlaintextTokens(); err != nil {
+		log.Warn("Could not move API tokens out of drconfig.yaml.", "error", err)
+	}
+
+	// Overlay the selected profile's endpoint and token. This must follow the
+	// read above, since the profiles themselves live in the config file.
+	_, createsProfile := cmd.Annotations[config.CreatesProfileAnnotationKey]
+
+	if err := config.ApplyActiveProfile(createsProfile); err != nil {
+		return fmt.Errorf("Failed to select auth profile: %w", err)
+	}
+
+	// An unreadable store (a locked keyring, a wrong passphrase) only matters
+	// to commands that need the token, and those report a missing one.
+	if err := config.LoadStoredToken(); err != nil {
+		log.Warn("Could not read the stored API token.", "error", err)
+	}
+
 	return nil
 }
 
@@ -392,6 +392,28 @@ func TestPrivateCATLSFlagsAlwaysRegistered(t *testing.T) {
 // TraverseChildren is ever removed from RootCmd. Without it, universal flags
 // placed before a plugin name (e.g. "dr --debug myplugin") would be silently
 // swallowed into the plugin's raw args instead of being parsed by core.
+// TestOnlyLoginCreatesProfiles guards the one exemption from the unknown
+// --profile check: every other command must fail on a misspelt name.
+func TestOnlyLoginCreatesProfiles(t *testing.T) {
+	var creators []string
+
+	var walk func(*cobra.Command)
+
+	walk = func(c *cobra.Command) {
+		if _, ok := c.Annotations[config.CreatesProfileAnnotationKey]; ok {
+			creators = append(creators, c.CommandPath())
+		}
+
+		for _, child := range c.Commands() {
+			walk(child)
+		}
+	}
+
+	walk(RootCmd.Command)
+
+	assert.Equal(t, []string{"dr auth login"}, creators)
+}
+
 func TestRootCmdTraverseChildrenEnabled(t *testing.T) {
 	assert.True(t, RootCmd.TraverseChildren,
 		"RootCmd must have TraverseChildren:true so universal flags (--debug, etc.) "+
@@ -15,6 +15,7 @@
 package add
 
 import (
+	"crypto/ed25519"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -33,6 +34,8 @@ func Cmd() *cobra.Command {
 
 	var fromFile string
 
+	var signingKey string
+
 	cmd := &cobra.Command{
 		Use:   "add <path-to-index.json>",
 		Short: "Add a packaged plugin version to a registry file (index.json)",
@@ -55,18 +58,33 @@ Example with manual flags:
     --version 1.0.0 \
     --url my-plugin/my-plugin-1.0.0.tar.xz \
     --sha256 abc123... \
-    --release-date 2026-01-28`,
+    --signature my-plugin/my-plugin-1.0.0.tar.xz.sig \
+    --release-date 2026-01-28
+
+Pass --signing-key to re-sign the index after the change, writing
+<index.json>.sig beside it.`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			indexPath := args[0]
 
+			var key ed25519.PrivateKey
+
+			if signingKey != "" {
+				var err error
+
+				if key, err = plugin.ReadSigningKey(signingKey); err != nil {
+					return err
+				}
+			}
+
 			if fromFile != "" {
-				return addFromFile(indexPath, fromFile)
+				return addFromFile(indexPath, fromFile, key)
 			}
 
 			releaseDate, _ := cmd.Flags().GetString("release-date")
 			url, _ := cmd.Flags().GetString("url")
 			sha256, _ := cmd.Flags().GetString("sha256")
+			signature, _ := cmd.Flags().GetString("signature")
 
 			if pluginName == "" {
 				return errors.New("either --from-file or --name is required")
@@ -88,7 +106,7 @@ Example with manual flags:
 				return errors.New("--release-date is required")
 			}
 
-			return addPluginToIndex(indexPath, pluginName, version, url, sha256, releaseDate)
+			return addPluginToIndex(indexPath, pluginName, version, url, sha256, signature, releaseDate, key)
 		},
 	}
 
@@ -97,7 +115,9 @@ Example with manual flags:
 	cmd.Flags().StringVar(&version, "version", "", "Plugin version (required if not using --from-file)")
 	cmd.Flags().String("url", "", "Archive URL relative to registry base (required if not using --from-file)")
 	cmd.Flags().String("sha256", "", "SHA256 checksum of the archive (required if not using --from-file)")
+	cmd.Flags().String("signature", "", "Detached signature URL relative to registry base, as written by 'dr self plugin package --signing-key'")
 	cmd.Flags().String("release-date", "", "Release date in YYYY-MM-DD format (required if not using --from-file)")
+	cmd.Flags().StringVar(&signingKey, "signing-key", "", "Ed25519 private key file from 'dr self plugin keygen'; re-signs the updated index")
 
 	// Mark flag constraints
 	cmd.MarkFlagsRequiredTogether("name", "version", "url", "sha256", "release-date")
@@ -106,6 +126,7 @@ Example with manual flags:
 	cmd.MarkFlagsMutuallyExclusive("from-file", "url")
 	cmd.MarkFlagsMutuallyExclusive("from-file", "sha256")
 	cmd.MarkFlagsMutuallyExclusive("from-file", "release-date")
+	cmd.MarkFlagsMutuallyExclusive("from-file", "signature")
 
 	return cmd
 }
@@ -115,10 +136,11 @@ type indexFragment struct {
 	Version     string `json:"version"`
 	URL         string `json:"url"`
 	SHA256      string `json:"sha256"`
+	Signature   string `json:"signature,omitempty"`
 	ReleaseDate string `json:"releaseDate"`
 }
 
-func addFromFile(indexPath, fragmentPath string) error {
+func addFromFile(indexPath, fragmentPath string, key ed25519.PrivateKey) error {
 	data, err := os.ReadFile(fragmentPath)
 	if err != nil {
 		return fmt.Errorf("failed to read fragment file: %w", err)
@@ -146,10 +168,10 @@ func addFromFile(indexPath, fragmentPath string) error {
 		"name", fragment.Name,
 		"version", fragment.Version)
 
-	return addPluginToIndex(indexPath, fragment.Name, fragment.Version, fragment.URL, fragment.SHA256, fragment.ReleaseDate)
+	return addPluginToIndex(indexPath, fragment.Name, fragment.Version, fragment.URL, fragment.SHA256, fragment.Signature, fragment.ReleaseDate, key)
 }
 
-func addPluginToIndex(indexPath, pluginName, version, url, sha256, releaseDate string) error {
+func addPluginToIndex(indexPath, pluginName, version, url, sha256, signature, releaseDate string, key ed25519.PrivateKey) error {
 	absPath, err := filepath.Abs(indexPath)
 	if err != nil {
 		return fmt.Errorf("failed to resolve registry path: %w", err)
@@ -168,6 +190,7 @@ func addPluginToIndex(indexPath, pluginName, version, url, sha256, releaseDate s
 		Version:     version,
 		URL:         url,
 		SHA256:      sha256,
+		Signature:   signature,
 		ReleaseDate: releaseDate,
 	}
 
@@ -197,6 +220,10 @@ func addPluginToIndex(indexPath, pluginName, version, url, sha256, releaseDate s
 		return err
 	}
 
+	if err := plugin.SignIndex(absPath, key); err != nil {
+		return fmt.Errorf("failed to sign index: %w", err)
+	}
+
 	fmt.Printf("✅ Added %s version %s to %s\n", pluginName, version, absPath)
 
 	return nil
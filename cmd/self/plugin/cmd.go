@@ -16,8 +16,10 @@ package selfplugin
 
 import (
 	"github.com/datarobot/cli/cmd/self/plugin/add"
+	"github.com/datarobot/cli/cmd/self/plugin/keygen"
 	pluginpackage "github.com/datarobot/cli/cmd/self/plugin/package"
 	"github.com/datarobot/cli/cmd/self/plugin/publish"
+	"github.com/datarobot/cli/cmd/self/plugin/sign"
 	"github.com/spf13/cobra"
 )
 
@@ -31,6 +33,8 @@ func Cmd() *cobra.Command {
 		add.Cmd(),
 		publish.Cmd(),
 		pluginpackage.Cmd(),
+		keygen.Cmd(),
+		sign.Cmd(),
 	)
 
 	return cmd
@@ -0,0 +1,83 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keygen
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/datarobot/cli/internal/plugin"
+	"github.com/spf13/cobra"
+)
+
+func Cmd() *cobra.Command {
+	var output string
+
+	cmd := &cobra.Command{
+		Use:   "keygen",
+		Short: "Create a key pair for signing plugin archives",
+		Long: `Create an Ed25519 key pair for signing plugin archives.
+
+The private key is written to --output, readable only by you; pass it to
+'dr self plugin package' or 'dr self plugin publish' with --signing-key.
+The public key is printed. Users who install from your registry add it to
+drconfig.yaml:
+
+  plugin:
+    trusted_keys:
+      - <public key>
+
+Example:
+  dr self plugin keygen --output ~/.config/datarobot/plugin-signing.key`,
+		Args: cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return generateKey(output)
+		},
+	}
+
+	cmd.Flags().StringVarP(&output, "output", "o", "plugin-signing.key", "Path to write the private key to")
+
+	return cmd
+}
+
+func generateKey(output string) error {
+	if _, err := os.Stat(output); err == nil {
+		return fmt.Errorf("%s already exists; refusing to overwrite a signing key", output)
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	public, private, err := plugin.GenerateSigningKey()
+	if err != nil {
+		return fmt.Errorf("failed to generate key: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(output), 0o700); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	if err := os.WriteFile(output, []byte(private+"\n"), 0o600); err != nil {
+		return fmt.Errorf("failed to write private key: %w", err)
+	}
+
+	fmt.Printf("\n✅ Private key written to: %s\n", output)
+	fmt.Printf("   Public key: %s\n\n", public)
+	fmt.Println("Keep the private key secret. Publish the public key so users can add it to")
+	fmt.Println("plugin.trusted_keys in drconfig.yaml.")
+
+	return nil
+}
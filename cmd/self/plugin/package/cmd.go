@@ -16,6 +16,7 @@ package pluginpackage
 
 import (
 	"archive/tar"
+	"crypto/ed25519"
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
@@ -38,6 +39,8 @@ func Cmd() *cobra.Command {
 
 	var indexOutput string
 
+	var signingKey string
+
 	cmd := &cobra.Command{
 		Use:   "package <plugin-dir>",
 		Short: "Package a plugin directory into a .tar.xz archive",
@@ -51,12 +54,17 @@ The command will:
   1. Validate the manifest
   2. Create a .tar.xz archive
   3. Calculate SHA256 checksum
-	  4. Output a JSON snippet for the registry`,
+  4. Sign the archive when --signing-key is given, writing <archive>.sig
+  5. Output a JSON snippet for the registry
+
+Registry installs refuse unsigned archives unless --allow-unsigned is passed,
+so release archives should always be signed. Create a key pair with
+'dr self plugin keygen'.`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			pluginDir := args[0]
 
-			return packagePlugin(pluginDir, outputDir, indexOutput)
+			return packagePlugin(pluginDir, outputDir, indexOutput, signingKey)
 		},
 	}
 
@@ -64,11 +72,13 @@ The command will:
 		"Output file path (e.g., my-plugin-1.0.0.tar.xz) or directory (defaults to current directory)")
 	cmd.Flags().StringVar(&indexOutput, "index-output", "",
 		"Save registry JSON fragment to file for use with 'dr self plugin add --from-file'")
+	cmd.Flags().StringVar(&signingKey, "signing-key", "",
+		"Ed25519 private key file from 'dr self plugin keygen'; writes a detached signature beside the archive")
 
 	return cmd
 }
 
-func packagePlugin(pluginDir, output, indexOutput string) error {
+func packagePlugin(pluginDir, output, indexOutput, signingKey string) error {
 	pluginDir = filepath.Clean(pluginDir)
 
 	if _, err := os.Stat(pluginDir); os.IsNotExist(err) {
@@ -84,6 +94,14 @@ func packagePlugin(pluginDir, output, indexOutput string) error {
 		return err
 	}
 
+	var key ed25519.PrivateKey
+
+	if signingKey != "" {
+		if key, err = plugin.ReadSigningKey(signingKey); err != nil {
+			return err
+		}
+	}
+
 	archiveName := fmt.Sprintf("%s-%s.tar.xz", manifest.Name, manifest.Version)
 	archivePath := determineOutputPath(output, archiveName)
 
@@ -106,24 +124,52 @@ func packagePlugin(pluginDir, output, indexOutput string) error {
 		return fmt.Errorf("failed to calculate checksum: %w", err)
 	}
 
+	signature, sigPath, err := signArchive(archivePath, archiveName, manifest.Name, key)
+	if err != nil {
+		return err
+	}
+
 	releaseDate := time.Now().Format("2006-01-02")
 
 	fmt.Printf("\n✅ Package created: %s\n", archivePath)
-	fmt.Printf("   SHA256: %s\n\n", sha256sum)
+	fmt.Printf("   SHA256: %s\n", sha256sum)
+
+	if sigPath != "" {
+		fmt.Printf("   Signature: %s\n", sigPath)
+	}
+
+	fmt.Println()
 
 	if indexOutput != "" {
-		if err := saveIndexFragment(indexOutput, manifest, archiveName, sha256sum, releaseDate); err != nil {
+		if err := saveIndexFragment(indexOutput, manifest, archiveName, sha256sum, signature, releaseDate); err != nil {
 			return fmt.Errorf("failed to save registry fragment: %w", err)
 		}
 
 		fmt.Printf("📝 Registry fragment saved to: %s\n\n", indexOutput)
 	}
 
-	printIndexJSON(manifest, archiveName, sha256sum, releaseDate)
+	printIndexJSON(manifest, archiveName, sha256sum, signature, releaseDate)
 
 	return nil
 }
 
+// signArchive writes the archive's detached signature when a key was given and
+// returns its registry URL and path. Without a key it warns and returns "".
+func signArchive(archivePath, archiveName, pluginName string, key ed25519.PrivateKey) (string, string, error) {
+	if key == nil {
+		log.Warn("Archive is not signed; registry installs refuse it without --allow-unsigned. Pass --signing-key to sign it.")
+
+		return "", "", nil
+	}
+
+	sigPath, err := plugin.SignArchive(archivePath, key)
+	if err != nil {
+		return "", "", err
+	}
+
+	return fmt.Sprintf("%s/%s%s", pluginName, archiveName, plugin.SignatureExt), sigPath, nil
+}
+
 func loadManifest(pluginDir string) (*plugin.PluginManifest, error) {
 	manifestPath := filepath.Join(pluginDir, "manifest.json")
 
@@ -258,7 +304,7 @@ func calculateSHA256(filePath string) (string, error) {
 	return hex.EncodeToString(hash.Sum(nil)), nil
 }
 
-func printIndexJSON(manifest *plugin.PluginManifest, archiveName, sha256sum, releaseDate string) {
+func printIndexJSON(manifest *plugin.PluginManifest, archiveName, sha256sum, signature, releaseDate string) {
 	fmt.Println("Add to registry (index.json):")
 	fmt.Println("```json")
 
@@ -269,6 +315,10 @@ func printIndexJSON(manifest *plugin.PluginManifest, archiveName, sha256sum, rel
 		"releaseDate": releaseDate,
 	}
 
+	if signature != "" {
+		snippet["signature"] = signature
+	}
+
 	data, _ := json.MarshalIndent(snippet, "", "  ")
 	fmt.Println(string(data))
 
@@ -280,15 +330,17 @@ type indexFragment struct {
 	Version     string `json:"version"`
 	URL         string `json:"url"`
 	SHA256      string `json:"sha256"`
+	Signature   string `json:"signature,omitempty"`
 	ReleaseDate string `json:"releaseDate"`
 }
 
-func saveIndexFragment(path string, manifest *plugin.PluginManifest, archiveName, sha256sum, releaseDate string) error {
+func saveIndexFragment(path string, manifest *plugin.PluginManifest, archiveName, sha256sum, signature, releaseDate string) error {
 	fragment := indexFragment{
 		Name:        manifest.Name,
 		Version:     manifest.Version,
 		URL:         fmt.Sprintf("%s/%s", manifest.Name, archiveName),
 		SHA256:      sha256sum,
+		Signature:   signature,
 		ReleaseDate: releaseDate,
 	}
 
@@ -16,6 +16,7 @@ package publish
 
 import (
 	"archive/tar"
+	"crypto/ed25519"
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
@@ -38,6 +39,8 @@ func Cmd() *cobra.Command {
 
 	var indexPath string
 
+	var signingKey string
+
 	cmd := &cobra.Command{
 		Use:   "publish <plugin-dir>",
 		Short: "Package and publish a plugin in one step",
@@ -47,10 +50,16 @@ This is an all-in-one command that:
   1. Validates the plugin manifest
   2. Creates a .tar.xz archive
   3. Copies it to plugins/<plugin-name>/<plugin-name>-<version>.tar.xz
-  4. Updates the index.json with the new version
+  4. Signs it when --signing-key is given, writing <archive>.sig beside it
+  5. Updates the index.json with the new version and its signature, and
+     re-signs the index when --signing-key is given
+
+Registry installs refuse unsigned archives unless --allow-unsigned is passed,
+so published archives should always be signed. Create a key pair with
+'dr self plugin keygen'.
 
 Example:
-  dr self plugin publish ./my-plugin
+  dr self plugin publish ./my-plugin --signing-key ~/.config/datarobot/plugin-signing.key
   dr self plugin publish ./my-plugin --plugins-dir docs/plugins --index docs/plugins/index.json`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -61,7 +70,7 @@ Example:
 				resolvedIndexPath = filepath.Join(pluginsDir, "index.json")
 			}
 
-			return publishPlugin(pluginDir, pluginsDir, resolvedIndexPath)
+			return publishPlugin(pluginDir, pluginsDir, resolvedIndexPath, signingKey)
 		},
 	}
 
@@ -69,11 +78,13 @@ Example:
 		"Directory where plugin archives are stored")
 	cmd.Flags().StringVar(&indexPath, "index", "",
 		"Path to the plugin index.json file (defaults to <plugins-dir>/index.json)")
+	cmd.Flags().StringVar(&signingKey, "signing-key", "",
+		"Ed25519 private key file from 'dr self plugin keygen'; signs the archive and the updated index")
 
 	return cmd
 }
 
-func publishPlugin(pluginDir, pluginsDir, indexPath string) error {
+func publishPlugin(pluginDir, pluginsDir, indexPath, signingKey string) error {
 	pluginDir = filepath.Clean(pluginDir)
 
 	if _, err := os.Stat(pluginDir); os.IsNotExist(err) {
@@ -89,6 +100,14 @@ func publishPlugin(pluginDir, pluginsDir, indexPath string) error {
 		return err
 	}
 
+	var key ed25519.PrivateKey
+
+	if signingKey != "" {
+		if key, err = plugin.ReadSigningKey(signingKey); err != nil {
+			return err
+		}
+	}
+
 	archiveName := fmt.Sprintf("%s-%s.tar.xz", manifest.Name, manifest.Version)
 	pluginOutputDir := filepath.Join(pluginsDir, manifest.Name)
 	archivePath := filepath.Join(pluginOutputDir, archiveName)
@@ -114,14 +133,37 @@ func publishPlugin(pluginDir, pluginsDir, indexPath string) error {
 	releaseDate := time.Now().Format("2006-01-02")
 
 	url := fmt.Sprintf("%s/%s", manifest.Name, archiveName)
+	signature := ""
+
+	if key != nil {
+		sigPath, err := plugin.SignArchive(archivePath, key)
+		if err != nil {
+			return err
+		}
+
+		log.Debug("Signed plugin archive", "signature", sigPath)
 
-	if err := addToIndex(indexPath, manifest.Name, manifest.Description, manifest.Version, url, sha256sum, releaseDate); err != nil {
+		signature = url + plugin.SignatureExt
+	} else {
+		log.Warn("Archive is not signed; registry installs refuse it without --allow-unsigned. Pass --signing-key to sign it.")
+	}
+
+	if err := addToIndex(indexPath, manifest.Name, manifest.Description, manifest.Version, url, sha256sum, signature, releaseDate); err != nil {
 		return fmt.Errorf("failed to update index: %w", err)
 	}
 
+	if err := plugin.SignIndex(indexPath, key); err != nil {
+		return fmt.Errorf("failed to sign index: %w", err)
+	}
+
 	fmt.Printf("\n✅ Published %s version %s\n", manifest.Name, manifest.Version)
 	fmt.Printf("   Archive: %s\n", archivePath)
 	fmt.Printf("   SHA256: %s\n", sha256sum)
+
+	if signature != "" {
+		fmt.Printf("   Signature: %s\n", archivePath+plugin.SignatureExt)
+	}
+
 	fmt.Printf("   Index: %s\n\n", indexPath)
 
 	return nil
@@ -243,7 +285,7 @@ func calculateSHA256(filePath string) (string, error) {
 	return hex.EncodeToString(hash.Sum(nil)), nil
 }
 
-func addToIndex(indexPath, pluginName, description, version, url, sha256sum, releaseDate string) error {
+func addToIndex(indexPath, pluginName, description, version, url, sha256sum, signature, releaseDate string) error {
 	absPath, err := filepath.Abs(indexPath)
 	if err != nil {
 		return fmt.Errorf("failed to resolve registry path: %w", err)
@@ -262,6 +304,7 @@ func addToIndex(indexPath, pluginName, description, version, url, sha256sum, rel
 		Version:     version,
 		URL:         url,
 		SHA256:      sha256sum,
+		Signature:   signature,
 		ReleaseDate: releaseDate,
 	}
 
@@ -0,0 +1,60 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sign
+
+import (
+	"fmt"
+
+	"github.com/datarobot/cli/internal/plugin"
+	"github.com/spf13/cobra"
+)
+
+func Cmd() *cobra.Command {
+	var signingKey string
+
+	cmd := &cobra.Command{
+		Use:   "sign <file>",
+		Short: "Sign a plugin archive or registry index",
+		Long: `Write the detached Ed25519 signature of a file to <file>.sig.
+
+Use it to sign a registry index.json after it changes, or an archive built
+elsewhere. The official registry's index is signed this way when the docs
+site is deployed.
+
+Example:
+  dr self plugin sign docs/plugins/index.json --signing-key ~/.config/datarobot/plugin-signing.key`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			key, err := plugin.ReadSigningKey(signingKey)
+			if err != nil {
+				return err
+			}
+
+			sigPath, err := plugin.SignArchive(args[0], key)
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("✅ Signature written to: %s\n", sigPath)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&signingKey, "signing-key", "", "Ed25519 private key file from 'dr self plugin keygen'")
+	_ = cmd.MarkFlagRequired("signing-key")
+
+	return cmd
+}
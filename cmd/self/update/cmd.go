@@ -15,202 +15,264 @@
 package update
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"runtime"
-	"strings"
+	"time"
 
-	"github.com/datarobot/cli/internal/fsutil"
-	"github.com/datarobot/cli/internal/log"
-	internalShell "github.com/datarobot/cli/internal/shell"
+	"github.com/datarobot/cli/internal/config"
+	"github.com/datarobot/cli/internal/config/viperx"
+	"github.com/datarobot/cli/internal/drapi"
+	"github.com/datarobot/cli/internal/selfupdate"
 	"github.com/datarobot/cli/internal/tools"
 	"github.com/datarobot/cli/internal/version"
+	"github.com/datarobot/cli/tui"
 	"github.com/spf13/cobra"
 )
 
-func Cmd() *cobra.Command { //nolint:cyclop
-	var force bool
+// downloadTimeout bounds reading the release index and downloading a
+// binary, retries included.
+const downloadTimeout = 10 * time.Minute
+
+type options struct {
+	force    bool
+	rollback bool
+	version  string
+	channel  string
+	mirror   string
+}
+
+// Deps holds the externally-injected collaborators for the update command.
+type Deps struct {
+	NewSource  func(mirror string) *selfupdate.Source
+	Executable func() (string, error)
+	GOOS       string
+	GOARCH     string
+
+	// Homebrew reports whether dr was installed as a Homebrew cask, and
+	// BrewUpgrade upgrades it.
+	Homebrew    func() bool
+	BrewUpgrade func() error
+}
+
+func defaultDeps() Deps {
+	return Deps{
+		NewSource: func(mirror string) *selfupdate.Source {
+			return &selfupdate.Source{
+				Client:    drapi.NewHTTPClient(downloadTimeout),
+				Mirror:    mirror,
+				Token:     os.Getenv("GITHUB_TOKEN"),
+				UserAgent: config.GetUserAgentHeader(),
+			}
+		},
+		Executable:  resolveExecutable,
+		GOOS:        runtime.GOOS,
+		GOARCH:      runtime.GOARCH,
+		Homebrew:    homebrewCask,
+		BrewUpgrade: brewUpgrade,
+	}
+}
+
+func Cmd() *cobra.Command {
+	return cmdWithDeps(defaultDeps())
+}
+
+func cmdWithDeps(deps Deps) *cobra.Command {
+	opts := options{channel: selfupdate.ChannelStable}
 
 	cmd := &cobra.Command{
 		Use:   "update",
 		Short: "🔄 Update DataRobot CLI",
-		Long: `Updates the DataRobot CLI to latest version. This will use Homebrew
-to update if it detects the installed cask;  otherwise it will use an OS-appropriate script
-with your default shell.
-`,
-
-		RunE: func(_ *cobra.Command, _ []string) error {
-			requirement, err := tools.GetSelfRequirement()
-			if err != nil {
-				return fmt.Errorf("get self requirement: %w", err)
+		Long: `Updates the DataRobot CLI to the latest release, or to the release given
+with --version. Homebrew installs are upgraded through Homebrew.
+
+Otherwise the release is downloaded from GitHub, or from the mirror set
+with --mirror or the update-mirror config key, and verified against the
+release's published checksums before it replaces the running binary. The
+binary it replaces is kept, and --rollback switches back to it.
+
+A mirror serves releases.json, in the GitHub releases API format, at its
+root and each release's files under <tag>/.
+
+Example:
+  dr self update
+  dr self update --version 0.2.50
+  dr self update --channel prerelease
+  dr self update --rollback`,
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if opts.mirror == "" {
+				opts.mirror = viperx.GetString(config.UpdateMirrorKey)
 			}
 
-			if tools.SufficientSelfVersion(requirement.MinimumVersion) && !force {
-				if requirement.MinimumVersion != "" {
-					fmt.Fprintf(os.Stderr, "Required version: %s. ", requirement.MinimumVersion)
+			if opts.rollback {
+				if opts.version != "" || cmd.Flags().Changed("channel") {
+					return errors.New("--rollback cannot be combined with --version or --channel")
 				}
 
-				fmt.Fprintf(os.Stderr, "Installed version: %s.\n", version.Version)
-				fmt.Fprintln(os.Stderr, "Skipping update. To force update to latest version, add -f flag.")
-
-				return nil
+				return rollback(cmd.OutOrStdout(), deps)
 			}
 
-			// Account for when dr-cli cask has been installed - via `brew install datarobot-oss/taps/dr-cli`
-			if runtime.GOOS == "darwin" { //nolint:nestif
-				// Try to find brew and check if datarobot-oss is installed
-				brewPath, err := exec.LookPath("brew")
-				if err == nil {
-					brewCheckCmd := exec.Command(brewPath, "list", "--cask", "dr-cli")
-
-					// If we have dr-cli cask installed then attempt upgrade (err above indicates dr-cli wasn't found)
-					if err := brewCheckCmd.Run(); err == nil {
-						// Update brew first
-						brewUpdateCmd := exec.Command(brewPath, "update")
-						brewUpdateCmd.Stdout = os.Stdout
-						brewUpdateCmd.Stderr = os.Stderr
-
-						if err := brewUpdateCmd.Run(); err != nil {
-							fmt.Fprintln(os.Stderr, "Error: ", err)
-							return fmt.Errorf("brew update: %w", err)
-						}
-
-						brewReinstallCmd := exec.Command(brewPath, "reinstall", "--cask", "dr-cli", "--force")
-						brewReinstallCmd.Stdout = os.Stdout
-						brewReinstallCmd.Stderr = os.Stderr
-
-						if err := brewReinstallCmd.Run(); err != nil {
-							fmt.Fprintln(os.Stderr, "Error: ", err)
-							return fmt.Errorf("brew reinstall: %w", err)
-						}
-
-						return nil
-					}
-				}
-			}
+			return update(cmd.Context(), cmd.OutOrStdout(), cmd.ErrOrStderr(), opts, deps)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&opts.force, "force", "f", false, "Force update to latest version")
+	cmd.Flags().StringVar(&opts.version, "version", "", "Install this release instead of the latest, e.g. 0.2.50")
+	cmd.Flags().StringVar(&opts.channel, "channel", opts.channel,
+		fmt.Sprintf("Release channel to update from (%s, %s)", selfupdate.ChannelStable, selfupdate.ChannelPrerelease))
+	cmd.Flags().StringVar(&opts.mirror, "mirror", "", "Base URL of a release mirror to use instead of GitHub")
+	cmd.Flags().BoolVar(&opts.rollback, "rollback", false, "Switch back to the binary the last update replaced")
+
+	_ = cmd.RegisterFlagCompletionFunc("channel", func(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+		return []string{selfupdate.ChannelStable, selfupdate.ChannelPrerelease}, cobra.ShellCompDirectiveNoFileComp
+	})
 
-			// Now, assuming we haven't upgraded via brew handle with OS specific command
-			shell, err := internalShell.DetectShell()
-			if err != nil {
-				fmt.Fprintln(os.Stderr, "Error while determining shell: ", err)
-				return fmt.Errorf("detect shell: %w", err)
+	return cmd
+}
+
+func update(ctx context.Context, out, errOut io.Writer, opts options, deps Deps) error {
+	// Without a pinned version, an installed dr that meets the project's
+	// requirement is left alone unless --force says otherwise.
+	if opts.version == "" && !opts.force {
+		requirement, err := tools.GetSelfRequirement()
+		if err != nil {
+			return fmt.Errorf("get self requirement: %w", err)
+		}
+
+		if tools.SufficientSelfVersion(requirement.MinimumVersion) {
+			if requirement.MinimumVersion != "" {
+				fmt.Fprintf(errOut, "Required version: %s. ", requirement.MinimumVersion)
 			}
 
-			var (
-				command    string
-				executable string
-				backup     string
-				execCmd    *exec.Cmd
-			)
+			fmt.Fprintf(errOut, "Installed version: %s.\n", version.Version)
+			fmt.Fprintln(errOut, "Skipping update. To force update to latest version, add -f flag.")
 
-			switch runtime.GOOS {
-			case "windows":
-				command = "irm https://raw.githubusercontent.com/datarobot-oss/cli/main/install.ps1 | iex"
+			return nil
+		}
+	}
 
-				var err error
+	if deps.Homebrew() {
+		if opts.version != "" || opts.channel != selfupdate.ChannelStable || opts.mirror != "" {
+			return errors.New("dr was installed with Homebrew, which only installs the latest release; use 'brew upgrade --cask dr-cli'")
+		}
 
-				executable, backup, err = backupExecutable()
-				if err != nil {
-					return err
-				}
+		return deps.BrewUpgrade()
+	}
 
-				// The install command is a PowerShell one-liner, so it must run
-				// under PowerShell regardless of the detected shell (e.g. cmd.exe,
-				// which uses /C and cannot run `irm ... | iex`).
-				execCmd = exec.Command("powershell", "-NoProfile", "-Command", command)
-			case "darwin", "linux":
-				command = "curl -fsSL https://raw.githubusercontent.com/datarobot-oss/cli/main/install.sh | sh"
-				execCmd = exec.Command(shell, "-c", command)
-			default:
-				return fmt.Errorf("could not determine OS: %s", runtime.GOOS)
-			}
+	source := deps.NewSource(opts.mirror)
 
-			execCmd.Stdout = os.Stdout
-			execCmd.Stderr = os.Stderr
-
-			// The install scripts (install.sh, install.ps1) default INSTALL_DIR to
-			// ~/.local/bin or %LOCALAPPDATA%\Programs\dr, ignoring where dr is
-			// actually installed. Point it at the running binary's directory so the
-			// update lands in place (e.g. DataRobot Codespaces install dr under a
-			// dr/ directory on PATH). Respect a user-provided INSTALL_DIR.
-			if _, ok := os.LookupEnv("INSTALL_DIR"); !ok {
-				if dir, ok := resolveInstallDir(); ok {
-					execCmd.Env = append(os.Environ(), "INSTALL_DIR="+dir)
-				}
-			}
+	release, err := source.Find(ctx, opts.version, opts.channel)
+	if err != nil {
+		return err
+	}
 
-			if err := execCmd.Run(); err != nil {
-				if runtime.GOOS == "windows" {
-					// rename back if update failed
-					revertErr := os.Rename(backup, executable)
-					if revertErr != nil {
-						log.Errorf("Could not revert executable from backup: %s\n", backup)
-					}
-				}
+	if release.Tag == selfupdate.Tag(version.Version) && !opts.force {
+		fmt.Fprintf(out, "dr %s is already installed.\n", release.Tag)
 
-				return fmt.Errorf("command execution failed: %w", err)
-			}
+		return nil
+	}
 
-			return nil
-		},
+	executable, err := deps.Executable()
+	if err != nil {
+		return err
 	}
 
-	cmd.Flags().BoolVarP(&force, "force", "f", false, "Force update to latest version")
+	fmt.Fprintln(errOut, tui.DimStyle.Render("Downloading "+selfupdate.AssetName(deps.GOOS, deps.GOARCH)+" "+release.Tag+"..."))
 
-	return cmd
+	data, err := source.Download(ctx, release, deps.GOOS, deps.GOARCH)
+	if err != nil {
+		return err
+	}
+
+	if err := selfupdate.Install(executable, data); err != nil {
+		return fmt.Errorf("install %s: %w", release.Tag, err)
+	}
+
+	fmt.Fprintln(out, tui.SuccessStyle.Render(fmt.Sprintf("Updated dr from %s to %s.", version.Version, release.Tag)))
+	fmt.Fprintln(out, tui.HintStyle.Render("Run 'dr self update --rollback' to switch back."))
+
+	return nil
 }
 
-// resolveInstallDir returns the directory of the currently running executable,
-// resolving any symlinks first so the path points at the real binary. It is used
-// to tell the install script where dr actually lives. The boolean is false when
-// the executable path cannot be determined, in which case callers should fall
-// back to the install script's default location.
-func resolveInstallDir() (string, bool) {
-	executable, err := os.Executable()
+func rollback(out io.Writer, deps Deps) error {
+	executable, err := deps.Executable()
 	if err != nil {
-		return "", false
+		return err
 	}
 
-	if resolved, err := filepath.EvalSymlinks(executable); err == nil {
-		executable = resolved
+	if err := selfupdate.Rollback(executable); err != nil {
+		if errors.Is(err, selfupdate.ErrNoPrevious) {
+			return fmt.Errorf("%w: no update has replaced %s", err, executable)
+		}
+
+		return err
 	}
 
-	return filepath.Dir(executable), true
+	fmt.Fprintln(out, tui.SuccessStyle.Render("Switched back to the previous dr."))
+	fmt.Fprintln(out, tui.HintStyle.Render("Run 'dr self update --rollback' again to undo."))
+
+	return nil
 }
 
-// backupExecutable creates a backup of the current CLI executable before updating.
-// It renames the existing executable to a versioned backup file (e.g., dr_v1.2.3).
-// If a backup from the same version already exists, it is removed first to avoid conflicts.
-//
-// Returns:
-//   - executable: absolute path to the original CLI executable
-//   - backup: absolute path to the backup file (with version suffix)
-//   - error: if determining the executable path, removing old backups, or creating the backup fails
-func backupExecutable() (string, string, error) {
-	executable, err := os.Executable()
+// homebrewCask reports whether dr was installed with `brew install
+// datarobot-oss/taps/dr-cli`.
+func homebrewCask() bool {
+	if runtime.GOOS != "darwin" {
+		return false
+	}
+
+	brewPath, err := exec.LookPath("brew")
 	if err != nil {
-		return "", "", fmt.Errorf("could not determine current executable: %w", err)
+		return false
 	}
 
-	dir, file := filepath.Split(executable)
-	ext := filepath.Ext(file)
-	name := strings.TrimSuffix(file, ext)
+	return exec.Command(brewPath, "list", "--cask", "dr-cli").Run() == nil
+}
 
-	backup := filepath.Join(dir, name+"_"+version.Version+ext)
+func brewUpgrade() error {
+	brewPath, err := exec.LookPath("brew")
+	if err != nil {
+		return fmt.Errorf("find brew: %w", err)
+	}
 
-	if fsutil.FileExists(backup) {
-		err = os.Remove(backup)
-		if err != nil {
-			return "", "", fmt.Errorf("could not remove old backup executable %s: %w", backup, err)
-		}
+	brewUpdateCmd := exec.Command(brewPath, "update")
+	brewUpdateCmd.Stdout = os.Stdout
+	brewUpdateCmd.Stderr = os.Stderr
+
+	if err := brewUpdateCmd.Run(); err != nil {
+		return fmt.Errorf("brew update: %w", err)
+	}
+
+	brewReinstallCmd := exec.Command(brewPath, "reinstall", "--cask", "dr-cli", "--force")
+	brewReinstallCmd.Stdout = os.Stdout
+	brewReinstallCmd.Stderr = os.Stderr
+
+	if err := brewReinstallCmd.Run(); err != nil {
+		return fmt.Errorf("brew reinstall: %w", err)
 	}
 
-	err = os.Rename(executable, backup)
+	return nil
+}
+
+// resolveExecutable returns the path of the running binary, resolving any
+// symlinks first so the update replaces the real binary rather than a link
+// to it on PATH.
+func resolveExecutable() (string, error) {
+	executable, err := os.Executable()
 	if err != nil {
-		return "", "", fmt.Errorf("could not backup current executable: %w", err)
+		return "", fmt.Errorf("could not determine current executable: %w", err)
+	}
+
+	if resolved, err := filepath.EvalSymlinks(executable); err == nil {
+		executable = resolved
 	}
 
-	return executable, backup, nil
+	return executable, nil
 }
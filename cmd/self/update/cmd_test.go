@@ -15,19 +15,29 @@
 package update
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"testing"
 
+	"github.com/datarobot/cli/internal/config"
+	"github.com/datarobot/cli/internal/config/viperx"
+	"github.com/datarobot/cli/internal/selfupdate"
+
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
-func TestResolveInstallDir(t *testing.T) {
-	dir, ok := resolveInstallDir()
+func TestResolveExecutable(t *testing.T) {
+	got, err := resolveExecutable()
 
-	require.True(t, ok, "expected resolveInstallDir to succeed for the test binary")
-	assert.True(t, filepath.IsAbs(dir), "expected an absolute directory, got %q", dir)
+	require.NoError(t, err, "expected resolveExecutable to succeed for the test binary")
+	assert.True(t, filepath.IsAbs(got), "expected an absolute path, got %q", got)
 
 	exe, err := os.Executable()
 	require.NoError(t, err)
@@ -35,14 +45,12 @@ func TestResolveInstallDir(t *testing.T) {
 	resolved, err := filepath.EvalSymlinks(exe)
 	require.NoError(t, err)
 
-	assert.Equal(t, filepath.Dir(resolved), dir,
-		"install dir should be the directory of the resolved executable")
+	assert.Equal(t, resolved, got, "the update should replace the resolved executable")
 }
 
-// TestResolveInstallDirFollowsSymlink verifies that when dr is invoked through a
-// symlink (as on PATH), the resolved install dir points at the real binary's
-// directory, not the symlink's directory.
-func TestResolveInstallDirFollowsSymlink(t *testing.T) {
+// TestResolveExecutableFollowsSymlink verifies that when dr is invoked through a
+// symlink (as on PATH), the binary replaced is the real one, not the symlink.
+func TestResolveExecutableFollowsSymlink(t *testing.T) {
 	exe, err := os.Executable()
 	require.NoError(t, err)
 
@@ -65,3 +73,186 @@ func TestResolveInstallDirFollowsSymlink(t *testing.T) {
 	assert.Equal(t, filepath.Dir(realExe), filepath.Dir(resolved))
 	assert.NotEqual(t, linkDir, filepath.Dir(resolved))
 }
+
+// stubReleases stands in for GitHub with two releases of a linux/amd64
+// binary whose contents are "dr <tag>".
+func stubReleases(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	files := map[string]string{}
+
+	var releases []selfupdate.Release
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/releases" {
+			_ = json.NewEncoder(w).Encode(releases)
+
+			return
+		}
+
+		body, ok := files[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+
+			return
+		}
+
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+
+	for _, tag := range []string{"v1.2.0", "v1.3.0"} {
+		binary := "dr " + tag
+		sum := sha256.Sum256([]byte(binary))
+
+		files["/"+tag+"/dr-linux-amd64"] = binary
+		files["/"+tag+"/checksums.txt"] = hex.EncodeToString(sum[:]) + "  dr-linux-amd64\n"
+
+		releases = append(releases, selfupdate.Release{Tag: tag, Assets: []selfupdate.Asset{
+			{Name: "dr-linux-amd64", URL: srv.URL + "/" + tag + "/dr-linux-amd64"},
+			{Name: "dr_" + tag + "_checksums.txt", URL: srv.URL + "/" + tag + "/checksums.txt"},
+		}})
+	}
+
+	files["/v1.2.0/dr-linux-amd64"] = "tampered"
+
+	return srv
+}
+
+type testEnv struct {
+	deps       Deps
+	executable string
+	mirrors    []string
+	brewed     bool
+	upgraded   bool
+}
+
+func newTestEnv(t *testing.T) *testEnv {
+	t.Helper()
+
+	srv := stubReleases(t)
+	env := &testEnv{executable: filepath.Join(t.TempDir(), "dr")}
+
+	require.NoError(t, os.WriteFile(env.executable, []byte("dr dev"), 0o755))
+
+	env.deps = Deps{
+		NewSource: func(mirror string) *selfupdate.Source {
+			env.mirrors = append(env.mirrors, mirror)
+
+			return &selfupdate.Source{Client: srv.Client(), IndexURL: srv.URL + "/releases"}
+		},
+		Executable: func() (string, error) { return env.executable, nil },
+		GOOS:       "linux",
+		GOARCH:     "amd64",
+		Homebrew:   func() bool { return env.brewed },
+		BrewUpgrade: func() error {
+			env.upgraded = true
+
+			return nil
+		},
+	}
+
+	t.Cleanup(viperx.Reset)
+
+	return env
+}
+
+func (env *testEnv) run(t *testing.T, args ...string) (string, error) {
+	t.Helper()
+
+	cmd := cmdWithDeps(env.deps)
+
+	var out bytes.Buffer
+
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs(args)
+
+	err := cmd.Execute()
+
+	return out.String(), err
+}
+
+func (env *testEnv) installed(t *testing.T) string {
+	t.Helper()
+
+	data, err := os.ReadFile(env.executable)
+	require.NoError(t, err)
+
+	return string(data)
+}
+
+func TestUpdate_InstallsLatestAndRollsBack(t *testing.T) {
+	env := newTestEnv(t)
+
+	out, err := env.run(t, "--force")
+	require.NoError(t, err)
+	assert.Contains(t, out, "Updated dr from dev to v1.3.0.")
+	assert.Equal(t, "dr v1.3.0", env.installed(t))
+
+	out, err = env.run(t, "--rollback")
+	require.NoError(t, err)
+	assert.Contains(t, out, "Switched back to the previous dr.")
+	assert.Equal(t, "dr dev", env.installed(t))
+}
+
+func TestUpdate_PinnedVersion(t *testing.T) {
+	env := newTestEnv(t)
+
+	_, err := env.run(t, "--version", "1.3.0", "--channel", "prerelease")
+	require.NoError(t, err)
+	assert.Equal(t, "dr v1.3.0", env.installed(t))
+
+	_, err = env.run(t, "--version", "2.0.0")
+	require.ErrorIs(t, err, selfupdate.ErrNoRelease)
+}
+
+func TestUpdate_ChecksumMismatchLeavesBinaryAlone(t *testing.T) {
+	env := newTestEnv(t)
+
+	_, err := env.run(t, "--version", "v1.2.0")
+	require.ErrorContains(t, err, "checksum mismatch")
+
+	assert.Equal(t, "dr dev", env.installed(t))
+	assert.NoFileExists(t, selfupdate.PreviousPath(env.executable))
+}
+
+func TestUpdate_MirrorFromConfig(t *testing.T) {
+	env := newTestEnv(t)
+	viperx.Set(config.UpdateMirrorKey, "https://mirror.example.com/dr")
+
+	_, err := env.run(t, "--force")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"https://mirror.example.com/dr"}, env.mirrors)
+
+	_, err = env.run(t, "--force", "--mirror", "https://other.example.com")
+	require.NoError(t, err)
+	assert.Equal(t, "https://other.example.com", env.mirrors[1], "--mirror wins over the config key")
+}
+
+func TestUpdate_Errors(t *testing.T) {
+	env := newTestEnv(t)
+
+	_, err := env.run(t, "--rollback")
+	require.ErrorIs(t, err, selfupdate.ErrNoPrevious)
+
+	_, err = env.run(t, "--rollback", "--version", "1.2.0")
+	require.ErrorContains(t, err, "--rollback cannot be combined")
+
+	_, err = env.run(t, "--force", "--channel", "nightly")
+	require.ErrorContains(t, err, `unknown channel "nightly"`)
+}
+
+func TestUpdate_Homebrew(t *testing.T) {
+	env := newTestEnv(t)
+	env.brewed = true
+
+	_, err := env.run(t, "--version", "1.3.0")
+	require.ErrorContains(t, err, "installed with Homebrew")
+	assert.False(t, env.upgraded)
+
+	_, err = env.run(t, "--force")
+	require.NoError(t, err)
+	assert.True(t, env.upgraded)
+	assert.Equal(t, "dr dev", env.installed(t), "Homebrew owns the binary")
+}
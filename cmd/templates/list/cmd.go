@@ -51,7 +51,7 @@ start building AI applications. Each template includes:
 💡 Use 'dr templates setup' for an interactive selection experience.`,
 		PreRunE: auth.EnsureAuthenticatedE,
 		RunE: func(cmd *cobra.Command, _ []string) error {
-			templateList, err := drapi.GetTemplates()
+			templateList, err := drapi.GetTemplates(cmd.Context())
 			if err != nil {
 				return err
 			}
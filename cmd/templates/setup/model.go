@@ -167,7 +167,7 @@ func handleExistingRepo(repoRoot string) tea.Msg {
 	envPath := filepath.Join(repoRoot, ".env")
 
 	// Try to fetch templates to match against git remote
-	templatesList, err := drapi.GetPublicTemplatesSorted()
+	templatesList, err := drapi.GetPublicTemplatesSorted(context.Background())
 	if err != nil {
 		if errors.Is(err, context.DeadlineExceeded) {
 			return networkTimeoutMsg()
@@ -221,7 +221,7 @@ func getTemplates(sessionID int) tea.Cmd {
 		}
 
 		// Not in a DataRobot repo, fetch templates and show gallery
-		templatesList, err := drapi.GetPublicTemplatesSorted()
+		templatesList, err := drapi.GetPublicTemplatesSorted(context.Background())
 		if err != nil {
 			if errors.Is(err, context.DeadlineExceeded) {
 				return networkTimeoutMsg()
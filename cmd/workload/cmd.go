@@ -18,10 +18,14 @@ import (
 	"github.com/datarobot/cli/cmd/workload/config"
 	"github.com/datarobot/cli/cmd/workload/create"
 	"github.com/datarobot/cli/cmd/workload/del"
+	"github.com/datarobot/cli/cmd/workload/diff"
 	"github.com/datarobot/cli/cmd/workload/endpoint"
 	"github.com/datarobot/cli/cmd/workload/get"
+	"github.com/datarobot/cli/cmd/workload/history"
+	initcmd "github.com/datarobot/cli/cmd/workload/init"
 	"github.com/datarobot/cli/cmd/workload/list"
 	"github.com/datarobot/cli/cmd/workload/logs"
+	"github.com/datarobot/cli/cmd/workload/rollback"
 	"github.com/datarobot/cli/cmd/workload/start"
 	"github.com/datarobot/cli/cmd/workload/status"
 	"github.com/datarobot/cli/cmd/workload/stop"
@@ -48,15 +52,21 @@ Manage and monitor workloads in your deployment infrastructure.`,
 		// writes the committed .datarobot.yaml that `up` deploys from. Listed
 		// apart from the verbs below so it does not read as one of them.
 		config.Cmd(),
+		// Setup for workloads that already run: one manifest per workload,
+		// read from the platform rather than from answers.
+		initcmd.Cmd(),
 
 		// The workload itself is the primary resource: direct verbs, like
 		// `dr pipeline create|get|...`.
 		create.Cmd(),
 		del.Cmd(),
+		diff.Cmd(),
 		endpoint.Cmd(),
 		get.Cmd(),
+		history.Cmd(),
 		list.Cmd(),
 		logs.Cmd(),
+		rollback.Cmd(),
 		start.Cmd(),
 		status.Cmd(),
 		stop.Cmd(),
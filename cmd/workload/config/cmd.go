@@ -190,7 +190,7 @@ func run(cmd *cobra.Command, f flags, format outputformat.OutputFormat) error {
 	// machine-readable stdout is a trap for whoever is parsing it.
 	yes := f.yes || viperx.GetBool("yes")
 
-	result, err := wizard.Run(wizard.Options{
+	result, err := wizard.Run(cmd.Context(), wizard.Options{
 		Dir:            dir,
 		NonInteractive: yes || format == outputformat.OutputFormatJSON,
 		DryRun:         f.dryRun,
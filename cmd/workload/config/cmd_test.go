@@ -16,6 +16,7 @@ package config
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -187,7 +188,7 @@ func TestCmd_JSONImpliesNonInteractive(t *testing.T) {
 	var ran bool
 
 	restoreFlow := wizard.SetInteractiveFlowForTest(
-		func(wizard.Options, wizard.Detected) ([]byte, manifest.Draft, error) {
+		func(context.Context, wizard.Options, wizard.Detected) ([]byte, manifest.Draft, error) {
 			ran = true
 
 			return nil, manifest.Draft{}, errors.New("the wizard must not run")
@@ -214,7 +215,7 @@ func TestCmd_YesImpliesNonInteractive(t *testing.T) {
 	var ran bool
 
 	restoreFlow := wizard.SetInteractiveFlowForTest(
-		func(wizard.Options, wizard.Detected) ([]byte, manifest.Draft, error) {
+		func(context.Context, wizard.Options, wizard.Detected) ([]byte, manifest.Draft, error) {
 			ran = true
 
 			return nil, manifest.Draft{}, errors.New("the wizard must not run")
@@ -155,7 +155,7 @@ Example:
 				return err
 			}
 
-			wl, err := workload.CreateWorkload(payload)
+			wl, err := workload.CreateWorkload(cmd.Context(), payload)
 			if err != nil {
 				return err
 			}
@@ -58,7 +58,7 @@ Example:
 				return err
 			}
 
-			if err := workload.DeleteWorkload(args[0]); err != nil {
+			if err := workload.DeleteWorkload(cmd.Context(), args[0]); err != nil {
 				return handleDeleteError(err, args[0])
 			}
 
@@ -0,0 +1,175 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package diff implements `dr workload diff`: the committed manifest
+// compared with the workload it is bound to, changing nothing.
+package diff
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/datarobot/cli/internal/auth"
+	"github.com/datarobot/cli/internal/cli"
+	"github.com/datarobot/cli/internal/outputformat"
+	"github.com/datarobot/cli/internal/telemetry"
+	"github.com/datarobot/cli/internal/workload/up"
+	"github.com/spf13/cobra"
+)
+
+// Exit codes, fixed so a scheduled job can branch on them. Any failure is
+// exitError, never exitDrift: a check that could not run must not read as one
+// that found something.
+const (
+	exitDrift = 1
+	exitError = 2
+)
+
+// diffFn is the comparison, swapped by this package's tests.
+var diffFn = up.Diff
+
+func Cmd() *cobra.Command {
+	var (
+		outputFormat outputformat.OutputFormat
+		dir          string
+		env          string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "diff",
+		Short: "Compare .datarobot.yaml with the workload it is bound to.",
+		Long: `Compare the committed .datarobot.yaml with the live workload it is bound to,
+and print the difference as a unified diff: the live value on the - line, the
+file's on the + line, and the file and line each field is set on. Nothing is
+changed.
+
+Every field the file names is compared, the same way 'dr workload up' plans
+its changes. The diff also lists what the workload holds that the file does
+not name, such as an environment variable or a container added in the UI.
+'dr workload up' leaves those alone, so this is where they show up.
+Environment variable values are never printed.
+
+The exit status is 0 when the file and the workload agree, 1 when they
+differ, and 2 when the comparison could not be made, which makes the command
+usable as a scheduled drift check.
+
+Examples:
+  dr workload diff
+  dr workload diff --env staging
+  dr workload diff --output-format json`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			return asError(cobra.NoArgs(cmd, args))
+		},
+		// Cobra runs only the nearest PersistentPreRunE, so this one runs the
+		// root's (config, TLS, --profile, HTTP recording) in its place.
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			return asError(parentPersistentPreRun(cmd, args))
+		},
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return asError(auth.EnsureAuthenticatedE(cmd, args))
+		},
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			outputFormat = outputformat.GetFormat(cmd)
+
+			return asError(run(cmd, dir, env, outputFormat))
+		},
+	}
+
+	outputformat.AddFlag(cmd, &outputFormat)
+	cmd.Flags().StringVar(&dir, "dir", "", "Project directory; the manifest is searched upward from here.")
+	cmd.Flags().StringVar(&env, "env", "", "Compare this environment: .datarobot.<env>.yaml merged onto the manifest.")
+
+	cmd.SetFlagErrorFunc(func(_ *cobra.Command, err error) error {
+		return asError(err)
+	})
+
+	telemetry.TrackWith(cmd, func(_ *cobra.Command, _ []string) map[string]any {
+		return map[string]any{
+			"environment":   env != "",
+			"output_format": string(outputFormat),
+		}
+	})
+
+	return cmd
+}
+
+func run(cmd *cobra.Command, dir, env string, format outputformat.OutputFormat) error {
+	if dir == "" {
+		cwd, err := os.Getwd()
+		if err != nil {
+			return fmt.Errorf("cannot determine the current directory: %w", err)
+		}
+
+		dir = cwd
+	}
+
+	drift, err := diffFn(cmd.Context(), dir, env)
+	if err != nil {
+		return err
+	}
+
+	if format == outputformat.OutputFormatJSON {
+		err = outputformat.PrintJSONEnvelope(cmd.OutOrStdout(), "diff", drift.JSON())
+	} else {
+		err = up.RenderDrift(cmd.OutOrStdout(), drift)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if drift.InSync() {
+		return nil
+	}
+
+	return &cli.ExitError{
+		Code: exitDrift,
+		Err: fmt.Errorf("workload %s has drifted from the manifest (%d changed, %d not named)",
+			drift.Summary.WorkloadID, len(drift.Changes), len(drift.Extra)),
+	}
+}
+
+// parentPersistentPreRun runs the persistent pre-run hook the nearest
+// ancestor of cmd defines, as cobra would have without diff's own.
+func parentPersistentPreRun(cmd *cobra.Command, args []string) error {
+	for p := cmd.Parent(); p != nil; p = p.Parent() {
+		switch {
+		case p.PersistentPreRunE != nil:
+			return p.PersistentPreRunE(cmd, args)
+		case p.PersistentPreRun != nil:
+			p.PersistentPreRun(cmd, args)
+
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// asError gives a failure the exit status that means the check did not run.
+// A drift result already carries its own and is passed through untouched.
+func asError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var exitErr *cli.ExitError
+	if errors.As(err, &exitErr) {
+		return err
+	}
+
+	return &cli.ExitError{Code: exitError, Err: err}
+}
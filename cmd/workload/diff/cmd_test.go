@@ -0,0 +1,198 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diff
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/datarobot/cli/internal/cli"
+	"github.com/datarobot/cli/internal/workload/up"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubDiff replaces the comparison and records the directory and environment
+// it was asked about.
+func stubDiff(t *testing.T, drift up.Drift, err error) *[2]string {
+	t.Helper()
+
+	seen := &[2]string{}
+	prev := diffFn
+
+	diffFn = func(_ context.Context, dir, env string) (up.Drift, error) {
+		seen[0], seen[1] = dir, env
+
+		return drift, err
+	}
+
+	t.Cleanup(func() { diffFn = prev })
+
+	return seen
+}
+
+func runCmd(t *testing.T, args ...string) (string, error) {
+	t.Helper()
+
+	cmd := Cmd()
+	cmd.PreRunE = nil
+
+	var out, errOut bytes.Buffer
+
+	cmd.SetOut(&out)
+	cmd.SetErr(&errOut)
+	cmd.SetArgs(args)
+
+	err := cmd.Execute()
+
+	return out.String(), err
+}
+
+func inSync() up.Drift {
+	return up.Drift{
+		Summary: up.Summary{Name: "my-app", WorkloadID: "68b0c1d2e3f4a5b6c7d8e9f0"},
+		State:   up.StateRunning,
+	}
+}
+
+func drifted() up.Drift {
+	d := inSync()
+	d.Changes = []up.Drifted{{Change: up.Change{
+		Path: "runtime.containerGroups[default].replicaCount", Want: float64(3), Have: float64(1),
+	}}}
+
+	return d
+}
+
+func TestCmd_InSyncExitsZero(t *testing.T) {
+	seen := stubDiff(t, inSync(), nil)
+
+	out, err := runCmd(t, "--dir", "/tmp/project", "--env", "staging")
+	require.NoError(t, err)
+
+	assert.Contains(t, out, "In sync")
+	assert.Equal(t, [2]string{"/tmp/project", "staging"}, *seen)
+}
+
+func TestCmd_DriftExitsOne(t *testing.T) {
+	stubDiff(t, drifted(), nil)
+
+	out, err := runCmd(t, "--dir", "/tmp/project")
+	require.Error(t, err)
+
+	assert.Equal(t, 1, cli.ExitCode(err))
+	assert.Contains(t, out, "- 1\n")
+	assert.Contains(t, out, "+ 3\n")
+}
+
+func TestCmd_FailureExitsTwo(t *testing.T) {
+	stubDiff(t, up.Drift{}, errors.New("cannot read workload"))
+
+	_, err := runCmd(t, "--dir", "/tmp/project")
+	require.Error(t, err)
+
+	assert.Equal(t, 2, cli.ExitCode(err))
+}
+
+// TestCmd_UsageErrorsExitTwo keeps a typo in a cron line from reading as
+// drift every night.
+func TestCmd_UsageErrorsExitTwo(t *testing.T) {
+	stubDiff(t, inSync(), nil)
+
+	_, err := runCmd(t, "--no-such-flag")
+	require.Error(t, err)
+	assert.Equal(t, 2, cli.ExitCode(err))
+
+	_, err = runCmd(t, "extra-arg")
+	require.Error(t, err)
+	assert.Equal(t, 2, cli.ExitCode(err))
+}
+
+// TestCmd_RootPreRunErrorsExitTwo covers failures before diff itself runs,
+// such as a config file that does not parse or an unknown --profile.
+func TestCmd_RootPreRunErrorsExitTwo(t *testing.T) {
+	stubDiff(t, inSync(), nil)
+
+	root := &cobra.Command{
+		Use: "dr",
+		PersistentPreRunE: func(*cobra.Command, []string) error {
+			return errors.New("load config: bad yaml")
+		},
+	}
+
+	cmd := Cmd()
+	cmd.PreRunE = nil
+	root.AddCommand(cmd)
+	root.SetOut(&bytes.Buffer{})
+	root.SetErr(&bytes.Buffer{})
+	root.SetArgs([]string{"diff", "--dir", "/tmp/project"})
+
+	err := root.Execute()
+	require.Error(t, err)
+	assert.Equal(t, 2, cli.ExitCode(err))
+	assert.Contains(t, err.Error(), "bad yaml")
+}
+
+func TestCmd_RunsRootPreRun(t *testing.T) {
+	stubDiff(t, inSync(), nil)
+
+	var ran string
+
+	root := &cobra.Command{
+		Use: "dr",
+		PersistentPreRunE: func(cmd *cobra.Command, _ []string) error {
+			ran = cmd.Name()
+
+			return nil
+		},
+	}
+
+	cmd := Cmd()
+	cmd.PreRunE = nil
+	root.AddCommand(cmd)
+	root.SetOut(&bytes.Buffer{})
+	root.SetArgs([]string{"diff", "--dir", "/tmp/project"})
+
+	require.NoError(t, root.Execute())
+	assert.Equal(t, "diff", ran, "the root hook runs for diff, with diff as its command")
+}
+
+func TestCmd_JSONEnvelope(t *testing.T) {
+	stubDiff(t, drifted(), nil)
+
+	out, err := runCmd(t, "--dir", "/tmp/project", "--output-format", "json")
+	require.Error(t, err)
+	assert.Equal(t, 1, cli.ExitCode(err))
+
+	var envelope struct {
+		Diff up.DriftJSON `json:"diff"`
+	}
+
+	require.NoError(t, json.Unmarshal([]byte(out), &envelope), "stdout is one JSON document")
+
+	assert.False(t, envelope.Diff.InSync)
+	assert.Equal(t, "68b0c1d2e3f4a5b6c7d8e9f0", envelope.Diff.WorkloadID)
+	require.Len(t, envelope.Diff.Changes, 1)
+	assert.Equal(t, "runtime.containerGroups[default].replicaCount", envelope.Diff.Changes[0].Path)
+	assert.NotNil(t, envelope.Diff.Extra)
+}
+
+func TestCmd_IsRegisteredUnderWorkload(t *testing.T) {
+	assert.Equal(t, "diff", Cmd().Name())
+}
@@ -45,8 +45,8 @@ Example:
 		Args:         cobra.ExactArgs(1),
 		PreRunE:      auth.EnsureAuthenticatedE,
 		SilenceUsage: true,
-		RunE: func(_ *cobra.Command, args []string) error {
-			wl, err := workload.GetWorkload(args[0])
+		RunE: func(cmd *cobra.Command, args []string) error {
+			wl, err := workload.GetWorkload(cmd.Context(), args[0])
 			if err != nil {
 				return err
 			}
@@ -0,0 +1,214 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package history implements `dr workload history`: the versions in the
+// repository a workload runs from, and which of them is serving.
+package history
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+	"github.com/datarobot/cli/internal/auth"
+	"github.com/datarobot/cli/internal/outputformat"
+	"github.com/datarobot/cli/internal/telemetry"
+	"github.com/datarobot/cli/internal/workload/up"
+	"github.com/datarobot/cli/tui"
+	"github.com/spf13/cobra"
+)
+
+const (
+	defaultLimit = 20
+
+	timestampFormat = "2006-01-02 15:04 UTC"
+	placeholder     = "—"
+)
+
+// historyFn is the read, swapped by this package's tests.
+var historyFn = up.History
+
+// versionOutput is one row of the JSON form. Version, buildId and lockedAt
+// are null rather than absent when there is nothing to report, so every row
+// carries the same keys.
+type versionOutput struct {
+	ArtifactID  string     `json:"artifactId"`
+	Version     *int       `json:"version"`
+	Status      string     `json:"status"`
+	BuildID     *string    `json:"buildId"`
+	BuildStatus *string    `json:"buildStatus"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	LockedAt    *time.Time `json:"lockedAt"`
+	Serving     bool       `json:"serving"`
+}
+
+type historyOutput struct {
+	WorkloadID           string          `json:"workloadId"`
+	ArtifactRepositoryID string          `json:"artifactRepositoryId"`
+	Versions             []versionOutput `json:"versions"`
+}
+
+func Cmd() *cobra.Command {
+	var (
+		outputFormat outputformat.OutputFormat
+		limit        int
+	)
+
+	cmd := &cobra.Command{
+		Use:   "history <workload-id>",
+		Short: "List the versions a workload can run, and which one is serving.",
+		Long: `List the versions in the artifact repository a workload runs from, newest
+first.
+
+Each row shows the version number, the artifact, whether it is a draft or
+locked, the build its image came from, and when it was locked. The platform
+keeps no log of past rollouts, so the lock time stands in for when a version
+went out: a locked version is locked as it is rolled in. The version the
+workload is running now is marked.
+
+Any locked version listed here can be promoted again with
+'dr workload rollback <workload-id> --to <artifact-id>'.
+
+Examples:
+  dr workload history 68b0c1d2e3f4a5b6c7d8e9f0
+  dr workload history 68b0c1d2e3f4a5b6c7d8e9f0 --output-format json`,
+		Args:         cobra.ExactArgs(1),
+		PreRunE:      auth.EnsureAuthenticatedE,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			outputFormat = outputformat.GetFormat(cmd)
+
+			if limit <= 0 {
+				return fmt.Errorf("invalid --limit %d: must be positive", limit)
+			}
+
+			live, entries, err := historyFn(cmd.Context(), args[0], limit)
+			if err != nil {
+				return err
+			}
+
+			if outputFormat == outputformat.OutputFormatJSON {
+				return outputformat.PrintJSONEnvelope(cmd.OutOrStdout(), "history", historyOutput{
+					WorkloadID:           live.WorkloadID,
+					ArtifactRepositoryID: live.ArtifactRepositoryID,
+					Versions:             toOutputs(entries),
+				})
+			}
+
+			printTable(cmd.OutOrStdout(), entries)
+
+			return nil
+		},
+	}
+
+	outputformat.AddFlag(cmd, &outputFormat)
+	cmd.Flags().IntVar(&limit, "limit", defaultLimit, "Maximum number of versions to list.")
+
+	telemetry.TrackWith(cmd, func(_ *cobra.Command, args []string) map[string]any {
+		return map[string]any{
+			"workload_id":   telemetry.FirstArg(args),
+			"limit":         limit,
+			"output_format": string(outputFormat),
+		}
+	})
+
+	return cmd
+}
+
+func toOutputs(entries []up.HistoryEntry) []versionOutput {
+	outputs := make([]versionOutput, 0, len(entries))
+
+	for _, e := range entries {
+		out := versionOutput{
+			ArtifactID: e.Artifact.ID,
+			Version:    e.Artifact.Version,
+			Status:     e.Artifact.Status,
+			CreatedAt:  e.Artifact.CreatedAt,
+			LockedAt:   e.LockedAt(),
+			Serving:    e.Serving,
+		}
+
+		if e.Build != nil {
+			out.BuildID = &e.Build.ID
+			out.BuildStatus = &e.Build.Status
+		}
+
+		outputs = append(outputs, out)
+	}
+
+	return outputs
+}
+
+func printTable(w io.Writer, entries []up.HistoryEntry) {
+	cellStyle := tui.BaseTextStyle.Padding(0, 1)
+	servingStyle := tui.SuccessStyle.Padding(0, 1)
+
+	t := table.New().
+		Border(lipgloss.RoundedBorder()).
+		BorderStyle(tui.TableBorderStyle).
+		StyleFunc(func(row, _ int) lipgloss.Style {
+			if row == table.HeaderRow {
+				return cellStyle.Bold(true)
+			}
+
+			if entries[row].Serving {
+				return servingStyle
+			}
+
+			return cellStyle
+		}).
+		Headers("", "VERSION", "ARTIFACT ID", "STATUS", "BUILD", "CREATED", "LOCKED")
+
+	for _, e := range entries {
+		serving := ""
+		if e.Serving {
+			serving = "▶"
+		}
+
+		t.Row(serving, versionNumber(e), e.Artifact.ID, e.Artifact.Status, build(e),
+			e.Artifact.CreatedAt.UTC().Format(timestampFormat), lockedAt(e))
+	}
+
+	fmt.Fprintln(w, t.Render())
+}
+
+// versionNumber is a dash for a draft, which the platform numbers only on
+// locking.
+func versionNumber(e up.HistoryEntry) string {
+	if e.Artifact.Version == nil {
+		return placeholder
+	}
+
+	return strconv.Itoa(*e.Artifact.Version)
+}
+
+func build(e up.HistoryEntry) string {
+	if e.Build == nil {
+		return placeholder
+	}
+
+	return e.Build.ID + " (" + e.Build.Status + ")"
+}
+
+func lockedAt(e up.HistoryEntry) string {
+	at := e.LockedAt()
+	if at == nil {
+		return placeholder
+	}
+
+	return at.UTC().Format(timestampFormat)
+}
@@ -0,0 +1,137 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package history
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/datarobot/cli/internal/workload"
+	"github.com/datarobot/cli/internal/workload/up"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func stubHistory(t *testing.T, entries []up.HistoryEntry) {
+	t.Helper()
+
+	prev := historyFn
+
+	historyFn = func(_ context.Context, workloadID string, _ int) (up.Live, []up.HistoryEntry, error) {
+		live := up.Live{ArtifactRepositoryID: "repo-1"}
+		live.WorkloadID = workloadID
+
+		return live, entries, nil
+	}
+
+	t.Cleanup(func() { historyFn = prev })
+}
+
+func runCmd(t *testing.T, args ...string) (string, error) {
+	t.Helper()
+
+	cmd := Cmd()
+	cmd.PreRunE = nil
+
+	var out, errOut bytes.Buffer
+
+	cmd.SetOut(&out)
+	cmd.SetErr(&errOut)
+	cmd.SetArgs(args)
+
+	err := cmd.Execute()
+
+	return out.String(), err
+}
+
+func entries() []up.HistoryEntry {
+	two := 2
+	created := time.Date(2026, 10, 2, 12, 0, 0, 0, time.UTC)
+
+	return []up.HistoryEntry{
+		{
+			Artifact: workload.Artifact{ID: "art-3", Status: workload.ArtifactStatusDraft, CreatedAt: created.Add(48 * time.Hour)},
+		},
+		{
+			Artifact: workload.Artifact{
+				ID: "art-2", Status: workload.ArtifactStatusLocked, Version: &two,
+				CreatedAt: created, UpdatedAt: created.Add(time.Hour),
+			},
+			Build:   &workload.Build{ID: "bld-1", Status: workload.BuildStatusCompleted},
+			Serving: true,
+		},
+	}
+}
+
+func TestCmd_RequiresArg(t *testing.T) {
+	_, err := runCmd(t)
+	require.Error(t, err)
+}
+
+func TestCmd_RejectsNonPositiveLimit(t *testing.T) {
+	stubHistory(t, entries())
+
+	_, err := runCmd(t, "wl-1", "--limit", "0")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--limit")
+}
+
+func TestCmd_TableMarksTheServingVersion(t *testing.T) {
+	stubHistory(t, entries())
+
+	out, err := runCmd(t, "wl-1")
+	require.NoError(t, err)
+
+	assert.Contains(t, out, "art-2")
+	assert.Contains(t, out, "bld-1 (COMPLETED)")
+	assert.Contains(t, out, "2026-10-02 13:00 UTC", "the lock time stands in for when it went out")
+	assert.Contains(t, out, "▶")
+}
+
+func TestCmd_JSONCarriesEveryKeyOnEveryRow(t *testing.T) {
+	stubHistory(t, entries())
+
+	out, err := runCmd(t, "wl-1", "--output-format", "json")
+	require.NoError(t, err)
+
+	var envelope struct {
+		History struct {
+			WorkloadID           string           `json:"workloadId"`
+			ArtifactRepositoryID string           `json:"artifactRepositoryId"`
+			Versions             []map[string]any `json:"versions"`
+		} `json:"history"`
+	}
+
+	require.NoError(t, json.Unmarshal([]byte(out), &envelope))
+
+	assert.Equal(t, "wl-1", envelope.History.WorkloadID)
+	assert.Equal(t, "repo-1", envelope.History.ArtifactRepositoryID)
+	require.Len(t, envelope.History.Versions, 2)
+
+	draft := envelope.History.Versions[0]
+	assert.Contains(t, draft, "version")
+	assert.Nil(t, draft["version"])
+	assert.Nil(t, draft["buildId"])
+	assert.Nil(t, draft["lockedAt"])
+	assert.Equal(t, false, draft["serving"])
+
+	serving := envelope.History.Versions[1]
+	assert.InDelta(t, 2, serving["version"], 0)
+	assert.Equal(t, "bld-1", serving["buildId"])
+	assert.Equal(t, true, serving["serving"])
+}
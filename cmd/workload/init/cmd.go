@@ -0,0 +1,342 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package initcmd implements `dr workload init --from-live`: a manifest for
+// each workload that already runs, so a fleet created by hand in the UI can be
+// committed as the source of truth.
+package initcmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/datarobot/cli/internal/auth"
+	"github.com/datarobot/cli/internal/outputformat"
+	"github.com/datarobot/cli/internal/telemetry"
+	"github.com/datarobot/cli/internal/workload"
+	"github.com/datarobot/cli/internal/workload/manifest"
+	"github.com/datarobot/cli/internal/workload/wizard"
+	"github.com/datarobot/cli/tui"
+	"github.com/spf13/cobra"
+)
+
+// exportFn is the export itself, swapped by this package's tests.
+var exportFn = wizard.Export
+
+// exportedOutput is one workload in the JSON form. Error is set only for a
+// failed one, and the secret lists are empty rather than null, so every row
+// carries the same keys.
+type exportedOutput struct {
+	WorkloadID     string   `json:"workloadId"`
+	Name           string   `json:"name"`
+	Path           string   `json:"path"`
+	Action         string   `json:"action"`
+	Secrets        []string `json:"secrets"`
+	SecretsStored  []string `json:"secretsStored"`
+	SecretsPending int      `json:"secretsPending"`
+	Error          string   `json:"error,omitempty"`
+}
+
+type initOutput struct {
+	Out       string           `json:"out"`
+	Workloads []exportedOutput `json:"workloads"`
+}
+
+// flags is the flag set, held together so the run path reads as one answer
+// set rather than a dozen lookups.
+type flags struct {
+	fromLive     bool
+	all          bool
+	statuses     []string
+	enclave      string
+	name         string
+	limit        int
+	out          string
+	storeSecrets bool
+	dryRun       bool
+}
+
+func Cmd() *cobra.Command {
+	var (
+		outputFormat outputformat.OutputFormat
+		f            flags
+	)
+
+	cmd := &cobra.Command{
+		Use:   "init --from-live [<workload-id>...]",
+		Short: "Write a .datarobot.yaml for workloads that already run.",
+		Long: `Write a .datarobot.yaml for each workload that already runs, into a tree of
+directories you can commit as the source of truth.
+
+Name the workloads by id, or select them the way 'dr workload list' does:
+--status, --enclave, and --name, a shell pattern matched against the
+workload's name. --all selects everything the listing returns. Each
+workload gets a directory under --out named after it; two workloads sharing
+a name each get their id's tail appended.
+
+Each file is the workload's own live spec, bound to it by workloadId, with
+what only the server sets stripped. A value that looks like a secret is not
+written: it is stored as a credential named <workload>/<VARIABLE> and the
+file references it with dr-credential:<id>/apiToken. With
+--store-secrets=false nothing is stored and the reference names
+PLACEHOLDER, which a deploy refuses until you fill in a credential id.
+
+An existing .datarobot.yaml is never overwritten, so running this again over
+the same tree only adds workloads created since. One workload failing does
+not stop the others; the command exits nonzero if any did.
+
+To set up a new project instead, use 'dr workload config'.
+
+Examples:
+  dr workload init --from-live 68b0c1d2e3f4a5b6c7d8e9f0
+  dr workload init --from-live --all --out workloads
+  dr workload init --from-live --status running --name 'billing-*' --dry-run`,
+		// Checked as arguments, so a run that does not say what to export
+		// fails before authenticating rather than after.
+		Args: func(_ *cobra.Command, args []string) error {
+			return checkSelection(f, args)
+		},
+		PreRunE:      auth.EnsureAuthenticatedE,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			outputFormat = outputformat.GetFormat(cmd)
+
+			return run(cmd, f, args, outputFormat)
+		},
+	}
+
+	outputformat.AddFlag(cmd, &outputFormat)
+
+	cmd.Flags().BoolVar(&f.fromLive, "from-live", false, "Read each manifest from a running workload.")
+	cmd.Flags().BoolVar(&f.all, "all", false, "Export every workload the listing returns.")
+	cmd.Flags().StringSliceVar(&f.statuses, "status", nil,
+		"Only workloads with this status (repeatable, also accepts comma-separated values).")
+	cmd.Flags().StringVar(&f.enclave, "enclave", "", "Only workloads running on the named Enclave.")
+	cmd.Flags().StringVar(&f.name, "name", "", "Only workloads whose name matches this shell pattern.")
+	cmd.Flags().IntVar(&f.limit, "limit", 100, "Maximum number of workloads to list.")
+	cmd.Flags().StringVar(&f.out, "out", ".", "Directory to write the tree into.")
+	cmd.Flags().BoolVar(&f.storeSecrets, "store-secrets", true,
+		"Store values that look like secrets as credentials and reference them. "+
+			"With --store-secrets=false the references name PLACEHOLDER instead.")
+	cmd.Flags().BoolVar(&f.dryRun, "dry-run", false, "Print what would be written; write and store nothing.")
+
+	telemetry.TrackWith(cmd, func(_ *cobra.Command, args []string) map[string]any {
+		return map[string]any{
+			"from_live":     f.fromLive,
+			"ids":           len(args),
+			"all":           f.all,
+			"filtered":      len(f.statuses) > 0 || f.enclave != "" || f.name != "",
+			"store_secrets": f.storeSecrets,
+			"dry_run":       f.dryRun,
+			"output_format": string(outputFormat),
+		}
+	})
+
+	return cmd
+}
+
+// checkSelection refuses a run that does not say what to export. Exporting
+// every workload in the tenant is a reasonable thing to want, but it is
+// --all, never what an empty command line falls into.
+func checkSelection(f flags, ids []string) error {
+	if !f.fromLive {
+		return errors.New("init reads manifests from running workloads and needs --from-live; " +
+			"to set up a new project, use 'dr workload config'")
+	}
+
+	filtered := len(f.statuses) > 0 || f.enclave != "" || f.name != ""
+
+	switch {
+	case len(ids) > 0 && (filtered || f.all):
+		return errors.New("name workloads by id or select them with --all, --status, --enclave or --name, not both")
+	case len(ids) == 0 && !filtered && !f.all:
+		return errors.New("name the workloads to export by id, or select them with --all, --status, --enclave or --name")
+	case f.limit <= 0:
+		return fmt.Errorf("invalid --limit %d: must be positive", f.limit)
+	}
+
+	return nil
+}
+
+func run(cmd *cobra.Command, f flags, ids []string, format outputformat.OutputFormat) error {
+	statuses, err := workload.ParseWorkloadStatuses(f.statuses)
+	if err != nil {
+		return err
+	}
+
+	results, err := exportFn(cmd.Context(), wizard.ExportOptions{
+		WorkloadIDs:  ids,
+		Statuses:     statuses,
+		Enclave:      strings.TrimSpace(f.enclave),
+		Name:         f.name,
+		Limit:        f.limit,
+		OutDir:       f.out,
+		StoreSecrets: f.storeSecrets,
+		DryRun:       f.dryRun,
+	})
+	if err != nil {
+		return err
+	}
+
+	if format == outputformat.OutputFormatJSON {
+		if err := outputformat.PrintJSONEnvelope(cmd.OutOrStdout(), "init", newInitOutput(f.out, results)); err != nil {
+			return err
+		}
+	} else {
+		render(cmd.OutOrStdout(), cmd.ErrOrStderr(), f, results)
+	}
+
+	return failure(results)
+}
+
+func newInitOutput(out string, results []wizard.Exported) initOutput {
+	rows := make([]exportedOutput, 0, len(results))
+
+	for _, r := range results {
+		row := exportedOutput{
+			WorkloadID:     r.WorkloadID,
+			Name:           r.Name,
+			Path:           r.Path,
+			Action:         r.Action,
+			Secrets:        orEmpty(r.Secrets),
+			SecretsStored:  orEmpty(r.Import.Stored),
+			SecretsPending: r.Pending(),
+		}
+
+		if r.Err != nil {
+			row.Error = r.Err.Error()
+		}
+
+		rows = append(rows, row)
+	}
+
+	return initOutput{Out: out, Workloads: rows}
+}
+
+func orEmpty(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+
+	return values
+}
+
+// render reports each workload on stderr and prints the path of every file
+// written on stdout, one per line, so the tree can be piped to git add.
+func render(stdout, stderr io.Writer, f flags, results []wizard.Exported) {
+	if len(results) == 0 {
+		fmt.Fprintln(stderr, "No workload matched; nothing was written.")
+
+		return
+	}
+
+	written := 0
+
+	for _, r := range results {
+		switch r.Action {
+		case wizard.ActionFailed:
+			fmt.Fprintf(stderr, "%s %s\n    %v\n", tui.ErrorStyle.Render("✗"), label(r), r.Err)
+
+			continue
+		case wizard.ActionUnchanged:
+			fmt.Fprintf(stderr, "%s %s already has %s; left as it is\n",
+				tui.HintStyle.Render("·"), label(r), wizard.ShortPath(r.Path))
+
+			continue
+		case wizard.ActionPlanned:
+			fmt.Fprintf(stderr, "Dry run: %s was not written.\n\n%s\n", wizard.ShortPath(r.Path), r.Content)
+		case wizard.ActionCreated:
+			fmt.Fprintf(stderr, "%s %s → %s%s\n",
+				tui.SuccessStyle.Render("✓"), label(r), wizard.ShortPath(r.Path), secretNote(r))
+			fmt.Fprintln(stdout, r.Path)
+		}
+
+		written++
+
+		for _, failed := range r.Import.Failed {
+			fmt.Fprintf(stderr, "    %s %s was not stored: %s\n", tui.WarnStyle.Render("!"), failed.Name, failed.Reason)
+		}
+	}
+
+	verb := "Exported"
+	if f.dryRun {
+		verb = "Would export"
+	}
+
+	fmt.Fprintf(stderr, "\n%s %d of %d %s into %s\n",
+		verb, written, len(results), wizard.Plural(len(results), "workload", "workloads"), displayDir(f.out))
+}
+
+// label names a workload the way the user would look for it.
+func label(r wizard.Exported) string {
+	if r.Name == "" {
+		return r.WorkloadID
+	}
+
+	return fmt.Sprintf("%s (%s)", r.Name, r.WorkloadID)
+}
+
+// secretNote says what became of the secrets, because a file that needs a
+// credential id filled in before it can deploy should not read as finished.
+func secretNote(r wizard.Exported) string {
+	if len(r.Secrets) == 0 {
+		return ""
+	}
+
+	stored := len(r.Import.Stored)
+	pending := r.Pending()
+
+	switch {
+	case pending == 0:
+		return fmt.Sprintf("; %d %s stored as credentials", stored, wizard.Plural(stored, "secret", "secrets"))
+	case stored == 0:
+		return fmt.Sprintf("; %d %s %s in place of a credential id",
+			pending, wizard.Plural(pending, "secret", "secrets"), manifest.CredentialPlaceholder)
+	default:
+		return fmt.Sprintf("; %d %s stored, %d left on %s",
+			stored, wizard.Plural(stored, "secret", "secrets"), pending, manifest.CredentialPlaceholder)
+	}
+}
+
+func displayDir(dir string) string {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return dir
+	}
+
+	return wizard.ShortPath(abs)
+}
+
+// failure is the run's error when any workload failed. The others were
+// exported regardless; the nonzero exit is what tells a script the tree is
+// incomplete.
+func failure(results []wizard.Exported) error {
+	failed := 0
+
+	for _, r := range results {
+		if r.Err != nil {
+			failed++
+		}
+	}
+
+	if failed == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("%d of %d %s could not be exported",
+		failed, len(results), wizard.Plural(len(results), "workload", "workloads"))
+}
@@ -0,0 +1,151 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package initcmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/datarobot/cli/internal/workload/wizard"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubExport records the options the command passed and answers with results.
+func stubExport(t *testing.T, results ...wizard.Exported) *wizard.ExportOptions {
+	t.Helper()
+
+	seen := &wizard.ExportOptions{}
+	prev := exportFn
+
+	exportFn = func(_ context.Context, opts wizard.ExportOptions) ([]wizard.Exported, error) {
+		*seen = opts
+
+		return results, nil
+	}
+
+	t.Cleanup(func() { exportFn = prev })
+
+	return seen
+}
+
+func runCmd(t *testing.T, args ...string) (string, string, error) {
+	t.Helper()
+
+	cmd := Cmd()
+	cmd.PreRunE = nil
+
+	var out, errOut bytes.Buffer
+
+	cmd.SetOut(&out)
+	cmd.SetErr(&errOut)
+	cmd.SetArgs(args)
+
+	err := cmd.Execute()
+
+	return out.String(), errOut.String(), err
+}
+
+func TestCmd_RequiresFromLive(t *testing.T) {
+	stubExport(t)
+
+	_, _, err := runCmd(t, "68b0")
+	require.Error(t, err)
+
+	assert.Contains(t, err.Error(), "--from-live")
+	assert.Contains(t, err.Error(), "dr workload config")
+}
+
+// Every workload in the tenant is something to ask for, not something an
+// empty command line falls into.
+func TestCmd_RequiresASelection(t *testing.T) {
+	stubExport(t)
+
+	_, _, err := runCmd(t, "--from-live")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--all")
+
+	_, _, err = runCmd(t, "--from-live", "68b0", "--status", "running")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not both")
+}
+
+func TestCmd_PassesTheSelectionThrough(t *testing.T) {
+	seen := stubExport(t)
+
+	_, _, err := runCmd(t, "--from-live", "--status", "running", "--name", "billing-*",
+		"--out", "fleet", "--store-secrets=false", "--dry-run")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"running"}, seen.Statuses)
+	assert.Equal(t, "billing-*", seen.Name)
+	assert.Equal(t, "fleet", seen.OutDir)
+	assert.Equal(t, 100, seen.Limit)
+	assert.False(t, seen.StoreSecrets)
+	assert.True(t, seen.DryRun)
+}
+
+// stdout carries only the files written, so the tree can be piped to git add;
+// one workload failing still exits nonzero once the rest are reported.
+func TestCmd_ReportsEachWorkload(t *testing.T) {
+	stubExport(t,
+		wizard.Exported{
+			WorkloadID: "68b0", Name: "billing", Path: "/tmp/fleet/billing/.datarobot.yaml",
+			Action: wizard.ActionCreated, Secrets: []string{"primary/OPENAI_API_KEY"},
+			Import: wizard.Import{Stored: []string{"primary/OPENAI_API_KEY"}},
+		},
+		wizard.Exported{
+			WorkloadID: "68b1", Name: "search", Path: "/tmp/fleet/search/.datarobot.yaml",
+			Action: wizard.ActionUnchanged,
+		},
+		wizard.Exported{WorkloadID: "nope", Action: wizard.ActionFailed, Err: errors.New("cannot read workload nope")})
+
+	stdout, stderr, err := runCmd(t, "--from-live", "68b0", "68b1", "nope")
+	require.Error(t, err)
+
+	assert.Contains(t, err.Error(), "1 of 3 workloads could not be exported")
+	assert.Equal(t, "/tmp/fleet/billing/.datarobot.yaml\n", stdout)
+	assert.Contains(t, stderr, "1 secret stored as credentials")
+	assert.Contains(t, stderr, "search (68b1) already has")
+	assert.Contains(t, stderr, "cannot read workload nope")
+	assert.Contains(t, stderr, "Exported 1 of 3 workloads")
+}
+
+func TestCmd_JSON(t *testing.T) {
+	stubExport(t, wizard.Exported{
+		WorkloadID: "68b0", Name: "billing", Path: "/tmp/fleet/billing/.datarobot.yaml",
+		Action: wizard.ActionCreated, Secrets: []string{"primary/OPENAI_API_KEY"},
+	})
+
+	stdout, _, err := runCmd(t, "--from-live", "--all", "--output-format", "json")
+	require.NoError(t, err)
+
+	var envelope struct {
+		Init initOutput `json:"init"`
+	}
+
+	require.NoError(t, json.Unmarshal([]byte(stdout), &envelope))
+	require.Len(t, envelope.Init.Workloads, 1)
+
+	row := envelope.Init.Workloads[0]
+
+	assert.Equal(t, "created", row.Action)
+	assert.Equal(t, []string{"primary/OPENAI_API_KEY"}, row.Secrets)
+	assert.Equal(t, []string{}, row.SecretsStored)
+	assert.Equal(t, 1, row.SecretsPending)
+}
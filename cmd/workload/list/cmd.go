@@ -80,7 +80,7 @@ Example:
 				return err
 			}
 
-			workloads, err := workload.ListWorkloads(limit, parsedStatuses, enclave)
+			workloads, err := workload.ListWorkloads(cmd.Context(), limit, parsedStatuses, enclave)
 			if err != nil {
 				return err
 			}
@@ -92,7 +92,7 @@ Example:
 					}, onWarn)
 			}
 
-			entries, err := workload.GetWorkloadLogs(args[0], limit, parsedLevel)
+			entries, err := workload.GetWorkloadLogs(cmd.Context(), args[0], limit, parsedLevel)
 			if err != nil {
 				return err
 			}
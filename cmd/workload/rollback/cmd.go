@@ -0,0 +1,186 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package rollback implements `dr workload rollback`: put a workload back on
+// an earlier locked version without rebuilding anything. The command is the
+// shell; the swap lives in internal/workload/up, beside the roll it shares.
+package rollback
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/datarobot/cli/cmd/internal/pollflags"
+	"github.com/datarobot/cli/cmd/internal/typedconfirm"
+	"github.com/datarobot/cli/internal/auth"
+	"github.com/datarobot/cli/internal/config/viperx"
+	"github.com/datarobot/cli/internal/misc/reader"
+	"github.com/datarobot/cli/internal/outputformat"
+	"github.com/datarobot/cli/internal/telemetry"
+	"github.com/datarobot/cli/internal/workload/up"
+	"github.com/datarobot/cli/tui"
+	"github.com/spf13/cobra"
+)
+
+// A rollback waits for the same two things a roll does, minus the build.
+const (
+	defaultPollInterval = 5 * time.Second
+	defaultPollTimeout  = 15 * time.Minute
+)
+
+// rollbackFn is the swap, swapped by this package's tests.
+var rollbackFn = up.Rollback
+
+// isStdinTerminalFn answers whether a person is there to be asked.
+var isStdinTerminalFn = reader.IsStdinTerminal
+
+// rollbackResult is the stable JSON shape emitted by --output-format json.
+type rollbackResult struct {
+	WorkloadID string `json:"workloadId"`
+	Name       string `json:"name"`
+	Status     string `json:"status"`
+	Endpoint   string `json:"endpoint"`
+	ArtifactID string `json:"artifactId"`
+	Action     string `json:"action"`
+}
+
+type flags struct {
+	to     string
+	yes    bool
+	detach bool
+}
+
+func Cmd() *cobra.Command {
+	var (
+		outputFormat outputformat.OutputFormat
+		f            flags
+		poll         pollflags.Set
+	)
+
+	cmd := &cobra.Command{
+		Use:   "rollback <workload-id>",
+		Short: "Put a workload back on an earlier locked version.",
+		Long: `Roll a workload back onto a version it ran before, without rebuilding.
+
+With no --to, the target is the newest locked version numbered below the one
+serving: the version that was live before the last locked deploy. --to names
+any locked version in the same artifact repository instead; see
+'dr workload history' for the list.
+
+The swap is the one 'dr workload up' makes. It is refused while another
+rollout is in flight, the endpoint does not change, and the version serving
+now keeps serving until the earlier one is ready. Nothing is built, and
+nothing new is locked.
+
+Only a locked workload can be rolled back: the platform replaces a locked
+version only with another locked one, and a draft keeps no record of what it
+held when it served. An interactive run asks for the workload name to be
+typed back first. A run with no terminal needs --yes.
+
+The artifact now serving is printed to stdout.
+
+Examples:
+  dr workload rollback 68b0c1d2e3f4a5b6c7d8e9f0
+  dr workload rollback 68b0c1d2e3f4a5b6c7d8e9f0 --to 68a0000000000000000000b2
+  dr workload rollback 68b0c1d2e3f4a5b6c7d8e9f0 --yes --output-format json`,
+		Args:         cobra.ExactArgs(1),
+		PreRunE:      auth.EnsureAuthenticatedE,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			outputFormat = outputformat.GetFormat(cmd)
+
+			return run(cmd, args[0], f, poll, outputFormat)
+		},
+	}
+
+	outputformat.AddFlag(cmd, &outputFormat)
+
+	cmd.Flags().StringVar(&f.to, "to", "", "Artifact ID of the locked version to roll back to.")
+	cmd.Flags().BoolVarP(&f.yes, "yes", "y", false, "Do not ask before rolling production back.")
+	cmd.Flags().BoolVar(&f.detach, "detach", false, "Return once the rollback is requested; do not wait for it.")
+
+	cmd.Flags().Var(pollflags.PositiveDuration(&poll.Interval, defaultPollInterval),
+		"poll-interval", "How often to check on a rollback in progress.")
+	cmd.Flags().Var(pollflags.PositiveDuration(&poll.Timeout, defaultPollTimeout),
+		"poll-timeout", "How long to wait for a rollback before giving up.")
+	_ = cmd.Flags().MarkHidden("poll-interval")
+	_ = cmd.Flags().MarkHidden("poll-timeout")
+
+	_ = viperx.BindEnv("yes", "DATAROBOT_CLI_NON_INTERACTIVE")
+
+	telemetry.TrackWith(cmd, func(_ *cobra.Command, args []string) map[string]any {
+		return map[string]any{
+			"workload_id":   telemetry.FirstArg(args),
+			"to":            f.to != "",
+			"yes":           f.yes || viperx.GetBool("yes"),
+			"detach":        f.detach,
+			"output_format": string(outputFormat),
+		}
+	})
+
+	return cmd
+}
+
+func run(cmd *cobra.Command, workloadID string, f flags, poll pollflags.Set, format outputformat.OutputFormat) error {
+	json := format == outputformat.OutputFormatJSON
+	yes := f.yes || viperx.GetBool("yes")
+	terminal := isStdinTerminalFn()
+
+	// Only --yes is consent. --output json is not: a terminal still asks, on
+	// stderr. With no terminal there is nobody to ask, so the rollback is
+	// refused rather than taken as agreed.
+	var confirm func(question, want string) (bool, error)
+	if !yes && terminal {
+		confirm = typedconfirm.Prompt(cmd)
+	}
+
+	result, runErr := rollbackFn(cmd.Context(), workloadID, f.to, up.Options{
+		NonInteractive: yes,
+		Detach:         f.detach,
+		Confirm:        confirm,
+		PollInterval:   poll.Interval,
+		PollTimeout:    poll.Timeout,
+		Stderr:         cmd.ErrOrStderr(),
+		Spinner:        !json && terminal,
+	})
+
+	// Nothing was swapped unless the rollout was at least requested, and an
+	// envelope describing the version that is still serving would read as
+	// though the rollback had landed on it.
+	if runErr != nil && result.Action == "" {
+		return runErr
+	}
+
+	if json {
+		if err := outputformat.PrintJSONEnvelope(cmd.OutOrStdout(), "rollback", rollbackResult{
+			WorkloadID: result.WorkloadID,
+			Name:       result.Name,
+			Status:     result.Status,
+			Endpoint:   result.Endpoint,
+			ArtifactID: result.ArtifactID,
+			Action:     result.Action,
+		}); err != nil {
+			return err
+		}
+
+		return runErr
+	}
+
+	if runErr == nil && !f.detach {
+		fmt.Fprintf(cmd.ErrOrStderr(), "\n  %s Workload %s is running artifact ", tui.SuccessStyle.Render("✓"), result.Name)
+		fmt.Fprintln(cmd.OutOrStdout(), result.ArtifactID)
+	}
+
+	return runErr
+}
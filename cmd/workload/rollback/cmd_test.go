@@ -0,0 +1,167 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rollback
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/datarobot/cli/internal/workload/up"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type call struct {
+	workloadID string
+	to         string
+	opts       up.Options
+}
+
+func stubRollback(t *testing.T, result up.Result, err error) *call {
+	t.Helper()
+
+	seen := &call{}
+	prev := rollbackFn
+
+	rollbackFn = func(_ context.Context, workloadID, to string, opts up.Options) (up.Result, error) {
+		*seen = call{workloadID: workloadID, to: to, opts: opts}
+
+		return result, err
+	}
+
+	t.Cleanup(func() { rollbackFn = prev })
+
+	return seen
+}
+
+func onATerminal(t *testing.T) {
+	t.Helper()
+
+	prev := isStdinTerminalFn
+	isStdinTerminalFn = func() bool { return true }
+
+	t.Cleanup(func() { isStdinTerminalFn = prev })
+}
+
+func runCmd(t *testing.T, args ...string) (stdout, stderr string, err error) {
+	t.Helper()
+
+	cmd := Cmd()
+	cmd.PreRunE = nil
+
+	var out, errOut bytes.Buffer
+
+	cmd.SetOut(&out)
+	cmd.SetErr(&errOut)
+	cmd.SetIn(strings.NewReader(""))
+	cmd.SetArgs(args)
+
+	err = cmd.Execute()
+
+	return out.String(), errOut.String(), err
+}
+
+func rolledBack() up.Result {
+	return up.Result{
+		WorkloadID: "wl-1", Name: "my-app", Status: "running",
+		Endpoint: "https://app.datarobot.com/workloads/wl-1/", ArtifactID: "art-2",
+		Action: up.ActionRolledBack, Locked: true,
+	}
+}
+
+func TestCmd_RequiresArg(t *testing.T) {
+	_, _, err := runCmd(t)
+	require.Error(t, err)
+}
+
+func TestCmd_PassesTheTargetAndPrintsTheArtifact(t *testing.T) {
+	seen := stubRollback(t, rolledBack(), nil)
+
+	stdout, stderr, err := runCmd(t, "wl-1", "--to", "art-2", "--yes")
+	require.NoError(t, err)
+
+	assert.Equal(t, "wl-1", seen.workloadID)
+	assert.Equal(t, "art-2", seen.to)
+	assert.True(t, seen.opts.NonInteractive)
+	assert.Nil(t, seen.opts.Confirm)
+	assert.Equal(t, "art-2\n", stdout, "stdout carries the artifact and nothing else")
+	assert.Contains(t, stderr, "is running artifact")
+}
+
+// On a terminal the question is asked, and --output json does not stand in
+// for the answer.
+func TestCmd_TerminalIsAskedEvenForJSON(t *testing.T) {
+	onATerminal(t)
+
+	seen := stubRollback(t, rolledBack(), nil)
+
+	_, _, err := runCmd(t, "wl-1", "--output-format", "json")
+	require.NoError(t, err)
+
+	assert.False(t, seen.opts.NonInteractive)
+	assert.NotNil(t, seen.opts.Confirm)
+}
+
+// A piped stdin has nobody to ask, and that is not consent either: the
+// rollback is left to refuse unless --yes was given.
+func TestCmd_NoTerminalIsNotConsent(t *testing.T) {
+	seen := stubRollback(t, rolledBack(), nil)
+
+	_, _, err := runCmd(t, "wl-1")
+	require.NoError(t, err)
+
+	assert.False(t, seen.opts.NonInteractive)
+	assert.Nil(t, seen.opts.Confirm)
+}
+
+func TestCmd_YesSkipsTheQuestion(t *testing.T) {
+	onATerminal(t)
+
+	seen := stubRollback(t, rolledBack(), nil)
+
+	_, _, err := runCmd(t, "wl-1", "--yes")
+	require.NoError(t, err)
+
+	assert.True(t, seen.opts.NonInteractive)
+	assert.Nil(t, seen.opts.Confirm)
+}
+
+func TestCmd_JSONEnvelope(t *testing.T) {
+	stubRollback(t, rolledBack(), nil)
+
+	stdout, _, err := runCmd(t, "wl-1", "--output-format", "json")
+	require.NoError(t, err)
+
+	var envelope struct {
+		Rollback rollbackResult `json:"rollback"`
+	}
+
+	require.NoError(t, json.Unmarshal([]byte(stdout), &envelope))
+	assert.Equal(t, "art-2", envelope.Rollback.ArtifactID)
+	assert.Equal(t, up.ActionRolledBack, envelope.Rollback.Action)
+}
+
+// A refusal swapped nothing, so there is nothing to report but the error.
+func TestCmd_RefusalPrintsNoEnvelope(t *testing.T) {
+	stubRollback(t, up.Result{WorkloadID: "wl-1", ArtifactID: "art-3"}, errors.New("running a draft"))
+
+	stdout, _, err := runCmd(t, "wl-1", "--output-format", "json")
+	require.Error(t, err)
+	assert.Empty(t, stdout)
+}
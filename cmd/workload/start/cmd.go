@@ -55,7 +55,7 @@ Example:
 		RunE: func(cmd *cobra.Command, args []string) error {
 			outputFormat = outputformat.GetFormat(cmd)
 
-			resp, err := workload.StartWorkload(args[0])
+			resp, err := workload.StartWorkload(cmd.Context(), args[0])
 			if err != nil {
 				return err
 			}
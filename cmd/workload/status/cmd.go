@@ -48,7 +48,7 @@ Example:
 		RunE: func(cmd *cobra.Command, args []string) error {
 			outputFormat = outputformat.GetFormat(cmd)
 
-			wl, err := workload.GetWorkload(args[0])
+			wl, err := workload.GetWorkload(cmd.Context(), args[0])
 			if err != nil {
 				return err
 			}
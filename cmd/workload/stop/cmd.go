@@ -53,7 +53,7 @@ Example:
 		RunE: func(cmd *cobra.Command, args []string) error {
 			outputFormat = outputformat.GetFormat(cmd)
 
-			resp, err := workload.StopWorkload(args[0])
+			resp, err := workload.StopWorkload(cmd.Context(), args[0])
 			if err != nil {
 				return err
 			}
@@ -18,15 +18,14 @@
 package up
 
 import (
-	"bufio"
 	"errors"
 	"fmt"
 	"io"
 	"os"
-	"strings"
 	"time"
 
 	"github.com/datarobot/cli/cmd/internal/pollflags"
+	"github.com/datarobot/cli/cmd/internal/typedconfirm"
 	"github.com/datarobot/cli/internal/auth"
 	"github.com/datarobot/cli/internal/config/viperx"
 	"github.com/datarobot/cli/internal/misc/reader"
@@ -81,6 +80,7 @@ func buildID(id string) *string {
 
 type flags struct {
 	dir    string
+	env    string
 	yes    bool
 	dryRun bool
 	detach bool
@@ -137,9 +137,14 @@ allocation, is applied in place instead. Nothing is built and no version is
 made, because what the workload runs has not changed. A deploy that moves both
 sends the sizing with the rollout, so the new version comes up with it.
 
+--env deploys an environment: .datarobot.<env>.yaml beside the manifest is
+merged onto it first, and the environment is its own workload, bound in that
+file rather than in .datarobot.yaml.
+
 Examples:
   dr workload up
   dr workload up --dry-run
+  dr workload up --env staging
   dr workload up --yes --output-format json`,
 		Args:         cobra.NoArgs,
 		PreRunE:      auth.EnsureAuthenticatedE,
@@ -160,6 +165,7 @@ Examples:
 		return map[string]any{
 			"yes":           f.yes || viperx.GetBool("yes"),
 			"dry_run":       f.dryRun,
+			"environment":   f.env != "",
 			"detach":        f.detach,
 			"lock":          f.lock,
 			"force_build":   f.force,
@@ -172,6 +178,8 @@ Examples:
 
 func addFlags(cmd *cobra.Command, f *flags, poll *pollflags.Set) {
 	cmd.Flags().StringVar(&f.dir, "dir", "", "Project directory; the manifest is searched upward from here.")
+	cmd.Flags().StringVar(&f.env, "env", "",
+		"Deploy this environment: merge .datarobot.<env>.yaml onto the manifest and use its workload.")
 	cmd.Flags().BoolVarP(&f.yes, "yes", "y", false,
 		"Do not prompt. With no manifest this is an error rather than a wizard, "+
 			"and rolling a locked production version is not confirmed.")
@@ -216,8 +224,9 @@ func run(cmd *cobra.Command, f flags, poll pollflags.Set, format outputformat.Ou
 	// stdout is being parsed is a trap for whoever is parsing it.
 	nonInteractive := yes || json || !isStdinTerminalFn()
 
-	result, runErr := runFn(up.Options{
+	result, runErr := runFn(cmd.Context(), up.Options{
 		Dir:            dir,
+		Environment:    f.env,
 		NonInteractive: nonInteractive,
 		DryRun:         f.dryRun,
 		Detach:         f.detach,
@@ -296,34 +305,7 @@ func rollConfirm(cmd *cobra.Command, yes bool) func(question, want string) (bool
 		return nil
 	}
 
-	return typedConfirm(cmd)
-}
-
-// typedConfirm asks a question that only the exact expected word answers.
-//
-// It goes to stderr and reads a single line, like everything else the deploy
-// says: stdout is the endpoint, or one JSON document, and a question printed
-// into it would break whatever is parsing it. A y/n would not do here, since
-// the one thing this is asked about is rolling production.
-//
-// The deploy calls it only on an interactive run, so it never has to decide
-// whether prompting is allowed. An answer that cannot be read at all is a no,
-// which leaves the workload running what it was running.
-func typedConfirm(cmd *cobra.Command) func(question, want string) (bool, error) {
-	return func(question, want string) (bool, error) {
-		fmt.Fprint(cmd.ErrOrStderr(), question)
-
-		scanner := bufio.NewScanner(cmd.InOrStdin())
-		if !scanner.Scan() {
-			if err := scanner.Err(); err != nil {
-				return false, fmt.Errorf("cannot read the answer: %w", err)
-			}
-
-			return false, nil
-		}
-
-		return strings.TrimSpace(scanner.Text()) == want, nil
-	}
+	return typedconfirm.Prompt(cmd)
 }
 
 // reportable says whether a failed run left behind anything worth printing.
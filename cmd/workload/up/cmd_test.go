@@ -16,6 +16,7 @@ package up
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"strings"
@@ -34,7 +35,7 @@ func stubRun(t *testing.T, result up.Result, err error) *up.Options {
 	seen := &up.Options{}
 	prev := runFn
 
-	runFn = func(opts up.Options) (up.Result, error) {
+	runFn = func(_ context.Context, opts up.Options) (up.Result, error) {
 		*seen = opts
 
 		return result, err
@@ -207,10 +208,11 @@ func TestCmd_DryRunSaysSoAndPrintsNoEndpoint(t *testing.T) {
 func TestCmd_PassesTheFlagsThrough(t *testing.T) {
 	seen := stubRun(t, deployed(), nil)
 
-	_, _, err := runCmd(t, "--detach", "--dir", "/tmp/project")
+	_, _, err := runCmd(t, "--detach", "--dir", "/tmp/project", "--env", "staging")
 	require.NoError(t, err)
 	assert.True(t, seen.Detach)
 	assert.Equal(t, "/tmp/project", seen.Dir)
+	assert.Equal(t, "staging", seen.Environment)
 
 	locking := stubRun(t, deployed(), nil)
 
@@ -298,39 +300,6 @@ func TestCmd_FailureBeforeAnythingExistsJustFails(t *testing.T) {
 	assert.Contains(t, err.Error(), "manifest")
 }
 
-// The deploy asks one question, and only the exact name answers it. The
-// question goes to stderr because stdout carries the endpoint.
-func TestCmd_TypedConfirmAcceptsOnlyTheName(t *testing.T) {
-	cases := []struct {
-		typed string
-		want  bool
-	}{
-		{"my-app\n", true},
-		{"  my-app  \n", true},
-		{"y\n", false},
-		{"My-App\n", false},
-		{"\n", false},
-		{"", false},
-	}
-
-	for _, c := range cases {
-		t.Run(c.typed, func(t *testing.T) {
-			cmd := Cmd()
-
-			var errOut bytes.Buffer
-
-			cmd.SetErr(&errOut)
-			cmd.SetIn(strings.NewReader(c.typed))
-
-			agreed, err := typedConfirm(cmd)("Type the workload name: ", "my-app")
-			require.NoError(t, err)
-
-			assert.Equal(t, c.want, agreed)
-			assert.Contains(t, errOut.String(), "Type the workload name: ")
-		})
-	}
-}
-
 // The question reaches the deploy wired up, so a locked production roll can
 // actually be answered from a terminal.
 func TestCmd_ConfirmIsHandedToTheDeploy(t *testing.T) {
@@ -294,6 +294,36 @@ func reportStoredProfileNotUsed(w io.Writer, creds *EnvCredentials) {
 	fmt.Fprintln(w, base.Render("."))
 }
 
+// reportMissingProfile explains how to create or find a profile when the
+// active one is not stored, and reports whether it exists.
+func reportMissingProfile(w io.Writer) bool {
+	name := config.ActiveProfile()
+
+	exists, err := config.ProfileExists(name)
+	if err != nil {
+		log.Error("Failed to read auth profiles.", "error", err)
+
+		return false
+	}
+
+	if exists {
+		return true
+	}
+
+	base, info := writerStyles(w)
+
+	fmt.Fprint(w, base.Render("❌ Profile "))
+	fmt.Fprint(w, info.Render(name))
+	fmt.Fprintln(w, base.Render(" does not exist."))
+	fmt.Fprint(w, base.Render("Run "))
+	fmt.Fprint(w, info.Render("dr auth login --profile "+name))
+	fmt.Fprint(w, base.Render(" to create it, or "))
+	fmt.Fprint(w, info.Render("dr auth profile list"))
+	fmt.Fprintln(w, base.Render(" to see the existing ones."))
+
+	return false
+}
+
 // VerifyEnvCredentials checks if environment variable credentials are valid.
 // Returns credentials and nil error if valid, credentials and error otherwise.
 func VerifyEnvCredentials(ctx context.Context) (*EnvCredentials, error) {
@@ -359,6 +389,12 @@ func EnsureAuthenticated(ctx context.Context) bool { //nolint: cyclop
 		return false
 	}
 
+	// A mistyped --profile must not start a login flow that would quietly
+	// create the misspelled profile. `dr auth login --profile` creates one.
+	if !reportMissingProfile(os.Stderr) {
+		return false
+	}
+
 	datarobotHost := GetBaseURLOrAsk()
 	if datarobotHost == "" {
 		// Appropriate error message was already displayed in GetBaseURLOrAsk() and SetURLAction()
@@ -153,6 +153,29 @@ func TestEnsureAuthenticated_ValidCredentials(t *testing.T) {
 	assert.True(t, result, "Expected EnsureAuthenticated to return true with valid credentials")
 }
 
+// A mistyped --profile must fail without starting the login flow, which would
+// otherwise create the misspelled profile.
+func TestEnsureAuthenticated_UnknownProfile(t *testing.T) {
+	_, cleanup := setupTestEnvironment(t)
+	defer cleanup()
+
+	viperx.Set(config.ProfileKey, "prdo")
+	viperx.Set(config.DataRobotAPIKey, "")
+
+	APIKeyCallbackFunc = func(_ context.Context, _ string) (string, error) {
+		t.Fatal("the login flow must not start for an unknown profile")
+
+		return "", nil
+	}
+
+	assert.False(t, EnsureAuthenticated(context.Background()))
+
+	var buf bytes.Buffer
+
+	assert.False(t, reportMissingProfile(&buf))
+	assert.Contains(t, buf.String(), "dr auth login --profile prdo")
+}
+
 func TestEnsureAuthenticated_ValidEnvironmentToken(t *testing.T) {
 	server, cleanup := setupTestEnvironment(t)
 	defer cleanup()
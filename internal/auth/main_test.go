@@ -0,0 +1,31 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"os"
+	"testing"
+
+	"github.com/datarobot/cli/internal/config"
+)
+
+func TestMain(m *testing.M) {
+	// Tests that save a token reach the credential-store probe. Report no OS
+	// keyring so they never read or write the developer's real one; tests that
+	// want a store install one with config.SetCredentialStoreForTest.
+	config.SetKeyringProbeForTest(false)
+
+	os.Exit(m.Run())
+}
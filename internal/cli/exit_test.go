@@ -0,0 +1,33 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExitCode(t *testing.T) {
+	ended := &ExitError{Code: 3, Err: errors.New("run r-1 was cancelled")}
+
+	assert.Equal(t, 3, ExitCode(ended))
+	assert.Equal(t, 3, ExitCode(fmt.Errorf("execute root command: %w", ended)), "the code survives wrapping")
+	assert.Equal(t, "run r-1 was cancelled", ended.Error())
+	assert.Equal(t, 1, ExitCode(errors.New("boom")))
+	assert.Equal(t, 1, ExitCode(&ExitError{Err: errors.New("no code")}))
+}
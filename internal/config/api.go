@@ -141,8 +141,13 @@ func RedactedReqInfo(req *http.Request) string {
 // above includes the request body, and bodies carry secrets: POST
 // /credentials/ sends one by definition. Without this, `dr --debug` writes
 // the user's token into the debug log file, permanently and in the clear.
-var secretBodyFields = regexp.MustCompile(
-	`(?i)"(apiToken|password|passwd|secret|privateKey|clientSecret|refreshToken|accessToken|token)"\s*:\s*"[^"]*"`)
+var secretBodyFields = regexp.MustCompile(`(?i)"(` + secretFieldNames + `)"\s*:\s*"[^"]*"`)
+
+// secretFieldNames are the names, matched case-insensitively, whose values
+// are secrets wherever they appear: JSON fields, query parameters, form fields.
+const secretFieldNames = `apiToken|password|passwd|secret|privateKey|clientSecret|refreshToken|accessToken|token`
+
+var secretFieldName = regexp.MustCompile(`(?i)^(` + secretFieldNames + `)$`)
 
 // RedactSecretFields replaces the value of every known secret-bearing JSON
 // field in s. It is deliberately keyed on field names rather than on the value
@@ -152,6 +157,12 @@ func RedactSecretFields(s string) string {
 	return secretBodyFields.ReplaceAllString(s, `"$1": "[REDACTED]"`)
 }
 
+// IsSecretField reports whether a field or parameter called name carries a
+// secret, by the same names RedactSecretFields redacts.
+func IsSecretField(name string) bool {
+	return secretFieldName.MatchString(name)
+}
+
 // TODO: I believe we want to delete this function as there is SetURLToConfig function
 // But it is used in cmd/templates/setup/model.go.
 func SaveURLToConfig(newURL string) error {
@@ -184,6 +184,7 @@ func ReadConfigFile(filePath string) error {
 var sensitiveDebugKeys = map[string]struct{}{
 	"token":                    {},
 	"pulumi_config_passphrase": {},
+	CredentialPassphraseKey:    {},
 }
 
 func DebugViperConfig() (string, error) {
@@ -211,7 +212,9 @@ func DebugViperConfig() (string, error) {
 		value := viper.Get(key)
 
 		// Redact sensitive keys
-		if _, sensitive := sensitiveDebugKeys[key]; sensitive {
+		if key == ProfilesKey {
+			writeDebugProfiles(&sb, value)
+		} else if _, sensitive := sensitiveDebugKeys[key]; sensitive {
 			fmt.Fprintf(&sb, "  %s: %s\n", key, "****")
 		} else {
 			fmt.Fprintf(&sb, "  %s: %v\n", key, value)
@@ -220,3 +223,44 @@ func DebugViperConfig() (string, error) {
 
 	return sb.String(), nil
 }
+
+// writeDebugProfiles prints each named profile's keys on its own line,
+// redacting the same sensitive keys as the top level: every profile holds a
+// token of its own.
+func writeDebugProfiles(sb *strings.Builder, value any) {
+	profiles, ok := value.(map[string]any)
+	if !ok {
+		fmt.Fprintf(sb, "  %s: %s\n", ProfilesKey, "****")
+
+		return
+	}
+
+	names := make([]string, 0, len(profiles))
+	for name := range profiles {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		fields, ok := profiles[name].(map[string]any)
+		if !ok {
+			continue
+		}
+
+		keys := make([]string, 0, len(fields))
+		for key := range fields {
+			keys = append(keys, key)
+		}
+
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			if _, sensitive := sensitiveDebugKeys[key]; sensitive {
+				fmt.Fprintf(sb, "  %s: %s\n", profileKeyPath(name, key), "****")
+			} else {
+				fmt.Fprintf(sb, "  %s: %v\n", profileKeyPath(name, key), fields[key])
+			}
+		}
+	}
+}
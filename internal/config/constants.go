@@ -32,6 +32,47 @@ const (
 	// either an LLM Gateway model id or a DataRobot deployment id.
 	DefaultLLMID = "default-llm-id"
 
+	// UpdateMirrorKey is the config key for the base URL `dr self update`
+	// reads releases from instead of GitHub, for networks that cannot reach
+	// it. DATAROBOT_CLI_UPDATE_MIRROR sets it too.
+	UpdateMirrorKey = "update-mirror"
+
+	// ComponentIndexKey is the config key for the component catalog `dr
+	// component add` offers: an http(s) URL, a file:// URL, or a local path.
+	// DATAROBOT_CLI_COMPONENT_INDEX sets it too. Unset means the published index.
+	ComponentIndexKey = "component-index"
+
+	// ProfileKey is the viper key behind the --profile persistent flag and the
+	// DATAROBOT_PROFILE environment variable. It selects a named profile for one
+	// invocation and is never persisted; CurrentProfileKey is the stored choice.
+	ProfileKey = "profile"
+
+	// CurrentProfileKey is the config key recording the profile `dr auth profile
+	// use` selected. Absent means DefaultProfile.
+	CurrentProfileKey = "current-profile"
+
+	// ProfilesKey is the config key holding the named profiles, each a map with
+	// its own DataRobotURL and DataRobotAPIKey.
+	ProfilesKey = "profiles"
+
+	// CredentialStoreKey records where API tokens are kept: CredentialStoreKeyring,
+	// CredentialStoreFile, or CredentialStorePlaintext in drconfig.yaml itself.
+	// DATAROBOT_CLI_CREDENTIAL_STORE overrides it for new tokens.
+	CredentialStoreKey = "credential-store"
+
+	CredentialStoreAuto      = "auto"
+	CredentialStoreKeyring   = "keyring"
+	CredentialStoreFile      = "file"
+	CredentialStorePlaintext = "plaintext"
+
+	// CredentialPassphraseKey is the passphrase for the encrypted credential
+	// file, read from DATAROBOT_CLI_CREDENTIAL_PASSPHRASE. It is never persisted.
+	CredentialPassphraseKey = "credential-passphrase"
+
+	// DefaultProfile names the unnamed profile stored in the top-level endpoint
+	// and token keys, which is what every config written before profiles holds.
+	DefaultProfile = "default"
+
 	// EnvPrefix is the canonical prefix for all DATAROBOT_CLI_* environment
 	// variables. Use this constant instead of hard-coding the string literal.
 	EnvPrefix = "DATAROBOT_CLI_"
@@ -40,4 +81,9 @@ const (
 	// persistent root flag for forwarding to plugin subprocesses as a
 	// DATAROBOT_CLI_<suffix> env var. cmd/root.go writes it; internal/plugin reads it.
 	UniversalAnnotationKey = "plugin-universal"
+
+	// CreatesProfileAnnotationKey marks a command that may name a profile that
+	// does not exist yet with --profile, because running it creates one.
+	// cmd/auth/login sets it; cmd/root.go passes it to ApplyActiveProfile.
+	CreatesProfileAnnotationKey = "creates-profile"
 )
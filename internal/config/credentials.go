@@ -0,0 +1,373 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"sort"
+
+	"github.com/datarobot/cli/internal/credstore"
+	"github.com/datarobot/cli/internal/log"
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
+)
+
+// credentialService is the keyring service API tokens are filed under for the
+// default drconfig.yaml. A config chosen with --config gets its own service, so
+// the "default" profiles of two config files never share a token.
+const credentialService = "datarobot-cli"
+
+// credentialFileName is the encrypted credential file, kept beside drconfig.yaml.
+const credentialFileName = "credentials.enc"
+
+// credentialStoreOverride replaces every backend when set. See
+// SetCredentialStoreForTest.
+var credentialStoreOverride credstore.Store
+
+// SetCredentialStoreForTest routes every token read and write through store
+// instead of the OS keyring or the encrypted file, and returns a function that
+// restores the previous store.
+func SetCredentialStoreForTest(store credstore.Store) func() {
+	prev := credentialStoreOverride
+	credentialStoreOverride = store
+
+	return func() { credentialStoreOverride = prev }
+}
+
+// keyringAvailable probes for a usable OS keyring. Tests replace it with
+// SetKeyringProbeForTest so they never touch the developer's real keyring.
+var keyringAvailable = credstore.KeyringAvailable
+
+// SetKeyringProbeForTest makes the credential-store probe report the OS keyring
+// as available or not without touching it, and returns a function that restores
+// the real probe.
+func SetKeyringProbeForTest(available bool) func() {
+	prev := keyringAvailable
+	keyringAvailable = func(string) bool { return available }
+
+	return func() { keyringAvailable = prev }
+}
+
+// credentialBackend returns the store new tokens go to: the configured
+// credential-store, or the first of the OS keyring, the encrypted file (when
+// a passphrase is set), and plaintext that is available.
+func credentialBackend() (string, error) {
+	switch backend := viper.GetString(CredentialStoreKey); backend {
+	case CredentialStoreKeyring, CredentialStoreFile, CredentialStorePlaintext:
+		return backend, nil
+	case "", CredentialStoreAuto:
+		return detectCredentialBackend(), nil
+	default:
+		return "", fmt.Errorf("unknown %s %q: use %s, %s, %s or %s", CredentialStoreKey, backend,
+			CredentialStoreAuto, CredentialStoreKeyring, CredentialStoreFile, CredentialStorePlaintext)
+	}
+}
+
+// detectCredentialBackend probes for a usable store.
+func detectCredentialBackend() string {
+	if credentialStoreOverride != nil {
+		return CredentialStoreKeyring
+	}
+
+	if keyringAvailable(keyringService()) {
+		return CredentialStoreKeyring
+	}
+
+	if viper.GetString(CredentialPassphraseKey) != "" {
+		return CredentialStoreFile
+	}
+
+	return CredentialStorePlaintext
+}
+
+// openCredentialStore returns the store for a backend other than plaintext.
+func openCredentialStore(backend string) (credstore.Store, error) {
+	if credentialStoreOverride != nil {
+		return credentialStoreOverride, nil
+	}
+
+	switch backend {
+	case CredentialStoreKeyring:
+		return credstore.NewKeyring(keyringService()), nil
+	case CredentialStoreFile:
+		passphrase := viper.GetString(CredentialPassphraseKey)
+		if passphrase == "" {
+			return nil, fmt.Errorf("%w: set %sCREDENTIAL_PASSPHRASE", credstore.ErrNoPassphrase, EnvPrefix)
+		}
+
+		configFile, err := resolveConfigFilePath()
+		if err != nil {
+			return nil, err
+		}
+
+		return credstore.NewFile(filepath.Join(filepath.Dir(configFile), credentialFileName), passphrase), nil
+	default:
+		return nil, fmt.Errorf("no credential store for %s %q", CredentialStoreKey, backend)
+	}
+}
+
+func keyringService() string {
+	configFile, err := resolveConfigFilePath()
+	if err != nil {
+		return credentialService
+	}
+
+	dir, err := GetConfigDir()
+	if err == nil && configFile == filepath.Join(dir, configFileName) {
+		return credentialService
+	}
+
+	if abs, err := filepath.Abs(configFile); err == nil {
+		configFile = abs
+	}
+
+	return credentialService + ":" + configFile
+}
+
+// usesCredentialStore reports whether backend keeps tokens outside drconfig.yaml.
+func usesCredentialStore(backend string) bool {
+	return backend == CredentialStoreKeyring || backend == CredentialStoreFile
+}
+
+// storeToken moves the token UpdateConfigFile just wrote into node out to the
+// credential store, leaving a credential-store marker in its place. It does
+// nothing when the token was not part of the write or plaintext is in use.
+func storeToken(node *yaml.Node, keys []string) error {
+	if len(keys) > 0 && !slices.Contains(keys, DataRobotAPIKey) {
+		return nil
+	}
+
+	if !viper.IsSet(DataRobotAPIKey) {
+		return nil
+	}
+
+	backend, err := credentialBackend()
+	if err != nil {
+		return err
+	}
+
+	if !usesCredentialStore(backend) {
+		return nil
+	}
+
+	store, err := openCredentialStore(backend)
+	if err != nil {
+		return err
+	}
+
+	account := ActiveProfile()
+
+	if token := viper.GetString(DataRobotAPIKey); token != "" {
+		err = store.Set(account, token)
+	} else {
+		err = store.Delete(account)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to save API token: %w", err)
+	}
+
+	deleteNestedKeyInNode(node, storedKeyPath(DataRobotAPIKey))
+	setNestedKeyInNode(node, CredentialStoreKey, backend)
+
+	return nil
+}
+
+// LoadStoredToken reads the active profile's token from the credential store
+// into viper's config layer, so every reader of DataRobotAPIKey sees it. A
+// plaintext token in drconfig.yaml or a DATAROBOT_CLI_TOKEN wins, and a config
+// that has never used a store is not probed at all. It must run after
+// ApplyActiveProfile.
+func LoadStoredToken() error {
+	if viper.GetString(DataRobotAPIKey) != "" {
+		return nil
+	}
+
+	stored, err := readStoredProfiles()
+	if err != nil {
+		return err
+	}
+
+	if !usesCredentialStore(stored.CredentialStore) {
+		return nil
+	}
+
+	store, err := openCredentialStore(stored.CredentialStore)
+	if err != nil {
+		return err
+	}
+
+	token, err := store.Get(ActiveProfile())
+	if errors.Is(err, credstore.ErrNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return viper.MergeConfigMap(map[string]any{DataRobotAPIKey: token})
+}
+
+// MigratePlaintextTokens moves every plaintext token in drconfig.yaml, for all
+// profiles, into the credential store. With no store available it records
+// credential-store: plaintext, so the decision (and the keyring probe behind
+// it) is made once rather than on every command.
+func MigratePlaintextTokens() error {
+	stored, err := readStoredProfiles()
+	if err != nil {
+		return err
+	}
+
+	tokens := plaintextTokens(stored)
+	if len(tokens) == 0 {
+		return nil
+	}
+
+	// An explicit plaintext choice, or an earlier migration that found nothing
+	// to migrate to, stands until DATAROBOT_CLI_CREDENTIAL_STORE says otherwise.
+	backend, err := credentialBackend()
+	if err != nil {
+		return err
+	}
+
+	if !usesCredentialStore(backend) {
+		if stored.CredentialStore == "" {
+			log.Warn("No OS keyring found and " + EnvPrefix + "CREDENTIAL_PASSPHRASE is not set; " +
+				"API tokens stay in plaintext in drconfig.yaml.")
+
+			return updateStoredConfig(func(node *yaml.Node) error {
+				setNestedKeyInNode(node, CredentialStoreKey, CredentialStorePlaintext)
+
+				return nil
+			})
+		}
+
+		return nil
+	}
+
+	store, err := openCredentialStore(backend)
+	if err != nil {
+		return err
+	}
+
+	err = updateStoredConfig(func(node *yaml.Node) error {
+		for _, name := range sortedKeys(tokens) {
+			if err := store.Set(name, tokens[name]); err != nil {
+				return fmt.Errorf("failed to move API token for profile %s: %w", name, err)
+			}
+
+			path := DataRobotAPIKey
+			if name != DefaultProfile {
+				path = profileKeyPath(name, DataRobotAPIKey)
+			}
+
+			deleteNestedKeyInNode(node, path)
+		}
+
+		setNestedKeyInNode(node, CredentialStoreKey, backend)
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Infof("Moved %d API token(s) from drconfig.yaml to the %s credential store.", len(tokens), backend)
+
+	return nil
+}
+
+// plaintextTokens returns the non-empty tokens in drconfig.yaml by profile.
+func plaintextTokens(stored *storedProfiles) map[string]string {
+	tokens := map[string]string{}
+
+	if stored.Token != "" {
+		tokens[DefaultProfile] = stored.Token
+	}
+
+	for name, creds := range stored.Profiles {
+		if creds.Token != "" {
+			tokens[name] = creds.Token
+		}
+	}
+
+	return tokens
+}
+
+// hasStoredToken reports whether a profile has a token in the credential
+// store, for a profile whose drconfig.yaml entry holds none.
+func hasStoredToken(stored *storedProfiles, name string) bool {
+	if !usesCredentialStore(stored.CredentialStore) {
+		return false
+	}
+
+	store, err := openCredentialStore(stored.CredentialStore)
+	if err != nil {
+		return false
+	}
+
+	token, err := store.Get(name)
+
+	return err == nil && token != ""
+}
+
+// deleteStoredToken removes a profile's token from the credential store, if
+// drconfig.yaml says tokens are kept in one.
+func deleteStoredToken(stored *storedProfiles, name string) error {
+	if !usesCredentialStore(stored.CredentialStore) {
+		return nil
+	}
+
+	store, err := openCredentialStore(stored.CredentialStore)
+	if err != nil {
+		return err
+	}
+
+	return store.Delete(name)
+}
+
+// updateStoredConfig applies edit to drconfig.yaml and writes it back.
+func updateStoredConfig(edit func(node *yaml.Node) error) error {
+	configFile, err := resolveConfigFilePath()
+	if err != nil {
+		return err
+	}
+
+	node, err := readYAMLNode(configFile)
+	if err != nil {
+		return err
+	}
+
+	if err := edit(node); err != nil {
+		return err
+	}
+
+	return writeYAMLNode(configFile, node)
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
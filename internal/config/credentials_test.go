@@ -0,0 +1,180 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"os"
+	"testing"
+
+	"github.com/datarobot/cli/internal/credstore"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeStore installs an in-memory credential store for the test.
+func fakeStore(t *testing.T) *credstore.Memory {
+	t.Helper()
+
+	store := credstore.NewMemory()
+	t.Cleanup(SetCredentialStoreForTest(store))
+
+	return store
+}
+
+func storedToken(t *testing.T, store credstore.Store, account string) string {
+	t.Helper()
+
+	token, err := store.Get(account)
+	require.NoError(t, err)
+
+	return token
+}
+
+func TestMigratePlaintextTokens_MovesEveryProfile(t *testing.T) {
+	store := fakeStore(t)
+	configFile := setupProfiles(t, profilesConfig)
+
+	require.NoError(t, MigratePlaintextTokens())
+
+	assert.Equal(t, "default-token", storedToken(t, store, DefaultProfile))
+	assert.Equal(t, "prod-token", storedToken(t, store, "prod"))
+	assert.Equal(t, "staging-token", storedToken(t, store, "staging"))
+
+	data, err := os.ReadFile(configFile)
+	require.NoError(t, err)
+
+	assert.NotContains(t, string(data), "-token")
+	assert.Contains(t, string(data), "endpoint: https://prod.datarobot.com/api/v2", "endpoints stay in the file")
+	assert.Contains(t, string(data), "# staging and prod are named profiles", "comments survive")
+	assert.Equal(t, CredentialStoreKeyring, readRaw(t, configFile)[CredentialStoreKey])
+
+	// A second run finds nothing left to move.
+	require.NoError(t, MigratePlaintextTokens())
+	assert.Equal(t, 3, store.Len())
+}
+
+// Without a store the decision is recorded once, so later runs neither probe
+// for a keyring again nor warn again.
+func TestMigratePlaintextTokens_NoStoreRecordsPlaintext(t *testing.T) {
+	configFile := setupProfiles(t, profilesConfig)
+
+	require.NoError(t, MigratePlaintextTokens())
+
+	raw := readRaw(t, configFile)
+
+	assert.Equal(t, CredentialStorePlaintext, raw[CredentialStoreKey])
+	assert.Equal(t, "default-token", raw["token"])
+}
+
+func TestMigratePlaintextTokens_RespectsPlaintextChoice(t *testing.T) {
+	store := fakeStore(t)
+	setupProfiles(t, profilesConfig+"credential-store: plaintext\n")
+
+	require.NoError(t, MigratePlaintextTokens())
+	assert.Zero(t, store.Len())
+}
+
+func TestLoadStoredToken_ActiveProfile(t *testing.T) {
+	fakeStore(t)
+	setupProfiles(t, profilesConfig)
+	require.NoError(t, MigratePlaintextTokens())
+
+	viper.Reset()
+	require.NoError(t, ReadConfigFile(""))
+	viper.Set(ProfileKey, "staging")
+	require.NoError(t, ApplyActiveProfile(false))
+
+	assert.Empty(t, viper.GetString(DataRobotAPIKey), "the file no longer holds the token")
+
+	require.NoError(t, LoadStoredToken())
+	assert.Equal(t, "staging-token", viper.GetString(DataRobotAPIKey))
+}
+
+func TestLoadStoredToken_EnvironmentWins(t *testing.T) {
+	fakeStore(t)
+	setupProfiles(t, profilesConfig)
+	require.NoError(t, MigratePlaintextTokens())
+
+	t.Setenv("DATAROBOT_CLI_TOKEN", "env-token")
+
+	viper.Reset()
+	viper.SetEnvPrefix("DATAROBOT_CLI")
+	viper.AutomaticEnv()
+	require.NoError(t, ReadConfigFile(""))
+	require.NoError(t, LoadStoredToken())
+
+	assert.Equal(t, "env-token", viper.GetString(DataRobotAPIKey))
+}
+
+func TestUpdateConfigFile_SavesTokenToStore(t *testing.T) {
+	store := fakeStore(t)
+	configFile := setupProfiles(t, "endpoint: https://app.datarobot.com/api/v2\n")
+	viper.Set(ProfileKey, "prod")
+	require.NoError(t, ApplyActiveProfile(true))
+
+	viper.Set(DataRobotAPIKey, "fresh-token")
+	require.NoError(t, UpdateConfigFile())
+
+	assert.Equal(t, "fresh-token", storedToken(t, store, "prod"))
+
+	raw := readRaw(t, configFile)
+
+	assert.Equal(t, CredentialStoreKeyring, raw[CredentialStoreKey])
+	assert.NotContains(t, raw[ProfilesKey].(map[string]any)["prod"], "token")
+
+	// Logging out clears the stored token.
+	viper.Set(DataRobotAPIKey, "")
+	require.NoError(t, UpdateConfigFile(DataRobotAPIKey))
+
+	_, err := store.Get("prod")
+	require.ErrorIs(t, err, credstore.ErrNotFound)
+}
+
+func TestUpdateConfigFile_ExplicitPlaintext(t *testing.T) {
+	store := fakeStore(t)
+	configFile := setupProfiles(t, "credential-store: plaintext\n")
+
+	viper.Set(DataRobotAPIKey, "plain-token")
+	require.NoError(t, UpdateConfigFile())
+
+	assert.Equal(t, "plain-token", readRaw(t, configFile)["token"])
+	assert.Zero(t, store.Len())
+}
+
+func TestUpdateConfigFile_UnknownCredentialStore(t *testing.T) {
+	setupProfiles(t, "credential-store: vault\n")
+
+	viper.Set(DataRobotAPIKey, "token")
+	require.ErrorContains(t, UpdateConfigFile(), "unknown credential-store")
+}
+
+func TestProfiles_SeeStoredTokens(t *testing.T) {
+	store := fakeStore(t)
+	setupProfiles(t, profilesConfig)
+	require.NoError(t, MigratePlaintextTokens())
+
+	profiles, err := ListProfiles()
+	require.NoError(t, err)
+
+	for _, p := range profiles {
+		assert.True(t, p.HasToken, p.Name)
+	}
+
+	require.NoError(t, DeleteProfile("prod"))
+
+	_, err = store.Get("prod")
+	require.ErrorIs(t, err, credstore.ErrNotFound)
+}
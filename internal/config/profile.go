@@ -0,0 +1,309 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+// ErrProfileNotFound is returned when a named profile is not in drconfig.yaml.
+var ErrProfileNotFound = errors.New("no such profile")
+
+// profileNamePattern restricts names to what survives a round trip through
+// viper, which lowercases keys and splits them on dots.
+var profileNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+// Profile describes one stored set of credentials.
+type Profile struct {
+	Name     string `json:"name"`
+	Endpoint string `json:"endpoint"`
+	HasToken bool   `json:"hasToken"`
+	Active   bool   `json:"active"`
+}
+
+// storedCredentials mirrors the endpoint and token keys of one profile.
+type storedCredentials struct {
+	Endpoint string `yaml:"endpoint"`
+	Token    string `yaml:"token"`
+}
+
+// storedProfiles is the part of drconfig.yaml that profiles and their tokens
+// live in. The default profile is the top-level endpoint and token.
+type storedProfiles struct {
+	storedCredentials `yaml:",inline"`
+
+	Current         string                       `yaml:"current-profile"`
+	Profiles        map[string]storedCredentials `yaml:"profiles"`
+	CredentialStore string                       `yaml:"credential-store"`
+}
+
+// ValidateProfileName rejects names that cannot be stored as a profile key.
+func ValidateProfileName(name string) error {
+	if !profileNamePattern.MatchString(name) {
+		return fmt.Errorf("invalid profile name %q: use lowercase letters, digits, '-' and '_'", name)
+	}
+
+	return nil
+}
+
+// ActiveProfile returns the profile this invocation uses: --profile, then
+// DATAROBOT_PROFILE (both bound to ProfileKey), then the stored current-profile,
+// then DefaultProfile.
+func ActiveProfile() string {
+	if name := strings.TrimSpace(viper.GetString(ProfileKey)); name != "" {
+		return name
+	}
+
+	if name := strings.TrimSpace(viper.GetString(CurrentProfileKey)); name != "" {
+		return name
+	}
+
+	return DefaultProfile
+}
+
+// ApplyActiveProfile makes the active profile's endpoint and token the values
+// behind DataRobotURL and DataRobotAPIKey. It must run after ReadConfigFile.
+//
+// A profile named with --profile or DATAROBOT_PROFILE must exist, or a typo
+// would run the command with no credentials at all. allowNew lifts that for
+// the one command that creates a profile, 'dr auth login --profile <new>'.
+//
+// The values are merged into viper's config layer rather than Set, so the
+// DATAROBOT_CLI_* variables and the environment credentials EnsureAuthenticated
+// binds still take precedence, exactly as they do over the default profile.
+func ApplyActiveProfile(allowNew bool) error {
+	name := ActiveProfile()
+
+	if err := ValidateProfileName(name); err != nil {
+		return err
+	}
+
+	if name == DefaultProfile {
+		return nil
+	}
+
+	if !allowNew && strings.TrimSpace(viper.GetString(ProfileKey)) == name {
+		if err := requireProfile(name); err != nil {
+			return err
+		}
+	}
+
+	return viper.MergeConfigMap(map[string]any{
+		DataRobotURL:    viper.GetString(profileKeyPath(name, DataRobotURL)),
+		DataRobotAPIKey: viper.GetString(profileKeyPath(name, DataRobotAPIKey)),
+	})
+}
+
+// requireProfile returns ErrProfileNotFound, listing the profiles that do
+// exist, unless name is stored in drconfig.yaml.
+func requireProfile(name string) error {
+	stored, err := readStoredProfiles()
+	if err != nil {
+		return err
+	}
+
+	if _, ok := stored.Profiles[name]; ok {
+		return nil
+	}
+
+	known := []string{DefaultProfile}
+	for other := range stored.Profiles {
+		known = append(known, other)
+	}
+
+	sort.Strings(known[1:])
+
+	return fmt.Errorf("%w: %s (known profiles: %s); create it with 'dr auth login --profile %s'",
+		ErrProfileNotFound, name, strings.Join(known, ", "), name)
+}
+
+// profileKeyPath returns the dotted config path of key within a named profile.
+func profileKeyPath(name, key string) string {
+	return ProfilesKey + "." + name + "." + key
+}
+
+// storedKeyPath maps a viper key to the path UpdateConfigFile writes it to:
+// the endpoint and token of a named profile live under ProfilesKey, so logging
+// in or setting the URL with a profile active never touches another profile.
+func storedKeyPath(key string) string {
+	if key != DataRobotURL && key != DataRobotAPIKey {
+		return key
+	}
+
+	name := ActiveProfile()
+	if name == DefaultProfile {
+		return key
+	}
+
+	return profileKeyPath(name, key)
+}
+
+// readStoredProfiles decodes the profile keys straight from drconfig.yaml.
+// Viper cannot be used here: ApplyActiveProfile has already replaced the
+// top-level values with the active profile's.
+func readStoredProfiles() (*storedProfiles, error) {
+	configFile, err := resolveConfigFilePath()
+	if err != nil {
+		return nil, err
+	}
+
+	node, err := readYAMLNode(configFile)
+	if err != nil {
+		return nil, err
+	}
+
+	stored := &storedProfiles{}
+
+	if err := node.Decode(stored); err != nil {
+		return nil, fmt.Errorf("failed to parse profiles: %w", err)
+	}
+
+	return stored, nil
+}
+
+// ProfileExists reports whether name is stored in drconfig.yaml. The default
+// profile always exists.
+func ProfileExists(name string) (bool, error) {
+	if name == DefaultProfile {
+		return true, nil
+	}
+
+	stored, err := readStoredProfiles()
+	if err != nil {
+		return false, err
+	}
+
+	_, ok := stored.Profiles[name]
+
+	return ok, nil
+}
+
+// ListProfiles returns the stored profiles sorted by name, with the default
+// profile first. The default profile is listed only when it holds credentials
+// or is the active one, so a config that only uses named profiles shows those.
+func ListProfiles() ([]Profile, error) {
+	stored, err := readStoredProfiles()
+	if err != nil {
+		return nil, err
+	}
+
+	active := ActiveProfile()
+	profiles := make([]Profile, 0, len(stored.Profiles)+1)
+
+	if stored.Endpoint != "" || stored.Token != "" || active == DefaultProfile {
+		profiles = append(profiles, newProfile(stored, DefaultProfile, stored.storedCredentials, active))
+	}
+
+	names := make([]string, 0, len(stored.Profiles))
+	for name := range stored.Profiles {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		profiles = append(profiles, newProfile(stored, name, stored.Profiles[name], active))
+	}
+
+	return profiles, nil
+}
+
+func newProfile(stored *storedProfiles, name string, creds storedCredentials, active string) Profile {
+	endpoint := creds.Endpoint
+	if host, err := SchemeHostOnly(endpoint); err == nil {
+		endpoint = host
+	}
+
+	return Profile{
+		Name:     name,
+		Endpoint: endpoint,
+		HasToken: creds.Token != "" || hasStoredToken(stored, name),
+		Active:   name == active,
+	}
+}
+
+// UseProfile stores name as the current profile. Selecting the default
+// profile removes the current-profile key instead of writing "default".
+func UseProfile(name string) error {
+	if err := ValidateProfileName(name); err != nil {
+		return err
+	}
+
+	exists, err := ProfileExists(name)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return fmt.Errorf("%w: %s", ErrProfileNotFound, name)
+	}
+
+	if name == DefaultProfile {
+		viper.Set(CurrentProfileKey, "")
+
+		return RemoveConfigFileKeys(CurrentProfileKey)
+	}
+
+	viper.Set(CurrentProfileKey, name)
+
+	return UpdateConfigFile(CurrentProfileKey)
+}
+
+// DeleteProfile removes a named profile from drconfig.yaml and its token from
+// wherever it is stored. If it was the current profile, the default profile
+// becomes current again. The default profile cannot be deleted; `dr auth
+// logout` clears its token.
+func DeleteProfile(name string) error {
+	if name == DefaultProfile {
+		return errors.New("the default profile cannot be deleted; use 'dr auth logout' to clear its token")
+	}
+
+	if err := ValidateProfileName(name); err != nil {
+		return err
+	}
+
+	stored, err := readStoredProfiles()
+	if err != nil {
+		return err
+	}
+
+	if _, ok := stored.Profiles[name]; !ok {
+		return fmt.Errorf("%w: %s", ErrProfileNotFound, name)
+	}
+
+	keys := []string{ProfilesKey + "." + name}
+
+	if len(stored.Profiles) == 1 {
+		keys = []string{ProfilesKey}
+	}
+
+	if stored.Current == name {
+		viper.Set(CurrentProfileKey, "")
+
+		keys = append(keys, CurrentProfileKey)
+	}
+
+	if err := RemoveConfigFileKeys(keys...); err != nil {
+		return err
+	}
+
+	return deleteStoredToken(stored, name)
+}
@@ -0,0 +1,255 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/datarobot/cli/internal/testutil"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+const profilesConfig = `endpoint: https://app.datarobot.com/api/v2
+token: default-token
+# staging and prod are named profiles
+profiles:
+  staging:
+    endpoint: https://staging.datarobot.com/api/v2
+    token: staging-token
+  prod:
+    endpoint: https://prod.datarobot.com/api/v2
+    token: prod-token
+`
+
+// setupProfiles writes contents to a fresh drconfig.yaml, reads it, and
+// returns its path.
+func setupProfiles(t *testing.T, contents string) string {
+	t.Helper()
+
+	testutil.SetTestHomeDir(t, t.TempDir())
+
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	require.NoError(t, CreateConfigFileDirIfNotExists())
+
+	dir, err := GetConfigDir()
+	require.NoError(t, err)
+
+	configFile := filepath.Join(dir, configFileName)
+	require.NoError(t, os.WriteFile(configFile, []byte(contents), configFileMode))
+	require.NoError(t, ReadConfigFile(""))
+
+	return configFile
+}
+
+func readRaw(t *testing.T, configFile string) map[string]any {
+	t.Helper()
+
+	data, err := os.ReadFile(configFile)
+	require.NoError(t, err)
+
+	var raw map[string]any
+
+	require.NoError(t, yaml.Unmarshal(data, &raw))
+
+	return raw
+}
+
+func TestActiveProfile_Precedence(t *testing.T) {
+	setupProfiles(t, profilesConfig+"current-profile: staging\n")
+
+	assert.Equal(t, "staging", ActiveProfile())
+
+	viper.Set(ProfileKey, "prod")
+
+	assert.Equal(t, "prod", ActiveProfile())
+}
+
+func TestActiveProfile_DefaultsToDefault(t *testing.T) {
+	setupProfiles(t, profilesConfig)
+
+	assert.Equal(t, DefaultProfile, ActiveProfile())
+}
+
+func TestApplyActiveProfile_OverlaysEndpointAndToken(t *testing.T) {
+	setupProfiles(t, profilesConfig)
+	viper.Set(ProfileKey, "prod")
+
+	require.NoError(t, ApplyActiveProfile(false))
+
+	assert.Equal(t, "https://prod.datarobot.com", GetBaseURL())
+	assert.Equal(t, "prod-token", viper.GetString(DataRobotAPIKey))
+}
+
+// A profile that does not exist yet (dr auth login --profile new) must not
+// inherit the default profile's credentials.
+func TestApplyActiveProfile_NewProfileStartsEmpty(t *testing.T) {
+	setupProfiles(t, profilesConfig)
+	viper.Set(ProfileKey, "new")
+
+	require.NoError(t, ApplyActiveProfile(true))
+
+	assert.Empty(t, GetBaseURL())
+	assert.Empty(t, viper.GetString(DataRobotAPIKey))
+}
+
+func TestApplyActiveProfile_RejectsUnknownProfile(t *testing.T) {
+	setupProfiles(t, profilesConfig)
+	viper.Set(ProfileKey, "stagign")
+
+	err := ApplyActiveProfile(false)
+	require.ErrorIs(t, err, ErrProfileNotFound)
+	assert.Contains(t, err.Error(), "stagign")
+	assert.Contains(t, err.Error(), "known profiles: default, prod, staging")
+
+	assert.Equal(t, "default-token", viper.GetString(DataRobotAPIKey), "no credentials were merged")
+}
+
+// A stale current-profile is not checked: it would lock the user out of
+// 'dr auth profile use', which is how they fix it.
+func TestApplyActiveProfile_UnknownCurrentProfileStartsEmpty(t *testing.T) {
+	setupProfiles(t, profilesConfig+"current-profile: gone\n")
+
+	require.NoError(t, ApplyActiveProfile(false))
+	assert.Empty(t, viper.GetString(DataRobotAPIKey))
+}
+
+func TestApplyActiveProfile_EnvironmentStillWins(t *testing.T) {
+	setupProfiles(t, profilesConfig)
+	t.Setenv("DATAROBOT_CLI_TOKEN", "env-token")
+
+	viper.AutomaticEnv()
+	viper.SetEnvPrefix("DATAROBOT_CLI")
+	viper.Set(ProfileKey, "prod")
+
+	require.NoError(t, ApplyActiveProfile(false))
+
+	assert.Equal(t, "env-token", viper.GetString(DataRobotAPIKey))
+	assert.Equal(t, "https://prod.datarobot.com", GetBaseURL())
+}
+
+func TestApplyActiveProfile_RejectsInvalidName(t *testing.T) {
+	setupProfiles(t, profilesConfig)
+
+	for _, name := range []string{"Prod", "eu.west", "-x", "a b"} {
+		viper.Set(ProfileKey, name)
+
+		assert.Error(t, ApplyActiveProfile(false), name)
+	}
+}
+
+func TestUpdateConfigFile_WritesToActiveProfile(t *testing.T) {
+	configFile := setupProfiles(t, profilesConfig)
+	viper.Set(ProfileKey, "staging")
+
+	require.NoError(t, ApplyActiveProfile(false))
+
+	viper.Set(DataRobotAPIKey, "fresh-token")
+
+	require.NoError(t, UpdateConfigFile())
+
+	raw := readRaw(t, configFile)
+	profiles := raw[ProfilesKey].(map[string]any)
+
+	assert.Equal(t, "fresh-token", profiles["staging"].(map[string]any)["token"])
+	assert.Equal(t, "prod-token", profiles["prod"].(map[string]any)["token"])
+	assert.Equal(t, "default-token", raw["token"], "the default profile must be untouched")
+	assert.NotContains(t, raw, ProfileKey, "--profile must never be persisted")
+
+	data, err := os.ReadFile(configFile)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "# staging and prod are named profiles")
+}
+
+func TestListProfiles(t *testing.T) {
+	setupProfiles(t, profilesConfig+"current-profile: prod\n")
+
+	profiles, err := ListProfiles()
+	require.NoError(t, err)
+
+	assert.Equal(t, []Profile{
+		{Name: DefaultProfile, Endpoint: "https://app.datarobot.com", HasToken: true},
+		{Name: "prod", Endpoint: "https://prod.datarobot.com", HasToken: true, Active: true},
+		{Name: "staging", Endpoint: "https://staging.datarobot.com", HasToken: true},
+	}, profiles)
+}
+
+func TestListProfiles_HidesEmptyInactiveDefault(t *testing.T) {
+	setupProfiles(t, "current-profile: eu\nprofiles:\n  eu:\n    endpoint: https://app.eu.datarobot.com/api/v2\n")
+
+	profiles, err := ListProfiles()
+	require.NoError(t, err)
+
+	assert.Equal(t, []Profile{{Name: "eu", Endpoint: "https://app.eu.datarobot.com", Active: true}}, profiles)
+}
+
+func TestUseProfile(t *testing.T) {
+	configFile := setupProfiles(t, profilesConfig)
+
+	require.NoError(t, UseProfile("prod"))
+	assert.Equal(t, "prod", readRaw(t, configFile)[CurrentProfileKey])
+	assert.Equal(t, "prod", ActiveProfile())
+
+	require.NoError(t, UseProfile(DefaultProfile))
+	assert.NotContains(t, readRaw(t, configFile), CurrentProfileKey)
+	assert.Equal(t, DefaultProfile, ActiveProfile())
+}
+
+func TestUseProfile_UnknownProfile(t *testing.T) {
+	configFile := setupProfiles(t, profilesConfig)
+
+	require.ErrorIs(t, UseProfile("qa"), ErrProfileNotFound)
+	assert.NotContains(t, readRaw(t, configFile), CurrentProfileKey)
+}
+
+func TestDeleteProfile(t *testing.T) {
+	configFile := setupProfiles(t, profilesConfig+"current-profile: staging\n")
+
+	require.NoError(t, DeleteProfile("staging"))
+
+	raw := readRaw(t, configFile)
+
+	assert.NotContains(t, raw[ProfilesKey], "staging")
+	assert.Contains(t, raw[ProfilesKey], "prod")
+	assert.NotContains(t, raw, CurrentProfileKey, "deleting the current profile resets it")
+
+	require.NoError(t, DeleteProfile("prod"))
+	assert.NotContains(t, readRaw(t, configFile), ProfilesKey)
+}
+
+func TestDeleteProfile_Errors(t *testing.T) {
+	setupProfiles(t, profilesConfig)
+
+	require.ErrorIs(t, DeleteProfile("qa"), ErrProfileNotFound)
+	require.Error(t, DeleteProfile(DefaultProfile))
+}
+
+func TestDebugViperConfig_RedactsProfileTokens(t *testing.T) {
+	setupProfiles(t, profilesConfig)
+
+	output, err := DebugViperConfig()
+	require.NoError(t, err)
+
+	assert.Contains(t, output, "profiles.prod.endpoint: https://prod.datarobot.com/api/v2")
+	assert.Contains(t, output, "profiles.prod.token: ****")
+	assert.NotContains(t, output, "prod-token")
+	assert.NotContains(t, output, "staging-token")
+}
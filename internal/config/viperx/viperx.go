@@ -54,6 +54,7 @@ type ConfigFileNotFoundError = viper.ConfigFileNotFoundError
 var (
 	Get            = viper.Get
 	GetString      = viper.GetString
+	GetStringSlice = viper.GetStringSlice
 	GetBool        = viper.GetBool
 	GetInt         = viper.GetInt
 	GetDuration    = viper.GetDuration
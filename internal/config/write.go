@@ -42,6 +42,7 @@ var PersistableKeys = map[string]struct{}{
 	"pulumi_config_passphrase": {},
 	"ca-cert":                  {},
 	DefaultLLMID:               {},
+	CurrentProfileKey:          {},
 }
 
 // UpdateConfigFile writes only the allowlisted keys from viper back to the
@@ -72,6 +73,43 @@ func UpdateConfigFile(keys ...string) error {
 
 	applyAllowedKeysToNode(rootNode, keys)
 
+	// The token was written like any other key above; move it to the
+	// credential store unless plaintext is in use.
+	if err := storeToken(rootNode, keys); err != nil {
+		return err
+	}
+
+	return writeYAMLNode(configFile, rootNode)
+}
+
+// RemoveConfigFileKeys deletes the given dotted-path keys from drconfig.yaml,
+// preserving every other field and comment. Unlike UpdateConfigFile it is not
+// restricted to PersistableKeys: it only ever removes, so it cannot leak a
+// transient flag into the file. Keys absent from the file are ignored.
+func RemoveConfigFileKeys(keys ...string) error {
+	if err := CreateConfigFileDirIfNotExists(); err != nil {
+		return err
+	}
+
+	configFile, err := resolveConfigFilePath()
+	if err != nil {
+		return err
+	}
+
+	rootNode, err := readYAMLNode(configFile)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		deleteNestedKeyInNode(rootNode, key)
+	}
+
+	return writeYAMLNode(configFile, rootNode)
+}
+
+// writeYAMLNode writes rootNode to configFile with owner-only permissions.
+func writeYAMLNode(configFile string, rootNode *yaml.Node) error {
 	docNode := &yaml.Node{
 		Kind:    yaml.DocumentNode,
 		Content: []*yaml.Node{rootNode},
@@ -170,7 +208,7 @@ func applyAllowedKeysToNode(node *yaml.Node, keys []string) {
 			continue
 		}
 
-		setNestedKeyInNode(node, key, viper.Get(key))
+		setNestedKeyInNode(node, storedKeyPath(key), viper.Get(key))
 	}
 }
 
@@ -207,6 +245,40 @@ func setNestedKeyInNode(node *yaml.Node, key string, value interface{}) {
 	}
 }
 
+// deleteNestedKeyInNode removes a dotted-path key from a yaml.Node. Missing
+// intermediate keys make it a no-op.
+func deleteNestedKeyInNode(node *yaml.Node, key string) {
+	parts := strings.Split(key, ".")
+
+	for i, part := range parts {
+		if node.Kind != yaml.MappingNode {
+			return
+		}
+
+		idx := -1
+
+		for j := 0; j+1 < len(node.Content); j += 2 {
+			if node.Content[j].Value == part {
+				idx = j
+
+				break
+			}
+		}
+
+		if idx < 0 {
+			return
+		}
+
+		if i == len(parts)-1 {
+			node.Content = append(node.Content[:idx], node.Content[idx+2:]...)
+
+			return
+		}
+
+		node = node.Content[idx+1]
+	}
+}
+
 // findOrCreateKeyInNode finds or creates a key in a mapping node, returning
 // both the key and value nodes. If the key exists, its existing value node
 // is returned (preserving any comments on that node). If the key doesn't exist,
@@ -0,0 +1,369 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package copier
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/datarobot/cli/internal/config"
+	"github.com/datarobot/cli/internal/config/viperx"
+	"github.com/datarobot/cli/internal/log"
+)
+
+const (
+	// DefaultIndexURL is the published component index, read when
+	// config.ComponentIndexKey is unset.
+	DefaultIndexURL = "https://cli.datarobot.com/components/index.json"
+
+	indexFetchTimeout = 10 * time.Second
+	indexCacheTTL     = 24 * time.Hour
+	indexCacheFile    = "component-index.json"
+)
+
+// Index is the component index document, in the same spirit as the plugin
+// registry: the published catalog, or a file a team keeps for its own
+// components. An entry without a readme falls back to the built-in one for
+// the same repository.
+type Index struct {
+	Version    string    `json:"version"`
+	Components []Details `json:"components"`
+}
+
+// indexCache is the last remote index fetched, kept in the config directory
+// so a catalog is available offline and is fetched at most once a day.
+type indexCache struct {
+	URL       string    `json:"url"`
+	FetchedAt time.Time `json:"fetchedAt"`
+	Index     Index     `json:"index"`
+}
+
+// Catalog is the set of components the CLI knows about: what `dr component
+// add` offers, and how installed components are named and described.
+type Catalog struct {
+	Components []Details
+}
+
+// ByShortName returns the component with the given short name, such as "agent".
+func (c Catalog) ByShortName(name string) (Details, bool) {
+	for _, details := range c.Components {
+		if details.ShortName == name {
+			return details, true
+		}
+	}
+
+	return Details{}, false
+}
+
+// ByURL returns the component generated from repoURL, the _src_path an
+// answers file records.
+func (c Catalog) ByURL(repoURL string) (Details, bool) {
+	for _, details := range c.Components {
+		if details.RepoURL == repoURL {
+			return details, true
+		}
+	}
+
+	return Details{}, false
+}
+
+// Enabled returns the components offered for adding.
+func (c Catalog) Enabled() []Details {
+	enabled := make([]Details, 0, len(c.Components))
+
+	for _, details := range c.Components {
+		if details.Enabled {
+			enabled = append(enabled, details)
+		}
+	}
+
+	return enabled
+}
+
+// EnabledShortNames returns the short names of the components offered for adding.
+func (c Catalog) EnabledShortNames() []string {
+	enabled := c.Enabled()
+	names := make([]string, len(enabled))
+
+	for i, details := range enabled {
+		names[i] = details.ShortName
+	}
+
+	return names
+}
+
+var (
+	catalogOnce sync.Once
+	catalog     Catalog
+)
+
+// Components returns the component catalog, loading it on first use from the
+// index config.ComponentIndexKey names. It is loaded lazily so commands that
+// never look at components never touch the network.
+func Components() Catalog {
+	catalogOnce.Do(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), indexFetchTimeout)
+		defer cancel()
+
+		catalog = LoadCatalog(ctx, viperx.GetString(config.ComponentIndexKey))
+	})
+
+	return catalog
+}
+
+// SetCatalog replaces the catalog Components returns. Tests use it to avoid
+// loading an index.
+func SetCatalog(c Catalog) {
+	catalogOnce.Do(func() {})
+
+	catalog = c
+}
+
+// CachedComponents is Components for shell completion, which runs on every
+// TAB and must not wait on the network. It reads a local index, or the cached
+// copy of a remote one however old, and otherwise uses the catalog built into
+// this release.
+func CachedComponents() Catalog {
+	location := indexLocation(viperx.GetString(config.ComponentIndexKey))
+
+	if !isRemoteIndex(location) {
+		return loadIndexFile(location, log.Debugf)
+	}
+
+	cached, err := readIndexCache(location)
+	if err != nil {
+		return Catalog{Components: embeddedComponents()}
+	}
+
+	return newCatalog(cached.Index)
+}
+
+// LoadCatalog reads the component index at location: an http(s) URL, a
+// file:// URL, or a local path. Empty means DefaultIndexURL. A remote index is
+// cached for a day; when it cannot be fetched the stale cache is used, and
+// failing that the catalog built into this release. Either fallback is cached
+// for a day too, so an unreachable index costs one timeout a day rather than
+// one per command. It never fails.
+func LoadCatalog(ctx context.Context, location string) Catalog {
+	location = indexLocation(location)
+
+	// Only a location the user chose is worth a warning. The published index
+	// being unreachable just means the built-in list is used.
+	warnf := log.Warnf
+	if location == DefaultIndexURL {
+		warnf = log.Debugf
+	}
+
+	if !isRemoteIndex(location) {
+		return loadIndexFile(location, warnf)
+	}
+
+	cached, cacheErr := readIndexCache(location)
+	if cacheErr == nil && time.Since(cached.FetchedAt) < indexCacheTTL {
+		return newCatalog(cached.Index)
+	}
+
+	index, err := FetchIndex(ctx, location)
+	if err != nil {
+		if cacheErr == nil {
+			log.Debugf("Could not refresh component index %s, using the cached copy: %v", location, err)
+
+			index = &cached.Index
+		} else {
+			warnf("Could not fetch component index %s, using the built-in list: %v", location, err)
+
+			index = embeddedIndex()
+		}
+	}
+
+	writeIndexCache(indexCache{URL: location, FetchedAt: time.Now(), Index: *index})
+
+	return newCatalog(*index)
+}
+
+func indexLocation(location string) string {
+	if location == "" {
+		return DefaultIndexURL
+	}
+
+	return location
+}
+
+// loadIndexFile reads a local index, falling back to the built-in list.
+func loadIndexFile(location string, warnf func(string, ...interface{})) Catalog {
+	index, err := readIndexFile(strings.TrimPrefix(location, "file://"))
+	if err != nil {
+		warnf("Could not read component index %s, using the built-in list: %v", location, err)
+
+		return Catalog{Components: embeddedComponents()}
+	}
+
+	return newCatalog(index)
+}
+
+// FetchIndex downloads and parses the component index at indexURL.
+func FetchIndex(ctx context.Context, indexURL string) (*Index, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, indexURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to create request: %w", err)
+	}
+
+	req.Header.Set("User-Agent", config.GetUserAgentHeader())
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to fetch component index: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("Failed to fetch component index: HTTP %d", resp.StatusCode)
+	}
+
+	var index Index
+
+	if err := json.NewDecoder(resp.Body).Decode(&index); err != nil {
+		return nil, fmt.Errorf("Failed to parse component index: %w", err)
+	}
+
+	if err := index.validate(); err != nil {
+		return nil, err
+	}
+
+	return &index, nil
+}
+
+func readIndexFile(path string) (Index, error) {
+	var index Index
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return index, err
+	}
+
+	if err := json.Unmarshal(data, &index); err != nil {
+		return index, fmt.Errorf("Failed to parse component index: %w", err)
+	}
+
+	return index, index.validate()
+}
+
+func (index Index) validate() error {
+	if len(index.Components) == 0 {
+		return errors.New("Component index lists no components.")
+	}
+
+	for i, details := range index.Components {
+		if details.ShortName == "" || details.RepoURL == "" {
+			return fmt.Errorf("Component index entry %d needs a shortName and a repoUrl.", i+1)
+		}
+	}
+
+	return nil
+}
+
+// newCatalog builds a catalog from index, taking READMEs the index leaves
+// out from the built-in list.
+func newCatalog(index Index) Catalog {
+	builtin := Catalog{Components: embeddedComponents()}
+	components := make([]Details, len(index.Components))
+
+	for i, details := range index.Components {
+		if details.Name == "" {
+			details.Name = details.ShortName
+		}
+
+		if details.ReadMeContents == "" {
+			if known, ok := builtin.ByURL(details.RepoURL); ok {
+				details.ReadMeContents = known.ReadMeContents
+			}
+		}
+
+		components[i] = details
+	}
+
+	return Catalog{Components: components}
+}
+
+func isRemoteIndex(location string) bool {
+	return strings.HasPrefix(location, "https://") || strings.HasPrefix(location, "http://")
+}
+
+func indexCachePath() (string, error) {
+	dir, err := config.GetConfigDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, indexCacheFile), nil
+}
+
+func readIndexCache(indexURL string) (indexCache, error) {
+	var cached indexCache
+
+	path, err := indexCachePath()
+	if err != nil {
+		return cached, err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return cached, err
+	}
+
+	if err := json.Unmarshal(data, &cached); err != nil {
+		return cached, err
+	}
+
+	if cached.URL != indexURL {
+		return cached, errors.New("cached index is for another URL")
+	}
+
+	return cached, cached.Index.validate()
+}
+
+// writeIndexCache saves cached, ignoring failures: the cache only saves a
+// fetch next time.
+func writeIndexCache(cached indexCache) {
+	path, err := indexCachePath()
+	if err != nil {
+		log.Debugf("Failed to get config directory for the component index cache: %v", err)
+		return
+	}
+
+	data, err := json.Marshal(cached)
+	if err != nil {
+		log.Debugf("Failed to marshal the component index cache: %v", err)
+		return
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		log.Debugf("Failed to create config directory for the component index cache: %v", err)
+		return
+	}
+
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		log.Debugf("Failed to write the component index cache: %v", err)
+	}
+}
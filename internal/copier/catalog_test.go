@@ -0,0 +1,198 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package copier
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/datarobot/cli/internal/config"
+	"github.com/datarobot/cli/internal/config/viperx"
+	"github.com/datarobot/cli/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testIndex = `{
+  "version": "1",
+  "components": [
+    {"name": "Agent", "shortName": "agent", "repoUrl": "https://github.com/datarobot-community/af-component-agent.git", "enabled": true},
+    {"name": "Search", "shortName": "search", "repoUrl": "https://github.com/example/af-component-search.git", "enabled": true, "readme": "# Search"},
+    {"shortName": "hidden", "repoUrl": "https://github.com/example/af-component-hidden.git"}
+  ]
+}`
+
+func serveIndex(t *testing.T, body *string, status *int) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(*status)
+		_, _ = w.Write([]byte(*body))
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestLoadCatalog_Remote(t *testing.T) {
+	testutil.SetXDGEnv(t, "XDG_CONFIG_HOME", t.TempDir())
+
+	body, status := testIndex, http.StatusOK
+	server := serveIndex(t, &body, &status)
+
+	catalog := LoadCatalog(context.Background(), server.URL)
+
+	require.Len(t, catalog.Components, 3)
+	assert.Equal(t, []string{"agent", "search"}, catalog.EnabledShortNames())
+
+	agent, ok := catalog.ByShortName("agent")
+	require.True(t, ok)
+	assert.NotEmpty(t, agent.ReadMeContents, "readme falls back to the built-in one")
+
+	search, ok := catalog.ByURL("https://github.com/example/af-component-search.git")
+	require.True(t, ok)
+	assert.Equal(t, "# Search", search.ReadMeContents)
+
+	hidden, _ := catalog.ByShortName("hidden")
+	assert.Equal(t, "hidden", hidden.Name, "name defaults to the short name")
+}
+
+func TestLoadCatalog_UsesCache(t *testing.T) {
+	testutil.SetXDGEnv(t, "XDG_CONFIG_HOME", t.TempDir())
+
+	body, status := testIndex, http.StatusOK
+	server := serveIndex(t, &body, &status)
+
+	LoadCatalog(context.Background(), server.URL)
+
+	// A fresh cache is used without fetching.
+	body = `{"components": []}`
+
+	assert.Len(t, LoadCatalog(context.Background(), server.URL).Components, 3)
+
+	// A stale cache is still better than the built-in list when the fetch fails.
+	cached, err := readIndexCache(server.URL)
+	require.NoError(t, err)
+
+	cached.FetchedAt = time.Now().Add(-2 * indexCacheTTL)
+	writeIndexCache(cached)
+
+	status = http.StatusBadGateway
+
+	assert.Len(t, LoadCatalog(context.Background(), server.URL).Components, 3)
+
+	// The failure is cached with the stale copy, so it is not retried at once.
+	body, status = `{"components": [{"shortName": "new", "repoUrl": "https://example.com/new.git"}]}`, http.StatusOK
+
+	assert.Len(t, LoadCatalog(context.Background(), server.URL).Components, 3)
+}
+
+// assertBuiltIn checks catalog lists the built-in components, READMEs
+// included, compared as the index format.
+func assertBuiltIn(t *testing.T, catalog Catalog, msgAndArgs ...interface{}) {
+	t.Helper()
+
+	want, err := json.Marshal(embeddedComponents())
+	require.NoError(t, err)
+
+	got, err := json.Marshal(catalog.Components)
+	require.NoError(t, err)
+
+	assert.JSONEq(t, string(want), string(got), msgAndArgs...)
+}
+
+func TestLoadCatalog_FallsBackToEmbedded(t *testing.T) {
+	testutil.SetXDGEnv(t, "XDG_CONFIG_HOME", t.TempDir())
+
+	body, status := "not json", http.StatusOK
+	server := serveIndex(t, &body, &status)
+
+	for _, location := range []string{
+		server.URL,
+		filepath.Join(t.TempDir(), "missing.json"),
+	} {
+		catalog := LoadCatalog(context.Background(), location)
+
+		assertBuiltIn(t, catalog, location)
+	}
+
+	// The built-in list is cached for the TTL, so the next command does not
+	// wait on the index again, even once it would parse.
+	body = testIndex
+
+	assertBuiltIn(t, LoadCatalog(context.Background(), server.URL))
+}
+
+func TestCachedComponents_NeverFetches(t *testing.T) {
+	testutil.SetXDGEnv(t, "XDG_CONFIG_HOME", t.TempDir())
+
+	fetched := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		fetched++
+
+		_, _ = w.Write([]byte(testIndex))
+	}))
+	t.Cleanup(server.Close)
+
+	viperx.Set(config.ComponentIndexKey, server.URL)
+	t.Cleanup(func() { viperx.Set(config.ComponentIndexKey, "") })
+
+	assertBuiltIn(t, CachedComponents(), "no cache means the built-in list")
+
+	LoadCatalog(context.Background(), server.URL)
+
+	cached, err := readIndexCache(server.URL)
+	require.NoError(t, err)
+
+	cached.FetchedAt = time.Now().Add(-2 * indexCacheTTL)
+	writeIndexCache(cached)
+
+	assert.Equal(t, []string{"agent", "search"}, CachedComponents().EnabledShortNames(), "a stale cache is still used")
+	assert.Equal(t, 1, fetched, "only LoadCatalog fetched")
+}
+
+// TestPublishedIndex keeps docs/components/index.json, the index DefaultIndexURL
+// serves, in step with the catalog built into the release.
+func TestPublishedIndex(t *testing.T) {
+	published, err := os.ReadFile(filepath.Join("..", "..", "docs", "components", "index.json"))
+	require.NoError(t, err)
+
+	embedded, err := json.Marshal(embeddedIndex())
+	require.NoError(t, err)
+
+	assert.JSONEq(t, string(embedded), string(published))
+}
+
+func TestLoadCatalog_LocalFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "index.json")
+	require.NoError(t, os.WriteFile(path, []byte(testIndex), 0o600))
+
+	for _, location := range []string{path, "file://" + path} {
+		catalog := LoadCatalog(context.Background(), location)
+
+		assert.Equal(t, []string{"agent", "search"}, catalog.EnabledShortNames(), location)
+	}
+}
+
+func TestIndexValidate(t *testing.T) {
+	assert.ErrorContains(t, Index{}.validate(), "lists no components")
+	assert.ErrorContains(t, Index{Components: []Details{{ShortName: "x"}}}.validate(), "entry 1 needs a shortName and a repoUrl")
+}
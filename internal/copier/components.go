@@ -16,51 +16,47 @@ package copier
 
 import (
 	"embed"
+	"slices"
 )
 
-// TODO: I don't know what we should add here.
+// Details describes a component in the catalog. The JSON tags are the
+// component index format (see Index).
 type Details struct {
 	readMeFile     string
-	ReadMeContents string
+	ReadMeContents string `json:"readme,omitempty"`
 
-	Name      string
-	ShortName string
-	RepoURL   string
-	Enabled   bool
+	Name      string `json:"name"`
+	ShortName string `json:"shortName"`
+	RepoURL   string `json:"repoUrl"`
+	Enabled   bool   `json:"enabled,omitempty"`
 }
 
 //go:embed readme/*.md
 var readmeFS embed.FS
 
-func init() {
-	for i, details := range ComponentDetails {
+// embeddedComponents returns the catalog built into this release, with its
+// READMEs loaded. It is the fallback when no index can be read.
+func embeddedComponents() []Details {
+	components := slices.Clone(componentDetails)
+
+	for i, details := range components {
 		contents, err := readmeFS.ReadFile("readme/" + details.readMeFile)
 		if err == nil {
-			ComponentDetails[i].ReadMeContents = string(contents)
+			components[i].ReadMeContents = string(contents)
 		}
 	}
 
-	for _, details := range ComponentDetails {
-		ComponentDetailsByURL[details.RepoURL] = details
-		ComponentDetailsByShortName[details.ShortName] = details
-
-		if details.Enabled {
-			EnabledComponents = append(EnabledComponents, details)
-			EnabledShortNames = append(EnabledShortNames, details.ShortName)
-		}
-	}
+	return components
 }
 
-// Map the repo listed in an "answer file" to relevant info for component
-// To Note: Not all of the README contents have been added.
-var (
-	ComponentDetailsByURL       = map[string]Details{}
-	ComponentDetailsByShortName = map[string]Details{}
-	EnabledComponents           = make([]Details, 0, len(ComponentDetails))
-	EnabledShortNames           = make([]string, 0, len(ComponentDetails))
-)
+// embeddedIndex returns the catalog built into this release as an index,
+// without READMEs: newCatalog takes them from the built-in list. It is what
+// docs/components/index.json publishes.
+func embeddedIndex() *Index {
+	return &Index{Version: "1", Components: slices.Clone(componentDetails)}
+}
 
-var ComponentDetails = []Details{
+var componentDetails = []Details{
 	{
 		readMeFile: "af-component-agent.md",
 
@@ -133,6 +133,51 @@ func ExecUpdate(yamlFile string, data map[string]interface{}, flags UpdateFlags)
 	return cmdRun(Update(yamlFile, data, flags))
 }
 
+// Render creates a copier copy command that generates the component an
+// answers file records into dir: the same template commit and answers, with
+// no prompts and no tasks. `dr component remove` compares the result with the
+// repository to tell which files the component generated.
+func Render(answers Answers, dir string) *exec.Cmd {
+	commandParts := []string{
+		"copier", "copy", "--trust", "--defaults", "--overwrite", "--quiet", "--skip-tasks",
+	}
+
+	if answers.Commit != "" {
+		commandParts = append(commandParts, "--vcs-ref", answers.Commit)
+	}
+
+	for key, value := range answers.Data {
+		commandParts = append(commandParts, "--data", key+"="+formatDataValue(value))
+	}
+
+	commandParts = append(commandParts, answers.Repo, dir)
+
+	cmd := exec.Command("uvx", commandParts...)
+	log.Debug("Running command: " + cmd.String())
+
+	// Suppress all Python warnings unless debug mode is enabled
+	if log.GetLevel() >= log.WarnLevel {
+		cmd.Env = append(os.Environ(), "PYTHONWARNINGS=ignore")
+	}
+
+	return cmd
+}
+
+// ExecRender executes a Render command. Its output is only shown when it
+// fails, as part of the error.
+func ExecRender(answers Answers, dir string) error {
+	if answers.Repo == "" {
+		return errors.New("Repository URL is missing.")
+	}
+
+	output, err := Render(answers, dir).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("%w: %s", err, strings.TrimSpace(string(output)))
+	}
+
+	return nil
+}
+
 // formatDataValue converts a value to a string suitable for --data arguments
 // This follows copier's type handling: str, int, float, bool, json, yaml.
 func formatDataValue(value interface{}) string {
@@ -17,6 +17,7 @@ package copier
 import (
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/datarobot/cli/internal/log"
 	"gopkg.in/yaml.v3"
@@ -27,7 +28,12 @@ type Answers struct {
 	ComponentDetails Details
 	// TODO: Add more properties to account for what we need to determine as canonical values expected for components
 
-	Repo string `yaml:"_src_path"`
+	Repo   string `yaml:"_src_path"`
+	Commit string `yaml:"_commit"`
+
+	// Data holds the answers to the component's questions, without the
+	// underscore-prefixed keys copier records about itself.
+	Data map[string]interface{} `yaml:"-"`
 }
 
 func AnswersFromPath(path string, all bool) ([]Answers, error) {
@@ -54,7 +60,18 @@ func AnswersFromPath(path string, all bool) ([]Answers, error) {
 			continue
 		}
 
-		componentDetails := ComponentDetailsByURL[fileParsed.Repo]
+		if err = yaml.Unmarshal(data, &fileParsed.Data); err != nil {
+			log.Errorf("Failed to unmarshal yaml file %s: %s", yamlFile, err)
+			continue
+		}
+
+		for key := range fileParsed.Data {
+			if strings.HasPrefix(key, "_") {
+				delete(fileParsed.Data, key)
+			}
+		}
+
+		componentDetails, _ := Components().ByURL(fileParsed.Repo)
 
 		if all || componentDetails.Enabled {
 			fileParsed.ComponentDetails = componentDetails
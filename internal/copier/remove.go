@@ -0,0 +1,151 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package copier
+
+import (
+	"bytes"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+)
+
+// Removal is what removing a component deletes and what it keeps, as
+// PlanRemoval finds it. Paths are relative to the repository root.
+type Removal struct {
+	// AnswersFile is the component's answers file. It is always deleted.
+	AnswersFile string
+	// Unchanged are the generated files still as the component wrote them.
+	Unchanged []string
+	// Modified are the generated files edited since. They are kept unless
+	// removal is forced.
+	Modified []string
+	// Shared are the generated files another installed component generates
+	// too. They are always kept.
+	Shared []string
+}
+
+// GeneratedFiles returns the files under dir, a directory Render wrote, as
+// paths relative to it.
+func GeneratedFiles(dir string) (map[string]bool, error) {
+	files := map[string]bool{}
+
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+
+		files[rel] = true
+
+		return nil
+	})
+
+	return files, err
+}
+
+// PlanRemoval compares rendered, a fresh Render of the component recorded in
+// answersFile, with the repository at root. Generated files the repository no
+// longer has are left out; shared holds the files other installed components
+// generate (see GeneratedFiles).
+func PlanRemoval(root, answersFile, rendered string, shared map[string]bool) (Removal, error) {
+	removal := Removal{AnswersFile: filepath.Clean(answersFile)}
+
+	files, err := GeneratedFiles(rendered)
+	if err != nil {
+		return removal, err
+	}
+
+	for rel := range files {
+		// The render writes its own answers file; the repository's is
+		// AnswersFile, and differs at least in its commit.
+		if rel == removal.AnswersFile {
+			continue
+		}
+
+		current, err := os.ReadFile(filepath.Join(root, rel))
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+
+		if err != nil {
+			return removal, err
+		}
+
+		generated, err := os.ReadFile(filepath.Join(rendered, rel))
+		if err != nil {
+			return removal, err
+		}
+
+		switch {
+		case shared[rel]:
+			removal.Shared = append(removal.Shared, rel)
+		case bytes.Equal(current, generated):
+			removal.Unchanged = append(removal.Unchanged, rel)
+		default:
+			removal.Modified = append(removal.Modified, rel)
+		}
+	}
+
+	slices.Sort(removal.Unchanged)
+	slices.Sort(removal.Modified)
+	slices.Sort(removal.Shared)
+
+	return removal, nil
+}
+
+// Apply deletes the answers file and the unchanged files, and the modified
+// ones too when force is set, then any directory that leaves empty. It
+// returns the files it deleted.
+func (r Removal) Apply(root string, force bool) ([]string, error) {
+	files := slices.Clone(r.Unchanged)
+	if force {
+		files = append(files, r.Modified...)
+	}
+
+	removed := make([]string, 0, len(files)+1)
+
+	for _, rel := range files {
+		if err := os.Remove(filepath.Join(root, rel)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return removed, err
+		}
+
+		removed = append(removed, rel)
+
+		pruneEmptyDirs(root, filepath.Dir(rel))
+	}
+
+	if err := os.Remove(filepath.Join(root, r.AnswersFile)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return removed, err
+	}
+
+	return append(removed, r.AnswersFile), nil
+}
+
+// pruneEmptyDirs removes dir and its parents below root while they are empty.
+func pruneEmptyDirs(root, dir string) {
+	for dir != "." && dir != string(filepath.Separator) {
+		if os.Remove(filepath.Join(root, dir)) != nil {
+			return
+		}
+
+		dir = filepath.Dir(dir)
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package copier
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeFiles(t *testing.T, root string, files map[string]string) {
+	t.Helper()
+
+	for rel, content := range files {
+		path := filepath.Join(root, rel)
+
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	}
+}
+
+func TestPlanRemoval(t *testing.T) {
+	root, rendered := t.TempDir(), t.TempDir()
+	answersFile := filepath.Join(".datarobot", "answers", "react.yml")
+
+	writeFiles(t, root, map[string]string{
+		answersFile:              "_commit: v2\n",
+		"web/index.html":         "<html>",
+		"web/src/app.tsx":        "edited",
+		"Taskfile.yml":           "shared",
+		"README.md":              "mine",
+		"other/untouched.txt":    "other",
+		"web/src/components/a.x": "a",
+	})
+	writeFiles(t, rendered, map[string]string{
+		answersFile:              "_commit: v1\n",
+		"web/index.html":         "<html>",
+		"web/src/app.tsx":        "original",
+		"Taskfile.yml":           "shared",
+		"web/deleted.txt":        "gone already",
+		"web/src/components/a.x": "a",
+	})
+
+	removal, err := PlanRemoval(root, "./"+answersFile, rendered, map[string]bool{"Taskfile.yml": true})
+	require.NoError(t, err)
+
+	assert.Equal(t, Removal{
+		AnswersFile: answersFile,
+		Unchanged:   []string{"web/index.html", filepath.Join("web", "src", "components", "a.x")},
+		Modified:    []string{filepath.Join("web", "src", "app.tsx")},
+		Shared:      []string{"Taskfile.yml"},
+	}, removal)
+
+	removed, err := removal.Apply(root, false)
+	require.NoError(t, err)
+	assert.Len(t, removed, 3)
+
+	assert.NoFileExists(t, filepath.Join(root, answersFile))
+	assert.NoDirExists(t, filepath.Join(root, "web", "src", "components"), "emptied directories are pruned")
+	assert.FileExists(t, filepath.Join(root, "web", "src", "app.tsx"))
+	assert.FileExists(t, filepath.Join(root, "Taskfile.yml"))
+	assert.FileExists(t, filepath.Join(root, "README.md"))
+
+	_, err = removal.Apply(root, true)
+	require.NoError(t, err)
+	assert.NoDirExists(t, filepath.Join(root, "web"))
+	assert.DirExists(t, filepath.Join(root, ".datarobot", "answers"))
+}
@@ -0,0 +1,28 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package credstore keeps secrets such as DataRobot API tokens out of
+// plaintext configuration files.
+//
+// A Store maps an account name to a secret. Three implementations exist:
+//
+//   - Keyring: the OS credential store (Secret Service on Linux, Keychain on
+//     macOS, Credential Manager on Windows).
+//   - File: a passphrase-encrypted file for headless machines without a
+//     keyring service, sealed with AES-256-GCM under an scrypt-derived key.
+//   - Memory: an in-process map for tests.
+//
+// The package knows nothing about drconfig.yaml or profiles; internal/config
+// decides which store to use and which account a token belongs to.
+package credstore
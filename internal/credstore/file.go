@@ -0,0 +1,225 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package credstore
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/datarobot/cli/internal/fsutil"
+	"golang.org/x/crypto/scrypt"
+)
+
+// ErrWrongPassphrase is returned when the encrypted file does not open with
+// the passphrase given, or has been tampered with. GCM cannot tell the two apart.
+var ErrWrongPassphrase = errors.New("credential file passphrase is incorrect or the file is corrupt")
+
+// ErrNoPassphrase is returned when File is used without a passphrase.
+var ErrNoPassphrase = errors.New("no passphrase set for the encrypted credential file")
+
+const (
+	fileFormatVersion = 1
+
+	// scrypt parameters recommended for interactive logins (2^15, 8, 1).
+	scryptN      = 1 << 15
+	scryptR      = 8
+	scryptP      = 1
+	scryptKeyLen = 32
+	saltLen      = 16
+
+	// fileMode keeps the file owner-only even though its contents are sealed:
+	// the ciphertext is still an offline guessing target for the passphrase.
+	fileMode os.FileMode = 0o600
+)
+
+// sealedFile is the on-disk envelope. The secrets themselves are a JSON map
+// of account to secret, sealed as one AES-256-GCM message.
+type sealedFile struct {
+	Version int    `json:"version"`
+	KDF     string `json:"kdf"`
+	Salt    []byte `json:"salt"`
+	Nonce   []byte `json:"nonce"`
+	Data    []byte `json:"data"`
+}
+
+// File stores secrets in a single passphrase-encrypted file. Each write
+// re-seals the whole file under a fresh salt and nonce.
+type File struct {
+	path       string
+	passphrase string
+
+	mu sync.Mutex
+}
+
+// NewFile returns a File store at path, sealed with passphrase.
+func NewFile(path, passphrase string) *File {
+	return &File{path: path, passphrase: passphrase}
+}
+
+func (f *File) Get(account string) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	secrets, err := f.read()
+	if err != nil {
+		return "", err
+	}
+
+	secret, ok := secrets[account]
+	if !ok {
+		return "", ErrNotFound
+	}
+
+	return secret, nil
+}
+
+func (f *File) Set(account, secret string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	secrets, err := f.read()
+	if err != nil {
+		return err
+	}
+
+	secrets[account] = secret
+
+	return f.write(secrets)
+}
+
+func (f *File) Delete(account string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	secrets, err := f.read()
+	if err != nil {
+		return err
+	}
+
+	if _, ok := secrets[account]; !ok {
+		return nil
+	}
+
+	delete(secrets, account)
+
+	return f.write(secrets)
+}
+
+// read returns the decrypted secrets, or an empty map when the file does not
+// exist yet.
+func (f *File) read() (map[string]string, error) {
+	if f.passphrase == "" {
+		return nil, ErrNoPassphrase
+	}
+
+	data, err := os.ReadFile(f.path)
+	if errors.Is(err, os.ErrNotExist) || (err == nil && len(data) == 0) {
+		return map[string]string{}, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("read credential file: %w", err)
+	}
+
+	var sealed sealedFile
+
+	if err := json.Unmarshal(data, &sealed); err != nil {
+		return nil, fmt.Errorf("parse credential file %s: %w", f.path, err)
+	}
+
+	if sealed.Version != fileFormatVersion {
+		return nil, fmt.Errorf("credential file %s has unsupported version %d", f.path, sealed.Version)
+	}
+
+	aead, err := newAEAD(f.passphrase, sealed.Salt)
+	if err != nil {
+		return nil, err
+	}
+
+	plaintext, err := aead.Open(nil, sealed.Nonce, sealed.Data, nil)
+	if err != nil {
+		return nil, ErrWrongPassphrase
+	}
+
+	secrets := map[string]string{}
+
+	if err := json.Unmarshal(plaintext, &secrets); err != nil {
+		return nil, fmt.Errorf("parse credential file %s: %w", f.path, err)
+	}
+
+	return secrets, nil
+}
+
+func (f *File) write(secrets map[string]string) error {
+	plaintext, err := json.Marshal(secrets)
+	if err != nil {
+		return fmt.Errorf("encode credentials: %w", err)
+	}
+
+	salt := make([]byte, saltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return fmt.Errorf("generate salt: %w", err)
+	}
+
+	aead, err := newAEAD(f.passphrase, salt)
+	if err != nil {
+		return err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return fmt.Errorf("generate nonce: %w", err)
+	}
+
+	out, err := json.Marshal(sealedFile{
+		Version: fileFormatVersion,
+		KDF:     "scrypt",
+		Salt:    salt,
+		Nonce:   nonce,
+		Data:    aead.Seal(nil, nonce, plaintext, nil),
+	})
+	if err != nil {
+		return fmt.Errorf("encode credential file: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(f.path), 0o700); err != nil {
+		return fmt.Errorf("create credential file directory: %w", err)
+	}
+
+	// Set the mode on every write, so a file that was made readable to
+	// others is narrowed back rather than kept as it was.
+	return fsutil.AtomicWriteFileMode(f.path, out, fileMode)
+}
+
+func newAEAD(passphrase string, salt []byte) (cipher.AEAD, error) {
+	key, err := scrypt.Key([]byte(passphrase), salt, scryptN, scryptR, scryptP, scryptKeyLen)
+	if err != nil {
+		return nil, fmt.Errorf("derive credential file key: %w", err)
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("create cipher: %w", err)
+	}
+
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,103 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package credstore
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFile_RoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "credentials.enc")
+	store := NewFile(path, "correct horse")
+
+	_, err := store.Get("default")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	require.NoError(t, store.Set("default", "token-1"))
+	require.NoError(t, store.Set("prod", "token-2"))
+
+	// A fresh store proves the secrets came from disk, not memory.
+	reopened := NewFile(path, "correct horse")
+
+	got, err := reopened.Get("prod")
+	require.NoError(t, err)
+	assert.Equal(t, "token-2", got)
+
+	require.NoError(t, reopened.Delete("prod"))
+	require.NoError(t, reopened.Delete("prod"), "deleting an absent account is not an error")
+
+	_, err = store.Get("prod")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	got, err = store.Get("default")
+	require.NoError(t, err)
+	assert.Equal(t, "token-1", got)
+}
+
+func TestFile_DoesNotStorePlaintext(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "credentials.enc")
+
+	require.NoError(t, NewFile(path, "correct horse").Set("default", "very-secret-token"))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "very-secret-token")
+	assert.NotContains(t, string(data), "default")
+}
+
+func TestFile_WrongPassphrase(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "credentials.enc")
+
+	require.NoError(t, NewFile(path, "correct horse").Set("default", "token"))
+
+	_, err := NewFile(path, "battery staple").Get("default")
+	require.ErrorIs(t, err, ErrWrongPassphrase)
+
+	// A failed open must not let a write replace the secrets it could not read.
+	require.ErrorIs(t, NewFile(path, "battery staple").Set("other", "x"), ErrWrongPassphrase)
+}
+
+func TestFile_NoPassphrase(t *testing.T) {
+	_, err := NewFile(filepath.Join(t.TempDir(), "credentials.enc"), "").Get("default")
+	require.ErrorIs(t, err, ErrNoPassphrase)
+}
+
+func TestFile_IsOwnerOnly(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("Windows does not have POSIX permission bits")
+	}
+
+	path := filepath.Join(t.TempDir(), "credentials.enc")
+
+	require.NoError(t, NewFile(path, "correct horse").Set("default", "token"))
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	// A file someone widened is narrowed again on the next write.
+	require.NoError(t, os.Chmod(path, 0o644))
+	require.NoError(t, NewFile(path, "correct horse").Set("other", "token"))
+
+	info, err = os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+}
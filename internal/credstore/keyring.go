@@ -0,0 +1,88 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package credstore
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/zalando/go-keyring"
+)
+
+// probeAccount is looked up by KeyringAvailable. It is never written.
+const probeAccount = "availability-probe"
+
+// probeTimeout bounds KeyringAvailable. On a headless Linux box the D-Bus
+// client may launch a session bus before learning no Secret Service runs on it.
+const probeTimeout = 3 * time.Second
+
+// Keyring stores secrets in the OS credential store under one service name.
+type Keyring struct {
+	service string
+}
+
+// NewKeyring returns a Keyring that files every secret under service.
+func NewKeyring(service string) *Keyring {
+	return &Keyring{service: service}
+}
+
+func (k *Keyring) Get(account string) (string, error) {
+	secret, err := keyring.Get(k.service, account)
+	if errors.Is(err, keyring.ErrNotFound) {
+		return "", ErrNotFound
+	}
+
+	if err != nil {
+		return "", fmt.Errorf("read %s from OS keyring: %w", account, err)
+	}
+
+	return secret, nil
+}
+
+func (k *Keyring) Set(account, secret string) error {
+	if err := keyring.Set(k.service, account, secret); err != nil {
+		return fmt.Errorf("write %s to OS keyring: %w", account, err)
+	}
+
+	return nil
+}
+
+func (k *Keyring) Delete(account string) error {
+	err := keyring.Delete(k.service, account)
+	if err != nil && !errors.Is(err, keyring.ErrNotFound) {
+		return fmt.Errorf("delete %s from OS keyring: %w", account, err)
+	}
+
+	return nil
+}
+
+// KeyringAvailable reports whether the OS credential store answers. A lookup
+// that finds nothing still proves the service is there.
+func KeyringAvailable(service string) bool {
+	done := make(chan error, 1)
+
+	go func() {
+		_, err := keyring.Get(service, probeAccount)
+		done <- err
+	}()
+
+	select {
+	case err := <-done:
+		return err == nil || errors.Is(err, keyring.ErrNotFound)
+	case <-time.After(probeTimeout):
+		return false
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package credstore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zalando/go-keyring"
+)
+
+func TestKeyring_MapsNotFound(t *testing.T) {
+	keyring.MockInit()
+
+	store := NewKeyring("datarobot-cli-test")
+
+	_, err := store.Get("default")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	require.NoError(t, store.Delete("default"), "deleting an absent account is not an error")
+	require.NoError(t, store.Set("default", "token"))
+
+	got, err := store.Get("default")
+	require.NoError(t, err)
+	assert.Equal(t, "token", got)
+
+	assert.True(t, KeyringAvailable("datarobot-cli-test"))
+}
@@ -0,0 +1,67 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package credstore
+
+import "sync"
+
+// Memory is an in-process Store. It exists for tests that must not touch the
+// developer's real keyring.
+type Memory struct {
+	mu      sync.Mutex
+	secrets map[string]string
+}
+
+// NewMemory returns an empty Memory store.
+func NewMemory() *Memory {
+	return &Memory{secrets: map[string]string{}}
+}
+
+func (m *Memory) Get(account string) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	secret, ok := m.secrets[account]
+	if !ok {
+		return "", ErrNotFound
+	}
+
+	return secret, nil
+}
+
+func (m *Memory) Set(account, secret string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.secrets[account] = secret
+
+	return nil
+}
+
+func (m *Memory) Delete(account string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.secrets, account)
+
+	return nil
+}
+
+// Len returns the number of stored secrets.
+func (m *Memory) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return len(m.secrets)
+}
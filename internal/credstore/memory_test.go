@@ -0,0 +1,39 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package credstore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemory(t *testing.T) {
+	var store Store = NewMemory()
+
+	_, err := store.Get("default")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	require.NoError(t, store.Set("default", "token"))
+
+	got, err := store.Get("default")
+	require.NoError(t, err)
+	assert.Equal(t, "token", got)
+
+	require.NoError(t, store.Delete("default"))
+	require.NoError(t, store.Delete("default"))
+	assert.Zero(t, store.(*Memory).Len())
+}
@@ -0,0 +1,28 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package credstore
+
+import "errors"
+
+// ErrNotFound is returned by Get when the store holds no secret for the account.
+var ErrNotFound = errors.New("secret not found in credential store")
+
+// Store maps account names to secrets. Delete of an absent account is not an
+// error, so callers can clear a secret without checking for it first.
+type Store interface {
+	Get(account string) (string, error)
+	Set(account, secret string) error
+	Delete(account string) error
+}
@@ -23,9 +23,10 @@ import (
 // AuthorizeRequest sets the standard DataRobot API headers on req:
 // Authorization (Bearer token), User-Agent, and the optional
 // X-DataRobot-Api-Consumer-Trace. The request body is never read, so this
-// is safe to call on multipart upload requests.
+// is safe to call on multipart upload requests. A token resolve on first use
+// runs under req's context, so cancelling the request also cancels it.
 func AuthorizeRequest(req *http.Request) error {
-	bearer, err := getToken()
+	bearer, err := getToken(req.Context())
 	if err != nil {
 		return err
 	}
@@ -14,7 +14,8 @@
 
 // This file owns the HTTP client constructor, the cached token resolver, and
 // the DefaultClientTimeout constant shared by every verb helper (get.go,
-// post.go, patch.go, delete.go). AuthorizeRequest lives in auth.go.
+// post.go, patch.go, delete.go). AuthorizeRequest lives in auth.go; the
+// retrying transport every client shares lives in transport.go.
 //
 // HTTPError, the package-level `token` cache, and resolveToken() live in
 // get.go for historical reasons and are reused from this file.
@@ -22,6 +23,7 @@
 package drapi
 
 import (
+	"context"
 	"net/http"
 	"sync"
 	"time"
@@ -39,7 +41,7 @@ var tokenMu sync.Mutex
 // resolveToken so concurrent callers share one round-trip instead of each
 // making their own. A failed resolve is not cached, so a later call retries.
 // The `token` variable and resolveToken() are defined in get.go.
-func getToken() (string, error) {
+func getToken(ctx context.Context) (string, error) {
 	tokenMu.Lock()
 	defer tokenMu.Unlock()
 
@@ -47,7 +49,7 @@ func getToken() (string, error) {
 		return token, nil
 	}
 
-	resolved, err := resolveToken()
+	resolved, err := resolveToken(ctx)
 	if err != nil {
 		return "", err
 	}
@@ -57,9 +59,10 @@ func getToken() (string, error) {
 	return token, nil
 }
 
-// NewHTTPClient returns an *http.Client preconfigured with the given timeout.
-// Use this in place of constructing &http.Client{...} inline so timeouts and
-// future shared-transport tweaks live in one place.
+// NewHTTPClient returns an *http.Client preconfigured with the given timeout
+// and the shared retrying transport. Use this in place of constructing
+// &http.Client{...} inline so timeouts and retry rules live in one place.
+// The timeout bounds the whole call, retries and backoff included.
 func NewHTTPClient(timeout time.Duration) *http.Client {
-	return &http.Client{Timeout: timeout}
+	return &http.Client{Timeout: timeout, Transport: sharedTransport}
 }
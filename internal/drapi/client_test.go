@@ -15,6 +15,7 @@
 package drapi
 
 import (
+	"context"
 	"io"
 	"net/http"
 	"strings"
@@ -78,7 +79,7 @@ func TestGetToken_ResolvesAndMemoizes(t *testing.T) {
 	resetTokenCache(t)
 	withSkipAuth(t, "abc123")
 
-	got, err := getToken()
+	got, err := getToken(context.Background())
 	require.NoError(t, err)
 	assert.Equal(t, "abc123", got)
 
@@ -87,7 +88,7 @@ func TestGetToken_ResolvesAndMemoizes(t *testing.T) {
 	// process.
 	viperx.Set(config.DataRobotAPIKey, "different")
 
-	cached, err := getToken()
+	cached, err := getToken(context.Background())
 	require.NoError(t, err)
 	assert.Equal(t, "abc123", cached)
 }
@@ -142,7 +143,7 @@ func TestTokenAccessorsAreGuarded(t *testing.T) {
 		go func() {
 			defer wg.Done()
 
-			got, err := getToken()
+			got, err := getToken(context.Background())
 			assert.NoError(t, err)
 			assert.Equal(t, want, got)
 		}()
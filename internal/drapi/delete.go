@@ -16,6 +16,7 @@ package drapi
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"net/http"
 
@@ -23,13 +24,13 @@ import (
 	"github.com/datarobot/cli/internal/log"
 )
 
-func Delete(url, info string, body any) (*http.Response, error) {
+func Delete(ctx context.Context, url, info string, body any) (*http.Response, error) {
 	payload, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodDelete, url, bytes.NewReader(payload))
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, url, bytes.NewReader(payload))
 	if err != nil {
 		return nil, err
 	}
@@ -70,8 +71,8 @@ func isDeleteSuccess(code int) bool {
 	return code == http.StatusOK || code == http.StatusAccepted || code == http.StatusNoContent
 }
 
-func DeleteJSON(url, info string, body, v any) error {
-	resp, err := Delete(url, info, body)
+func DeleteJSON(ctx context.Context, url, info string, body, v any) error {
+	resp, err := Delete(ctx, url, info, body)
 	if err != nil {
 		return err
 	}
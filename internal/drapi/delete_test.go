@@ -15,6 +15,7 @@
 package drapi
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -39,7 +40,7 @@ func TestDeleteJSON_204NoContent(t *testing.T) {
 		ID string `json:"id"`
 	}
 
-	err := DeleteJSON(server.URL, "", nil, &out)
+	err := DeleteJSON(context.Background(), server.URL, "", nil, &out)
 	require.NoError(t, err)
 	assert.Empty(t, out.ID)
 }
@@ -57,7 +58,7 @@ func TestDeleteJSON_200WithBody(t *testing.T) {
 		ID string `json:"id"`
 	}
 
-	err := DeleteJSON(server.URL, "", nil, &out)
+	err := DeleteJSON(context.Background(), server.URL, "", nil, &out)
 	require.NoError(t, err)
 	assert.Equal(t, "abc", out.ID)
 }
@@ -72,7 +73,7 @@ func TestDeleteJSON_NonSuccess(t *testing.T) {
 
 	var out struct{}
 
-	err := DeleteJSON(server.URL, "", nil, &out)
+	err := DeleteJSON(context.Background(), server.URL, "", nil, &out)
 	require.Error(t, err)
 
 	var httpErr *HTTPError
@@ -94,7 +95,7 @@ func TestDeleteJSON_NonSuccessWithBody(t *testing.T) {
 	}))
 	defer server.Close()
 
-	err := DeleteJSON(server.URL, "", nil, nil)
+	err := DeleteJSON(context.Background(), server.URL, "", nil, nil)
 	require.Error(t, err)
 
 	var httpErr *HTTPError
@@ -0,0 +1,83 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package drapi
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// Deployment is the subset of the /api/v2/deployments/ response the CLI needs
+// to name a deployment and, for GetDeployedLLMs, to tell a chat LLM apart.
+type Deployment struct {
+	ID          string `json:"id"`
+	Label       string `json:"label"`
+	Description string `json:"description"`
+	Status      string `json:"status"`
+	Model       struct {
+		TargetType string `json:"targetType"`
+	} `json:"model"`
+}
+
+// DeploymentList is one page of the deployments route.
+type DeploymentList struct {
+	Data       []Deployment `json:"data"`
+	Count      int          `json:"count"`
+	TotalCount int          `json:"totalCount"`
+	Next       string       `json:"next"`
+	Previous   string       `json:"previous"`
+}
+
+// ListDeployments returns up to limit deployments the user can see, in the
+// order the server lists them.
+func ListDeployments(ctx context.Context, limit int) ([]Deployment, error) {
+	if limit <= 0 {
+		return nil, fmt.Errorf("limit must be positive, got %d", limit)
+	}
+
+	query := url.Values{}
+	query.Set("limit", strconv.Itoa(min(limit, 100)))
+
+	pageURL, err := EndpointURL("/deployments/", query)
+	if err != nil {
+		return nil, err
+	}
+
+	deployments := make([]Deployment, 0)
+
+	for pageURL != "" && len(deployments) < limit {
+		var list DeploymentList
+
+		if err := GetJSON(ctx, pageURL, "deployments", &list); err != nil {
+			return nil, err
+		}
+
+		deployments = append(deployments, list.Data[:min(len(list.Data), limit-len(deployments))]...)
+
+		if list.Next == "" || len(list.Data) == 0 {
+			break
+		}
+
+		if err := AssertNextOnSameHost(list.Next); err != nil {
+			return nil, err
+		}
+
+		pageURL = list.Next
+	}
+
+	return deployments, nil
+}
@@ -0,0 +1,72 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package drapi
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/datarobot/cli/internal/config"
+	"github.com/datarobot/cli/internal/config/viperx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListDeployments_PagesUpToTheLimit(t *testing.T) {
+	var (
+		srv      *httptest.Server
+		requests []string
+	)
+
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		requests = append(requests, r.URL.RequestURI())
+
+		if r.URL.Query().Get("offset") == "" {
+			_, _ = io.WriteString(w, `{"data":[{"id":"dep-1","label":"One"},{"id":"dep-2","label":"Two"}],"next":"`+srv.URL+`/api/v2/deployments/?offset=2"}`)
+
+			return
+		}
+
+		_, _ = io.WriteString(w, `{"data":[{"id":"dep-3","label":"Three"},{"id":"dep-4","label":"Four"}],"next":"`+srv.URL+`/api/v2/deployments/?offset=4"}`)
+	}))
+
+	viperx.Reset()
+	viperx.Set(config.DataRobotURL, srv.URL)
+	viperx.Set(config.DataRobotAPIKey, "test-token")
+	viperx.Set(config.SkipAuthKey, true)
+
+	t.Cleanup(func() {
+		srv.Close()
+		viperx.Reset()
+	})
+
+	deployments, err := ListDeployments(context.Background(), 3)
+	require.NoError(t, err)
+
+	require.Len(t, deployments, 3)
+	assert.Equal(t, "dep-3", deployments[2].ID)
+	assert.Equal(t, "Three", deployments[2].Label)
+	assert.Equal(t, []string{"/api/v2/deployments/?limit=3", "/api/v2/deployments/?offset=2"}, requests)
+}
+
+func TestListDeployments_RejectsNonPositiveLimit(t *testing.T) {
+	_, err := ListDeployments(context.Background(), 0)
+	require.ErrorContains(t, err, "limit must be positive")
+}
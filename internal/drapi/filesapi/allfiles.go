@@ -15,6 +15,7 @@
 package filesapi
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"net/url"
@@ -23,7 +24,7 @@ import (
 	"github.com/datarobot/cli/internal/workload/fileops"
 )
 
-func (c *httpClient) AllFiles(catalogID, versionID string) (map[string]FileMeta, error) {
+func (c *httpClient) AllFiles(ctx context.Context, catalogID, versionID string) (map[string]FileMeta, error) {
 	out := make(map[string]FileMeta)
 
 	pageURL, err := allFilesURL(catalogID, versionID)
@@ -34,7 +35,7 @@ func (c *httpClient) AllFiles(catalogID, versionID string) (map[string]FileMeta,
 	for pageURL != "" {
 		var page AllFilesResp
 
-		if err := drapi.GetJSON(pageURL, "files", &page); err != nil {
+		if err := drapi.GetJSON(ctx, pageURL, "files", &page); err != nil {
 			return nil, err
 		}
 
@@ -70,7 +71,7 @@ func allFilesURL(catalogID, versionID string) (string, error) {
 	return drapi.EndpointURL("/files/"+url.PathEscape(catalogID)+"/allFiles/", nil)
 }
 
-func (c *httpClient) DownloadFile(catalogID, versionID, path string, w io.Writer) (string, int64, error) {
+func (c *httpClient) DownloadFile(ctx context.Context, catalogID, versionID, path string, w io.Writer) (string, int64, error) {
 	if err := fileops.SafeRelPath(path); err != nil {
 		return "", 0, fmt.Errorf("download path %q: %w", path, err)
 	}
@@ -84,7 +85,7 @@ func (c *httpClient) DownloadFile(catalogID, versionID, path string, w io.Writer
 		return "", 0, fmt.Errorf("build download url: %w", err)
 	}
 
-	resp, err := drapi.Get(requestURL, "file", int(downloadHTTPTimeout.Seconds()))
+	resp, err := drapi.Get(ctx, requestURL, "file", int(downloadHTTPTimeout.Seconds()))
 	if err != nil {
 		return "", 0, fmt.Errorf("download %s: %w", path, err)
 	}
@@ -99,7 +100,7 @@ func (c *httpClient) DownloadFile(catalogID, versionID, path string, w io.Writer
 	return "", n, nil
 }
 
-func (c *httpClient) DeleteFiles(catalogID string, paths []string) (*DeleteFilesResp, error) {
+func (c *httpClient) DeleteFiles(ctx context.Context, catalogID string, paths []string) (*DeleteFilesResp, error) {
 	if len(paths) == 0 {
 		return nil, nil
 	}
@@ -111,7 +112,7 @@ func (c *httpClient) DeleteFiles(catalogID string, paths []string) (*DeleteFiles
 
 	var resp DeleteFilesResp
 
-	if err := drapi.DeleteJSON(requestURL, "files", DeleteFilesReq{Paths: paths}, &resp); err != nil {
+	if err := drapi.DeleteJSON(ctx, requestURL, "files", DeleteFilesReq{Paths: paths}, &resp); err != nil {
 		return nil, err
 	}
 
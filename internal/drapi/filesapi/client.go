@@ -15,21 +15,22 @@
 package filesapi
 
 import (
+	"context"
 	"io"
 )
 
 type Client interface {
-	CreateCatalog() (*CatalogResp, error)
-	CreateStage(catalogID string) (*StageResp, error)
-	UploadToStage(catalogID, stageID, name string, size int64, body io.Reader) error
-	ApplyStage(catalogID, stageID, overwrite string) (*ApplyStageResp, error)
-	UploadFromZipNew(name string, size int64, body io.Reader) (*FromFileResp, error)
-	UploadFromZipExisting(catalogID, name, overwrite string, size int64, body io.Reader) (*FromFileResp, error)
-	PollStatus(statusID string) (*StatusResp, error)
-	AllFiles(catalogID, versionID string) (map[string]FileMeta, error)
-	DownloadFile(catalogID, versionID, path string, w io.Writer) (string, int64, error)
-	DeleteFiles(catalogID string, paths []string) (*DeleteFilesResp, error)
-	ListVersions(catalogID string, limit int) ([]CatalogVersion, error)
+	CreateCatalog(ctx context.Context) (*CatalogResp, error)
+	CreateStage(ctx context.Context, catalogID string) (*StageResp, error)
+	UploadToStage(ctx context.Context, catalogID, stageID, name string, size int64, body io.Reader) error
+	ApplyStage(ctx context.Context, catalogID, stageID, overwrite string) (*ApplyStageResp, error)
+	UploadFromZipNew(ctx context.Context, name string, size int64, body io.Reader) (*FromFileResp, error)
+	UploadFromZipExisting(ctx context.Context, catalogID, name, overwrite string, size int64, body io.Reader) (*FromFileResp, error)
+	PollStatus(ctx context.Context, statusID string) (*StatusResp, error)
+	AllFiles(ctx context.Context, catalogID, versionID string) (map[string]FileMeta, error)
+	DownloadFile(ctx context.Context, catalogID, versionID, path string, w io.Writer) (string, int64, error)
+	DeleteFiles(ctx context.Context, catalogID string, paths []string) (*DeleteFilesResp, error)
+	ListVersions(ctx context.Context, catalogID string, limit int) ([]CatalogVersion, error)
 }
 
 func New() Client {
@@ -16,6 +16,7 @@ package filesapi
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
 	"mime/multipart"
@@ -72,7 +73,7 @@ func TestCreateCatalog(t *testing.T) {
 	}))
 
 	c := New()
-	got, err := c.CreateCatalog()
+	got, err := c.CreateCatalog(context.Background())
 
 	require.NoError(t, err)
 	assert.Equal(t, "cid-1", got.CatalogID)
@@ -103,11 +104,11 @@ func TestCreateStage_ApplyStage(t *testing.T) {
 
 	c := New()
 
-	stage, err := c.CreateStage("cid-1")
+	stage, err := c.CreateStage(context.Background(), "cid-1")
 	require.NoError(t, err)
 	assert.Equal(t, "st-1", stage.StageID)
 
-	apply, err := c.ApplyStage("cid-1", "st-1", OverwriteReplace)
+	apply, err := c.ApplyStage(context.Background(), "cid-1", "st-1", OverwriteReplace)
 	require.NoError(t, err)
 	assert.Equal(t, "v1", apply.CatalogVersionID)
 	assert.Equal(t, 3, apply.NumFiles)
@@ -146,7 +147,7 @@ func TestUploadToStage_Multipart(t *testing.T) {
 
 	c := New()
 	body := strings.NewReader("print('hi')\n")
-	err := c.UploadToStage("cid-1", "st-1", "agent.py", int64(body.Len()), body)
+	err := c.UploadToStage(context.Background(), "cid-1", "st-1", "agent.py", int64(body.Len()), body)
 	require.NoError(t, err)
 }
 
@@ -176,7 +177,7 @@ func TestUploadToStage_AdvertisesContentLength(t *testing.T) {
 
 	c := New()
 	body := strings.NewReader(payload)
-	require.NoError(t, c.UploadToStage("cid-1", "st-1", "test.txt", int64(body.Len()), body))
+	require.NoError(t, c.UploadToStage(context.Background(), "cid-1", "st-1", "test.txt", int64(body.Len()), body))
 
 	assert.Greater(t, gotContentLength, int64(len(payload)),
 		"Content-Length should include multipart envelope, not just payload")
@@ -219,7 +220,7 @@ func TestAllFiles_Pagination(t *testing.T) {
 
 	c := New()
 
-	got, err := c.AllFiles("cid-1", "v1")
+	got, err := c.AllFiles(context.Background(), "cid-1", "v1")
 	require.NoError(t, err)
 	assert.Len(t, got, 3)
 	assert.Equal(t, FileMeta{Hash: "aaa", Size: 10}, got["a.py"])
@@ -247,7 +248,7 @@ func TestAllFiles_RejectsCrossHostNext(t *testing.T) {
 
 	c := New()
 
-	got, err := c.AllFiles("cid-1", "v1")
+	got, err := c.AllFiles(context.Background(), "cid-1", "v1")
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "host")
 	assert.Nil(t, got)
@@ -273,7 +274,7 @@ func TestAllFiles_RejectsHostilePath(t *testing.T) {
 
 	c := New()
 
-	got, err := c.AllFiles("cid-1", "v1")
+	got, err := c.AllFiles(context.Background(), "cid-1", "v1")
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "escapes project root")
 	assert.Nil(t, got)
@@ -301,7 +302,7 @@ func TestDownloadFile_RejectsHostilePath(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			var buf bytes.Buffer
 
-			_, _, err := c.DownloadFile("cid-1", "v1", tc.path, &buf)
+			_, _, err := c.DownloadFile(context.Background(), "cid-1", "v1", tc.path, &buf)
 			require.Error(t, err)
 			assert.Contains(t, err.Error(), tc.wantSub)
 		})
@@ -323,7 +324,7 @@ func TestDeleteFiles(t *testing.T) {
 	}))
 
 	c := New()
-	got, err := c.DeleteFiles("cid-1", []string{"old.py"})
+	got, err := c.DeleteFiles(context.Background(), "cid-1", []string{"old.py"})
 	require.NoError(t, err)
 	assert.Equal(t, "v2", got.CatalogVersionID)
 	assert.Equal(t, 1, got.NumFiles)
@@ -331,7 +332,7 @@ func TestDeleteFiles(t *testing.T) {
 
 func TestDeleteFiles_EmptyIsNoop(t *testing.T) {
 	c := New()
-	got, err := c.DeleteFiles("cid-1", nil)
+	got, err := c.DeleteFiles(context.Background(), "cid-1", nil)
 	require.NoError(t, err)
 	assert.Nil(t, got)
 }
@@ -344,7 +345,7 @@ func TestPollStatus_RunningJSON(t *testing.T) {
 	}))
 
 	c := New()
-	resp, err := c.PollStatus("sid-1")
+	resp, err := c.PollStatus(context.Background(), "sid-1")
 	require.NoError(t, err)
 	assert.Equal(t, StatusRunningToWorkers, resp.Status)
 	assert.False(t, IsTerminalStatus(resp.Status))
@@ -358,7 +359,7 @@ func TestPollStatus_CompletedRedirect(t *testing.T) {
 	}))
 
 	c := New()
-	resp, err := c.PollStatus("sid-2")
+	resp, err := c.PollStatus(context.Background(), "sid-2")
 	require.NoError(t, err)
 	assert.Equal(t, StatusCompleted, resp.Status)
 	assert.True(t, IsTerminalStatus(resp.Status))
@@ -391,7 +392,7 @@ func TestUploadFromZipExisting(t *testing.T) {
 	c := New()
 
 	zipBody := bytes.NewReader([]byte("PK\x03\x04fake-zip"))
-	resp, err := c.UploadFromZipExisting("cid-1", "changes.zip", "", int64(zipBody.Len()), zipBody)
+	resp, err := c.UploadFromZipExisting(context.Background(), "cid-1", "changes.zip", "", int64(zipBody.Len()), zipBody)
 	require.NoError(t, err)
 	assert.Equal(t, "v9", resp.CatalogVersionID)
 	assert.Equal(t, "sid-9", resp.StatusID)
@@ -428,7 +429,7 @@ func TestUploadFromZipNew_HitsFromFileEndpoint(t *testing.T) {
 	c := New()
 
 	zipBody := bytes.NewReader([]byte("PK\x03\x04fake-zip"))
-	resp, err := c.UploadFromZipNew("wapi-sync.zip", int64(zipBody.Len()), zipBody)
+	resp, err := c.UploadFromZipNew(context.Background(), "wapi-sync.zip", int64(zipBody.Len()), zipBody)
 	require.NoError(t, err)
 	assert.Equal(t, "new-cid", resp.CatalogID)
 	assert.Equal(t, "new-ver", resp.CatalogVersionID)
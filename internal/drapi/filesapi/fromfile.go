@@ -15,6 +15,7 @@
 package filesapi
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -24,7 +25,7 @@ import (
 	"github.com/datarobot/cli/internal/drapi"
 )
 
-func (c *httpClient) UploadFromZipNew(name string, size int64, body io.Reader) (*FromFileResp, error) {
+func (c *httpClient) UploadFromZipNew(ctx context.Context, name string, size int64, body io.Reader) (*FromFileResp, error) {
 	q := url.Values{}
 	q.Set("useArchiveContents", "true")
 
@@ -33,10 +34,10 @@ func (c *httpClient) UploadFromZipNew(name string, size int64, body io.Reader) (
 		return nil, fmt.Errorf("build files url: %w", err)
 	}
 
-	return uploadZipMultipart(requestURL, name, size, body)
+	return uploadZipMultipart(ctx, requestURL, name, size, body)
 }
 
-func (c *httpClient) UploadFromZipExisting(catalogID, name, overwrite string, size int64, body io.Reader) (*FromFileResp, error) {
+func (c *httpClient) UploadFromZipExisting(ctx context.Context, catalogID, name, overwrite string, size int64, body io.Reader) (*FromFileResp, error) {
 	if overwrite == "" {
 		overwrite = OverwriteReplace
 	}
@@ -50,16 +51,16 @@ func (c *httpClient) UploadFromZipExisting(catalogID, name, overwrite string, si
 		return nil, fmt.Errorf("build fromFile url: %w", err)
 	}
 
-	return uploadZipMultipart(requestURL, name, size, body)
+	return uploadZipMultipart(ctx, requestURL, name, size, body)
 }
 
-func uploadZipMultipart(requestURL, name string, size int64, body io.Reader) (*FromFileResp, error) {
-	req, err := newStreamingMultipartRequest(requestURL, nil, name, size, body)
+func uploadZipMultipart(ctx context.Context, requestURL, name string, size int64, body io.Reader) (*FromFileResp, error) {
+	req, err := newStreamingMultipartRequest(ctx, requestURL, nil, name, size, body)
 	if err != nil {
 		return nil, err
 	}
 
-	client := &http.Client{Timeout: uploadHTTPTimeout}
+	client := drapi.NewHTTPClient(uploadHTTPTimeout)
 
 	resp, err := client.Do(req)
 	if err != nil {
@@ -82,13 +83,13 @@ func uploadZipMultipart(requestURL, name string, size int64, body io.Reader) (*F
 	return &out, nil
 }
 
-func (c *httpClient) PollStatus(statusID string) (*StatusResp, error) {
+func (c *httpClient) PollStatus(ctx context.Context, statusID string) (*StatusResp, error) {
 	requestURL, err := drapi.EndpointURL("/status/"+url.PathEscape(statusID)+"/", nil)
 	if err != nil {
 		return nil, fmt.Errorf("build status url: %w", err)
 	}
 
-	httpResp, err := getAcceptingRedirect(requestURL)
+	httpResp, err := getAcceptingRedirect(ctx, requestURL)
 	if err != nil {
 		return nil, err
 	}
@@ -109,8 +110,8 @@ func (c *httpClient) PollStatus(statusID string) (*StatusResp, error) {
 	return &resp, nil
 }
 
-func getAcceptingRedirect(requestURL string) (*http.Response, error) {
-	req, err := http.NewRequest(http.MethodGet, requestURL, nil)
+func getAcceptingRedirect(ctx context.Context, requestURL string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
 	if err != nil {
 		return nil, fmt.Errorf("build status request: %w", err)
 	}
@@ -119,11 +120,9 @@ func getAcceptingRedirect(requestURL string) (*http.Response, error) {
 		return nil, err
 	}
 
-	client := &http.Client{
-		Timeout: statusPollHTTPTimeout,
-		CheckRedirect: func(*http.Request, []*http.Request) error {
-			return http.ErrUseLastResponse
-		},
+	client := drapi.NewHTTPClient(statusPollHTTPTimeout)
+	client.CheckRedirect = func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
 	}
 
 	resp, err := client.Do(req)
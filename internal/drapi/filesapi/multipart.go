@@ -16,6 +16,7 @@ package filesapi
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"mime/multipart"
@@ -34,11 +35,12 @@ const multipartFormField = "file"
 // by the pipe (one chunk in flight) plus the small envelope, regardless
 // of file size — important because the engine may upload multi-GiB zips.
 //
-// Trade-off: the request has no GetBody, so http.Transport cannot
-// transparently retry the body on connection reset. Callers needing
-// retry must redo the call from scratch (re-opening the source if it
-// isn't seekable).
+// Trade-off: the request has no GetBody, so neither http.Transport nor
+// drapi's retrying transport can replay the body after a failure. Callers
+// needing retry must redo the call from scratch (re-opening the source if
+// it isn't seekable).
 func newStreamingMultipartRequest(
+	ctx context.Context,
 	requestURL string,
 	query url.Values,
 	filename string,
@@ -58,7 +60,7 @@ func newStreamingMultipartRequest(
 
 	go streamMultipartBody(pw, prologue, body, epilogue)
 
-	req, err := http.NewRequest(http.MethodPost, requestURL, pr)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, requestURL, pr)
 	if err != nil {
 		_ = pr.Close()
 
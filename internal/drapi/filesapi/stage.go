@@ -15,6 +15,7 @@
 package filesapi
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"net/http"
@@ -23,7 +24,7 @@ import (
 	"github.com/datarobot/cli/internal/drapi"
 )
 
-func (c *httpClient) CreateCatalog() (*CatalogResp, error) {
+func (c *httpClient) CreateCatalog(ctx context.Context) (*CatalogResp, error) {
 	requestURL, err := drapi.EndpointURL("/files/", nil)
 	if err != nil {
 		return nil, fmt.Errorf("build catalog url: %w", err)
@@ -31,14 +32,14 @@ func (c *httpClient) CreateCatalog() (*CatalogResp, error) {
 
 	var resp CatalogResp
 
-	if err := drapi.PostJSON(requestURL, "catalog", struct{}{}, &resp); err != nil {
+	if err := drapi.PostJSON(ctx, requestURL, "catalog", struct{}{}, &resp); err != nil {
 		return nil, err
 	}
 
 	return &resp, nil
 }
 
-func (c *httpClient) CreateStage(catalogID string) (*StageResp, error) {
+func (c *httpClient) CreateStage(ctx context.Context, catalogID string) (*StageResp, error) {
 	requestURL, err := drapi.EndpointURL("/files/"+url.PathEscape(catalogID)+"/stages/", nil)
 	if err != nil {
 		return nil, fmt.Errorf("build stage url: %w", err)
@@ -46,26 +47,26 @@ func (c *httpClient) CreateStage(catalogID string) (*StageResp, error) {
 
 	var resp StageResp
 
-	if err := drapi.PostJSON(requestURL, "stage", struct{}{}, &resp); err != nil {
+	if err := drapi.PostJSON(ctx, requestURL, "stage", struct{}{}, &resp); err != nil {
 		return nil, err
 	}
 
 	return &resp, nil
 }
 
-func (c *httpClient) UploadToStage(catalogID, stageID, name string, size int64, body io.Reader) error {
+func (c *httpClient) UploadToStage(ctx context.Context, catalogID, stageID, name string, size int64, body io.Reader) error {
 	requestURL, err := drapi.EndpointURL(
 		"/files/"+url.PathEscape(catalogID)+"/stages/"+url.PathEscape(stageID)+"/upload/", nil)
 	if err != nil {
 		return fmt.Errorf("build upload url: %w", err)
 	}
 
-	req, err := newStreamingMultipartRequest(requestURL, nil, name, size, body)
+	req, err := newStreamingMultipartRequest(ctx, requestURL, nil, name, size, body)
 	if err != nil {
 		return err
 	}
 
-	client := &http.Client{Timeout: uploadHTTPTimeout}
+	client := drapi.NewHTTPClient(uploadHTTPTimeout)
 
 	resp, err := client.Do(req)
 	if err != nil {
@@ -81,7 +82,7 @@ func (c *httpClient) UploadToStage(catalogID, stageID, name string, size int64,
 	return nil
 }
 
-func (c *httpClient) ApplyStage(catalogID, stageID, overwrite string) (*ApplyStageResp, error) {
+func (c *httpClient) ApplyStage(ctx context.Context, catalogID, stageID, overwrite string) (*ApplyStageResp, error) {
 	requestURL, err := drapi.EndpointURL("/files/"+url.PathEscape(catalogID)+"/fromStage/", nil)
 	if err != nil {
 		return nil, fmt.Errorf("build apply-stage url: %w", err)
@@ -90,7 +91,7 @@ func (c *httpClient) ApplyStage(catalogID, stageID, overwrite string) (*ApplySta
 	var resp ApplyStageResp
 
 	body := ApplyStageReq{StageID: stageID, Overwrite: overwrite}
-	if err := drapi.PostJSON(requestURL, "apply-stage", body, &resp); err != nil {
+	if err := drapi.PostJSON(ctx, requestURL, "apply-stage", body, &resp); err != nil {
 		return nil, err
 	}
 
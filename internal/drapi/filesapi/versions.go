@@ -15,6 +15,7 @@
 package filesapi
 
 import (
+	"context"
 	"net/url"
 	"strconv"
 
@@ -31,7 +32,7 @@ const versionsPageSize = 100
 // limit > 0 the result is truncated to that many entries (pagination
 // stops as soon as the cap is reached). When limit <= 0 every page is
 // followed until the server returns no Next cursor.
-func (c *httpClient) ListVersions(catalogID string, limit int) ([]CatalogVersion, error) {
+func (c *httpClient) ListVersions(ctx context.Context, catalogID string, limit int) ([]CatalogVersion, error) {
 	q := url.Values{}
 	q.Set("orderBy", "-created")
 	q.Set("limit", strconv.Itoa(versionsPageSize))
@@ -46,7 +47,7 @@ func (c *httpClient) ListVersions(catalogID string, limit int) ([]CatalogVersion
 	for pageURL != "" {
 		var page CatalogVersionsResp
 
-		if err := drapi.GetJSON(pageURL, "versions", &page); err != nil {
+		if err := drapi.GetJSON(ctx, pageURL, "versions", &page); err != nil {
 			return nil, err
 		}
 
@@ -15,6 +15,7 @@
 package filesapi
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"testing"
@@ -42,7 +43,7 @@ func TestListVersions_SinglePage(t *testing.T) {
 
 	startServer(t, mux)
 
-	got, err := New().ListVersions("cid-1", 0)
+	got, err := New().ListVersions(context.Background(), "cid-1", 0)
 	require.NoError(t, err)
 	assert.Len(t, got, 3)
 	assert.Equal(t, "v3", got[0].ID)
@@ -81,7 +82,7 @@ func TestListVersions_Pagination(t *testing.T) {
 
 	startServer(t, mux)
 
-	got, err := New().ListVersions("cid-1", 0)
+	got, err := New().ListVersions(context.Background(), "cid-1", 0)
 	require.NoError(t, err)
 	require.Len(t, got, 3)
 	assert.Equal(t, "v5", got[0].ID)
@@ -103,7 +104,7 @@ func TestListVersions_LimitTruncatesMidPage(t *testing.T) {
 
 	startServer(t, mux)
 
-	got, err := New().ListVersions("cid-1", 2)
+	got, err := New().ListVersions(context.Background(), "cid-1", 2)
 	require.NoError(t, err)
 	require.Len(t, got, 2)
 	assert.Equal(t, "v5", got[0].ID)
@@ -124,7 +125,7 @@ func TestListVersions_RejectsCrossHostNext(t *testing.T) {
 
 	startServer(t, mux)
 
-	got, err := New().ListVersions("cid-1", 0)
+	got, err := New().ListVersions(context.Background(), "cid-1", 0)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "host")
 	assert.Nil(t, got)
@@ -158,7 +159,7 @@ func TestListVersions_DecodesRealServerShape(t *testing.T) {
 
 	startServer(t, mux)
 
-	got, err := New().ListVersions("cid-1", 0)
+	got, err := New().ListVersions(context.Background(), "cid-1", 0)
 	require.NoError(t, err)
 	require.Len(t, got, 1)
 	assert.Equal(t, "ver-abc-1", got[0].ID)
@@ -176,7 +177,7 @@ func TestListVersions_EmptyResponse(t *testing.T) {
 
 	startServer(t, mux)
 
-	got, err := New().ListVersions("cid-1", 0)
+	got, err := New().ListVersions(context.Background(), "cid-1", 0)
 	require.NoError(t, err)
 	assert.Empty(t, got)
 }
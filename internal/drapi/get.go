@@ -69,21 +69,23 @@ func SetToken(value string) {
 // When --skip-auth (or DATAROBOT_CLI_SKIP_AUTH) is active we trust whatever
 // is in viper without contacting the server, so local development against
 // stub APIs that don't implement /version/ still works.
-func resolveToken() (string, error) {
+func resolveToken(ctx context.Context) (string, error) {
 	if viperx.GetBool(config.SkipAuthKey) {
 		return viperx.GetString(config.DataRobotAPIKey), nil
 	}
 
-	return config.GetAPIKey(context.Background())
+	return config.GetAPIKey(ctx)
 }
 
-func Get(url, info string, timeoutSecs ...int) (*http.Response, error) {
+// Get issues an authenticated GET bound to ctx. Transient failures are
+// retried by the shared transport before a non-200 becomes an *HTTPError.
+func Get(ctx context.Context, url, info string, timeoutSecs ...int) (*http.Response, error) {
 	timeout := DefaultClientTimeout
 	if len(timeoutSecs) > 0 {
 		timeout = time.Duration(timeoutSecs[0]) * time.Second
 	}
 
-	req, err := http.NewRequest(http.MethodGet, url, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -112,8 +114,8 @@ func Get(url, info string, timeoutSecs ...int) (*http.Response, error) {
 	return resp, err
 }
 
-func GetJSON(url, info string, v any, timeoutSecs ...int) error {
-	resp, err := Get(url, info, timeoutSecs...)
+func GetJSON(ctx context.Context, url, info string, v any, timeoutSecs ...int) error {
+	resp, err := Get(ctx, url, info, timeoutSecs...)
 	if err != nil {
 		return err
 	}
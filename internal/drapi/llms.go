@@ -15,6 +15,7 @@
 package drapi
 
 import (
+	"context"
 	"errors"
 	"strings"
 	"sync"
@@ -97,7 +98,7 @@ type LLMList struct {
 	Warnings []string `json:"-"`
 }
 
-func GetLLMs() (*LLMList, error) {
+func GetLLMs(ctx context.Context) (*LLMList, error) {
 	url, err := config.GetEndpointURL("/api/v2/genai/llmgw/catalog/?limit=100")
 	if err != nil {
 		return nil, err
@@ -110,7 +111,7 @@ func GetLLMs() (*LLMList, error) {
 	for url != "" {
 		llmList = LLMList{}
 
-		err = GetJSON(url, "LLMs", &llmList)
+		err = GetJSON(ctx, url, "LLMs", &llmList)
 		if err != nil {
 			return nil, err
 		}
@@ -138,32 +139,12 @@ func GetLLMs() (*LLMList, error) {
 	return &llmList, nil
 }
 
-// deployment is the subset of the /api/v2/deployments/ response the CLI needs
-// to present a deployed model as a selectable LLM.
-type deployment struct {
-	ID          string `json:"id"`
-	Label       string `json:"label"`
-	Description string `json:"description"`
-	Status      string `json:"status"`
-	Model       struct {
-		TargetType string `json:"targetType"`
-	} `json:"model"`
-}
-
-type deploymentList struct {
-	Data       []deployment `json:"data"`
-	Count      int          `json:"count"`
-	TotalCount int          `json:"totalCount"`
-	Next       string       `json:"next"`
-	Previous   string       `json:"previous"`
-}
-
 // GetDeployedLLMs lists DataRobot Deployments serving as chat LLMs (champion
 // model target type TextGeneration). The server-side championModelTargetType
 // filter is honored on recent platforms; older on-prem builds ignore unknown
 // query params and return every deployment, so rows are re-filtered
 // client-side on target type and active status.
-func GetDeployedLLMs() ([]LLM, error) {
+func GetDeployedLLMs(ctx context.Context) ([]LLM, error) {
 	url, err := config.GetEndpointURL("/api/v2/deployments/?championModelTargetType=" + targetTypeTextGeneration + "&limit=100")
 	if err != nil {
 		return nil, err
@@ -172,9 +153,9 @@ func GetDeployedLLMs() ([]LLM, error) {
 	var deployed []LLM
 
 	for url != "" {
-		var dl deploymentList
+		var dl DeploymentList
 
-		if err = GetJSON(url, "deployed LLMs", &dl); err != nil {
+		if err = GetJSON(ctx, url, "deployed LLMs", &dl); err != nil {
 			return nil, err
 		}
 
@@ -228,7 +209,7 @@ func GetDeployedLLMs() ([]LLM, error) {
 // internal/log, whose stderrLogger global tui.Run rewrites. Nothing overlaps
 // today because select starts its picker after this returns, but wrapping the
 // fetch in a spinner would make that a live race.
-func GetLLMsAndDeployed() (*LLMList, error) {
+func GetLLMsAndDeployed(ctx context.Context) (*LLMList, error) {
 	var (
 		gateway  *LLMList
 		deployed []LLM
@@ -242,13 +223,13 @@ func GetLLMsAndDeployed() (*LLMList, error) {
 	go func() {
 		defer wg.Done()
 
-		gateway, gwErr = GetLLMs()
+		gateway, gwErr = GetLLMs(ctx)
 	}()
 
 	go func() {
 		defer wg.Done()
 
-		deployed, depErr = GetDeployedLLMs()
+		deployed, depErr = GetDeployedLLMs(ctx)
 	}()
 
 	wg.Wait()
@@ -15,6 +15,7 @@
 package drapi
 
 import (
+	"context"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -90,7 +91,7 @@ const deployedBody = `{"data":[
 func TestGetDeployedLLMs_MapsAndFilters(t *testing.T) {
 	setupRoutedServer(t, routeResponse{}, routeResponse{body: deployedBody})
 
-	deployed, err := GetDeployedLLMs()
+	deployed, err := GetDeployedLLMs(context.Background())
 	require.NoError(t, err)
 
 	require.Len(t, deployed, 1)
@@ -130,7 +131,7 @@ func TestGetDeployedLLMs_Pagination(t *testing.T) {
 		viperx.Reset()
 	})
 
-	deployed, err := GetDeployedLLMs()
+	deployed, err := GetDeployedLLMs(context.Background())
 	require.NoError(t, err)
 
 	require.Len(t, deployed, 2)
@@ -141,7 +142,7 @@ func TestGetDeployedLLMs_Pagination(t *testing.T) {
 func TestGetLLMsAndDeployed_Union(t *testing.T) {
 	setupRoutedServer(t, routeResponse{body: gatewayBody}, routeResponse{body: deployedBody})
 
-	list, err := GetLLMsAndDeployed()
+	list, err := GetLLMsAndDeployed(context.Background())
 	require.NoError(t, err)
 
 	require.Len(t, list.LLMs, 2)
@@ -155,7 +156,7 @@ func TestGetLLMsAndDeployed_Union(t *testing.T) {
 func TestGetLLMsAndDeployed_GatewayFailsSoftDegrade(t *testing.T) {
 	setupRoutedServer(t, routeResponse{status: http.StatusInternalServerError, body: "boom"}, routeResponse{body: deployedBody})
 
-	list, err := GetLLMsAndDeployed()
+	list, err := GetLLMsAndDeployed(context.Background())
 	require.NoError(t, err)
 
 	require.Len(t, list.LLMs, 1)
@@ -168,7 +169,7 @@ func TestGetLLMsAndDeployed_GatewayFailsSoftDegrade(t *testing.T) {
 func TestGetLLMsAndDeployed_DeployedFailsSoftDegrade(t *testing.T) {
 	setupRoutedServer(t, routeResponse{body: gatewayBody}, routeResponse{status: http.StatusInternalServerError, body: "boom"})
 
-	list, err := GetLLMsAndDeployed()
+	list, err := GetLLMsAndDeployed(context.Background())
 	require.NoError(t, err)
 
 	require.Len(t, list.LLMs, 1)
@@ -181,7 +182,7 @@ func TestGetLLMsAndDeployed_DeployedFailsSoftDegrade(t *testing.T) {
 func TestGetLLMsAndDeployed_BothSucceedNoWarnings(t *testing.T) {
 	setupRoutedServer(t, routeResponse{body: gatewayBody}, routeResponse{body: deployedBody})
 
-	list, err := GetLLMsAndDeployed()
+	list, err := GetLLMsAndDeployed(context.Background())
 	require.NoError(t, err)
 
 	assert.Empty(t, list.Warnings)
@@ -191,7 +192,7 @@ func TestGetDeployedLLMs_EmptyLabelFallsBackToID(t *testing.T) {
 	body := `{"data":[{"id":"dep-no-label","label":"","status":"active","model":{"targetType":"TextGeneration"}}]}`
 	setupRoutedServer(t, routeResponse{}, routeResponse{body: body})
 
-	deployed, err := GetDeployedLLMs()
+	deployed, err := GetDeployedLLMs(context.Background())
 	require.NoError(t, err)
 
 	require.Len(t, deployed, 1)
@@ -223,7 +224,7 @@ func TestGetDeployedLLMs_SendsFilterAndLimit(t *testing.T) {
 		viperx.Reset()
 	})
 
-	_, err := GetDeployedLLMs()
+	_, err := GetDeployedLLMs(context.Background())
 	require.NoError(t, err)
 
 	values, err := url.ParseQuery(<-queryCh)
@@ -238,7 +239,7 @@ func TestGetLLMsAndDeployed_BothFail(t *testing.T) {
 		routeResponse{status: http.StatusInternalServerError, body: "boom"},
 	)
 
-	_, err := GetLLMsAndDeployed()
+	_, err := GetLLMsAndDeployed(context.Background())
 	assert.Error(t, err)
 }
 
@@ -305,7 +306,7 @@ func TestGetLLMsAndDeployed_FetchesConcurrently(t *testing.T) {
 		viperx.Reset()
 	})
 
-	list, err := GetLLMsAndDeployed()
+	list, err := GetLLMsAndDeployed(context.Background())
 	require.NoError(t, err)
 	assert.Len(t, list.LLMs, 2)
 }
@@ -321,7 +322,7 @@ func TestGetLLMsAndDeployed_ColdTokenIsRaceFree(t *testing.T) {
 
 	t.Cleanup(func() { token = previous })
 
-	list, err := GetLLMsAndDeployed()
+	list, err := GetLLMsAndDeployed(context.Background())
 	require.NoError(t, err)
 	assert.Len(t, list.LLMs, 2)
 }
@@ -16,6 +16,7 @@ package drapi
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"net/http"
 
@@ -23,13 +24,13 @@ import (
 	"github.com/datarobot/cli/internal/log"
 )
 
-func Patch(url, info string, body any) (*http.Response, error) {
+func Patch(ctx context.Context, url, info string, body any) (*http.Response, error) {
 	payload, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPatch, url, bytes.NewReader(payload))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, url, bytes.NewReader(payload))
 	if err != nil {
 		return nil, err
 	}
@@ -73,8 +74,8 @@ func isPatchSuccess(code int) bool {
 	return code == http.StatusOK || code == http.StatusAccepted || code == http.StatusNoContent
 }
 
-func PatchJSON(url, info string, body, v any) error {
-	resp, err := Patch(url, info, body)
+func PatchJSON(ctx context.Context, url, info string, body, v any) error {
+	resp, err := Patch(ctx, url, info, body)
 	if err != nil {
 		return err
 	}
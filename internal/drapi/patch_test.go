@@ -15,6 +15,7 @@
 package drapi
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -39,7 +40,7 @@ func TestPatchJSON_204NoContent(t *testing.T) {
 		ID string `json:"id"`
 	}
 
-	err := PatchJSON(server.URL, "", map[string]string{"k": "v"}, &out)
+	err := PatchJSON(context.Background(), server.URL, "", map[string]string{"k": "v"}, &out)
 	require.NoError(t, err)
 	assert.Empty(t, out.ID)
 }
@@ -57,7 +58,7 @@ func TestPatchJSON_200WithBody(t *testing.T) {
 		ID string `json:"id"`
 	}
 
-	err := PatchJSON(server.URL, "", map[string]string{"k": "v"}, &out)
+	err := PatchJSON(context.Background(), server.URL, "", map[string]string{"k": "v"}, &out)
 	require.NoError(t, err)
 	assert.Equal(t, "xyz", out.ID)
 }
@@ -73,7 +74,7 @@ func TestPatchJSON_ErrorCarriesBodyDetail(t *testing.T) {
 	}))
 	defer server.Close()
 
-	err := PatchJSON(server.URL, "", map[string]string{"k": "v"}, nil)
+	err := PatchJSON(context.Background(), server.URL, "", map[string]string{"k": "v"}, nil)
 	require.Error(t, err)
 
 	var httpErr *HTTPError
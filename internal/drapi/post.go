@@ -16,6 +16,7 @@ package drapi
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"net/http"
 
@@ -23,13 +24,13 @@ import (
 	"github.com/datarobot/cli/internal/log"
 )
 
-func Post(url, info string, body any) (*http.Response, error) {
+func Post(ctx context.Context, url, info string, body any) (*http.Response, error) {
 	payload, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(payload))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
 	if err != nil {
 		return nil, err
 	}
@@ -96,8 +97,8 @@ func restoreRequestBody(req *http.Request) error {
 	return nil
 }
 
-func PostJSON(url, info string, body, v any) error {
-	resp, err := Post(url, info, body)
+func PostJSON(ctx context.Context, url, info string, body, v any) error {
+	resp, err := Post(ctx, url, info, body)
 	if err != nil {
 		return err
 	}
@@ -15,6 +15,7 @@
 package drapi
 
 import (
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -46,7 +47,7 @@ func TestPost_Created(t *testing.T) {
 	}))
 	defer server.Close()
 
-	resp, err := Post(server.URL, "", map[string]string{"name": "v"})
+	resp, err := Post(context.Background(), server.URL, "", map[string]string{"name": "v"})
 	require.NoError(t, err)
 
 	defer resp.Body.Close()
@@ -63,7 +64,7 @@ func TestPost_OK(t *testing.T) {
 	}))
 	defer server.Close()
 
-	resp, err := Post(server.URL, "", map[string]string{})
+	resp, err := Post(context.Background(), server.URL, "", map[string]string{})
 	require.NoError(t, err)
 
 	defer resp.Body.Close()
@@ -79,7 +80,7 @@ func TestPost_NonSuccess(t *testing.T) {
 	}))
 	defer server.Close()
 
-	resp, err := Post(server.URL, "", map[string]string{}) //nolint:bodyclose // resp is nil on error; Post closes it before returning
+	resp, err := Post(context.Background(), server.URL, "", map[string]string{}) //nolint:bodyclose // resp is nil on error; Post closes it before returning
 	require.Error(t, err)
 	assert.Nil(t, resp)
 
@@ -102,7 +103,7 @@ func TestPost_NonSuccess_IncludesBody(t *testing.T) {
 	}))
 	defer server.Close()
 
-	resp, err := Post(server.URL, "", map[string]string{}) //nolint:bodyclose // resp is nil on error; Post closes it before returning
+	resp, err := Post(context.Background(), server.URL, "", map[string]string{}) //nolint:bodyclose // resp is nil on error; Post closes it before returning
 	require.Error(t, err)
 	assert.Nil(t, resp)
 	assert.Contains(t, err.Error(), validationBody)
@@ -121,7 +122,7 @@ func TestPost_Unauthorized(t *testing.T) {
 	}))
 	defer server.Close()
 
-	resp, err := Post(server.URL, "", map[string]string{}) //nolint:bodyclose // resp is nil on error; Post closes it before returning
+	resp, err := Post(context.Background(), server.URL, "", map[string]string{}) //nolint:bodyclose // resp is nil on error; Post closes it before returning
 	require.Error(t, err)
 	assert.Nil(t, resp)
 
@@ -134,7 +135,7 @@ func TestPost_Unauthorized(t *testing.T) {
 func TestPost_NetworkError(t *testing.T) {
 	defer resetTokenForTest(t, "test-token")()
 
-	_, err := Post("http://127.0.0.1:1/does-not-exist", "", map[string]string{}) //nolint:bodyclose // network error path returns nil resp
+	_, err := Post(context.Background(), "http://127.0.0.1:1/does-not-exist", "", map[string]string{}) //nolint:bodyclose // network error path returns nil resp
 	require.Error(t, err)
 }
 
@@ -156,7 +157,7 @@ func TestPost_SetsHeaders(t *testing.T) {
 	}))
 	defer server.Close()
 
-	resp, err := Post(server.URL, "", map[string]string{})
+	resp, err := Post(context.Background(), server.URL, "", map[string]string{})
 	require.NoError(t, err)
 
 	defer resp.Body.Close()
@@ -183,7 +184,7 @@ func TestPost_SendsBody(t *testing.T) {
 
 	body := map[string]any{"name": "my-agent", "count": 3}
 
-	resp, err := Post(server.URL, "", body)
+	resp, err := Post(context.Background(), server.URL, "", body)
 	require.NoError(t, err)
 
 	defer resp.Body.Close()
@@ -207,7 +208,7 @@ func TestPostJSON_Decode(t *testing.T) {
 		Name string `json:"name"`
 	}
 
-	err := PostJSON(server.URL, "", map[string]string{}, &out)
+	err := PostJSON(context.Background(), server.URL, "", map[string]string{}, &out)
 	require.NoError(t, err)
 	assert.Equal(t, "abc", out.ID)
 	assert.Equal(t, "my-agent", out.Name)
@@ -224,6 +225,6 @@ func TestPostJSON_DecodeError(t *testing.T) {
 
 	var out struct{}
 
-	err := PostJSON(server.URL, "", map[string]string{}, &out)
+	err := PostJSON(context.Background(), server.URL, "", map[string]string{}, &out)
 	require.Error(t, err)
 }
@@ -15,6 +15,7 @@
 package drapi
 
 import (
+	"context"
 	"fmt"
 	"sort"
 	"strings"
@@ -121,7 +122,7 @@ func (tl TemplateList) SortByName() TemplateList {
 	return tl
 }
 
-func GetTemplates() (*TemplateList, error) {
+func GetTemplates(ctx context.Context) (*TemplateList, error) {
 	url, err := config.GetEndpointURL("/api/v2/applicationTemplates/?limit=100")
 	if err != nil {
 		return nil, err
@@ -134,7 +135,7 @@ func GetTemplates() (*TemplateList, error) {
 	for url != "" {
 		templateList = TemplateList{}
 
-		err = GetJSON(url, "templates", &templateList)
+		err = GetJSON(ctx, url, "templates", &templateList)
 		if err != nil {
 			return nil, err
 		}
@@ -157,8 +158,8 @@ func GetTemplates() (*TemplateList, error) {
 	return &templateList, nil
 }
 
-func GetPublicTemplatesSorted() (*TemplateList, error) {
-	templates, err := GetTemplates()
+func GetPublicTemplatesSorted(ctx context.Context) (*TemplateList, error) {
+	templates, err := GetTemplates(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -168,8 +169,8 @@ func GetPublicTemplatesSorted() (*TemplateList, error) {
 	return &result, nil
 }
 
-func GetTemplate(id string) (*Template, error) {
-	templates, err := GetTemplates()
+func GetTemplate(ctx context.Context, id string) (*Template, error) {
+	templates, err := GetTemplates(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,348 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// This file owns the retrying http.RoundTripper every client returned by
+// NewHTTPClient shares. It retries transient gateway failures (429, 502,
+// 503, 504) and dropped connections with bounded exponential backoff,
+// honours Retry-After, and never replays a request the server may already
+// have acted on.
+
+package drapi
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/datarobot/cli/internal/log"
+)
+
+// RetryPolicy bounds how often and how long the shared transport retries.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first.
+	// Values below 2 disable retries.
+	MaxAttempts int
+	// BaseDelay is the backoff before the second attempt; each later
+	// attempt doubles it.
+	BaseDelay time.Duration
+	// MaxDelay caps a single backoff. A Retry-After longer than this is
+	// not honoured: the response is returned to the caller instead of
+	// retrying early against the server's wishes.
+	MaxDelay time.Duration
+}
+
+// DefaultRetryPolicy keeps the worst-case added latency of a failing call
+// to a few seconds, well inside DefaultClientTimeout.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 4,
+	BaseDelay:   500 * time.Millisecond,
+	MaxDelay:    10 * time.Second,
+}
+
+var (
+	retryPolicyMu sync.RWMutex
+	retryPolicy   = DefaultRetryPolicy
+)
+
+// SetRetryPolicyForTest swaps the policy the shared transport uses and
+// returns a func restoring the previous one. Tests that count server hits
+// on a failing route, or that would otherwise sleep through real backoffs,
+// install a zero-delay or single-attempt policy with it.
+func SetRetryPolicyForTest(p RetryPolicy) func() {
+	retryPolicyMu.Lock()
+	defer retryPolicyMu.Unlock()
+
+	original := retryPolicy
+	retryPolicy = p
+
+	return func() {
+		retryPolicyMu.Lock()
+		defer retryPolicyMu.Unlock()
+
+		retryPolicy = original
+	}
+}
+
+func currentRetryPolicy() RetryPolicy {
+	retryPolicyMu.RLock()
+	defer retryPolicyMu.RUnlock()
+
+	return retryPolicy
+}
+
+// noRetryKey marks a context whose requests must not be retried.
+type noRetryKey struct{}
+
+// WithoutRetries returns a copy of ctx whose requests the shared transport
+// sends exactly once. Best-effort calls that run on every invocation, such
+// as telemetry's account lookup, use it so an unreachable server costs one
+// failed attempt rather than a full backoff schedule.
+func WithoutRetries(ctx context.Context) context.Context {
+	return context.WithValue(ctx, noRetryKey{}, true)
+}
+
+func retriesDisabled(ctx context.Context) bool {
+	disabled, _ := ctx.Value(noRetryKey{}).(bool)
+
+	return disabled
+}
+
+// sharedTransport is the RoundTripper behind every NewHTTPClient client.
+var sharedTransport http.RoundTripper = &retryTransport{}
+
+// retryTransport wraps base (http.DefaultTransport when nil) with retries.
+// base is resolved on every attempt rather than captured at construction
+// because --ca-cert and --skip-certificate-check replace
+// http.DefaultTransport after package init.
+type retryTransport struct {
+	base http.RoundTripper
+}
+
+func (t *retryTransport) baseTransport() http.RoundTripper {
+	if t.base != nil {
+		return t.base
+	}
+
+	return http.DefaultTransport
+}
+
+// RoundTrip sends req, retrying per the current RetryPolicy. Cancelling
+// req's context aborts both an in-flight attempt and a pending backoff.
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	policy := currentRetryPolicy()
+
+	attemptReq := req
+
+	for attempt := 1; ; attempt++ {
+		resp, err := t.baseTransport().RoundTrip(attemptReq)
+
+		wait, retry := retryDelay(req, resp, err, attempt, policy)
+		if !retry {
+			return resp, err
+		}
+
+		reason := describeFailure(resp, err)
+
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+			_ = resp.Body.Close()
+		}
+
+		log.Debugf("Retrying %s %s in %s after %s (attempt %d of %d)",
+			req.Method, req.URL.Redacted(), wait, reason, attempt+1, policy.MaxAttempts)
+
+		if err := sleepContext(req.Context(), wait); err != nil {
+			return nil, err
+		}
+
+		next, err := rewind(req)
+		if err != nil {
+			return nil, err
+		}
+
+		attemptReq = next
+	}
+}
+
+// retryDelay decides whether the outcome of attempt warrants another try
+// and, if so, how long to wait first. It refuses when attempts are used
+// up, when the caller opted out with WithoutRetries, when the body cannot
+// be replayed, when the failure is not one a retry can fix for this
+// method, or when the wait would outlive the request's deadline.
+func retryDelay(req *http.Request, resp *http.Response, err error, attempt int, policy RetryPolicy) (time.Duration, bool) {
+	ctx := req.Context()
+
+	if attempt >= policy.MaxAttempts || ctx.Err() != nil || retriesDisabled(ctx) || !replayable(req) {
+		return 0, false
+	}
+
+	var wait time.Duration
+
+	switch {
+	case err != nil:
+		// A dropped connection may have delivered the request, so only
+		// methods that are safe to repeat are retried.
+		if !idempotent(req) || !transientNetError(err) {
+			return 0, false
+		}
+	case !retryableStatus(req, resp):
+		return 0, false
+	default:
+		after, ok := retryAfter(resp, time.Now())
+		if ok && after > policy.MaxDelay {
+			return 0, false
+		}
+
+		wait = after
+	}
+
+	if wait == 0 {
+		wait = backoff(attempt, policy)
+	}
+
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < wait {
+		return 0, false
+	}
+
+	return wait, true
+}
+
+// retryableStatus reports whether resp is a failure worth repeating req for.
+// Idempotent requests retry on every gateway-style failure. Mutations only
+// retry when the server says it never processed them: 429 always means the
+// request was turned away, and a 503 carrying Retry-After is the server
+// explicitly asking for the same request later. A bare 502/503/504 on a
+// POST or PATCH is ambiguous (the backend may have committed the change
+// before the gateway gave up), so it is surfaced to the caller instead.
+func retryableStatus(req *http.Request, resp *http.Response) bool {
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusServiceUnavailable:
+		return idempotent(req) || resp.Header.Get("Retry-After") != ""
+	case http.StatusBadGateway, http.StatusGatewayTimeout:
+		return idempotent(req)
+	}
+
+	return false
+}
+
+// idempotent reports whether repeating req is harmless. The HTTP-idempotent
+// methods qualify, as does any request the caller marked with an
+// Idempotency-Key header.
+func idempotent(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+
+	return req.Header.Get("Idempotency-Key") != ""
+}
+
+// replayable reports whether req's body can be sent again. Streaming
+// uploads (see filesapi's multipart pipe) have no GetBody and are never
+// retried; the caller has to restart them from the source.
+func replayable(req *http.Request) bool {
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+// rewind returns a copy of req with a fresh body for the next attempt.
+func rewind(req *http.Request) (*http.Request, error) {
+	next := req.Clone(req.Context())
+
+	if req.GetBody == nil {
+		return next, nil
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+
+	next.Body = body
+
+	return next, nil
+}
+
+// transientNetError reports whether err is a connection-level failure that
+// a fresh attempt may not hit: a reset or refused connection, a timeout on
+// a single dial or read, or a connection closed mid-response. Cancellation
+// and TLS verification failures are not transient.
+func transientNetError(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	if errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF) {
+		return true
+	}
+
+	var netErr net.Error
+
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// retryAfter parses resp's Retry-After header, which is either a number of
+// seconds or an HTTP date. The bool is false when the header is absent or
+// unparseable.
+func retryAfter(resp *http.Response, now time.Time) (time.Duration, bool) {
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+
+	if secs, err := strconv.Atoi(value); err == nil {
+		if secs < 0 {
+			return 0, false
+		}
+
+		return time.Duration(secs) * time.Second, true
+	}
+
+	when, err := http.ParseTime(value)
+	if err != nil {
+		return 0, false
+	}
+
+	return max(when.Sub(now), 0), true
+}
+
+// backoff returns the delay before attempt+1: BaseDelay doubled per prior
+// attempt, capped at MaxDelay, with up to half of it randomised so a fleet
+// of CI jobs hitting the same outage does not retry in lockstep.
+func backoff(attempt int, policy RetryPolicy) time.Duration {
+	delay := policy.BaseDelay << (attempt - 1)
+	if delay <= 0 || delay > policy.MaxDelay {
+		delay = policy.MaxDelay
+	}
+
+	if half := int64(delay / 2); half > 0 {
+		delay = time.Duration(half + rand.Int64N(half+1)) //nolint:gosec // jitter, not a secret
+	}
+
+	return delay
+}
+
+func describeFailure(resp *http.Response, err error) string {
+	if err != nil {
+		return err.Error()
+	}
+
+	return resp.Status
+}
+
+// sleepContext waits for d, returning ctx's error early if it is cancelled.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,340 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package drapi
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fastRetries installs a policy with millisecond backoffs so retry tests do
+// not sleep through the production schedule.
+func fastRetries(t *testing.T) {
+	t.Helper()
+
+	t.Cleanup(SetRetryPolicyForTest(RetryPolicy{
+		MaxAttempts: 4,
+		BaseDelay:   time.Millisecond,
+		MaxDelay:    10 * time.Millisecond,
+	}))
+}
+
+// flakyServer answers the first failures requests with status (and
+// Retry-After, when set) and every later one with 200. It returns the server
+// and the number of requests it has seen.
+func flakyServer(t *testing.T, failures int32, status int, retryAfter string) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var calls atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if calls.Add(1) <= failures {
+			if retryAfter != "" {
+				w.Header().Set("Retry-After", retryAfter)
+			}
+
+			w.WriteHeader(status)
+
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &calls
+}
+
+func newRequest(t *testing.T, method, url, body string, header http.Header) *http.Request {
+	t.Helper()
+
+	var reader io.Reader
+	if body != "" {
+		reader = strings.NewReader(body)
+	}
+
+	req, err := http.NewRequestWithContext(context.Background(), method, url, reader)
+	require.NoError(t, err)
+
+	for k, v := range header {
+		req.Header[k] = v
+	}
+
+	return req
+}
+
+// send issues req through a NewHTTPClient client, closing any response when
+// the test ends.
+func send(t *testing.T, req *http.Request) (*http.Response, error) {
+	t.Helper()
+
+	resp, err := NewHTTPClient(DefaultClientTimeout).Do(req)
+	if resp != nil {
+		t.Cleanup(func() { _ = resp.Body.Close() })
+	}
+
+	return resp, err
+}
+
+func TestRetryTransport_GetRetriesGatewayErrors(t *testing.T) {
+	for _, status := range []int{http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout, http.StatusTooManyRequests} {
+		t.Run(http.StatusText(status), func(t *testing.T) {
+			fastRetries(t)
+
+			server, calls := flakyServer(t, 2, status, "")
+
+			resp, err := send(t, newRequest(t, http.MethodGet, server.URL, "", nil))
+			require.NoError(t, err)
+
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			assert.Equal(t, int32(3), calls.Load())
+		})
+	}
+}
+
+func TestRetryTransport_GivesUpAfterMaxAttempts(t *testing.T) {
+	fastRetries(t)
+
+	server, calls := flakyServer(t, 100, http.StatusBadGateway, "")
+
+	resp, err := send(t, newRequest(t, http.MethodGet, server.URL, "", nil))
+	require.NoError(t, err)
+
+	assert.Equal(t, http.StatusBadGateway, resp.StatusCode)
+	assert.Equal(t, int32(4), calls.Load())
+}
+
+func TestRetryTransport_DoesNotRetryClientErrors(t *testing.T) {
+	fastRetries(t)
+
+	server, calls := flakyServer(t, 1, http.StatusNotFound, "")
+
+	resp, err := send(t, newRequest(t, http.MethodGet, server.URL, "", nil))
+	require.NoError(t, err)
+
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+// A bare 502 on a POST may have come from a gateway that gave up after the
+// backend committed the change, so repeating it could create a duplicate.
+func TestRetryTransport_PostNotRetriedOnAmbiguousFailure(t *testing.T) {
+	for _, status := range []int{http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout} {
+		t.Run(http.StatusText(status), func(t *testing.T) {
+			fastRetries(t)
+
+			server, calls := flakyServer(t, 1, status, "")
+
+			resp, err := send(t, newRequest(t, http.MethodPost, server.URL, `{"name":"x"}`, nil))
+			require.NoError(t, err)
+
+			assert.Equal(t, status, resp.StatusCode)
+			assert.Equal(t, int32(1), calls.Load())
+		})
+	}
+}
+
+func TestRetryTransport_PostRetriedWhenServerRefusedIt(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     int
+		retryAfter string
+	}{
+		{name: "429", status: http.StatusTooManyRequests},
+		{name: "503 with Retry-After", status: http.StatusServiceUnavailable, retryAfter: "0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fastRetries(t)
+
+			server, calls := flakyServer(t, 1, tt.status, tt.retryAfter)
+
+			resp, err := send(t, newRequest(t, http.MethodPost, server.URL, `{"name":"x"}`, nil))
+			require.NoError(t, err)
+
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			assert.Equal(t, int32(2), calls.Load())
+		})
+	}
+}
+
+func TestRetryTransport_PostWithIdempotencyKeyRetried(t *testing.T) {
+	fastRetries(t)
+
+	server, calls := flakyServer(t, 1, http.StatusBadGateway, "")
+
+	resp, err := send(t, newRequest(t, http.MethodPost, server.URL, `{}`,
+		http.Header{"Idempotency-Key": {"abc"}}))
+	require.NoError(t, err)
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestRetryTransport_ReplaysBody(t *testing.T) {
+	fastRetries(t)
+
+	var bodies []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+
+		if len(bodies) == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	resp, err := send(t, newRequest(t, http.MethodPost, server.URL, `{"name":"x"}`, nil))
+	require.NoError(t, err)
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, []string{`{"name":"x"}`, `{"name":"x"}`}, bodies)
+}
+
+// A streaming body has no GetBody, so a retry would send an empty request.
+func TestRetryTransport_NonReplayableBodyNotRetried(t *testing.T) {
+	fastRetries(t)
+
+	server, calls := flakyServer(t, 1, http.StatusTooManyRequests, "")
+
+	pr, pw := io.Pipe()
+
+	go func() {
+		_, _ = pw.Write([]byte("chunk"))
+		_ = pw.Close()
+	}()
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPut, server.URL, pr)
+	require.NoError(t, err)
+
+	resp, err := NewHTTPClient(DefaultClientTimeout).Do(req)
+	require.NoError(t, err)
+
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestRetryTransport_WithoutRetries(t *testing.T) {
+	fastRetries(t)
+
+	server, calls := flakyServer(t, 1, http.StatusServiceUnavailable, "")
+
+	resp, err := send(t, newRequest(t, http.MethodGet, server.URL, "", nil).WithContext(WithoutRetries(context.Background())))
+	require.NoError(t, err)
+
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestRetryTransport_RetryAfterBeyondMaxDelayReturnsResponse(t *testing.T) {
+	fastRetries(t)
+
+	server, calls := flakyServer(t, 1, http.StatusTooManyRequests, "3600")
+
+	resp, err := send(t, newRequest(t, http.MethodGet, server.URL, "", nil))
+	require.NoError(t, err)
+
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestRetryTransport_CancelDuringBackoff(t *testing.T) {
+	// Long enough that only cancellation can end the wait, short enough to
+	// fit inside the client timeout (a wait past the deadline is refused).
+	t.Cleanup(SetRetryPolicyForTest(RetryPolicy{
+		MaxAttempts: 4,
+		BaseDelay:   10 * time.Second,
+		MaxDelay:    10 * time.Second,
+	}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+
+		// Cancel once the failure is on its way back, so the transport is
+		// in (or about to enter) its backoff when it happens.
+		time.AfterFunc(20*time.Millisecond, cancel)
+	}))
+	defer server.Close()
+
+	started := time.Now()
+
+	_, err := send(t, newRequest(t, http.MethodGet, server.URL, "", nil).WithContext(ctx)) //nolint:bodyclose // send closes any response
+	require.ErrorIs(t, err, context.Canceled)
+	assert.Less(t, time.Since(started), 5*time.Second)
+}
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		header string
+		want   time.Duration
+		ok     bool
+	}{
+		{name: "absent", header: "", ok: false},
+		{name: "seconds", header: "7", want: 7 * time.Second, ok: true},
+		{name: "negative", header: "-1", ok: false},
+		{name: "http date", header: now.Add(30 * time.Second).Format(http.TimeFormat), want: 30 * time.Second, ok: true},
+		{name: "date in the past", header: now.Add(-time.Hour).Format(http.TimeFormat), want: 0, ok: true},
+		{name: "garbage", header: "soon", ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &http.Response{Header: http.Header{}}
+			if tt.header != "" {
+				resp.Header.Set("Retry-After", tt.header)
+			}
+
+			got, ok := retryAfter(resp, now)
+
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestBackoff_GrowsAndIsCapped(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 10, BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second}
+
+	for attempt := 1; attempt <= 8; attempt++ {
+		want := min(policy.BaseDelay<<(attempt-1), policy.MaxDelay)
+		got := backoff(attempt, policy)
+
+		assert.GreaterOrEqual(t, got, want/2, "attempt %d", attempt)
+		assert.LessOrEqual(t, got, want, "attempt %d", attempt)
+	}
+}
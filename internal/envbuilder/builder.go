@@ -17,6 +17,7 @@ package envbuilder
 import (
 	"crypto/rand"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"maps"
 	"os"
@@ -30,15 +31,58 @@ import (
 type PromptType string
 
 const (
-	PromptTypeString      PromptType = "string"
-	PromptTypeSecret      PromptType = "secret_string"
-	GeneratedSecretLength int        = 32
+	PromptTypeString       PromptType = "string"
+	PromptTypeSecret       PromptType = "secret_string"
+	PromptTypeInt          PromptType = "int"
+	PromptTypeFloat        PromptType = "float"
+	PromptTypeBool         PromptType = "bool"
+	PromptTypeURL          PromptType = "url"
+	PromptTypePath         PromptType = "path"
+	PromptTypeEmail        PromptType = "email"
+	PromptTypeLLMGWCatalog PromptType = "llmgw_catalog"
+	GeneratedSecretLength  int        = 32
 )
 
+// PromptTypes lists every type a prompt file may name. "number" and
+// "boolean" predate int, float and bool and are still used by templates; they
+// are accepted and, like string, their values are not checked.
+var PromptTypes = []PromptType{
+	PromptTypeString,
+	PromptTypeSecret,
+	PromptTypeInt,
+	PromptTypeFloat,
+	PromptTypeBool,
+	PromptTypeURL,
+	PromptTypePath,
+	PromptTypeEmail,
+	PromptTypeLLMGWCatalog,
+	"number",
+	"boolean",
+}
+
 func (pt PromptType) String() string {
 	return string(pt)
 }
 
+// PromptSource names a DataRobot API listing whose entries a prompt offers as
+// options, storing the chosen entry's ID.
+type PromptSource string
+
+const (
+	PromptSourceLLMs                  PromptSource = "llms"
+	PromptSourceDeployments           PromptSource = "deployments"
+	PromptSourceCredentials           PromptSource = "credentials"
+	PromptSourceExecutionEnvironments PromptSource = "execution_environments"
+)
+
+// PromptSources lists every source a prompt file may name.
+var PromptSources = []PromptSource{
+	PromptSourceLLMs,
+	PromptSourceDeployments,
+	PromptSourceCredentials,
+	PromptSourceExecutionEnvironments,
+}
+
 // UserPrompt represents a configuration prompt that can be displayed to users
 // during the dotenv setup wizard. Prompts are defined in YAML files within the .datarobot
 // directory of a given template.
@@ -52,18 +96,32 @@ type UserPrompt struct {
 	Commented bool
 	// Value is the current value for this prompt (from .env, environment, or user input).
 	Value string
+	// Line is the .env file line Value was read from, or 0 when it came from elsewhere.
+	Line int `yaml:"-"`
 	// Hidden indicates if this prompt should never be shown to users (e.g., core variables).
 	Hidden bool
 	// Env is the environment variable name to set (e.g., "DATABASE_URL").
 	Env string `yaml:"env"`
 	// Key is an alternative identifier when Env is not set (written as comment).
 	Key string `yaml:"key"`
-	// Type is the prompt type: "string" (default) or "secret_string" (masked input).
+	// Type is the prompt type: "string" (default), "secret_string" (masked input),
+	// or one of "int", "float", "bool", "url", "path" and "email", whose values
+	// are checked by ValidateValue. Unknown types are treated as "string".
 	Type PromptType `yaml:"type"`
+	// Pattern is a regular expression the whole value must match.
+	Pattern string `yaml:"pattern,omitempty"`
+	// Min and Max bound the value of "int" and "float" prompts, inclusive.
+	Min *float64 `yaml:"min,omitempty"`
+	Max *float64 `yaml:"max,omitempty"`
+	// MustExist requires the file or directory named by a "path" prompt to exist.
+	MustExist bool `yaml:"must_exist,omitempty"`
 	// Multiple allows selecting multiple options (checkbox-style) when Options is set.
 	Multiple bool `yaml:"multiple"`
 	// Options provides a list of choices for selection-style prompts.
 	Options []PromptOption `yaml:"options,omitempty"`
+	// Source fills Options from the DataRobot API when the prompt is shown.
+	// It cannot be combined with Options.
+	Source PromptSource `yaml:"source,omitempty"`
 	// Default is the initial value for this prompt. Prompts with defaults are
 	// skipped during the wizard unless the value differs or AlwaysPrompt is set.
 	Default string `yaml:"default,omitempty"`
@@ -278,7 +336,16 @@ func gatherUserPrompts(rootDir string, variables Variables, includeEnv, synthesi
 	for _, yamlFile := range yamlFiles {
 		prompts, err := filePrompts(yamlFile)
 		if err != nil {
+			var constraintErr *ConstraintError
+
+			// A template with broken constraints must be fixed, not silently
+			// skipped: its values could not be checked otherwise.
+			if errors.As(err, &constraintErr) {
+				return nil, err
+			}
+
 			log.Debug(err)
+
 			continue
 		}
 
@@ -311,6 +378,10 @@ func filePrompts(yamlFile string) ([]UserPrompt, error) {
 		return nil, nil
 	}
 
+	if err := ValidatePromptConstraints(data); err != nil {
+		return nil, fmt.Errorf("Invalid prompt file %s: %w", yamlFile, err)
+	}
+
 	log.Infof("Parsing prompts from yaml file %s", yamlFile)
 
 	fileParsed, err := UnmarshalPromptFile(data)
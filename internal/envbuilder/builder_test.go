@@ -425,10 +425,6 @@ root:
     env: TEST_BOOLEAN
     type: boolean
     help: A boolean type
-  - key: test-unknown
-    env: TEST_UNKNOWN
-    type: some_unknown_type
-    help: An unknown type
 `
 
 	// Create a temporary YAML file
@@ -439,7 +435,7 @@ root:
 	// Parse the file
 	prompts, err := filePrompts(tmpFile)
 	suite.Require().NoError(err)
-	suite.Require().Len(prompts, 4, "Expected 4 prompts")
+	suite.Require().Len(prompts, 3, "Expected 3 prompts")
 
 	// Verify that Type field is preserved exactly as specified in YAML
 	suite.Equal(PromptTypeString, prompts[0].Type, "Known types work")
@@ -449,7 +445,14 @@ root:
 	suite.Equal(PromptType("string"), prompts[0].Type, "String type should be preserved")
 	suite.Equal(PromptType("secret_string"), prompts[1].Type, "Secret string type should be preserved")
 	suite.Equal(PromptType("boolean"), prompts[2].Type, "Boolean type should be preserved")
-	suite.Equal(PromptType("some_unknown_type"), prompts[3].Type, "Unknown type should be preserved")
+
+	// An unknown type is an error in the prompt file rather than a free-text prompt.
+	unknown := filepath.Join(suite.tempDir, ".datarobot", "test_unknown_type.yaml")
+	err = os.WriteFile(unknown, []byte("root:\n  - env: TEST_UNKNOWN\n    type: some_unknown_type\n"), 0o600)
+	suite.Require().NoError(err)
+
+	_, err = filePrompts(unknown)
+	suite.Require().ErrorContains(err, `unknown type "some_unknown_type"`)
 }
 
 func (suite *BuilderTestSuite) TestUserPromptMultilineHelpString() {
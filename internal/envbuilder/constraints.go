@@ -0,0 +1,181 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package envbuilder
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/mail"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ValidateValue checks value against the prompt's type, options and
+// constraints and returns an error describing the first mismatch. An empty
+// value always passes: whether a value is required is a separate question
+// (see Valid). The values of a Multiple prompt are checked one by one. Relative paths are
+// resolved against dir, which is normally the directory holding the .env file.
+func (up UserPrompt) ValidateValue(value, dir string) error {
+	if value == "" {
+		return nil
+	}
+
+	values := []string{value}
+
+	if up.Multiple {
+		values = strings.Split(value, ",")
+	}
+
+	for _, v := range values {
+		if err := up.validateOption(v); err != nil {
+			return err
+		}
+
+		if err := up.validateType(v, dir); err != nil {
+			return err
+		}
+
+		if err := up.validatePattern(v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (up UserPrompt) validateType(value, dir string) error {
+	switch up.Type {
+	case PromptTypeInt:
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return errors.New("must be a whole number")
+		}
+
+		return up.validateRange(float64(n))
+	case PromptTypeFloat:
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+			return errors.New("must be a number")
+		}
+
+		return up.validateRange(f)
+	case PromptTypeBool:
+		if _, err := strconv.ParseBool(value); err != nil {
+			return errors.New("must be true or false")
+		}
+	case PromptTypeURL:
+		if u, err := url.Parse(value); err != nil || u.Scheme == "" || u.Host == "" {
+			return errors.New("must be a URL with a scheme and host, such as https://example.com")
+		}
+	case PromptTypeEmail:
+		if addr, err := mail.ParseAddress(value); err != nil || addr.Address != value {
+			return errors.New("must be an email address")
+		}
+	case PromptTypePath:
+		return up.validatePath(value, dir)
+	}
+
+	return nil
+}
+
+// validateOption checks that value is one of the prompt's options, given by
+// value or by name. A prompt without options takes any value.
+func (up UserPrompt) validateOption(value string) error {
+	if len(up.Options) == 0 {
+		return nil
+	}
+
+	allowed := make([]string, 0, len(up.Options))
+
+	for _, option := range up.Options {
+		if value == option.Value || value == option.Name {
+			return nil
+		}
+
+		if option.Value != "" {
+			allowed = append(allowed, option.Value)
+		} else if option.Name != "" {
+			allowed = append(allowed, option.Name)
+		}
+	}
+
+	return fmt.Errorf("must be one of %s", strings.Join(allowed, ", "))
+}
+
+func (up UserPrompt) validateRange(f float64) error {
+	tooLow := up.Min != nil && f < *up.Min
+	tooHigh := up.Max != nil && f > *up.Max
+
+	if !tooLow && !tooHigh {
+		return nil
+	}
+
+	switch {
+	case up.Min != nil && up.Max != nil:
+		return fmt.Errorf("must be between %s and %s", formatBound(*up.Min), formatBound(*up.Max))
+	case tooLow:
+		return fmt.Errorf("must be at least %s", formatBound(*up.Min))
+	default:
+		return fmt.Errorf("must be at most %s", formatBound(*up.Max))
+	}
+}
+
+func (up UserPrompt) validatePath(value, dir string) error {
+	if !up.MustExist {
+		return nil
+	}
+
+	path := value
+	if !filepath.IsAbs(path) && dir != "" {
+		path = filepath.Join(dir, path)
+	}
+
+	if _, err := os.Stat(path); err != nil {
+		return fmt.Errorf("%s does not exist", value)
+	}
+
+	return nil
+}
+
+func (up UserPrompt) validatePattern(value string) error {
+	if up.Pattern == "" {
+		return nil
+	}
+
+	re, err := compilePattern(up.Pattern)
+	if err != nil {
+		return fmt.Errorf("pattern %q does not compile: %w", up.Pattern, err)
+	}
+
+	if !re.MatchString(value) {
+		return fmt.Errorf("must match the pattern %s", up.Pattern)
+	}
+
+	return nil
+}
+
+// compilePattern anchors pattern so that it has to match the whole value.
+func compilePattern(pattern string) (*regexp.Regexp, error) {
+	return regexp.Compile("^(?:" + pattern + ")$")
+}
+
+func formatBound(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
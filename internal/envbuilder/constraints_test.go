@@ -0,0 +1,253 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package envbuilder
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func bound(f float64) *float64 {
+	return &f
+}
+
+func TestUserPrompt_ValidateValue(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "creds.json"), []byte("{}"), 0o600))
+
+	port := UserPrompt{Type: PromptTypeInt, Min: bound(1), Max: bound(65535)}
+	llm := UserPrompt{Options: []PromptOption{{Name: "Gateway", Value: "gw"}, {Name: "Deployed"}}}
+
+	tests := []struct {
+		name   string
+		prompt UserPrompt
+		value  string
+		want   string
+	}{
+		{"empty value passes", port, "", ""},
+		{"int in range", port, "8080", ""},
+		{"int not a number", port, "80a", "must be a whole number"},
+		{"int out of range", port, "0", "must be between 1 and 65535"},
+		{"float at least", UserPrompt{Type: PromptTypeFloat, Min: bound(0.5)}, "0.25", "must be at least 0.5"},
+		{"float at most", UserPrompt{Type: PromptTypeFloat, Max: bound(1)}, "0.75", ""},
+		{"float not finite", UserPrompt{Type: PromptTypeFloat}, "NaN", "must be a number"},
+		{"bool", UserPrompt{Type: PromptTypeBool}, "yes", "must be true or false"},
+		{"url", UserPrompt{Type: PromptTypeURL}, "https://example.com/api", ""},
+		{"url without host", UserPrompt{Type: PromptTypeURL}, "example.com", "must be a URL"},
+		{"url with pattern", UserPrompt{Type: PromptTypeURL, Pattern: `https://.*`}, "http://example.com", "must match the pattern https://.*"},
+		{"email", UserPrompt{Type: PromptTypeEmail}, "me@example.com", ""},
+		{"email with name", UserPrompt{Type: PromptTypeEmail}, "Me <me@example.com>", "must be an email address"},
+		{"path relative to dir", UserPrompt{Type: PromptTypePath, MustExist: true}, "creds.json", ""},
+		{"path missing", UserPrompt{Type: PromptTypePath, MustExist: true}, "nope.json", "nope.json does not exist"},
+		{"path need not exist", UserPrompt{Type: PromptTypePath}, "nope.json", ""},
+		{"pattern matches whole value", UserPrompt{Pattern: `[a-z]+`}, "abc1", "must match"},
+		{"multiple checks each value", UserPrompt{Type: PromptTypeInt, Multiple: true}, "1,x", "must be a whole number"},
+		{"legacy number type is not checked", UserPrompt{Type: "number"}, "thirty", ""},
+		{"option by value", llm, "gw", ""},
+		{"option by name", llm, "Deployed", ""},
+		{"not an option", llm, "other", "must be one of gw, Deployed"},
+		{"multiple checks each option", UserPrompt{Options: llm.Options, Multiple: true}, "gw,other", "must be one of gw, Deployed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.prompt.ValidateValue(tt.value, dir)
+
+			if tt.want == "" {
+				assert.NoError(t, err)
+
+				return
+			}
+
+			assert.ErrorContains(t, err, tt.want)
+		})
+	}
+}
+
+func TestPromptFileSchema_Constraints(t *testing.T) {
+	tests := []struct {
+		name string
+		yaml string
+		want string
+	}{
+		{
+			name: "valid constraints",
+			yaml: `
+root:
+  - env: PORT
+    type: int
+    min: 1
+    max: 65535
+  - env: CREDENTIALS
+    type: path
+    must_exist: true
+    pattern: .*\.json
+  - env: LLM_ID
+    source: llms
+`,
+		},
+		{
+			name: "pattern does not compile",
+			yaml: `
+root:
+  - env: NAME
+    help: A name.
+    pattern: "[a-z"
+`,
+			want: `invalid prompt at index 0: line 5: pattern "[a-z" does not compile`,
+		},
+		{
+			name: "min is not a number",
+			yaml: `
+root:
+  - env: PORT
+  - env: TIMEOUT
+    type: float
+    min: soon
+`,
+			want: "invalid prompt at index 1: line 6: min must be a number",
+		},
+		{
+			name: "min above max",
+			yaml: `
+root:
+  - env: PORT
+    type: int
+    min: 10
+    max: 1
+`,
+			want: "line 5: min is greater than max",
+		},
+		{
+			name: "max on a string prompt",
+			yaml: `
+root:
+  - env: NAME
+    max: 10
+`,
+			want: "line 4: max applies only to int and float prompts",
+		},
+		{
+			name: "must_exist on a string prompt",
+			yaml: `
+root:
+  - env: NAME
+    must_exist: true
+`,
+			want: "line 4: must_exist applies only to path prompts",
+		},
+		{
+			name: "unknown type",
+			yaml: `
+root:
+  - env: PORT
+    type: integer
+`,
+			want: `line 4: unknown type "integer"; expected one of string, secret_string, int, float, bool, url, path, email, llmgw_catalog, number, boolean`,
+		},
+		{
+			name: "unknown source",
+			yaml: `
+root:
+  - env: DEPLOYMENT_ID
+    source: models
+`,
+			want: `line 4: unknown source "models"; expected one of llms, deployments, credentials, execution_environments`,
+		},
+		{
+			name: "source with options",
+			yaml: `
+root:
+  - env: DEPLOYMENT_ID
+    source: deployments
+    options:
+      - name: One
+`,
+			want: "line 5: options cannot be combined with source",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidatePromptConstraints([]byte(tt.yaml))
+
+			if tt.want == "" {
+				require.NoError(t, err)
+
+				return
+			}
+
+			require.ErrorContains(t, err, tt.want)
+
+			var constraintErr *ConstraintError
+
+			assert.True(t, errors.As(err, &constraintErr))
+			assert.True(t, ValidateAndSkipNonPromptFiles([]byte(tt.yaml)), "a prompt file with a broken constraint is not skipped")
+		})
+	}
+}
+
+func TestGatherUserPrompts_InvalidConstraint(t *testing.T) {
+	dir := t.TempDir()
+	promptsDir := filepath.Join(dir, ".datarobot")
+
+	require.NoError(t, os.MkdirAll(promptsDir, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(promptsDir, "prompts.yaml"), []byte("root:\n  - env: PORT\n    min: 1\n"), 0o600))
+
+	_, err := GatherUserPrompts(dir, nil)
+
+	require.ErrorContains(t, err, "prompts.yaml: invalid prompt at index 0: line 3: min applies only to int and float prompts")
+}
+
+func TestValidateEnvironment_InvalidValueLine(t *testing.T) {
+	dir := t.TempDir()
+	promptsDir := filepath.Join(dir, ".datarobot")
+
+	require.NoError(t, os.MkdirAll(promptsDir, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(promptsDir, "prompts.yaml"), []byte(`
+root:
+  - env: APP_PORT
+    type: int
+    min: 1
+    max: 65535
+    help: The port to listen on.
+`), 0o600))
+
+	variables := ParseVariablesOnly([]string{
+		"# Application settings\n",
+		"APP_NAME=\"demo\"\n",
+		"APP_PORT=\"99999\"\n",
+	})
+
+	result := ValidateEnvironment(dir, variables)
+
+	var portResult ValidationResult
+
+	for _, r := range result.Results {
+		if r.Field == "APP_PORT" {
+			portResult = r
+		}
+	}
+
+	assert.False(t, portResult.Valid)
+	assert.Equal(t, 3, portResult.Line)
+	assert.Equal(t, "Invalid value: must be between 1 and 65535.", portResult.Message)
+	assert.Contains(t, result.Error(), "  - APP_PORT (line 3): Invalid value: must be between 1 and 65535.")
+}
@@ -31,6 +31,8 @@ type Variable struct {
 	Description string
 	Secret      bool
 	Commented   bool
+	// Line is the 1-based line ParseVariablesOnly read the variable from, or 0.
+	Line int
 }
 
 type Variables []Variable
@@ -67,8 +69,9 @@ func ParseVariablesOnly(dotenvLines []string) []Variable {
 	unquotedValues, _ := godotenv.Unmarshal(strings.Join(dotenvLines, "\n"))
 	variables := make([]Variable, 0)
 
-	for _, templateLine := range dotenvLines {
+	for i, templateLine := range dotenvLines {
 		v := NewFromLine(templateLine, unquotedValues)
+		v.Line = i + 1
 
 		if v.Name != "" {
 			variables = append(variables, v)
@@ -17,6 +17,9 @@ package envbuilder
 import (
 	"errors"
 	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 
 	"github.com/datarobot/cli/internal/log"
 	"gopkg.in/yaml.v3"
@@ -26,6 +29,13 @@ const (
 	fieldEnv     = "env"
 	fieldKey     = "key"
 	fieldHelp    = "help"
+	fieldType    = "type"
+	fieldPattern = "pattern"
+	fieldMin     = "min"
+	fieldMax     = "max"
+	fieldExist   = "must_exist"
+	fieldSource  = "source"
+	fieldOptions = "options"
 	yamlMapping  = "mapping"
 	yamlSequence = "sequence"
 )
@@ -38,9 +48,24 @@ type ParsedYaml map[string][]UserPrompt
 // - Root must be a mapping (sections)
 // - Each section value must be a sequence of mappings (prompts)
 // - Each prompt must have at least one of: env or key
-// - Each prompt must have a help field.
+// - Each prompt must have a help field
+// - Each prompt's constraint keys must be enforceable (see ConstraintError).
 type PromptFileSchema struct{}
 
+// ConstraintError reports a prompt whose constraint keys cannot be enforced,
+// such as a pattern that does not compile or min on a string prompt. Unlike a
+// file that is not shaped like a prompt file at all, a file with such a prompt
+// is reported rather than skipped.
+type ConstraintError struct {
+	// Line is the line of the offending key in the prompt file.
+	Line    int
+	Message string
+}
+
+func (e *ConstraintError) Error() string {
+	return fmt.Sprintf("line %d: %s", e.Line, e.Message)
+}
+
 // Validate checks if the provided YAML node conforms to the prompt file schema.
 // Returns nil if valid, or an error describing validation failures.
 func (s *PromptFileSchema) Validate(doc *yaml.Node) error {
@@ -125,6 +150,78 @@ func (s *PromptFileSchema) validatePromptYaml(promptNode *yaml.Node) error {
 		log.Info("prompt is missing recommended 'help' field for user guidance")
 	}
 
+	return s.validateConstraints(promptNode)
+}
+
+// validateConstraints checks that a prompt's type is known, that its pattern
+// compiles, that min and max are numbers in order on a numeric prompt, that
+// must_exist is only set on a path prompt, and that source names a known
+// source and comes without options.
+func (s *PromptFileSchema) validateConstraints(promptNode *yaml.Node) error { //nolint:cyclop,gocognit
+	var (
+		promptType                 PromptType
+		minNode, maxNode, mustNode *yaml.Node
+		sourceNode, optionsNode    *yaml.Node
+		minValue, maxValue         float64
+	)
+
+	// mapping content alternates key, value, key, value...
+	for i := 0; i+1 < len(promptNode.Content); i += 2 {
+		keyNode, valueNode := promptNode.Content[i], promptNode.Content[i+1]
+
+		switch keyNode.Value {
+		case fieldType:
+			promptType = PromptType(valueNode.Value)
+
+			if !slices.Contains(PromptTypes, promptType) {
+				return &ConstraintError{Line: keyNode.Line, Message: fmt.Sprintf("unknown type %q; expected one of %s", valueNode.Value, joinTypes())}
+			}
+		case fieldPattern:
+			if _, err := compilePattern(valueNode.Value); err != nil {
+				return &ConstraintError{Line: keyNode.Line, Message: fmt.Sprintf("pattern %q does not compile: %v", valueNode.Value, err)}
+			}
+		case fieldMin, fieldMax:
+			f, err := strconv.ParseFloat(valueNode.Value, 64)
+			if err != nil || valueNode.Kind != yaml.ScalarNode {
+				return &ConstraintError{Line: keyNode.Line, Message: keyNode.Value + " must be a number"}
+			}
+
+			if keyNode.Value == fieldMin {
+				minNode, minValue = keyNode, f
+			} else {
+				maxNode, maxValue = keyNode, f
+			}
+		case fieldExist:
+			mustNode = keyNode
+		case fieldSource:
+			if !slices.Contains(PromptSources, PromptSource(valueNode.Value)) {
+				return &ConstraintError{Line: keyNode.Line, Message: fmt.Sprintf("unknown source %q; expected one of %s", valueNode.Value, joinSources())}
+			}
+
+			sourceNode = keyNode
+		case fieldOptions:
+			optionsNode = keyNode
+		}
+	}
+
+	if sourceNode != nil && optionsNode != nil {
+		return &ConstraintError{Line: optionsNode.Line, Message: "options cannot be combined with source"}
+	}
+
+	for _, node := range []*yaml.Node{minNode, maxNode} {
+		if node != nil && promptType != PromptTypeInt && promptType != PromptTypeFloat {
+			return &ConstraintError{Line: node.Line, Message: node.Value + " applies only to int and float prompts"}
+		}
+	}
+
+	if minNode != nil && maxNode != nil && minValue > maxValue {
+		return &ConstraintError{Line: minNode.Line, Message: "min is greater than max"}
+	}
+
+	if mustNode != nil && promptType != PromptTypePath {
+		return &ConstraintError{Line: mustNode.Line, Message: "must_exist applies only to path prompts"}
+	}
+
 	return nil
 }
 
@@ -157,6 +254,26 @@ func (s *PromptFileSchema) extractPromptFields(promptNode *yaml.Node) promptFiel
 	return fields
 }
 
+func joinTypes() string {
+	names := make([]string, len(PromptTypes))
+
+	for i, promptType := range PromptTypes {
+		names[i] = string(promptType)
+	}
+
+	return strings.Join(names, ", ")
+}
+
+func joinSources() string {
+	names := make([]string, len(PromptSources))
+
+	for i, source := range PromptSources {
+		names[i] = string(source)
+	}
+
+	return strings.Join(names, ", ")
+}
+
 // UnmarshalPromptFile unmarshals YAML data into a ParsedYaml map.
 func UnmarshalPromptFile(data []byte) (ParsedYaml, error) {
 	var fileParsed ParsedYaml
@@ -186,8 +303,36 @@ func ValidateAndSkipNonPromptFiles(data []byte) bool {
 
 	schema := &PromptFileSchema{}
 	if err := schema.Validate(root.Content[0]); err != nil {
-		return false
+		// a prompt file with an unenforceable constraint: let
+		// ValidatePromptConstraints surface the error
+		var constraintErr *ConstraintError
+
+		return errors.As(err, &constraintErr)
 	}
 
 	return true
 }
+
+// ValidatePromptConstraints returns the *ConstraintError, wrapped with the
+// prompt's position, of the first prompt in a prompt file whose constraints
+// cannot be enforced, or nil when there is none. Other schema violations are
+// left to ValidateAndSkipNonPromptFiles.
+func ValidatePromptConstraints(data []byte) error {
+	var root yaml.Node
+
+	if err := yaml.Unmarshal(data, &root); err != nil || len(root.Content) == 0 {
+		return nil
+	}
+
+	schema := &PromptFileSchema{}
+
+	err := schema.Validate(root.Content[0])
+
+	var constraintErr *ConstraintError
+
+	if errors.As(err, &constraintErr) {
+		return err
+	}
+
+	return nil
+}
@@ -17,6 +17,7 @@ package envbuilder
 import (
 	"os"
 	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/datarobot/cli/internal/config/viperx"
@@ -29,6 +30,7 @@ type ValidationResult struct {
 	Valid   bool   // Whether the variable is valid
 	Message string // Error message if invalid, or success message if valid
 	Help    string // Optional help text describing the variable
+	Line    int    // The .env file line the value was read from, 0 if not from the file
 }
 
 // EnvironmentValidationError contains the results of validating environment configuration.
@@ -61,6 +63,13 @@ func (r EnvironmentValidationError) Error() string {
 		if !result.Valid {
 			builder.WriteString("  - ")
 			builder.WriteString(result.Field)
+
+			if result.Line > 0 {
+				builder.WriteString(" (line ")
+				builder.WriteString(strconv.Itoa(result.Line))
+				builder.WriteString(")")
+			}
+
 			builder.WriteString(": ")
 			builder.WriteString(result.Message)
 
@@ -84,7 +93,8 @@ func (r EnvironmentValidationError) Error() string {
 // The validation process:
 // 1. Determines which sections are active based on requires dependencies
 // 2. Validates all required UserPrompts in active sections
-// 3. Validates core DataRobot variables (DATAROBOT_ENDPOINT, DATAROBOT_API_TOKEN).
+// 3. Checks every set value against its prompt's type and constraints
+// 4. Validates core DataRobot variables (DATAROBOT_ENDPOINT, DATAROBOT_API_TOKEN).
 func ValidateEnvironment(repoRoot string, variables Variables) EnvironmentValidationError {
 	return validateEnvironment(repoRoot, variables, true, true)
 }
@@ -120,7 +130,7 @@ func validateEnvironment(repoRoot string, variables Variables, includeEnv, synth
 	}
 
 	// Validate required prompts
-	validatePrompts(&result, userPrompts)
+	validatePrompts(&result, userPrompts, repoRoot)
 
 	return result
 }
@@ -201,6 +211,7 @@ func resolvePromptValue(prompt UserPrompt, variables Variables, includeEnv, synt
 		// .env file value overrides viper config
 		prompt.Value = v.Value
 		prompt.Commented = v.Commented
+		prompt.Line = v.Line
 
 		return prompt
 	}
@@ -308,10 +319,29 @@ func isOptionSelected(option PromptOption, selectedValues []string) bool {
 	return slices.Contains(selectedValues, option.Name)
 }
 
-// validatePrompts validates all required prompts in active sections.
-func validatePrompts(result *EnvironmentValidationError, userPrompts []UserPrompt) {
+// validatePrompts validates all required prompts in active sections, and the
+// values of all set prompts against their types and constraints. Relative
+// paths are resolved against repoRoot.
+func validatePrompts(result *EnvironmentValidationError, userPrompts []UserPrompt, repoRoot string) {
 	for _, prompt := range userPrompts {
-		if !prompt.Active || prompt.Optional {
+		if !prompt.Active {
+			continue
+		}
+
+		if err := prompt.ValidateValue(prompt.Value, repoRoot); err != nil {
+			result.Results = append(result.Results, ValidationResult{
+				Field:   prompt.Env,
+				Value:   prompt.Value,
+				Valid:   false,
+				Message: "Invalid value: " + err.Error() + ".",
+				Help:    prompt.Help,
+				Line:    prompt.Line,
+			})
+
+			continue
+		}
+
+		if prompt.Optional {
 			continue
 		}
 
@@ -361,7 +361,7 @@ func TestValidatePrompts(t *testing.T) {
 			Results: make([]ValidationResult, 0),
 		}
 
-		validatePrompts(result, prompts)
+		validatePrompts(result, prompts, "")
 
 		if len(result.Results) != 1 {
 			t.Errorf("Expected 1 result, got %d", len(result.Results))
@@ -385,7 +385,7 @@ func TestValidatePrompts(t *testing.T) {
 			Results: make([]ValidationResult, 0),
 		}
 
-		validatePrompts(result, prompts)
+		validatePrompts(result, prompts, "")
 
 		if len(result.Results) != 0 {
 			t.Errorf("Expected 0 results for optional prompt, got %d", len(result.Results))
@@ -407,7 +407,7 @@ func TestValidatePrompts(t *testing.T) {
 			Results: make([]ValidationResult, 0),
 		}
 
-		validatePrompts(result, prompts)
+		validatePrompts(result, prompts, "")
 
 		if len(result.Results) != 1 {
 			t.Fatalf("Expected 1 result, got %d", len(result.Results))
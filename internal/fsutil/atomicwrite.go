@@ -84,44 +84,75 @@ func targetMode(path, probePath string) (os.FileMode, error) {
 // is fsynced after rename so the new dentry survives a crash. The temp file
 // is removed on any failure before rename so no .tmp.* leftovers remain.
 func AtomicWriteFile(path string, data []byte) (err error) {
-	dir := filepath.Dir(path)
+	tmpPath, err := writeTemp(path, data)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err != nil {
+			_ = os.Remove(tmpPath)
+		}
+	}()
 
-	tmp, err := os.CreateTemp(dir, filepath.Base(path)+".tmp.*")
+	// The umask probe borrows the temp file's name so that two writers racing
+	// for the same destination cannot collide on it. targetMode removes it
+	// before returning.
+	mode, err := targetMode(path, tmpPath+".mode")
 	if err != nil {
-		return fmt.Errorf("create temp file for %s: %w", path, err)
+		return err
+	}
+
+	return renameIntoPlace(tmpPath, path, filepath.Dir(path), mode)
+}
+
+// AtomicWriteFileMode is AtomicWriteFile for callers that need a specific
+// mode, such as an executable: path ends up with mode whether it existed
+// before or not.
+func AtomicWriteFileMode(path string, data []byte, mode os.FileMode) (err error) {
+	tmpPath, err := writeTemp(path, data)
+	if err != nil {
+		return err
 	}
 
 	defer func() {
 		if err != nil {
-			_ = os.Remove(tmp.Name())
+			_ = os.Remove(tmpPath)
 		}
 	}()
 
+	return renameIntoPlace(tmpPath, path, filepath.Dir(path), mode)
+}
+
+// writeTemp writes data to a synced sibling temp file of path and returns
+// its name. The temp file is removed if writing it fails.
+func writeTemp(path string, data []byte) (string, error) {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp.*")
+	if err != nil {
+		return "", fmt.Errorf("create temp file for %s: %w", path, err)
+	}
+
 	if _, err = tmp.Write(data); err != nil {
 		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
 
-		return fmt.Errorf("write temp file %s: %w", tmp.Name(), err)
+		return "", fmt.Errorf("write temp file %s: %w", tmp.Name(), err)
 	}
 
 	if err = tmp.Sync(); err != nil {
 		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
 
-		return fmt.Errorf("sync temp file %s: %w", tmp.Name(), err)
+		return "", fmt.Errorf("sync temp file %s: %w", tmp.Name(), err)
 	}
 
 	if err = tmp.Close(); err != nil {
-		return fmt.Errorf("close temp file %s: %w", tmp.Name(), err)
-	}
+		_ = os.Remove(tmp.Name())
 
-	// The umask probe borrows the temp file's name so that two writers racing
-	// for the same destination cannot collide on it. targetMode removes it
-	// before returning.
-	mode, err := targetMode(path, tmp.Name()+".mode")
-	if err != nil {
-		return err
+		return "", fmt.Errorf("close temp file %s: %w", tmp.Name(), err)
 	}
 
-	return renameIntoPlace(tmp.Name(), path, dir, mode)
+	return tmp.Name(), nil
 }
 
 // renameIntoPlace sets the temp file's mode, clears any read-only attribute
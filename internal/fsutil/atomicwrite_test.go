@@ -112,6 +112,29 @@ func TestAtomicWriteFile_PreservesAnExistingMode(t *testing.T) {
 	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
 }
 
+// AtomicWriteFileMode sets the mode it is given on a new file and on a
+// replaced one alike, so an updated executable stays executable.
+func TestAtomicWriteFileMode_SetsTheMode(t *testing.T) {
+	dir := t.TempDir()
+
+	created := filepath.Join(dir, "new")
+	require.NoError(t, AtomicWriteFileMode(created, []byte("#!/bin/sh\n"), 0o755))
+
+	replaced := filepath.Join(dir, "existing")
+	require.NoError(t, os.WriteFile(replaced, []byte("old"), 0o600))
+	require.NoError(t, AtomicWriteFileMode(replaced, []byte("new"), 0o755))
+
+	for _, path := range []string{created, replaced} {
+		info, err := os.Stat(path)
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(0o755), info.Mode().Perm(), path)
+	}
+
+	got, err := os.ReadFile(replaced)
+	require.NoError(t, err)
+	assert.Equal(t, "new", string(got))
+}
+
 func TestAtomicWriteFile_OverwritesExisting(t *testing.T) {
 	tmp := t.TempDir()
 	target := filepath.Join(tmp, "existing.json")
@@ -0,0 +1,230 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package httprecord records HTTP sessions to a HAR file and replays them.
+// Recorder and Replayer are http.RoundTrippers; the root command installs
+// one in front of http.DefaultTransport for --record-http and --replay-http,
+// so every DataRobot API call the CLI makes, whichever package makes it, is
+// captured or served from the file. Credentials and secret body fields are
+// redacted before anything is written.
+package httprecord
+
+import (
+	"encoding/base64"
+	"mime"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/datarobot/cli/internal/config"
+)
+
+// HAR is the subset of the HTTP Archive 1.2 format recordings use. Browsers
+// and HAR viewers open the files; Replayer reads them back.
+type HAR struct {
+	Log HARLog `json:"log"`
+}
+
+type HARLog struct {
+	Version string     `json:"version"`
+	Creator HARCreator `json:"creator"`
+	Entries []Entry    `json:"entries"`
+}
+
+type HARCreator struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// Entry is one request and its response. A request that failed without a
+// response has a zero Response and the failure in Error.
+type Entry struct {
+	StartedDateTime time.Time `json:"startedDateTime"`
+	// Time is how long the response headers took, in milliseconds.
+	Time     float64  `json:"time"`
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+	Cache    struct{} `json:"cache"`
+	Timings  Timings  `json:"timings"`
+	Error    string   `json:"_error,omitempty"`
+}
+
+type Request struct {
+	Method      string      `json:"method"`
+	URL         string      `json:"url"`
+	HTTPVersion string      `json:"httpVersion"`
+	Headers     []NameValue `json:"headers"`
+	QueryString []NameValue `json:"queryString"`
+	PostData    *PostData   `json:"postData,omitempty"`
+	HeadersSize int         `json:"headersSize"`
+	BodySize    int         `json:"bodySize"`
+}
+
+type PostData struct {
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text"`
+	Encoding string `json:"_encoding,omitempty"`
+}
+
+type Response struct {
+	Status      int         `json:"status"`
+	StatusText  string      `json:"statusText"`
+	HTTPVersion string      `json:"httpVersion"`
+	Headers     []NameValue `json:"headers"`
+	Content     Content     `json:"content"`
+	RedirectURL string      `json:"redirectURL"`
+	HeadersSize int         `json:"headersSize"`
+	BodySize    int         `json:"bodySize"`
+}
+
+// Content is a response body. Text is base64 when Encoding says so, which
+// recordings use for bodies that are not UTF-8.
+type Content struct {
+	Size     int    `json:"size"`
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text"`
+	Encoding string `json:"encoding,omitempty"`
+	// Truncated marks a body cut at maxBodySize.
+	Truncated bool `json:"_truncated,omitempty"`
+}
+
+type Timings struct {
+	Send    float64 `json:"send"`
+	Wait    float64 `json:"wait"`
+	Receive float64 `json:"receive"`
+}
+
+type NameValue struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// redactedHeaders carry credentials. Their values are never written.
+var redactedHeaders = map[string]bool{
+	"Authorization":       true,
+	"Proxy-Authorization": true,
+	"Cookie":              true,
+	"Set-Cookie":          true,
+}
+
+const redacted = "[REDACTED]"
+
+func harHeaders(header http.Header) []NameValue {
+	headers := make([]NameValue, 0, len(header))
+
+	for name, values := range header {
+		for _, value := range values {
+			if redactedHeaders[http.CanonicalHeaderKey(name)] {
+				value = redacted
+			}
+
+			headers = append(headers, NameValue{Name: name, Value: value})
+		}
+	}
+
+	sortPairs(headers)
+
+	return headers
+}
+
+// harQuery lists the query parameters of u, which redactURL has already
+// been applied to.
+func harQuery(u *url.URL) []NameValue {
+	query := u.Query()
+	pairs := make([]NameValue, 0, len(query))
+
+	for name, values := range query {
+		for _, value := range values {
+			pairs = append(pairs, NameValue{Name: name, Value: value})
+		}
+	}
+
+	sortPairs(pairs)
+
+	return pairs
+}
+
+// sortPairs orders pairs by name so the same session always writes the same file.
+func sortPairs(pairs []NameValue) {
+	slices.SortStableFunc(pairs, func(a, b NameValue) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+}
+
+// redactURL returns u with the value of every secret-named query parameter
+// redacted, by the names config.RedactSecretFields uses. The Replayer keys on
+// the redacted URL too, so a recording still matches the live request.
+func redactURL(u *url.URL) *url.URL {
+	query, changed := redactForm(u.RawQuery)
+	if !changed {
+		return u
+	}
+
+	out := *u
+	out.RawQuery = query
+
+	return &out
+}
+
+// redactForm redacts the secret-named fields of a URL-encoded query or form
+// body. The fields keep their order and encoding, so the result is the
+// original string wherever nothing was redacted.
+func redactForm(form string) (string, bool) {
+	if form == "" {
+		return form, false
+	}
+
+	fields := strings.Split(form, "&")
+	changed := false
+
+	for i, field := range fields {
+		name, _, _ := strings.Cut(field, "=")
+
+		if unescaped, err := url.QueryUnescape(name); err == nil && config.IsSecretField(unescaped) {
+			fields[i] = name + "=" + url.QueryEscape(redacted)
+			changed = true
+		}
+	}
+
+	return strings.Join(fields, "&"), changed
+}
+
+// encodeBody returns body as HAR text: redacted when it is UTF-8 text,
+// base64 otherwise, with the encoding to record. mimeType picks how it is
+// redacted: form fields for a URL-encoded form, JSON fields otherwise.
+func encodeBody(body []byte, mimeType string) (string, string) {
+	if utf8.Valid(body) {
+		text := string(body)
+
+		if mediaType, _, _ := mime.ParseMediaType(mimeType); mediaType == "application/x-www-form-urlencoded" {
+			text, _ = redactForm(text)
+		}
+
+		return config.RedactSecretFields(text), ""
+	}
+
+	return base64.StdEncoding.EncodeToString(body), "base64"
+}
+
+// decodeBody reverses encodeBody.
+func decodeBody(text, encoding string) ([]byte, error) {
+	if encoding == "base64" {
+		return base64.StdEncoding.DecodeString(text)
+	}
+
+	return []byte(text), nil
+}
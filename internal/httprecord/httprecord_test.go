@@ -0,0 +1,218 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httprecord
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newAPI serves a few routes the way the DataRobot API would.
+func newAPI(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	polls := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/credentials/":
+			body, _ := io.ReadAll(r.Body)
+			w.Header().Set("Content-Type", "application/json")
+			w.Header().Set("Set-Cookie", "session=abc")
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id": "cred-1", "echo": ` + string(body) + `}`))
+		case "/api/v2/workloads/w1/":
+			polls++
+			_, _ = w.Write([]byte(`{"status": "poll-` + strconv.Itoa(polls) + `"}`))
+		case "/api/v2/blob/":
+			_, _ = w.Write([]byte{0xff, 0x00, 0xfe})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func do(t *testing.T, rt http.RoundTripper, method, url, body string) (*http.Response, string) {
+	t.Helper()
+
+	req, err := http.NewRequestWithContext(context.Background(), method, url, strings.NewReader(body))
+	require.NoError(t, err)
+
+	req.Header.Set("Authorization", "Bearer secret-token")
+
+	resp, err := (&http.Client{Transport: rt}).Do(req)
+	require.NoError(t, err)
+
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	return resp, string(data)
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	server := newAPI(t)
+	recorder := &Recorder{Base: http.DefaultTransport}
+
+	_, created := do(t, recorder, http.MethodPost, server.URL+"/api/v2/credentials/", `{"name": "db", "password": "hunter2"}`)
+	_, first := do(t, recorder, http.MethodGet, server.URL+"/api/v2/workloads/w1/", "")
+	_, second := do(t, recorder, http.MethodGet, server.URL+"/api/v2/workloads/w1/", "")
+	_, blob := do(t, recorder, http.MethodGet, server.URL+"/api/v2/blob/", "")
+
+	assert.Contains(t, created, "hunter2", "the caller sees the real response")
+	assert.Equal(t, 4, recorder.Len())
+
+	path := filepath.Join(t.TempDir(), "session.har")
+	require.NoError(t, recorder.Save(path))
+
+	har := recorder.HAR()
+	post := har.Log.Entries[0]
+
+	assert.Equal(t, "1.2", har.Log.Version)
+	assert.Contains(t, post.Request.Headers, NameValue{Name: "Authorization", Value: "[REDACTED]"})
+	assert.Contains(t, post.Response.Headers, NameValue{Name: "Set-Cookie", Value: "[REDACTED]"})
+	assert.Equal(t, `{"name": "db", "password": "[REDACTED]"}`, post.Request.PostData.Text)
+	assert.NotContains(t, post.Response.Content.Text, "hunter2")
+	assert.Equal(t, http.StatusCreated, post.Response.Status)
+	assert.Equal(t, "base64", har.Log.Entries[3].Response.Content.Encoding)
+
+	replayer, err := LoadReplayer(path)
+	require.NoError(t, err)
+
+	// Another host: matching ignores it.
+	other := "http://replay.example.com"
+
+	resp, replayed := do(t, replayer, http.MethodPost, other+"/api/v2/credentials/", `{}`)
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+	assert.Contains(t, replayed, `"id": "cred-1"`)
+
+	_, got := do(t, replayer, http.MethodGet, other+"/api/v2/workloads/w1/", "")
+	assert.Equal(t, first, got)
+
+	_, got = do(t, replayer, http.MethodGet, other+"/api/v2/workloads/w1/", "")
+	assert.Equal(t, second, got)
+
+	_, got = do(t, replayer, http.MethodGet, other+"/api/v2/workloads/w1/", "")
+	assert.Equal(t, second, got, "the last response repeats")
+
+	_, got = do(t, replayer, http.MethodGet, other+"/api/v2/blob/", "")
+	assert.Equal(t, blob, got)
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, other+"/api/v2/missing/", nil)
+	require.NoError(t, err)
+
+	_, err = replayer.RoundTrip(req)
+	require.ErrorContains(t, err, "no recorded response for GET /api/v2/missing/ in "+path)
+}
+
+func TestRecorder_RedactsQueryAndForm(t *testing.T) {
+	server := newAPI(t)
+	recorder := &Recorder{Base: http.DefaultTransport}
+
+	do(t, recorder, http.MethodGet, server.URL+"/api/v2/workloads/w1/?limit=5&token=abc123&apiToken=def456", "")
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, server.URL+"/api/v2/workloads/w1/",
+		strings.NewReader("username=me&password=hunter2&client_id=cli"))
+	require.NoError(t, err)
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := (&http.Client{Transport: recorder}).Do(req)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+
+	path := filepath.Join(t.TempDir(), "session.har")
+	require.NoError(t, recorder.Save(path))
+
+	har := recorder.HAR()
+	get, post := har.Log.Entries[0].Request, har.Log.Entries[1].Request
+
+	assert.Equal(t, server.URL+"/api/v2/workloads/w1/?limit=5&token=%5BREDACTED%5D&apiToken=%5BREDACTED%5D", get.URL)
+	assert.Equal(t, []NameValue{
+		{Name: "apiToken", Value: "[REDACTED]"},
+		{Name: "limit", Value: "5"},
+		{Name: "token", Value: "[REDACTED]"},
+	}, get.QueryString)
+	assert.Equal(t, "username=me&password=%5BREDACTED%5D&client_id=cli", post.PostData.Text)
+
+	replayer, err := LoadReplayer(path)
+	require.NoError(t, err)
+
+	resp, _ = do(t, replayer, http.MethodGet, "http://replay.example.com/api/v2/workloads/w1/?limit=5&token=other&apiToken=other", "")
+	assert.Equal(t, http.StatusOK, resp.StatusCode, "a request with other secrets still matches the recording")
+}
+
+func TestRecorder_Scope(t *testing.T) {
+	server := newAPI(t)
+	recorder := &Recorder{
+		Base:    http.DefaultTransport,
+		InScope: func(req *http.Request) bool { return req.URL.Path != "/api/v2/blob/" },
+	}
+
+	do(t, recorder, http.MethodGet, server.URL+"/api/v2/blob/", "")
+	do(t, recorder, http.MethodGet, server.URL+"/api/v2/workloads/w1/", "")
+
+	entries := recorder.HAR().Log.Entries
+	require.Len(t, entries, 1)
+	assert.Equal(t, server.URL+"/api/v2/workloads/w1/", entries[0].Request.URL)
+}
+
+func TestRecorder_StreamedBodyRecordedAsRead(t *testing.T) {
+	server := newAPI(t)
+	recorder := &Recorder{Base: http.DefaultTransport}
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, server.URL+"/api/v2/credentials/", strings.NewReader(`{"a": 1}`))
+	require.NoError(t, err)
+
+	resp, err := recorder.RoundTrip(req)
+	require.NoError(t, err)
+
+	defer resp.Body.Close()
+
+	assert.Empty(t, recorder.HAR().Log.Entries[0].Response.Content.Text, "nothing read yet")
+
+	_, err = io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	assert.Contains(t, recorder.HAR().Log.Entries[0].Response.Content.Text, `"id": "cred-1"`)
+}
+
+func TestReplayer_RecordedError(t *testing.T) {
+	replayer, err := NewReplayer("session.har", HAR{Log: HARLog{Entries: []Entry{{
+		Request: Request{Method: http.MethodGet, URL: "https://app.example.com/api/v2/version/"},
+		Error:   "dial tcp: connection refused",
+	}}}})
+	require.NoError(t, err)
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "https://other.example.com/api/v2/version/", nil)
+	require.NoError(t, err)
+
+	_, err = replayer.RoundTrip(req)
+	require.EqualError(t, err, "dial tcp: connection refused")
+}
@@ -0,0 +1,241 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httprecord
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/datarobot/cli/internal/version"
+)
+
+// maxBodySize caps how much of one request or response body is recorded, so
+// an artifact upload or download does not end up in the file whole.
+const maxBodySize = 10 << 20
+
+// Recorder is an http.RoundTripper that sends requests through Base and
+// records each one, with its response, for Save. Response bodies are
+// recorded as the caller reads them, so streamed responses still stream.
+type Recorder struct {
+	// Base sends the requests. It must be set.
+	Base http.RoundTripper
+	// InScope reports whether a request is recorded. Others pass through
+	// unrecorded. Nil records every request.
+	InScope func(*http.Request) bool
+
+	mu      sync.Mutex
+	entries []*recording
+}
+
+// recording is an entry in progress: its bodies fill in as they are sent and read.
+type recording struct {
+	entry    Entry
+	reqBody  *capture
+	respBody *capture
+}
+
+// RoundTrip sends req through Base and records it.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	if r.InScope != nil && !r.InScope(req) {
+		return r.Base.RoundTrip(req)
+	}
+
+	rec := &recording{
+		entry: Entry{
+			StartedDateTime: time.Now(),
+			Request: Request{
+				Method:      req.Method,
+				URL:         redactURL(req.URL).String(),
+				HTTPVersion: req.Proto,
+				Headers:     harHeaders(req.Header),
+				QueryString: harQuery(redactURL(req.URL)),
+				HeadersSize: -1,
+				BodySize:    -1,
+			},
+		},
+	}
+
+	r.mu.Lock()
+	r.entries = append(r.entries, rec)
+	r.mu.Unlock()
+
+	if req.Body != nil && req.Body != http.NoBody {
+		rec.reqBody = &capture{}
+
+		// RoundTrippers must not modify the caller's request.
+		req = req.Clone(req.Context())
+		req.Body = &teeBody{ReadCloser: req.Body, capture: rec.reqBody}
+	}
+
+	resp, err := r.Base.RoundTrip(req)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	rec.entry.Time = msSince(rec.entry.StartedDateTime)
+	rec.entry.Timings.Wait = rec.entry.Time
+
+	if err != nil {
+		rec.entry.Error = err.Error()
+
+		return nil, err
+	}
+
+	rec.entry.Response = Response{
+		Status:      resp.StatusCode,
+		StatusText:  http.StatusText(resp.StatusCode),
+		HTTPVersion: resp.Proto,
+		Headers:     harHeaders(resp.Header),
+		HeadersSize: -1,
+		BodySize:    -1,
+		Content:     Content{MimeType: resp.Header.Get("Content-Type")},
+	}
+
+	rec.respBody = &capture{}
+	resp.Body = &teeBody{ReadCloser: resp.Body, capture: rec.respBody}
+
+	return resp, nil
+}
+
+// Len returns how many requests have been recorded.
+func (r *Recorder) Len() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return len(r.entries)
+}
+
+// HAR returns the recording so far. A body still being read is recorded as
+// far as it has been read.
+func (r *Recorder) HAR() HAR {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entries := make([]Entry, len(r.entries))
+
+	for i, rec := range r.entries {
+		entry := rec.entry
+
+		if rec.reqBody != nil {
+			body, _ := rec.reqBody.contents()
+			mimeType := headerValue(entry.Request.Headers, "Content-Type")
+			text, encoding := encodeBody(body, mimeType)
+
+			entry.Request.BodySize = len(body)
+			entry.Request.PostData = &PostData{
+				MimeType: mimeType,
+				Text:     text,
+				Encoding: encoding,
+			}
+		}
+
+		if rec.respBody != nil {
+			body, truncated := rec.respBody.contents()
+
+			entry.Response.BodySize = len(body)
+			entry.Response.Content.Size = len(body)
+			entry.Response.Content.Text, entry.Response.Content.Encoding = encodeBody(body, entry.Response.Content.MimeType)
+			entry.Response.Content.Truncated = truncated
+		}
+
+		entries[i] = entry
+	}
+
+	return HAR{Log: HARLog{
+		Version: "1.2",
+		Creator: HARCreator{Name: version.CliName, Version: version.Version},
+		Entries: entries,
+	}}
+}
+
+// Save writes the recording so far to path. The file may hold response data
+// the redaction does not recognize as secret, so it is created owner-only.
+func (r *Recorder) Save(path string) error {
+	data, err := json.MarshalIndent(r.HAR(), "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		return fmt.Errorf("writing HTTP recording: %w", err)
+	}
+
+	return nil
+}
+
+// capture holds the first maxBodySize bytes of a body. Bodies are read on
+// the caller's goroutine while Save may run on another, hence the lock.
+type capture struct {
+	mu        sync.Mutex
+	buf       bytes.Buffer
+	truncated bool
+}
+
+func (c *capture) Write(p []byte) (int, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	room := maxBodySize - c.buf.Len()
+	if len(p) > room {
+		c.truncated = true
+		p = p[:max(room, 0)]
+	}
+
+	c.buf.Write(p)
+
+	return len(p), nil
+}
+
+func (c *capture) contents() ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return bytes.Clone(c.buf.Bytes()), c.truncated
+}
+
+// teeBody copies what is read from a body into a capture.
+type teeBody struct {
+	io.ReadCloser
+	capture *capture
+}
+
+func (t *teeBody) Read(p []byte) (int, error) {
+	n, err := t.ReadCloser.Read(p)
+	if n > 0 {
+		_, _ = t.capture.Write(p[:n])
+	}
+
+	return n, err
+}
+
+func headerValue(headers []NameValue, name string) string {
+	for _, header := range headers {
+		if http.CanonicalHeaderKey(header.Name) == name {
+			return header.Value
+		}
+	}
+
+	return ""
+}
+
+func msSince(start time.Time) float64 {
+	return float64(time.Since(start).Microseconds()) / 1000
+}
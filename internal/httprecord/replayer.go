@@ -0,0 +1,154 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httprecord
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"sync"
+)
+
+// Replayer is an http.RoundTripper that answers requests from a recording
+// instead of the network. A request matches the recorded ones with the same
+// method, path and query, whatever the host, so a session recorded against
+// one DataRobot installation replays against any endpoint. Matching requests
+// get the recorded responses in order; once those run out, the last one
+// repeats, so a poll that takes more rounds than it did when recorded still
+// ends the same way.
+type Replayer struct {
+	// Base sends requests outside InScope. It must be set when InScope is.
+	Base http.RoundTripper
+	// InScope reports whether a request is answered from the recording.
+	// Nil answers every request.
+	InScope func(*http.Request) bool
+
+	mu     sync.Mutex
+	path   string
+	queues map[string][]Entry
+	served map[string]int
+}
+
+// LoadReplayer reads the recording Recorder.Save wrote to path.
+func LoadReplayer(path string) (*Replayer, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading HTTP recording: %w", err)
+	}
+
+	var har HAR
+
+	if err := json.Unmarshal(data, &har); err != nil {
+		return nil, fmt.Errorf("parsing HTTP recording %s: %w", path, err)
+	}
+
+	return NewReplayer(path, har)
+}
+
+// NewReplayer returns a Replayer serving har. name identifies the recording
+// in errors.
+func NewReplayer(name string, har HAR) (*Replayer, error) {
+	r := &Replayer{
+		path:   name,
+		queues: map[string][]Entry{},
+		served: map[string]int{},
+	}
+
+	for _, entry := range har.Log.Entries {
+		u, err := url.Parse(entry.Request.URL)
+		if err != nil {
+			return nil, fmt.Errorf("parsing HTTP recording %s: %w", name, err)
+		}
+
+		key := replayKey(entry.Request.Method, u)
+		r.queues[key] = append(r.queues[key], entry)
+	}
+
+	return r, nil
+}
+
+// RoundTrip answers req with the next recorded response for it.
+func (r *Replayer) RoundTrip(req *http.Request) (*http.Response, error) {
+	if r.InScope != nil && !r.InScope(req) {
+		return r.Base.RoundTrip(req)
+	}
+
+	// A RoundTripper must close the request body, sent or not.
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+		_ = req.Body.Close()
+	}
+
+	entry, ok := r.next(replayKey(req.Method, req.URL))
+	if !ok {
+		return nil, fmt.Errorf("no recorded response for %s %s in %s", req.Method, req.URL.RequestURI(), r.path)
+	}
+
+	if entry.Error != "" {
+		return nil, errors.New(entry.Error)
+	}
+
+	body, err := decodeBody(entry.Response.Content.Text, entry.Response.Content.Encoding)
+	if err != nil {
+		return nil, fmt.Errorf("decoding recorded response for %s %s: %w", req.Method, req.URL.RequestURI(), err)
+	}
+
+	header := http.Header{}
+
+	for _, h := range entry.Response.Headers {
+		header.Add(h.Name, h.Value)
+	}
+
+	// The recorded body may be redacted or truncated, so its length wins
+	// over the recorded header.
+	header.Set("Content-Length", strconv.Itoa(len(body)))
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", entry.Response.Status, entry.Response.StatusText),
+		StatusCode:    entry.Response.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+func (r *Replayer) next(key string) (Entry, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	queue := r.queues[key]
+	if len(queue) == 0 {
+		return Entry{}, false
+	}
+
+	i := min(r.served[key], len(queue)-1)
+	r.served[key]++
+
+	return queue[i], true
+}
+
+func replayKey(method string, u *url.URL) string {
+	return method + " " + redactURL(u).RequestURI()
+}
@@ -0,0 +1,187 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package llmgateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/datarobot/cli/internal/drapi"
+)
+
+const (
+	// gatewayChatPath is the LLM Gateway's chat completions endpoint; the
+	// gateway model id goes in the request body.
+	gatewayChatPath = "/api/v2/genai/llmgw/chat/completions/"
+
+	// deploymentChatPath is a deployed LLM's chat completions endpoint. The
+	// deployment in the path does the routing, so the body names the generic
+	// deployed model.
+	deploymentChatPath = "/api/v2/deployments/%s/chat/completions"
+	deployedModelName  = "datarobot-deployed-llm"
+
+	// catalogRefreshInterval bounds how often a name that is not in the
+	// catalog sends us back to the API to look again.
+	catalogRefreshInterval = time.Minute
+)
+
+// ErrModelNotFound is returned for a model name no LLM in the catalog has.
+var ErrModelNotFound = errors.New("model not found")
+
+// Route is where a chat completion for one model goes.
+type Route struct {
+	LLM drapi.LLM
+
+	// URL is the upstream chat completions endpoint.
+	URL string
+
+	// Model is the model name the upstream expects in the request body.
+	Model string
+}
+
+// Catalog resolves model names to routes. The LLM list is loaded on first
+// use and reloaded when a name is missing, at most once per
+// catalogRefreshInterval, so a model deployed while a server runs is found
+// without a restart.
+type Catalog struct {
+	load        func(ctx context.Context) ([]drapi.LLM, error)
+	endpointURL func(path string) (string, error)
+	now         func() time.Time
+
+	mu       sync.Mutex
+	llms     []drapi.LLM
+	loadedAt time.Time
+}
+
+// NewCatalog returns a Catalog that lists LLMs with load and builds upstream
+// URLs with endpointURL, normally config.GetEndpointURL.
+func NewCatalog(load func(ctx context.Context) ([]drapi.LLM, error), endpointURL func(path string) (string, error)) *Catalog {
+	return &Catalog{load: load, endpointURL: endpointURL, now: time.Now}
+}
+
+// LoadLLMs lists gateway and deployed LLMs, as `dr llm-gateway list` does.
+func LoadLLMs(ctx context.Context) ([]drapi.LLM, error) {
+	list, err := drapi.GetLLMsAndDeployed(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return list.LLMs, nil
+}
+
+// LLMs returns the catalog, loading it if it has not been yet.
+func (c *Catalog) LLMs(ctx context.Context) ([]drapi.LLM, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.loadedAt.IsZero() {
+		if err := c.reload(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return c.llms, nil
+}
+
+// Resolve finds the LLM named model and returns its route. A model matches
+// an LLM id, a deployment id, or a name; ids win over names.
+func (c *Catalog) Resolve(ctx context.Context, model string) (Route, error) {
+	llm, err := c.find(ctx, model)
+	if err != nil {
+		return Route{}, err
+	}
+
+	return c.route(llm)
+}
+
+func (c *Catalog) find(ctx context.Context, model string) (drapi.LLM, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.loadedAt.IsZero() {
+		if err := c.reload(ctx); err != nil {
+			return drapi.LLM{}, err
+		}
+	}
+
+	if llm, ok := match(c.llms, model); ok {
+		return llm, nil
+	}
+
+	if c.now().Sub(c.loadedAt) < catalogRefreshInterval {
+		return drapi.LLM{}, fmt.Errorf("%w: %q", ErrModelNotFound, model)
+	}
+
+	if err := c.reload(ctx); err != nil {
+		return drapi.LLM{}, err
+	}
+
+	if llm, ok := match(c.llms, model); ok {
+		return llm, nil
+	}
+
+	return drapi.LLM{}, fmt.Errorf("%w: %q", ErrModelNotFound, model)
+}
+
+// reload must be called with c.mu held.
+func (c *Catalog) reload(ctx context.Context) error {
+	llms, err := c.load(ctx)
+	if err != nil {
+		return fmt.Errorf("list LLMs: %w", err)
+	}
+
+	c.llms = llms
+	c.loadedAt = c.now()
+
+	return nil
+}
+
+func match(llms []drapi.LLM, model string) (drapi.LLM, bool) {
+	for _, l := range llms {
+		if l.LlmID == model || (l.DeploymentID != "" && l.DeploymentID == model) {
+			return l, true
+		}
+	}
+
+	for _, l := range llms {
+		if l.Name == model {
+			return l, true
+		}
+	}
+
+	return drapi.LLM{}, false
+}
+
+func (c *Catalog) route(llm drapi.LLM) (Route, error) {
+	if llm.Kind == drapi.LLMKindDeployed {
+		u, err := c.endpointURL(fmt.Sprintf(deploymentChatPath, url.PathEscape(llm.DeploymentID)))
+		if err != nil {
+			return Route{}, err
+		}
+
+		return Route{LLM: llm, URL: u, Model: deployedModelName}, nil
+	}
+
+	u, err := c.endpointURL(gatewayChatPath)
+	if err != nil {
+		return Route{}, err
+	}
+
+	return Route{LLM: llm, URL: u, Model: llm.LlmID}, nil
+}
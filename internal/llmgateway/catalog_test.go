@@ -0,0 +1,75 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package llmgateway
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/datarobot/cli/internal/drapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCatalog_ResolveMatchesIDBeforeName(t *testing.T) {
+	llms := []drapi.LLM{
+		{LlmID: "a", Name: "b", Kind: drapi.LLMKindGateway},
+		{LlmID: "b", Name: "other", Kind: drapi.LLMKindGateway},
+	}
+
+	c := NewCatalog(
+		func(context.Context) ([]drapi.LLM, error) { return llms, nil },
+		func(path string) (string, error) { return "https://dr.example" + path, nil },
+	)
+
+	route, err := c.Resolve(context.Background(), "b")
+	require.NoError(t, err)
+	assert.Equal(t, "b", route.LLM.LlmID)
+	assert.Equal(t, "https://dr.example"+gatewayChatPath, route.URL)
+}
+
+func TestCatalog_ReloadsOnMissAfterInterval(t *testing.T) {
+	loads := 0
+	llms := []drapi.LLM{{LlmID: "a", Kind: drapi.LLMKindGateway}}
+
+	c := NewCatalog(
+		func(context.Context) ([]drapi.LLM, error) {
+			loads++
+
+			return llms, nil
+		},
+		func(path string) (string, error) { return path, nil },
+	)
+
+	now := time.Now()
+	c.now = func() time.Time { return now }
+
+	_, err := c.Resolve(context.Background(), "a")
+	require.NoError(t, err)
+
+	llms = append(llms, drapi.LLM{LlmID: "new", Kind: drapi.LLMKindGateway})
+
+	_, err = c.Resolve(context.Background(), "new")
+	require.ErrorIs(t, err, ErrModelNotFound, "a miss inside the interval does not reload")
+	assert.Equal(t, 1, loads)
+
+	now = now.Add(catalogRefreshInterval)
+
+	route, err := c.Resolve(context.Background(), "new")
+	require.NoError(t, err)
+	assert.Equal(t, "new", route.Model)
+	assert.Equal(t, 2, loads)
+}
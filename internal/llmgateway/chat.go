@@ -0,0 +1,240 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package llmgateway
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Chat message roles.
+const (
+	RoleSystem    = "system"
+	RoleUser      = "user"
+	RoleAssistant = "assistant"
+)
+
+// maxErrorBytes caps how much of a failed response is read for its message.
+const maxErrorBytes = 64 << 10
+
+// Message is one message of a chat conversation.
+type Message struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+// Reply is a finished chat completion.
+type Reply struct {
+	Content string
+	Usage   *Usage
+
+	// FirstToken is the time until the first content arrived; Latency is
+	// the time until the response ended.
+	FirstToken time.Duration
+	Latency    time.Duration
+}
+
+// Client sends chat completions straight to a route, for callers that talk
+// to one model themselves rather than through the proxy.
+type Client struct {
+	authorize func(*http.Request) error
+	http      *http.Client
+	now       func() time.Time
+}
+
+// NewClient returns a Client that authorizes requests with authorize,
+// normally drapi.AuthorizeRequest, and sends them with httpClient, which
+// must not time out a whole call.
+func NewClient(authorize func(*http.Request) error, httpClient *http.Client) *Client {
+	return &Client{authorize: authorize, http: httpClient, now: time.Now}
+}
+
+// Complete asks route's model to continue messages. The reply is streamed:
+// onDelta, if set, is called with each piece of content as it arrives. An
+// upstream that ignores "stream" and answers in one body works too.
+func (c *Client) Complete(ctx context.Context, route Route, messages []Message, onDelta func(string)) (Reply, error) {
+	body, err := json.Marshal(map[string]any{
+		"model":          route.Model,
+		"messages":       messages,
+		"stream":         true,
+		"stream_options": map[string]bool{"include_usage": true},
+	})
+	if err != nil {
+		return Reply{}, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, route.URL, bytes.NewReader(body))
+	if err != nil {
+		return Reply{}, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "text/event-stream")
+
+	if err := c.authorize(req); err != nil {
+		return Reply{}, fmt.Errorf("authorize request: %w", err)
+	}
+
+	started := c.now()
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return Reply{}, fmt.Errorf("call %s: %w", route.URL, err)
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return Reply{}, upstreamError(resp)
+	}
+
+	if onDelta == nil {
+		onDelta = func(string) {}
+	}
+
+	reply := Reply{}
+	content := &strings.Builder{}
+
+	deliver := func(delta string) {
+		if delta == "" {
+			return
+		}
+
+		if content.Len() == 0 {
+			reply.FirstToken = c.now().Sub(started)
+		}
+
+		content.WriteString(delta)
+		onDelta(delta)
+	}
+
+	if strings.HasPrefix(resp.Header.Get("Content-Type"), "text/event-stream") {
+		reply.Usage, err = readStream(resp.Body, deliver)
+	} else {
+		reply.Usage, err = readBody(resp.Body, deliver)
+	}
+
+	reply.Content = content.String()
+	reply.Latency = c.now().Sub(started)
+
+	return reply, err
+}
+
+// completionChunk is the part of a completion, or of one streamed chunk of
+// it, that Complete reads.
+type completionChunk struct {
+	Choices []struct {
+		Delta   Message `json:"delta"`
+		Message Message `json:"message"`
+	} `json:"choices"`
+	Usage *Usage `json:"usage"`
+	Error *struct {
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+func readStream(body io.Reader, deliver func(string)) (*Usage, error) {
+	reader := bufio.NewReader(body)
+
+	var usage *Usage
+
+	for {
+		line, err := reader.ReadBytes('\n')
+
+		if data, ok := sseData(line); ok {
+			var chunk completionChunk
+			if jerr := json.Unmarshal(data, &chunk); jerr != nil {
+				return usage, fmt.Errorf("malformed stream event: %w", jerr)
+			}
+
+			if chunk.Error != nil {
+				return usage, fmt.Errorf("stream failed: %s", chunk.Error.Message)
+			}
+
+			if chunk.Usage != nil {
+				usage = chunk.Usage
+			}
+
+			if len(chunk.Choices) > 0 {
+				deliver(chunk.Choices[0].Delta.Content)
+			}
+		}
+
+		if errors.Is(err, io.EOF) {
+			return usage, nil
+		}
+
+		if err != nil {
+			return usage, err
+		}
+	}
+}
+
+func readBody(body io.Reader, deliver func(string)) (*Usage, error) {
+	raw, err := io.ReadAll(io.LimitReader(body, maxResponseBytes))
+	if err != nil {
+		return nil, err
+	}
+
+	var completion completionChunk
+	if err := json.Unmarshal(raw, &completion); err != nil {
+		return nil, fmt.Errorf("malformed completion: %w", err)
+	}
+
+	if len(completion.Choices) > 0 {
+		deliver(completion.Choices[0].Message.Content)
+	}
+
+	return completion.Usage, nil
+}
+
+// upstreamError turns a failed response into an error carrying the message
+// the upstream gave: the OpenAI error shape, the DataRobot one, or the raw
+// body.
+func upstreamError(resp *http.Response) error {
+	raw, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBytes))
+
+	var shaped struct {
+		Error *struct {
+			Message string `json:"message"`
+		} `json:"error"`
+		Message string `json:"message"`
+	}
+
+	msg := strings.TrimSpace(string(raw))
+
+	if json.Unmarshal(raw, &shaped) == nil {
+		switch {
+		case shaped.Error != nil && shaped.Error.Message != "":
+			msg = shaped.Error.Message
+		case shaped.Message != "":
+			msg = shaped.Message
+		}
+	}
+
+	if msg == "" {
+		return fmt.Errorf("LLM request failed: %s", resp.Status)
+	}
+
+	return fmt.Errorf("LLM request failed: %s: %s", resp.Status, msg)
+}
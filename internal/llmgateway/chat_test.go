@@ -0,0 +1,106 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package llmgateway
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func authorizeTest(r *http.Request) error {
+	r.Header.Set("Authorization", "Bearer dr-token")
+
+	return nil
+}
+
+func TestClient_Complete_Streams(t *testing.T) {
+	stub := &stubUpstream{}
+	upstream := httptest.NewServer(stub)
+	t.Cleanup(upstream.Close)
+
+	route := Route{URL: upstream.URL + gatewayChatPath, Model: "azure/gpt-4o"}
+	messages := []Message{{Role: RoleSystem, Content: "Be brief."}, {Role: RoleUser, Content: "Hi"}}
+
+	var deltas []string
+
+	reply, err := NewClient(authorizeTest, upstream.Client()).Complete(context.Background(), route, messages, func(d string) {
+		deltas = append(deltas, d)
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"Hel", "lo"}, deltas)
+	assert.Equal(t, "Hello", reply.Content)
+	require.NotNil(t, reply.Usage)
+	assert.Equal(t, 7, reply.Usage.TotalTokens)
+	assert.Positive(t, reply.Latency)
+
+	require.Len(t, stub.calls, 1)
+	call := stub.calls[0]
+	assert.Equal(t, "Bearer dr-token", call.Auth)
+	assert.Equal(t, "azure/gpt-4o", call.Model)
+	assert.Equal(t, map[string]any{"include_usage": true}, call.Body["stream_options"])
+	assert.Len(t, call.Body["messages"], 2)
+}
+
+func TestClient_Complete_UnstreamedBody(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"choices":[{"message":{"role":"assistant","content":"Hello"}}],"usage":{"prompt_tokens":5,"completion_tokens":1,"total_tokens":6}}`))
+	}))
+	t.Cleanup(upstream.Close)
+
+	var deltas []string
+
+	reply, err := NewClient(authorizeTest, upstream.Client()).Complete(context.Background(), Route{URL: upstream.URL}, nil, func(d string) {
+		deltas = append(deltas, d)
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"Hello"}, deltas)
+	assert.Equal(t, "Hello", reply.Content)
+	assert.Equal(t, 6, reply.Usage.TotalTokens)
+}
+
+func TestClient_Complete_Errors(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		status      int
+		contentType string
+		body        string
+		want        string
+	}{
+		{"openai shape", http.StatusBadRequest, "application/json", `{"error":{"message":"context too long"}}`, "400 Bad Request: context too long"},
+		{"datarobot shape", http.StatusForbidden, "application/json", `{"message":"no access to deployment"}`, "403 Forbidden: no access to deployment"},
+		{"plain text", http.StatusBadGateway, "text/plain", "upstream down\n", "502 Bad Gateway: upstream down"},
+		{"error in stream", http.StatusOK, "text/event-stream", "data: {\"error\":{\"message\":\"rate limited\"}}\n\n", "stream failed: rate limited"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", tc.contentType)
+				w.WriteHeader(tc.status)
+				_, _ = w.Write([]byte(tc.body))
+			}))
+			t.Cleanup(upstream.Close)
+
+			_, err := NewClient(authorizeTest, upstream.Client()).Complete(context.Background(), Route{URL: upstream.URL}, nil, nil)
+			require.ErrorContains(t, err, tc.want)
+		})
+	}
+}
@@ -0,0 +1,21 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package llmgateway talks to DataRobot LLMs through the OpenAI chat
+// completions API. A model name resolves against the same catalog
+// `dr llm-gateway list` shows: an LLM Gateway model is called through the
+// gateway, and a deployed LLM through its deployment. Proxy serves that
+// routing to local OpenAI clients with the CLI's credentials attached;
+// Client calls a route directly and streams the reply.
+package llmgateway
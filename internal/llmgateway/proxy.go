@@ -0,0 +1,426 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package llmgateway
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	// maxRequestBytes caps the request body the proxy buffers to rewrite
+	// its model; long multimodal conversations stay well inside it.
+	maxRequestBytes = 32 << 20
+
+	// maxResponseBytes caps a non-streamed response, which is buffered to
+	// read its usage.
+	maxResponseBytes = 64 << 20
+)
+
+// Request is what the proxy reports about each chat completion it forwards.
+type Request struct {
+	// Model is the name the client asked for.
+	Model   string
+	Route   Route
+	Stream  bool
+	Status  int
+	Usage   *Usage
+	Latency time.Duration
+	Err     error
+}
+
+// ProxyOptions configures NewProxy.
+type ProxyOptions struct {
+	Catalog *Catalog
+
+	// Host is the address the server listens on. Requests must name it or
+	// a loopback host, so a web page cannot reach the proxy through DNS
+	// rebinding. When it is unspecified (0.0.0.0 or ::), any IP is accepted.
+	Host string
+
+	// DefaultModel answers requests that name no model.
+	DefaultModel string
+
+	// Authorize adds the DataRobot credentials to an upstream request;
+	// production passes drapi.AuthorizeRequest.
+	Authorize func(*http.Request) error
+
+	// Client sends upstream requests. It must not time out a whole call, or
+	// long streams are cut off.
+	Client *http.Client
+
+	// OnRequest, if set, is called once per chat completion, after the
+	// response has been written.
+	OnRequest func(Request)
+
+	now func() time.Time
+}
+
+type proxy struct {
+	opts ProxyOptions
+}
+
+// NewProxy returns an http.Handler serving the OpenAI chat completions and
+// models endpoints, under /v1 and at the root, from the catalog.
+//
+// Every forwarded call carries the user's DataRobot credentials, so the
+// handler only answers clients that are not browsers: requests with an
+// Origin header or naming another host are refused, and chat completions
+// must be sent as application/json, which a page cannot do cross-origin
+// without a preflight.
+func NewProxy(opts ProxyOptions) http.Handler {
+	if opts.now == nil {
+		opts.now = time.Now
+	}
+
+	p := &proxy{opts: opts}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /v1/chat/completions", p.chatCompletions)
+	mux.HandleFunc("POST /chat/completions", p.chatCompletions)
+	mux.HandleFunc("GET /v1/models", p.models)
+	mux.HandleFunc("GET /models", p.models)
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusNotFound, "invalid_request_error", fmt.Sprintf("no route for %s %s", r.Method, r.URL.Path))
+	})
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Origin") != "" {
+			writeError(w, http.StatusForbidden, "invalid_request_error", "requests from browsers are not accepted")
+
+			return
+		}
+
+		if !allowedHost(r.Host, opts.Host) {
+			writeError(w, http.StatusForbidden, "invalid_request_error", fmt.Sprintf("host %q is not served here", r.Host))
+
+			return
+		}
+
+		mux.ServeHTTP(w, r)
+	})
+}
+
+// allowedHost reports whether a request's Host header names the server:
+// localhost, a loopback IP, the listen host, or, when listening on every
+// interface, any IP. A DNS name other than those means the request came
+// through a name the user never gave the server.
+func allowedHost(requestHost, listenHost string) bool {
+	name := requestHost
+	if h, _, err := net.SplitHostPort(requestHost); err == nil {
+		name = h
+	}
+
+	name = strings.Trim(name, "[]")
+
+	if strings.EqualFold(name, "localhost") || (listenHost != "" && strings.EqualFold(name, strings.Trim(listenHost, "[]"))) {
+		return true
+	}
+
+	ip := net.ParseIP(name)
+	if ip == nil {
+		return false
+	}
+
+	if ip.IsLoopback() {
+		return true
+	}
+
+	listenIP := net.ParseIP(strings.Trim(listenHost, "[]"))
+
+	return listenIP != nil && listenIP.IsUnspecified()
+}
+
+func (p *proxy) models(w http.ResponseWriter, r *http.Request) {
+	llms, err := p.opts.Catalog.LLMs(r.Context())
+	if err != nil {
+		writeError(w, http.StatusBadGateway, "api_error", err.Error())
+
+		return
+	}
+
+	type model struct {
+		ID      string `json:"id"`
+		Object  string `json:"object"`
+		Created int64  `json:"created"`
+		OwnedBy string `json:"owned_by"`
+	}
+
+	data := make([]model, 0, len(llms))
+
+	for _, l := range llms {
+		owner := l.Provider
+		if owner == "" {
+			owner = "datarobot"
+		}
+
+		data = append(data, model{ID: l.LlmID, Object: "model", OwnedBy: owner})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]any{"object": "list", "data": data})
+}
+
+func (p *proxy) chatCompletions(w http.ResponseWriter, r *http.Request) {
+	started := p.opts.now()
+	rec := Request{}
+
+	defer func() {
+		if p.opts.OnRequest != nil {
+			rec.Latency = p.opts.now().Sub(started)
+			p.opts.OnRequest(rec)
+		}
+	}()
+
+	body, err := p.rewrite(r, &rec)
+	if err != nil {
+		rec.Status, rec.Err = writeRequestError(w, err)
+
+		return
+	}
+
+	resp, err := p.send(r, rec.Route, body)
+	if err != nil {
+		rec.Status, rec.Err = http.StatusBadGateway, err
+		writeError(w, rec.Status, "api_error", err.Error())
+
+		return
+	}
+
+	defer resp.Body.Close()
+
+	rec.Status = resp.StatusCode
+
+	copyHeaders(w.Header(), resp.Header)
+	w.WriteHeader(resp.StatusCode)
+
+	if strings.HasPrefix(resp.Header.Get("Content-Type"), "text/event-stream") {
+		rec.Usage, rec.Err = relayStream(w, resp.Body)
+
+		return
+	}
+
+	rec.Usage, rec.Err = relayBody(w, resp.Body)
+}
+
+// rewrite reads the request body, resolves its model, and returns the body
+// with the model the upstream expects. Every other field passes through.
+func (p *proxy) rewrite(r *http.Request, rec *Request) ([]byte, error) {
+	if mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); mediaType != "application/json" {
+		return nil, &requestError{
+			status: http.StatusUnsupportedMediaType,
+			msg:    "Content-Type must be application/json",
+		}
+	}
+
+	raw, err := io.ReadAll(io.LimitReader(r.Body, maxRequestBytes+1))
+	if err != nil {
+		return nil, badRequest("read request body: %v", err)
+	}
+
+	if len(raw) > maxRequestBytes {
+		return nil, badRequest("request body exceeds %d bytes", maxRequestBytes)
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return nil, badRequest("request body is not a JSON object: %v", err)
+	}
+
+	if m, ok := fields["model"]; ok {
+		if err := json.Unmarshal(m, &rec.Model); err != nil {
+			return nil, badRequest("model must be a string")
+		}
+	}
+
+	if s, ok := fields["stream"]; ok {
+		_ = json.Unmarshal(s, &rec.Stream)
+	}
+
+	if rec.Model == "" {
+		rec.Model = p.opts.DefaultModel
+	}
+
+	if rec.Model == "" {
+		return nil, badRequest("no model given and no default LLM selected; run 'dr llm-gateway select'")
+	}
+
+	route, err := p.opts.Catalog.Resolve(r.Context(), rec.Model)
+	if err != nil {
+		return nil, err
+	}
+
+	rec.Route = route
+
+	fields["model"], _ = json.Marshal(route.Model)
+
+	return json.Marshal(fields)
+}
+
+func (p *proxy) send(r *http.Request, route Route, body []byte) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(r.Context(), http.MethodPost, route.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	if accept := r.Header.Get("Accept"); accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+
+	if err := p.opts.Authorize(req); err != nil {
+		return nil, fmt.Errorf("authorize upstream request: %w", err)
+	}
+
+	resp, err := p.opts.Client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("call %s: %w", route.URL, err)
+	}
+
+	return resp, nil
+}
+
+// relayStream copies a server-sent event stream line by line, flushing at
+// each event boundary so tokens reach the client as they arrive, and picks
+// the usage out of the chunk that carries it.
+func relayStream(w http.ResponseWriter, body io.Reader) (*Usage, error) {
+	flusher, _ := w.(http.Flusher)
+	reader := bufio.NewReader(body)
+
+	var usage *Usage
+
+	for {
+		line, err := reader.ReadBytes('\n')
+
+		if len(line) > 0 {
+			if _, werr := w.Write(line); werr != nil {
+				return usage, werr
+			}
+
+			if data, ok := sseData(line); ok {
+				if u := usageOf(data); u != nil {
+					usage = u
+				}
+			}
+
+			if flusher != nil && len(bytes.TrimSpace(line)) == 0 {
+				flusher.Flush()
+			}
+		}
+
+		if errors.Is(err, io.EOF) {
+			if flusher != nil {
+				flusher.Flush()
+			}
+
+			return usage, nil
+		}
+
+		if err != nil {
+			return usage, err
+		}
+	}
+}
+
+// relayBody copies a whole response and reads its usage.
+func relayBody(w io.Writer, body io.Reader) (*Usage, error) {
+	raw, err := io.ReadAll(io.LimitReader(body, maxResponseBytes))
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := w.Write(raw); err != nil {
+		return nil, err
+	}
+
+	return usageOf(raw), nil
+}
+
+// hopHeaders are not forwarded from the upstream response; the server
+// writing our response sets its own.
+var hopHeaders = map[string]bool{
+	"Connection":        true,
+	"Content-Length":    true,
+	"Keep-Alive":        true,
+	"Transfer-Encoding": true,
+	"Trailer":           true,
+	"Upgrade":           true,
+}
+
+func copyHeaders(dst, src http.Header) {
+	for k, vs := range src {
+		if hopHeaders[k] {
+			continue
+		}
+
+		for _, v := range vs {
+			dst.Add(k, v)
+		}
+	}
+}
+
+// requestError is a client mistake, answered with its status.
+type requestError struct {
+	status int
+	msg    string
+}
+
+func (e *requestError) Error() string { return e.msg }
+
+func badRequest(format string, args ...any) error {
+	return &requestError{status: http.StatusBadRequest, msg: fmt.Sprintf(format, args...)}
+}
+
+// writeRequestError answers a request the proxy could not forward and
+// returns the status it used.
+func writeRequestError(w http.ResponseWriter, err error) (int, error) {
+	var reqErr *requestError
+
+	switch {
+	case errors.As(err, &reqErr):
+		writeError(w, reqErr.status, "invalid_request_error", err.Error())
+
+		return reqErr.status, err
+	case errors.Is(err, ErrModelNotFound):
+		writeError(w, http.StatusNotFound, "invalid_request_error", err.Error()+"; see 'dr llm-gateway list'")
+
+		return http.StatusNotFound, err
+	default:
+		writeError(w, http.StatusBadGateway, "api_error", err.Error())
+
+		return http.StatusBadGateway, err
+	}
+}
+
+// writeError answers in the OpenAI error shape, which client SDKs parse
+// into their own exceptions.
+func writeError(w http.ResponseWriter, status int, kind, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"error": map[string]any{"message": message, "type": kind},
+	})
+}
@@ -0,0 +1,346 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package llmgateway
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/datarobot/cli/internal/drapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testLLMs = []drapi.LLM{
+	{LlmID: "azure/gpt-4o", Name: "GPT-4o", Provider: "azure", Kind: drapi.LLMKindGateway},
+	{LlmID: "dep-1", Name: "support-bot", Kind: drapi.LLMKindDeployed, DeploymentID: "dep-1"},
+}
+
+// upstreamCall is what the stub upstream saw of one request.
+type upstreamCall struct {
+	Path  string
+	Auth  string
+	Model string
+	Body  map[string]any
+}
+
+// stubUpstream answers chat completions the way the gateway does: a JSON
+// completion, or an SSE stream when the request asks for one.
+type stubUpstream struct {
+	mu    sync.Mutex
+	calls []upstreamCall
+}
+
+func (s *stubUpstream) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var body map[string]any
+
+	_ = json.NewDecoder(r.Body).Decode(&body)
+
+	model, _ := body["model"].(string)
+
+	s.mu.Lock()
+	s.calls = append(s.calls, upstreamCall{Path: r.URL.Path, Auth: r.Header.Get("Authorization"), Model: model, Body: body})
+	s.mu.Unlock()
+
+	if stream, _ := body["stream"].(bool); stream {
+		w.Header().Set("Content-Type", "text/event-stream")
+
+		for _, tok := range []string{"Hel", "lo"} {
+			fmt.Fprintf(w, "data: {\"choices\":[{\"delta\":{\"content\":%q}}]}\n\n", tok)
+			w.(http.Flusher).Flush()
+		}
+
+		fmt.Fprint(w, "data: {\"choices\":[],\"usage\":{\"prompt_tokens\":5,\"completion_tokens\":2,\"total_tokens\":7}}\n\n")
+		fmt.Fprint(w, "data: [DONE]\n\n")
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	fmt.Fprint(w, `{"choices":[{"message":{"role":"assistant","content":"Hello"}}],"usage":{"prompt_tokens":5,"completion_tokens":1,"total_tokens":6}}`)
+}
+
+func newTestProxy(t *testing.T, defaultModel string) (*httptest.Server, *stubUpstream, *[]Request) {
+	t.Helper()
+
+	stub := &stubUpstream{}
+	upstream := httptest.NewServer(stub)
+	t.Cleanup(upstream.Close)
+
+	catalog := NewCatalog(
+		func(context.Context) ([]drapi.LLM, error) { return testLLMs, nil },
+		func(path string) (string, error) { return upstream.URL + path, nil },
+	)
+
+	var (
+		mu       sync.Mutex
+		requests []Request
+	)
+
+	handler := NewProxy(ProxyOptions{
+		Catalog:      catalog,
+		DefaultModel: defaultModel,
+		Authorize: func(r *http.Request) error {
+			r.Header.Set("Authorization", "Bearer dr-token")
+
+			return nil
+		},
+		Client: upstream.Client(),
+		OnRequest: func(r Request) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			requests = append(requests, r)
+		},
+	})
+
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	return srv, stub, &requests
+}
+
+func post(t *testing.T, url, body string, edit ...func(*http.Request)) *http.Response {
+	t.Helper()
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, url, strings.NewReader(body))
+	require.NoError(t, err)
+
+	req.Header.Set("Authorization", "Bearer client-key")
+	req.Header.Set("Content-Type", "application/json")
+
+	for _, e := range edit {
+		e(req)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { _ = resp.Body.Close() })
+
+	return resp
+}
+
+func TestProxy_RoutesGatewayModel(t *testing.T) {
+	srv, stub, requests := newTestProxy(t, "")
+
+	resp := post(t, srv.URL+"/v1/chat/completions", `{"model":"azure/gpt-4o","messages":[{"role":"user","content":"hi"}],"temperature":0.2}`)
+
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Contains(t, string(body), `"content":"Hello"`)
+
+	require.Len(t, stub.calls, 1)
+	assert.Equal(t, gatewayChatPath, stub.calls[0].Path)
+	assert.Equal(t, "Bearer dr-token", stub.calls[0].Auth, "the client's key is replaced by the CLI's")
+	assert.Equal(t, "azure/gpt-4o", stub.calls[0].Model)
+	assert.InDelta(t, 0.2, stub.calls[0].Body["temperature"], 0.0001, "other fields pass through")
+
+	require.Len(t, *requests, 1)
+	assert.Equal(t, "azure/gpt-4o", (*requests)[0].Model)
+	assert.Equal(t, http.StatusOK, (*requests)[0].Status)
+	require.NotNil(t, (*requests)[0].Usage)
+	assert.Equal(t, 6, (*requests)[0].Usage.TotalTokens)
+}
+
+func TestProxy_RoutesDeployedModelByName(t *testing.T) {
+	srv, stub, _ := newTestProxy(t, "")
+
+	resp := post(t, srv.URL+"/chat/completions", `{"model":"support-bot","messages":[]}`)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	require.Len(t, stub.calls, 1)
+	assert.Equal(t, "/api/v2/deployments/dep-1/chat/completions", stub.calls[0].Path)
+	assert.Equal(t, deployedModelName, stub.calls[0].Model)
+}
+
+func TestProxy_StreamsEvents(t *testing.T) {
+	srv, _, requests := newTestProxy(t, "")
+
+	resp := post(t, srv.URL+"/v1/chat/completions", `{"model":"azure/gpt-4o","stream":true,"messages":[]}`)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	var content strings.Builder
+
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		data, ok := sseData(scanner.Bytes())
+		if !ok {
+			continue
+		}
+
+		var chunk struct {
+			Choices []struct {
+				Delta struct {
+					Content string `json:"content"`
+				} `json:"delta"`
+			} `json:"choices"`
+		}
+
+		require.NoError(t, json.Unmarshal(data, &chunk))
+
+		for _, c := range chunk.Choices {
+			content.WriteString(c.Delta.Content)
+		}
+	}
+
+	assert.Equal(t, "Hello", content.String())
+
+	require.Len(t, *requests, 1)
+	assert.True(t, (*requests)[0].Stream)
+	require.NotNil(t, (*requests)[0].Usage)
+	assert.Equal(t, 7, (*requests)[0].Usage.TotalTokens)
+}
+
+func TestProxy_DefaultModel(t *testing.T) {
+	srv, stub, _ := newTestProxy(t, "dep-1")
+
+	resp := post(t, srv.URL+"/v1/chat/completions", `{"messages":[]}`)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	require.Len(t, stub.calls, 1)
+	assert.Equal(t, "/api/v2/deployments/dep-1/chat/completions", stub.calls[0].Path)
+}
+
+func TestProxy_Errors(t *testing.T) {
+	srv, stub, _ := newTestProxy(t, "")
+
+	tests := []struct {
+		name   string
+		body   string
+		status int
+		want   string
+	}{
+		{"unknown model", `{"model":"nope"}`, http.StatusNotFound, "model not found"},
+		{"no model", `{"messages":[]}`, http.StatusBadRequest, "no model given"},
+		{"not json", `hello`, http.StatusBadRequest, "not a JSON object"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := post(t, srv.URL+"/v1/chat/completions", tt.body)
+			assert.Equal(t, tt.status, resp.StatusCode)
+
+			var got struct {
+				Error struct {
+					Message string `json:"message"`
+				} `json:"error"`
+			}
+
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&got))
+			assert.Contains(t, got.Error.Message, tt.want)
+		})
+	}
+
+	assert.Empty(t, stub.calls)
+}
+
+func TestProxy_RejectsBrowserOrigin(t *testing.T) {
+	srv, stub, _ := newTestProxy(t, "")
+
+	resp := post(t, srv.URL+"/v1/chat/completions", `{"model":"azure/gpt-4o"}`, func(r *http.Request) {
+		r.Header.Set("Origin", "https://attacker.example")
+	})
+
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	assert.Empty(t, stub.calls)
+}
+
+func TestProxy_RejectsForeignHost(t *testing.T) {
+	srv, stub, _ := newTestProxy(t, "")
+
+	resp := post(t, srv.URL+"/v1/chat/completions", `{"model":"azure/gpt-4o"}`, func(r *http.Request) {
+		r.Host = "rebind.attacker.example:4000"
+	})
+
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	assert.Empty(t, stub.calls)
+}
+
+func TestProxy_RequiresJSONContentType(t *testing.T) {
+	srv, stub, requests := newTestProxy(t, "")
+
+	resp := post(t, srv.URL+"/v1/chat/completions", `{"model":"azure/gpt-4o"}`, func(r *http.Request) {
+		r.Header.Set("Content-Type", "text/plain")
+	})
+
+	assert.Equal(t, http.StatusUnsupportedMediaType, resp.StatusCode)
+	assert.Empty(t, stub.calls)
+	require.Len(t, *requests, 1)
+	assert.Equal(t, http.StatusUnsupportedMediaType, (*requests)[0].Status)
+
+	resp = post(t, srv.URL+"/v1/chat/completions", `{"model":"azure/gpt-4o"}`, func(r *http.Request) {
+		r.Header.Set("Content-Type", "application/json; charset=utf-8")
+	})
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestAllowedHost(t *testing.T) {
+	tests := []struct {
+		requestHost, listenHost string
+		want                    bool
+	}{
+		{"127.0.0.1:4000", "127.0.0.1", true},
+		{"localhost:4000", "127.0.0.1", true},
+		{"[::1]:4000", "127.0.0.1", true},
+		{"localhost", "127.0.0.1", true},
+		{"rebind.attacker.example:4000", "127.0.0.1", false},
+		{"10.0.0.5:4000", "127.0.0.1", false},
+		{"10.0.0.5:4000", "10.0.0.5", true},
+		{"gpu-box:4000", "gpu-box", true},
+		{"10.0.0.5:4000", "0.0.0.0", true},
+		{"rebind.attacker.example:4000", "0.0.0.0", false},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, allowedHost(tt.requestHost, tt.listenHost), "%s listening on %s", tt.requestHost, tt.listenHost)
+	}
+}
+
+func TestProxy_Models(t *testing.T) {
+	srv, _, _ := newTestProxy(t, "")
+
+	resp, err := http.Get(srv.URL + "/v1/models") //nolint:noctx // test server
+	require.NoError(t, err)
+
+	defer resp.Body.Close()
+
+	var got struct {
+		Object string `json:"object"`
+		Data   []struct {
+			ID      string `json:"id"`
+			OwnedBy string `json:"owned_by"`
+		} `json:"data"`
+	}
+
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&got))
+	assert.Equal(t, "list", got.Object)
+	require.Len(t, got.Data, 2)
+	assert.Equal(t, "azure/gpt-4o", got.Data[0].ID)
+	assert.Equal(t, "azure", got.Data[0].OwnedBy)
+	assert.Equal(t, "datarobot", got.Data[1].OwnedBy)
+}
@@ -0,0 +1,58 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package llmgateway
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// Usage is the token accounting an OpenAI-compatible response reports.
+type Usage struct {
+	PromptTokens     int `json:"prompt_tokens"`
+	CompletionTokens int `json:"completion_tokens"`
+	TotalTokens      int `json:"total_tokens"`
+}
+
+// usageOf returns the usage a completion or a stream chunk carries, or nil.
+// Streams report it only in their last chunk, and only when the request
+// set stream_options.include_usage.
+func usageOf(body []byte) *Usage {
+	var v struct {
+		Usage *Usage `json:"usage"`
+	}
+
+	if err := json.Unmarshal(body, &v); err != nil {
+		return nil
+	}
+
+	return v.Usage
+}
+
+// sseData returns the payload of a server-sent event "data:" line. The
+// stream's closing "[DONE]" has no payload.
+func sseData(line []byte) ([]byte, bool) {
+	data, ok := bytes.CutPrefix(bytes.TrimRight(line, "\r\n"), []byte("data:"))
+	if !ok {
+		return nil, false
+	}
+
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 || bytes.Equal(data, []byte("[DONE]")) {
+		return nil, false
+	}
+
+	return data, true
+}
@@ -0,0 +1,302 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apply
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+
+	"github.com/datarobot/cli/internal/pipeline"
+)
+
+// Options is everything Apply needs from its caller.
+type Options struct {
+	// StatePath is where the state is saved, after every step, so an apply
+	// that fails partway still records what it created and the next run
+	// picks up from there rather than creating it twice.
+	StatePath string
+
+	// Progress receives a line per completed step; nil for none.
+	Progress io.Writer
+}
+
+// Result is the state after an apply and the locked version, if any.
+type Result struct {
+	State   State
+	Version *int
+}
+
+// applier carries what the steps learn as they run: the ids they create and
+// the versions they mint, which later steps need.
+type applier struct {
+	bundle       *Bundle
+	state        State
+	version      *int
+	imageVersion int
+	opts         Options
+}
+
+// Apply carries out a plan built by Build from the same bundle and state.
+// On error the Result still holds the state as far as the apply got, which
+// has already been saved.
+func Apply(ctx context.Context, b *Bundle, st State, plan Plan, opts Options) (Result, error) {
+	a := &applier{
+		bundle:       b,
+		state:        cloneState(st),
+		version:      plan.Version,
+		imageVersion: plan.imageVersion,
+		opts:         opts,
+	}
+
+	for _, step := range plan.Steps {
+		err := a.do(ctx, step)
+		if err != nil {
+			return a.result(), fmt.Errorf("cannot %s %s %s: %w", step.Op, step.Kind, stepName(step), err)
+		}
+
+		if err := SaveState(opts.StatePath, a.state); err != nil {
+			return a.result(), err
+		}
+
+		if opts.Progress != nil {
+			fmt.Fprintln(opts.Progress, progressLine(step, a.idOf(step)))
+		}
+	}
+
+	return a.result(), nil
+}
+
+func (a *applier) result() Result {
+	return Result{State: a.state, Version: a.version}
+}
+
+func cloneState(st State) State {
+	st.Inputs = maps.Clone(st.Inputs)
+	st.Schedules = maps.Clone(st.Schedules)
+
+	if st.Inputs == nil {
+		st.Inputs = map[string]InputState{}
+	}
+
+	if st.Schedules == nil {
+		st.Schedules = map[string]ScheduleState{}
+	}
+
+	return st
+}
+
+func (a *applier) do(ctx context.Context, step Step) error {
+	switch step.Kind {
+	case KindImage:
+		return a.image(ctx, step)
+	case KindPipeline:
+		return a.pipeline(ctx, step)
+	case KindInput:
+		return a.input(ctx, step)
+	case KindSchedule:
+		return a.schedule(ctx, step)
+	default:
+		return fmt.Errorf("unknown kind %q", step.Kind)
+	}
+}
+
+func (a *applier) image(ctx context.Context, step Step) error {
+	spec := a.bundle.Image
+
+	var conda *pipeline.CondaValue
+	if spec != nil && spec.Conda != nil {
+		conda = &spec.Conda.CondaValue
+	}
+
+	switch step.Op {
+	case OpCreate:
+		img, err := createImageFn(ctx, a.bundle.imageName(), spec.Description, spec.Pip, conda, spec.PythonVersion, "", spec.GPU)
+		if err != nil {
+			return err
+		}
+
+		a.state.ImageID = img.ImageID
+		a.imageVersion = img.LatestVersion
+	case OpUpdate:
+		img, err := updateImageFn(ctx, step.ID, spec.Pip, conda, spec.PythonVersion, "", spec.GPU)
+		if err != nil {
+			return err
+		}
+
+		a.imageVersion = img.LatestVersion
+	case OpForget:
+		a.state.ImageID = ""
+	}
+
+	return nil
+}
+
+func (a *applier) pipeline(ctx context.Context, step Step) error {
+	b := a.bundle
+
+	switch step.Op {
+	case OpCreate:
+		created, err := createPipelineFn(ctx, b.SourcePath, b.Description, b.Name, "", a.state.ImageID)
+		if err != nil {
+			return err
+		}
+
+		a.state.PipelineID = created.PipelineID
+	case OpUpdate:
+		var file, imageID, name, description string
+
+		if slices.Contains(step.Changes, fieldSource) {
+			file = b.SourcePath
+		}
+
+		if slices.Contains(step.Changes, fieldImage) {
+			imageID = a.state.ImageID
+		}
+
+		if slices.Contains(step.Changes, fieldName) {
+			name = b.Name
+		}
+
+		if slices.Contains(step.Changes, fieldDescription) {
+			description = b.Description
+		}
+
+		if _, err := updatePipelineFn(ctx, a.state.PipelineID, file, imageID, name, description); err != nil {
+			return err
+		}
+	case OpLock:
+		locked, err := lockPipelineFn(ctx, a.state.PipelineID)
+		if err != nil {
+			return err
+		}
+
+		version := locked.Version
+		a.version = &version
+	}
+
+	return nil
+}
+
+// inputScope is where new input sets go: the locked version once there is
+// one, the draft otherwise.
+func (a *applier) inputScope() (pipeline.Scope, *int) {
+	if a.version != nil {
+		return pipeline.ScopeLocked, a.version
+	}
+
+	return pipeline.ScopeDraft, nil
+}
+
+func (a *applier) input(ctx context.Context, step Step) error {
+	switch step.Op {
+	case OpCreate:
+		scope, version := a.inputScope()
+
+		created, err := createInputFn(ctx, a.state.PipelineID, scope, version, a.bundle.Inputs[step.Name])
+		if err != nil {
+			return err
+		}
+
+		a.state.Inputs[step.Name] = InputState{ID: created.InputID, Version: version}
+	case OpUpdate:
+		if _, err := updateInputFn(ctx, a.state.PipelineID, step.ID, a.bundle.Inputs[step.Name]); err != nil {
+			return err
+		}
+	case OpForget:
+		delete(a.state.Inputs, step.Name)
+	}
+
+	return nil
+}
+
+func (a *applier) schedule(ctx context.Context, step Step) error {
+	spec := a.bundle.Schedules[step.Name]
+
+	switch step.Op {
+	case OpReplace:
+		if err := deleteScheduleFn(ctx, a.state.PipelineID, step.ID); err != nil && !isNotFound(err) {
+			return err
+		}
+
+		delete(a.state.Schedules, step.Name)
+
+		return a.createSchedule(ctx, step.Name, spec)
+	case OpCreate:
+		return a.createSchedule(ctx, step.Name, spec)
+	case OpUpdate:
+		var body pipeline.ScheduleUpdateRequest
+
+		if slices.Contains(step.Changes, fieldCron) {
+			body.CronExpression = &spec.Cron
+		}
+
+		if slices.Contains(step.Changes, fieldTimezone) {
+			body.Timezone = &spec.Timezone
+		}
+
+		if _, err := updateScheduleFn(ctx, a.state.PipelineID, step.ID, body); err != nil {
+			return err
+		}
+	case OpForget:
+		delete(a.state.Schedules, step.Name)
+	}
+
+	return nil
+}
+
+func (a *applier) createSchedule(ctx context.Context, name string, spec ScheduleSpec) error {
+	if a.version == nil {
+		return fmt.Errorf("pipeline %s is not locked", a.state.PipelineID)
+	}
+
+	inputID := a.state.Inputs[spec.Input].ID
+
+	created, err := createScheduleFn(ctx, a.state.PipelineID, pipeline.ScheduleCreateRequest{
+		CronExpression:    spec.Cron,
+		PipelineVersionID: *a.version,
+		PipelineInputID:   inputID,
+		ImageID:           a.state.ImageID,
+		ImageVersion:      a.imageVersion,
+		Timezone:          spec.Timezone,
+	})
+	if err != nil {
+		return err
+	}
+
+	a.state.Schedules[name] = ScheduleState{ID: created.ScheduleID, InputID: inputID}
+
+	return nil
+}
+
+// idOf is the id a finished step acted on, including one it just created.
+func (a *applier) idOf(step Step) string {
+	switch {
+	case step.Op == OpForget:
+		return step.ID
+	case step.Kind == KindImage:
+		return a.state.ImageID
+	case step.Kind == KindPipeline:
+		return a.state.PipelineID
+	case step.Kind == KindInput:
+		return a.state.Inputs[step.Name].ID
+	case step.Kind == KindSchedule:
+		return a.state.Schedules[step.Name].ID
+	default:
+		return step.ID
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apply
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/datarobot/cli/internal/pipeline"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApply_FreshBundle(t *testing.T) {
+	f := installFake(t)
+
+	b := writeBundle(t, testBundle)
+	st := applyAll(t, b, State{})
+
+	assert.Equal(t, []string{
+		"create image churn",
+		"create pipeline churn",
+		"lock pipeline " + st.PipelineID,
+		"create input",
+		"create input",
+		"create schedule",
+	}, f.calls)
+
+	p := f.pipelines[st.PipelineID]
+	assert.Equal(t, st.ImageID, *p.ImageID, "the pipeline is created against the new image")
+	assert.Equal(t, "Nightly churn scoring", p.Description)
+
+	in := f.inputs[st.Inputs["default"].ID]
+	require.NotNil(t, in.VersionID, "inputs go to the locked version")
+	assert.Equal(t, 1, *in.VersionID)
+
+	sch := f.schedules[st.Schedules["nightly"].ID]
+	assert.Equal(t, st.ImageID, sch.ImageID)
+	assert.Equal(t, 1, sch.ImageVersion)
+	assert.Equal(t, st.Inputs["default"].ID, f.inputOf[sch.ScheduleID])
+
+	saved, err := LoadState(StatePath(b.Path))
+	require.NoError(t, err)
+	assert.Equal(t, st, saved)
+}
+
+func TestApply_ReplaceSchedulePinsNewImageVersion(t *testing.T) {
+	f := installFake(t)
+
+	b := writeBundle(t, testBundle)
+	st := applyAll(t, b, State{})
+	old := st.Schedules["nightly"].ID
+
+	b.Image.GPU = true
+	st = applyAll(t, b, st)
+
+	assert.NotContains(t, f.schedules, old)
+
+	sch := f.schedules[st.Schedules["nightly"].ID]
+	assert.Equal(t, 2, sch.ImageVersion)
+	assert.True(t, build(t, b, st).Empty())
+}
+
+func TestApply_ForgetLeavesServerObjects(t *testing.T) {
+	f := installFake(t)
+
+	b := writeBundle(t, testBundle)
+	st := applyAll(t, b, State{})
+	large := st.Inputs["large"].ID
+
+	delete(b.Inputs, "large")
+	st = applyAll(t, b, st)
+
+	assert.NotContains(t, st.Inputs, "large")
+	assert.Contains(t, f.inputs, large)
+}
+
+func TestApply_FailurePartwaySavesProgress(t *testing.T) {
+	installFake(t)
+
+	force(t, &createInputFn, func(context.Context, string, pipeline.Scope, *int, map[string]any) (*pipeline.Input, error) {
+		return nil, errors.New("boom")
+	})
+
+	b := writeBundle(t, draftBundle)
+
+	plan := build(t, b, State{})
+	result, err := Apply(context.Background(), b, State{}, plan, Options{StatePath: StatePath(b.Path)})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot create input default: boom")
+
+	saved, loadErr := LoadState(StatePath(b.Path))
+	require.NoError(t, loadErr)
+	assert.NotEmpty(t, saved.PipelineID)
+	assert.Equal(t, result.State.PipelineID, saved.PipelineID)
+	assert.NotEmpty(t, saved.ImageID)
+}
+
+func TestApply_ReportsProgress(t *testing.T) {
+	installFake(t)
+
+	b := writeBundle(t, draftBundle)
+
+	var progress bytes.Buffer
+
+	plan := build(t, b, State{})
+	_, err := Apply(context.Background(), b, State{}, plan, Options{StatePath: StatePath(b.Path), Progress: &progress})
+	require.NoError(t, err)
+
+	assert.Contains(t, progress.String(), "created image churn")
+	assert.Contains(t, progress.String(), "created pipeline churn")
+	assert.Contains(t, progress.String(), "created input default")
+}
+
+func TestRender(t *testing.T) {
+	installFake(t)
+
+	b := writeBundle(t, testBundle)
+
+	var out bytes.Buffer
+
+	require.NoError(t, Render(&out, b, State{}, build(t, b, State{})))
+	assert.Contains(t, out.String(), "Pipeline churn, not created yet")
+	assert.Contains(t, out.String(), "+ image      churn will be created")
+	assert.Contains(t, out.String(), "~ pipeline   churn will be locked; this cannot be undone")
+
+	st := applyAll(t, b, State{})
+	out.Reset()
+
+	require.NoError(t, Render(&out, b, st, build(t, b, st)))
+	assert.Contains(t, out.String(), "locked at version 1")
+	assert.Contains(t, out.String(), "Already up to date")
+}
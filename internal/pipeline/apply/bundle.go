@@ -0,0 +1,210 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apply
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/datarobot/cli/internal/pipeline"
+	"gopkg.in/yaml.v3"
+)
+
+// Bundle is the parsed pipeline bundle file.
+type Bundle struct {
+	// Name is the pipeline name, and the image name when the image block
+	// does not give one.
+	Name        string `yaml:"name"`
+	Description string `yaml:"description"`
+
+	// Source is the pipeline's Python file, relative to the bundle.
+	Source string `yaml:"source"`
+
+	// Image is the execution image, nil when the pipeline runs without one.
+	Image *ImageSpec `yaml:"image"`
+
+	// Lock promotes the pipeline to locked mode once its source is
+	// uploaded. Schedules need it: the service only schedules locked
+	// versions.
+	Lock bool `yaml:"lock"`
+
+	// Inputs are the named input set payloads.
+	Inputs map[string]map[string]any `yaml:"inputs"`
+
+	// Schedules are the named recurring runs.
+	Schedules map[string]ScheduleSpec `yaml:"schedules"`
+
+	// Path is the bundle file and SourcePath the resolved source file.
+	Path       string `yaml:"-"`
+	SourcePath string `yaml:"-"`
+
+	// SourceBytes is the source file as read when the bundle was loaded, so
+	// the plan and the upload see the same content.
+	SourceBytes []byte `yaml:"-"`
+}
+
+// ImageSpec is the bundle's execution image definition.
+type ImageSpec struct {
+	Name          string     `yaml:"name"`
+	Description   string     `yaml:"description"`
+	PythonVersion string     `yaml:"pythonVersion"`
+	Pip           []string   `yaml:"pip"`
+	Conda         *CondaSpec `yaml:"conda"`
+	GPU           bool       `yaml:"gpu"`
+}
+
+// CondaSpec accepts conda packages the same two ways the API does: a plain
+// list of packages, or a map with channels and dependencies.
+type CondaSpec struct {
+	pipeline.CondaValue
+}
+
+// UnmarshalYAML decodes either conda form.
+func (c *CondaSpec) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.SequenceNode {
+		return node.Decode(&c.Deps)
+	}
+
+	var spec struct {
+		Channels     []string `yaml:"channels"`
+		Dependencies []string `yaml:"dependencies"`
+	}
+
+	if err := node.Decode(&spec); err != nil {
+		return err
+	}
+
+	c.Channels = spec.Channels
+	c.Deps = spec.Dependencies
+
+	return nil
+}
+
+// ScheduleSpec is one named schedule. Input names an entry of Inputs.
+type ScheduleSpec struct {
+	Cron     string `yaml:"cron"`
+	Timezone string `yaml:"timezone"`
+	Input    string `yaml:"input"`
+}
+
+// imageName is the name a new image is created with.
+func (b *Bundle) imageName() string {
+	if b.Image.Name != "" {
+		return b.Image.Name
+	}
+
+	return b.Name
+}
+
+// LoadBundle reads, decodes and validates a bundle file, and reads the
+// source file it names. Unknown keys are errors: a misspelt key would
+// otherwise be a setting the plan silently never applies.
+func LoadBundle(path string) (*Bundle, error) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return nil, fmt.Errorf("cannot resolve %s: %w", path, err)
+	}
+
+	data, err := os.ReadFile(abs)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read bundle: %w", err)
+	}
+
+	var b Bundle
+
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+
+	if err := decoder.Decode(&b); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("invalid bundle %s: %w", path, err)
+	}
+
+	b.Path = abs
+
+	if err := b.validate(); err != nil {
+		return nil, fmt.Errorf("invalid bundle %s: %w", path, err)
+	}
+
+	b.SourcePath = b.Source
+	if !filepath.IsAbs(b.SourcePath) {
+		b.SourcePath = filepath.Join(filepath.Dir(abs), b.SourcePath)
+	}
+
+	b.SourceBytes, err = os.ReadFile(b.SourcePath)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read pipeline source: %w", err)
+	}
+
+	return &b, nil
+}
+
+// validate reports the first thing in the bundle the service would reject,
+// or that the plan could not act on.
+func (b *Bundle) validate() error {
+	if strings.TrimSpace(b.Name) == "" {
+		return errors.New("name is required")
+	}
+
+	if strings.TrimSpace(b.Source) == "" {
+		return errors.New("source is required")
+	}
+
+	if b.Image != nil {
+		if err := pipeline.ValidatePythonVersion(b.Image.PythonVersion); err != nil {
+			return fmt.Errorf("image: %w", err)
+		}
+	}
+
+	for name := range b.Inputs {
+		if strings.TrimSpace(name) == "" {
+			return errors.New("inputs: every input set needs a name")
+		}
+	}
+
+	if len(b.Schedules) > 0 && !b.Lock {
+		return errors.New("schedules need lock: true; the service only schedules locked versions")
+	}
+
+	if len(b.Schedules) > 0 && b.Image == nil {
+		return errors.New("schedules need an image; every scheduled run names one")
+	}
+
+	for _, name := range sortedKeys(b.Schedules) {
+		s := b.Schedules[name]
+
+		if strings.TrimSpace(s.Cron) == "" {
+			return fmt.Errorf("schedules.%s: cron is required", name)
+		}
+
+		if _, ok := b.Inputs[s.Input]; !ok {
+			return fmt.Errorf("schedules.%s: input %q is not one of the bundle's inputs", name, s.Input)
+		}
+	}
+
+	return nil
+}
+
+// sortedKeys returns a map's keys in order, so plans and errors come out
+// the same on every run.
+func sortedKeys[V any](m map[string]V) []string {
+	return slices.Sorted(maps.Keys(m))
+}
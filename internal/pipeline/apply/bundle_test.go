@@ -0,0 +1,77 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apply
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadBundle(t *testing.T) {
+	b := writeBundle(t, testBundle)
+
+	assert.Equal(t, "churn", b.Name)
+	assert.Equal(t, filepath.Join(filepath.Dir(b.Path), "pipeline.py"), b.SourcePath)
+	assert.Equal(t, testSource, string(b.SourceBytes))
+	require.NotNil(t, b.Image)
+	assert.Equal(t, []string{"numpy"}, b.Image.Conda.Deps)
+	assert.Equal(t, []string{"conda-forge"}, b.Image.Conda.Channels)
+	assert.Equal(t, "churn", b.imageName(), "the image is named after the pipeline by default")
+	assert.Equal(t, 100, b.Inputs["default"]["rows"])
+	assert.Equal(t, "default", b.Schedules["nightly"].Input)
+}
+
+func TestLoadBundle_CondaList(t *testing.T) {
+	b := writeBundle(t, "name: p\nsource: pipeline.py\nimage:\n  name: img\n  conda: [numpy, scipy]\n")
+
+	assert.Equal(t, []string{"numpy", "scipy"}, b.Image.Conda.Deps)
+	assert.Empty(t, b.Image.Conda.Channels)
+	assert.Equal(t, "img", b.imageName())
+}
+
+func TestLoadBundle_Invalid(t *testing.T) {
+	cases := map[string]struct {
+		content string
+		want    string
+	}{
+		"no name":             {"source: pipeline.py\n", "name is required"},
+		"no source":           {"name: p\n", "source is required"},
+		"unknown key":         {"name: p\nsource: pipeline.py\nschedule: {}\n", "field schedule not found"},
+		"bad python":          {"name: p\nsource: pipeline.py\nimage: {pythonVersion: '2.7'}\n", "invalid --python-version"},
+		"schedule unlocked":   {"name: p\nsource: pipeline.py\nimage: {}\nschedules: {s: {cron: x, input: a}}\ninputs: {a: {}}\n", "lock: true"},
+		"schedule no image":   {"name: p\nsource: pipeline.py\nlock: true\nschedules: {s: {cron: x, input: a}}\ninputs: {a: {}}\n", "need an image"},
+		"schedule no cron":    {"name: p\nsource: pipeline.py\nimage: {}\nlock: true\nschedules: {s: {input: a}}\ninputs: {a: {}}\n", "schedules.s: cron is required"},
+		"schedule bad input":  {"name: p\nsource: pipeline.py\nimage: {}\nlock: true\nschedules: {s: {cron: x, input: b}}\ninputs: {a: {}}\n", `input "b" is not one of`},
+		"missing source file": {"name: p\nsource: nope.py\n", "cannot read pipeline source"},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			path := filepath.Join(dir, "pipeline.yaml")
+
+			require.NoError(t, os.WriteFile(path, []byte(tc.content), 0o644))
+			require.NoError(t, os.WriteFile(filepath.Join(dir, "pipeline.py"), []byte(testSource), 0o644))
+
+			_, err := LoadBundle(path)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.want)
+		})
+	}
+}
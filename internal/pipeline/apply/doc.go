@@ -0,0 +1,42 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package apply is the reconcile behind `dr pipeline apply`: read a pipeline
+// bundle, look at what the pipelines service holds for it, plan the
+// difference, and create or update only that.
+//
+// A bundle is one YAML file naming a pipeline's source file, its execution
+// image, its named input sets and its schedules. None of those carry a name
+// the server can be searched by, so which server objects a bundle owns is
+// recorded in a local state file beside it, under .datarobot/pipeline/. The
+// state holds ids and nothing else: every comparison is made against the
+// live object the id points at, so an edit made with the other `dr pipeline`
+// commands shows up as drift and is put back.
+//
+// The lifecycle of the service decides most of the plan. Image definitions
+// are immutable, so a changed one becomes a new image version. A draft
+// pipeline takes a new source upload as a new version; a locked one never
+// changes again, and a bundle whose source moves after locking is an error
+// that names the way out rather than a plan. Locked inputs are immutable
+// too, so a changed payload is a new input set, and schedules pin a pipeline
+// version, an input and an image version, so moving any of those replaces
+// the schedule while a new cron or timezone is patched in place.
+//
+// Like `dr workload up`, what the bundle leaves out is left alone. Deleting
+// an input or a schedule from the file stops managing it: it is dropped from
+// the state and reported, never deleted from the server.
+//
+// Non-scope: no cobra. The command renders nothing but what Render and the
+// Progress writer are handed; this package returns values.
+package apply
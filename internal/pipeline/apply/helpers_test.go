@@ -0,0 +1,316 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apply
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/datarobot/cli/internal/drapi"
+	"github.com/datarobot/cli/internal/pipeline"
+	"github.com/stretchr/testify/require"
+)
+
+const testSource = "from datarobot import pipeline\n"
+
+// testBundle is a bundle using every block: an image, a lock, two inputs
+// and a schedule.
+const testBundle = `name: churn
+description: Nightly churn scoring
+source: pipeline.py
+image:
+  pythonVersion: "3.12"
+  pip: [pandas==2.2.0]
+  conda:
+    channels: [conda-forge]
+    dependencies: [numpy]
+lock: true
+inputs:
+  default:
+    rows: 100
+  large:
+    rows: 100000
+    nested: {a: 1}
+schedules:
+  nightly:
+    cron: "0 2 * * *"
+    timezone: UTC
+    input: default
+`
+
+// writeBundle writes a bundle and its source file into a temp dir and loads
+// it.
+func writeBundle(t *testing.T, content string) *Bundle {
+	t.Helper()
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "pipeline.yaml")
+
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "pipeline.py"), []byte(testSource), 0o644))
+
+	b, err := LoadBundle(path)
+	require.NoError(t, err)
+
+	return b
+}
+
+// fakeService is an in-memory pipelines service behind the package seams.
+type fakeService struct {
+	images    map[string]*pipeline.Image
+	pipelines map[string]*pipeline.Pipeline
+	sources   map[string]string
+	inputs    map[string]*pipeline.Input
+	schedules map[string]*pipeline.Schedule
+	inputOf   map[string]string
+
+	calls []string
+	next  int
+}
+
+func notFound() error {
+	return &drapi.HTTPError{StatusCode: http.StatusNotFound, URL: "x"}
+}
+
+func (f *fakeService) id(prefix string) string {
+	f.next++
+
+	return fmt.Sprintf("%s-%d", prefix, f.next)
+}
+
+func (f *fakeService) call(format string, args ...any) {
+	f.calls = append(f.calls, fmt.Sprintf(format, args...))
+}
+
+// installFake replaces every seam with the fake for the test's duration.
+func installFake(t *testing.T) *fakeService {
+	t.Helper()
+
+	f := &fakeService{
+		images:    map[string]*pipeline.Image{},
+		pipelines: map[string]*pipeline.Pipeline{},
+		sources:   map[string]string{},
+		inputs:    map[string]*pipeline.Input{},
+		schedules: map[string]*pipeline.Schedule{},
+		inputOf:   map[string]string{},
+	}
+
+	force(t, &getImageFn, func(_ context.Context, id string) (*pipeline.Image, error) {
+		if img, ok := f.images[id]; ok {
+			return img, nil
+		}
+
+		return nil, notFound()
+	})
+
+	force(t, &createImageFn, func(_ context.Context, name, _ string, pip []string, conda *pipeline.CondaValue, python, _ string, gpu bool) (*pipeline.Image, error) {
+		img := &pipeline.Image{ImageID: f.id("img"), Name: name}
+		f.images[img.ImageID] = img
+		f.addImageVersion(img, pip, conda, python, gpu)
+		f.call("create image %s", name)
+
+		return img, nil
+	})
+
+	force(t, &updateImageFn, func(_ context.Context, id string, pip []string, conda *pipeline.CondaValue, python, _ string, gpu bool) (*pipeline.Image, error) {
+		img := f.images[id]
+		f.addImageVersion(img, pip, conda, python, gpu)
+		f.call("update image %s", id)
+
+		return img, nil
+	})
+
+	force(t, &getPipelineFn, func(_ context.Context, id string) (*pipeline.Pipeline, error) {
+		if p, ok := f.pipelines[id]; ok {
+			return p, nil
+		}
+
+		return nil, notFound()
+	})
+
+	force(t, &getSourceFn, func(_ context.Context, id string, _ pipeline.Scope, _ *int) (*pipeline.PipelineSourceResponse, error) {
+		return &pipeline.PipelineSourceResponse{Source: f.sources[id]}, nil
+	})
+
+	force(t, &createPipelineFn, func(_ context.Context, file, description, name, _, imageID string) (*pipeline.CreateResponse, error) {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+
+		p := &pipeline.Pipeline{
+			PipelineID: f.id("pl"), Name: name, Description: description, Mode: pipeline.ModeDraft,
+			ImageID: &imageID, Versions: []pipeline.PipelineVersion{{Version: 1}},
+		}
+		f.pipelines[p.PipelineID] = p
+		f.sources[p.PipelineID] = string(data)
+		f.call("create pipeline %s", name)
+
+		return &pipeline.CreateResponse{PipelineID: p.PipelineID, Version: 1}, nil
+	})
+
+	force(t, &updatePipelineFn, func(_ context.Context, id, file, imageID, name, description string) (*pipeline.CreateResponse, error) {
+		p := f.pipelines[id]
+
+		if file != "" {
+			data, err := os.ReadFile(file)
+			if err != nil {
+				return nil, err
+			}
+
+			f.sources[id] = string(data)
+			p.Versions = append(p.Versions, pipeline.PipelineVersion{Version: len(p.Versions) + 1})
+		}
+
+		if imageID != "" {
+			p.ImageID = &imageID
+		}
+
+		if name != "" {
+			p.Name = name
+		}
+
+		if description != "" {
+			p.Description = description
+		}
+
+		f.call("update pipeline %s", id)
+
+		return &pipeline.CreateResponse{PipelineID: id}, nil
+	})
+
+	force(t, &lockPipelineFn, func(_ context.Context, id string) (*pipeline.CreateResponse, error) {
+		p := f.pipelines[id]
+		p.Mode = pipeline.ModeLocked
+		f.call("lock pipeline %s", id)
+
+		return &pipeline.CreateResponse{PipelineID: id, Version: latestVersion(p)}, nil
+	})
+
+	force(t, &getInputFn, func(_ context.Context, _ string, _ pipeline.Scope, _ *int, id string) (*pipeline.Input, error) {
+		if in, ok := f.inputs[id]; ok {
+			return in, nil
+		}
+
+		return nil, notFound()
+	})
+
+	force(t, &createInputFn, func(_ context.Context, pid string, _ pipeline.Scope, version *int, payload map[string]any) (*pipeline.Input, error) {
+		in := &pipeline.Input{InputID: f.id("in"), PipelineID: pid, VersionID: version, Payload: roundTrip(payload)}
+		f.inputs[in.InputID] = in
+		f.call("create input")
+
+		return in, nil
+	})
+
+	force(t, &updateInputFn, func(_ context.Context, _, id string, payload map[string]any) (*pipeline.Input, error) {
+		f.inputs[id].Payload = roundTrip(payload)
+		f.call("update input %s", id)
+
+		return f.inputs[id], nil
+	})
+
+	force(t, &getScheduleFn, func(_ context.Context, _, id string) (*pipeline.Schedule, error) {
+		if s, ok := f.schedules[id]; ok {
+			return s, nil
+		}
+
+		return nil, notFound()
+	})
+
+	force(t, &createScheduleFn, func(_ context.Context, pid string, body pipeline.ScheduleCreateRequest) (*pipeline.Schedule, error) {
+		s := &pipeline.Schedule{
+			ScheduleID: f.id("sch"), PipelineID: pid, Version: body.PipelineVersionID, ImageID: body.ImageID,
+			ImageVersion: body.ImageVersion, CronExpression: body.CronExpression, Timezone: body.Timezone,
+			Status: pipeline.ScheduleStatusActive,
+		}
+		f.schedules[s.ScheduleID] = s
+		f.inputOf[s.ScheduleID] = body.PipelineInputID
+		f.call("create schedule")
+
+		return s, nil
+	})
+
+	force(t, &updateScheduleFn, func(_ context.Context, _, id string, body pipeline.ScheduleUpdateRequest) (*pipeline.Schedule, error) {
+		s := f.schedules[id]
+
+		if body.CronExpression != nil {
+			s.CronExpression = *body.CronExpression
+		}
+
+		if body.Timezone != nil {
+			s.Timezone = *body.Timezone
+		}
+
+		f.call("update schedule %s", id)
+
+		return s, nil
+	})
+
+	force(t, &deleteScheduleFn, func(_ context.Context, _, id string) error {
+		delete(f.schedules, id)
+		f.call("delete schedule %s", id)
+
+		return nil
+	})
+
+	return f
+}
+
+func (f *fakeService) addImageVersion(img *pipeline.Image, pip []string, conda *pipeline.CondaValue, python string, gpu bool) {
+	img.LatestVersion++
+
+	def := pipeline.ImageDefinition{Name: img.Name, Pip: pip, Conda: conda, Gpu: gpu}
+	if python != "" {
+		def.PythonVersion = &python
+	}
+
+	img.Versions = append([]pipeline.ImageVersion{{Version: img.LatestVersion, Definition: def}}, img.Versions...)
+}
+
+// force installs value over the seam at target, restoring what was there
+// when the test ends.
+func force[F any](t *testing.T, target *F, value F) {
+	t.Helper()
+
+	prev := *target
+	*target = value
+
+	t.Cleanup(func() { *target = prev })
+}
+
+// roundTrip stores a payload the way the API hands it back: through JSON.
+func roundTrip(payload map[string]any) map[string]any {
+	out, _ := normalise(payload).(map[string]any)
+
+	return out
+}
+
+// applyAll plans and applies b against the fake, returning the new state.
+func applyAll(t *testing.T, b *Bundle, st State) State {
+	t.Helper()
+
+	plan, err := Build(context.Background(), b, st)
+	require.NoError(t, err)
+
+	result, err := Apply(context.Background(), b, st, plan, Options{StatePath: StatePath(b.Path)})
+	require.NoError(t, err)
+
+	return result.State
+}
@@ -0,0 +1,515 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apply
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/datarobot/cli/internal/drapi"
+	"github.com/datarobot/cli/internal/pipeline"
+)
+
+// Test seams. The plan and the apply are all network, and the tests are not.
+var (
+	getImageFn       = pipeline.GetImage
+	createImageFn    = pipeline.CreateImage
+	updateImageFn    = pipeline.UpdateImage
+	getPipelineFn    = pipeline.GetPipeline
+	getSourceFn      = pipeline.GetPipelineSource
+	createPipelineFn = pipeline.CreatePipeline
+	updatePipelineFn = pipeline.UpdatePipeline
+	lockPipelineFn   = pipeline.LockPipeline
+	getInputFn       = pipeline.GetInput
+	createInputFn    = pipeline.CreateInput
+	updateInputFn    = pipeline.UpdateInput
+	getScheduleFn    = pipeline.GetSchedule
+	createScheduleFn = pipeline.CreateSchedule
+	updateScheduleFn = pipeline.UpdateSchedule
+	deleteScheduleFn = pipeline.DeleteSchedule
+)
+
+// The kinds of object a bundle manages, in the order an apply touches them:
+// a pipeline links its image, inputs belong to a pipeline version, and a
+// schedule pins all three.
+const (
+	KindImage    = "image"
+	KindPipeline = "pipeline"
+	KindInput    = "input"
+	KindSchedule = "schedule"
+)
+
+// The operations a step can perform.
+const (
+	OpCreate = "create"
+	OpUpdate = "update"
+	OpLock   = "lock"
+
+	// OpReplace deletes the object and creates it again, for a schedule
+	// whose pinned version, input or image moved.
+	OpReplace = "replace"
+
+	// OpForget drops an object the bundle no longer mentions from the
+	// state. The object itself is left on the server.
+	OpForget = "forget"
+)
+
+// Field names a step's Changes list.
+const (
+	fieldSource       = "source"
+	fieldName         = "name"
+	fieldDescription  = "description"
+	fieldImage        = "image"
+	fieldPip          = "pip"
+	fieldConda        = "conda"
+	fieldPython       = "pythonVersion"
+	fieldGPU          = "gpu"
+	fieldPayload      = "payload"
+	fieldCron         = "cron"
+	fieldTimezone     = "timezone"
+	fieldInput        = "input"
+	fieldVersion      = "pipeline version"
+	fieldImageVersion = "image version"
+)
+
+// Step is one thing an apply does to one object.
+type Step struct {
+	Kind string `json:"kind"`
+
+	// Name is the object's name in the bundle: the pipeline or image name,
+	// or the key of an input set or schedule.
+	Name string `json:"name"`
+	Op   string `json:"op"`
+
+	// ID is the server id the step acts on, empty for a create.
+	ID string `json:"id,omitempty"`
+
+	// Changes lists the fields that differ, for an update or a replace.
+	Changes []string `json:"changes,omitempty"`
+
+	// Note says why a step is not the obvious one, such as a create for an
+	// input the state already records.
+	Note string `json:"note,omitempty"`
+}
+
+// Plan is what an apply intends to do, worked out before it does any of it.
+type Plan struct {
+	// Locked reports whether the pipeline is locked already, and Version is
+	// its locked version when it is.
+	Locked  bool `json:"locked"`
+	Version *int `json:"version,omitempty"`
+
+	Steps []Step `json:"steps"`
+
+	// imageVersion is the latest version of an image the plan leaves as it
+	// is; a step that creates or updates the image replaces it.
+	imageVersion int
+}
+
+// Empty reports that the server already matches the bundle.
+func (p Plan) Empty() bool {
+	return len(p.Steps) == 0
+}
+
+func (p *Plan) add(s Step) {
+	p.Steps = append(p.Steps, s)
+}
+
+// Build works out what differs between the bundle and the objects the state
+// records. It reads from the server and changes nothing.
+func Build(ctx context.Context, b *Bundle, st State) (Plan, error) {
+	var plan Plan
+
+	imageMoved, err := plan.image(ctx, b, st)
+	if err != nil {
+		return Plan{}, err
+	}
+
+	created, err := plan.pipeline(ctx, b, st, imageMoved)
+	if err != nil {
+		return Plan{}, err
+	}
+
+	newInputs, err := plan.inputs(ctx, b, st, created)
+	if err != nil {
+		return Plan{}, err
+	}
+
+	if err := plan.schedules(ctx, b, st, created, imageMoved, newInputs); err != nil {
+		return Plan{}, err
+	}
+
+	return plan, nil
+}
+
+// image plans the execution image and reports whether its latest version
+// will change, which anything pinning an image version has to follow.
+func (p *Plan) image(ctx context.Context, b *Bundle, st State) (bool, error) {
+	if b.Image == nil {
+		if st.ImageID != "" {
+			p.add(Step{Kind: KindImage, Op: OpForget, ID: st.ImageID})
+		}
+
+		return false, nil
+	}
+
+	if st.ImageID == "" {
+		p.add(Step{Kind: KindImage, Name: b.imageName(), Op: OpCreate})
+
+		return true, nil
+	}
+
+	img, err := getImageFn(ctx, st.ImageID)
+	if err != nil {
+		return false, missing(err, b, "image", st.ImageID, "imageId")
+	}
+
+	p.imageVersion = img.LatestVersion
+
+	changes := imageChanges(b.Image, latestDefinition(img))
+	if len(changes) == 0 {
+		return false, nil
+	}
+
+	p.add(Step{Kind: KindImage, Name: img.Name, Op: OpUpdate, ID: img.ImageID, Changes: changes})
+
+	return true, nil
+}
+
+// latestDefinition is the definition of an image's newest version.
+func latestDefinition(img *pipeline.Image) pipeline.ImageDefinition {
+	for _, v := range img.Versions {
+		if v.Version == img.LatestVersion {
+			return v.Definition
+		}
+	}
+
+	return pipeline.ImageDefinition{}
+}
+
+// imageChanges compares the bundle's image with a live definition. A Python
+// version the bundle does not give is not compared, because the service
+// fills in its default and that would otherwise read as drift on every run.
+func imageChanges(want *ImageSpec, have pipeline.ImageDefinition) []string {
+	var changes []string
+
+	if !slices.Equal(orEmpty(want.Pip), orEmpty(have.Pip)) {
+		changes = append(changes, fieldPip)
+	}
+
+	var wantConda, haveConda pipeline.CondaValue
+	if want.Conda != nil {
+		wantConda = want.Conda.CondaValue
+	}
+
+	if have.Conda != nil {
+		haveConda = *have.Conda
+	}
+
+	if !slices.Equal(orEmpty(wantConda.Deps), orEmpty(haveConda.Deps)) ||
+		!slices.Equal(orEmpty(wantConda.Channels), orEmpty(haveConda.Channels)) {
+		changes = append(changes, fieldConda)
+	}
+
+	if want.PythonVersion != "" && (have.PythonVersion == nil || *have.PythonVersion != want.PythonVersion) {
+		changes = append(changes, fieldPython)
+	}
+
+	if want.GPU != have.Gpu {
+		changes = append(changes, fieldGPU)
+	}
+
+	return changes
+}
+
+func orEmpty(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+
+	return s
+}
+
+// pipeline plans the pipeline itself and reports whether it is created.
+func (p *Plan) pipeline(ctx context.Context, b *Bundle, st State, imageMoved bool) (bool, error) {
+	if st.PipelineID == "" {
+		p.add(Step{Kind: KindPipeline, Name: b.Name, Op: OpCreate})
+
+		if b.Lock {
+			p.add(Step{Kind: KindPipeline, Name: b.Name, Op: OpLock})
+		}
+
+		return true, nil
+	}
+
+	live, err := getPipelineFn(ctx, st.PipelineID)
+	if err != nil {
+		return false, missing(err, b, "pipeline", st.PipelineID, "pipelineId")
+	}
+
+	scope, version := pipeline.ScopeDraft, (*int)(nil)
+
+	if live.Mode == pipeline.ModeLocked {
+		v := latestVersion(live)
+		scope, version = pipeline.ScopeLocked, &v
+		p.Locked, p.Version = true, &v
+	}
+
+	source, err := getSourceFn(ctx, live.PipelineID, scope, version)
+	if err != nil {
+		return false, err
+	}
+
+	var changes []string
+
+	if strings.TrimSpace(source.Source) != strings.TrimSpace(string(b.SourceBytes)) {
+		changes = append(changes, fieldSource)
+	}
+
+	if live.Name != b.Name {
+		changes = append(changes, fieldName)
+	}
+
+	if b.Description != "" && live.Description != b.Description {
+		changes = append(changes, fieldDescription)
+	}
+
+	if p.Locked {
+		if !b.Lock {
+			return false, fmt.Errorf("pipeline %s is locked and locking cannot be undone; set lock: true in %s",
+				live.PipelineID, b.Path)
+		}
+
+		// The image is not relinked: a locked pipeline takes no updates, and
+		// runs and schedules name their image version themselves.
+		if len(changes) > 0 {
+			return false, fmt.Errorf(
+				"pipeline %s is locked, so its %s can no longer change. "+
+					"Run 'dr pipeline clone %s' and put the clone's id in %s, "+
+					"or remove pipelineId from it to create a new pipeline",
+				live.PipelineID, strings.Join(changes, ", "), live.PipelineID, StatePath(b.Path))
+		}
+
+		return false, nil
+	}
+
+	if b.Image != nil && (imageMoved || live.ImageID == nil || *live.ImageID != st.ImageID) {
+		changes = append(changes, fieldImage)
+	}
+
+	if len(changes) > 0 {
+		p.add(Step{Kind: KindPipeline, Name: b.Name, Op: OpUpdate, ID: live.PipelineID, Changes: changes})
+	}
+
+	if b.Lock {
+		p.add(Step{Kind: KindPipeline, Name: b.Name, Op: OpLock, ID: live.PipelineID})
+	}
+
+	return false, nil
+}
+
+// latestVersion is the newest version a pipeline has, which for a locked
+// pipeline is the locked one.
+func latestVersion(p *pipeline.Pipeline) int {
+	latest := 0
+
+	for _, v := range p.Versions {
+		latest = max(latest, v.Version)
+	}
+
+	return latest
+}
+
+// inputs plans the input sets and returns the names that end up with a new
+// id, which schedules running them have to follow.
+func (p *Plan) inputs(ctx context.Context, b *Bundle, st State, created bool) (map[string]bool, error) {
+	fresh := map[string]bool{}
+
+	create := func(name, note string) {
+		p.add(Step{Kind: KindInput, Name: name, Op: OpCreate, Note: note})
+		fresh[name] = true
+	}
+
+	for _, name := range sortedKeys(b.Inputs) {
+		recorded, ok := st.Inputs[name]
+
+		switch {
+		case created || !ok:
+			create(name, "")
+
+			continue
+		case b.Lock && (recorded.Version == nil || p.Version == nil || *recorded.Version != *p.Version):
+			create(name, "for the locked version")
+
+			continue
+		}
+
+		scope := pipeline.ScopeDraft
+		if recorded.Version != nil {
+			scope = pipeline.ScopeLocked
+		}
+
+		live, err := getInputFn(ctx, st.PipelineID, scope, recorded.Version, recorded.ID)
+		if isNotFound(err) {
+			create(name, "the recorded input set no longer exists")
+
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if samePayload(b.Inputs[name], live.Payload) {
+			continue
+		}
+
+		if recorded.Version != nil {
+			create(name, "locked input sets are immutable, so a changed payload is a new one")
+
+			continue
+		}
+
+		p.add(Step{Kind: KindInput, Name: name, Op: OpUpdate, ID: recorded.ID, Changes: []string{fieldPayload}})
+	}
+
+	for _, name := range sortedKeys(st.Inputs) {
+		if _, ok := b.Inputs[name]; !ok {
+			p.add(Step{Kind: KindInput, Name: name, Op: OpForget, ID: st.Inputs[name].ID})
+		}
+	}
+
+	return fresh, nil
+}
+
+// samePayload compares a bundle payload with a live one through JSON, so a
+// YAML integer and the float64 the API decodes to are the same value.
+func samePayload(want, have map[string]any) bool {
+	return reflect.DeepEqual(normalise(want), normalise(have))
+}
+
+func normalise(v map[string]any) any {
+	if v == nil {
+		v = map[string]any{}
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return v
+	}
+
+	var out any
+
+	if err := json.Unmarshal(data, &out); err != nil {
+		return v
+	}
+
+	return out
+}
+
+// schedules plans the schedules. Everything a schedule pins to (pipeline
+// version, input set, image version) can only change by replacing it; the
+// cron expression and timezone are patched in place.
+func (p *Plan) schedules(ctx context.Context, b *Bundle, st State, created, imageMoved bool, newInputs map[string]bool) error {
+	for _, name := range sortedKeys(b.Schedules) {
+		spec := b.Schedules[name]
+		recorded, ok := st.Schedules[name]
+
+		if created || !ok || p.Version == nil {
+			p.add(Step{Kind: KindSchedule, Name: name, Op: OpCreate})
+
+			continue
+		}
+
+		live, err := getScheduleFn(ctx, st.PipelineID, recorded.ID)
+		if isNotFound(err) || err == nil && live.Status == pipeline.ScheduleStatusDeleted {
+			p.add(Step{Kind: KindSchedule, Name: name, Op: OpCreate, Note: "the recorded schedule no longer exists"})
+
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		var pins []string
+
+		if newInputs[spec.Input] || recorded.InputID != st.Inputs[spec.Input].ID {
+			pins = append(pins, fieldInput)
+		}
+
+		if live.Version != *p.Version {
+			pins = append(pins, fieldVersion)
+		}
+
+		if live.ImageID != st.ImageID {
+			pins = append(pins, fieldImage)
+		} else if imageMoved || live.ImageVersion != p.imageVersion {
+			pins = append(pins, fieldImageVersion)
+		}
+
+		if len(pins) > 0 {
+			p.add(Step{Kind: KindSchedule, Name: name, Op: OpReplace, ID: live.ScheduleID, Changes: pins})
+
+			continue
+		}
+
+		var changes []string
+
+		if live.CronExpression != spec.Cron {
+			changes = append(changes, fieldCron)
+		}
+
+		if spec.Timezone != "" && live.Timezone != spec.Timezone {
+			changes = append(changes, fieldTimezone)
+		}
+
+		if len(changes) > 0 {
+			p.add(Step{Kind: KindSchedule, Name: name, Op: OpUpdate, ID: live.ScheduleID, Changes: changes})
+		}
+	}
+
+	for _, name := range sortedKeys(st.Schedules) {
+		if _, ok := b.Schedules[name]; !ok {
+			p.add(Step{Kind: KindSchedule, Name: name, Op: OpForget, ID: st.Schedules[name].ID})
+		}
+	}
+
+	return nil
+}
+
+// missing turns a 404 for an object the state records into an error naming
+// the fix. Quietly creating a replacement would leave two objects behind
+// where the user expects one, and no way to tell which the bundle owns.
+func missing(err error, b *Bundle, kind, id, key string) error {
+	if !isNotFound(err) {
+		return err
+	}
+
+	return fmt.Errorf("%s %s recorded in %s no longer exists; remove %s from the state file to create a new one",
+		kind, id, StatePath(b.Path), key)
+}
+
+func isNotFound(err error) bool {
+	var httpErr *drapi.HTTPError
+
+	return errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusNotFound
+}
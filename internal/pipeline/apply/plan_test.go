@@ -0,0 +1,226 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apply
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// draftBundle is testBundle without the lock or the schedule.
+const draftBundle = `name: churn
+source: pipeline.py
+image:
+  pip: [pandas==2.2.0]
+inputs:
+  default:
+    rows: 100
+`
+
+// ops summarises a plan as "op kind name" lines.
+func ops(plan Plan) []string {
+	out := make([]string, 0, len(plan.Steps))
+
+	for _, s := range plan.Steps {
+		out = append(out, s.Op+" "+s.Kind+" "+stepName(s))
+	}
+
+	return out
+}
+
+func build(t *testing.T, b *Bundle, st State) Plan {
+	t.Helper()
+
+	plan, err := Build(context.Background(), b, st)
+	require.NoError(t, err)
+
+	return plan
+}
+
+func TestBuild_FreshBundleCreatesEverythingInOrder(t *testing.T) {
+	installFake(t)
+
+	plan := build(t, writeBundle(t, testBundle), State{})
+
+	assert.Equal(t, []string{
+		"create image churn",
+		"create pipeline churn",
+		"lock pipeline churn",
+		"create input default",
+		"create input large",
+		"create schedule nightly",
+	}, ops(plan))
+}
+
+func TestBuild_AppliedBundleIsUpToDate(t *testing.T) {
+	installFake(t)
+
+	b := writeBundle(t, testBundle)
+	st := applyAll(t, b, State{})
+
+	plan := build(t, b, st)
+	assert.True(t, plan.Empty(), ops(plan))
+	assert.True(t, plan.Locked)
+	require.NotNil(t, plan.Version)
+	assert.Equal(t, 1, *plan.Version)
+}
+
+func TestBuild_CronChangeUpdatesScheduleInPlace(t *testing.T) {
+	installFake(t)
+
+	b := writeBundle(t, testBundle)
+	st := applyAll(t, b, State{})
+
+	spec := b.Schedules["nightly"]
+	spec.Cron = "0 3 * * *"
+	b.Schedules["nightly"] = spec
+
+	plan := build(t, b, st)
+	require.Len(t, plan.Steps, 1)
+	assert.Equal(t, OpUpdate, plan.Steps[0].Op)
+	assert.Equal(t, []string{fieldCron}, plan.Steps[0].Changes)
+}
+
+func TestBuild_ImageChangeReplacesSchedule(t *testing.T) {
+	installFake(t)
+
+	b := writeBundle(t, testBundle)
+	st := applyAll(t, b, State{})
+
+	b.Image.Pip = append(b.Image.Pip, "scikit-learn")
+
+	plan := build(t, b, st)
+	assert.Equal(t, []string{"update image churn", "replace schedule nightly"}, ops(plan),
+		"a locked pipeline is not relinked; the schedule follows the new image version")
+	assert.Equal(t, []string{fieldPip}, plan.Steps[0].Changes)
+	assert.Equal(t, []string{fieldImageVersion}, plan.Steps[1].Changes)
+}
+
+func TestBuild_LockedPayloadChangeIsANewInput(t *testing.T) {
+	installFake(t)
+
+	b := writeBundle(t, testBundle)
+	st := applyAll(t, b, State{})
+
+	b.Inputs["default"]["rows"] = 200
+
+	plan := build(t, b, st)
+	assert.Equal(t, []string{"create input default", "replace schedule nightly"}, ops(plan))
+	assert.Contains(t, plan.Steps[0].Note, "immutable")
+	assert.Equal(t, []string{fieldInput}, plan.Steps[1].Changes)
+}
+
+func TestBuild_DraftChangesAreUpdates(t *testing.T) {
+	installFake(t)
+
+	b := writeBundle(t, draftBundle)
+	st := applyAll(t, b, State{})
+
+	require.NoError(t, os.WriteFile(b.SourcePath, []byte(testSource+"# v2\n"), 0o644))
+
+	b, err := LoadBundle(b.Path)
+	require.NoError(t, err)
+
+	b.Description = "now described"
+	b.Inputs["default"]["rows"] = 5
+
+	plan := build(t, b, st)
+	assert.Equal(t, []string{"update pipeline churn", "update input default"}, ops(plan))
+	assert.Equal(t, []string{fieldSource, fieldDescription}, plan.Steps[0].Changes)
+	assert.Equal(t, []string{fieldPayload}, plan.Steps[1].Changes)
+}
+
+func TestBuild_LockingADraftMovesItsInputs(t *testing.T) {
+	installFake(t)
+
+	b := writeBundle(t, draftBundle)
+	st := applyAll(t, b, State{})
+
+	b.Lock = true
+
+	plan := build(t, b, st)
+	assert.Equal(t, []string{"lock pipeline churn", "create input default"}, ops(plan))
+	assert.Equal(t, "for the locked version", plan.Steps[1].Note)
+}
+
+func TestBuild_LockedSourceChangeIsAnError(t *testing.T) {
+	installFake(t)
+
+	b := writeBundle(t, testBundle)
+	st := applyAll(t, b, State{})
+
+	b.SourceBytes = []byte("changed")
+
+	_, err := Build(context.Background(), b, st)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "is locked, so its source can no longer change")
+	assert.Contains(t, err.Error(), "dr pipeline clone "+st.PipelineID)
+}
+
+func TestBuild_LockedCannotBeUnlocked(t *testing.T) {
+	installFake(t)
+
+	b := writeBundle(t, testBundle)
+	st := applyAll(t, b, State{})
+
+	b.Lock = false
+	b.Schedules = nil
+
+	_, err := Build(context.Background(), b, st)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "set lock: true")
+}
+
+func TestBuild_MissingPipelineNamesTheFix(t *testing.T) {
+	installFake(t)
+
+	b := writeBundle(t, draftBundle)
+
+	_, err := Build(context.Background(), b, State{PipelineID: "gone"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "pipeline gone recorded in")
+	assert.Contains(t, err.Error(), "remove pipelineId")
+}
+
+func TestBuild_RemovedEntriesAreForgotten(t *testing.T) {
+	installFake(t)
+
+	b := writeBundle(t, testBundle)
+	st := applyAll(t, b, State{})
+
+	delete(b.Inputs, "large")
+	b.Schedules = nil
+
+	plan := build(t, b, st)
+	assert.Equal(t, []string{"forget input large", "forget schedule nightly"}, ops(plan))
+}
+
+func TestBuild_RecordedInputGoneIsRecreated(t *testing.T) {
+	f := installFake(t)
+
+	b := writeBundle(t, draftBundle)
+	st := applyAll(t, b, State{})
+
+	delete(f.inputs, st.Inputs["default"].ID)
+
+	plan := build(t, b, st)
+	assert.Equal(t, []string{"create input default"}, ops(plan))
+	assert.True(t, strings.Contains(plan.Steps[0].Note, "no longer exists"))
+}
@@ -0,0 +1,160 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apply
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/datarobot/cli/tui"
+)
+
+// shortIDLen is how much of an id is enough to recognise it in a plan; the
+// full ids are in the state file and the JSON output.
+const shortIDLen = 8
+
+var (
+	planTitleStyle = lipgloss.NewStyle().Bold(true)
+	addStyle       = tui.SuccessStyle
+	changeStyle    = tui.WarnStyle
+	forgetStyle    = tui.DimStyle
+)
+
+// markers pairs each operation with the symbol and style its plan line uses.
+var markers = map[string]struct {
+	symbol string
+	style  lipgloss.Style
+}{
+	OpCreate:  {"+", addStyle},
+	OpUpdate:  {"~", changeStyle},
+	OpLock:    {"~", changeStyle},
+	OpReplace: {"±", changeStyle},
+	OpForget:  {"-", forgetStyle},
+}
+
+// Render writes the plan block `dr pipeline apply` prints before it acts,
+// and that --dry-run prints instead of acting.
+func Render(w io.Writer, b *Bundle, st State, plan Plan) error {
+	var sb strings.Builder
+
+	sb.WriteString(planTitleStyle.Render(header(b, st, plan)))
+	sb.WriteString("\n")
+
+	if plan.Empty() {
+		sb.WriteString("\n" + tui.SuccessStyle.Render("✓ Already up to date") + "\n")
+
+		_, err := io.WriteString(w, sb.String())
+
+		return err
+	}
+
+	sb.WriteString("\n")
+
+	for _, step := range plan.Steps {
+		sb.WriteString(planLine(step))
+		sb.WriteString("\n")
+	}
+
+	_, err := io.WriteString(w, sb.String())
+
+	return err
+}
+
+// header names the pipeline and the state it is in.
+func header(b *Bundle, st State, plan Plan) string {
+	switch {
+	case st.PipelineID == "":
+		return fmt.Sprintf("Pipeline %s, not created yet", b.Name)
+	case plan.Locked:
+		return fmt.Sprintf("Pipeline %s (%s), locked at version %d", b.Name, shortID(st.PipelineID), *plan.Version)
+	default:
+		return fmt.Sprintf("Pipeline %s (%s), draft", b.Name, shortID(st.PipelineID))
+	}
+}
+
+func planLine(step Step) string {
+	m := markers[step.Op]
+
+	return fmt.Sprintf("  %s %s %s",
+		m.style.Render(m.symbol),
+		m.style.Render(fmt.Sprintf("%-10s", step.Kind)),
+		tui.HintStyle.Render(planDetail(step)))
+}
+
+// planDetail says what a step will do, in the future tense: the plan is
+// printed before anything happens.
+func planDetail(step Step) string {
+	name := stepName(step)
+
+	var detail string
+
+	switch step.Op {
+	case OpCreate:
+		detail = name + " will be created"
+	case OpUpdate:
+		detail = name + ": " + strings.Join(step.Changes, ", ")
+	case OpLock:
+		detail = name + " will be locked; this cannot be undone"
+	case OpReplace:
+		detail = name + " will be replaced; " + strings.Join(step.Changes, ", ") + " moved"
+	case OpForget:
+		detail = name + " is no longer in the bundle; it stays on the server but is no longer managed"
+	}
+
+	if step.Note != "" {
+		detail += " (" + step.Note + ")"
+	}
+
+	return detail
+}
+
+// progressLine reports a finished step.
+func progressLine(step Step, id string) string {
+	done := map[string]string{
+		OpCreate:  "created",
+		OpUpdate:  "updated",
+		OpLock:    "locked",
+		OpReplace: "replaced",
+		OpForget:  "stopped managing",
+	}[step.Op]
+
+	line := fmt.Sprintf("  %s %s %s %s", tui.SuccessStyle.Render("✓"), done, step.Kind, stepName(step))
+	if id != "" {
+		line += " " + tui.DimStyle.Render("("+id+")")
+	}
+
+	return line
+}
+
+// stepName is how a plan line names the object, falling back to its id for
+// one the bundle no longer names.
+func stepName(step Step) string {
+	if step.Name != "" {
+		return step.Name
+	}
+
+	return shortID(step.ID)
+}
+
+// shortID trims an id to something a person can compare at a glance.
+func shortID(id string) string {
+	if len(id) <= shortIDLen {
+		return id
+	}
+
+	return id[:shortIDLen]
+}
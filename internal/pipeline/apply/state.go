@@ -0,0 +1,103 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apply
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/datarobot/cli/internal/fsutil"
+)
+
+// stateDir is where state files live, relative to the bundle. It sits under
+// the .datarobot directory the CLI already keeps per-project state in.
+const stateDir = ".datarobot/pipeline"
+
+// State records which server objects a bundle owns. It holds ids only; what
+// those objects contain is always read back from the server.
+type State struct {
+	PipelineID string                   `json:"pipelineId,omitempty"`
+	ImageID    string                   `json:"imageId,omitempty"`
+	Inputs     map[string]InputState    `json:"inputs,omitempty"`
+	Schedules  map[string]ScheduleState `json:"schedules,omitempty"`
+}
+
+// InputState is one named input set. Version is the locked version it was
+// created for, nil for a draft input.
+type InputState struct {
+	ID      string `json:"id"`
+	Version *int   `json:"version,omitempty"`
+}
+
+// ScheduleState is one named schedule. InputID is kept because the service
+// does not report which input a schedule runs with, and pointing a schedule
+// at a new input means replacing it.
+type ScheduleState struct {
+	ID      string `json:"id"`
+	InputID string `json:"inputId"`
+}
+
+// StatePath is the state file for a bundle: named after the bundle file, so
+// two bundles in one directory keep separate state.
+func StatePath(bundlePath string) string {
+	stem := strings.TrimSuffix(filepath.Base(bundlePath), filepath.Ext(bundlePath))
+
+	return filepath.Join(filepath.Dir(bundlePath), stateDir, stem+".state.json")
+}
+
+// LoadState reads a bundle's state. A missing file is an empty state: the
+// bundle has never been applied from this checkout.
+func LoadState(path string) (State, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return State{}, nil
+	}
+
+	if err != nil {
+		return State{}, fmt.Errorf("cannot read state: %w", err)
+	}
+
+	var s State
+
+	if err := json.Unmarshal(data, &s); err != nil {
+		return State{}, fmt.Errorf("corrupt state file %s: %w", path, err)
+	}
+
+	return s, nil
+}
+
+// SaveState writes a bundle's state, creating its directory. The write is
+// atomic so an interrupted apply never leaves half a file where the ids of
+// what it created should be.
+func SaveState(path string, s State) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("cannot create state directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := fsutil.AtomicWriteFile(path, append(data, '\n')); err != nil {
+		return fmt.Errorf("cannot write state: %w", err)
+	}
+
+	return nil
+}
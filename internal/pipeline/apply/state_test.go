@@ -0,0 +1,64 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apply
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatePath(t *testing.T) {
+	assert.Equal(t,
+		filepath.Join("/work", ".datarobot", "pipeline", "churn.state.json"),
+		StatePath(filepath.Join("/work", "churn.yaml")))
+}
+
+func TestState_RoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), stateDir, "p.state.json")
+
+	empty, err := LoadState(path)
+	require.NoError(t, err)
+	assert.Equal(t, State{}, empty, "a bundle never applied has no state")
+
+	version := 2
+	want := State{
+		PipelineID: "pl-1",
+		ImageID:    "img-1",
+		Inputs:     map[string]InputState{"default": {ID: "in-1", Version: &version}},
+		Schedules:  map[string]ScheduleState{"nightly": {ID: "sch-1", InputID: "in-1"}},
+	}
+
+	require.NoError(t, SaveState(path, want))
+
+	got, err := LoadState(path)
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+
+	_, err = os.Stat(path + ".tmp")
+	assert.True(t, os.IsNotExist(err), "the temporary file is renamed into place")
+}
+
+func TestLoadState_Corrupt(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "p.state.json")
+	require.NoError(t, os.WriteFile(path, []byte("{"), 0o644))
+
+	_, err := LoadState(path)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "corrupt state file")
+}
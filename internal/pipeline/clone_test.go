@@ -15,6 +15,7 @@
 package pipeline
 
 import (
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -53,7 +54,7 @@ func TestClonePipeline_DefaultName(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	got, err := ClonePipeline("p-1", "")
+	got, err := ClonePipeline(context.Background(), "p-1", "")
 	require.NoError(t, err)
 
 	assert.Equal(t, http.MethodPost, gotMethod)
@@ -91,7 +92,7 @@ func TestClonePipeline_NameOverride(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	got, err := ClonePipeline("p-1", "My Clone")
+	got, err := ClonePipeline(context.Background(), "p-1", "My Clone")
 	require.NoError(t, err)
 
 	var payload map[string]any
@@ -122,7 +123,7 @@ func TestClonePipeline_EscapesPipelineID(t *testing.T) {
 
 	// A reserved "/" in the id must be percent-encoded so it stays a single
 	// path segment instead of altering the request path.
-	_, err := ClonePipeline("p/1", "")
+	_, err := ClonePipeline(context.Background(), "p/1", "")
 	require.NoError(t, err)
 
 	assert.Equal(t, "/api/v2/pipelines/p%2F1/clone", gotRequestURI)
@@ -140,7 +141,7 @@ func TestClonePipeline_404NotFound(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	_, err := ClonePipeline("p-1", "")
+	_, err := ClonePipeline(context.Background(), "p-1", "")
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "HTTP 404")
 	assert.Contains(t, err.Error(), "not found")
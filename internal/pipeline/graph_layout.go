@@ -0,0 +1,442 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// graph_layout.go lays a Graph out in layers for the terminal. Nodes sit in
+// the layer of their longest path from a root; edges that skip layers pass
+// through one-column placeholders, and each layer is ordered by the average
+// position of its parents so most edges run straight down.
+package pipeline
+
+import (
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// layoutItem is one slot in a layer: a node drawn as a box, or a placeholder
+// (node == nil) that carries an edge through a layer it skips.
+type layoutItem struct {
+	node   *GraphNode
+	lines  []string
+	width  int
+	x      int
+	preds  []*layoutItem
+	index  int
+	styled bool
+	style  lipgloss.Style
+}
+
+func (it *layoutItem) center() int {
+	return it.x + it.width/2
+}
+
+// Line directions a canvas cell connects to.
+const (
+	lineUp = 1 << iota
+	lineDown
+	lineLeft
+	lineRight
+)
+
+var boxChars = map[int]rune{
+	lineUp | lineDown:                        '│',
+	lineLeft | lineRight:                     '─',
+	lineDown | lineRight:                     '┌',
+	lineDown | lineLeft:                      '┐',
+	lineUp | lineRight:                       '└',
+	lineUp | lineLeft:                        '┘',
+	lineUp | lineDown | lineRight:            '├',
+	lineUp | lineDown | lineLeft:             '┤',
+	lineDown | lineLeft | lineRight:          '┬',
+	lineUp | lineLeft | lineRight:            '┴',
+	lineUp | lineDown | lineLeft | lineRight: '┼',
+	lineUp:                                   '│',
+	lineDown:                                 '│',
+	lineLeft:                                 '─',
+	lineRight:                                '─',
+}
+
+// canvas is a grid where each cell holds either a literal rune or a set of
+// line directions, so crossing and joining edges get the right junction.
+type canvas struct {
+	runes [][]rune
+	lines [][]int
+	owner [][]*layoutItem
+}
+
+func newCanvas(width, height int) *canvas {
+	c := &canvas{
+		runes: make([][]rune, height),
+		lines: make([][]int, height),
+		owner: make([][]*layoutItem, height),
+	}
+
+	for y := range height {
+		c.runes[y] = make([]rune, width)
+		c.lines[y] = make([]int, width)
+		c.owner[y] = make([]*layoutItem, width)
+	}
+
+	return c
+}
+
+func (c *canvas) text(x, y int, s string, owner *layoutItem) {
+	for _, r := range s {
+		c.runes[y][x] = r
+		c.owner[y][x] = owner
+		x++
+	}
+}
+
+// vline joins the cells from y0 down to y1 in column x.
+func (c *canvas) vline(x, y0, y1 int) {
+	for y := y0; y <= y1; y++ {
+		if y > y0 {
+			c.lines[y][x] |= lineUp
+		}
+
+		if y < y1 {
+			c.lines[y][x] |= lineDown
+		}
+	}
+}
+
+// hline joins the cells between x0 and x1 in row y.
+func (c *canvas) hline(y, x0, x1 int) {
+	if x0 > x1 {
+		x0, x1 = x1, x0
+	}
+
+	for x := x0; x <= x1; x++ {
+		if x > x0 {
+			c.lines[y][x] |= lineLeft
+		}
+
+		if x < x1 {
+			c.lines[y][x] |= lineRight
+		}
+	}
+}
+
+func (c *canvas) String() string {
+	var b strings.Builder
+
+	for y, row := range c.runes {
+		cells := make([]rune, len(row))
+
+		for x, r := range row {
+			switch {
+			case r != 0:
+				cells[x] = r
+			case c.lines[y][x] != 0:
+				cells[x] = boxChars[c.lines[y][x]]
+			default:
+				cells[x] = ' '
+			}
+		}
+
+		end := len(cells)
+		for end > 0 && cells[end-1] == ' ' {
+			end--
+		}
+
+		// Style runs of cells that belong to the same coloured box.
+		for start := 0; start < end; {
+			owner := c.owner[y][start]
+			stop := start + 1
+
+			for stop < end && c.owner[y][stop] == owner {
+				stop++
+			}
+
+			segment := string(cells[start:stop])
+			if owner != nil && owner.styled {
+				segment = owner.style.Render(segment)
+			}
+
+			b.WriteString(segment)
+
+			start = stop
+		}
+
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
+
+// GraphASCII draws g as layered boxes joined by lines, roots at the top.
+// Each box shows the node name and parameter count; when statuses is
+// non-nil it also shows the node's status in the run, coloured to match.
+func GraphASCII(g Graph, statuses map[int]string) string {
+	v := newGraphView(g)
+	if len(v.nodes) == 0 {
+		return ""
+	}
+
+	layers := v.layoutLayers(statuses)
+
+	boxHeight := len(v.label(v.nodes[0], statuses)) + 2
+	tops := make([]int, len(layers))
+	rows := make([]map[*layoutItem]int, len(layers))
+	height, width := 0, 0
+
+	for l, layer := range layers {
+		tops[l] = height
+		height += boxHeight
+
+		for _, it := range layer {
+			width = max(width, it.x+it.width)
+		}
+
+		if l == len(layers)-1 {
+			break
+		}
+
+		// Each parent whose children are not straight below it gets its own
+		// row in the channel to the next layer, so fan-outs stay readable.
+		rows[l] = map[*layoutItem]int{}
+
+		for _, child := range layers[l+1] {
+			for _, p := range child.preds {
+				if _, ok := rows[l][p]; !ok && p.center() != child.center() {
+					rows[l][p] = len(rows[l]) + 1
+				}
+			}
+		}
+
+		height += len(rows[l]) + 1
+	}
+
+	c := newCanvas(width, height)
+
+	for l, layer := range layers {
+		for _, it := range layer {
+			top := tops[l]
+
+			if it.node == nil {
+				c.vline(it.x, top, top+boxHeight-1)
+
+				continue
+			}
+
+			c.text(it.x, top, "┌"+strings.Repeat("─", it.width-2)+"┐", it)
+
+			for i, line := range it.lines {
+				padding := it.width - 4 - lipgloss.Width(line)
+				c.text(it.x, top+1+i, "│ "+line+strings.Repeat(" ", padding)+" │", it)
+			}
+
+			c.text(it.x, top+boxHeight-1, "└"+strings.Repeat("─", it.width-2)+"┘", it)
+		}
+
+		if l == 0 {
+			continue
+		}
+
+		bottom := tops[l-1] + boxHeight - 1
+		arrow := tops[l] - 1
+
+		for _, child := range layer {
+			for _, p := range child.preds {
+				sx, tx := p.center(), child.center()
+
+				hy := bottom + rows[l-1][p]
+
+				end := arrow
+				if child.node == nil {
+					end = tops[l]
+				}
+
+				c.vline(sx, bottom, hy)
+				c.hline(hy, sx, tx)
+				c.vline(tx, hy, end)
+
+				if p.node != nil {
+					c.runes[bottom][sx] = '┬'
+				}
+
+				if child.node != nil {
+					c.runes[arrow][tx] = '▼'
+				}
+			}
+		}
+	}
+
+	return c.String()
+}
+
+// layoutLayers assigns every node to a layer, threads placeholders through
+// the layers long edges skip, orders each layer and sets the x positions.
+func (v graphView) layoutLayers(statuses map[int]string) [][]*layoutItem {
+	succ := map[int][]int{}
+	indegree := map[int]int{}
+
+	for _, e := range v.edges {
+		succ[e.Source] = append(succ[e.Source], e.Target)
+		indegree[e.Target]++
+	}
+
+	// Longest-path layering (Kahn's algorithm). A cycle leaves its nodes
+	// where their acyclic parents put them; edges back up are not drawn.
+	depth := map[int]int{}
+	queue := []int{}
+
+	for _, n := range v.nodes {
+		if indegree[n.ID] == 0 {
+			queue = append(queue, n.ID)
+		}
+	}
+
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+
+		for _, t := range succ[id] {
+			depth[t] = max(depth[t], depth[id]+1)
+
+			indegree[t]--
+			if indegree[t] == 0 {
+				queue = append(queue, t)
+			}
+		}
+	}
+
+	items := make(map[int]*layoutItem, len(v.nodes))
+	layers := [][]*layoutItem{}
+
+	place := func(l int, it *layoutItem) {
+		for len(layers) <= l {
+			layers = append(layers, nil)
+		}
+
+		layers[l] = append(layers[l], it)
+	}
+
+	for i := range v.nodes {
+		n := &v.nodes[i]
+		it := &layoutItem{node: n, lines: v.label(*n, statuses)}
+
+		for _, line := range it.lines {
+			it.width = max(it.width, lipgloss.Width(line)+4)
+		}
+
+		if status, ok := statuses[n.ID]; ok {
+			it.styled = true
+			it.style = runStatusStyle(status)
+		}
+
+		items[n.ID] = it
+		place(depth[n.ID], it)
+	}
+
+	for _, e := range v.edges {
+		from, to := depth[e.Source], depth[e.Target]
+		if to <= from {
+			continue
+		}
+
+		prev := items[e.Source]
+
+		for l := from + 1; l < to; l++ {
+			dummy := &layoutItem{width: 1, preds: []*layoutItem{prev}}
+			place(l, dummy)
+			prev = dummy
+		}
+
+		items[e.Target].preds = append(items[e.Target].preds, prev)
+	}
+
+	for l, layer := range layers {
+		if l > 0 {
+			orderByParents(layer)
+		}
+
+		cursor := 0
+
+		for i, it := range layer {
+			it.index = i
+			it.x = cursor
+
+			if len(it.preds) > 0 {
+				it.x = max(cursor, alignedCenter(it)-it.width/2)
+			}
+
+			cursor = it.x + it.width + graphBoxGap
+		}
+	}
+
+	return layers
+}
+
+// alignedCenter returns where an item's center should go: the mean of its
+// parents' centers, snapped to the nearest parent the box would span anyway
+// so that edge runs straight instead of jogging by a column.
+func alignedCenter(it *layoutItem) int {
+	sum := 0
+	for _, p := range it.preds {
+		sum += p.center()
+	}
+
+	mean := sum / len(it.preds)
+	best, bestDist := mean, it.width/2+1
+
+	for _, p := range it.preds {
+		if d := abs(p.center() - mean); d < bestDist {
+			best, bestDist = p.center(), d
+		}
+	}
+
+	return best
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+
+	return n
+}
+
+// orderByParents sorts a layer by the mean index of each item's parents in
+// the layer above; items without drawn parents keep their order at the end.
+func orderByParents(layer []*layoutItem) {
+	key := func(it *layoutItem) float64 {
+		if len(it.preds) == 0 {
+			return math.Inf(1)
+		}
+
+		sum := 0
+		for _, p := range it.preds {
+			sum += p.index
+		}
+
+		return float64(sum) / float64(len(it.preds))
+	}
+
+	slices.SortStableFunc(layer, func(a, b *layoutItem) int {
+		ka, kb := key(a), key(b)
+
+		switch {
+		case ka < kb:
+			return -1
+		case ka > kb:
+			return 1
+		default:
+			return 0
+		}
+	})
+}
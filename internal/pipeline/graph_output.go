@@ -0,0 +1,300 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// graph_output.go draws a pipeline Graph for `dr pipeline graph --format`:
+// Graphviz DOT for design docs, Mermaid for Markdown READMEs, and a layered
+// box-and-line drawing for the terminal. Each can colour the nodes by the
+// task execution statuses of one run.
+package pipeline
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/spf13/pflag"
+)
+
+// GraphFormat selects how `dr pipeline graph` draws the DAG. It is
+// independent of --output-format, which still chooses between the text
+// renderings and the raw JSON payload.
+type GraphFormat string
+
+const (
+	GraphFormatTable   GraphFormat = "table"
+	GraphFormatDOT     GraphFormat = "dot"
+	GraphFormatMermaid GraphFormat = "mermaid"
+	GraphFormatASCII   GraphFormat = "ascii"
+)
+
+var _ pflag.Value = (*GraphFormat)(nil)
+
+func (f *GraphFormat) String() string {
+	if f == nil {
+		return ""
+	}
+
+	return string(*f)
+}
+
+func (f *GraphFormat) Set(s string) error {
+	switch GraphFormat(s) {
+	case GraphFormatTable, GraphFormatDOT, GraphFormatMermaid, GraphFormatASCII:
+		*f = GraphFormat(s)
+
+		return nil
+	}
+
+	return fmt.Errorf("invalid graph format %q: use %s, %s, %s or %s",
+		s, GraphFormatTable, GraphFormatDOT, GraphFormatMermaid, GraphFormatASCII)
+}
+
+func (f *GraphFormat) Type() string {
+	return "format"
+}
+
+// graphStatusSeverity ranks task statuses so a node that ran more than once
+// shows its worst outcome. Unknown statuses rank lowest.
+var graphStatusSeverity = map[string]int{
+	RunStatusCompleted: 1,
+	RunStatusPending:   2,
+	RunStatusPreparing: 2,
+	RunStatusRunning:   3,
+	RunStatusCancelled: 4,
+	RunStatusErrored:   5,
+	RunStatusFailed:    6,
+}
+
+// graphStatusColors are the fill colours DOT and Mermaid use per status.
+var graphStatusColors = map[string]string{
+	RunStatusCompleted: "#d4edda",
+	RunStatusPending:   "#e2e3e5",
+	RunStatusPreparing: "#e2e3e5",
+	RunStatusRunning:   "#cce5ff",
+	RunStatusCancelled: "#fff3cd",
+	RunStatusErrored:   "#f8d7da",
+	RunStatusFailed:    "#f8d7da",
+}
+
+const (
+	graphUnknownStatusColor = "#ffffff"
+	graphMaxNameWidth       = 32
+	graphBoxGap             = 3
+)
+
+// GraphNodeStatuses maps graph node IDs to the status of a run's task
+// executions. When a node fanned out into several executions it takes the
+// most severe status, so one failed invocation is not hidden by completed
+// siblings. Executions without a GraphNodeID (loops) are skipped.
+func GraphNodeStatuses(tasks []TaskExecution) map[int]string {
+	statuses := make(map[int]string, len(tasks))
+
+	for _, task := range tasks {
+		if task.GraphNodeID == nil {
+			continue
+		}
+
+		id := *task.GraphNodeID
+
+		current, ok := statuses[id]
+		if !ok || graphStatusSeverity[task.Status] > graphStatusSeverity[current] {
+			statuses[id] = task.Status
+		}
+	}
+
+	return statuses
+}
+
+// graphView is the normalised form every renderer draws from: nodes in ID
+// order, edges deduplicated with dangling endpoints and self-loops dropped,
+// and the number of incoming argument edges per node.
+type graphView struct {
+	title  string
+	nodes  []GraphNode
+	edges  []GraphEdge
+	params map[int]int
+}
+
+func newGraphView(g Graph) graphView {
+	v := graphView{
+		title:  g.Pipeline.Name,
+		nodes:  slices.Clone(g.Nodes),
+		params: make(map[int]int, len(g.Nodes)),
+	}
+
+	if v.title == "" {
+		v.title = "pipeline"
+	}
+
+	slices.SortFunc(v.nodes, func(a, b GraphNode) int { return a.ID - b.ID })
+
+	known := make(map[int]bool, len(v.nodes))
+	for _, n := range v.nodes {
+		known[n.ID] = true
+	}
+
+	seen := make(map[[2]int]bool, len(g.Edges))
+
+	for _, e := range g.Edges {
+		if !known[e.Source] || !known[e.Target] || e.Source == e.Target {
+			continue
+		}
+
+		v.params[e.Target]++
+
+		key := [2]int{e.Source, e.Target}
+		if seen[key] {
+			continue
+		}
+
+		seen[key] = true
+
+		v.edges = append(v.edges, GraphEdge{Source: e.Source, Target: e.Target})
+	}
+
+	slices.SortFunc(v.edges, func(a, b GraphEdge) int {
+		if a.Source != b.Source {
+			return a.Source - b.Source
+		}
+
+		return a.Target - b.Target
+	})
+
+	return v
+}
+
+// label returns the lines drawn inside a node: its name, its parameter count
+// and, when statuses is non-nil, its status in the run.
+func (v graphView) label(n GraphNode, statuses map[int]string) []string {
+	name := n.Name
+	if utf8.RuneCountInString(name) > graphMaxNameWidth {
+		name = string([]rune(name)[:graphMaxNameWidth-1]) + "…"
+	}
+
+	params := strconv.Itoa(v.params[n.ID]) + " params"
+	if v.params[n.ID] == 1 {
+		params = "1 param"
+	}
+
+	lines := []string{name, params}
+
+	if statuses != nil {
+		status, ok := statuses[n.ID]
+		if !ok {
+			status = emptyValuePlaceholder
+		}
+
+		lines = append(lines, status)
+	}
+
+	return lines
+}
+
+func graphStatusColor(status string) string {
+	if color, ok := graphStatusColors[status]; ok {
+		return color
+	}
+
+	return graphUnknownStatusColor
+}
+
+// GraphDOT renders g as a Graphviz digraph. Nodes with a status in statuses
+// are filled with that status's colour.
+func GraphDOT(g Graph, statuses map[int]string) string {
+	v := newGraphView(g)
+
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "digraph %s {\n", strconv.Quote(v.title))
+	b.WriteString("  rankdir=TB;\n")
+	b.WriteString("  node [shape=box, style=rounded];\n")
+
+	for _, n := range v.nodes {
+		attrs := "label=" + strconv.Quote(strings.Join(v.label(n, statuses), "\n"))
+
+		if status, ok := statuses[n.ID]; ok {
+			attrs += `, style="rounded,filled", fillcolor="` + graphStatusColor(status) + `"`
+		}
+
+		fmt.Fprintf(&b, "  n%d [%s];\n", n.ID, attrs)
+	}
+
+	for _, e := range v.edges {
+		fmt.Fprintf(&b, "  n%d -> n%d;\n", e.Source, e.Target)
+	}
+
+	b.WriteString("}\n")
+
+	return b.String()
+}
+
+// mermaidEscaper protects quoted Mermaid labels; Mermaid reads #name; as an
+// entity code inside labels.
+var mermaidEscaper = strings.NewReplacer(`"`, "#quot;", "<", "#lt;", ">", "#gt;")
+
+// GraphMermaid renders g as a Mermaid flowchart. Nodes with a status in
+// statuses get one classDef per status.
+func GraphMermaid(g Graph, statuses map[int]string) string {
+	v := newGraphView(g)
+
+	var b strings.Builder
+
+	b.WriteString("flowchart TD\n")
+
+	for _, n := range v.nodes {
+		lines := v.label(n, statuses)
+		for i, line := range lines {
+			lines[i] = mermaidEscaper.Replace(line)
+		}
+
+		fmt.Fprintf(&b, "    n%d[\"%s\"]\n", n.ID, strings.Join(lines, "<br/>"))
+	}
+
+	for _, e := range v.edges {
+		fmt.Fprintf(&b, "    n%d --> n%d\n", e.Source, e.Target)
+	}
+
+	classes := map[string][]string{}
+
+	for _, n := range v.nodes {
+		if status, ok := statuses[n.ID]; ok {
+			classes[status] = append(classes[status], "n"+strconv.Itoa(n.ID))
+		}
+	}
+
+	for _, status := range slices.Sorted(maps.Keys(classes)) {
+		class := mermaidClassName(status)
+
+		fmt.Fprintf(&b, "    classDef %s fill:%s\n", class, graphStatusColor(status))
+		fmt.Fprintf(&b, "    class %s %s\n", strings.Join(classes[status], ","), class)
+	}
+
+	return b.String()
+}
+
+// mermaidClassName turns a status into a class name Mermaid accepts.
+func mermaidClassName(status string) string {
+	name := strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= '0' && r <= '9' {
+			return r
+		}
+
+		return '_'
+	}, strings.ToLower(status))
+
+	return "status_" + name
+}
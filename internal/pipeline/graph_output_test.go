@@ -0,0 +1,138 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipeline
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// diamondGraph is load -> {clean, featurize} -> train, plus a load -> report
+// edge that skips a layer and a duplicate argument edge into train.
+func diamondGraph() Graph {
+	return Graph{
+		Pipeline: GraphPipeline{Name: "wf"},
+		Nodes: []GraphNode{
+			{ID: 3, Type: "function", Name: "train"},
+			{ID: 0, Type: "function", Name: "load"},
+			{ID: 1, Type: "function", Name: "clean"},
+			{ID: 2, Type: "function", Name: "featurize"},
+			{ID: 4, Type: "function", Name: "report"},
+		},
+		Edges: []GraphEdge{
+			{Source: 0, Target: 1},
+			{Source: 0, Target: 2},
+			{Source: 1, Target: 3, EdgeName: "x"},
+			{Source: 1, Target: 3, EdgeName: "y"},
+			{Source: 2, Target: 3},
+			{Source: 3, Target: 4},
+			{Source: 0, Target: 4},
+			{Source: 0, Target: 9},
+		},
+	}
+}
+
+func TestGraphFormat_Set(t *testing.T) {
+	var f GraphFormat
+
+	require.NoError(t, f.Set("mermaid"))
+	assert.Equal(t, GraphFormatMermaid, f)
+
+	err := f.Set("svg")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid graph format")
+}
+
+func TestGraphNodeStatuses_WorstStatusWins(t *testing.T) {
+	statuses := GraphNodeStatuses([]TaskExecution{
+		{GraphNodeID: intPtr(1), Status: RunStatusCompleted},
+		{GraphNodeID: intPtr(1), Status: RunStatusFailed},
+		{GraphNodeID: intPtr(1), Status: RunStatusCompleted},
+		{GraphNodeID: intPtr(2), Status: RunStatusRunning},
+		{Status: RunStatusErrored},
+	})
+
+	assert.Equal(t, map[int]string{1: RunStatusFailed, 2: RunStatusRunning}, statuses)
+}
+
+func TestGraphDOT(t *testing.T) {
+	out := GraphDOT(diamondGraph(), map[int]string{3: RunStatusFailed})
+
+	assert.True(t, strings.HasPrefix(out, `digraph "wf" {`))
+	assert.Contains(t, out, `n3 [label="train\n3 params\nFAILED", style="rounded,filled", fillcolor="#f8d7da"];`)
+	assert.Contains(t, out, `n1 [label="clean\n1 param\n—"];`)
+	assert.Contains(t, out, "n1 -> n3;")
+	assert.Equal(t, 1, strings.Count(out, "n1 -> n3;"), "parallel argument edges are drawn once")
+	assert.NotContains(t, out, "n9", "edges to unknown nodes are dropped")
+}
+
+func TestGraphDOT_NoRun(t *testing.T) {
+	out := GraphDOT(diamondGraph(), nil)
+
+	assert.Contains(t, out, `n0 [label="load\n0 params"];`)
+	assert.NotContains(t, out, "fillcolor")
+}
+
+func TestGraphMermaid(t *testing.T) {
+	g := diamondGraph()
+	g.Nodes[0].Name = `say "hi" <now>`
+
+	out := GraphMermaid(g, map[int]string{0: RunStatusCompleted, 3: RunStatusFailed})
+
+	assert.True(t, strings.HasPrefix(out, "flowchart TD\n"))
+	assert.Contains(t, out, `n3["say #quot;hi#quot; #lt;now#gt;<br/>3 params<br/>FAILED"]`)
+	assert.Contains(t, out, "n0 --> n4")
+	assert.Contains(t, out, "classDef status_failed fill:#f8d7da")
+	assert.Contains(t, out, "class n3 status_failed")
+	assert.Contains(t, out, "class n0 status_completed")
+}
+
+func TestGraphASCII_Layers(t *testing.T) {
+	out := GraphASCII(diamondGraph(), nil)
+	lines := strings.Split(out, "\n")
+
+	row := func(name string) int {
+		for i, line := range lines {
+			if strings.Contains(line, "│ "+name+" ") {
+				return i
+			}
+		}
+
+		t.Fatalf("node %q not drawn:\n%s", name, out)
+
+		return -1
+	}
+
+	assert.Less(t, row("load"), row("clean"))
+	assert.Equal(t, row("clean"), row("featurize"), "siblings share a layer")
+	assert.Less(t, row("featurize"), row("train"))
+	assert.Less(t, row("train"), row("report"), "longest path decides the layer")
+	assert.Contains(t, out, "3 params")
+	assert.Equal(t, 4, strings.Count(out, "▼"), "one arrowhead above each child")
+}
+
+func TestGraphASCII_ShowsRunStatus(t *testing.T) {
+	out := GraphASCII(diamondGraph(), map[int]string{3: RunStatusFailed})
+
+	assert.Contains(t, out, "FAILED")
+	assert.Contains(t, out, "│ "+emptyValuePlaceholder)
+}
+
+func TestGraphASCII_Empty(t *testing.T) {
+	assert.Empty(t, GraphASCII(Graph{}, nil))
+}
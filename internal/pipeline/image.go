@@ -30,6 +30,7 @@
 package pipeline
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -205,7 +206,7 @@ type ImageUpdateRequest struct {
 // CreateImage POSTs a new image. The API returns 201 with the full Image
 // payload (a single CREATING version is returned immediately; READY status
 // is reached asynchronously by the covalent build).
-func CreateImage(name, description string, pip []string, conda *CondaValue, pythonVersion, baseImage string, gpu bool) (*Image, error) {
+func CreateImage(ctx context.Context, name, description string, pip []string, conda *CondaValue, pythonVersion, baseImage string, gpu bool) (*Image, error) {
 	endpoint, err := config.GetEndpointURL("/api/v2/pipelines/images")
 	if err != nil {
 		return nil, err
@@ -235,7 +236,7 @@ func CreateImage(name, description string, pip []string, conda *CondaValue, pyth
 
 	var result Image
 
-	err = doJSON(http.MethodPost, endpoint, body, "create image", &result)
+	err = doJSON(ctx, http.MethodPost, endpoint, body, "create image", &result)
 	if err != nil {
 		return nil, err
 	}
@@ -245,7 +246,7 @@ func CreateImage(name, description string, pip []string, conda *CondaValue, pyth
 
 // ListImages returns a paginated slice of images. The API returns a
 // DataPage envelope; results are newest first.
-func ListImages(offset, limit int) ([]ImageSummary, error) {
+func ListImages(ctx context.Context, offset, limit int) ([]ImageSummary, error) {
 	endpoint, err := config.GetEndpointURL("/api/v2/pipelines/images")
 	if err != nil {
 		return nil, err
@@ -266,7 +267,7 @@ func ListImages(offset, limit int) ([]ImageSummary, error) {
 
 	var page DataPage[ImageSummary]
 
-	err = doJSON(http.MethodGet, endpoint, nil, "images", &page)
+	err = doJSON(ctx, http.MethodGet, endpoint, nil, "images", &page)
 	if err != nil {
 		return nil, err
 	}
@@ -280,7 +281,7 @@ type ImageLogsResponse struct {
 }
 
 // GetImage fetches a single image by ID from GET /api/v2/pipelines/images/{image_id}.
-func GetImage(imageID string) (*Image, error) {
+func GetImage(ctx context.Context, imageID string) (*Image, error) {
 	endpoint, err := config.GetEndpointURL("/api/v2/pipelines/images/" + imageID)
 	if err != nil {
 		return nil, err
@@ -288,7 +289,7 @@ func GetImage(imageID string) (*Image, error) {
 
 	var result Image
 
-	err = doJSON(http.MethodGet, endpoint, nil, "get image", &result)
+	err = doJSON(ctx, http.MethodGet, endpoint, nil, "get image", &result)
 	if err != nil {
 		return nil, err
 	}
@@ -298,7 +299,7 @@ func GetImage(imageID string) (*Image, error) {
 
 // GetImageBuildLogs fetches build logs for a specific image version from
 // GET /api/v2/pipelines/images/{image_id}/versions/{version_id}/logs.
-func GetImageBuildLogs(imageID string, version int) (*ImageLogsResponse, error) {
+func GetImageBuildLogs(ctx context.Context, imageID string, version int) (*ImageLogsResponse, error) {
 	endpoint, err := config.GetEndpointURL(
 		"/api/v2/pipelines/images/" + imageID + "/versions/" + strconv.Itoa(version) + "/logs",
 	)
@@ -308,7 +309,7 @@ func GetImageBuildLogs(imageID string, version int) (*ImageLogsResponse, error)
 
 	var result ImageLogsResponse
 
-	err = doJSON(http.MethodGet, endpoint, nil, "image build logs", &result)
+	err = doJSON(ctx, http.MethodGet, endpoint, nil, "image build logs", &result)
 	if err != nil {
 		return nil, err
 	}
@@ -322,7 +323,7 @@ func GetImageBuildLogs(imageID string, version int) (*ImageLogsResponse, error)
 //
 // The API requires the image name in the body; UpdateImage fetches it
 // first so callers only need to supply the image ID.
-func UpdateImage(imageID string, pip []string, conda *CondaValue, pythonVersion, baseImage string, gpu bool) (*Image, error) {
+func UpdateImage(ctx context.Context, imageID string, pip []string, conda *CondaValue, pythonVersion, baseImage string, gpu bool) (*Image, error) {
 	endpoint, err := config.GetEndpointURL("/api/v2/pipelines/images/" + imageID)
 	if err != nil {
 		return nil, err
@@ -331,7 +332,7 @@ func UpdateImage(imageID string, pip []string, conda *CondaValue, pythonVersion,
 	// Fetch the current image to resolve its canonical name — required by
 	// the update request body even though the server overrides it with the
 	// stored name anyway.
-	current, err := GetImage(imageID)
+	current, err := GetImage(ctx, imageID)
 	if err != nil {
 		var httpErr *drapi.HTTPError
 
@@ -362,7 +363,7 @@ func UpdateImage(imageID string, pip []string, conda *CondaValue, pythonVersion,
 
 	var result Image
 
-	err = doJSON(http.MethodPatch, endpoint, body, "update image", &result)
+	err = doJSON(ctx, http.MethodPatch, endpoint, body, "update image", &result)
 	if err != nil {
 		return nil, err
 	}
@@ -372,18 +373,18 @@ func UpdateImage(imageID string, pip []string, conda *CondaValue, pythonVersion,
 
 // DeleteImage soft-deletes the most-recent active version of an image.
 // If no active versions remain, the parent image is soft-deleted as well.
-func DeleteImage(imageID string) error {
+func DeleteImage(ctx context.Context, imageID string) error {
 	endpoint, err := config.GetEndpointURL("/api/v2/pipelines/images/" + imageID)
 	if err != nil {
 		return err
 	}
 
-	return doDelete(endpoint, "delete image")
+	return doDelete(ctx, endpoint, "delete image")
 }
 
 // DeleteImageVersion soft-deletes a specific version of an image without
 // touching the parent.
-func DeleteImageVersion(imageID string, version int) error {
+func DeleteImageVersion(ctx context.Context, imageID string, version int) error {
 	endpoint, err := config.GetEndpointURL(
 		"/api/v2/pipelines/images/" + imageID + "/versions/" + strconv.Itoa(version),
 	)
@@ -391,5 +392,5 @@ func DeleteImageVersion(imageID string, version int) error {
 		return err
 	}
 
-	return doDelete(endpoint, "delete image version")
+	return doDelete(ctx, endpoint, "delete image version")
 }
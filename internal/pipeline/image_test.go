@@ -15,6 +15,7 @@
 package pipeline
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -65,7 +66,7 @@ func TestCreateImage_PostsBody(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	got, err := CreateImage("ml-base", "for testing", []string{"numpy", "pandas==2.0"}, nil, "3.11", "", true)
+	got, err := CreateImage(context.Background(), "ml-base", "for testing", []string{"numpy", "pandas==2.0"}, nil, "3.11", "", true)
 	require.NoError(t, err)
 	assert.Equal(t, "img-1", got.ImageID)
 	assert.Equal(t, 1, got.LatestVersion)
@@ -98,7 +99,7 @@ func TestCreateImage_OmitsEmptyDescription(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	_, err := CreateImage("x", "", []string{"numpy"}, nil, "", "", false)
+	_, err := CreateImage(context.Background(), "x", "", []string{"numpy"}, nil, "", "", false)
 	require.NoError(t, err)
 }
 
@@ -119,7 +120,7 @@ func TestListImages_AddsPaginationQuery(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	items, err := ListImages(5, 20)
+	items, err := ListImages(context.Background(), 5, 20)
 	require.NoError(t, err)
 	require.Len(t, items, 1)
 	assert.Equal(t, "img-1", items[0].ImageID)
@@ -140,7 +141,7 @@ func TestListImages_OmitsZeroPagination(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	items, err := ListImages(0, 0)
+	items, err := ListImages(context.Background(), 0, 0)
 	require.NoError(t, err)
 	assert.Empty(t, items)
 }
@@ -194,7 +195,7 @@ func TestUpdateImage_PatchesBody(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	got, err := UpdateImage("img-1", []string{"scikit-learn"}, nil, "3.12", "", true)
+	got, err := UpdateImage(context.Background(), "img-1", []string{"scikit-learn"}, nil, "3.12", "", true)
 	require.NoError(t, err)
 	assert.Equal(t, 2, got.LatestVersion)
 	require.Len(t, got.Versions, 2)
@@ -216,7 +217,7 @@ func TestDeleteImage_HitsCorrectURL(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	require.NoError(t, DeleteImage("img-1"))
+	require.NoError(t, DeleteImage(context.Background(), "img-1"))
 }
 
 func TestDeleteImageVersion_HitsCorrectURL(t *testing.T) {
@@ -233,7 +234,7 @@ func TestDeleteImageVersion_HitsCorrectURL(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	require.NoError(t, DeleteImageVersion("img-1", 3))
+	require.NoError(t, DeleteImageVersion(context.Background(), "img-1", 3))
 }
 
 func TestDeleteImage_PropagatesNotFound(t *testing.T) {
@@ -247,7 +248,7 @@ func TestDeleteImage_PropagatesNotFound(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	err := DeleteImage("nope")
+	err := DeleteImage(context.Background(), "nope")
 
 	var httpErr *drapi.HTTPError
 
@@ -280,7 +281,7 @@ func TestGetImage_ReturnsFullDetail(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	got, err := GetImage("img-1")
+	got, err := GetImage(context.Background(), "img-1")
 	require.NoError(t, err)
 	assert.Equal(t, "img-1", got.ImageID)
 	assert.Equal(t, "ml-base", got.Name)
@@ -323,7 +324,7 @@ func TestGetImage_DefinitionUsesCanonicalKeys(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	got, err := GetImage("img-2")
+	got, err := GetImage(context.Background(), "img-2")
 	require.NoError(t, err)
 	require.Len(t, got.Versions, 1)
 
@@ -348,7 +349,7 @@ func TestGetImage_PropagatesNotFound(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	_, err := GetImage("missing")
+	_, err := GetImage(context.Background(), "missing")
 
 	var httpErr *drapi.HTTPError
 
@@ -371,7 +372,7 @@ func TestGetImageBuildLogs_ReturnsLogs(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	got, err := GetImageBuildLogs("img-1", 2)
+	got, err := GetImageBuildLogs(context.Background(), "img-1", 2)
 	require.NoError(t, err)
 	assert.Contains(t, got.Logs, "Step 1/5")
 	assert.Contains(t, got.Logs, "Build complete.")
@@ -388,7 +389,7 @@ func TestGetImageBuildLogs_PropagatesNotFound(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	_, err := GetImageBuildLogs("nope", 1)
+	_, err := GetImageBuildLogs(context.Background(), "nope", 1)
 
 	var httpErr *drapi.HTTPError
 
@@ -19,6 +19,7 @@
 package pipeline
 
 import (
+	"context"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -57,7 +58,7 @@ type InputUpdateRequest struct {
 
 // CreateInput POSTs a new input set against the appropriate URL for the
 // given scope/version.
-func CreateInput(pipelineID string, scope Scope, version *int, payload map[string]any) (*Input, error) {
+func CreateInput(ctx context.Context, pipelineID string, scope Scope, version *int, payload map[string]any) (*Input, error) {
 	endpoint, err := EndpointFor(pipelineID, scope, version, "inputs")
 	if err != nil {
 		return nil, err
@@ -67,7 +68,7 @@ func CreateInput(pipelineID string, scope Scope, version *int, payload map[strin
 
 	var result Input
 
-	err = doJSON(http.MethodPost, endpoint, body, "create input", &result)
+	err = doJSON(ctx, http.MethodPost, endpoint, body, "create input", &result)
 	if err != nil {
 		return nil, err
 	}
@@ -76,7 +77,7 @@ func CreateInput(pipelineID string, scope Scope, version *int, payload map[strin
 }
 
 // ListInputs returns a paginated slice of inputs for the given scope.
-func ListInputs(pipelineID string, scope Scope, version *int, offset, limit int) ([]Input, error) {
+func ListInputs(ctx context.Context, pipelineID string, scope Scope, version *int, offset, limit int) ([]Input, error) {
 	endpoint, err := EndpointFor(pipelineID, scope, version, "inputs")
 	if err != nil {
 		return nil, err
@@ -97,7 +98,7 @@ func ListInputs(pipelineID string, scope Scope, version *int, offset, limit int)
 
 	var page DataPage[Input]
 
-	err = doJSON(http.MethodGet, endpoint, nil, "inputs", &page)
+	err = doJSON(ctx, http.MethodGet, endpoint, nil, "inputs", &page)
 	if err != nil {
 		return nil, err
 	}
@@ -106,7 +107,7 @@ func ListInputs(pipelineID string, scope Scope, version *int, offset, limit int)
 }
 
 // GetInput fetches a single input by id within the given scope.
-func GetInput(pipelineID string, scope Scope, version *int, inputID string) (*Input, error) {
+func GetInput(ctx context.Context, pipelineID string, scope Scope, version *int, inputID string) (*Input, error) {
 	endpoint, err := EndpointFor(pipelineID, scope, version, "inputs/"+inputID)
 	if err != nil {
 		return nil, err
@@ -114,7 +115,7 @@ func GetInput(pipelineID string, scope Scope, version *int, inputID string) (*In
 
 	var input Input
 
-	err = doJSON(http.MethodGet, endpoint, nil, "input", &input)
+	err = doJSON(ctx, http.MethodGet, endpoint, nil, "input", &input)
 	if err != nil {
 		return nil, err
 	}
@@ -124,7 +125,7 @@ func GetInput(pipelineID string, scope Scope, version *int, inputID string) (*In
 
 // UpdateInput PATCHes a draft input set with a new payload. Locked inputs
 // cannot be updated; the API will return 409 in that case.
-func UpdateInput(pipelineID, inputID string, payload map[string]any) (*Input, error) {
+func UpdateInput(ctx context.Context, pipelineID, inputID string, payload map[string]any) (*Input, error) {
 	endpoint, err := EndpointFor(pipelineID, ScopeDraft, nil, "inputs/"+inputID)
 	if err != nil {
 		return nil, err
@@ -134,7 +135,7 @@ func UpdateInput(pipelineID, inputID string, payload map[string]any) (*Input, er
 
 	var result Input
 
-	err = doJSON(http.MethodPatch, endpoint, body, "update input", &result)
+	err = doJSON(ctx, http.MethodPatch, endpoint, body, "update input", &result)
 	if err != nil {
 		return nil, err
 	}
@@ -143,11 +144,11 @@ func UpdateInput(pipelineID, inputID string, payload map[string]any) (*Input, er
 }
 
 // DeleteInput removes an input set within the given scope.
-func DeleteInput(pipelineID string, scope Scope, version *int, inputID string) error {
+func DeleteInput(ctx context.Context, pipelineID string, scope Scope, version *int, inputID string) error {
 	endpoint, err := EndpointFor(pipelineID, scope, version, "inputs/"+inputID)
 	if err != nil {
 		return err
 	}
 
-	return doDelete(endpoint, "delete input")
+	return doDelete(ctx, endpoint, "delete input")
 }
@@ -15,6 +15,7 @@
 package pipeline
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -60,7 +61,7 @@ func TestCreateInput_Draft(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	got, err := CreateInput("p-1", ScopeDraft, nil, map[string]any{"k": "v"})
+	got, err := CreateInput(context.Background(), "p-1", ScopeDraft, nil, map[string]any{"k": "v"})
 	require.NoError(t, err)
 	assert.Equal(t, "in-1", got.InputID)
 	assert.Equal(t, InputStateValid, got.State)
@@ -81,7 +82,7 @@ func TestCreateInput_LockedURLShape(t *testing.T) {
 	installEndpoint(t, srv.URL)
 
 	v := 2
-	got, err := CreateInput("p-1", ScopeLocked, &v, map[string]any{})
+	got, err := CreateInput(context.Background(), "p-1", ScopeLocked, &v, map[string]any{})
 	require.NoError(t, err)
 	require.NotNil(t, got.VersionID)
 	assert.Equal(t, 2, *got.VersionID)
@@ -104,7 +105,7 @@ func TestListInputs_AddsPaginationQuery(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	items, err := ListInputs("p-1", ScopeDraft, nil, 10, 5)
+	items, err := ListInputs(context.Background(), "p-1", ScopeDraft, nil, 10, 5)
 	require.NoError(t, err)
 	require.Len(t, items, 1)
 	assert.Equal(t, "in-1", items[0].InputID)
@@ -124,7 +125,7 @@ func TestListInputs_OmitsZeroPagination(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	items, err := ListInputs("p-1", ScopeDraft, nil, 0, 0)
+	items, err := ListInputs(context.Background(), "p-1", ScopeDraft, nil, 0, 0)
 	require.NoError(t, err)
 	assert.Empty(t, items)
 }
@@ -143,7 +144,7 @@ func TestGetInput_TargetsCorrectURL(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	got, err := GetInput("p-1", ScopeDraft, nil, "in-1")
+	got, err := GetInput(context.Background(), "p-1", ScopeDraft, nil, "in-1")
 	require.NoError(t, err)
 	assert.Equal(t, "in-1", got.InputID)
 }
@@ -168,7 +169,7 @@ func TestUpdateInput_PatchesDraft(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	got, err := UpdateInput("p-1", "in-1", map[string]any{"k": "new"})
+	got, err := UpdateInput(context.Background(), "p-1", "in-1", map[string]any{"k": "new"})
 	require.NoError(t, err)
 	assert.Equal(t, "new", got.Payload["k"])
 }
@@ -188,7 +189,7 @@ func TestDeleteInput_LockedURL(t *testing.T) {
 	installEndpoint(t, srv.URL)
 
 	v := 3
-	require.NoError(t, DeleteInput("p-1", ScopeLocked, &v, "in-1"))
+	require.NoError(t, DeleteInput(context.Background(), "p-1", ScopeLocked, &v, "in-1"))
 }
 
 func TestDeleteInput_PropagatesAPIError(t *testing.T) {
@@ -203,7 +204,7 @@ func TestDeleteInput_PropagatesAPIError(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	err := DeleteInput("p-1", ScopeDraft, nil, "in-1")
+	err := DeleteInput(context.Background(), "p-1", ScopeDraft, nil, "in-1")
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "HTTP 409")
 }
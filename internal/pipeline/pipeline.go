@@ -16,6 +16,7 @@ package pipeline
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -107,7 +108,7 @@ type ListItem struct {
 }
 
 // CreatePipeline uploads a Python file to POST /api/v2/pipelines.
-func CreatePipeline(filePath, description, name, mode, imageID string) (*CreateResponse, error) {
+func CreatePipeline(ctx context.Context, filePath, description, name, mode, imageID string) (*CreateResponse, error) {
 	endpoint, err := config.GetEndpointURL("/api/v2/pipelines")
 	if err != nil {
 		return nil, err
@@ -132,7 +133,7 @@ func CreatePipeline(filePath, description, name, mode, imageID string) (*CreateR
 
 	var result CreateResponse
 
-	err = doMultipart(http.MethodPost, endpoint, filePath, fields, "create pipeline", &result)
+	err = doMultipart(ctx, http.MethodPost, endpoint, filePath, fields, "create pipeline", &result)
 	if err != nil {
 		return nil, err
 	}
@@ -141,7 +142,7 @@ func CreatePipeline(filePath, description, name, mode, imageID string) (*CreateR
 }
 
 // ListPipelines fetches a paginated list of pipelines from GET /api/v2/pipelines.
-func ListPipelines(mode, search string, offset, limit int) (*DataPage[ListItem], error) {
+func ListPipelines(ctx context.Context, mode, search string, offset, limit int) (*DataPage[ListItem], error) {
 	endpoint, err := config.GetEndpointURL("/api/v2/pipelines")
 	if err != nil {
 		return nil, err
@@ -170,7 +171,7 @@ func ListPipelines(mode, search string, offset, limit int) (*DataPage[ListItem],
 
 	var page DataPage[ListItem]
 
-	err = drapi.GetJSON(endpoint, "pipelines", &page)
+	err = drapi.GetJSON(ctx, endpoint, "pipelines", &page)
 	if err != nil {
 		return nil, err
 	}
@@ -185,7 +186,7 @@ func escapeID(id string) string {
 }
 
 // GetPipeline fetches a single pipeline from GET /api/v2/pipelines/{pipeline_id}.
-func GetPipeline(pipelineID string) (*Pipeline, error) {
+func GetPipeline(ctx context.Context, pipelineID string) (*Pipeline, error) {
 	endpoint, err := config.GetEndpointURL("/api/v2/pipelines/" + escapeID(pipelineID))
 	if err != nil {
 		return nil, err
@@ -193,7 +194,7 @@ func GetPipeline(pipelineID string) (*Pipeline, error) {
 
 	var pipeline Pipeline
 
-	err = drapi.GetJSON(endpoint, "pipeline", &pipeline)
+	err = drapi.GetJSON(ctx, endpoint, "pipeline", &pipeline)
 	if err != nil {
 		return nil, err
 	}
@@ -203,7 +204,7 @@ func GetPipeline(pipelineID string) (*Pipeline, error) {
 
 // UpdatePipeline patches a draft pipeline. filePath is optional (empty = no file
 // re-upload); name, description, and imageID are each no-op when empty.
-func UpdatePipeline(pipelineID, filePath, imageID, name, description string) (*CreateResponse, error) {
+func UpdatePipeline(ctx context.Context, pipelineID, filePath, imageID, name, description string) (*CreateResponse, error) {
 	endpoint, err := config.GetEndpointURL("/api/v2/pipelines/" + escapeID(pipelineID))
 	if err != nil {
 		return nil, err
@@ -225,7 +226,7 @@ func UpdatePipeline(pipelineID, filePath, imageID, name, description string) (*C
 
 	var result CreateResponse
 
-	err = doMultipart(http.MethodPatch, endpoint, filePath, fields, "update pipeline", &result)
+	err = doMultipart(ctx, http.MethodPatch, endpoint, filePath, fields, "update pipeline", &result)
 	if err != nil {
 		return nil, err
 	}
@@ -235,20 +236,20 @@ func UpdatePipeline(pipelineID, filePath, imageID, name, description string) (*C
 
 // DeletePipeline issues DELETE /api/v2/pipelines/{pipeline_id}. The API
 // returns 204 on success.
-func DeletePipeline(pipelineID string) error {
+func DeletePipeline(ctx context.Context, pipelineID string) error {
 	endpoint, err := config.GetEndpointURL("/api/v2/pipelines/" + escapeID(pipelineID))
 	if err != nil {
 		return err
 	}
 
-	return doDelete(endpoint, "delete pipeline")
+	return doDelete(ctx, endpoint, "delete pipeline")
 }
 
 // LockPipeline issues PATCH /api/v2/pipelines/{pipeline_id}/mode to
 // promote a draft pipeline into the locked mode. The response mirrors a
 // create/update payload, with `mode` set to "locked" and `version`
 // pointing at the locked version.
-func LockPipeline(pipelineID string) (*CreateResponse, error) {
+func LockPipeline(ctx context.Context, pipelineID string) (*CreateResponse, error) {
 	endpoint, err := config.GetEndpointURL("/api/v2/pipelines/" + escapeID(pipelineID) + "/mode")
 	if err != nil {
 		return nil, err
@@ -256,7 +257,7 @@ func LockPipeline(pipelineID string) (*CreateResponse, error) {
 
 	var result CreateResponse
 
-	err = doJSON(http.MethodPatch, endpoint, nil, "lock pipeline", &result)
+	err = doJSON(ctx, http.MethodPatch, endpoint, nil, "lock pipeline", &result)
 	if err != nil {
 		return nil, err
 	}
@@ -275,7 +276,7 @@ type cloneRequest struct {
 // existing pipeline into a new draft (source, description, latest input, and
 // assigned image). An empty name lets the server default to
 // "Clone of <source name>". The response mirrors a create payload.
-func ClonePipeline(pipelineID, name string) (*CreateResponse, error) {
+func ClonePipeline(ctx context.Context, pipelineID, name string) (*CreateResponse, error) {
 	endpoint, err := config.GetEndpointURL("/api/v2/pipelines/" + escapeID(pipelineID) + "/clone")
 	if err != nil {
 		return nil, err
@@ -283,7 +284,7 @@ func ClonePipeline(pipelineID, name string) (*CreateResponse, error) {
 
 	var result CreateResponse
 
-	err = doJSON(http.MethodPost, endpoint, cloneRequest{Name: name}, "clone pipeline", &result)
+	err = doJSON(ctx, http.MethodPost, endpoint, cloneRequest{Name: name}, "clone pipeline", &result)
 	if err != nil {
 		return nil, err
 	}
@@ -293,8 +294,8 @@ func ClonePipeline(pipelineID, name string) (*CreateResponse, error) {
 
 // doMultipart performs a multipart/form-data request with a single "file" upload
 // and optional form fields, decoding the JSON response into out.
-func doMultipart(method, endpoint, filePath string, fields map[string]string, info string, out any) error {
-	req, err := buildMultipartRequest(method, endpoint, filePath, fields)
+func doMultipart(ctx context.Context, method, endpoint, filePath string, fields map[string]string, info string, out any) error {
+	req, err := buildMultipartRequest(ctx, method, endpoint, filePath, fields)
 	if err != nil {
 		return err
 	}
@@ -337,13 +338,13 @@ func doMultipart(method, endpoint, filePath string, fields map[string]string, in
 
 // buildMultipartRequest assembles the multipart body and HTTP request with
 // authentication and tracing headers populated via drapi.AuthorizeRequest.
-func buildMultipartRequest(method, endpoint, filePath string, fields map[string]string) (*http.Request, error) {
+func buildMultipartRequest(ctx context.Context, method, endpoint, filePath string, fields map[string]string) (*http.Request, error) {
 	body, contentType, err := buildMultipartBody(filePath, fields)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest(method, endpoint, body)
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, body)
 	if err != nil {
 		return nil, err
 	}
@@ -15,6 +15,7 @@
 package pipeline
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -37,7 +38,7 @@ func TestDeletePipeline_Success(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	require.NoError(t, DeletePipeline("p-1"))
+	require.NoError(t, DeletePipeline(context.Background(), "p-1"))
 }
 
 func TestDeletePipeline_404PropagatesAsHTTPError(t *testing.T) {
@@ -51,7 +52,7 @@ func TestDeletePipeline_404PropagatesAsHTTPError(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	err := DeletePipeline("p-1")
+	err := DeletePipeline(context.Background(), "p-1")
 	require.Error(t, err)
 
 	var httpErr *drapi.HTTPError
@@ -83,7 +84,7 @@ func TestLockPipeline_PromotesAndDecodes(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	got, err := LockPipeline("p-1")
+	got, err := LockPipeline(context.Background(), "p-1")
 	require.NoError(t, err)
 	assert.Equal(t, "locked", got.Mode)
 	assert.Equal(t, 3, got.Version)
@@ -102,7 +103,7 @@ func TestLockPipeline_409Conflict(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	_, err := LockPipeline("p-1")
+	_, err := LockPipeline(context.Background(), "p-1")
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "HTTP 409")
 	assert.Contains(t, err.Error(), "already locked")
@@ -24,6 +24,7 @@
 package pipeline
 
 import (
+	"context"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -73,7 +74,7 @@ type RunCreateRequest struct {
 
 // CreateRun starts a new run for the given input. Returns the
 // freshly-created Run (status PENDING).
-func CreateRun(pipelineID string, scope Scope, version *int, inputID, imageID string) (*Run, error) {
+func CreateRun(ctx context.Context, pipelineID string, scope Scope, version *int, inputID, imageID string) (*Run, error) {
 	endpoint, err := EndpointFor(pipelineID, scope, version, "dispatches")
 	if err != nil {
 		return nil, err
@@ -87,7 +88,7 @@ func CreateRun(pipelineID string, scope Scope, version *int, inputID, imageID st
 
 	var result Run
 
-	err = doJSON(http.MethodPost, endpoint, body, "create run", &result)
+	err = doJSON(ctx, http.MethodPost, endpoint, body, "create run", &result)
 	if err != nil {
 		return nil, err
 	}
@@ -96,7 +97,7 @@ func CreateRun(pipelineID string, scope Scope, version *int, inputID, imageID st
 }
 
 // ListRuns returns a paginated slice of runs for the given scope.
-func ListRuns(pipelineID string, scope Scope, version *int, offset, limit int) ([]Run, error) {
+func ListRuns(ctx context.Context, pipelineID string, scope Scope, version *int, offset, limit int) ([]Run, error) {
 	endpoint, err := EndpointFor(pipelineID, scope, version, "dispatches")
 	if err != nil {
 		return nil, err
@@ -117,7 +118,7 @@ func ListRuns(pipelineID string, scope Scope, version *int, offset, limit int) (
 
 	var page DataPage[Run]
 
-	err = doJSON(http.MethodGet, endpoint, nil, "runs", &page)
+	err = doJSON(ctx, http.MethodGet, endpoint, nil, "runs", &page)
 	if err != nil {
 		return nil, err
 	}
@@ -126,7 +127,7 @@ func ListRuns(pipelineID string, scope Scope, version *int, offset, limit int) (
 }
 
 // GetRun fetches a single run by id within the given scope.
-func GetRun(pipelineID string, scope Scope, version *int, runID string) (*Run, error) {
+func GetRun(ctx context.Context, pipelineID string, scope Scope, version *int, runID string) (*Run, error) {
 	endpoint, err := EndpointFor(pipelineID, scope, version, "dispatches/"+runID)
 	if err != nil {
 		return nil, err
@@ -134,7 +135,7 @@ func GetRun(pipelineID string, scope Scope, version *int, runID string) (*Run, e
 
 	var run Run
 
-	err = doJSON(http.MethodGet, endpoint, nil, "run", &run)
+	err = doJSON(ctx, http.MethodGet, endpoint, nil, "run", &run)
 	if err != nil {
 		return nil, err
 	}
@@ -144,7 +145,7 @@ func GetRun(pipelineID string, scope Scope, version *int, runID string) (*Run, e
 
 // GetRunStatus calls the lightweight GET .../status endpoint useful for
 // polling without re-downloading the full run record.
-func GetRunStatus(pipelineID string, scope Scope, version *int, runID string) (*RunStatus, error) {
+func GetRunStatus(ctx context.Context, pipelineID string, scope Scope, version *int, runID string) (*RunStatus, error) {
 	endpoint, err := EndpointFor(pipelineID, scope, version, "dispatches/"+runID+"/status")
 	if err != nil {
 		return nil, err
@@ -152,7 +153,7 @@ func GetRunStatus(pipelineID string, scope Scope, version *int, runID string) (*
 
 	var status RunStatus
 
-	err = doJSON(http.MethodGet, endpoint, nil, "run status", &status)
+	err = doJSON(ctx, http.MethodGet, endpoint, nil, "run status", &status)
 	if err != nil {
 		return nil, err
 	}
@@ -162,11 +163,11 @@ func GetRunStatus(pipelineID string, scope Scope, version *int, runID string) (*
 
 // CancelRun issues a DELETE on a run, transitioning it to CANCELLED if
 // it is still in a non-terminal state.
-func CancelRun(pipelineID string, scope Scope, version *int, runID string) error {
+func CancelRun(ctx context.Context, pipelineID string, scope Scope, version *int, runID string) error {
 	endpoint, err := EndpointFor(pipelineID, scope, version, "dispatches/"+runID)
 	if err != nil {
 		return err
 	}
 
-	return doDelete(endpoint, "cancel run")
+	return doDelete(ctx, endpoint, "cancel run")
 }
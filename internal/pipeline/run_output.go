@@ -272,3 +272,59 @@ func printStatusHuman(s RunStatus) {
 
 	w.Flush()
 }
+
+// RenderRunEvent prints one event of a watched run: a JSON object per line
+// (JSON Lines) in JSON mode, otherwise a line tagged with the task it
+// belongs to.
+func RenderRunEvent(format outputformat.OutputFormat, e RunEvent) error {
+	if format == outputformat.OutputFormatJSON {
+		data, err := json.Marshal(e)
+		if err != nil {
+			return err
+		}
+
+		fmt.Println(string(data))
+
+		return nil
+	}
+
+	fmt.Println(formatRunEvent(e))
+
+	return nil
+}
+
+func formatRunEvent(e RunEvent) string {
+	tag := tui.DimStyle.Render("[" + e.Task + "]")
+
+	switch e.Type {
+	case RunEventLog:
+		return tag + " " + e.Line
+	case RunEventTaskStarted:
+		return tag + " " + tui.DimStyle.Render("started")
+	case RunEventCreated:
+		return "Run " + e.RunID + " created (" + e.Status + ")"
+	case RunEventTaskFinished:
+		line := tag + " " + runStatusStyle(e.Status).Render(e.Status)
+		if e.Error != "" {
+			line += ": " + e.Error
+		}
+
+		return line
+	default:
+		return "Run " + e.RunID + ": " + runStatusStyle(e.Status).Render(e.Status)
+	}
+}
+
+// runStatusStyle colours a run or task status by how it ended.
+func runStatusStyle(status string) lipgloss.Style {
+	switch status {
+	case RunStatusCompleted:
+		return tui.SuccessStyle
+	case RunStatusFailed, RunStatusErrored:
+		return tui.ErrorStyle
+	case RunStatusCancelled:
+		return tui.WarnStyle
+	default:
+		return tui.BaseTextStyle
+	}
+}
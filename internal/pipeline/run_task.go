@@ -20,6 +20,7 @@
 package pipeline
 
 import (
+	"context"
 	"net/url"
 	"strconv"
 	"time"
@@ -106,7 +107,7 @@ func withQuery(endpoint string, query url.Values) string {
 }
 
 // ListTaskExecutions returns all task execution records for a run.
-func ListTaskExecutions(pipelineID, runID string) ([]TaskExecution, error) {
+func ListTaskExecutions(ctx context.Context, pipelineID, runID string) ([]TaskExecution, error) {
 	endpoint, err := taskBase(pipelineID, runID)
 	if err != nil {
 		return nil, err
@@ -114,7 +115,7 @@ func ListTaskExecutions(pipelineID, runID string) ([]TaskExecution, error) {
 
 	var tasks []TaskExecution
 
-	err = drapi.GetJSON(endpoint, "task executions", &tasks)
+	err = drapi.GetJSON(ctx, endpoint, "task executions", &tasks)
 	if err != nil {
 		return nil, err
 	}
@@ -124,7 +125,7 @@ func ListTaskExecutions(pipelineID, runID string) ([]TaskExecution, error) {
 
 // GetTaskExecution fetches a single task execution by its sequential task ID.
 // nodeID (optional) selects a specific fan-out invocation; see setNodeID.
-func GetTaskExecution(pipelineID, runID string, taskID int, nodeID *int) (*TaskExecution, error) {
+func GetTaskExecution(ctx context.Context, pipelineID, runID string, taskID int, nodeID *int) (*TaskExecution, error) {
 	base, err := taskBase(pipelineID, runID)
 	if err != nil {
 		return nil, err
@@ -136,7 +137,7 @@ func GetTaskExecution(pipelineID, runID string, taskID int, nodeID *int) (*TaskE
 
 	var task TaskExecution
 
-	err = drapi.GetJSON(endpoint, "task execution", &task)
+	err = drapi.GetJSON(ctx, endpoint, "task execution", &task)
 	if err != nil {
 		return nil, err
 	}
@@ -146,7 +147,7 @@ func GetTaskExecution(pipelineID, runID string, taskID int, nodeID *int) (*TaskE
 
 // GetTaskLogs reads live K8s pod logs for a task. tailLines limits the number
 // of trailing lines returned (nil = no limit). verbosity is "user" or "all".
-func GetTaskLogs(pipelineID, runID string, taskID int, nodeID, tailLines *int, verbosity string) (*TaskExecutionLogs, error) {
+func GetTaskLogs(ctx context.Context, pipelineID, runID string, taskID int, nodeID, tailLines *int, verbosity string) (*TaskExecutionLogs, error) {
 	base, err := taskBase(pipelineID, runID)
 	if err != nil {
 		return nil, err
@@ -167,7 +168,7 @@ func GetTaskLogs(pipelineID, runID string, taskID int, nodeID, tailLines *int, v
 
 	var logs TaskExecutionLogs
 
-	err = drapi.GetJSON(endpoint, "task logs", &logs)
+	err = drapi.GetJSON(ctx, endpoint, "task logs", &logs)
 	if err != nil {
 		return nil, err
 	}
@@ -177,7 +178,7 @@ func GetTaskLogs(pipelineID, runID string, taskID int, nodeID, tailLines *int, v
 
 // GetTaskDurableLog reads the S3-uploaded log for a task. stream must be
 // "stdout" or "stderr". verbosity is "user" or "all".
-func GetTaskDurableLog(pipelineID, runID string, taskID int, nodeID *int, stream, verbosity string) (*TaskExecutionDurableLog, error) {
+func GetTaskDurableLog(ctx context.Context, pipelineID, runID string, taskID int, nodeID *int, stream, verbosity string) (*TaskExecutionDurableLog, error) {
 	base, err := taskBase(pipelineID, runID)
 	if err != nil {
 		return nil, err
@@ -194,7 +195,7 @@ func GetTaskDurableLog(pipelineID, runID string, taskID int, nodeID *int, stream
 
 	var log TaskExecutionDurableLog
 
-	err = drapi.GetJSON(endpoint, "task durable log", &log)
+	err = drapi.GetJSON(ctx, endpoint, "task durable log", &log)
 	if err != nil {
 		return nil, err
 	}
@@ -204,7 +205,7 @@ func GetTaskDurableLog(pipelineID, runID string, taskID int, nodeID *int, stream
 
 // GetTaskResult returns the presigned S3 URL for a completed task's result.
 // nodeID (optional) selects a specific fan-out invocation; see setNodeID.
-func GetTaskResult(pipelineID, runID string, taskID int, nodeID *int) (*TaskExecutionResult, error) {
+func GetTaskResult(ctx context.Context, pipelineID, runID string, taskID int, nodeID *int) (*TaskExecutionResult, error) {
 	base, err := taskBase(pipelineID, runID)
 	if err != nil {
 		return nil, err
@@ -216,7 +217,7 @@ func GetTaskResult(pipelineID, runID string, taskID int, nodeID *int) (*TaskExec
 
 	var result TaskExecutionResult
 
-	err = drapi.GetJSON(endpoint, "task result", &result)
+	err = drapi.GetJSON(ctx, endpoint, "task result", &result)
 	if err != nil {
 		return nil, err
 	}
@@ -15,6 +15,7 @@
 package pipeline
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -42,7 +43,7 @@ func TestListTaskExecutions_URLAndDecode(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	tasks, err := ListTaskExecutions("p-1", "d-1")
+	tasks, err := ListTaskExecutions(context.Background(), "p-1", "d-1")
 	require.NoError(t, err)
 	require.Len(t, tasks, 2)
 	assert.Equal(t, "load_data", tasks[0].Name)
@@ -66,7 +67,7 @@ func TestGetTaskExecution_URLAndDecode(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	task, err := GetTaskExecution("p-1", "d-1", 2, nil)
+	task, err := GetTaskExecution(context.Background(), "p-1", "d-1", 2, nil)
 	require.NoError(t, err)
 	assert.Equal(t, "train_model", task.Name)
 	assert.Equal(t, "FAILED", task.Status)
@@ -91,7 +92,7 @@ func TestListTaskExecutions_DecodesNodeAndGraphNodeID(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	tasks, err := ListTaskExecutions("p-1", "d-1")
+	tasks, err := ListTaskExecutions(context.Background(), "p-1", "d-1")
 	require.NoError(t, err)
 	require.Len(t, tasks, 2)
 	require.NotNil(t, tasks[0].NodeID)
@@ -133,16 +134,16 @@ func TestPerTaskEndpoints_SendNodeIDQueryWhenSet(t *testing.T) {
 
 	node := 7
 
-	_, err := GetTaskExecution("p-1", "d-1", 3, &node)
+	_, err := GetTaskExecution(context.Background(), "p-1", "d-1", 3, &node)
 	require.NoError(t, err)
 
-	_, err = GetTaskResult("p-1", "d-1", 3, &node)
+	_, err = GetTaskResult(context.Background(), "p-1", "d-1", 3, &node)
 	require.NoError(t, err)
 
-	_, err = GetTaskLogs("p-1", "d-1", 3, &node, nil, "")
+	_, err = GetTaskLogs(context.Background(), "p-1", "d-1", 3, &node, nil, "")
 	require.NoError(t, err)
 
-	_, err = GetTaskDurableLog("p-1", "d-1", 3, &node, "stdout", "")
+	_, err = GetTaskDurableLog(context.Background(), "p-1", "d-1", 3, &node, "stdout", "")
 	require.NoError(t, err)
 }
 
@@ -163,7 +164,7 @@ func TestGetTaskLogs_URLQueryAndDecode(t *testing.T) {
 	installEndpoint(t, srv.URL)
 
 	tail := 50
-	logs, err := GetTaskLogs("p-1", "d-1", 1, nil, &tail, "all")
+	logs, err := GetTaskLogs(context.Background(), "p-1", "d-1", 1, nil, &tail, "all")
 	require.NoError(t, err)
 	assert.Equal(t, "hello from task\n", logs.Logs)
 	assert.Equal(t, 0, logs.FilteredLineCount)
@@ -185,7 +186,7 @@ func TestGetTaskLogs_NoQueryParamsWhenAbsent(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	_, err := GetTaskLogs("p-1", "d-1", 1, nil, nil, "")
+	_, err := GetTaskLogs(context.Background(), "p-1", "d-1", 1, nil, nil, "")
 	require.NoError(t, err)
 }
 
@@ -204,7 +205,7 @@ func TestGetTaskDurableLog_URLAndDecode(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	log, err := GetTaskDurableLog("p-1", "d-1", 3, nil, "stdout", "user")
+	log, err := GetTaskDurableLog(context.Background(), "p-1", "d-1", 3, nil, "stdout", "user")
 	require.NoError(t, err)
 	assert.Equal(t, "task output\n", log.Content)
 	assert.Equal(t, 12, log.TotalBytes)
@@ -232,7 +233,7 @@ func TestGetTaskResult_URLAndDecode(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	res, err := GetTaskResult("p-1", "d-1", 1, nil)
+	res, err := GetTaskResult(context.Background(), "p-1", "d-1", 1, nil)
 	require.NoError(t, err)
 	assert.Equal(t, "https://s3.example.com/result.tobj?sig=abc", res.URL)
 	assert.Equal(t, 900, res.ExpiresIn)
@@ -263,7 +264,7 @@ func TestGetTaskResult_ValueUnavailable(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	res, err := GetTaskResult("p-1", "d-1", 1, nil)
+	res, err := GetTaskResult(context.Background(), "p-1", "d-1", 1, nil)
 	require.NoError(t, err)
 	assert.False(t, res.ValueAvailable)
 	require.NotNil(t, res.ValueUnavailableReason)
@@ -293,7 +294,7 @@ func TestGetTaskResult_TextPreviewForNonSerializable(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	res, err := GetTaskResult("p-1", "d-1", 1, nil)
+	res, err := GetTaskResult(context.Background(), "p-1", "d-1", 1, nil)
 	require.NoError(t, err)
 	assert.False(t, res.ValueAvailable)
 	assert.Equal(t, "   x  y\n0  1  3\n1  2  4", res.ValueText)
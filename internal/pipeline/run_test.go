@@ -15,6 +15,7 @@
 package pipeline
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -44,7 +45,7 @@ func TestCreateRun_DraftURLAndBody(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	got, err := CreateRun("p-1", ScopeDraft, nil, "in-1", "")
+	got, err := CreateRun(context.Background(), "p-1", ScopeDraft, nil, "in-1", "")
 	require.NoError(t, err)
 	assert.Equal(t, "d-1", got.RunID)
 	assert.Equal(t, RunStatusPending, got.Status)
@@ -65,7 +66,7 @@ func TestCreateRun_LockedURL(t *testing.T) {
 	installEndpoint(t, srv.URL)
 
 	v := 2
-	got, err := CreateRun("p-1", ScopeLocked, &v, "in-1", "")
+	got, err := CreateRun(context.Background(), "p-1", ScopeLocked, &v, "in-1", "")
 	require.NoError(t, err)
 	require.NotNil(t, got.VersionID)
 	assert.Equal(t, 2, *got.VersionID)
@@ -87,7 +88,7 @@ func TestListRuns_QueryAndDecode(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	items, err := ListRuns("p-1", ScopeDraft, nil, 10, 5)
+	items, err := ListRuns(context.Background(), "p-1", ScopeDraft, nil, 10, 5)
 	require.NoError(t, err)
 	require.Len(t, items, 1)
 	assert.Equal(t, RunStatusRunning, items[0].Status)
@@ -107,7 +108,7 @@ func TestGetRun_TargetsCorrectURL(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	got, err := GetRun("p-1", ScopeDraft, nil, "d-1")
+	got, err := GetRun(context.Background(), "p-1", ScopeDraft, nil, "d-1")
 	require.NoError(t, err)
 	assert.Equal(t, RunStatusCompleted, got.Status)
 }
@@ -127,7 +128,7 @@ func TestGetRunStatus_StatusEndpointURL(t *testing.T) {
 	installEndpoint(t, srv.URL)
 
 	v := 2
-	got, err := GetRunStatus("p-1", ScopeLocked, &v, "d-1")
+	got, err := GetRunStatus(context.Background(), "p-1", ScopeLocked, &v, "d-1")
 	require.NoError(t, err)
 	assert.Equal(t, RunStatusRunning, got.Status)
 	assert.Equal(t, "cov-x", got.CovalentDispatchID)
@@ -146,7 +147,7 @@ func TestCancelRun_DeletesDraftURL(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	require.NoError(t, CancelRun("p-1", ScopeDraft, nil, "d-1"))
+	require.NoError(t, CancelRun(context.Background(), "p-1", ScopeDraft, nil, "d-1"))
 }
 
 func TestCancelRun_PropagatesConflict(t *testing.T) {
@@ -161,7 +162,7 @@ func TestCancelRun_PropagatesConflict(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	err := CancelRun("p-1", ScopeDraft, nil, "d-1")
+	err := CancelRun(context.Background(), "p-1", ScopeDraft, nil, "d-1")
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "HTTP 409")
 	assert.Contains(t, err.Error(), "already terminal")
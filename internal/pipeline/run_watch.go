@@ -0,0 +1,375 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// run_watch.go polls a run until it reaches a terminal state, turning the
+// status and task-execution endpoints into a stream of events for
+// `dr pipeline run create --wait/--follow` and `dr pipeline run watch`.
+
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrRunWaitTimeout is returned by WatchRun when the run is still going
+// when the timeout elapses.
+var ErrRunWaitTimeout = errors.New("timed out waiting for run")
+
+// Run event types, the "type" of each JSON event. RunEventCreated is
+// emitted by `dr pipeline run create`, not by WatchRun.
+const (
+	RunEventCreated      = "created"
+	RunEventStatus       = "status"
+	RunEventTaskStarted  = "task_started"
+	RunEventTaskFinished = "task_finished"
+	RunEventLog          = "log"
+)
+
+// RunEvent is one thing that happened to a watched run: its status
+// changed, a task started or finished, or a task printed a log line.
+type RunEvent struct {
+	Type   string    `json:"type"`
+	RunID  string    `json:"run_id"`
+	Time   time.Time `json:"time"`
+	Status string    `json:"status,omitempty"`
+	Task   string    `json:"task,omitempty"`
+	TaskID *int      `json:"task_id,omitempty"`
+	NodeID *int      `json:"node_id,omitempty"`
+	Line   string    `json:"line,omitempty"`
+	Error  string    `json:"error,omitempty"`
+}
+
+// WatchOptions configures WatchRun.
+type WatchOptions struct {
+	Interval time.Duration
+	Timeout  time.Duration
+
+	// Logs streams each task's log lines as they appear.
+	Logs bool
+
+	// OnEvent receives every event in order. An error stops the watch.
+	OnEvent func(RunEvent) error
+
+	// OnWarn receives problems worth mentioning that do not stop the
+	// watch, such as a task whose logs could not be read. May be nil.
+	OnWarn func(string)
+}
+
+// IsTerminalRunStatus reports whether a run (or task) status is final.
+func IsTerminalRunStatus(status string) bool {
+	switch status {
+	case RunStatusCompleted, RunStatusFailed, RunStatusCancelled, RunStatusErrored:
+		return true
+	}
+
+	return false
+}
+
+// WatchRun polls runID until it reaches a terminal status and returns that
+// status. Tasks are reported as they start and finish; with opts.Logs their
+// log lines are interleaved, each tagged with the task it came from.
+//
+// A cancelled ctx returns ctx.Err(); running out of opts.Timeout returns
+// an error wrapping ErrRunWaitTimeout.
+func WatchRun(ctx context.Context, pipelineID string, scope Scope, version *int, runID string, opts WatchOptions) (string, error) {
+	if opts.Interval <= 0 {
+		return "", fmt.Errorf("invalid interval %s: must be positive", opts.Interval)
+	}
+
+	if opts.OnEvent == nil {
+		return "", errors.New("watch run: OnEvent is required")
+	}
+
+	if opts.OnWarn == nil {
+		opts.OnWarn = func(string) {}
+	}
+
+	w := &runWatcher{
+		pipelineID: pipelineID,
+		runID:      runID,
+		opts:       opts,
+		tasks:      map[string]*watchedTask{},
+	}
+
+	deadline := time.Now().Add(opts.Timeout)
+
+	for {
+		status, err := GetRunStatus(ctx, pipelineID, scope, version, runID)
+		if err != nil {
+			if ctx.Err() != nil {
+				return w.status, ctx.Err()
+			}
+
+			return w.status, fmt.Errorf("get run status: %w", err)
+		}
+
+		// Tasks go first so that a run's final status is the last event,
+		// after the tasks that led to it.
+		if err := w.pollTasks(ctx, IsTerminalRunStatus(status.Status)); err != nil {
+			return w.status, err
+		}
+
+		if err := w.setStatus(status.Status); err != nil {
+			return w.status, err
+		}
+
+		if IsTerminalRunStatus(status.Status) {
+			return status.Status, nil
+		}
+
+		if opts.Timeout > 0 && time.Now().After(deadline) {
+			return w.status, fmt.Errorf("%w %s after %s; it is still %s", ErrRunWaitTimeout, runID, opts.Timeout, w.status)
+		}
+
+		select {
+		case <-ctx.Done():
+			return w.status, ctx.Err()
+		case <-time.After(opts.Interval):
+		}
+	}
+}
+
+// runWatcher is one watch's state between polls.
+type runWatcher struct {
+	pipelineID string
+	runID      string
+	opts       WatchOptions
+
+	status     string
+	tasks      map[string]*watchedTask
+	listWarned bool
+}
+
+// watchedTask tracks what has been reported for one task execution.
+type watchedTask struct {
+	started  bool
+	finished bool
+	logLines int // complete log lines already emitted
+}
+
+func (w *runWatcher) emit(e RunEvent) error {
+	e.RunID = w.runID
+
+	if e.Time.IsZero() {
+		e.Time = time.Now().UTC()
+	}
+
+	return w.opts.OnEvent(e)
+}
+
+func (w *runWatcher) setStatus(status string) error {
+	if status == w.status {
+		return nil
+	}
+
+	w.status = status
+
+	return w.emit(RunEvent{Type: RunEventStatus, Status: status})
+}
+
+// pollTasks reports task starts, log lines and finishes since the last
+// poll. runDone marks the last poll, when every task's remaining log lines
+// are flushed whether or not the task reports a terminal status.
+func (w *runWatcher) pollTasks(ctx context.Context, runDone bool) error {
+	execs, err := ListTaskExecutions(ctx, w.pipelineID, w.runID)
+	if err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		// The run may not have scheduled any task yet; the next poll
+		// tries again. Say so once rather than on every poll.
+		if !w.listWarned {
+			w.listWarned = true
+			w.opts.OnWarn("could not list task executions: " + err.Error())
+		}
+
+		return nil
+	}
+
+	names := taskDisplayNames(execs)
+
+	for i, te := range execs {
+		if err := w.pollTask(ctx, te, names[i], runDone); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (w *runWatcher) pollTask(ctx context.Context, te TaskExecution, name string, runDone bool) error {
+	key := taskKey(te)
+
+	t, ok := w.tasks[key]
+	if !ok {
+		t = &watchedTask{}
+		w.tasks[key] = t
+	}
+
+	if t.finished {
+		return nil
+	}
+
+	base := RunEvent{Task: name, TaskID: te.TaskID, NodeID: te.NodeID}
+	terminal := IsTerminalRunStatus(te.Status)
+
+	if !t.started && (te.StartedAt != nil || te.Status == RunStatusRunning || terminal) {
+		t.started = true
+
+		e := base
+		e.Type = RunEventTaskStarted
+		e.Status = te.Status
+
+		if te.StartedAt != nil {
+			e.Time = te.StartedAt.UTC()
+		}
+
+		if err := w.emit(e); err != nil {
+			return err
+		}
+	}
+
+	if w.opts.Logs && t.started {
+		if err := w.streamLogs(ctx, te, t, base, terminal || runDone); err != nil {
+			return err
+		}
+	}
+
+	if !terminal {
+		return nil
+	}
+
+	t.finished = true
+
+	e := base
+	e.Type = RunEventTaskFinished
+	e.Status = te.Status
+
+	if te.CompletedAt != nil {
+		e.Time = te.CompletedAt.UTC()
+	}
+
+	if te.ErrorDetail != nil {
+		e.Error = *te.ErrorDetail
+	}
+
+	return w.emit(e)
+}
+
+// streamLogs emits the log lines a task printed since the last poll. Live
+// logs are read first; once the task is over and its pod is gone they fall
+// back to the durable stdout log. Until final, a trailing line without a
+// newline is held back in case the task is still writing it.
+func (w *runWatcher) streamLogs(ctx context.Context, te TaskExecution, t *watchedTask, base RunEvent, final bool) error {
+	if te.TaskID == nil {
+		return nil
+	}
+
+	content, err := w.taskLogs(ctx, *te.TaskID, te.NodeID, final)
+	if err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		if final {
+			w.opts.OnWarn(fmt.Sprintf("could not read logs for task %s: %v", base.Task, err))
+		}
+
+		return nil
+	}
+
+	lines := strings.Split(content, "\n")
+	if !final || lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	if len(lines) < t.logLines {
+		// The log restarted (a retried pod); start over rather than skip.
+		t.logLines = 0
+	}
+
+	for _, line := range lines[t.logLines:] {
+		e := base
+		e.Type = RunEventLog
+		e.Line = line
+
+		if err := w.emit(e); err != nil {
+			return err
+		}
+	}
+
+	t.logLines = len(lines)
+
+	return nil
+}
+
+func (w *runWatcher) taskLogs(ctx context.Context, taskID int, nodeID *int, final bool) (string, error) {
+	live, err := GetTaskLogs(ctx, w.pipelineID, w.runID, taskID, nodeID, nil, "")
+	if err == nil {
+		return live.Logs, nil
+	}
+
+	if !final {
+		return "", err
+	}
+
+	durable, derr := GetTaskDurableLog(ctx, w.pipelineID, w.runID, taskID, nodeID, "stdout", "")
+	if derr != nil {
+		return "", errors.Join(err, derr)
+	}
+
+	return durable.Content, nil
+}
+
+// taskKey identifies a task execution across polls: the node id is unique
+// per invocation, the task id and name are fallbacks for older servers.
+func taskKey(te TaskExecution) string {
+	switch {
+	case te.NodeID != nil:
+		return "node:" + strconv.Itoa(*te.NodeID)
+	case te.TaskID != nil:
+		return "task:" + strconv.Itoa(*te.TaskID)
+	default:
+		return "name:" + te.Name
+	}
+}
+
+// taskDisplayNames labels each execution with its task name, adding the
+// node id where a fanned-out task ran more than once so the interleaved
+// log lines stay attributable.
+func taskDisplayNames(execs []TaskExecution) []string {
+	counts := map[string]int{}
+
+	for _, te := range execs {
+		counts[te.Name]++
+	}
+
+	names := make([]string, len(execs))
+
+	for i, te := range execs {
+		names[i] = te.Name
+
+		if counts[te.Name] > 1 && te.NodeID != nil {
+			names[i] += "#" + strconv.Itoa(*te.NodeID)
+		}
+	}
+
+	return names
+}
@@ -0,0 +1,222 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	stdsync "sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// runScript serves one step of a run per status poll: the status, the task
+// list, and each task's live logs at that point.
+type runScript struct {
+	mu    stdsync.Mutex
+	step  int
+	steps []runStep
+}
+
+type runStep struct {
+	status string
+	tasks  string
+	logs   map[string]string // task id to live log content
+}
+
+func (s *runScript) serve(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/api/v2/pipelines/p-1/dispatches/d-1/status":
+			s.mu.Lock()
+			step := s.steps[min(s.step, len(s.steps)-1)]
+			s.step++
+			s.mu.Unlock()
+
+			_, _ = fmt.Fprintf(w, `{"id":"d-1","status":%q}`, step.status)
+		case "/api/v2/pipelines/p-1/dispatches/d-1/tasks":
+			_, _ = w.Write([]byte(s.prev().tasks))
+		default:
+			id := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/api/v2/pipelines/p-1/dispatches/d-1/tasks/"), "/logs")
+
+			logs, ok := s.prev().logs[id]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+
+				return
+			}
+
+			_, _ = fmt.Fprintf(w, `{"logs":%q}`, logs)
+		}
+	}))
+}
+
+// prev is the step the last status poll served; tasks and logs are read
+// after it within the same poll.
+func (s *runScript) prev() runStep {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.steps[max(min(s.step, len(s.steps))-1, 0)]
+}
+
+func watchScript(t *testing.T, script *runScript, opts WatchOptions) (string, []RunEvent, error) {
+	t.Helper()
+	installSkipAuth(t)
+
+	srv := script.serve(t)
+	defer srv.Close()
+
+	installEndpoint(t, srv.URL)
+
+	var events []RunEvent
+
+	opts.Interval = time.Millisecond
+	opts.OnEvent = func(e RunEvent) error {
+		events = append(events, e)
+
+		return nil
+	}
+
+	status, err := WatchRun(context.Background(), "p-1", ScopeDraft, nil, "d-1", opts)
+
+	return status, events, err
+}
+
+func describe(events []RunEvent) []string {
+	out := make([]string, len(events))
+
+	for i, e := range events {
+		switch e.Type {
+		case RunEventLog:
+			out[i] = e.Task + ": " + e.Line
+		case RunEventStatus:
+			out[i] = "run " + e.Status
+		default:
+			out[i] = e.Task + " " + e.Type + " " + e.Status
+		}
+	}
+
+	return out
+}
+
+func TestWatchRun_InterleavesTasksAndLogs(t *testing.T) {
+	script := &runScript{steps: []runStep{
+		{status: "PENDING", tasks: `[]`},
+		{
+			status: "RUNNING",
+			tasks:  `[{"taskId":1,"nodeId":1,"name":"load","status":"RUNNING"}]`,
+			logs:   map[string]string{"1": "reading\nhalf"},
+		},
+		{
+			status: "RUNNING",
+			tasks: `[{"taskId":1,"nodeId":1,"name":"load","status":"COMPLETED"},
+				{"taskId":2,"nodeId":2,"name":"train","status":"RUNNING"}]`,
+			logs: map[string]string{"1": "reading\nhalf done\n", "2": "epoch 1\n"},
+		},
+		{
+			status: "FAILED",
+			tasks: `[{"taskId":1,"nodeId":1,"name":"load","status":"COMPLETED"},
+				{"taskId":2,"nodeId":2,"name":"train","status":"FAILED","errorDetail":"OOM"}]`,
+			logs: map[string]string{"2": "epoch 1\nepoch 2\n"},
+		},
+	}}
+
+	status, events, err := watchScript(t, script, WatchOptions{Logs: true})
+	require.NoError(t, err)
+	assert.Equal(t, RunStatusFailed, status)
+
+	assert.Equal(t, []string{
+		"run PENDING",
+		"load task_started RUNNING",
+		"load: reading",
+		"run RUNNING",
+		"load: half done",
+		"load task_finished COMPLETED",
+		"train task_started RUNNING",
+		"train: epoch 1",
+		"train: epoch 2",
+		"train task_finished FAILED",
+		"run FAILED",
+	}, describe(events))
+
+	assert.Equal(t, "OOM", events[9].Error)
+	assert.Equal(t, "d-1", events[0].RunID)
+}
+
+func TestWatchRun_WithoutLogs(t *testing.T) {
+	script := &runScript{steps: []runStep{
+		{status: "RUNNING", tasks: `[{"taskId":1,"name":"load","status":"RUNNING"}]`},
+		{status: "COMPLETED", tasks: `[{"taskId":1,"name":"load","status":"COMPLETED"}]`},
+	}}
+
+	status, events, err := watchScript(t, script, WatchOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, RunStatusCompleted, status)
+	assert.Equal(t, []string{
+		"load task_started RUNNING",
+		"run RUNNING",
+		"load task_finished COMPLETED",
+		"run COMPLETED",
+	}, describe(events))
+}
+
+func TestWatchRun_Timeout(t *testing.T) {
+	script := &runScript{steps: []runStep{{status: "RUNNING", tasks: `[]`}}}
+
+	status, _, err := watchScript(t, script, WatchOptions{Timeout: 5 * time.Millisecond})
+	require.ErrorIs(t, err, ErrRunWaitTimeout)
+	assert.Equal(t, RunStatusRunning, status)
+}
+
+func TestWatchRun_OnEventErrorStops(t *testing.T) {
+	installSkipAuth(t)
+
+	srv := (&runScript{steps: []runStep{{status: "RUNNING", tasks: `[]`}}}).serve(t)
+	defer srv.Close()
+
+	installEndpoint(t, srv.URL)
+
+	stop := errors.New("stop")
+
+	_, err := WatchRun(context.Background(), "p-1", ScopeDraft, nil, "d-1", WatchOptions{
+		Interval: time.Millisecond,
+		OnEvent:  func(RunEvent) error { return stop },
+	})
+	require.ErrorIs(t, err, stop)
+}
+
+func TestTaskDisplayNames_FanOut(t *testing.T) {
+	one, two, three := 1, 2, 3
+
+	names := taskDisplayNames([]TaskExecution{
+		{Name: "score", NodeID: &one},
+		{Name: "score", NodeID: &two},
+		{Name: "report", NodeID: &three},
+	})
+
+	assert.Equal(t, []string{"score#1", "score#2", "report"}, names)
+}
@@ -20,6 +20,7 @@
 package pipeline
 
 import (
+	"context"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -73,7 +74,7 @@ func scheduleBase(pipelineID string) (string, error) {
 }
 
 // CreateSchedule registers a new recurring run for a locked pipeline version.
-func CreateSchedule(pipelineID string, body ScheduleCreateRequest) (*Schedule, error) {
+func CreateSchedule(ctx context.Context, pipelineID string, body ScheduleCreateRequest) (*Schedule, error) {
 	endpoint, err := scheduleBase(pipelineID)
 	if err != nil {
 		return nil, err
@@ -81,7 +82,7 @@ func CreateSchedule(pipelineID string, body ScheduleCreateRequest) (*Schedule, e
 
 	var result Schedule
 
-	err = doJSON(http.MethodPost, endpoint, body, "create schedule", &result)
+	err = doJSON(ctx, http.MethodPost, endpoint, body, "create schedule", &result)
 	if err != nil {
 		return nil, err
 	}
@@ -90,7 +91,7 @@ func CreateSchedule(pipelineID string, body ScheduleCreateRequest) (*Schedule, e
 }
 
 // ListSchedules returns a paginated list of all schedules for a pipeline.
-func ListSchedules(pipelineID string, offset, limit int) ([]Schedule, error) {
+func ListSchedules(ctx context.Context, pipelineID string, offset, limit int) ([]Schedule, error) {
 	endpoint, err := scheduleBase(pipelineID)
 	if err != nil {
 		return nil, err
@@ -111,7 +112,7 @@ func ListSchedules(pipelineID string, offset, limit int) ([]Schedule, error) {
 
 	var page DataPage[Schedule]
 
-	err = doJSON(http.MethodGet, endpoint, nil, "schedules", &page)
+	err = doJSON(ctx, http.MethodGet, endpoint, nil, "schedules", &page)
 	if err != nil {
 		return nil, err
 	}
@@ -120,7 +121,7 @@ func ListSchedules(pipelineID string, offset, limit int) ([]Schedule, error) {
 }
 
 // GetSchedule fetches a single schedule by id.
-func GetSchedule(pipelineID, scheduleID string) (*Schedule, error) {
+func GetSchedule(ctx context.Context, pipelineID, scheduleID string) (*Schedule, error) {
 	endpoint, err := scheduleBase(pipelineID)
 	if err != nil {
 		return nil, err
@@ -130,7 +131,7 @@ func GetSchedule(pipelineID, scheduleID string) (*Schedule, error) {
 
 	var schedule Schedule
 
-	err = doJSON(http.MethodGet, endpoint, nil, "schedule", &schedule)
+	err = doJSON(ctx, http.MethodGet, endpoint, nil, "schedule", &schedule)
 	if err != nil {
 		return nil, err
 	}
@@ -139,7 +140,7 @@ func GetSchedule(pipelineID, scheduleID string) (*Schedule, error) {
 }
 
 // UpdateSchedule patches a schedule's cron expression and/or timezone.
-func UpdateSchedule(pipelineID, scheduleID string, body ScheduleUpdateRequest) (*Schedule, error) {
+func UpdateSchedule(ctx context.Context, pipelineID, scheduleID string, body ScheduleUpdateRequest) (*Schedule, error) {
 	endpoint, err := scheduleBase(pipelineID)
 	if err != nil {
 		return nil, err
@@ -149,7 +150,7 @@ func UpdateSchedule(pipelineID, scheduleID string, body ScheduleUpdateRequest) (
 
 	var result Schedule
 
-	err = doJSON(http.MethodPatch, endpoint, body, "update schedule", &result)
+	err = doJSON(ctx, http.MethodPatch, endpoint, body, "update schedule", &result)
 	if err != nil {
 		return nil, err
 	}
@@ -158,11 +159,11 @@ func UpdateSchedule(pipelineID, scheduleID string, body ScheduleUpdateRequest) (
 }
 
 // DeleteSchedule removes a schedule.
-func DeleteSchedule(pipelineID, scheduleID string) error {
+func DeleteSchedule(ctx context.Context, pipelineID, scheduleID string) error {
 	endpoint, err := scheduleBase(pipelineID)
 	if err != nil {
 		return err
 	}
 
-	return doDelete(endpoint+"/"+scheduleID, "delete schedule")
+	return doDelete(ctx, endpoint+"/"+scheduleID, "delete schedule")
 }
@@ -15,6 +15,7 @@
 package pipeline
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -49,7 +50,7 @@ func TestCreateSchedule_URLAndBody(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	got, err := CreateSchedule("p-1", ScheduleCreateRequest{
+	got, err := CreateSchedule(context.Background(), "p-1", ScheduleCreateRequest{
 		CronExpression:    "0 * * * *",
 		PipelineVersionID: 2,
 		PipelineInputID:   "in-1",
@@ -79,7 +80,7 @@ func TestListSchedules_QueryAndDecode(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	items, err := ListSchedules("p-1", 0, 5)
+	items, err := ListSchedules(context.Background(), "p-1", 0, 5)
 	require.NoError(t, err)
 	require.Len(t, items, 1)
 	assert.Equal(t, "s-1", items[0].ScheduleID)
@@ -100,7 +101,7 @@ func TestGetSchedule_TargetsCorrectURL(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	got, err := GetSchedule("p-1", "s-1")
+	got, err := GetSchedule(context.Background(), "p-1", "s-1")
 	require.NoError(t, err)
 	assert.Equal(t, ScheduleStatusPaused, got.Status)
 }
@@ -129,7 +130,7 @@ func TestUpdateSchedule_OmitsUnsuppliedFields(t *testing.T) {
 	installEndpoint(t, srv.URL)
 
 	cron := "*/15 * * * *"
-	got, err := UpdateSchedule("p-1", "s-1", ScheduleUpdateRequest{CronExpression: &cron})
+	got, err := UpdateSchedule(context.Background(), "p-1", "s-1", ScheduleUpdateRequest{CronExpression: &cron})
 	require.NoError(t, err)
 	assert.Equal(t, "*/15 * * * *", got.CronExpression)
 }
@@ -147,5 +148,5 @@ func TestDeleteSchedule_DeletesCorrectURL(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	require.NoError(t, DeleteSchedule("p-1", "s-1"))
+	require.NoError(t, DeleteSchedule(context.Background(), "p-1", "s-1"))
 }
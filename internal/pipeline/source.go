@@ -18,7 +18,10 @@
 //	GET /api/v2/pipelines/{id}/versions/{v}/source
 package pipeline
 
-import "net/http"
+import (
+	"context"
+	"net/http"
+)
 
 // PipelineSourceResponse mirrors PipelineSourceResponse from the pipelines-api.
 type PipelineSourceResponse struct {
@@ -28,7 +31,7 @@ type PipelineSourceResponse struct {
 // GetPipelineSource fetches the full source.py content of a pipeline.
 // For draft scope it calls GET /pipelines/{id}/source; for locked scope
 // it calls GET /pipelines/{id}/versions/{v}/source.
-func GetPipelineSource(pipelineID string, scope Scope, version *int) (*PipelineSourceResponse, error) {
+func GetPipelineSource(ctx context.Context, pipelineID string, scope Scope, version *int) (*PipelineSourceResponse, error) {
 	endpoint, err := EndpointFor(pipelineID, scope, version, "source")
 	if err != nil {
 		return nil, err
@@ -36,7 +39,7 @@ func GetPipelineSource(pipelineID string, scope Scope, version *int) (*PipelineS
 
 	var result PipelineSourceResponse
 
-	err = doJSON(http.MethodGet, endpoint, nil, "pipeline source", &result)
+	err = doJSON(ctx, http.MethodGet, endpoint, nil, "pipeline source", &result)
 	if err != nil {
 		return nil, err
 	}
@@ -24,6 +24,7 @@
 package pipeline
 
 import (
+	"context"
 	"net/http"
 	"strconv"
 )
@@ -57,7 +58,7 @@ type PipelineTask struct {
 // GetTask fetches per-task detail from the pipelines-api. For draft scope
 // the response always has Inputs=nil; for locked scope Inputs is the latest
 // VALID pipeline input payload or nil if none exists.
-func GetTask(pipelineID string, scope Scope, version *int, taskID int) (*PipelineTask, error) {
+func GetTask(ctx context.Context, pipelineID string, scope Scope, version *int, taskID int) (*PipelineTask, error) {
 	endpoint, err := EndpointFor(pipelineID, scope, version, "tasks/"+strconv.Itoa(taskID))
 	if err != nil {
 		return nil, err
@@ -65,7 +66,7 @@ func GetTask(pipelineID string, scope Scope, version *int, taskID int) (*Pipelin
 
 	var task PipelineTask
 
-	err = doJSON(http.MethodGet, endpoint, nil, "task", &task)
+	err = doJSON(ctx, http.MethodGet, endpoint, nil, "task", &task)
 	if err != nil {
 		return nil, err
 	}
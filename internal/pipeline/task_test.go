@@ -15,6 +15,7 @@
 package pipeline
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -48,7 +49,7 @@ func TestGetTask_DraftURLShape(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	got, err := GetTask("p-1", ScopeDraft, nil, 1)
+	got, err := GetTask(context.Background(), "p-1", ScopeDraft, nil, 1)
 	require.NoError(t, err)
 	assert.Equal(t, 1, got.TaskID)
 	assert.Equal(t, "p-1", got.PipelineID)
@@ -89,7 +90,7 @@ func TestGetTask_LockedURLShape(t *testing.T) {
 	installEndpoint(t, srv.URL)
 
 	v := 2
-	got, err := GetTask("p-1", ScopeLocked, &v, 1)
+	got, err := GetTask(context.Background(), "p-1", ScopeLocked, &v, 1)
 	require.NoError(t, err)
 	assert.Equal(t, 1, got.TaskID)
 	require.NotNil(t, got.VersionID)
@@ -110,7 +111,7 @@ func TestGetTask_404ReturnsHTTPError(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	_, err := GetTask("p-1", ScopeDraft, nil, 404)
+	_, err := GetTask(context.Background(), "p-1", ScopeDraft, nil, 404)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "HTTP 404")
 }
@@ -133,7 +134,7 @@ func TestGetTask_ParameterNilAnnotation(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	got, err := GetTask("p-1", ScopeDraft, nil, 1)
+	got, err := GetTask(context.Background(), "p-1", ScopeDraft, nil, 1)
 	require.NoError(t, err)
 	require.Len(t, got.Parameters, 1)
 	assert.Nil(t, got.Parameters[0].Annotation)
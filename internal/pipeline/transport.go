@@ -20,12 +20,15 @@
 //
 // Authorization, User-Agent, and consumer-trace headers are owned by the
 // shared drapi.AuthorizeRequest helper so the headers stay consistent with
-// every other CLI command.
+// every other CLI command. Retries of transient failures come from the
+// shared transport behind drapi.NewHTTPClient, and every request is bound
+// to the caller's context so Ctrl-C aborts it.
 
 package pipeline
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -43,8 +46,8 @@ const jsonTimeout = drapi.DefaultClientTimeout
 // doJSON performs a request with a JSON-encoded body. If body is nil the
 // request is sent with no body (useful for status-only POSTs). If out is
 // nil the response body is discarded.
-func doJSON(method, endpoint string, body any, info string, out any) error {
-	req, err := buildJSONRequest(method, endpoint, body)
+func doJSON(ctx context.Context, method, endpoint string, body any, info string, out any) error {
+	req, err := buildJSONRequest(ctx, method, endpoint, body)
 	if err != nil {
 		return err
 	}
@@ -84,7 +87,7 @@ func doJSON(method, endpoint string, body any, info string, out any) error {
 // buildJSONRequest assembles an authenticated *http.Request with a
 // JSON-encoded body. Extracted from doJSON to keep doJSON's cyclomatic
 // complexity within lint limits.
-func buildJSONRequest(method, endpoint string, body any) (*http.Request, error) {
+func buildJSONRequest(ctx context.Context, method, endpoint string, body any) (*http.Request, error) {
 	reqBody := &bytes.Buffer{}
 
 	if body != nil {
@@ -96,7 +99,7 @@ func buildJSONRequest(method, endpoint string, body any) (*http.Request, error)
 		reqBody = bytes.NewBuffer(buf)
 	}
 
-	req, err := http.NewRequest(method, endpoint, reqBody)
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, reqBody)
 	if err != nil {
 		return nil, err
 	}
@@ -125,8 +128,8 @@ type DataPage[T any] struct {
 
 // doDelete sends a DELETE and treats any 2xx response as success. The
 // response body is drained but ignored.
-func doDelete(endpoint, info string) error {
-	req, err := http.NewRequest(http.MethodDelete, endpoint, nil)
+func doDelete(ctx context.Context, endpoint, info string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, endpoint, nil)
 	if err != nil {
 		return err
 	}
@@ -15,6 +15,7 @@
 package pipeline
 
 import (
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -49,7 +50,7 @@ func installSkipAuth(t *testing.T) {
 func TestBuildJSONRequest_BodyAndHeaders(t *testing.T) {
 	installSkipAuth(t)
 
-	req, err := buildJSONRequest(http.MethodPost, "http://example/x", map[string]string{"a": "b"})
+	req, err := buildJSONRequest(context.Background(), http.MethodPost, "http://example/x", map[string]string{"a": "b"})
 	require.NoError(t, err)
 	assert.Equal(t, http.MethodPost, req.Method)
 	assert.Equal(t, "application/json", req.Header.Get("Content-Type"))
@@ -67,7 +68,7 @@ func TestBuildJSONRequest_BodyAndHeaders(t *testing.T) {
 func TestBuildJSONRequest_NilBodyOmitsContentType(t *testing.T) {
 	installSkipAuth(t)
 
-	req, err := buildJSONRequest(http.MethodPatch, "http://example/x", nil)
+	req, err := buildJSONRequest(context.Background(), http.MethodPatch, "http://example/x", nil)
 	require.NoError(t, err)
 	assert.Empty(t, req.Header.Get("Content-Type"))
 }
@@ -92,7 +93,7 @@ func TestDoJSON_DecodesSuccess(t *testing.T) {
 
 	var out map[string]bool
 
-	err := doJSON(http.MethodPost, srv.URL, map[string]string{"input_id": "in-1"}, "test", &out)
+	err := doJSON(context.Background(), http.MethodPost, srv.URL, map[string]string{"input_id": "in-1"}, "test", &out)
 	require.NoError(t, err)
 	assert.True(t, out["ok"])
 }
@@ -107,7 +108,7 @@ func TestDoJSON_NilOutDiscardsResponse(t *testing.T) {
 
 	defer srv.Close()
 
-	require.NoError(t, doJSON(http.MethodGet, srv.URL, nil, "", nil))
+	require.NoError(t, doJSON(context.Background(), http.MethodGet, srv.URL, nil, "", nil))
 }
 
 func TestDoJSON_404ReturnsHTTPError(t *testing.T) {
@@ -121,7 +122,7 @@ func TestDoJSON_404ReturnsHTTPError(t *testing.T) {
 
 	var out map[string]any
 
-	err := doJSON(http.MethodGet, srv.URL, nil, "", &out)
+	err := doJSON(context.Background(), http.MethodGet, srv.URL, nil, "", &out)
 	require.Error(t, err)
 
 	var httpErr *drapi.HTTPError
@@ -142,7 +143,7 @@ func TestDoJSON_400WithDetailReturnsFormattedError(t *testing.T) {
 
 	var out map[string]any
 
-	err := doJSON(http.MethodPost, srv.URL, map[string]string{}, "", &out)
+	err := doJSON(context.Background(), http.MethodPost, srv.URL, map[string]string{}, "", &out)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "HTTP 400")
 	assert.Contains(t, err.Error(), "lattice missing")
@@ -159,7 +160,7 @@ func TestDoDelete_SuccessOn2xx(t *testing.T) {
 
 	defer srv.Close()
 
-	require.NoError(t, doDelete(srv.URL, "test"))
+	require.NoError(t, doDelete(context.Background(), srv.URL, "test"))
 }
 
 func TestDoDelete_404ReturnsHTTPError(t *testing.T) {
@@ -171,7 +172,7 @@ func TestDoDelete_404ReturnsHTTPError(t *testing.T) {
 
 	defer srv.Close()
 
-	err := doDelete(srv.URL, "test")
+	err := doDelete(context.Background(), srv.URL, "test")
 	require.Error(t, err)
 
 	var httpErr *drapi.HTTPError
@@ -190,7 +191,7 @@ func TestDoDelete_409WithDetailReturnsFormattedError(t *testing.T) {
 
 	defer srv.Close()
 
-	err := doDelete(srv.URL, "test")
+	err := doDelete(context.Background(), srv.URL, "test")
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "HTTP 409")
 	assert.Contains(t, err.Error(), "already terminal")
@@ -27,6 +27,7 @@
 package pipeline
 
 import (
+	"context"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -72,7 +73,7 @@ type Graph struct {
 }
 
 // ListVersions fetches a paginated list of versions for a pipeline.
-func ListVersions(pipelineID string, offset, limit int) ([]PipelineVersion, error) {
+func ListVersions(ctx context.Context, pipelineID string, offset, limit int) ([]PipelineVersion, error) {
 	endpoint, err := config.GetEndpointURL("/api/v2/pipelines/" + pipelineID + "/versions")
 	if err != nil {
 		return nil, err
@@ -93,7 +94,7 @@ func ListVersions(pipelineID string, offset, limit int) ([]PipelineVersion, erro
 
 	var page DataPage[PipelineVersion]
 
-	err = doJSON(http.MethodGet, endpoint, nil, "pipeline versions", &page)
+	err = doJSON(ctx, http.MethodGet, endpoint, nil, "pipeline versions", &page)
 	if err != nil {
 		return nil, err
 	}
@@ -102,7 +103,7 @@ func ListVersions(pipelineID string, offset, limit int) ([]PipelineVersion, erro
 }
 
 // GetVersion fetches a single version of a pipeline.
-func GetVersion(pipelineID string, versionID int) (*PipelineVersion, error) {
+func GetVersion(ctx context.Context, pipelineID string, versionID int) (*PipelineVersion, error) {
 	endpoint, err := config.GetEndpointURL("/api/v2/pipelines/" + pipelineID + "/versions/" + strconv.Itoa(versionID))
 	if err != nil {
 		return nil, err
@@ -110,7 +111,7 @@ func GetVersion(pipelineID string, versionID int) (*PipelineVersion, error) {
 
 	var version PipelineVersion
 
-	err = doJSON(http.MethodGet, endpoint, nil, "pipeline version", &version)
+	err = doJSON(ctx, http.MethodGet, endpoint, nil, "pipeline version", &version)
 	if err != nil {
 		return nil, err
 	}
@@ -121,7 +122,7 @@ func GetVersion(pipelineID string, versionID int) (*PipelineVersion, error) {
 // GetGraph fetches the DAG visualization payload for a pipeline. When
 // scope is ScopeDraft the latest draft graph is returned; with
 // ScopeLocked + version, the graph for that locked version is returned.
-func GetGraph(pipelineID string, scope Scope, version *int) (*Graph, error) {
+func GetGraph(ctx context.Context, pipelineID string, scope Scope, version *int) (*Graph, error) {
 	endpoint, err := EndpointFor(pipelineID, scope, version, "graph")
 	if err != nil {
 		return nil, err
@@ -129,7 +130,7 @@ func GetGraph(pipelineID string, scope Scope, version *int) (*Graph, error) {
 
 	var graph Graph
 
-	err = doJSON(http.MethodGet, endpoint, nil, "graph", &graph)
+	err = doJSON(ctx, http.MethodGet, endpoint, nil, "graph", &graph)
 	if err != nil {
 		return nil, err
 	}
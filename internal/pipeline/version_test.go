@@ -15,6 +15,7 @@
 package pipeline
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -38,7 +39,7 @@ func TestListVersions_TargetsCorrectURL(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	items, err := ListVersions("p-1", 10, 0)
+	items, err := ListVersions(context.Background(), "p-1", 10, 0)
 	require.NoError(t, err)
 	require.Len(t, items, 1)
 	assert.Equal(t, 1, items[0].Version)
@@ -59,7 +60,7 @@ func TestGetVersion_TargetsCorrectURL(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	got, err := GetVersion("p-1", 2)
+	got, err := GetVersion(context.Background(), "p-1", 2)
 	require.NoError(t, err)
 	assert.Equal(t, 2, got.Version)
 }
@@ -82,7 +83,7 @@ func TestGetGraph_DraftURL(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	got, err := GetGraph("p-1", ScopeDraft, nil)
+	got, err := GetGraph(context.Background(), "p-1", ScopeDraft, nil)
 	require.NoError(t, err)
 	assert.Equal(t, "wf", got.Pipeline.Name)
 	require.Len(t, got.Nodes, 1)
@@ -104,7 +105,7 @@ func TestGetGraph_LockedURL(t *testing.T) {
 	installEndpoint(t, srv.URL)
 
 	v := 3
-	got, err := GetGraph("p-1", ScopeLocked, &v)
+	got, err := GetGraph(context.Background(), "p-1", ScopeLocked, &v)
 	require.NoError(t, err)
 	assert.Empty(t, got.Nodes)
 }
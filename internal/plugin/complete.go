@@ -0,0 +1,248 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/datarobot/cli/internal/config"
+	"github.com/datarobot/cli/internal/config/viperx"
+	"github.com/datarobot/cli/internal/fsutil"
+	"github.com/datarobot/cli/internal/log"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// PluginCompleteCommand is the first argument a plugin declaring completion in
+// its manifest is called with to complete a command line. It is cobra's own
+// hidden completion command, so a plugin built with cobra supports it as is:
+//
+//	dr-foo __complete <args...> <partial word>
+//
+// The plugin writes one candidate per line, optionally followed by a tab and
+// a description, then a final ":<directive>" line carrying a
+// cobra.ShellCompDirective.
+const PluginCompleteCommand = "__complete"
+
+// knownDirectives masks a plugin's directive to the bits this CLI's cobra
+// understands, so a newer plugin cannot hand the shell script a flag it
+// would misread.
+const knownDirectives = cobra.ShellCompDirectiveError |
+	cobra.ShellCompDirectiveNoSpace |
+	cobra.ShellCompDirectiveNoFileComp |
+	cobra.ShellCompDirectiveFilterFileExt |
+	cobra.ShellCompDirectiveFilterDirs |
+	cobra.ShellCompDirectiveKeepOrder
+
+// pluginCompletions is one answer to PluginCompleteCommand.
+type pluginCompletions struct {
+	completions []cobra.Completion
+	directive   cobra.ShellCompDirective
+}
+
+const (
+	// completionCacheFile keeps plugin answers between TABs. Every TAB is a
+	// fresh `dr __complete` process, so the cache has to live on disk.
+	completionCacheFile = "plugin-completions.json"
+
+	// completionCacheTTL bounds how stale a cached answer gets. Plugins may
+	// complete resources that come and go, so it is kept short: long enough
+	// to answer a burst of TABs on one command line without rerunning the
+	// plugin.
+	completionCacheTTL = 5 * time.Minute
+)
+
+// cachedCompletion is a plugin answer on disk.
+type cachedCompletion struct {
+	Completions []string  `json:"completions"`
+	Directive   int       `json:"directive"`
+	StoredAt    time.Time `json:"storedAt"`
+	// ModTime is the plugin executable's, so a rebuilt plugin is asked again.
+	ModTime time.Time `json:"modTime"`
+}
+
+// CompletePlugin asks a plugin to complete its command line: args are the
+// words after the plugin name and toComplete the partial word under the
+// cursor. Plugins that do not declare completion, and plugins that fail or
+// time out, get cobra's default, so the shell falls back to file names as it
+// did before the protocol existed.
+//
+// Answers are cached in the config directory for completionCacheTTL.
+func CompletePlugin(ctx context.Context, p DiscoveredPlugin, args []string, toComplete string, rootFlags *pflag.FlagSet) ([]cobra.Completion, cobra.ShellCompDirective) {
+	if !p.Manifest.Completion {
+		return nil, cobra.ShellCompDirectiveDefault
+	}
+
+	words := append(append([]string{PluginCompleteCommand}, args...), toComplete)
+	key := p.Executable + "\x00" + strings.Join(words, "\x00")
+	modTime := executableModTime(p.Executable)
+
+	cache := readCompletionCache()
+
+	if cached, ok := cache[key]; ok && cached.ModTime.Equal(modTime) && time.Since(cached.StoredAt) < completionCacheTTL {
+		return cached.Completions, cobra.ShellCompDirective(cached.Directive)
+	}
+
+	result, err := runPluginCompletion(ctx, p, words, rootFlags)
+	if err != nil {
+		log.Debug("Plugin completion failed", "plugin", p.Manifest.Name, "error", err)
+
+		return nil, cobra.ShellCompDirectiveDefault
+	}
+
+	cache[key] = cachedCompletion{
+		Completions: result.completions,
+		Directive:   int(result.directive),
+		StoredAt:    time.Now(),
+		ModTime:     modTime,
+	}
+
+	writeCompletionCache(cache)
+
+	return result.completions, result.directive
+}
+
+func executableModTime(path string) time.Time {
+	info, err := os.Stat(path)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return info.ModTime()
+}
+
+func completionCachePath() (string, error) {
+	dir, err := config.GetConfigDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, completionCacheFile), nil
+}
+
+// readCompletionCache returns the cached answers, or an empty cache when there
+// are none or the file cannot be read.
+func readCompletionCache() map[string]cachedCompletion {
+	cache := map[string]cachedCompletion{}
+
+	path, err := completionCachePath()
+	if err != nil {
+		return cache
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return cache
+	}
+
+	if err := json.Unmarshal(data, &cache); err != nil {
+		return map[string]cachedCompletion{}
+	}
+
+	return cache
+}
+
+// writeCompletionCache saves cache without its expired answers, ignoring
+// failures: the cache only saves a plugin run. Answers may name the user's
+// resources, so the file is owner-only.
+func writeCompletionCache(cache map[string]cachedCompletion) {
+	for key, cached := range cache {
+		if time.Since(cached.StoredAt) >= completionCacheTTL {
+			delete(cache, key)
+		}
+	}
+
+	path, err := completionCachePath()
+	if err != nil {
+		return
+	}
+
+	data, err := json.Marshal(cache)
+	if err != nil {
+		return
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		log.Debugf("Failed to create config directory for the plugin completion cache: %v", err)
+		return
+	}
+
+	if err := fsutil.AtomicWriteFileMode(path, data, 0o600); err != nil {
+		log.Debugf("Failed to write the plugin completion cache: %v", err)
+	}
+}
+
+// runPluginCompletion runs the plugin's completion command under
+// plugin.completion_timeout_ms. The environment is the one the plugin runs
+// with, including credentials when it declares authentication and they are
+// already configured; completion never prompts for a login.
+func runPluginCompletion(ctx context.Context, p DiscoveredPlugin, words []string, rootFlags *pflag.FlagSet) (pluginCompletions, error) {
+	timeout := time.Second
+	if viperx.IsSet("plugin.completion_timeout_ms") {
+		timeout = time.Duration(viperx.GetInt("plugin.completion_timeout_ms")) * time.Millisecond
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Unlike buildPluginCommand there is no grace period on cancel: the shell
+	// is waiting on the answer.
+	name, cmdArgs := pluginCommandArgs(p.Executable, words...)
+
+	cmd := exec.CommandContext(ctx, name, cmdArgs...)
+	cmd.Env = buildPluginEnv(p.Executable, p.Manifest.Authentication, rootFlags)
+
+	output, err := cmd.Output()
+	if err != nil {
+		return pluginCompletions{}, err
+	}
+
+	return parsePluginCompletions(output), nil
+}
+
+// parsePluginCompletions reads the output of PluginCompleteCommand. Output
+// without a directive line keeps its candidates with the default directive.
+func parsePluginCompletions(output []byte) pluginCompletions {
+	var lines []string
+
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	for scanner.Scan() {
+		if line := strings.TrimRight(scanner.Text(), "\r"); line != "" {
+			lines = append(lines, line)
+		}
+	}
+
+	result := pluginCompletions{directive: cobra.ShellCompDirectiveDefault}
+
+	if n := len(lines); n > 0 && strings.HasPrefix(lines[n-1], ":") {
+		if directive, err := strconv.Atoi(lines[n-1][1:]); err == nil && directive >= 0 {
+			result.directive = cobra.ShellCompDirective(directive) & knownDirectives
+			lines = lines[:n-1]
+		}
+	}
+
+	result.completions = lines
+
+	return result
+}
@@ -0,0 +1,191 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/datarobot/cli/internal/testutil"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeCompletionScript creates a POSIX plugin answering __complete with
+// "<args>" and "beta\tsecond", then ":4". Every call appends a line to the
+// returned calls file.
+func writeCompletionScript(t *testing.T) (string, string) {
+	t.Helper()
+
+	if runtime.GOOS == "windows" {
+		t.Skip("completion scripts are POSIX shell")
+	}
+
+	dir := t.TempDir()
+	calls := filepath.Join(dir, "calls")
+	path := filepath.Join(dir, "dr-complete")
+
+	createScript(t, path, "#!/bin/sh\n"+
+		"echo x >> '"+calls+"'\n"+
+		"[ \"$1\" = \"__complete\" ] || exit 1\n"+
+		"shift\n"+
+		"echo \"$*\"\n"+
+		"printf 'beta\\tsecond\\n'\n"+
+		"echo :4\n")
+
+	return path, calls
+}
+
+func countCalls(t *testing.T, calls string) int {
+	t.Helper()
+
+	data, err := os.ReadFile(calls)
+	if os.IsNotExist(err) {
+		return 0
+	}
+
+	require.NoError(t, err)
+
+	return strings.Count(string(data), "x")
+}
+
+// resetCompletions gives a test an empty config directory, and so an empty
+// completion cache.
+func resetCompletions(t *testing.T) {
+	t.Helper()
+
+	testutil.SetXDGEnv(t, "XDG_CONFIG_HOME", t.TempDir())
+}
+
+func TestCompletePlugin_ForwardsAndTranslates(t *testing.T) {
+	resetCompletions(t)
+
+	path, calls := writeCompletionScript(t)
+	p := DiscoveredPlugin{Manifest: PluginManifest{Completion: true}, Executable: path}
+
+	comps, directive := CompletePlugin(context.Background(), p, []string{"deploy", "--to"}, "pr", nil)
+
+	assert.Equal(t, []cobra.Completion{"deploy --to pr", "beta\tsecond"}, comps)
+	assert.Equal(t, cobra.ShellCompDirectiveNoFileComp, directive)
+	assert.Equal(t, 1, countCalls(t, calls))
+}
+
+func TestCompletePlugin_CachesAnswers(t *testing.T) {
+	resetCompletions(t)
+
+	path, calls := writeCompletionScript(t)
+	p := DiscoveredPlugin{Manifest: PluginManifest{Completion: true}, Executable: path}
+
+	CompletePlugin(context.Background(), p, []string{"deploy"}, "", nil)
+	CompletePlugin(context.Background(), p, []string{"deploy"}, "", nil)
+	assert.Equal(t, 1, countCalls(t, calls), "the same command line is answered from the cache")
+
+	CompletePlugin(context.Background(), p, []string{"deploy"}, "x", nil)
+	assert.Equal(t, 2, countCalls(t, calls), "a different command line runs the plugin again")
+
+	cache := readCompletionCache()
+	for key, cached := range cache {
+		cached.StoredAt = time.Now().Add(-completionCacheTTL)
+		cache[key] = cached
+	}
+
+	writeCompletionCache(cache)
+	assert.Empty(t, readCompletionCache(), "expired answers are dropped")
+
+	CompletePlugin(context.Background(), p, []string{"deploy"}, "", nil)
+	assert.Equal(t, 3, countCalls(t, calls), "an expired answer runs the plugin again")
+}
+
+func TestCompletePlugin_WithoutCapability(t *testing.T) {
+	resetCompletions(t)
+
+	path, calls := writeCompletionScript(t)
+	p := DiscoveredPlugin{Manifest: PluginManifest{}, Executable: path}
+
+	comps, directive := CompletePlugin(context.Background(), p, nil, "", nil)
+
+	assert.Empty(t, comps)
+	assert.Equal(t, cobra.ShellCompDirectiveDefault, directive)
+	assert.Equal(t, 0, countCalls(t, calls), "a plugin that does not declare completion is never run")
+}
+
+func TestCompletePlugin_FailureFallsBackToDefault(t *testing.T) {
+	resetCompletions(t)
+
+	p := DiscoveredPlugin{
+		Manifest:   PluginManifest{Completion: true},
+		Executable: writeExitScript(t, t.TempDir(), "dr-broken", 3),
+	}
+
+	comps, directive := CompletePlugin(context.Background(), p, nil, "", nil)
+
+	assert.Empty(t, comps)
+	assert.Equal(t, cobra.ShellCompDirectiveDefault, directive)
+}
+
+func TestParsePluginCompletions(t *testing.T) {
+	tests := []struct {
+		name      string
+		output    string
+		want      []cobra.Completion
+		directive cobra.ShellCompDirective
+	}{
+		{
+			name:      "candidates and directive",
+			output:    "alpha\nbeta\tdescribed\n:36\n",
+			want:      []cobra.Completion{"alpha", "beta\tdescribed"},
+			directive: cobra.ShellCompDirectiveNoFileComp | cobra.ShellCompDirectiveKeepOrder,
+		},
+		{
+			name:      "no directive line",
+			output:    "alpha\n",
+			want:      []cobra.Completion{"alpha"},
+			directive: cobra.ShellCompDirectiveDefault,
+		},
+		{
+			name:      "only a directive",
+			output:    ":2\n",
+			want:      []cobra.Completion{},
+			directive: cobra.ShellCompDirectiveNoSpace,
+		},
+		{
+			name:      "unknown directive bits are dropped",
+			output:    "alpha\n:1028\n",
+			want:      []cobra.Completion{"alpha"},
+			directive: cobra.ShellCompDirectiveNoFileComp,
+		},
+		{
+			name:      "malformed directive is a candidate",
+			output:    "alpha\n:nope\r\n",
+			want:      []cobra.Completion{"alpha", ":nope"},
+			directive: cobra.ShellCompDirectiveDefault,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := parsePluginCompletions([]byte(tt.output))
+
+			assert.Equal(t, tt.want, got.completions)
+			assert.Equal(t, tt.directive, got.directive)
+		})
+	}
+}
@@ -251,7 +251,7 @@ func TestPluginUpgradeWithRollback(t *testing.T) {
 			URL:     "file://" + archivePath,
 		}
 
-		err := InstallPlugin(entry, version, "")
+		err := InstallPlugin(entry, version, "", false)
 		require.NoError(t, err)
 
 		installedPlugins, err := GetInstalledPlugins()
@@ -314,7 +314,7 @@ func TestPluginUpgradeWithRollback(t *testing.T) {
 			URL:     "file://" + brokenArchive,
 		}
 
-		err = InstallPlugin(entry, version, "")
+		err = InstallPlugin(entry, version, "", false)
 		require.NoError(t, err)
 
 		err = ValidatePlugin(pluginName)
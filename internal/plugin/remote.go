@@ -75,12 +75,27 @@ func FetchRegistryWithContext(ctx context.Context, registryURL string) (*PluginR
 		return nil, "", fmt.Errorf("Failed to fetch registry: HTTP %d", resp.StatusCode)
 	}
 
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, "", fmt.Errorf("Failed to fetch registry: %w", err)
+	}
+
 	var registry PluginRegistry
 
-	if err := json.NewDecoder(resp.Body).Decode(&registry); err != nil {
+	if err := json.Unmarshal(data, &registry); err != nil {
 		return nil, "", fmt.Errorf("Failed to parse registry: %w", err)
 	}
 
+	if verifyRegistrySignature(ctx, client, registryURL, data) {
+		for name, entry := range registry.Plugins {
+			for i := range entry.Versions {
+				entry.Versions[i].indexSigned = true
+			}
+
+			registry.Plugins[name] = entry
+		}
+	}
+
 	// Extract base URL (directory containing index.json)
 	baseURL := registryURL
 	if idx := strings.LastIndex(baseURL, "/"); idx > 0 {
@@ -90,6 +105,52 @@ func FetchRegistryWithContext(ctx context.Context, registryURL string) (*PluginR
 	return &registry, baseURL, nil
 }
 
+// verifyRegistrySignature reports whether the index at registryURL carries a
+// detached signature, at registryURL+SignatureExt, from a trusted key. The
+// index pins every archive's SHA256, so a signed index vouches for archives
+// that have no signature of their own. An index without a signature is
+// normal for third-party registries; one whose signature fails is not, so
+// that is warned about.
+func verifyRegistrySignature(ctx context.Context, client *http.Client, registryURL string, data []byte) bool {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, registryURL+SignatureExt, nil)
+	if err != nil {
+		return false
+	}
+
+	req.Header.Set("User-Agent", config.GetUserAgentHeader())
+
+	resp, err := client.Do(req)
+	if err != nil {
+		log.Debug("Failed to fetch registry signature", "error", err)
+
+		return false
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		log.Debug("Registry index is not signed", "url", registryURL, "status", resp.StatusCode)
+
+		return false
+	}
+
+	signature, err := io.ReadAll(resp.Body)
+	if err != nil {
+		log.Debug("Failed to read registry signature", "error", err)
+
+		return false
+	}
+
+	if err := VerifySignature(data, signature, TrustedKeys()); err != nil {
+		log.Warn("Registry index signature does not verify; each plugin needs its own signature", "url", registryURL, "reason", err)
+
+		return false
+	}
+
+	log.Debug("Registry index signature verified", "url", registryURL)
+
+	return true
+}
+
 // ResolveVersion finds the best matching version for a constraint
 // Supports full semver constraint syntax including: exact (1.2.3), caret (^1.2.3), tilde (~1.2.3),
 // ranges (>=1.0.0), comma-separated constraints (>=1.0.0, <2.0.0), and latest.
@@ -276,14 +337,16 @@ func readNameFromArchive(archivePath string) (string, error) {
 	return manifest.Name, nil
 }
 
-// InstallPlugin downloads and installs a plugin.
-func InstallPlugin(pluginEntry RegistryPlugin, version RegistryVersion, baseURL string) error {
+// InstallPlugin downloads and installs a plugin. The archive must carry a
+// signature from one of TrustedKeys unless allowUnsigned is set, in which case
+// a missing or failing signature is only warned about.
+func InstallPlugin(pluginEntry RegistryPlugin, version RegistryVersion, baseURL string, allowUnsigned bool) error {
 	pluginDir, err := preparePluginDirectory(pluginEntry.Name)
 	if err != nil {
 		return err
 	}
 
-	archivePath, err := downloadAndVerifyPlugin(version, baseURL)
+	archivePath, err := downloadAndVerifyPlugin(version, baseURL, allowUnsigned)
 	if err != nil {
 		return err
 	}
@@ -309,7 +372,7 @@ func preparePluginDirectory(name string) (string, error) {
 	return filepath.Join(managedDir, name), nil
 }
 
-func downloadAndVerifyPlugin(version RegistryVersion, baseURL string) (string, error) {
+func downloadAndVerifyPlugin(version RegistryVersion, baseURL string, allowUnsigned bool) (string, error) {
 	log.Debug("Downloading plugin", "url", version.URL)
 
 	archivePath, err := downloadFile(version.URL, baseURL)
@@ -333,9 +396,83 @@ func downloadAndVerifyPlugin(version RegistryVersion, baseURL string) (string, e
 		}
 	}
 
+	if err := verifySignature(archivePath, version, baseURL, allowUnsigned); err != nil {
+		os.Remove(archivePath)
+
+		return "", err
+	}
+
 	return archivePath, nil
 }
 
+// verifySignature checks the archive against its detached signature. The
+// checksum alone comes from the same index.json as the URL, so whoever can
+// change one can change both; the signature is what ties the archive to a key
+// the user chose to trust. An archive without a signature is accepted when
+// the index itself was signed by a trusted key and pinned its checksum, which
+// downloadAndVerifyPlugin has already checked.
+//
+// Unsigned file:// archives are exempt, as they are from the checksum: the
+// archive is already on the user's disk, so there is no download to tamper
+// with. The exemption is logged so it is never silent.
+func verifySignature(archivePath string, version RegistryVersion, baseURL string, allowUnsigned bool) error {
+	if version.Signature == "" {
+		if version.indexSigned && version.SHA256 != "" {
+			log.Debug("Plugin archive covered by the signed registry index", "url", version.URL)
+
+			return nil
+		}
+
+		if strings.HasPrefix(version.URL, "file://") {
+			log.Warn("Installing an unsigned local plugin archive without signature verification", "url", version.URL)
+
+			return nil
+		}
+
+		return refuseUnsigned(ErrSignatureMissing, allowUnsigned)
+	}
+
+	sigPath, err := downloadFile(version.Signature, baseURL)
+	if err != nil {
+		return refuseUnsigned(fmt.Errorf("failed to download signature: %w", err), allowUnsigned)
+	}
+
+	defer os.Remove(sigPath)
+
+	signature, err := os.ReadFile(sigPath)
+	if err != nil {
+		return err
+	}
+
+	if err := VerifyArchiveSignature(archivePath, signature, TrustedKeys()); err != nil {
+		return refuseUnsigned(err, allowUnsigned)
+	}
+
+	log.Debug("Plugin signature verified", "url", version.URL)
+
+	return nil
+}
+
+// refuseUnsigned turns a signature problem into an install error, or into a
+// warning when the user allowed unsigned plugins.
+func refuseUnsigned(err error, allowUnsigned bool) error {
+	if allowUnsigned {
+		log.Warn("Installing plugin without a valid signature", "reason", err)
+
+		return nil
+	}
+
+	if errors.Is(err, ErrNoTrustedKeys) {
+		return fmt.Errorf("%w: add the publisher's public key to %s in drconfig.yaml, or pass --allow-unsigned", err, TrustedKeysKey)
+	}
+
+	if errors.Is(err, ErrSignatureInvalid) {
+		return fmt.Errorf("%w: add the publisher's public key to %s in drconfig.yaml, or pass --allow-unsigned to install it anyway", err, TrustedKeysKey)
+	}
+
+	return fmt.Errorf("%w; pass --allow-unsigned to install it anyway", err)
+}
+
 func installPluginFromArchive(archivePath, pluginDir string, entry RegistryPlugin, version RegistryVersion) error {
 	if _, err := os.Stat(pluginDir); err == nil {
 		if err := os.RemoveAll(pluginDir); err != nil {
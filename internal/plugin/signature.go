@@ -0,0 +1,172 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/datarobot/cli/internal/config/viperx"
+	"github.com/datarobot/cli/internal/log"
+)
+
+// SignatureExt is appended to an archive's name to name its detached
+// signature: my-plugin-1.0.0.tar.xz is signed by my-plugin-1.0.0.tar.xz.sig.
+const SignatureExt = ".sig"
+
+// TrustedKeysKey is the drconfig.yaml key listing the Ed25519 public keys,
+// base64-encoded, whose signatures registry installs accept.
+const TrustedKeysKey = "plugin.trusted_keys"
+
+// RegistryPublicKey is the public key the official plugin registry's index
+// is signed with when the docs site is deployed. Release builds set it with
+// -ldflags from PLUGIN_REGISTRY_PUBLIC_KEY; development builds leave it empty
+// and trust only TrustedKeysKey.
+var RegistryPublicKey string
+
+var (
+	// ErrSignatureMissing is returned when a registry version has no signature.
+	ErrSignatureMissing = errors.New("plugin archive is not signed")
+
+	// ErrSignatureInvalid is returned when no trusted key verifies a signature.
+	ErrSignatureInvalid = errors.New("plugin archive signature does not match any trusted key")
+
+	// ErrNoTrustedKeys is returned when a signature cannot be checked because
+	// TrustedKeysKey is empty.
+	ErrNoTrustedKeys = errors.New("no trusted plugin signing keys configured")
+)
+
+// GenerateSigningKey returns a new Ed25519 key pair, base64-encoded. The
+// public key goes in TrustedKeysKey; the private key signs archives.
+func GenerateSigningKey() (string, string, error) {
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return "", "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(public), base64.StdEncoding.EncodeToString(private), nil
+}
+
+// ReadSigningKey reads a base64-encoded Ed25519 private key from a file, as
+// written by `dr self plugin keygen`.
+func ReadSigningKey(path string) (ed25519.PrivateKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read signing key: %w", err)
+	}
+
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(data)))
+	if err != nil || len(key) != ed25519.PrivateKeySize {
+		return nil, fmt.Errorf("%s is not an Ed25519 private key", path)
+	}
+
+	return ed25519.PrivateKey(key), nil
+}
+
+// SignArchive writes the detached signature of archivePath beside it and
+// returns the signature's path. It signs a registry index.json the same way.
+func SignArchive(archivePath string, key ed25519.PrivateKey) (string, error) {
+	data, err := os.ReadFile(archivePath)
+	if err != nil {
+		return "", fmt.Errorf("failed to read archive: %w", err)
+	}
+
+	sigPath := archivePath + SignatureExt
+	sig := base64.StdEncoding.EncodeToString(ed25519.Sign(key, data)) + "\n"
+
+	if err := os.WriteFile(sigPath, []byte(sig), 0o644); err != nil {
+		return "", fmt.Errorf("failed to write signature: %w", err)
+	}
+
+	return sigPath, nil
+}
+
+// SignIndex writes the detached signature of a registry index.json beside it
+// after the index changed. Without a key it only warns when the index was
+// signed before, since that signature no longer matches.
+func SignIndex(indexPath string, key ed25519.PrivateKey) error {
+	if key == nil {
+		if _, err := os.Stat(indexPath + SignatureExt); err == nil {
+			log.Warn("The registry index changed and its signature no longer matches. Pass --signing-key to re-sign it.", "index", indexPath)
+		}
+
+		return nil
+	}
+
+	sigPath, err := SignArchive(indexPath, key)
+	if err != nil {
+		return err
+	}
+
+	log.Debug("Signed registry index", "signature", sigPath)
+
+	return nil
+}
+
+// TrustedKeys returns RegistryPublicKey, when the build sets one, followed by
+// the public keys configured under TrustedKeysKey.
+func TrustedKeys() []string {
+	keys := viperx.GetStringSlice(TrustedKeysKey)
+
+	if RegistryPublicKey == "" {
+		return keys
+	}
+
+	return append([]string{RegistryPublicKey}, keys...)
+}
+
+// VerifyArchiveSignature checks a detached signature, base64 as SignArchive
+// writes it, against each trusted public key and succeeds if any verifies.
+// Keys that do not decode are skipped, so one bad entry in the config does
+// not lock out the others.
+func VerifyArchiveSignature(archivePath string, signature []byte, trustedKeys []string) error {
+	data, err := os.ReadFile(archivePath)
+	if err != nil {
+		return err
+	}
+
+	return VerifySignature(data, signature, trustedKeys)
+}
+
+// VerifySignature is VerifyArchiveSignature for data already in memory, such
+// as a downloaded registry index.
+func VerifySignature(data, signature []byte, trustedKeys []string) error {
+	if len(trustedKeys) == 0 {
+		return ErrNoTrustedKeys
+	}
+
+	sig, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(signature)))
+	if err != nil || len(sig) != ed25519.SignatureSize {
+		return fmt.Errorf("%w: malformed signature", ErrSignatureInvalid)
+	}
+
+	for _, encoded := range trustedKeys {
+		key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+		if err != nil || len(key) != ed25519.PublicKeySize {
+			continue
+		}
+
+		if ed25519.Verify(ed25519.PublicKey(key), data, sig) {
+			return nil
+		}
+	}
+
+	return ErrSignatureInvalid
+}
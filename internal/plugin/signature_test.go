@@ -0,0 +1,265 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/datarobot/cli/internal/config/viperx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// signedArchive writes a fake archive, signs it with a new key and returns
+// the archive path, the signature and the public key.
+func signedArchive(t *testing.T) (string, []byte, string) {
+	t.Helper()
+
+	dir := t.TempDir()
+	archive := filepath.Join(dir, "p-1.0.0.tar.xz")
+	require.NoError(t, os.WriteFile(archive, []byte("archive bytes"), 0o644))
+
+	public, private, err := GenerateSigningKey()
+	require.NoError(t, err)
+
+	keyPath := filepath.Join(dir, "signing.key")
+	require.NoError(t, os.WriteFile(keyPath, []byte(private+"\n"), 0o600))
+
+	key, err := ReadSigningKey(keyPath)
+	require.NoError(t, err)
+
+	sigPath, err := SignArchive(archive, key)
+	require.NoError(t, err)
+	assert.Equal(t, archive+SignatureExt, sigPath)
+
+	sig, err := os.ReadFile(sigPath)
+	require.NoError(t, err)
+
+	return archive, sig, public
+}
+
+func TestVerifyArchiveSignature(t *testing.T) {
+	archive, sig, public := signedArchive(t)
+
+	other, _, err := GenerateSigningKey()
+	require.NoError(t, err)
+
+	t.Run("any trusted key verifies", func(t *testing.T) {
+		require.NoError(t, VerifyArchiveSignature(archive, sig, []string{"not base64!", other, public}))
+	})
+
+	t.Run("untrusted key", func(t *testing.T) {
+		require.ErrorIs(t, VerifyArchiveSignature(archive, sig, []string{other}), ErrSignatureInvalid)
+	})
+
+	t.Run("no trusted keys", func(t *testing.T) {
+		require.ErrorIs(t, VerifyArchiveSignature(archive, sig, nil), ErrNoTrustedKeys)
+	})
+
+	t.Run("malformed signature", func(t *testing.T) {
+		require.ErrorIs(t, VerifyArchiveSignature(archive, []byte("abc"), []string{public}), ErrSignatureInvalid)
+	})
+
+	t.Run("tampered archive", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(archive, []byte("other bytes"), 0o644))
+		require.ErrorIs(t, VerifyArchiveSignature(archive, sig, []string{public}), ErrSignatureInvalid)
+	})
+}
+
+func TestReadSigningKey_RejectsOtherData(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "key")
+	require.NoError(t, os.WriteFile(path, []byte(base64.StdEncoding.EncodeToString([]byte("short"))), 0o600))
+
+	_, err := ReadSigningKey(path)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "is not an Ed25519 private key")
+}
+
+func TestDownloadAndVerifyPlugin_Signature(t *testing.T) {
+	archive, sig, public := signedArchive(t)
+
+	data, err := os.ReadFile(archive)
+	require.NoError(t, err)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/p/p-1.0.0.tar.xz":
+			_, _ = w.Write(data)
+		case "/p/p-1.0.0.tar.xz.sig":
+			_, _ = w.Write(sig)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	sum := sha256Hex(t, archive)
+	signed := RegistryVersion{Version: "1.0.0", URL: "p/p-1.0.0.tar.xz", SHA256: sum, Signature: "p/p-1.0.0.tar.xz.sig"}
+	unsigned := RegistryVersion{Version: "1.0.0", URL: "p/p-1.0.0.tar.xz", SHA256: sum}
+
+	download := func(t *testing.T, version RegistryVersion, allowUnsigned bool) error {
+		t.Helper()
+
+		path, err := downloadAndVerifyPlugin(version, server.URL, allowUnsigned)
+		if err == nil {
+			_ = os.Remove(path)
+		}
+
+		return err
+	}
+
+	trust := func(t *testing.T, keys ...string) {
+		t.Helper()
+
+		viperx.Set(TrustedKeysKey, keys)
+		t.Cleanup(func() { viperx.Set(TrustedKeysKey, nil) })
+	}
+
+	t.Run("signed by a trusted key", func(t *testing.T) {
+		trust(t, public)
+		require.NoError(t, download(t, signed, false))
+	})
+
+	t.Run("signed by an untrusted key", func(t *testing.T) {
+		other, _, err := GenerateSigningKey()
+		require.NoError(t, err)
+
+		trust(t, other)
+
+		err = download(t, signed, false)
+		require.ErrorIs(t, err, ErrSignatureInvalid)
+		assert.Contains(t, err.Error(), "--allow-unsigned")
+	})
+
+	t.Run("no trusted keys configured", func(t *testing.T) {
+		err := download(t, signed, false)
+		require.ErrorIs(t, err, ErrNoTrustedKeys)
+		assert.Contains(t, err.Error(), TrustedKeysKey)
+	})
+
+	t.Run("the release registry key is trusted", func(t *testing.T) {
+		prev := RegistryPublicKey
+		RegistryPublicKey = public
+
+		t.Cleanup(func() { RegistryPublicKey = prev })
+
+		require.NoError(t, download(t, signed, false))
+	})
+
+	t.Run("unsigned is allowed from a signed index", func(t *testing.T) {
+		covered := unsigned
+		covered.indexSigned = true
+
+		require.NoError(t, download(t, covered, false))
+
+		covered.SHA256 = ""
+		require.ErrorIs(t, download(t, covered, false), ErrSignatureMissing)
+	})
+
+	t.Run("unsigned local archive is exempt", func(t *testing.T) {
+		local := RegistryVersion{Version: "1.0.0", URL: "file://" + archive}
+		require.NoError(t, download(t, local, false))
+	})
+
+	t.Run("unsigned is refused", func(t *testing.T) {
+		trust(t, public)
+		require.ErrorIs(t, download(t, unsigned, false), ErrSignatureMissing)
+	})
+
+	t.Run("unsigned is allowed with the flag", func(t *testing.T) {
+		trust(t, public)
+		require.NoError(t, download(t, unsigned, true))
+	})
+
+	t.Run("missing signature file", func(t *testing.T) {
+		trust(t, public)
+
+		missing := signed
+		missing.Signature = "p/nope.sig"
+
+		require.Error(t, download(t, missing, false))
+	})
+}
+
+func TestFetchRegistry_MarksSignedIndex(t *testing.T) {
+	public, private, err := GenerateSigningKey()
+	require.NoError(t, err)
+
+	keyPath := filepath.Join(t.TempDir(), "signing.key")
+	require.NoError(t, os.WriteFile(keyPath, []byte(private), 0o600))
+
+	key, err := ReadSigningKey(keyPath)
+	require.NoError(t, err)
+
+	index := []byte(`{"version":"1","plugins":{"p":{"name":"p","versions":[{"version":"1.0.0","url":"p/p-1.0.0.tar.xz","sha256":"abc"}]}}}`)
+	signature := []byte(base64.StdEncoding.EncodeToString(ed25519.Sign(key, index)))
+
+	serve := func(t *testing.T, sig []byte) string {
+		t.Helper()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/index.json":
+				_, _ = w.Write(index)
+			case "/index.json.sig":
+				if sig == nil {
+					http.NotFound(w, r)
+
+					return
+				}
+
+				_, _ = w.Write(sig)
+			}
+		}))
+		t.Cleanup(server.Close)
+
+		return server.URL + "/index.json"
+	}
+
+	fetch := func(t *testing.T, url string) bool {
+		t.Helper()
+
+		registry, _, err := FetchRegistry(url)
+		require.NoError(t, err)
+
+		return registry.Plugins["p"].Versions[0].indexSigned
+	}
+
+	viperx.Set(TrustedKeysKey, []string{public})
+	t.Cleanup(func() { viperx.Set(TrustedKeysKey, nil) })
+
+	assert.True(t, fetch(t, serve(t, signature)), "a trusted signature marks the versions")
+	assert.False(t, fetch(t, serve(t, nil)), "an unsigned index marks nothing")
+	assert.False(t, fetch(t, serve(t, []byte(base64.StdEncoding.EncodeToString(make([]byte, 64))))), "a bad signature marks nothing")
+}
+
+func sha256Hex(t *testing.T, path string) string {
+	t.Helper()
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	sum := sha256.Sum256(data)
+
+	return hex.EncodeToString(sum[:])
+}
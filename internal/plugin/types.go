@@ -42,6 +42,7 @@ type PluginManifest struct {
 	BasicPluginManifest
 	Scripts       *PluginScripts `json:"scripts,omitempty"`       // Platform-specific script paths
 	MinCLIVersion string         `json:"minCLIVersion,omitempty"` // Minimum CLI version required
+	Completion    bool           `json:"completion,omitempty"`    // Answers PluginCompleteCommand for shell completion
 }
 
 // RegistryVersion represents a specific version in the plugin registry.
@@ -49,7 +50,12 @@ type RegistryVersion struct {
 	Version     string `json:"version"`
 	URL         string `json:"url"`
 	SHA256      string `json:"sha256,omitempty"`
+	Signature   string `json:"signature,omitempty"` // URL of the archive's detached signature, resolved like URL
 	ReleaseDate string `json:"releaseDate,omitempty"`
+
+	// indexSigned is set when the index listing this version verified against
+	// a trusted key, which vouches for SHA256 in place of Signature.
+	indexSigned bool
 }
 
 // RegistryPlugin represents a plugin entry in the remote registry.
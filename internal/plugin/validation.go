@@ -106,7 +106,8 @@ var createManifestValidatorOnce = sync.OnceValue(func() *validator.Validate {
 })
 
 // validateManifests validates the script output manifest and checks that the
-// core BasicPluginManifest fields match expected. Scripts and MinCLIVersion
+// core BasicPluginManifest fields and Completion match expected, since the
+// script answers completion requests once installed. Scripts and MinCLIVersion
 // are intentionally ignored — they are optional managed-plugin fields that
 // PATH plugins do not output.
 //
@@ -126,7 +127,7 @@ func validateManifests(expected, actual PluginManifest) error {
 
 	var mismatches []string
 
-	// Fields `Scripts` and `MinCLIVersion` are ignored as they're optional managed plugin fields
+	// Fields `Scripts` and `MinCLIVersion` are ignored as they're optional managed plugin fields.
 	if actual.Name != expected.Name {
 		mismatches = append(mismatches, fmt.Sprintf("Name: expected %q, got %q", expected.Name, actual.Name))
 	}
@@ -143,6 +144,10 @@ func validateManifests(expected, actual PluginManifest) error {
 		mismatches = append(mismatches, fmt.Sprintf("Authentication: expected %v, got %v", expected.Authentication, actual.Authentication))
 	}
 
+	if actual.Completion != expected.Completion {
+		mismatches = append(mismatches, fmt.Sprintf("Completion: expected %v, got %v", expected.Completion, actual.Completion))
+	}
+
 	if len(mismatches) > 0 {
 		return fmt.Errorf("plugin script output does not match manifest.json:\n  %s", strings.Join(mismatches, "\n  "))
 	}
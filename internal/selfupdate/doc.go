@@ -0,0 +1,20 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package selfupdate replaces the running dr binary with a published
+// release. It reads the release index from GitHub, or from a mirror on
+// networks that cannot reach it, downloads the bare dr-<os>-<arch> binary,
+// verifies it against the release's checksums file, and swaps it in
+// atomically. The binary it replaces is kept next to it for Rollback.
+package selfupdate
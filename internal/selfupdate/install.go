@@ -0,0 +1,60 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package selfupdate
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/datarobot/cli/internal/fsutil"
+)
+
+// ErrNoPrevious is returned by Rollback when no update has kept a previous
+// binary.
+var ErrNoPrevious = errors.New("no previous version to roll back to")
+
+// PreviousPath is where Install keeps the binary it replaces.
+func PreviousPath(executable string) string {
+	return executable + ".previous"
+}
+
+// Install replaces executable with data, keeping the binary it replaces at
+// PreviousPath. The new binary gets the old one's mode.
+func Install(executable string, data []byte) error {
+	info, err := os.Stat(executable)
+	if err != nil {
+		return fmt.Errorf("stat %s: %w", executable, err)
+	}
+
+	return replaceExecutable(executable, PreviousPath(executable), data, info.Mode().Perm())
+}
+
+// Rollback swaps executable with the binary Install kept, so a second
+// Rollback undoes the first.
+func Rollback(executable string) error {
+	previous := PreviousPath(executable)
+
+	if !fsutil.FileExists(previous) {
+		return ErrNoPrevious
+	}
+
+	info, err := os.Stat(executable)
+	if err != nil {
+		return fmt.Errorf("stat %s: %w", executable, err)
+	}
+
+	return swapExecutables(executable, previous, info.Mode().Perm())
+}
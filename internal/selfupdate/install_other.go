@@ -0,0 +1,59 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !windows
+
+package selfupdate
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/datarobot/cli/internal/fsutil"
+)
+
+// replaceExecutable copies the current binary to previous and writes the new
+// one over it. A running binary can be replaced on POSIX: the process keeps
+// the inode it started from, and the path never goes missing.
+func replaceExecutable(executable, previous string, data []byte, mode os.FileMode) error {
+	current, err := os.ReadFile(executable)
+	if err != nil {
+		return fmt.Errorf("read %s: %w", executable, err)
+	}
+
+	if err := fsutil.AtomicWriteFileMode(previous, current, mode); err != nil {
+		return fmt.Errorf("keep previous version: %w", err)
+	}
+
+	return fsutil.AtomicWriteFileMode(executable, data, mode)
+}
+
+// swapExecutables exchanges the contents of executable and previous.
+func swapExecutables(executable, previous string, mode os.FileMode) error {
+	current, err := os.ReadFile(executable)
+	if err != nil {
+		return fmt.Errorf("read %s: %w", executable, err)
+	}
+
+	kept, err := os.ReadFile(previous)
+	if err != nil {
+		return fmt.Errorf("read %s: %w", previous, err)
+	}
+
+	if err := fsutil.AtomicWriteFileMode(executable, kept, mode); err != nil {
+		return err
+	}
+
+	return fsutil.AtomicWriteFileMode(previous, current, mode)
+}
@@ -0,0 +1,63 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !windows
+
+package selfupdate
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func readString(t *testing.T, path string) string {
+	t.Helper()
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	return string(data)
+}
+
+func TestInstallAndRollback(t *testing.T) {
+	exe := filepath.Join(t.TempDir(), "dr")
+	require.NoError(t, os.WriteFile(exe, []byte("old"), 0o755))
+
+	require.ErrorIs(t, Rollback(exe), ErrNoPrevious)
+
+	require.NoError(t, Install(exe, []byte("new")))
+	assert.Equal(t, "new", readString(t, exe))
+	assert.Equal(t, "old", readString(t, PreviousPath(exe)))
+
+	for _, path := range []string{exe, PreviousPath(exe)} {
+		info, err := os.Stat(path)
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(0o755), info.Mode().Perm(), path)
+	}
+
+	require.NoError(t, Rollback(exe))
+	assert.Equal(t, "old", readString(t, exe))
+	assert.Equal(t, "new", readString(t, PreviousPath(exe)))
+
+	require.NoError(t, Rollback(exe), "a second rollback undoes the first")
+	assert.Equal(t, "new", readString(t, exe))
+
+	entries, err := os.ReadDir(filepath.Dir(exe))
+	require.NoError(t, err)
+	assert.Len(t, entries, 2, "no temp files are left behind")
+}
@@ -0,0 +1,68 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build windows
+
+package selfupdate
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/datarobot/cli/internal/fsutil"
+)
+
+// replaceExecutable moves the running binary aside to previous and writes
+// the new one in its place. Windows refuses to overwrite a running binary
+// but lets it be renamed.
+func replaceExecutable(executable, previous string, data []byte, mode os.FileMode) error {
+	if err := os.Remove(previous); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("remove old previous version: %w", err)
+	}
+
+	if err := os.Rename(executable, previous); err != nil {
+		return fmt.Errorf("keep previous version: %w", err)
+	}
+
+	if err := fsutil.AtomicWriteFileMode(executable, data, mode); err != nil {
+		if restoreErr := os.Rename(previous, executable); restoreErr != nil {
+			return errors.Join(err, fmt.Errorf("restore %s: %w", executable, restoreErr))
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// swapExecutables exchanges executable and previous by renaming, since the
+// running binary cannot be overwritten.
+func swapExecutables(executable, previous string, _ os.FileMode) error {
+	parked := executable + ".rollback"
+
+	if err := os.Rename(executable, parked); err != nil {
+		return fmt.Errorf("move %s aside: %w", executable, err)
+	}
+
+	if err := os.Rename(previous, executable); err != nil {
+		if restoreErr := os.Rename(parked, executable); restoreErr != nil {
+			return errors.Join(err, fmt.Errorf("restore %s: %w", executable, restoreErr))
+		}
+
+		return fmt.Errorf("restore previous version: %w", err)
+	}
+
+	return os.Rename(parked, previous)
+}
@@ -0,0 +1,271 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package selfupdate
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+)
+
+const (
+	// DefaultIndexURL lists the CLI's releases in the GitHub releases API
+	// format.
+	DefaultIndexURL = "https://api.github.com/repos/datarobot-oss/cli/releases?per_page=100"
+
+	// MirrorIndexName is the release index a mirror serves at its root, in
+	// the same format as DefaultIndexURL. Each release's assets are served
+	// under <mirror>/<tag>/.
+	MirrorIndexName = "releases.json"
+
+	// ChannelStable offers only full releases; ChannelPrerelease offers
+	// release candidates too.
+	ChannelStable     = "stable"
+	ChannelPrerelease = "prerelease"
+
+	maxIndexBytes  = 16 << 20
+	maxBinaryBytes = 512 << 20
+)
+
+// ErrNoRelease is returned when no published release matches the request.
+var ErrNoRelease = errors.New("no matching release")
+
+// Release is one entry of the release index.
+type Release struct {
+	Tag        string  `json:"tag_name"`
+	Draft      bool    `json:"draft"`
+	Prerelease bool    `json:"prerelease"`
+	Assets     []Asset `json:"assets"`
+}
+
+// Asset is one file published with a release.
+type Asset struct {
+	Name string `json:"name"`
+	URL  string `json:"browser_download_url"`
+}
+
+// Source is where releases are read from.
+type Source struct {
+	Client *http.Client
+
+	// Mirror is the base URL of a copy of the releases: MirrorIndexName at
+	// its root and each release's assets under <tag>/. Empty means GitHub.
+	Mirror string
+
+	// IndexURL overrides DefaultIndexURL when there is no mirror.
+	IndexURL string
+
+	// Token, if set, authenticates index requests to GitHub, which raises
+	// its API rate limit. It is never sent to a mirror.
+	Token string
+
+	UserAgent string
+}
+
+// Releases fetches the release index.
+func (s *Source) Releases(ctx context.Context) ([]Release, error) {
+	indexURL := s.IndexURL
+	if indexURL == "" {
+		indexURL = DefaultIndexURL
+	}
+
+	token := s.Token
+
+	if s.Mirror != "" {
+		indexURL = strings.TrimRight(s.Mirror, "/") + "/" + MirrorIndexName
+		token = ""
+	}
+
+	data, err := s.fetch(ctx, indexURL, token, maxIndexBytes)
+	if err != nil {
+		return nil, fmt.Errorf("fetch release index: %w", err)
+	}
+
+	var releases []Release
+	if err := json.Unmarshal(data, &releases); err != nil {
+		return nil, fmt.Errorf("parse release index %s: %w", indexURL, err)
+	}
+
+	return releases, nil
+}
+
+// Find returns the release to install: the one tagged version when it is
+// set, otherwise the newest release on channel. Drafts are never offered.
+func (s *Source) Find(ctx context.Context, version, channel string) (Release, error) {
+	if channel != ChannelStable && channel != ChannelPrerelease {
+		return Release{}, fmt.Errorf("unknown channel %q: use %s or %s", channel, ChannelStable, ChannelPrerelease)
+	}
+
+	releases, err := s.Releases(ctx)
+	if err != nil {
+		return Release{}, err
+	}
+
+	if version != "" {
+		tag := Tag(version)
+
+		for _, r := range releases {
+			if r.Tag == tag && !r.Draft {
+				return r, nil
+			}
+		}
+
+		return Release{}, fmt.Errorf("%w: %s is not published", ErrNoRelease, tag)
+	}
+
+	var (
+		newest    Release
+		newestVer *semver.Version
+	)
+
+	for _, r := range releases {
+		if r.Draft || (r.Prerelease && channel == ChannelStable) {
+			continue
+		}
+
+		v, err := semver.NewVersion(r.Tag)
+		if err != nil {
+			continue
+		}
+
+		if newestVer == nil || v.GreaterThan(newestVer) {
+			newest, newestVer = r, v
+		}
+	}
+
+	if newestVer == nil {
+		return Release{}, fmt.Errorf("%w on the %s channel", ErrNoRelease, channel)
+	}
+
+	return newest, nil
+}
+
+// Download fetches the release's binary for goos and goarch and verifies it
+// against the release's checksums. A release without checksums is refused.
+func (s *Source) Download(ctx context.Context, r Release, goos, goarch string) ([]byte, error) {
+	name := AssetName(goos, goarch)
+
+	binary, ok := r.asset(func(a Asset) bool { return a.Name == name })
+	if !ok {
+		return nil, fmt.Errorf("release %s has no %s binary", r.Tag, name)
+	}
+
+	sums, ok := r.asset(func(a Asset) bool { return strings.HasSuffix(a.Name, "checksums.txt") })
+	if !ok {
+		return nil, fmt.Errorf("release %s publishes no checksums; refusing to install an unverified binary", r.Tag)
+	}
+
+	sumsData, err := s.fetch(ctx, s.assetURL(r, sums), "", maxIndexBytes)
+	if err != nil {
+		return nil, fmt.Errorf("download %s: %w", sums.Name, err)
+	}
+
+	data, err := s.fetch(ctx, s.assetURL(r, binary), "", maxBinaryBytes)
+	if err != nil {
+		return nil, fmt.Errorf("download %s: %w", name, err)
+	}
+
+	if err := verifyChecksum(data, name, parseChecksums(sumsData)); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// Tag returns version as a release tag: "1.2.3" and "v1.2.3" are both
+// "v1.2.3".
+func Tag(version string) string {
+	version = strings.TrimSpace(version)
+	if version == "" || strings.HasPrefix(version, "v") {
+		return version
+	}
+
+	return "v" + version
+}
+
+// AssetName is the name a release publishes the bare binary for goos and
+// goarch under.
+func AssetName(goos, goarch string) string {
+	name := "dr-" + goos + "-" + goarch
+	if goos == "windows" {
+		name += ".exe"
+	}
+
+	return name
+}
+
+func (r Release) asset(match func(Asset) bool) (Asset, bool) {
+	for _, a := range r.Assets {
+		if match(a) {
+			return a, true
+		}
+	}
+
+	return Asset{}, false
+}
+
+// assetURL is where an asset is downloaded from: its published URL, or
+// its place under the mirror.
+func (s *Source) assetURL(r Release, a Asset) string {
+	if s.Mirror == "" {
+		return a.URL
+	}
+
+	return strings.TrimRight(s.Mirror, "/") + "/" + url.PathEscape(r.Tag) + "/" + url.PathEscape(a.Name)
+}
+
+func (s *Source) fetch(ctx context.Context, rawURL, token string, limit int64) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.UserAgent != "" {
+		req.Header.Set("User-Agent", s.UserAgent)
+	}
+
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := s.Client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %s: HTTP %d", rawURL, resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, limit+1))
+	if err != nil {
+		return nil, fmt.Errorf("GET %s: %w", rawURL, err)
+	}
+
+	if int64(len(data)) > limit {
+		return nil, fmt.Errorf("GET %s: response exceeds %d bytes", rawURL, limit)
+	}
+
+	return data, nil
+}
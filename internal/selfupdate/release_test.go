@@ -0,0 +1,184 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package selfupdate
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// releaseServer stands in for GitHub: it serves a release index and each
+// release's assets, and records the Authorization header of every request.
+type releaseServer struct {
+	*httptest.Server
+
+	releases []Release
+	files    map[string]string // path -> body
+	auth     map[string]string // path -> Authorization header
+}
+
+func newReleaseServer(t *testing.T, binary string) *releaseServer {
+	t.Helper()
+
+	rs := &releaseServer{files: map[string]string{}, auth: map[string]string{}}
+
+	rs.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rs.auth[r.URL.Path] = r.Header.Get("Authorization")
+
+		if r.URL.Path == "/releases" || r.URL.Path == "/mirror/"+MirrorIndexName {
+			_ = json.NewEncoder(w).Encode(rs.releases)
+
+			return
+		}
+
+		body, ok := rs.files[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+
+			return
+		}
+
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(rs.Close)
+
+	rs.publish("v1.2.0", false, binary)
+	rs.publish("v1.3.0", false, binary)
+	rs.publish("v1.4.0-rc.1", true, binary)
+
+	return rs
+}
+
+// publish adds a release with a linux/amd64 binary and its checksums, at
+// both the GitHub download path and the mirror path.
+func (rs *releaseServer) publish(tag string, prerelease bool, binary string) {
+	name := AssetName("linux", "amd64")
+	sumsName := "dr_" + tag + "_checksums.txt"
+	sum := sha256.Sum256([]byte(binary + tag))
+	sums := fmt.Sprintf("%s  %s\n%s  dr_%s_Linux_x86_64.tar.gz\n", hex.EncodeToString(sum[:]), name, "00", tag)
+
+	for _, base := range []string{"/download/" + tag, "/mirror/" + tag} {
+		rs.files[base+"/"+name] = binary + tag
+		rs.files[base+"/"+sumsName] = sums
+	}
+
+	rs.releases = append(rs.releases, Release{
+		Tag:        tag,
+		Prerelease: prerelease,
+		Assets: []Asset{
+			{Name: name, URL: rs.URL + "/download/" + tag + "/" + name},
+			{Name: sumsName, URL: rs.URL + "/download/" + tag + "/" + sumsName},
+		},
+	})
+}
+
+func (rs *releaseServer) source() *Source {
+	return &Source{Client: rs.Client(), IndexURL: rs.URL + "/releases", Token: "gh-token"}
+}
+
+func TestSource_Find(t *testing.T) {
+	rs := newReleaseServer(t, "dr")
+	rs.releases = append(rs.releases, Release{Tag: "v9.0.0", Draft: true})
+
+	for _, tc := range []struct {
+		name, version, channel, want string
+	}{
+		{"newest stable", "", ChannelStable, "v1.3.0"},
+		{"newest prerelease", "", ChannelPrerelease, "v1.4.0-rc.1"},
+		{"pinned", "1.2.0", ChannelStable, "v1.2.0"},
+		{"pinned with v", "v1.4.0-rc.1", ChannelStable, "v1.4.0-rc.1"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			r, err := rs.source().Find(context.Background(), tc.version, tc.channel)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, r.Tag)
+		})
+	}
+
+	_, err := rs.source().Find(context.Background(), "9.0.0", ChannelStable)
+	require.ErrorIs(t, err, ErrNoRelease, "drafts are never offered")
+
+	_, err = rs.source().Find(context.Background(), "", "nightly")
+	require.ErrorContains(t, err, `unknown channel "nightly"`)
+
+	assert.Equal(t, "Bearer gh-token", rs.auth["/releases"])
+}
+
+func TestSource_DownloadVerifiesChecksum(t *testing.T) {
+	rs := newReleaseServer(t, "dr")
+
+	r, err := rs.source().Find(context.Background(), "1.3.0", ChannelStable)
+	require.NoError(t, err)
+
+	data, err := rs.source().Download(context.Background(), r, "linux", "amd64")
+	require.NoError(t, err)
+	assert.Equal(t, "drv1.3.0", string(data))
+	assert.Empty(t, rs.auth["/download/v1.3.0/dr-linux-amd64"], "the GitHub token is only for the index")
+
+	rs.files["/download/v1.3.0/dr-linux-amd64"] = "tampered"
+
+	_, err = rs.source().Download(context.Background(), r, "linux", "amd64")
+	require.ErrorContains(t, err, "checksum mismatch for dr-linux-amd64")
+
+	_, err = rs.source().Download(context.Background(), r, "darwin", "arm64")
+	require.ErrorContains(t, err, "release v1.3.0 has no dr-darwin-arm64 binary")
+}
+
+func TestSource_DownloadRefusesUnverified(t *testing.T) {
+	rs := newReleaseServer(t, "dr")
+
+	r := rs.releases[1]
+	r.Assets = r.Assets[:1]
+
+	_, err := rs.source().Download(context.Background(), r, "linux", "amd64")
+	require.ErrorContains(t, err, "publishes no checksums")
+
+	rs.files["/download/v1.3.0/dr_v1.3.0_checksums.txt"] = "abc  dr-windows-amd64.exe\n"
+
+	_, err = rs.source().Download(context.Background(), rs.releases[1], "linux", "amd64")
+	require.ErrorContains(t, err, "do not list dr-linux-amd64")
+}
+
+func TestSource_Mirror(t *testing.T) {
+	rs := newReleaseServer(t, "dr")
+	delete(rs.files, "/download/v1.3.0/dr-linux-amd64")
+
+	src := rs.source()
+	src.Mirror = rs.URL + "/mirror/"
+
+	r, err := src.Find(context.Background(), "", ChannelStable)
+	require.NoError(t, err)
+
+	data, err := src.Download(context.Background(), r, "linux", "amd64")
+	require.NoError(t, err)
+	assert.Equal(t, "drv1.3.0", string(data))
+	assert.Empty(t, rs.auth["/mirror/"+MirrorIndexName], "the GitHub token is never sent to a mirror")
+}
+
+func TestAssetNameAndTag(t *testing.T) {
+	assert.Equal(t, "dr-linux-arm64", AssetName("linux", "arm64"))
+	assert.Equal(t, "dr-windows-amd64.exe", AssetName("windows", "amd64"))
+	assert.Equal(t, "v1.2.3", Tag("1.2.3"))
+	assert.Equal(t, "v1.2.3", Tag(" v1.2.3 "))
+}
@@ -83,8 +83,10 @@ type accountInfoResult struct {
 
 // GetAccountInfo fetches the DataRobot account info from GET /api/v2/account/info/.
 // It returns the full AccountInfo on success, or (*AccountInfo, error) on non-200 status,
-// empty uid, or network failure.
-func GetAccountInfo(_ context.Context) (*AccountInfo, error) {
+// empty uid, or network failure. The request is sent once, without drapi's
+// transient-failure retries: this runs before every command, and telemetry
+// must not stall the CLI while an unreachable server is retried.
+func GetAccountInfo(ctx context.Context) (*AccountInfo, error) {
 	url, err := config.GetEndpointURL("/api/v2/account/info/")
 	if err != nil {
 		return nil, err
@@ -92,7 +94,7 @@ func GetAccountInfo(_ context.Context) (*AccountInfo, error) {
 
 	var info AccountInfo
 
-	if err := drapi.GetJSON(url, "", &info); err != nil { //nolint:contextcheck // GetJSON does not yet accept context; ctx is reserved for future use
+	if err := drapi.GetJSON(drapi.WithoutRetries(ctx), url, "", &info); err != nil {
 		return nil, err
 	}
 
@@ -16,9 +16,11 @@ package workload
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/url"
 	"strconv"
 	"strings"
 	"time"
@@ -262,7 +264,7 @@ func PrimaryContainerName(artifact Artifact) string {
 	return container.Name
 }
 
-func GetArtifact(artifactID string) (*Artifact, error) {
+func GetArtifact(ctx context.Context, artifactID string) (*Artifact, error) {
 	url, err := config.GetEndpointURL("/api/v2/artifacts/" + escapeID(artifactID) + "/")
 	if err != nil {
 		return nil, err
@@ -270,7 +272,7 @@ func GetArtifact(artifactID string) (*Artifact, error) {
 
 	var artifact Artifact
 
-	err = drapi.GetJSON(url, "artifact", &artifact)
+	err = drapi.GetJSON(ctx, url, "artifact", &artifact)
 	if err != nil {
 		return nil, err
 	}
@@ -342,7 +344,7 @@ func ValidateCreateRequest(data []byte) error {
 // CreateArtifact POSTs payload to /api/v2/artifacts/ and returns the parsed artifact.
 // payload is typically a json.RawMessage from the spec file, sent verbatim after
 // ValidateCreateRequest passed.
-func CreateArtifact(payload any) (*Artifact, error) {
+func CreateArtifact(ctx context.Context, payload any) (*Artifact, error) {
 	url, err := config.GetEndpointURL("/api/v2/artifacts/")
 	if err != nil {
 		return nil, err
@@ -350,7 +352,7 @@ func CreateArtifact(payload any) (*Artifact, error) {
 
 	var artifact Artifact
 
-	err = drapi.PostJSON(url, "artifact", payload, &artifact)
+	err = drapi.PostJSON(ctx, url, "artifact", payload, &artifact)
 	if err != nil {
 		return nil, err
 	}
@@ -358,7 +360,7 @@ func CreateArtifact(payload any) (*Artifact, error) {
 	return &artifact, nil
 }
 
-func PatchArtifactCodeRef(artifactID, catalogID, catalogVersionID string) error {
+func PatchArtifactCodeRef(ctx context.Context, artifactID, catalogID, catalogVersionID string) error {
 	url, err := config.GetEndpointURL("/api/v2/artifacts/" + escapeID(artifactID) + "/")
 	if err != nil {
 		return err
@@ -366,7 +368,7 @@ func PatchArtifactCodeRef(artifactID, catalogID, catalogVersionID string) error
 
 	var raw map[string]any
 
-	if err := drapi.GetJSON(url, "artifact", &raw); err != nil {
+	if err := drapi.GetJSON(ctx, url, "artifact", &raw); err != nil {
 		return fmt.Errorf("fetch artifact for codeRef update: %w", err)
 	}
 
@@ -376,7 +378,7 @@ func PatchArtifactCodeRef(artifactID, catalogID, catalogVersionID string) error
 
 	body := map[string]any{"spec": raw["spec"]}
 
-	return drapi.PatchJSON(url, "artifact", body, nil)
+	return drapi.PatchJSON(ctx, url, "artifact", body, nil)
 }
 
 func setPrimaryCodeRefInRawArtifact(raw map[string]any, catalogID, catalogVersionID string) error {
@@ -483,7 +485,7 @@ func isPrimaryContainer(container map[string]any) bool {
 // one-way. The server replies 403 when the artifact is already locked, 404 when
 // it does not exist, and 422 when a source-built container is incomplete (missing
 // codeRef or unbuilt imageUri); the error detail names the missing piece.
-func LockArtifact(artifactID string) (*Artifact, error) {
+func LockArtifact(ctx context.Context, artifactID string) (*Artifact, error) {
 	url, err := config.GetEndpointURL("/api/v2/artifacts/" + escapeID(artifactID) + "/")
 	if err != nil {
 		return nil, err
@@ -493,7 +495,7 @@ func LockArtifact(artifactID string) (*Artifact, error) {
 
 	var artifact Artifact
 
-	err = drapi.PatchJSON(url, "artifact", body, &artifact)
+	err = drapi.PatchJSON(ctx, url, "artifact", body, &artifact)
 	if err != nil {
 		return nil, err
 	}
@@ -505,16 +507,16 @@ func LockArtifact(artifactID string) (*Artifact, error) {
 // artifact is locked (locking is one-way; locked artifacts can never be
 // deleted) or still referenced by a workload's proton(s); the 409 detail
 // names the blocking workload IDs.
-func DeleteArtifact(artifactID string) error {
+func DeleteArtifact(ctx context.Context, artifactID string) error {
 	url, err := config.GetEndpointURL("/api/v2/artifacts/" + escapeID(artifactID) + "/")
 	if err != nil {
 		return err
 	}
 
-	return drapi.DeleteJSON(url, "artifact", nil, nil)
+	return drapi.DeleteJSON(ctx, url, "artifact", nil, nil)
 }
 
-func ListArtifacts(limit int, status Status) ([]Artifact, error) {
+func ListArtifacts(ctx context.Context, limit int, status Status) ([]Artifact, error) {
 	endpoint := "/api/v2/artifacts/?limit=" + strconv.Itoa(limit)
 
 	if status != "" {
@@ -531,7 +533,7 @@ func ListArtifacts(limit int, status Status) ([]Artifact, error) {
 	for pageURL != "" {
 		var list ArtifactList
 
-		if err := drapi.GetJSON(pageURL, "artifacts", &list); err != nil {
+		if err := drapi.GetJSON(ctx, pageURL, "artifacts", &list); err != nil {
 			return nil, err
 		}
 
@@ -554,3 +556,56 @@ func ListArtifacts(limit int, status Status) ([]Artifact, error) {
 
 	return all, nil
 }
+
+// ListRepositoryArtifacts returns up to limit artifacts in one repository: the
+// versions of one thing, drafts included.
+//
+// The repository is asked for as a filter and then checked again on each
+// artifact. A server that ignores the filter still answers correctly, only
+// by paging through every artifact the user can see, and that is better than
+// a history made of whatever the first page happened to hold.
+func ListRepositoryArtifacts(ctx context.Context, repositoryID string, limit int) ([]Artifact, error) {
+	if limit <= 0 {
+		return nil, fmt.Errorf("invalid limit %d: must be positive", limit)
+	}
+
+	endpoint := "/api/v2/artifacts/?limit=" + strconv.Itoa(limit) +
+		"&artifactRepositoryId=" + url.QueryEscape(repositoryID)
+
+	pageURL, err := config.GetEndpointURL(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	var matched []Artifact
+
+	for pageURL != "" {
+		var list ArtifactList
+
+		if err := drapi.GetJSON(ctx, pageURL, "artifacts", &list); err != nil {
+			return nil, err
+		}
+
+		for _, artifact := range list.Data {
+			if artifact.ArtifactRepositoryID == repositoryID {
+				matched = append(matched, artifact)
+			}
+		}
+
+		if len(matched) >= limit {
+			return matched[:limit], nil
+		}
+
+		if list.Next == "" {
+			break
+		}
+
+		if err := drapi.AssertNextOnSameHost(list.Next); err != nil {
+			return nil, err
+		}
+
+		pageURL = list.Next
+	}
+
+	return matched, nil
+}
@@ -15,6 +15,7 @@
 package workload
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -660,7 +661,7 @@ func TestDeleteArtifact_Success(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	require.NoError(t, DeleteArtifact("art-1"))
+	require.NoError(t, DeleteArtifact(context.Background(), "art-1"))
 }
 
 func TestDeleteArtifact_EscapesIDInPath(t *testing.T) {
@@ -677,7 +678,7 @@ func TestDeleteArtifact_EscapesIDInPath(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	require.NoError(t, DeleteArtifact("art-1?force=true"))
+	require.NoError(t, DeleteArtifact(context.Background(), "art-1?force=true"))
 }
 
 func TestDeleteArtifact_409PropagatesAsHTTPError(t *testing.T) {
@@ -692,7 +693,7 @@ func TestDeleteArtifact_409PropagatesAsHTTPError(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	err := DeleteArtifact("art-1")
+	err := DeleteArtifact(context.Background(), "art-1")
 	require.Error(t, err)
 
 	var httpErr *drapi.HTTPError
@@ -722,7 +723,7 @@ func TestLockArtifact_SendsStatusLocked(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	artifact, err := LockArtifact("art-1")
+	artifact, err := LockArtifact(context.Background(), "art-1")
 	require.NoError(t, err)
 	assert.Equal(t, "art-1", artifact.ID)
 	assert.Equal(t, "locked", artifact.Status)
@@ -743,7 +744,7 @@ func TestLockArtifact_EscapesIDInPath(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	_, err := LockArtifact("art-1?force=true")
+	_, err := LockArtifact(context.Background(), "art-1?force=true")
 	require.NoError(t, err)
 }
 
@@ -759,7 +760,7 @@ func TestLockArtifact_422PropagatesDetail(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	_, err := LockArtifact("art-1")
+	_, err := LockArtifact(context.Background(), "art-1")
 	require.Error(t, err)
 
 	var httpErr *drapi.HTTPError
@@ -781,7 +782,7 @@ func TestLockArtifact_403AlreadyLockedPropagatesAsHTTPError(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	_, err := LockArtifact("art-1")
+	_, err := LockArtifact(context.Background(), "art-1")
 	require.Error(t, err)
 
 	var httpErr *drapi.HTTPError
@@ -949,3 +950,30 @@ func TestPrimaryHelpers_AgreeAcrossGroups(t *testing.T) {
 	assert.Equal(t, "real", PrimaryContainerName(art))
 	assert.Equal(t, "real:1", GetPrimaryContainerImageURI(art))
 }
+
+func TestListRepositoryArtifacts_FiltersOnTheRepository(t *testing.T) {
+	serveAPI(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v2/artifacts/", r.URL.Path)
+		assert.Equal(t, "repo-1", r.URL.Query().Get("artifactRepositoryId"))
+
+		// A server that ignores the filter answers with everything; only
+		// the repository's own versions may come back from the call.
+		fmt.Fprint(w, `{"data":[
+			{"id":"art-1","artifactRepositoryId":"repo-1"},
+			{"id":"art-2","artifactRepositoryId":"repo-2"},
+			{"id":"art-3","artifactRepositoryId":"repo-1"}
+		]}`)
+	}))
+
+	artifacts, err := ListRepositoryArtifacts(context.Background(), "repo-1", 10)
+	require.NoError(t, err)
+
+	require.Len(t, artifacts, 2)
+	assert.Equal(t, "art-1", artifacts[0].ID)
+	assert.Equal(t, "art-3", artifacts[1].ID)
+}
+
+func TestListRepositoryArtifacts_RejectsNonPositiveLimit(t *testing.T) {
+	_, err := ListRepositoryArtifacts(context.Background(), "repo-1", 0)
+	require.Error(t, err)
+}
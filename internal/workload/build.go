@@ -16,6 +16,7 @@ package workload
 
 import (
 	"bufio"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -164,7 +165,7 @@ func IsBuildErrorStatus(s string) bool {
 // returns the trigger response. The defensive empty-slice check is in the
 // caller so the service layer remains a thin pass-through of the server
 // shape.
-func TriggerArtifactBuild(artifactID string) (*BuildTriggerResponse, error) {
+func TriggerArtifactBuild(ctx context.Context, artifactID string) (*BuildTriggerResponse, error) {
 	url, err := config.GetEndpointURL("/api/v2/artifacts/" + escapeID(artifactID) + "/builds/")
 	if err != nil {
 		return nil, err
@@ -172,7 +173,7 @@ func TriggerArtifactBuild(artifactID string) (*BuildTriggerResponse, error) {
 
 	var resp BuildTriggerResponse
 
-	if err := drapi.PostJSON(url, "build", map[string]any{}, &resp); err != nil {
+	if err := drapi.PostJSON(ctx, url, "build", map[string]any{}, &resp); err != nil {
 		return nil, err
 	}
 
@@ -180,7 +181,7 @@ func TriggerArtifactBuild(artifactID string) (*BuildTriggerResponse, error) {
 }
 
 // GetArtifactBuild fetches a single Build by id.
-func GetArtifactBuild(artifactID, buildID string) (*Build, error) {
+func GetArtifactBuild(ctx context.Context, artifactID, buildID string) (*Build, error) {
 	url, err := config.GetEndpointURL("/api/v2/artifacts/" + escapeID(artifactID) + "/builds/" + escapeID(buildID))
 	if err != nil {
 		return nil, err
@@ -188,7 +189,7 @@ func GetArtifactBuild(artifactID, buildID string) (*Build, error) {
 
 	var build Build
 
-	if err := drapi.GetJSON(url, "build", &build); err != nil {
+	if err := drapi.GetJSON(ctx, url, "build", &build); err != nil {
 		return nil, err
 	}
 
@@ -197,7 +198,7 @@ func GetArtifactBuild(artifactID, buildID string) (*Build, error) {
 
 // ListArtifactBuilds returns up to limit Builds for the artifact, walking
 // pagination the same way ListArtifacts does.
-func ListArtifactBuilds(artifactID string, limit int) ([]Build, error) {
+func ListArtifactBuilds(ctx context.Context, artifactID string, limit int) ([]Build, error) {
 	if limit <= 0 {
 		return nil, fmt.Errorf("invalid limit %d: must be positive", limit)
 	}
@@ -214,7 +215,7 @@ func ListArtifactBuilds(artifactID string, limit int) ([]Build, error) {
 	for pageURL != "" {
 		var list BuildList
 
-		if err := drapi.GetJSON(pageURL, "builds", &list); err != nil {
+		if err := drapi.GetJSON(ctx, pageURL, "builds", &list); err != nil {
 			return nil, err
 		}
 
@@ -242,13 +243,13 @@ func ListArtifactBuilds(artifactID string, limit int) ([]Build, error) {
 // emits newline-delimited JSON; we tolerate malformed lines so a single bad
 // record cannot blank the whole tail. The original bytes for each line are
 // preserved in Raw so JSON output can pass them through unchanged.
-func GetArtifactBuildLogs(artifactID, buildID string) ([]BuildLogEntry, error) {
+func GetArtifactBuildLogs(ctx context.Context, artifactID, buildID string) ([]BuildLogEntry, error) {
 	url, err := config.GetEndpointURL("/api/v2/artifacts/" + escapeID(artifactID) + "/builds/" + escapeID(buildID) + "/logs")
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := drapi.Get(url, "build logs")
+	resp, err := drapi.Get(ctx, url, "build logs")
 	if err != nil {
 		return nil, err
 	}
@@ -292,12 +293,13 @@ func parseBuildLogs(r io.Reader) ([]BuildLogEntry, error) {
 }
 
 // WaitForBuild polls GetArtifactBuild on interval until IsTerminalBuildStatus
-// returns true or deadline expires. On terminal error status it returns the
+// returns true, deadline expires, or ctx is cancelled. On terminal error status it returns the
 // final Build alongside an error so callers get both pieces. onTick may be
 // nil and is invoked after each poll for debug-only observation (Bubble Tea
 // cannot redraw the spinner label from inside fn(), so this is a passive
 // seam in PR 1).
 func WaitForBuild(
+	ctx context.Context,
 	artifactID, buildID string,
 	interval, timeout time.Duration,
 	onTick func(*Build),
@@ -305,7 +307,7 @@ func WaitForBuild(
 	deadline := time.Now().Add(timeout)
 
 	for {
-		build, err := GetArtifactBuild(artifactID, buildID)
+		build, err := GetArtifactBuild(ctx, artifactID, buildID)
 		if err != nil {
 			return nil, fmt.Errorf("poll build %s: %w", buildID, err)
 		}
@@ -326,7 +328,9 @@ func WaitForBuild(
 			return build, fmt.Errorf("timeout waiting for build %s after %s", buildID, timeout)
 		}
 
-		time.Sleep(interval)
+		if !sleepInterval(ctx, interval) {
+			return build, ctx.Err()
+		}
 	}
 }
 
@@ -339,7 +343,7 @@ func WaitForBuild(
 // stale imageUri from a prior successful build cannot leak into the
 // current build's summary. On error status we additionally pull the log
 // tail so the user has something to act on.
-func BuildSummaryFor(build *Build, tailLen int) (BuildSummary, error) {
+func BuildSummaryFor(ctx context.Context, build *Build, tailLen int) (BuildSummary, error) {
 	if build == nil {
 		return BuildSummary{}, errors.New("nil build")
 	}
@@ -352,7 +356,7 @@ func BuildSummaryFor(build *Build, tailLen int) (BuildSummary, error) {
 
 	if build.Status != BuildStatusCompleted {
 		if IsBuildErrorStatus(build.Status) {
-			logs, lerr := GetArtifactBuildLogs(build.ArtifactID, build.ID)
+			logs, lerr := GetArtifactBuildLogs(ctx, build.ArtifactID, build.ID)
 			if lerr != nil {
 				// Surface the fetch error via debug logging rather than
 				// failing the whole summary -- the user still benefits
@@ -369,7 +373,7 @@ func BuildSummaryFor(build *Build, tailLen int) (BuildSummary, error) {
 		return summary, nil
 	}
 
-	artifact, err := GetArtifact(build.ArtifactID)
+	artifact, err := GetArtifact(ctx, build.ArtifactID)
 	if err != nil {
 		// Surface the partial summary so callers can still render
 		// duration and status even when the artifact fetch fails.
@@ -15,6 +15,7 @@
 package workload
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -211,7 +212,7 @@ func TestTriggerArtifactBuild_PostsEmptyBodyAndDecodes(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	resp, err := TriggerArtifactBuild("art-1")
+	resp, err := TriggerArtifactBuild(context.Background(), "art-1")
 	require.NoError(t, err)
 	require.NotNil(t, resp)
 	assert.Equal(t, []string{"b-1", "b-2"}, resp.BuildIDs)
@@ -234,7 +235,7 @@ func TestTriggerArtifactBuild_PropagatesValidationError(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	_, err := TriggerArtifactBuild("art-1")
+	_, err := TriggerArtifactBuild(context.Background(), "art-1")
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "Artifact not found", "validation body must reach caller (0ae2527 regression)")
 }
@@ -259,7 +260,7 @@ func TestGetArtifactBuild_Decodes(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	build, err := GetArtifactBuild("art-1", "b-1")
+	build, err := GetArtifactBuild(context.Background(), "art-1", "b-1")
 	require.NoError(t, err)
 	assert.Equal(t, "b-1", build.ID)
 	assert.Equal(t, BuildStatusCompleted, build.Status)
@@ -277,7 +278,7 @@ func TestGetArtifactBuild_NotFound(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	_, err := GetArtifactBuild("art-1", "b-missing")
+	_, err := GetArtifactBuild(context.Background(), "art-1", "b-missing")
 	require.Error(t, err)
 
 	var httpErr *drapi.HTTPError
@@ -332,7 +333,7 @@ func TestListArtifactBuilds_PaginatesAndCaps(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	builds, err := ListArtifactBuilds("art-1", 5)
+	builds, err := ListArtifactBuilds(context.Background(), "art-1", 5)
 	require.NoError(t, err)
 	require.Len(t, builds, 5)
 	assert.Equal(t, "b-5", builds[4].ID)
@@ -356,13 +357,13 @@ func TestListArtifactBuilds_RespectsLimitCap(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	builds, err := ListArtifactBuilds("art-1", 2)
+	builds, err := ListArtifactBuilds(context.Background(), "art-1", 2)
 	require.NoError(t, err)
 	assert.Len(t, builds, 2)
 }
 
 func TestListArtifactBuilds_InvalidLimit(t *testing.T) {
-	_, err := ListArtifactBuilds("art-1", 0)
+	_, err := ListArtifactBuilds(context.Background(), "art-1", 0)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "limit")
 }
@@ -385,7 +386,7 @@ func TestGetArtifactBuildLogs_ParsesJSONL(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	entries, err := GetArtifactBuildLogs("art-1", "b-1")
+	entries, err := GetArtifactBuildLogs(context.Background(), "art-1", "b-1")
 	require.NoError(t, err)
 	require.Len(t, entries, 2)
 	assert.Equal(t, "INFO", entries[0].Levelname)
@@ -418,7 +419,7 @@ func TestWaitForBuild_TerminalCompletedReturnsNil(t *testing.T) {
 
 	var ticks int
 
-	build, err := WaitForBuild("art-1", "b-1", time.Millisecond, time.Second, func(*Build) {
+	build, err := WaitForBuild(context.Background(), "art-1", "b-1", time.Millisecond, time.Second, func(*Build) {
 		ticks++
 	})
 	require.NoError(t, err)
@@ -441,7 +442,7 @@ func TestWaitForBuild_FailedReturnsError(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	build, err := WaitForBuild("art-1", "b-1", time.Millisecond, time.Second, nil)
+	build, err := WaitForBuild(context.Background(), "art-1", "b-1", time.Millisecond, time.Second, nil)
 	require.Error(t, err)
 	require.NotNil(t, build, "FAILED returns final Build alongside error")
 	assert.Equal(t, BuildStatusFailed, build.Status)
@@ -463,7 +464,7 @@ func TestWaitForBuild_Timeout(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	_, err := WaitForBuild("art-1", "b-1", 5*time.Millisecond, 25*time.Millisecond, nil)
+	_, err := WaitForBuild(context.Background(), "art-1", "b-1", 5*time.Millisecond, 25*time.Millisecond, nil)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "timeout")
 }
@@ -499,7 +500,7 @@ func TestBuildSummaryFor_SuccessSkipsLogs(t *testing.T) {
 		UpdatedAt:  time.Date(2026, 6, 9, 10, 0, 12, 0, time.UTC),
 	}
 
-	summary, err := BuildSummaryFor(build, DefaultBuildLogTail)
+	summary, err := BuildSummaryFor(context.Background(), build, DefaultBuildLogTail)
 	require.NoError(t, err)
 	assert.Equal(t, "b-1", summary.BuildID)
 	assert.Equal(t, BuildStatusCompleted, summary.Status)
@@ -542,7 +543,7 @@ func TestBuildSummaryFor_FailureFetchesLogs(t *testing.T) {
 		UpdatedAt:  time.Date(2026, 6, 9, 10, 0, 9, 0, time.UTC),
 	}
 
-	summary, err := BuildSummaryFor(build, 1)
+	summary, err := BuildSummaryFor(context.Background(), build, 1)
 	require.NoError(t, err)
 	assert.Equal(t, int64(9), summary.DurationSeconds)
 	assert.Empty(t, summary.ImageURI)
@@ -551,7 +552,7 @@ func TestBuildSummaryFor_FailureFetchesLogs(t *testing.T) {
 }
 
 func TestBuildSummaryFor_NilBuild(t *testing.T) {
-	_, err := BuildSummaryFor(nil, DefaultBuildLogTail)
+	_, err := BuildSummaryFor(context.Background(), nil, DefaultBuildLogTail)
 	require.Error(t, err)
 }
 
@@ -579,7 +580,7 @@ func TestBuildSummaryFor_TimeoutLeavesImageEmpty(t *testing.T) {
 		UpdatedAt:  time.Date(2026, 6, 9, 10, 10, 0, 0, time.UTC),
 	}
 
-	summary, err := BuildSummaryFor(build, DefaultBuildLogTail)
+	summary, err := BuildSummaryFor(context.Background(), build, DefaultBuildLogTail)
 	require.NoError(t, err)
 	assert.Equal(t, BuildStatusInProgress, summary.Status)
 	assert.Equal(t, int64(600), summary.DurationSeconds)
@@ -594,6 +595,7 @@ func TestBuildSummaryFor_TimeoutLeavesImageEmpty(t *testing.T) {
 // LogTail is left nil so the caller can render the partial info.
 func TestBuildSummaryFor_FailureLogFetch502(t *testing.T) {
 	installSkipAuth(t)
+	disableRetries(t)
 
 	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if strings.HasSuffix(r.URL.Path, "/logs") {
@@ -621,7 +623,7 @@ func TestBuildSummaryFor_FailureLogFetch502(t *testing.T) {
 		UpdatedAt:  time.Date(2026, 6, 9, 10, 24, 8, 0, time.UTC),
 	}
 
-	summary, err := BuildSummaryFor(build, DefaultBuildLogTail)
+	summary, err := BuildSummaryFor(context.Background(), build, DefaultBuildLogTail)
 	require.NoError(t, err, "log-fetch 502 must not fail the summary")
 	assert.Equal(t, "b-1", summary.BuildID)
 	assert.Equal(t, BuildStatusCancelled, summary.Status)
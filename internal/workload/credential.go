@@ -15,6 +15,8 @@
 package workload
 
 import (
+	"context"
+	"fmt"
 	"net/url"
 	"strconv"
 
@@ -51,7 +53,7 @@ type CredentialList struct {
 // runs out would turn every refusal into a long walk. Reaching the bound
 // answers nil, the same as a name that is genuinely not there, because to the
 // caller the two mean the same thing: no id to offer.
-func FindCredentialNamed(name string, limit int) (*Credential, error) {
+func FindCredentialNamed(ctx context.Context, name string, limit int) (*Credential, error) {
 	query := url.Values{}
 	query.Set("limit", strconv.Itoa(limit))
 
@@ -63,7 +65,7 @@ func FindCredentialNamed(name string, limit int) (*Credential, error) {
 	for scanned := 0; pageURL != "" && scanned < limit; {
 		var list CredentialList
 
-		if err := drapi.GetJSON(pageURL, "credentials", &list); err != nil {
+		if err := drapi.GetJSON(ctx, pageURL, "credentials", &list); err != nil {
 			return nil, err
 		}
 
@@ -94,6 +96,46 @@ func FindCredentialNamed(name string, limit int) (*Credential, error) {
 	return nil, nil
 }
 
+// ListCredentials returns up to limit of the user's credentials, in the order
+// the server lists them, for pickers that let the user choose one by name.
+func ListCredentials(ctx context.Context, limit int) ([]Credential, error) {
+	if limit <= 0 {
+		return nil, fmt.Errorf("limit must be positive, got %d", limit)
+	}
+
+	query := url.Values{}
+	query.Set("limit", strconv.Itoa(min(limit, 100)))
+
+	pageURL, err := drapi.EndpointURL("/credentials/", query)
+	if err != nil {
+		return nil, err
+	}
+
+	credentials := make([]Credential, 0)
+
+	for pageURL != "" && len(credentials) < limit {
+		var list CredentialList
+
+		if err := drapi.GetJSON(ctx, pageURL, "credentials", &list); err != nil {
+			return nil, err
+		}
+
+		credentials = append(credentials, list.Data[:min(len(list.Data), limit-len(credentials))]...)
+
+		if list.Next == "" || len(list.Data) == 0 {
+			break
+		}
+
+		if err := drapi.AssertNextOnSameHost(list.Next); err != nil {
+			return nil, err
+		}
+
+		pageURL = list.Next
+	}
+
+	return credentials, nil
+}
+
 // CreateCredential stores a secret and returns the credential holding it, so a
 // manifest can reference it by id instead of carrying the value.
 //
@@ -112,7 +154,7 @@ func FindCredentialNamed(name string, limit int) (*Credential, error) {
 // Callers decide what that means, because reusing a credential of the same
 // name would silently deploy whatever value it already holds, which may not be
 // the one the user just supplied.
-func CreateCredential(name, value string) (*Credential, error) {
+func CreateCredential(ctx context.Context, name, value string) (*Credential, error) {
 	url, err := config.GetEndpointURL("/api/v2/credentials/")
 	if err != nil {
 		return nil, err
@@ -126,7 +168,7 @@ func CreateCredential(name, value string) (*Credential, error) {
 
 	var cred Credential
 
-	if err := drapi.PostJSON(url, "credential", body, &cred); err != nil {
+	if err := drapi.PostJSON(ctx, url, "credential", body, &cred); err != nil {
 		return nil, err
 	}
 
@@ -140,7 +182,7 @@ func CreateCredential(name, value string) (*Credential, error) {
 // written into an artifact spec. Without the check a mistyped id survives
 // every local validation and only surfaces when the container fails to
 // start, long after the build has been paid for.
-func GetCredential(credentialID string) (*Credential, error) {
+func GetCredential(ctx context.Context, credentialID string) (*Credential, error) {
 	url, err := config.GetEndpointURL("/api/v2/credentials/" + escapeID(credentialID) + "/")
 	if err != nil {
 		return nil, err
@@ -148,7 +190,7 @@ func GetCredential(credentialID string) (*Credential, error) {
 
 	var cred Credential
 
-	if err := drapi.GetJSON(url, "credential", &cred); err != nil {
+	if err := drapi.GetJSON(ctx, url, "credential", &cred); err != nil {
 		return nil, err
 	}
 
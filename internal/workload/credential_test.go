@@ -15,6 +15,7 @@
 package workload
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -36,7 +37,7 @@ func TestGetCredential_Decodes(t *testing.T) {
 		fmt.Fprint(w, `{"credentialId":"66f1a2b3c4d5e6f7a8b9c0d1","name":"my-app/OPENAI_API_KEY","credentialType":"api_token"}`)
 	}))
 
-	cred, err := GetCredential("66f1a2b3c4d5e6f7a8b9c0d1")
+	cred, err := GetCredential(context.Background(), "66f1a2b3c4d5e6f7a8b9c0d1")
 	require.NoError(t, err)
 	require.NotNil(t, cred)
 	assert.Equal(t, "66f1a2b3c4d5e6f7a8b9c0d1", cred.CredentialID)
@@ -52,7 +53,7 @@ func TestGetCredential_MissingIsAnError(t *testing.T) {
 		w.WriteHeader(http.StatusNotFound)
 	}))
 
-	_, err := GetCredential("does-not-exist")
+	_, err := GetCredential(context.Background(), "does-not-exist")
 	require.Error(t, err)
 
 	var httpErr *drapi.HTTPError
@@ -69,7 +70,7 @@ func TestGetCredential_EscapesID(t *testing.T) {
 		fmt.Fprint(w, `{"credentialId":"x"}`)
 	}))
 
-	_, err := GetCredential("a/b")
+	_, err := GetCredential(context.Background(), "a/b")
 	require.NoError(t, err)
 }
 
@@ -89,7 +90,7 @@ func TestCreateCredential_PostsTheValueAndReturnsTheID(t *testing.T) {
 		fmt.Fprint(w, `{"credentialId":"66f1a2b3c4d5e6f7a8b9c0d1","name":"my-app/OPENAI_API_KEY"}`)
 	}))
 
-	cred, err := CreateCredential("my-app/OPENAI_API_KEY", "sk-live-abc123")
+	cred, err := CreateCredential(context.Background(), "my-app/OPENAI_API_KEY", "sk-live-abc123")
 	require.NoError(t, err)
 	assert.Equal(t, "66f1a2b3c4d5e6f7a8b9c0d1", cred.CredentialID)
 }
@@ -103,7 +104,7 @@ func TestCreateCredential_ConflictSurvivesAsAnHTTPError(t *testing.T) {
 		fmt.Fprint(w, `{"detail":"credential name already exists"}`)
 	}))
 
-	_, err := CreateCredential("my-app/OPENAI_API_KEY", "sk-live-abc123")
+	_, err := CreateCredential(context.Background(), "my-app/OPENAI_API_KEY", "sk-live-abc123")
 	require.Error(t, err)
 
 	var httpErr *drapi.HTTPError
@@ -131,7 +132,7 @@ func TestCreateCredential_TheFieldItSendsIsOneTheDebugLogRedacts(t *testing.T) {
 		fmt.Fprint(w, `{"credentialId":"c1","name":"my-app/OPENAI_API_KEY"}`)
 	}))
 
-	_, err := CreateCredential("my-app/OPENAI_API_KEY", "sk-live-abc123")
+	_, err := CreateCredential(context.Background(), "my-app/OPENAI_API_KEY", "sk-live-abc123")
 	require.NoError(t, err)
 	require.NoError(t, readEr)
 	require.Contains(t, string(sent), "sk-live-abc123", "the platform still gets the real value")
@@ -165,7 +166,7 @@ func TestFindCredentialNamed_StopsAtTheScanLimit(t *testing.T) {
 	base, err := drapi.EndpointURL("/credentials/", url.Values{})
 	require.NoError(t, err)
 
-	found, err := FindCredentialNamed("wanted", 3)
+	found, err := FindCredentialNamed(context.Background(), "wanted", 3)
 	require.NoError(t, err)
 	assert.Nil(t, found, "reaching the bound reads the same as not being there: no id to offer")
 	assert.Equal(t, 3, pages, "one row per page, so the bound is three pages")
@@ -179,7 +180,7 @@ func TestFindCredentialNamed_RefusesANextOnAnotherHost(t *testing.T) {
 			`{"data":[{"credentialId":"c1","name":"other"}],"next":"https://elsewhere.example.com/api/v2/credentials/"}`)
 	}))
 
-	_, err := FindCredentialNamed("wanted", 200)
+	_, err := FindCredentialNamed(context.Background(), "wanted", 200)
 	require.Error(t, err)
 }
 
@@ -189,7 +190,30 @@ func TestFindCredentialNamed_AnswersNilWhenThereIsNone(t *testing.T) {
 		fmt.Fprint(w, `{"data":[],"next":""}`)
 	}))
 
-	found, err := FindCredentialNamed("wanted", 200)
+	found, err := FindCredentialNamed(context.Background(), "wanted", 200)
 	require.NoError(t, err)
 	assert.Nil(t, found)
 }
+
+func TestListCredentials_StopsAtTheLimit(t *testing.T) {
+	var (
+		pages int
+		base  string
+	)
+
+	serveAPI(t, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		pages++
+
+		fmt.Fprintf(w, `{"data":[{"credentialId":"c%d-a","name":"a"},{"credentialId":"c%d-b","name":"b"}],"next":%q}`,
+			pages, pages, base+"?page="+strconv.Itoa(pages+1))
+	}))
+
+	base, err := drapi.EndpointURL("/credentials/", url.Values{})
+	require.NoError(t, err)
+
+	credentials, err := ListCredentials(context.Background(), 3)
+	require.NoError(t, err)
+	require.Len(t, credentials, 3)
+	assert.Equal(t, "c2-a", credentials[2].CredentialID)
+	assert.Equal(t, 2, pages)
+}
@@ -15,6 +15,8 @@
 package workload
 
 import (
+	"context"
+
 	"github.com/datarobot/cli/internal/config"
 	"github.com/datarobot/cli/internal/drapi"
 )
@@ -32,7 +34,7 @@ import (
 type Document map[string]any
 
 // GetWorkloadDocument fetches a workload as the server has it.
-func GetWorkloadDocument(workloadID string) (Document, error) {
+func GetWorkloadDocument(ctx context.Context, workloadID string) (Document, error) {
 	url, err := config.GetEndpointURL("/api/v2/workloads/" + escapeID(workloadID) + "/")
 	if err != nil {
 		return nil, err
@@ -40,7 +42,7 @@ func GetWorkloadDocument(workloadID string) (Document, error) {
 
 	var doc Document
 
-	if err := drapi.GetJSON(url, "workload", &doc); err != nil {
+	if err := drapi.GetJSON(ctx, url, "workload", &doc); err != nil {
 		return nil, err
 	}
 
@@ -48,7 +50,7 @@ func GetWorkloadDocument(workloadID string) (Document, error) {
 }
 
 // GetArtifactDocument fetches an artifact as the server has it.
-func GetArtifactDocument(artifactID string) (Document, error) {
+func GetArtifactDocument(ctx context.Context, artifactID string) (Document, error) {
 	url, err := config.GetEndpointURL("/api/v2/artifacts/" + escapeID(artifactID) + "/")
 	if err != nil {
 		return nil, err
@@ -56,7 +58,7 @@ func GetArtifactDocument(artifactID string) (Document, error) {
 
 	var doc Document
 
-	if err := drapi.GetJSON(url, "artifact", &doc); err != nil {
+	if err := drapi.GetJSON(ctx, url, "artifact", &doc); err != nil {
 		return nil, err
 	}
 
@@ -15,6 +15,7 @@
 package workload
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -58,8 +59,8 @@ const maxExecEnvPages = 100
 // error rather than whichever copy the server happened to list first. Picking
 // silently would build against the wrong base image and only show up as a
 // puzzling runtime failure.
-func ResolveExecutionEnvironment(nameOrID string) (id, versionID string, err error) {
-	byID, byName, err := scanExecutionEnvironments(nameOrID)
+func ResolveExecutionEnvironment(ctx context.Context, nameOrID string) (id, versionID string, err error) {
+	byID, byName, err := scanExecutionEnvironments(ctx, nameOrID)
 	if err != nil {
 		return "", "", err
 	}
@@ -86,7 +87,7 @@ func ResolveExecutionEnvironment(nameOrID string) (id, versionID string, err err
 // version to build from, for the setup wizard's base-image picker. Ones with
 // no successful version are dropped rather than offered: picking one would
 // fail at build time with nothing the user could do about it.
-func ListExecutionEnvironments(limit int) ([]ExecutionEnvironment, error) {
+func ListExecutionEnvironments(ctx context.Context, limit int) ([]ExecutionEnvironment, error) {
 	if limit <= 0 {
 		return nil, fmt.Errorf("limit must be positive, got %d", limit)
 	}
@@ -107,7 +108,7 @@ func ListExecutionEnvironments(limit int) ([]ExecutionEnvironment, error) {
 
 		var list executionEnvironmentList
 
-		if err := drapi.GetJSON(pageURL, "execution environments", &list); err != nil {
+		if err := drapi.GetJSON(ctx, pageURL, "execution environments", &list); err != nil {
 			return nil, err
 		}
 
@@ -160,7 +161,7 @@ func nextPage(next string) (string, error) {
 // id match if one turned up and every environment carrying the name. It stops
 // early on an id hit; a name has to be checked against the whole list before it
 // can be called unambiguous.
-func scanExecutionEnvironments(nameOrID string) (byID *ExecutionEnvironment, byName []ExecutionEnvironment, err error) {
+func scanExecutionEnvironments(ctx context.Context, nameOrID string) (byID *ExecutionEnvironment, byName []ExecutionEnvironment, err error) {
 	pageURL, err := config.GetEndpointURL("/api/v2/executionEnvironments/?limit=100")
 	if err != nil {
 		return nil, nil, err
@@ -173,7 +174,7 @@ func scanExecutionEnvironments(nameOrID string) (byID *ExecutionEnvironment, byN
 
 		var list executionEnvironmentList
 
-		if err := drapi.GetJSON(pageURL, "execution environments", &list); err != nil {
+		if err := drapi.GetJSON(ctx, pageURL, "execution environments", &list); err != nil {
 			return nil, nil, err
 		}
 
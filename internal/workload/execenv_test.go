@@ -15,6 +15,7 @@
 package workload
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -50,7 +51,7 @@ func TestResolveExecutionEnvironment_MatchesByName(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	id, versionID, err := ResolveExecutionEnvironment("Python 3.12")
+	id, versionID, err := ResolveExecutionEnvironment(context.Background(), "Python 3.12")
 	require.NoError(t, err)
 	assert.Equal(t, "ee-2", id)
 	assert.Equal(t, "ver-2", versionID)
@@ -67,7 +68,7 @@ func TestResolveExecutionEnvironment_MatchesByID(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	id, versionID, err := ResolveExecutionEnvironment("ee-1")
+	id, versionID, err := ResolveExecutionEnvironment(context.Background(), "ee-1")
 	require.NoError(t, err)
 	assert.Equal(t, "ee-1", id)
 	assert.Equal(t, "ver-1", versionID)
@@ -97,7 +98,7 @@ func TestResolveExecutionEnvironment_FollowsNextPage(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	id, versionID, err := ResolveExecutionEnvironment("Wanted")
+	id, versionID, err := ResolveExecutionEnvironment(context.Background(), "Wanted")
 	require.NoError(t, err)
 	assert.Equal(t, "ee-9", id)
 	assert.Equal(t, "ver-9", versionID)
@@ -117,7 +118,7 @@ func TestResolveExecutionEnvironment_NoSuccessfulVersion(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	_, _, err := ResolveExecutionEnvironment("Broken")
+	_, _, err := ResolveExecutionEnvironment(context.Background(), "Broken")
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "no successful version")
 }
@@ -133,7 +134,7 @@ func TestResolveExecutionEnvironment_NotFound(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	_, _, err := ResolveExecutionEnvironment("nope")
+	_, _, err := ResolveExecutionEnvironment(context.Background(), "nope")
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), `"nope" not found`)
 }
@@ -164,7 +165,7 @@ func TestResolveExecutionEnvironment_AmbiguousName(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	_, _, err := ResolveExecutionEnvironment("Python 3.11")
+	_, _, err := ResolveExecutionEnvironment(context.Background(), "Python 3.11")
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "ambiguous")
 	assert.Contains(t, err.Error(), "ee-1")
@@ -187,7 +188,7 @@ func TestResolveExecutionEnvironment_IDWinsOverDuplicateNames(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	id, versionID, err := ResolveExecutionEnvironment("ee-2")
+	id, versionID, err := ResolveExecutionEnvironment(context.Background(), "ee-2")
 	require.NoError(t, err)
 	assert.Equal(t, "ee-2", id)
 	assert.Equal(t, "ver-2", versionID)
@@ -217,7 +218,7 @@ func TestResolveExecutionEnvironment_StopsAtPageCap(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	_, _, err := ResolveExecutionEnvironment("Wanted")
+	_, _, err := ResolveExecutionEnvironment(context.Background(), "Wanted")
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "pages")
 	assert.Equal(t, maxExecEnvPages, pages, "the walk stops at the cap rather than running forever")
@@ -238,7 +239,7 @@ func TestResolveExecutionEnvironment_RejectsCrossHostNext(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	_, _, err := ResolveExecutionEnvironment("Wanted")
+	_, _, err := ResolveExecutionEnvironment(context.Background(), "Wanted")
 	require.Error(t, err)
 	assert.NotContains(t, err.Error(), "not found", "a cross-host next must fail loudly, not fall through to not-found")
 }
@@ -261,7 +262,7 @@ func TestListExecutionEnvironments_SkipsVersionlessEnvironments(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	environments, err := ListExecutionEnvironments(50)
+	environments, err := ListExecutionEnvironments(context.Background(), 50)
 	require.NoError(t, err)
 
 	require.Len(t, environments, 2)
@@ -293,7 +294,7 @@ func TestListExecutionEnvironments_StopsAtTheLimit(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	environments, err := ListExecutionEnvironments(3)
+	environments, err := ListExecutionEnvironments(context.Background(), 3)
 	require.NoError(t, err)
 
 	assert.Len(t, environments, 3)
@@ -311,6 +312,6 @@ func TestListExecutionEnvironments_ServerError(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	_, err := ListExecutionEnvironments(50)
+	_, err := ListExecutionEnvironments(context.Background(), 50)
 	require.Error(t, err)
 }
@@ -0,0 +1,37 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileops
+
+// StatKey is what the filesystem says about a file without reading it. Two
+// equal keys for the same path mean the content has not been touched, which
+// is what lets a hash computed earlier be reused.
+//
+// Size and modification time alone miss a rewrite that restores the old
+// mtime, as some tools do; the inode changes when an editor saves by
+// renaming a new file into place, and the change time moves on any write or
+// chmod and cannot be set back from user space. Where a platform has no
+// inode or change time they stay zero and the key degrades to size and mtime.
+type StatKey struct {
+	Size    int64  `json:"size"`
+	MtimeNs int64  `json:"mtime"`
+	Inode   uint64 `json:"ino,omitempty"`
+	CtimeNs int64  `json:"ctime,omitempty"`
+}
+
+// Stat returns the StatKey of the file at path, following symlinks the same
+// way HashFile does.
+func Stat(path string) (StatKey, error) {
+	return stat(path)
+}
@@ -0,0 +1,58 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileops
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStat(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "a.txt")
+	require.NoError(t, os.WriteFile(path, []byte("hello"), 0o644))
+
+	mtime := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	require.NoError(t, os.Chtimes(path, mtime, mtime))
+
+	key, err := Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), key.Size)
+	assert.Equal(t, mtime.UnixNano(), key.MtimeNs)
+
+	t.Run("RestoredMtimeStillChangesTheKey", func(t *testing.T) {
+		if runtime.GOOS == "windows" {
+			t.Skip("no change time on Windows")
+		}
+
+		require.NoError(t, os.WriteFile(path, []byte("HELLO"), 0o644))
+		require.NoError(t, os.Chtimes(path, mtime, mtime))
+
+		again, err := Stat(path)
+		require.NoError(t, err)
+		assert.Equal(t, key.MtimeNs, again.MtimeNs)
+		assert.NotEqual(t, key, again)
+	})
+
+	t.Run("Missing", func(t *testing.T) {
+		_, err := Stat(filepath.Join(t.TempDir(), "nope"))
+		require.ErrorIs(t, err, os.ErrNotExist)
+	})
+}
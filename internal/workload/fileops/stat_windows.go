@@ -0,0 +1,33 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build windows
+
+package fileops
+
+import (
+	"fmt"
+	"os"
+)
+
+// stat has no inode or change time to offer on Windows: os.Stat reports
+// neither, and the file index would cost an open per file.
+func stat(path string) (StatKey, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return StatKey{}, fmt.Errorf("stat %s: %w", path, err)
+	}
+
+	return StatKey{Size: info.Size(), MtimeNs: info.ModTime().UnixNano()}, nil
+}
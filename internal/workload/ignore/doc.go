@@ -14,6 +14,8 @@
 
 // Package ignore decides which files the sync engine excludes. The
 // effective set is the union of hardcoded system excludes (the state
-// directory, .git)
-// and patterns from <project-root>/.wapiignore in gitignore syntax.
+// directory, .git) and the .wapiignore files of the project, in gitignore
+// syntax: one at the root and any number in subdirectories, each applying to
+// the tree below it. A root .wapiignore can opt in to the project's
+// .gitignore files as well.
 package ignore
@@ -20,11 +20,19 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
-
-	gitignore "github.com/sabhiram/go-gitignore"
+	"sync"
 )
 
-const wapiignoreFile = ".wapiignore"
+const (
+	wapiignoreFile = ".wapiignore"
+	gitignoreFile  = ".gitignore"
+
+	// IncludeGitignoreDirective, on a line of its own in the root
+	// .wapiignore, makes every .gitignore in the project count as well. It is
+	// a comment to anything else that reads the file, the same spelling
+	// .gcloudignore uses for the same purpose.
+	IncludeGitignoreDirective = "#!include:.gitignore"
+)
 
 // systemExcludes are always-ignored paths, not overridable by .wapiignore.
 //
@@ -43,68 +51,274 @@ const wapiignoreFile = ".wapiignore"
 // Entries must be lowercase: matchesSystemExclude folds the path it is given.
 var systemExcludes = []string{".datarobot/workload", ".wapi", ".git", ".gitignore", ".datarobot.yaml"}
 
+// Decision is the answer to whether a path is excluded, and which rule gave
+// it. A path no rule matched has neither Source nor Pattern.
+type Decision struct {
+	Ignored bool `json:"ignored"`
+	// System is set when a hardcoded exclude decided; Pattern names it.
+	System bool `json:"system,omitempty"`
+	// Source is the project-relative ignore file holding the deciding
+	// pattern, and Line its 1-based line there. Empty for FromLines rules.
+	Source  string `json:"source,omitempty"`
+	Line    int    `json:"line,omitempty"`
+	Pattern string `json:"pattern,omitempty"`
+}
+
+// Matched reports whether any rule decided, including a negation that kept
+// the path in.
+func (d Decision) Matched() bool {
+	return d.Pattern != ""
+}
+
 // Matcher decides whether a path is excluded from sync. Match is safe for
 // concurrent use after New.
+//
+// Ignore files below the root are read the first time a path under their
+// directory is asked about, so a walk that prunes a directory never reads the
+// files inside it. A file that cannot be read there is recorded rather than
+// skipped, because skipping it would upload what it excludes; Err reports it.
 type Matcher struct {
-	user *gitignore.GitIgnore // nil when the user has no .wapiignore
+	root      string // "" for FromLines: nothing is read from disk
+	gitignore bool
+
+	mu    sync.Mutex
+	rules map[string][]rule   // by slash-separated directory, "" for the root
+	dirs  map[string]Decision // memoized decisions for directories
+	err   error
 }
 
-// New loads .wapiignore from projectDir if present. A missing file is
-// fine — only the hardcoded system excludes apply.
+// New loads the root .wapiignore from projectDir if present. A missing file
+// is fine — only the hardcoded system excludes apply, along with any
+// .wapiignore further down.
 func New(projectDir string) (*Matcher, error) {
-	path := filepath.Join(projectDir, wapiignoreFile)
+	m := &Matcher{
+		root:  projectDir,
+		rules: map[string][]rule{},
+		dirs:  map[string]Decision{},
+	}
 
-	gi, err := gitignore.CompileIgnoreFile(path)
-	if err != nil {
-		if errors.Is(err, os.ErrNotExist) {
-			return &Matcher{}, nil
+	data, err := os.ReadFile(filepath.Join(projectDir, wapiignoreFile))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("read %s: %w", filepath.Join(projectDir, wapiignoreFile), err)
+	}
+
+	lines := splitLines(string(data))
+
+	for _, line := range lines {
+		if strings.TrimSpace(line) == IncludeGitignoreDirective {
+			m.gitignore = true
 		}
+	}
 
-		return nil, fmt.Errorf("read %s: %w", path, err)
+	var root []rule
+
+	if m.gitignore {
+		if root, err = readRules(projectDir, gitignoreFile); err != nil {
+			return nil, err
+		}
 	}
 
-	return &Matcher{user: gi}, nil
+	m.rules[""] = append(root, parseRules(wapiignoreFile, lines)...)
+
+	return m, nil
 }
 
-// FromLines builds a Matcher from in-memory pattern lines. Empty/nil
-// means "system excludes only".
+// FromLines builds a Matcher from in-memory pattern lines, as if they were
+// the root .wapiignore. Empty/nil means "system excludes only".
 func FromLines(lines []string) *Matcher {
-	if len(lines) == 0 {
-		return &Matcher{}
+	return &Matcher{
+		rules: map[string][]rule{"": parseRules("", lines)},
+		dirs:  map[string]Decision{},
 	}
+}
 
-	return &Matcher{user: gitignore.CompileIgnoreLines(lines...)}
+// IncludesGitignore reports whether the root .wapiignore opted in to the
+// project's .gitignore files.
+func (m *Matcher) IncludesGitignore() bool {
+	return m.gitignore
+}
+
+// Err returns the first ignore file below the root that could not be read.
+// Callers that act on every path the matcher let through should check it
+// once they are done asking.
+func (m *Matcher) Err() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.err
 }
 
 // Match reports whether relPath should be excluded. isDir lets
 // directory-only patterns ("build/") prune subtrees.
 func (m *Matcher) Match(relPath string, isDir bool) bool {
+	return m.Explain(relPath, isDir).Ignored
+}
+
+// Explain is Match with its reasons: the rule that decided, or none.
+//
+// The rules are those of gitignore. Every ignore file from the root down to
+// the path's own directory applies, each to paths relative to where it lives,
+// and the last pattern to match wins, so a deeper file overrides a shallower
+// one and a "!" pattern re-includes what an earlier one excluded. A path
+// inside an excluded directory is excluded with it whatever comes later:
+// git never looks inside such a directory, so nothing in it can be brought
+// back, and a walk pruned at the directory agrees with a question asked
+// about a single file inside it.
+func (m *Matcher) Explain(relPath string, isDir bool) Decision {
 	if relPath == "" {
-		return false
+		return Decision{}
 	}
 
-	if matchesSystemExclude(relPath) {
-		return true
+	if name, ok := matchesSystemExclude(relPath); ok {
+		return Decision{Ignored: true, System: true, Pattern: name}
 	}
 
-	if m.user == nil {
-		return false
+	for i := range len(relPath) {
+		if relPath[i] != '/' {
+			continue
+		}
+
+		if d := m.dirDecision(relPath[:i]); d.Ignored {
+			return d
+		}
 	}
 
-	if m.user.MatchesPath(relPath) {
-		return true
+	return m.decide(relPath, isDir)
+}
+
+// dirDecision is decide for a directory, memoized: a walk asks about the
+// same ancestors for every file beneath them.
+func (m *Matcher) dirDecision(dir string) Decision {
+	m.mu.Lock()
+	d, ok := m.dirs[dir]
+	m.mu.Unlock()
+
+	if ok {
+		return d
 	}
 
-	// Directory-only patterns need a trailing slash to match in go-gitignore.
-	if isDir {
-		return m.user.MatchesPath(relPath + "/")
+	d = m.decide(dir, true)
+
+	m.mu.Lock()
+	m.dirs[dir] = d
+	m.mu.Unlock()
+
+	return d
+}
+
+// decide applies the rules of every directory from the root to relPath's
+// parent, shallowest first, and returns the last match.
+func (m *Matcher) decide(relPath string, isDir bool) Decision {
+	var (
+		last  *rule
+		found bool
+	)
+
+	dir := ""
+
+	for {
+		sub := strings.TrimPrefix(relPath, dir)
+		sub = strings.TrimPrefix(sub, "/")
+
+		rules := m.rulesFor(dir)
+		for i := range rules {
+			if rules[i].matches(sub, isDir) {
+				last, found = &rules[i], true
+			}
+		}
+
+		next := strings.IndexByte(sub, '/')
+		if next < 0 {
+			break
+		}
+
+		if dir == "" {
+			dir = sub[:next]
+		} else {
+			dir += "/" + sub[:next]
+		}
+	}
+
+	if !found {
+		return Decision{}
+	}
+
+	return Decision{Ignored: !last.negate, Source: last.source, Line: last.line, Pattern: last.text}
+}
+
+// rulesFor returns the rules the ignore files in dir hold, reading them on
+// first use. A .gitignore comes before the .wapiignore beside it, so the
+// file written for sync has the last word.
+func (m *Matcher) rulesFor(dir string) []rule {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if rules, ok := m.rules[dir]; ok {
+		return rules
+	}
+
+	var rules []rule
+
+	if m.root != "" {
+		names := []string{wapiignoreFile}
+		if m.gitignore {
+			names = []string{gitignoreFile, wapiignoreFile}
+		}
+
+		for _, name := range names {
+			read, err := readRules(m.root, joinRel(dir, name))
+			if err != nil {
+				if m.err == nil {
+					m.err = err
+				}
+
+				continue
+			}
+
+			rules = append(rules, read...)
+		}
+	}
+
+	m.rules[dir] = rules
+
+	return rules
+}
+
+// readRules parses the ignore file at the slash-separated rel under root. A
+// missing file holds no rules.
+func readRules(root, rel string) ([]rule, error) {
+	path := filepath.Join(root, filepath.FromSlash(rel))
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("read %s: %w", path, err)
+	}
+
+	return parseRules(rel, splitLines(string(data))), nil
+}
+
+func splitLines(data string) []string {
+	if data == "" {
+		return nil
+	}
+
+	return strings.Split(strings.ReplaceAll(data, "\r\n", "\n"), "\n")
+}
+
+func joinRel(dir, name string) string {
+	if dir == "" {
+		return name
 	}
 
-	return false
+	return dir + "/" + name
 }
 
 // matchesSystemExclude reports whether relPath is or lives inside a
-// system-excluded directory.
+// system-excluded directory, and which one.
 //
 // The comparison folds case because macOS and Windows preserve case without
 // distinguishing it: a project that already holds a differently-cased
@@ -112,14 +326,14 @@ func (m *Matcher) Match(relPath string, isDir bool) bool {
 // exclude would then let config.json and manifest.json sync to the remote. The
 // cost is that a case-sensitive filesystem also excludes a genuine .Git or
 // .DataRobot, which is not a directory anyone keeps alongside the real ones.
-func matchesSystemExclude(relPath string) bool {
+func matchesSystemExclude(relPath string) (string, bool) {
 	lowered := strings.ToLower(relPath)
 
 	for _, name := range systemExcludes {
 		if lowered == name || strings.HasPrefix(lowered, name+"/") {
-			return true
+			return name, true
 		}
 	}
 
-	return false
+	return "", false
 }
@@ -144,3 +144,184 @@ func TestNew_WithWapiignore(t *testing.T) {
 	assert.True(t, m.Match("scratch.tmp", false))
 	assert.False(t, m.Match("agent.py", false))
 }
+
+// writeTree writes files, slash-separated paths to contents, under a new
+// temporary directory and returns it.
+func writeTree(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+
+	for rel, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(rel))
+
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	}
+
+	return dir
+}
+
+func TestNew_NestedWapiignore(t *testing.T) {
+	dir := writeTree(t, map[string]string{
+		".wapiignore":          "*.log\n/top.txt\n",
+		"sub/.wapiignore":      "!keep.log\n/local.txt\ndata/\n",
+		"sub/deep/.wapiignore": "*.txt\n",
+	})
+
+	m, err := New(dir)
+	require.NoError(t, err)
+
+	cases := []struct {
+		path  string
+		isDir bool
+		want  bool
+	}{
+		{path: "app.log", want: true},
+		{path: "sub/app.log", want: true},
+		{path: "sub/keep.log", want: false}, // a deeper negation wins
+		{path: "keep.log", want: true},      // but only below its own file
+		{path: "top.txt", want: true},
+		{path: "sub/top.txt", want: false}, // anchored to the root file
+		{path: "sub/local.txt", want: true},
+		{path: "local.txt", want: false},
+		{path: "sub/other/local.txt", want: false}, // anchored to sub/
+		{path: "sub/data", isDir: true, want: true},
+		{path: "sub/data/x.csv", want: true},
+		{path: "sub/deep/notes.txt", want: true},
+		{path: "sub/notes.txt", want: false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.path, func(t *testing.T) {
+			assert.Equal(t, tc.want, m.Match(tc.path, tc.isDir))
+		})
+	}
+
+	require.NoError(t, m.Err())
+}
+
+func TestMatch_NoReincludeInsideExcludedDirectory(t *testing.T) {
+	dir := writeTree(t, map[string]string{
+		".wapiignore":       "build/\n!build/keep.txt\ncache/*\n!cache/keep.txt\n",
+		"build/.wapiignore": "!*\n",
+	})
+
+	m, err := New(dir)
+	require.NoError(t, err)
+
+	assert.True(t, m.Match("build/keep.txt", false), "git never looks inside an excluded directory")
+	assert.True(t, m.Match("build/other.txt", false))
+	assert.False(t, m.Match("cache", true), "cache/* excludes the contents, not the directory")
+	assert.False(t, m.Match("cache/keep.txt", false))
+	assert.True(t, m.Match("cache/other.txt", false))
+}
+
+func TestNew_GitignoreIsOptIn(t *testing.T) {
+	files := map[string]string{
+		".gitignore":     "*.secret\n",
+		"sub/.gitignore": "generated/\n",
+	}
+
+	t.Run("Off", func(t *testing.T) {
+		m, err := New(writeTree(t, files))
+		require.NoError(t, err)
+
+		assert.False(t, m.IncludesGitignore())
+		assert.False(t, m.Match("a.secret", false))
+		assert.False(t, m.Match("sub/generated", true))
+	})
+
+	t.Run("On", func(t *testing.T) {
+		withDirective := map[string]string{".wapiignore": IncludeGitignoreDirective + "\n!keep.secret\n"}
+		for k, v := range files {
+			withDirective[k] = v
+		}
+
+		m, err := New(writeTree(t, withDirective))
+		require.NoError(t, err)
+
+		assert.True(t, m.IncludesGitignore())
+		assert.True(t, m.Match("a.secret", false))
+		assert.True(t, m.Match("sub/generated", true))
+		assert.False(t, m.Match("keep.secret", false), ".wapiignore comes after the .gitignore beside it")
+	})
+}
+
+func TestExplain(t *testing.T) {
+	dir := writeTree(t, map[string]string{
+		".wapiignore":     "# comment\n*.log\n",
+		"sub/.wapiignore": "\n!debug.log\n",
+	})
+
+	m, err := New(dir)
+	require.NoError(t, err)
+
+	assert.Equal(t, Decision{Ignored: true, Source: ".wapiignore", Line: 2, Pattern: "*.log"}, m.Explain("app.log", false))
+	assert.Equal(t, Decision{Source: "sub/.wapiignore", Line: 2, Pattern: "!debug.log"}, m.Explain("sub/debug.log", false))
+	assert.Equal(t, Decision{Ignored: true, System: true, Pattern: ".git"}, m.Explain(".git/HEAD", false))
+	assert.False(t, m.Explain("app.py", false).Matched())
+}
+
+func TestExplain_ExcludedAncestorDecides(t *testing.T) {
+	m := FromLines([]string{"vendor/", "!vendor/keep.go"})
+
+	d := m.Explain("vendor/keep.go", false)
+
+	assert.True(t, d.Ignored)
+	assert.Equal(t, "vendor/", d.Pattern)
+	assert.Equal(t, 1, d.Line)
+}
+
+func TestPatterns_GitignoreSyntax(t *testing.T) {
+	cases := []struct {
+		pattern string
+		path    string
+		isDir   bool
+		want    bool
+	}{
+		{pattern: "doc/frotz", path: "doc/frotz", want: true},
+		{pattern: "doc/frotz", path: "a/doc/frotz", want: false}, // a middle slash anchors
+		{pattern: "frotz", path: "a/b/frotz", want: true},
+		{pattern: "/frotz", path: "a/frotz", want: false},
+		{pattern: "a?c", path: "abc", want: true},
+		{pattern: "a?c", path: "a/c", want: false},
+		{pattern: "*.py[co]", path: "x.pyc", want: true},
+		{pattern: "*.py[!co]", path: "x.pyc", want: false},
+		{pattern: "*.py[!co]", path: "x.pyd", want: true},
+		{pattern: "**/logs", path: "logs", isDir: true, want: true},
+		{pattern: "**/logs", path: "a/b/logs", isDir: true, want: true},
+		{pattern: "a/**/b", path: "a/b", want: true},
+		{pattern: "a/**/b", path: "a/x/y/b", want: true},
+		{pattern: "abc/**", path: "abc/x/y", want: true},
+		{pattern: "abc/**", path: "abc", isDir: true, want: false},
+		{pattern: "a*b", path: "a/b", want: false},
+		{pattern: "build/", path: "build", want: false}, // directory-only
+		{pattern: `\#hash`, path: "#hash", want: true},
+		{pattern: `\!bang`, path: "!bang", want: true},
+		{pattern: `trail\ `, path: "trail ", want: true},
+		{pattern: "trail   ", path: "trail", want: true},
+		{pattern: "file.txt", path: "fileXtxt", want: false}, // dots are literal
+		{pattern: "[", path: "[", want: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.pattern+" "+tc.path, func(t *testing.T) {
+			assert.Equal(t, tc.want, FromLines([]string{tc.pattern}).Match(tc.path, tc.isDir))
+		})
+	}
+}
+
+func TestErr_UnreadableNestedFile(t *testing.T) {
+	dir := writeTree(t, map[string]string{"sub/x.txt": "x"})
+
+	// A directory where the file should be cannot be read as one.
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "sub", ".wapiignore"), 0o755))
+
+	m, err := New(dir)
+	require.NoError(t, err)
+
+	assert.False(t, m.Match("sub/x.txt", false))
+	require.Error(t, m.Err())
+	assert.Contains(t, m.Err().Error(), ".wapiignore")
+}
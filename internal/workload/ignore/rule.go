@@ -0,0 +1,252 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ignore
+
+import (
+	"regexp"
+	"strings"
+)
+
+// rule is one pattern line of an ignore file, compiled.
+type rule struct {
+	source  string // project-relative ignore file, "" for FromLines
+	line    int    // 1-based
+	text    string // the pattern as written, "!" included
+	negate  bool
+	dirOnly bool
+	re      *regexp.Regexp
+}
+
+// matches reports whether the rule matches sub, a path relative to the
+// directory of the file the rule came from.
+func (r rule) matches(sub string, isDir bool) bool {
+	if r.dirOnly && !isDir {
+		return false
+	}
+
+	return r.re.MatchString(sub)
+}
+
+// parseRules compiles the pattern lines of one ignore file. Blank lines and
+// comments hold no rule; neither does a pattern that cannot be compiled,
+// which git also passes over.
+func parseRules(source string, lines []string) []rule {
+	var rules []rule
+
+	for i, line := range lines {
+		r, ok := parseRule(line)
+		if !ok {
+			continue
+		}
+
+		r.source, r.line = source, i+1
+		rules = append(rules, r)
+	}
+
+	return rules
+}
+
+func parseRule(line string) (rule, bool) {
+	line = trimTrailingSpace(strings.TrimSuffix(line, "\r"))
+	if line == "" || strings.HasPrefix(line, "#") {
+		return rule{}, false
+	}
+
+	r := rule{text: line}
+	pattern := line
+
+	switch {
+	case strings.HasPrefix(pattern, "!"):
+		r.negate = true
+		pattern = pattern[1:]
+	case strings.HasPrefix(pattern, `\!`), strings.HasPrefix(pattern, `\#`):
+		pattern = pattern[1:]
+	}
+
+	if strings.HasSuffix(pattern, "/") && !strings.HasSuffix(pattern, `\/`) {
+		r.dirOnly = true
+		pattern = strings.TrimSuffix(pattern, "/")
+	}
+
+	if pattern == "" {
+		return rule{}, false
+	}
+
+	// A slash anywhere but the end ties the pattern to the directory of its
+	// file; without one it matches a name at any depth below it.
+	anchored := strings.Contains(pattern, "/")
+	pattern = strings.TrimPrefix(pattern, "/")
+
+	expr := translate(pattern)
+	if !anchored {
+		expr = "(?:.*/)?" + expr
+	}
+
+	re, err := regexp.Compile("^" + expr + "$")
+	if err != nil {
+		return rule{}, false
+	}
+
+	r.re = re
+
+	return r, true
+}
+
+// trimTrailingSpace drops trailing spaces unless the last is escaped with a
+// backslash, which keeps it.
+func trimTrailingSpace(line string) string {
+	for strings.HasSuffix(line, " ") && !strings.HasSuffix(line, `\ `) {
+		line = line[:len(line)-1]
+	}
+
+	return line
+}
+
+// translate turns a gitignore glob into a regular expression. "*" and "?"
+// stop at a slash; "**" as a whole path segment crosses any number of them:
+// a leading "**/" matches in every directory, a trailing "/**" everything
+// inside, and "/**/" zero or more directories in between.
+func translate(pattern string) string {
+	var b strings.Builder
+
+	for i := 0; i < len(pattern); {
+		c := pattern[i]
+
+		switch {
+		case c == '*' && isDoubleStarSegment(pattern, i):
+			if i+2 == len(pattern) {
+				b.WriteString(".*")
+
+				i += 2
+			} else {
+				b.WriteString("(?:.*/)?")
+
+				i += 3
+			}
+		case c == '*':
+			b.WriteString("[^/]*")
+
+			for i < len(pattern) && pattern[i] == '*' {
+				i++
+			}
+		case c == '?':
+			b.WriteString("[^/]")
+
+			i++
+		case c == '[':
+			class, next, ok := translateClass(pattern, i)
+			if !ok {
+				b.WriteString(`\[`)
+
+				i++
+
+				continue
+			}
+
+			b.WriteString(class)
+
+			i = next
+		case c == '\\' && i+1 < len(pattern):
+			b.WriteString(regexp.QuoteMeta(pattern[i+1 : i+2]))
+
+			i += 2
+		default:
+			b.WriteString(regexp.QuoteMeta(pattern[i : i+1]))
+
+			i++
+		}
+	}
+
+	return b.String()
+}
+
+// isDoubleStarSegment reports whether the "**" at i fills a whole path
+// segment. Elsewhere, as in "a**b", it means no more than "*".
+func isDoubleStarSegment(pattern string, i int) bool {
+	if i+1 >= len(pattern) || pattern[i+1] != '*' {
+		return false
+	}
+
+	before := i == 0 || pattern[i-1] == '/'
+	after := i+2 == len(pattern) || pattern[i+2] == '/'
+
+	return before && after
+}
+
+// translateClass turns the bracket expression starting at i into a regexp
+// class, returning it with the index just past its closing bracket. A
+// leading "!" negates, as "^" does. A class never matches a slash. ok is
+// false when there is no closing bracket, and the "[" is then literal.
+func translateClass(pattern string, i int) (string, int, bool) {
+	j := i + 1
+
+	negate := j < len(pattern) && (pattern[j] == '!' || pattern[j] == '^')
+	if negate {
+		j++
+	}
+
+	var b strings.Builder
+
+	b.WriteString("[")
+
+	if negate {
+		b.WriteString("^/")
+	}
+
+	for first := true; j < len(pattern); first = false {
+		c := pattern[j]
+
+		switch {
+		case c == ']' && !first:
+			b.WriteString("]")
+
+			return b.String(), j + 1, true
+		case c == '[' && strings.HasPrefix(pattern[j:], "[:"):
+			end := strings.Index(pattern[j+2:], ":]")
+			if end < 0 {
+				return "", 0, false
+			}
+
+			b.WriteString(pattern[j : j+2+end+2])
+
+			j += 2 + end + 2
+		case c == '\\' && j+1 < len(pattern):
+			b.WriteString(classLiteral(pattern[j+1]))
+
+			j += 2
+		case c == '\\', c == '[', c == ']':
+			b.WriteString(classLiteral(c))
+
+			j++
+		default:
+			b.WriteByte(c)
+
+			j++
+		}
+	}
+
+	return "", 0, false
+}
+
+// classLiteral is c as a literal inside a regexp class. Punctuation is
+// escaped; letters and digits are not, since "\d" and the like are classes
+// of their own.
+func classLiteral(c byte) string {
+	if c >= 0x80 || c == '_' || ('0' <= c && c <= '9') || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') {
+		return string([]byte{c})
+	}
+
+	return `\` + string([]byte{c})
+}
@@ -109,12 +109,12 @@ type workloadLogsResponse struct {
 // GetWorkloadLogs returns up to limit of the most recent log lines,
 // oldest-first for display (like `kubectl logs --tail`). Empty level keeps
 // the server default.
-func GetWorkloadLogs(workloadID string, limit int, level string) ([]WorkloadLogEntry, error) {
+func GetWorkloadLogs(ctx context.Context, workloadID string, limit int, level string) ([]WorkloadLogEntry, error) {
 	if limit <= 0 {
 		return nil, fmt.Errorf("invalid limit %d: must be positive", limit)
 	}
 
-	all, err := fetchWorkloadLogs(workloadID, limit, level, "", "workload logs")
+	all, err := fetchWorkloadLogs(ctx, workloadID, limit, level, "", "workload logs")
 	if err != nil {
 		return nil, err
 	}
@@ -169,7 +169,7 @@ func appendUnseenPageEntries(all, page []WorkloadLogEntry, priorPages map[string
 // page stops the loop even if a next link is present. reqInfo is drapi's
 // per-request log label; the follow loop passes "" to silence the per-poll
 // "Fetching ..." line so it does not interleave with the streamed log lines.
-func fetchWorkloadLogs(workloadID string, maxEntries int, level, since, reqInfo string) ([]WorkloadLogEntry, error) {
+func fetchWorkloadLogs(ctx context.Context, workloadID string, maxEntries int, level, since, reqInfo string) ([]WorkloadLogEntry, error) {
 	pageURL, err := drapi.EndpointURL("/otel/workload/"+escapeID(workloadID)+"/logs/", logsQueryParams(maxEntries, level, since))
 	if err != nil {
 		return nil, err
@@ -182,7 +182,7 @@ func fetchWorkloadLogs(workloadID string, maxEntries int, level, since, reqInfo
 	for pageURL != "" {
 		var resp workloadLogsResponse
 
-		if err := drapi.GetJSON(pageURL, reqInfo, &resp); err != nil {
+		if err := drapi.GetJSON(ctx, pageURL, reqInfo, &resp); err != nil {
 			return nil, err
 		}
 
@@ -236,8 +236,14 @@ func FollowWorkloadLogs(
 	}
 
 	for {
-		entries, hadSince, err := f.fetch() //nolint:contextcheck // drapi does not yet accept context; ctx gates the inter-poll sleeps
+		entries, hadSince, err := f.fetch(ctx)
 		if err != nil {
+			// Ctrl-C mid-request surfaces as a failed fetch; it ends the
+			// follow cleanly rather than counting as a transient error.
+			if ctx.Err() != nil {
+				return nil
+			}
+
 			retryNow, ferr := f.fetchFailure(err, hadSince)
 			if ferr != nil {
 				return ferr
@@ -317,7 +323,7 @@ func newLogFollower(
 // fetch retrieves the next poll's entries: the newest-limit window when
 // seeding or in window mode, else everything newer than the cursor. hadSince
 // reports which mode was used.
-func (f *logFollower) fetch() (entries []WorkloadLogEntry, hadSince bool, err error) {
+func (f *logFollower) fetch(ctx context.Context) (entries []WorkloadLogEntry, hadSince bool, err error) {
 	since := ""
 	maxEntries := f.limit
 
@@ -328,7 +334,7 @@ func (f *logFollower) fetch() (entries []WorkloadLogEntry, hadSince bool, err er
 
 	// Empty reqInfo silences drapi's per-request "Fetching ..." log so the
 	// follow stream stays just the workload's log lines.
-	entries, err = fetchWorkloadLogs(f.workloadID, maxEntries, f.level, since, "")
+	entries, err = fetchWorkloadLogs(ctx, f.workloadID, maxEntries, f.level, since, "")
 
 	return entries, since != "", err
 }
@@ -70,7 +70,7 @@ func TestGetWorkloadLogs_SinglePageReversedToChronological(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	entries, err := GetWorkloadLogs("wl-1", 25, "")
+	entries, err := GetWorkloadLogs(context.Background(), "wl-1", 25, "")
 	require.NoError(t, err)
 	require.Len(t, entries, 2)
 	// Reversed for display: oldest first, newest last.
@@ -91,7 +91,7 @@ func TestGetWorkloadLogs_LevelLowercased(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	_, err := GetWorkloadLogs("wl-1", 25, "ERROR")
+	_, err := GetWorkloadLogs(context.Background(), "wl-1", 25, "ERROR")
 	require.NoError(t, err)
 }
 
@@ -112,7 +112,7 @@ func TestGetWorkloadLogs_OmitsLevelWhenEmpty(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	_, err := GetWorkloadLogs("wl-1", 25, "")
+	_, err := GetWorkloadLogs(context.Background(), "wl-1", 25, "")
 	require.NoError(t, err)
 }
 
@@ -141,7 +141,7 @@ func TestGetWorkloadLogs_FollowsNextAndTruncatesToLimit(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	entries, err := GetWorkloadLogs("wl-1", 3, "")
+	entries, err := GetWorkloadLogs(context.Background(), "wl-1", 3, "")
 	require.NoError(t, err)
 	assert.Equal(t, 2, calls)
 	require.Len(t, entries, 3)
@@ -163,7 +163,7 @@ func TestGetWorkloadLogs_ClampsPageSizeToServerMax(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	_, err := GetWorkloadLogs("wl-1", 2500, "")
+	_, err := GetWorkloadLogs(context.Background(), "wl-1", 2500, "")
 	require.NoError(t, err)
 }
 
@@ -189,7 +189,7 @@ func TestGetWorkloadLogs_StopsOnEmptyPageWithNext(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	entries, err := GetWorkloadLogs("wl-1", 5, "")
+	entries, err := GetWorkloadLogs(context.Background(), "wl-1", 5, "")
 	require.NoError(t, err)
 	assert.Empty(t, entries)
 	assert.Equal(t, 1, calls)
@@ -228,7 +228,7 @@ func TestGetWorkloadLogs_DropsCrossPageDuplicates(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	entries, err := GetWorkloadLogs("wl-1", 10, "")
+	entries, err := GetWorkloadLogs(context.Background(), "wl-1", 10, "")
 	require.NoError(t, err)
 	require.Len(t, entries, 3)
 	assert.Equal(t, "n2", entries[0].Message)
@@ -253,7 +253,7 @@ func TestGetWorkloadLogs_RejectsOffHostNext(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	_, err := GetWorkloadLogs("wl-1", 10, "")
+	_, err := GetWorkloadLogs(context.Background(), "wl-1", 10, "")
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "does not match API base host")
 }
@@ -272,7 +272,7 @@ func TestGetWorkloadLogs_RejectsMalformedNext(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	_, err := GetWorkloadLogs("wl-1", 10, "")
+	_, err := GetWorkloadLogs(context.Background(), "wl-1", 10, "")
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "parse Next URL")
 }
@@ -293,14 +293,14 @@ func TestGetWorkloadLogs_KeepsSamePageDuplicates(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	entries, err := GetWorkloadLogs("wl-1", 10, "")
+	entries, err := GetWorkloadLogs(context.Background(), "wl-1", 10, "")
 	require.NoError(t, err)
 	assert.Len(t, entries, 2)
 }
 
 func TestGetWorkloadLogs_RejectsNonPositiveLimit(t *testing.T) {
 	for _, limit := range []int{0, -1} {
-		_, err := GetWorkloadLogs("wl-1", limit, "")
+		_, err := GetWorkloadLogs(context.Background(), "wl-1", limit, "")
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "must be positive")
 	}
@@ -318,7 +318,7 @@ func TestGetWorkloadLogs_EscapesIDInPath(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	_, err := GetWorkloadLogs("wl-1?x=1", 25, "")
+	_, err := GetWorkloadLogs(context.Background(), "wl-1?x=1", 25, "")
 	require.NoError(t, err)
 }
 
@@ -333,7 +333,7 @@ func TestGetWorkloadLogs_404PropagatesAsHTTPError(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	_, err := GetWorkloadLogs("missing", 25, "")
+	_, err := GetWorkloadLogs(context.Background(), "missing", 25, "")
 	require.Error(t, err)
 
 	var httpErr *drapi.HTTPError
@@ -472,8 +472,6 @@ func TestFollowWorkloadLogs_StreamsWithTimeCursor(t *testing.T) {
 				logEntryDocAt("2026-06-11 14:04:16.000001+00:00", "INFO", "c"),
 				logEntryDocAt("2026-06-11 14:04:15.000001+00:00", "INFO", "b"),
 			))
-
-			cancel()
 		}
 	}))
 
@@ -483,10 +481,16 @@ func TestFollowWorkloadLogs_StreamsWithTimeCursor(t *testing.T) {
 
 	var lines []string
 
+	// Cancel once the follow poll's line lands, not from the handler: the
+	// request is bound to ctx, so cancelling mid-response would drop it.
 	err := FollowWorkloadLogs(ctx, "wl-1", 5, "", time.Millisecond,
 		func(e WorkloadLogEntry) error {
 			lines = append(lines, e.Message)
 
+			if e.Message == "c" {
+				cancel()
+			}
+
 			return nil
 		}, nil)
 	require.NoError(t, err)
@@ -544,6 +548,7 @@ func TestFollowWorkloadLogs_TerminalErrorStops(t *testing.T) {
 
 func TestFollowWorkloadLogs_GivesUpAfterSustainedTransientErrors(t *testing.T) {
 	installSkipAuth(t)
+	disableRetries(t)
 
 	calls := 0
 
@@ -572,6 +577,7 @@ func TestFollowWorkloadLogs_GivesUpAfterSustainedTransientErrors(t *testing.T) {
 
 func TestFollowWorkloadLogs_RecoversFromTransientErrors(t *testing.T) {
 	installSkipAuth(t)
+	disableRetries(t)
 
 	ctx, cancel := context.WithCancel(context.Background())
 
@@ -592,8 +598,6 @@ func TestFollowWorkloadLogs_RecoversFromTransientErrors(t *testing.T) {
 		}
 
 		fmt.Fprint(w, logsPage("", logEntryDoc("INFO", "ok")))
-
-		cancel()
 	}))
 
 	defer srv.Close()
@@ -608,6 +612,8 @@ func TestFollowWorkloadLogs_RecoversFromTransientErrors(t *testing.T) {
 		func(e WorkloadLogEntry) error {
 			lines = append(lines, e.Message)
 
+			cancel()
+
 			return nil
 		},
 		func(msg string) { warnings = append(warnings, msg) })
@@ -644,7 +650,6 @@ func TestFollowWorkloadLogs_FallsBackWhenTimeFilterRejected(t *testing.T) {
 				default:
 					// The follow must retry without the filter and keep streaming.
 					fmt.Fprint(w, logsPage("", logEntryDoc("INFO", "b"), logEntryDoc("INFO", "a")))
-					cancel()
 				}
 			}))
 
@@ -660,6 +665,10 @@ func TestFollowWorkloadLogs_FallsBackWhenTimeFilterRejected(t *testing.T) {
 				func(e WorkloadLogEntry) error {
 					lines = append(lines, e.Message)
 
+					if e.Message == "b" {
+						cancel()
+					}
+
 					return nil
 				},
 				func(msg string) { warnings = append(warnings, msg) })
@@ -703,8 +712,6 @@ func TestFollowWorkloadLogs_WarnsOnWindowGap(t *testing.T) {
 			logEntryDocAt("t9", "INFO", "z"),
 			logEntryDocAt("t8", "INFO", "y"),
 		))
-
-		cancel()
 	}))
 
 	defer srv.Close()
@@ -715,7 +722,11 @@ func TestFollowWorkloadLogs_WarnsOnWindowGap(t *testing.T) {
 
 	err := FollowWorkloadLogs(ctx, "wl-1", 2, "", time.Millisecond,
 		func(WorkloadLogEntry) error { return nil },
-		func(msg string) { warnings = append(warnings, msg) })
+		func(msg string) {
+			warnings = append(warnings, msg)
+
+			cancel()
+		})
 	require.NoError(t, err)
 	require.NotEmpty(t, warnings)
 	assert.Contains(t, warnings[0], "possible gap")
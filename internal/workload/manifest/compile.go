@@ -17,6 +17,7 @@ package manifest
 import (
 	"encoding/json"
 	"fmt"
+	"path/filepath"
 	"strings"
 
 	"gopkg.in/yaml.v3"
@@ -50,6 +51,19 @@ type CredentialRef struct {
 	EnvName string
 	// Line is where the reference appears in the manifest.
 	Line int
+	// File is the environment overlay the reference came from, "" when it is
+	// in the manifest itself.
+	File string
+}
+
+// SourceFile names the file the reference is in, for messages that send
+// someone to fix it.
+func (r CredentialRef) SourceFile() string {
+	if r.File != "" {
+		return filepath.Base(r.File)
+	}
+
+	return FileName
 }
 
 // Compiled is a manifest lowered to what the API accepts.
@@ -75,7 +89,7 @@ type Compiled struct {
 // Compile still refuses a malformed credential shorthand rather than sending
 // it to the API as a literal value.
 func (m *Manifest) Compile() (*Compiled, error) {
-	refs, fieldErrs := collectCredentialRefs(m.root)
+	refs, fieldErrs := collectCredentialRefs(m.root, m.origins)
 	if len(fieldErrs) > 0 {
 		return nil, &ValidationError{File: m.fileLabel(), Errors: fieldErrs}
 	}
@@ -269,16 +283,17 @@ func parseCredentialShorthand(value string) (id, key string, ok bool) {
 // with syntax problems collected alongside. Validate reports the problems;
 // Compile returns the refs so callers can GET-verify each credential before
 // anything mutates.
-func collectCredentialRefs(root *yaml.Node) ([]CredentialRef, []FieldError) {
-	c := &refCollector{}
+func collectCredentialRefs(root *yaml.Node, origins map[*yaml.Node]string) ([]CredentialRef, []FieldError) {
+	c := &refCollector{origins: origins}
 	c.walk(root, "")
 
 	return c.refs, c.errs
 }
 
 type refCollector struct {
-	refs []CredentialRef
-	errs []FieldError
+	refs    []CredentialRef
+	errs    []FieldError
+	origins map[*yaml.Node]string
 }
 
 func (c *refCollector) walk(node *yaml.Node, path string) {
@@ -333,12 +348,15 @@ func (c *refCollector) addShorthand(node *yaml.Node, path, name, value string) {
 			Path: path + "." + keyValue,
 			Line: node.Line,
 			Msg:  fmt.Sprintf("credential reference %q must be %s<credential-id>/<key>", value, CredentialShorthandPrefix),
+			File: c.origins[node],
 		})
 
 		return
 	}
 
-	c.refs = append(c.refs, CredentialRef{CredentialID: id, Key: key, EnvName: name, Line: node.Line})
+	c.refs = append(c.refs, CredentialRef{
+		CredentialID: id, Key: key, EnvName: name, Line: node.Line, File: c.origins[node],
+	})
 }
 
 func (c *refCollector) addObjectForm(entry *yaml.Node, path, name string) {
@@ -350,6 +368,7 @@ func (c *refCollector) addObjectForm(entry *yaml.Node, path, name string) {
 			Path: path + "." + keyDRCredentialID,
 			Line: entry.Line,
 			Msg:  fmt.Sprintf("%s is required when source is %s", keyDRCredentialID, credentialSource),
+			File: c.origins[entry],
 		})
 	}
 
@@ -358,10 +377,13 @@ func (c *refCollector) addObjectForm(entry *yaml.Node, path, name string) {
 			Path: path + "." + keyKey,
 			Line: entry.Line,
 			Msg:  fmt.Sprintf("%s is required when source is %s", keyKey, credentialSource),
+			File: c.origins[entry],
 		})
 	}
 
 	if id != "" {
-		c.refs = append(c.refs, CredentialRef{CredentialID: id, Key: key, EnvName: name, Line: entry.Line})
+		c.refs = append(c.refs, CredentialRef{
+			CredentialID: id, Key: key, EnvName: name, Line: entry.Line, File: c.origins[entry],
+		})
 	}
 }
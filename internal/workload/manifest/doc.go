@@ -27,6 +27,15 @@
 // from it: Compile lowers the tree to the JSON create payload, and Validate
 // walks the same tree so every finding carries its manifest line.
 //
+// Environments are overlay files beside the manifest, .datarobot.<env>.yaml,
+// each a partial manifest saying only what differs. LoadEnvironment merges
+// one onto the base tree before either of the above sees it, and remembers
+// which nodes it contributed, so a finding still names the file and line that
+// set the value. An overlay carries its environment's workloadId; the base
+// file's binding is never inherited. Separate files rather than a block
+// inside the manifest, so that each environment's write-back touches a file
+// of its own and the base stays the same in every checkout.
+//
 // Writing is the narrow half. Draft is the fixed subset the setup wizard
 // settles, and Render turns it into the commented file the confirm screen
 // shows; Write creates that file and refuses to overwrite one. After the
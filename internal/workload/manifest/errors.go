@@ -22,13 +22,22 @@ import (
 // FieldError is one finding against the manifest, anchored to the line it
 // came from. Path names YAML keys the way the file spells them, e.g.
 // artifact.spec.containerGroups[0].containers[1].port.
+//
+// File is set only when the line is in an environment overlay rather than the
+// manifest itself, so a finding against a merged manifest still sends the
+// user to the file that set the value.
 type FieldError struct {
 	Path string
 	Line int
 	Msg  string
+	File string
 }
 
 func (e FieldError) Error() string {
+	if e.File != "" {
+		return fmt.Sprintf("%s line %d: %s: %s", e.File, e.Line, e.Path, e.Msg)
+	}
+
 	return fmt.Sprintf("line %d: %s: %s", e.Line, e.Path, e.Msg)
 }
 
@@ -339,29 +339,94 @@ func (l Live) LiteralEnvVars() []EnvVar {
 		return nil
 	}
 
-	existing, _ := container[keyEnvironmentVars].([]any)
-	literals := make([]EnvVar, 0, len(existing))
+	return literalEnvVars(container)
+}
 
-	for _, entry := range existing {
-		typed, ok := entry.(map[string]any)
-		if !ok {
-			continue
+// ContainerEnvVar is a literal variable and the container declaring it. A
+// spec can declare the same name in two containers with two different values,
+// so the name alone does not say which entry is meant.
+type ContainerEnvVar struct {
+	Container string
+	EnvVar
+}
+
+// ContainerLiteralEnvVars is LiteralEnvVars across every container, sidecars
+// included. A bind only ever edits the primary, but an export writes the whole
+// spec, and a value in a sidecar lands in git just the same.
+func (l Live) ContainerLiteralEnvVars() []ContainerEnvVar {
+	var literals []ContainerEnvVar
+
+	for _, group := range slicesAt(l.Spec, keyContainerGroups) {
+		for _, container := range slicesAt(group, keyContainers) {
+			name := stringAt(container, keyName)
+
+			for _, v := range literalEnvVars(container) {
+				literals = append(literals, ContainerEnvVar{Container: name, EnvVar: v})
+			}
 		}
+	}
 
-		// A credential-backed entry has no value to read: the object form
-		// names an id and a key instead, and the shorthand carries them in a
-		// string that is a reference rather than the secret it points at.
-		value := stringAt(typed, keyValue)
-		if value == "" || strings.HasPrefix(value, CredentialShorthandPrefix) {
-			continue
+	return literals
+}
+
+// ReferenceCredential rewrites the literal variable name in container as a
+// reference to the credential holding its value, or to CredentialPlaceholder
+// when credentialID is empty. A reference still carrying the placeholder is
+// rewritten too, which is how a caller checks the file before any credential
+// exists and fills the ids in after. It reports whether there was such an
+// entry to rewrite. The spec is edited in place, so a caller that wants the
+// original back has to keep its own copy.
+func (l Live) ReferenceCredential(container, name, credentialID string) bool {
+	ref := EnvVar{Name: name, Secret: true, CredentialID: credentialID}
+	placeholder := CredentialShorthandPrefix + CredentialPlaceholder + "/" + credentialKey
+
+	for _, group := range slicesAt(l.Spec, keyContainerGroups) {
+		for _, c := range slicesAt(group, keyContainers) {
+			if stringAt(c, keyName) != container {
+				continue
+			}
+
+			for _, entry := range slicesAt(c, keyEnvironmentVars) {
+				if stringAt(entry, keyName) != name ||
+					(!isLiteralEntry(entry) && stringAt(entry, keyValue) != placeholder) {
+					continue
+				}
+
+				entry[keyValue] = CredentialShorthandPrefix + ref.credentialID() + "/" + credentialKey
+
+				return true
+			}
 		}
+	}
+
+	return false
+}
+
+// literalEnvVars is the entries of one container that carry their value
+// written out.
+func literalEnvVars(container map[string]any) []EnvVar {
+	existing := slicesAt(container, keyEnvironmentVars)
+	literals := make([]EnvVar, 0, len(existing))
 
-		literals = append(literals, EnvVar{Name: stringAt(typed, keyName), Value: value})
+	for _, entry := range existing {
+		if isLiteralEntry(entry) {
+			literals = append(literals, EnvVar{Name: stringAt(entry, keyName), Value: stringAt(entry, keyValue)})
+		}
 	}
 
 	return literals
 }
 
+// isLiteralEntry reports whether an environmentVars entry holds its value. A
+// credential-backed entry has no value to read: the object form names an id
+// and a key instead, and the shorthand carries them in a string that is a
+// reference rather than the secret it points at.
+func isLiteralEntry(entry map[string]any) bool {
+	value := stringAt(entry, keyValue)
+
+	return value != "" && !strings.HasPrefix(value, CredentialShorthandPrefix)
+}
+
 // declaredEnvNames is the set of variable names an environmentVars list
 // already carries.
 func declaredEnvNames(existing []any) map[string]bool {
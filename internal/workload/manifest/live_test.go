@@ -298,6 +298,45 @@ func TestLive_LiteralEnvVarsSkipsCredentialReferences(t *testing.T) {
 	}, live.LiteralEnvVars())
 }
 
+// An export writes the whole spec, so a sidecar's values are as much a part
+// of the file as the primary's, and rewriting one names its container: the
+// same variable in another container is another entry.
+func TestLive_ReferenceCredentialRewritesOneContainer(t *testing.T) {
+	var artifactDoc map[string]any
+
+	require.NoError(t, json.Unmarshal([]byte(`{
+      "name": "a",
+      "spec": {"containerGroups": [{"name": "default", "containers": [
+        {"name": "primary", "primary": true, "port": 8080, "imageUri": "nginx:latest",
+         "environmentVars": [{"name": "TOKEN", "value": "one"}]},
+        {"name": "sidecar", "imageUri": "busybox:latest",
+         "environmentVars": [{"name": "TOKEN", "value": "two"}, {"name": "MODE", "value": "fast"}]}
+      ]}]}
+    }`), &artifactDoc))
+
+	live := NewLive("68b0", map[string]any{"name": "a"}, artifactDoc)
+
+	assert.Equal(t, []ContainerEnvVar{
+		{Container: "primary", EnvVar: EnvVar{Name: "TOKEN", Value: "one"}},
+		{Container: "sidecar", EnvVar: EnvVar{Name: "TOKEN", Value: "two"}},
+		{Container: "sidecar", EnvVar: EnvVar{Name: "MODE", Value: "fast"}},
+	}, live.ContainerLiteralEnvVars())
+
+	require.True(t, live.ReferenceCredential("sidecar", "TOKEN", ""))
+	require.True(t, live.ReferenceCredential("sidecar", "TOKEN", "68f0cccc0000000000000003"))
+	assert.False(t, live.ReferenceCredential("sidecar", "TOKEN", "68f0cccc0000000000000004"),
+		"a real reference is not a literal, and is not rewritten again")
+
+	assert.Equal(t, []ContainerEnvVar{
+		{Container: "primary", EnvVar: EnvVar{Name: "TOKEN", Value: "one"}},
+		{Container: "sidecar", EnvVar: EnvVar{Name: "MODE", Value: "fast"}},
+	}, live.ContainerLiteralEnvVars())
+
+	rendered, err := live.Render()
+	require.NoError(t, err)
+	assert.Contains(t, string(rendered), "value: dr-credential:68f0cccc0000000000000003/apiToken")
+}
+
 // A spec with no container has nothing declared, which is not the same as
 // having something this cannot read.
 func TestLive_LiteralEnvVarsWithoutAContainer(t *testing.T) {
@@ -48,7 +48,17 @@ type Manifest struct {
 	// check; "" skips them.
 	Dir string
 
+	// Environment is the overlay merged onto the file, "" when there is none.
+	// OverlayPath is that overlay's absolute path.
+	Environment string
+	OverlayPath string
+
 	root *yaml.Node
+
+	// origins records the overlay file behind every node an overlay merged
+	// in. A node that is not in it came from the base file, which is what
+	// lets a finding name the file the user has to open.
+	origins map[*yaml.Node]string
 }
 
 // Load reads and parses the manifest at path. The file's directory becomes
@@ -83,6 +93,24 @@ func Load(path string) (*Manifest, error) {
 // yet. JSON content parses too: the manifest format is whatever the create
 // endpoint accepts, and YAML is a superset of JSON.
 func Parse(data []byte, dir string) (*Manifest, error) {
+	root, err := parseRoot(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if !hasRecognizedKey(root) {
+		return nil, fmt.Errorf("does not look like a DataRobot workload manifest (none of %s present)",
+			strings.Join(recognizedKeys, ", "))
+	}
+
+	return &Manifest{Dir: dir, root: root}, nil
+}
+
+// parseRoot parses YAML bytes down to the root mapping, with the alias guard
+// already run. It is everything Parse asks of a file except that it look like
+// a manifest, which an environment overlay need not: one that only resizes
+// the runtime names none of the keys a whole manifest would.
+func parseRoot(data []byte) (*yaml.Node, error) {
 	var doc yaml.Node
 
 	if err := yaml.Unmarshal(data, &doc); err != nil {
@@ -107,17 +135,12 @@ func Parse(data []byte, dir string) (*Manifest, error) {
 		return nil, errors.New("root must be a YAML mapping")
 	}
 
-	// Before anything walks the tree, including the check just below.
+	// Before anything walks the tree, including the caller's own checks.
 	if err := checkAliases(root); err != nil {
 		return nil, err
 	}
 
-	if !hasRecognizedKey(root) {
-		return nil, fmt.Errorf("does not look like a DataRobot workload manifest (none of %s present)",
-			strings.Join(recognizedKeys, ", "))
-	}
-
-	return &Manifest{Dir: dir, root: root}, nil
+	return root, nil
 }
 
 // Locate searches for the manifest in startDir and each ancestor, the way
@@ -159,14 +182,31 @@ func (m *Manifest) Name() string {
 	return topLevelString(m.root, keyName)
 }
 
+// BindingPath is the file the workload binding belongs in: the environment's
+// overlay when one is selected, so each environment keeps its own workload,
+// and the manifest itself otherwise.
+func (m *Manifest) BindingPath() string {
+	if m.OverlayPath != "" {
+		return m.OverlayPath
+	}
+
+	return m.Path
+}
+
 // fileLabel names the manifest in error messages: the real path when the
-// manifest came from disk, the conventional file name otherwise.
+// manifest came from disk, the conventional file name otherwise. A finding
+// inside an overlay names the overlay itself; see FieldError.File.
 func (m *Manifest) fileLabel() string {
+	label := FileName
 	if m.Path != "" {
-		return m.Path
+		label = m.Path
+	}
+
+	if m.Environment != "" {
+		label += " (environment " + m.Environment + ")"
 	}
 
-	return FileName
+	return label
 }
 
 func hasRecognizedKey(root *yaml.Node) bool {
@@ -0,0 +1,287 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifest
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ErrNoEnvironment reports that the selected environment has no overlay file
+// beside the manifest.
+var ErrNoEnvironment = errors.New("no such environment")
+
+// environmentNamePattern keeps a name to what sits safely inside a file name
+// and reads the same on every filesystem.
+var environmentNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+// ValidateEnvironmentName rejects a name that cannot name an overlay file.
+func ValidateEnvironmentName(env string) error {
+	if !environmentNamePattern.MatchString(env) {
+		return fmt.Errorf("invalid environment name %q: use lowercase letters, digits, '-' and '_'", env)
+	}
+
+	return nil
+}
+
+// EnvironmentPath is where the overlay for env sits: beside the manifest at
+// path, as .datarobot.<env>.yaml.
+func EnvironmentPath(path, env string) string {
+	return filepath.Join(filepath.Dir(path), strings.TrimSuffix(FileName, ".yaml")+"."+env+".yaml")
+}
+
+// LoadEnvironment reads the manifest at path with env's overlay merged onto
+// it. An empty env is Load.
+//
+// The overlay is a partial manifest: it says only what differs, and the
+// merge is done on the parse trees, before Validate or Compile see anything,
+// so both read one document and cannot disagree about what it says. Nodes
+// keep the line they were parsed on, and the ones an overlay contributed
+// remember which file that was, so a finding still points at the line the
+// user has to edit.
+//
+// The base file's workloadId is never inherited. Each environment is its own
+// workload, and an overlay that has not been deployed yet inheriting the
+// base's binding would roll production in its place. The binding is read from
+// the overlay alone and written back to it; see BindingPath.
+func LoadEnvironment(path, env string) (*Manifest, error) {
+	if env == "" {
+		return Load(path)
+	}
+
+	if err := ValidateEnvironmentName(env); err != nil {
+		return nil, err
+	}
+
+	m, err := Load(path)
+	if err != nil {
+		return nil, err
+	}
+
+	overlayPath := EnvironmentPath(m.Path, env)
+
+	data, err := os.ReadFile(overlayPath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("%w %q: %s does not exist", ErrNoEnvironment, env, overlayPath)
+		}
+
+		return nil, fmt.Errorf("cannot read %s: %w", overlayPath, err)
+	}
+
+	overlay, err := parseRoot(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", overlayPath, err)
+	}
+
+	m.Environment = env
+	m.OverlayPath = overlayPath
+	m.origins = map[*yaml.Node]string{}
+
+	markOrigin(overlay, overlayPath, m.origins)
+	deleteKey(m.root, keyWorkloadID)
+	mergeMapping(m.root, overlay)
+
+	// Each file passed the guard on its own; merged, they are one document
+	// and are held to the one limit.
+	if err := checkAliases(m.root); err != nil {
+		return nil, fmt.Errorf("%s: %w", overlayPath, err)
+	}
+
+	return m, nil
+}
+
+// markOrigin records file against node and everything under it. Aliases are
+// not followed: the node an alias names is somewhere in the same file, and is
+// reached on its own.
+func markOrigin(node *yaml.Node, file string, origins map[*yaml.Node]string) {
+	if node == nil {
+		return
+	}
+
+	origins[node] = file
+
+	for _, child := range node.Content {
+		markOrigin(child, file, origins)
+	}
+}
+
+// mergeMapping merges src onto dst, key by key:
+//
+//   - a mapping merges into a mapping, recursively;
+//   - a sequence of named mappings merges into another one item by item,
+//     matched on name, so an overlay can resize one container group or
+//     change one environment variable without restating the rest;
+//   - anything else replaces what was there, and an explicit null removes
+//     the key, which is the one way for an overlay to take something away.
+func mergeMapping(dst, src *yaml.Node) {
+	src = resolveAlias(src)
+
+	for i := 0; i+1 < len(src.Content); i += 2 {
+		key, value := src.Content[i], src.Content[i+1]
+		at := keyIndex(dst, key.Value)
+
+		switch {
+		case isNull(value):
+			if at >= 0 {
+				dst.Content = append(dst.Content[:at], dst.Content[at+2:]...)
+			}
+
+		case at < 0:
+			dst.Content = append(dst.Content, key, value)
+
+		default:
+			merged := mergeValue(dst.Content[at+1], value)
+
+			// A value the overlay replaced whole takes its key along, so
+			// the line reported for it is the overlay's. A merged block
+			// keeps the base's key: most of what is under it is the base's.
+			if merged == value {
+				dst.Content[at] = key
+			}
+
+			dst.Content[at+1] = merged
+		}
+	}
+}
+
+// mergeValue is what dst becomes with src merged onto it.
+func mergeValue(dst, src *yaml.Node) *yaml.Node {
+	resolved := resolveAlias(src)
+	target := resolveAlias(dst)
+
+	switch {
+	case resolved.Kind == yaml.MappingNode && target.Kind == yaml.MappingNode:
+		merged := ownCopy(dst)
+		mergeMapping(merged, resolved)
+
+		return merged
+
+	case resolved.Kind == yaml.SequenceNode && target.Kind == yaml.SequenceNode &&
+		namedItems(resolved) && namedItems(target):
+		merged := ownCopy(dst)
+		mergeNamedItems(merged, resolved)
+
+		return merged
+
+	default:
+		return src
+	}
+}
+
+// mergeNamedItems merges each item of src into the item of dst with the same
+// name, appending the ones dst does not have.
+func mergeNamedItems(dst, src *yaml.Node) {
+	for _, item := range src.Content {
+		name, _ := scalarString(mapValue(item, keyName))
+		at := -1
+
+		for j, existing := range dst.Content {
+			if existingName, _ := scalarString(mapValue(existing, keyName)); existingName == name {
+				at = j
+
+				break
+			}
+		}
+
+		if at < 0 {
+			dst.Content = append(dst.Content, item)
+
+			continue
+		}
+
+		dst.Content[at] = mergeValue(dst.Content[at], item)
+	}
+}
+
+// namedItems reports whether every item of a sequence is a mapping with a
+// name, which is what makes matching them up unambiguous. An empty sequence
+// qualifies; a single unnamed item means the list is replaced whole.
+func namedItems(seq *yaml.Node) bool {
+	for _, item := range seq.Content {
+		if name, ok := scalarString(mapValue(item, keyName)); !ok || name == "" {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ownCopy is node ready to be merged into without touching anything else.
+//
+// A node reached through an alias, or one that carries an anchor, is shared
+// with every other place the anchor is used. Merging into it in place would
+// change all of them, so an overlay that resized one container group would
+// quietly resize every group the base built from the same anchor. Copying
+// first confines the change to the key the overlay named.
+func ownCopy(node *yaml.Node) *yaml.Node {
+	if node.Kind != yaml.AliasNode && node.Anchor == "" {
+		return node
+	}
+
+	return cloneNode(resolveAlias(node))
+}
+
+// cloneNode copies node and everything under it. An alias inside is copied as
+// an alias: it still names its anchor, and nothing merges into it through the
+// copy without going through ownCopy again.
+func cloneNode(node *yaml.Node) *yaml.Node {
+	clone := *node
+	clone.Anchor = ""
+	clone.Content = make([]*yaml.Node, len(node.Content))
+
+	for i, child := range node.Content {
+		if child.Kind == yaml.AliasNode {
+			clone.Content[i] = child
+
+			continue
+		}
+
+		clone.Content[i] = cloneNode(child)
+	}
+
+	return &clone
+}
+
+// keyIndex is the position of key in a mapping's Content, -1 when absent.
+func keyIndex(mapping *yaml.Node, key string) int {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// deleteKey removes key from a mapping, if it is there.
+func deleteKey(mapping *yaml.Node, key string) {
+	if at := keyIndex(mapping, key); at >= 0 {
+		mapping.Content = append(mapping.Content[:at], mapping.Content[at+2:]...)
+	}
+}
+
+// isNull reports whether node is an explicit YAML null.
+func isNull(node *yaml.Node) bool {
+	node = resolveAlias(node)
+
+	return node != nil && node.Kind == yaml.ScalarNode && node.Tag == nullTag
+}
@@ -0,0 +1,270 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifest
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeOverlay drops content beside the manifest at path as env's overlay.
+func writeOverlay(t *testing.T, path, env, content string) string {
+	t.Helper()
+
+	overlayPath := EnvironmentPath(path, env)
+	require.NoError(t, os.WriteFile(overlayPath, []byte(content), 0o600))
+
+	return overlayPath
+}
+
+// compiledPayload is the compiled create payload, decoded.
+func compiledPayload(t *testing.T, m *Manifest) map[string]any {
+	t.Helper()
+
+	compiled, err := m.Compile()
+	require.NoError(t, err)
+
+	var payload map[string]any
+	require.NoError(t, json.Unmarshal(compiled.Payload, &payload))
+
+	return payload
+}
+
+func TestEnvironmentPath(t *testing.T) {
+	assert.Equal(t,
+		filepath.Join("project", ".datarobot.staging.yaml"),
+		EnvironmentPath(filepath.Join("project", FileName), "staging"))
+}
+
+func TestValidateEnvironmentName(t *testing.T) {
+	for _, name := range []string{"staging", "prod-eu", "dev_2"} {
+		assert.NoError(t, ValidateEnvironmentName(name), name)
+	}
+
+	for _, name := range []string{"", "Staging", "../prod", "a.b", "-x"} {
+		assert.Error(t, ValidateEnvironmentName(name), name)
+	}
+}
+
+func TestLoadEnvironment_EmptyIsTheManifestAlone(t *testing.T) {
+	path := writeManifest(t, t.TempDir(), validManifest)
+
+	m, err := LoadEnvironment(path, "")
+	require.NoError(t, err)
+
+	assert.Equal(t, "68b0aaaa0000000000000001", m.WorkloadID())
+	assert.Equal(t, path, m.BindingPath())
+}
+
+func TestLoadEnvironment_MissingOverlayIsNamed(t *testing.T) {
+	path := writeManifest(t, t.TempDir(), validManifest)
+
+	_, err := LoadEnvironment(path, "staging")
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrNoEnvironment))
+	assert.Contains(t, err.Error(), ".datarobot.staging.yaml")
+}
+
+// TestLoadEnvironment_MergesOntoTheBase covers each merge rule at once:
+// scalars replace, mappings merge, named lists merge item by item, and a
+// null removes.
+func TestLoadEnvironment_MergesOntoTheBase(t *testing.T) {
+	path := writeManifest(t, t.TempDir(), validManifest)
+	writeOverlay(t, path, "staging", `name: my-app-staging
+importance: null
+artifact:
+  spec:
+    containerGroups:
+      - name: default
+        containers:
+          - name: primary
+            environmentVars:
+              - name: LOG_LEVEL
+                value: info
+runtime:
+  containerGroups:
+    - name: default
+      replicaCount: 1
+`)
+
+	m, err := LoadEnvironment(path, "staging")
+	require.NoError(t, err)
+	require.NoError(t, m.Validate())
+
+	payload := compiledPayload(t, m)
+
+	assert.Equal(t, "my-app-staging", payload["name"])
+	assert.NotContains(t, payload, "importance")
+
+	group := payload["runtime"].(map[string]any)["containerGroups"].([]any)[0].(map[string]any)
+	assert.InDelta(t, 1, group["replicaCount"], 0)
+	assert.NotNil(t, group["containers"], "the base's containers survive a replica change")
+
+	spec := payload["artifact"].(map[string]any)["spec"].(map[string]any)
+	container := spec["containerGroups"].([]any)[0].(map[string]any)["containers"].([]any)[0].(map[string]any)
+
+	assert.Equal(t, "nginx:latest", container["imageUri"])
+
+	vars := container["environmentVars"].([]any)
+	require.Len(t, vars, 2, "variables merge by name rather than replacing the list")
+	assert.Equal(t, "info", vars[0].(map[string]any)["value"])
+}
+
+// TestLoadEnvironment_BindingIsPerEnvironment is the safety property: an
+// environment that has not been deployed yet must not inherit the base's
+// workload, or its first deploy would roll production.
+func TestLoadEnvironment_BindingIsPerEnvironment(t *testing.T) {
+	path := writeManifest(t, t.TempDir(), validManifest)
+	overlayPath := writeOverlay(t, path, "staging", "name: my-app-staging\n")
+
+	m, err := LoadEnvironment(path, "staging")
+	require.NoError(t, err)
+
+	assert.Empty(t, m.WorkloadID())
+	assert.Equal(t, overlayPath, m.BindingPath())
+
+	require.NoError(t, WriteWorkloadID(m.BindingPath(), "68b0dddd0000000000000004"))
+
+	m, err = LoadEnvironment(path, "staging")
+	require.NoError(t, err)
+	assert.Equal(t, "68b0dddd0000000000000004", m.WorkloadID())
+
+	base, err := Load(path)
+	require.NoError(t, err)
+	assert.Equal(t, "68b0aaaa0000000000000001", base.WorkloadID(), "the base binding is untouched")
+}
+
+// TestLoadEnvironment_FindingsNameTheFileThatSetTheValue is the line-number
+// promise: a bad value from the overlay points into the overlay, and one
+// from the base still points into the base.
+func TestLoadEnvironment_FindingsNameTheFileThatSetTheValue(t *testing.T) {
+	path := writeManifest(t, t.TempDir(), validManifest)
+	overlayPath := writeOverlay(t, path, "staging", `runtime:
+  containerGroups:
+    - name: default
+      containers:
+        - name: primary
+          resourceAllocation:
+            memory: 1Gi
+`)
+
+	m, err := LoadEnvironment(path, "staging")
+	require.NoError(t, err)
+
+	var validationErr *ValidationError
+
+	require.ErrorAs(t, m.Validate(), &validationErr)
+	require.NotEmpty(t, validationErr.Errors)
+
+	finding := validationErr.Errors[0]
+	assert.Equal(t, overlayPath, finding.File)
+	assert.Equal(t, 7, finding.Line)
+	assert.Contains(t, finding.Error(), ".datarobot.staging.yaml line 7")
+	assert.Contains(t, validationErr.Error(), "(environment staging)")
+}
+
+func TestLoadEnvironment_CredentialRefsRememberTheirFile(t *testing.T) {
+	path := writeManifest(t, t.TempDir(), validManifest)
+	writeOverlay(t, path, "staging", `artifact:
+  spec:
+    containerGroups:
+      - name: default
+        containers:
+          - name: primary
+            environmentVars:
+              - name: OPENAI_API_KEY
+                value: dr-credential:68f0eeee0000000000000005/apiToken
+`)
+
+	m, err := LoadEnvironment(path, "staging")
+	require.NoError(t, err)
+
+	compiled, err := m.Compile()
+	require.NoError(t, err)
+	require.Len(t, compiled.CredentialRefs, 1)
+
+	ref := compiled.CredentialRefs[0]
+	assert.Equal(t, "68f0eeee0000000000000005", ref.CredentialID)
+	assert.Equal(t, 9, ref.Line)
+	assert.Equal(t, ".datarobot.staging.yaml", ref.SourceFile())
+}
+
+// TestLoadEnvironment_AnchoredNodesAreNotChangedEverywhere guards the
+// aliasing trap: merging into a node the base shares through an anchor must
+// change only the place the overlay named.
+func TestLoadEnvironment_AnchoredNodesAreNotChangedEverywhere(t *testing.T) {
+	path := writeManifest(t, t.TempDir(), `name: my-app
+artifact:
+  spec:
+    containerGroups:
+      - name: default
+        containers:
+          - name: primary
+            primary: true
+            port: 8080
+            imageUri: nginx:latest
+      - name: worker
+        containers:
+          - name: worker
+            imageUri: nginx:latest
+runtime:
+  containerGroups:
+    - name: default
+      replicaCount: 2
+      containers: &sizing
+        - name: primary
+          resourceAllocation:
+            cpu: 1
+    - name: worker
+      replicaCount: 2
+      containers:
+        - name: worker
+          resourceAllocation:
+            cpu: 1
+  other: *sizing
+`)
+	writeOverlay(t, path, "staging", `runtime:
+  containerGroups:
+    - name: default
+      containers:
+        - name: primary
+          resourceAllocation:
+            cpu: 0.5
+`)
+
+	m, err := LoadEnvironment(path, "staging")
+	require.NoError(t, err)
+
+	runtime := compiledPayload(t, m)["runtime"].(map[string]any)
+	group := runtime["containerGroups"].([]any)[0].(map[string]any)
+	allocation := group["containers"].([]any)[0].(map[string]any)["resourceAllocation"].(map[string]any)
+	assert.InDelta(t, 0.5, allocation["cpu"], 0)
+
+	shared := runtime["other"].([]any)[0].(map[string]any)["resourceAllocation"].(map[string]any)
+	assert.InDelta(t, 1, shared["cpu"], 0, "the anchor's other use keeps the base value")
+}
+
+func TestLoadEnvironment_InvalidName(t *testing.T) {
+	path := writeManifest(t, t.TempDir(), validManifest)
+
+	_, err := LoadEnvironment(path, "../prod")
+	require.Error(t, err)
+}
@@ -0,0 +1,159 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifest
+
+import (
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Position is where in the files a value was written.
+type Position struct {
+	// File is the file that set the value: the manifest, or the environment
+	// overlay that replaced it. "" for a manifest that was parsed rather
+	// than loaded.
+	File string
+
+	// Line is 1-based. For a path the file does not spell out, it is the
+	// line of the nearest enclosing key that it does, so a reader still
+	// lands in the right block.
+	Line int
+
+	// Exact is false when Line belongs to an enclosing key rather than to
+	// the path itself.
+	Exact bool
+}
+
+// PositionOf finds the line a dotted path sits on, in the spelling the plan
+// uses: keys joined by dots, and name-keyed list items addressed by name in
+// brackets, e.g. runtime.containerGroups[default].replicaCount. A bracket
+// holding a number that matches no name is read as an index.
+//
+// An empty Position means not even the first key of the path is in the file.
+func (m *Manifest) PositionOf(path string) Position {
+	node := m.root
+	found := Position{}
+
+	for _, segment := range splitPath(path) {
+		next, written := step(node, segment)
+		if next == nil {
+			return found
+		}
+
+		node = next
+		found = m.positionAt(written)
+	}
+
+	found.Exact = found.Line > 0
+
+	return found
+}
+
+// positionAt is where node itself was written.
+func (m *Manifest) positionAt(node *yaml.Node) Position {
+	file := m.origins[node]
+	if file == "" {
+		file = m.Path
+	}
+
+	return Position{File: file, Line: node.Line}
+}
+
+// pathSegment is one step of a path: a key, or a list item by name.
+type pathSegment struct {
+	key  string
+	item bool
+}
+
+// step follows one segment down from node, nil when the file has nothing
+// there. written is the node to point a reader at: the key rather than its
+// value, because a block value starts on the line after the key that names
+// it, and the key is what the reader is looking for.
+func step(node *yaml.Node, segment pathSegment) (next, written *yaml.Node) {
+	if !segment.item {
+		node = resolveAlias(node)
+		if node == nil || node.Kind != yaml.MappingNode {
+			return nil, nil
+		}
+
+		at := keyIndex(node, segment.key)
+		if at < 0 {
+			return nil, nil
+		}
+
+		return resolveAlias(node.Content[at+1]), node.Content[at]
+	}
+
+	items := seqItems(node)
+
+	for _, item := range items {
+		if name, ok := scalarString(mapValue(item, keyName)); ok && name == segment.key {
+			return item, item
+		}
+	}
+
+	if index, err := strconv.Atoi(segment.key); err == nil && index >= 0 && index < len(items) {
+		return items[index], items[index]
+	}
+
+	return nil, nil
+}
+
+// splitPath breaks a path into its segments. Names inside brackets are taken
+// whole, dots and all, because a container is free to call itself "api.v2".
+func splitPath(path string) []pathSegment {
+	var (
+		segments []pathSegment
+		current  strings.Builder
+	)
+
+	flush := func() {
+		if current.Len() > 0 {
+			segments = append(segments, pathSegment{key: current.String()})
+			current.Reset()
+		}
+	}
+
+	for i := 0; i < len(path); i++ {
+		switch path[i] {
+		case '.':
+			flush()
+
+		case '[':
+			flush()
+
+			end := strings.IndexByte(path[i:], ']')
+			if end < 0 {
+				current.WriteString(path[i:])
+
+				i = len(path)
+
+				continue
+			}
+
+			segments = append(segments, pathSegment{key: path[i+1 : i+end], item: true})
+			i += end
+
+		default:
+			current.WriteByte(path[i])
+		}
+	}
+
+	flush()
+
+	return segments
+}
@@ -0,0 +1,78 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPositionOf_FollowsKeysAndNames(t *testing.T) {
+	path := writeManifest(t, t.TempDir(), validManifest)
+
+	m, err := Load(path)
+	require.NoError(t, err)
+
+	got := m.PositionOf("runtime.containerGroups[default].replicaCount")
+	assert.Equal(t, Position{File: path, Line: 24, Exact: true}, got)
+
+	got = m.PositionOf("artifact.spec.containerGroups[default].containers[primary].environmentVars[LOG_LEVEL].value")
+	assert.Equal(t, 18, got.Line)
+	assert.True(t, got.Exact)
+
+	got = m.PositionOf("artifact.spec.containerGroups[0].containers[0].port")
+	assert.Equal(t, 14, got.Line, "a number that names nothing is an index")
+}
+
+// TestPositionOf_MissingFallsBackToTheEnclosingKey is what lets a field the
+// file does not name still point somewhere: the block it would go in.
+func TestPositionOf_MissingFallsBackToTheEnclosingKey(t *testing.T) {
+	path := writeManifest(t, t.TempDir(), validManifest)
+
+	m, err := Load(path)
+	require.NoError(t, err)
+
+	got := m.PositionOf("runtime.containerGroups[default].containers[sidecar].resourceAllocation")
+	assert.Equal(t, 25, got.Line)
+	assert.False(t, got.Exact)
+
+	assert.Equal(t, Position{}, m.PositionOf("nowhere.at.all"))
+}
+
+func TestPositionOf_NamesTheOverlay(t *testing.T) {
+	path := writeManifest(t, t.TempDir(), validManifest)
+	overlayPath := writeOverlay(t, path, "staging", `runtime:
+  containerGroups:
+    - name: default
+      replicaCount: 1
+`)
+
+	m, err := LoadEnvironment(path, "staging")
+	require.NoError(t, err)
+
+	assert.Equal(t, Position{File: overlayPath, Line: 4, Exact: true},
+		m.PositionOf("runtime.containerGroups[default].replicaCount"))
+	assert.Equal(t, path, m.PositionOf("artifact.spec").File)
+}
+
+func TestSplitPath_KeepsDotsInsideNames(t *testing.T) {
+	assert.Equal(t, []pathSegment{
+		{key: "containers"},
+		{key: "api.v2", item: true},
+		{key: "port"},
+	}, splitPath("containers[api.v2].port"))
+}
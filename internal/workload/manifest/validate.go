@@ -153,7 +153,7 @@ const MinPrimaryPort = minPrimaryPort
 // verifying that each one exists is a GET the caller makes with
 // Compiled.CredentialRefs.
 func (m *Manifest) Validate() error {
-	v := &validator{dir: m.Dir}
+	v := &validator{dir: m.Dir, origins: m.origins}
 
 	v.checkName(m.root)
 	v.checkArtifactBinding(m.root)
@@ -161,7 +161,7 @@ func (m *Manifest) Validate() error {
 	groups := v.checkArtifact(mapValue(m.root, keyArtifact))
 	v.checkRuntime(mapValue(m.root, keyRuntime), groups)
 
-	if _, errs := collectCredentialRefs(m.root); len(errs) > 0 {
+	if _, errs := collectCredentialRefs(m.root, m.origins); len(errs) > 0 {
 		v.errs = append(v.errs, errs...)
 	}
 
@@ -169,7 +169,11 @@ func (m *Manifest) Validate() error {
 		return nil
 	}
 
-	slices.SortStableFunc(v.errs, func(a, b FieldError) int { return cmp.Compare(a.Line, b.Line) })
+	// The manifest's own findings first, then each overlay's, each in line
+	// order: lines from two files interleaved would read as one file.
+	slices.SortStableFunc(v.errs, func(a, b FieldError) int {
+		return cmp.Or(cmp.Compare(a.File, b.File), cmp.Compare(a.Line, b.Line))
+	})
 
 	return &ValidationError{File: m.fileLabel(), Errors: v.errs}
 }
@@ -179,22 +183,26 @@ type validator struct {
 	// dir anchors file-existence rules; "" skips them.
 	dir  string
 	errs []FieldError
+
+	// origins is Manifest.origins: which nodes an overlay contributed.
+	origins map[*yaml.Node]string
 }
 
 // add records a finding at node's line. A nil node means the key is missing
 // entirely, in which case anchor names the nearest node that does exist so
 // the message still points somewhere useful.
 func (v *validator) add(node, anchor *yaml.Node, path, format string, args ...any) {
-	line := 0
+	at := anchor
+	if node != nil {
+		at = node
+	}
 
-	switch {
-	case node != nil:
-		line = node.Line
-	case anchor != nil:
-		line = anchor.Line
+	line := 0
+	if at != nil {
+		line = at.Line
 	}
 
-	v.errs = append(v.errs, FieldError{Path: path, Line: line, Msg: fmt.Sprintf(format, args...)})
+	v.errs = append(v.errs, FieldError{Path: path, Line: line, Msg: fmt.Sprintf(format, args...), File: v.origins[at]})
 }
 
 // checkName holds the one field the create endpoint always needs.
@@ -15,6 +15,8 @@
 package workload
 
 import (
+	"context"
+
 	"github.com/datarobot/cli/internal/config"
 	"github.com/datarobot/cli/internal/drapi"
 )
@@ -31,20 +33,20 @@ type WorkloadOperationResponse struct {
 // StartWorkload requests an asynchronous start of a stopped workload. 202
 // queues it, 200 is an idempotent no-op (already running/initializing); 409
 // (must stop first), 403 (limits), and 404 surface as *drapi.HTTPError.
-func StartWorkload(workloadID string) (*WorkloadOperationResponse, error) {
-	return postWorkloadAction(workloadID, "start")
+func StartWorkload(ctx context.Context, workloadID string) (*WorkloadOperationResponse, error) {
+	return postWorkloadAction(ctx, workloadID, "start")
 }
 
 // StopWorkload requests an asynchronous stop of a workload. 202 queues it,
 // 200 is an idempotent no-op (already stopped); 404 surfaces as
 // *drapi.HTTPError.
-func StopWorkload(workloadID string) (*WorkloadOperationResponse, error) {
-	return postWorkloadAction(workloadID, "stop")
+func StopWorkload(ctx context.Context, workloadID string) (*WorkloadOperationResponse, error) {
+	return postWorkloadAction(ctx, workloadID, "stop")
 }
 
 // postWorkloadAction POSTs an empty JSON object to a workload action
 // sub-path (no trailing slash, unlike GetWorkload's resource route).
-func postWorkloadAction(workloadID, action string) (*WorkloadOperationResponse, error) {
+func postWorkloadAction(ctx context.Context, workloadID, action string) (*WorkloadOperationResponse, error) {
 	url, err := config.GetEndpointURL("/api/v2/workloads/" + escapeID(workloadID) + "/" + action)
 	if err != nil {
 		return nil, err
@@ -52,7 +54,7 @@ func postWorkloadAction(workloadID, action string) (*WorkloadOperationResponse,
 
 	var resp WorkloadOperationResponse
 
-	if err := drapi.PostJSON(url, "workload "+action+" request", map[string]any{}, &resp); err != nil {
+	if err := drapi.PostJSON(ctx, url, "workload "+action+" request", map[string]any{}, &resp); err != nil {
 		return nil, err
 	}
 
@@ -15,6 +15,7 @@
 package workload
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"net/http"
@@ -52,7 +53,7 @@ func TestStartWorkload_PostsEmptyBodyAndParsesResponse(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	resp, err := StartWorkload("wl-1")
+	resp, err := StartWorkload(context.Background(), "wl-1")
 	require.NoError(t, err)
 	assert.Equal(t, "started", resp.Status)
 	assert.Equal(t, "wl-1", resp.WorkloadID)
@@ -76,7 +77,7 @@ func TestStopWorkload_AlreadyStopped200Succeeds(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	resp, err := StopWorkload("wl-1")
+	resp, err := StopWorkload(context.Background(), "wl-1")
 	require.NoError(t, err)
 	assert.Equal(t, "Proton is already stopped", resp.Status)
 }
@@ -93,7 +94,7 @@ func TestStartWorkload_409SurfacesServerDetail(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	_, err := StartWorkload("wl-1")
+	_, err := StartWorkload(context.Background(), "wl-1")
 	require.Error(t, err)
 
 	var httpErr *drapi.HTTPError
@@ -114,7 +115,7 @@ func TestStopWorkload_404PropagatesAsHTTPError(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	_, err := StopWorkload("missing")
+	_, err := StopWorkload(context.Background(), "missing")
 	require.Error(t, err)
 
 	var httpErr *drapi.HTTPError
@@ -139,6 +140,6 @@ func TestStartWorkload_EscapesIDInPath(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	_, err := StartWorkload("wl-1?force=true")
+	_, err := StartWorkload(context.Background(), "wl-1?force=true")
 	require.NoError(t, err)
 }
@@ -15,6 +15,7 @@
 package workload
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -105,7 +106,7 @@ func replacementURL(workloadID string) (string, error) {
 // caller that has not already established the workload exists should use
 // GuardNoActiveReplacement, which pays for one extra request to tell the two
 // apart, rather than reading (nil, nil) as proof of anything.
-func GetActiveReplacement(workloadID string) (*Replacement, error) {
+func GetActiveReplacement(ctx context.Context, workloadID string) (*Replacement, error) {
 	url, err := replacementURL(workloadID)
 	if err != nil {
 		return nil, err
@@ -113,7 +114,7 @@ func GetActiveReplacement(workloadID string) (*Replacement, error) {
 
 	var replacement Replacement
 
-	if err := drapi.GetJSON(url, "replacement", &replacement); err != nil {
+	if err := drapi.GetJSON(ctx, url, "replacement", &replacement); err != nil {
 		if isNotFound(err) {
 			return nil, nil
 		}
@@ -143,7 +144,7 @@ func GetActiveReplacement(workloadID string) (*Replacement, error) {
 // sizing it was asked for: applying them as two operations either resizes the
 // version being rolled off, or starts the new one under the old sizing, which
 // is how a larger model meets a bundle that cannot hold it.
-func StartReplacement(workloadID, artifactID string, runtime json.RawMessage) (*Replacement, error) {
+func StartReplacement(ctx context.Context, workloadID, artifactID string, runtime json.RawMessage) (*Replacement, error) {
 	url, err := replacementURL(workloadID)
 	if err != nil {
 		return nil, err
@@ -163,7 +164,7 @@ func StartReplacement(workloadID, artifactID string, runtime json.RawMessage) (*
 
 	var replacement Replacement
 
-	if err := drapi.PostJSON(url, "replacement", body, &replacement); err != nil {
+	if err := drapi.PostJSON(ctx, url, "replacement", body, &replacement); err != nil {
 		return nil, err
 	}
 
@@ -186,15 +187,15 @@ func StartReplacement(workloadID, artifactID string, runtime json.RawMessage) (*
 // exercised against a live instance; if the platform answers 202 with a
 // "cancelling" status rather than tearing down synchronously, the first
 // caller will need to poll, and should confirm that on staging.
-func CancelReplacement(workloadID string) error {
+func CancelReplacement(ctx context.Context, workloadID string) error {
 	url, err := replacementURL(workloadID)
 	if err != nil {
 		return err
 	}
 
-	if err := drapi.DeleteJSON(url, "replacement", nil, nil); err != nil {
+	if err := drapi.DeleteJSON(ctx, url, "replacement", nil, nil); err != nil {
 		if isNotFound(err) {
-			return confirmWorkloadExists(workloadID)
+			return confirmWorkloadExists(ctx, workloadID)
 		}
 
 		return err
@@ -217,14 +218,14 @@ func CancelReplacement(workloadID string) error {
 // possibly lock an artifact before discovering the target was never real.
 // One extra request on the quiet path buys that, and the quiet path is about
 // to spend minutes rolling a workload.
-func GuardNoActiveReplacement(workloadID string) error {
-	active, err := GetActiveReplacement(workloadID)
+func GuardNoActiveReplacement(ctx context.Context, workloadID string) error {
+	active, err := GetActiveReplacement(ctx, workloadID)
 	if err != nil {
 		return err
 	}
 
 	if active == nil {
-		return confirmWorkloadExists(workloadID)
+		return confirmWorkloadExists(ctx, workloadID)
 	}
 
 	// A settled replacement stays readable for a while after it ends, and
@@ -247,8 +248,8 @@ func GuardNoActiveReplacement(workloadID string) error {
 // definite answer. The message stays factual; naming the remedy is the
 // command layer's job, since only it knows whether the id came from a flag or
 // from a manifest.
-func confirmWorkloadExists(workloadID string) error {
-	if _, err := GetWorkload(workloadID); err != nil {
+func confirmWorkloadExists(ctx context.Context, workloadID string) error {
+	if _, err := GetWorkload(ctx, workloadID); err != nil {
 		if isNotFound(err) {
 			return fmt.Errorf("workload %s does not exist or is not visible to this account", workloadID)
 		}
@@ -260,10 +261,11 @@ func confirmWorkloadExists(workloadID string) error {
 }
 
 // WaitForReplacement polls until the replacement reaches a terminal status,
-// is cleared after having been seen, or timeout expires. started is what
-// StartReplacement returned, and may be nil when attaching to a rollout this
-// process did not begin. onTick, if non-nil, is called with every poll's
-// result so a caller can show progress; it is never called with nil.
+// is cleared after having been seen, timeout expires, or ctx is cancelled.
+// started is what StartReplacement returned, and may be nil when attaching to
+// a rollout this process did not begin. onTick, if non-nil, is called with
+// every poll's result so a caller can show progress; it is never called with
+// nil.
 //
 // It returns:
 //
@@ -288,6 +290,7 @@ func confirmWorkloadExists(workloadID string) error {
 // status should ask IsTerminalReplacementStatus rather than read a nil error
 // as "completed".
 func WaitForReplacement(
+	ctx context.Context,
 	workloadID string,
 	started *Replacement,
 	interval, timeout time.Duration,
@@ -301,7 +304,7 @@ func WaitForReplacement(
 	lastSeen := started
 
 	for {
-		replacement, err := GetActiveReplacement(workloadID)
+		replacement, err := GetActiveReplacement(ctx, workloadID)
 		if err != nil {
 			return nil, fmt.Errorf("poll replacement for workload %s: %w", workloadID, err)
 		}
@@ -331,7 +334,9 @@ func WaitForReplacement(
 			return replacement, fmt.Errorf("timeout waiting for replacement on workload %s after %s", workloadID, timeout)
 		}
 
-		time.Sleep(interval)
+		if !sleepInterval(ctx, interval) {
+			return replacement, ctx.Err()
+		}
 	}
 }
 
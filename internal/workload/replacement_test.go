@@ -15,6 +15,7 @@
 package workload
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -122,7 +123,7 @@ func TestGetActiveReplacement_Decodes(t *testing.T) {
 		fmt.Fprint(w, `{"id":"rep-1","workloadId":"wl-1","candidateArtifactId":"art-2","status":"submitted","strategy":"rolling"}`)
 	})
 
-	replacement, err := GetActiveReplacement("wl-1")
+	replacement, err := GetActiveReplacement(context.Background(), "wl-1")
 	require.NoError(t, err)
 	require.NotNil(t, replacement)
 	assert.Equal(t, "art-2", replacement.ArtifactID)
@@ -134,7 +135,7 @@ func TestGetActiveReplacement_404ReturnsNilNil(t *testing.T) {
 		notFound(w)
 	})
 
-	replacement, err := GetActiveReplacement("wl-1")
+	replacement, err := GetActiveReplacement(context.Background(), "wl-1")
 	require.NoError(t, err)
 	assert.Nil(t, replacement)
 }
@@ -144,7 +145,7 @@ func TestGetActiveReplacement_OtherErrorPropagates(t *testing.T) {
 		w.WriteHeader(http.StatusInternalServerError)
 	})
 
-	_, err := GetActiveReplacement("wl-1")
+	_, err := GetActiveReplacement(context.Background(), "wl-1")
 	require.Error(t, err)
 
 	var httpErr *drapi.HTTPError
@@ -162,7 +163,7 @@ func TestReplacementRoute_EscapesID(t *testing.T) {
 		fmt.Fprint(w, `{"candidateArtifactId":"art-2","status":"submitted"}`)
 	}))
 
-	_, err := GetActiveReplacement("a/b")
+	_, err := GetActiveReplacement(context.Background(), "a/b")
 	require.NoError(t, err)
 }
 
@@ -181,7 +182,7 @@ func TestStartReplacement_PostsArtifactIDAndRollingStrategy(t *testing.T) {
 		fmt.Fprint(w, `{"candidateArtifactId":"art-2","status":"submitted"}`)
 	})
 
-	replacement, err := StartReplacement("wl-1", "art-2", nil)
+	replacement, err := StartReplacement(context.Background(), "wl-1", "art-2", nil)
 	require.NoError(t, err)
 	assert.Equal(t, "art-2", replacement.ArtifactID)
 	assert.Equal(t, "submitted", replacement.Status)
@@ -204,7 +205,7 @@ func TestStartReplacement_CarriesTheRuntimeWhenThereIsOne(t *testing.T) {
 		fmt.Fprint(w, `{"candidateArtifactId":"art-2","status":"submitted"}`)
 	})
 
-	_, err := StartReplacement("wl-1", "art-2", json.RawMessage(`{"replicaCount":3}`))
+	_, err := StartReplacement(context.Background(), "wl-1", "art-2", json.RawMessage(`{"replicaCount":3}`))
 	require.NoError(t, err)
 }
 
@@ -218,7 +219,7 @@ func TestStartReplacement_ErrorPropagates(t *testing.T) {
 		fmt.Fprint(w, `{"detail":"candidate artifact must be locked"}`)
 	})
 
-	replacement, err := StartReplacement("wl-1", "art-2", nil)
+	replacement, err := StartReplacement(context.Background(), "wl-1", "art-2", nil)
 	require.Error(t, err)
 	assert.Nil(t, replacement)
 
@@ -238,7 +239,7 @@ func TestCancelReplacement_DeletesTheRoute(t *testing.T) {
 		w.WriteHeader(http.StatusNoContent)
 	})
 
-	require.NoError(t, CancelReplacement("wl-1"))
+	require.NoError(t, CancelReplacement(context.Background(), "wl-1"))
 	assert.Equal(t, int32(1), atomic.LoadInt32(&called), "cancel must actually reach the server")
 }
 
@@ -256,7 +257,7 @@ func TestCancelReplacement_AcceptsEverySuccessCode(t *testing.T) {
 				}
 			})
 
-			assert.NoError(t, CancelReplacement("wl-1"))
+			assert.NoError(t, CancelReplacement(context.Background(), "wl-1"))
 		})
 	}
 }
@@ -269,7 +270,7 @@ func TestCancelReplacement_NothingToCancelIsSuccess(t *testing.T) {
 		notFound(w)
 	})
 
-	assert.NoError(t, CancelReplacement("wl-1"))
+	assert.NoError(t, CancelReplacement(context.Background(), "wl-1"))
 }
 
 // TestCancelReplacement_MissingWorkloadIsAnError is the other half of the
@@ -280,7 +281,7 @@ func TestCancelReplacement_MissingWorkloadIsAnError(t *testing.T) {
 		notFound(w)
 	}))
 
-	err := CancelReplacement("wl-1")
+	err := CancelReplacement(context.Background(), "wl-1")
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "does not exist")
 }
@@ -290,7 +291,7 @@ func TestCancelReplacement_OtherErrorPropagates(t *testing.T) {
 		w.WriteHeader(http.StatusConflict)
 	})
 
-	err := CancelReplacement("wl-1")
+	err := CancelReplacement(context.Background(), "wl-1")
 	require.Error(t, err)
 
 	var httpErr *drapi.HTTPError
@@ -304,7 +305,7 @@ func TestGuardNoActiveReplacement_PassesWhenNoneActive(t *testing.T) {
 		notFound(w)
 	})
 
-	assert.NoError(t, GuardNoActiveReplacement("wl-1"))
+	assert.NoError(t, GuardNoActiveReplacement(context.Background(), "wl-1"))
 }
 
 // TestGuardNoActiveReplacement_MissingWorkloadFailsFast is the whole reason
@@ -317,7 +318,7 @@ func TestGuardNoActiveReplacement_MissingWorkloadFailsFast(t *testing.T) {
 		notFound(w)
 	}))
 
-	err := GuardNoActiveReplacement("wl-1")
+	err := GuardNoActiveReplacement(context.Background(), "wl-1")
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "does not exist")
 }
@@ -338,7 +339,7 @@ func TestGuardNoActiveReplacement_SkipsTheExistenceCheckWhenOneIsActive(t *testi
 
 	serveAPI(t, mux)
 
-	err := GuardNoActiveReplacement("wl-1")
+	err := GuardNoActiveReplacement(context.Background(), "wl-1")
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "art-9")
 	assert.Contains(t, err.Error(), "switching")
@@ -362,7 +363,7 @@ func TestGuardNoActiveReplacement_SettledReplacementDoesNotBlock(t *testing.T) {
 				fmt.Fprintf(w, `{"candidateArtifactId":"art-2","status":"%s"}`, status)
 			})
 
-			assert.NoError(t, GuardNoActiveReplacement("wl-1"))
+			assert.NoError(t, GuardNoActiveReplacement(context.Background(), "wl-1"))
 		})
 	}
 }
@@ -372,7 +373,7 @@ func TestGuardNoActiveReplacement_PropagatesLookupFailure(t *testing.T) {
 		w.WriteHeader(http.StatusInternalServerError)
 	})
 
-	err := GuardNoActiveReplacement("wl-1")
+	err := GuardNoActiveReplacement(context.Background(), "wl-1")
 	require.Error(t, err)
 
 	var httpErr *drapi.HTTPError
@@ -393,7 +394,7 @@ func TestWaitForReplacement_TerminalCompletedReturnsNoError(t *testing.T) {
 		fmt.Fprintf(w, `{"candidateArtifactId":"art-2","status":"%s"}`, status)
 	})
 
-	replacement, err := WaitForReplacement("wl-1", nil, time.Millisecond, time.Second, nil)
+	replacement, err := WaitForReplacement(context.Background(), "wl-1", nil, time.Millisecond, time.Second, nil)
 	require.NoError(t, err)
 	assert.Equal(t, ReplacementStatusCompleted, replacement.Status)
 }
@@ -403,7 +404,7 @@ func TestWaitForReplacement_FailedReturnsError(t *testing.T) {
 		fmt.Fprint(w, `{"candidateArtifactId":"art-2","status":"failed"}`)
 	})
 
-	replacement, err := WaitForReplacement("wl-1", nil, time.Millisecond, time.Second, nil)
+	replacement, err := WaitForReplacement(context.Background(), "wl-1", nil, time.Millisecond, time.Second, nil)
 	require.Error(t, err)
 	require.NotNil(t, replacement, "a failure returns the final replacement alongside the error")
 	assert.Equal(t, ReplacementStatusFailed, replacement.Status)
@@ -428,7 +429,7 @@ func TestWaitForReplacement_ErroredClearedViaNotFound(t *testing.T) {
 		notFound(w)
 	})
 
-	replacement, err := WaitForReplacement("wl-1", nil, time.Millisecond, time.Second, nil)
+	replacement, err := WaitForReplacement(context.Background(), "wl-1", nil, time.Millisecond, time.Second, nil)
 	require.Error(t, err, "an errored candidate must not read as success just because it was later cleared")
 	require.NotNil(t, replacement)
 	assert.Equal(t, ReplacementStatusErrored, replacement.Status)
@@ -441,7 +442,7 @@ func TestWaitForReplacement_NotFoundOnFirstPollIsError(t *testing.T) {
 		notFound(w)
 	})
 
-	replacement, err := WaitForReplacement("wl-1", nil, time.Millisecond, time.Second, nil)
+	replacement, err := WaitForReplacement(context.Background(), "wl-1", nil, time.Millisecond, time.Second, nil)
 	require.Error(t, err)
 	assert.Nil(t, replacement)
 	assert.Contains(t, err.Error(), "no replacement is in flight")
@@ -468,7 +469,7 @@ func TestWaitForReplacement_StartedSeedsTheWait(t *testing.T) {
 
 	started := &Replacement{ArtifactID: "art-2", Status: "submitted"}
 
-	replacement, err := WaitForReplacement("wl-1", started, time.Millisecond, time.Second, func(*Replacement) {
+	replacement, err := WaitForReplacement(context.Background(), "wl-1", started, time.Millisecond, time.Second, func(*Replacement) {
 		t.Error("onTick must not fire when the seed carries the wait")
 	})
 	require.NoError(t, err)
@@ -494,7 +495,7 @@ func TestWaitForReplacement_NonTerminalClearedViaNotFoundIsSuccess(t *testing.T)
 		notFound(w)
 	})
 
-	replacement, err := WaitForReplacement("wl-1", nil, time.Millisecond, time.Second, nil)
+	replacement, err := WaitForReplacement(context.Background(), "wl-1", nil, time.Millisecond, time.Second, nil)
 	require.NoError(t, err)
 	require.NotNil(t, replacement)
 	assert.Equal(t, "switching", replacement.Status)
@@ -509,7 +510,7 @@ func TestWaitForReplacement_TimeoutReturnsTheLastSeen(t *testing.T) {
 		fmt.Fprint(w, `{"candidateArtifactId":"art-2","status":"candidate-warming"}`)
 	})
 
-	replacement, err := WaitForReplacement("wl-1", nil, 5*time.Millisecond, 25*time.Millisecond, nil)
+	replacement, err := WaitForReplacement(context.Background(), "wl-1", nil, 5*time.Millisecond, 25*time.Millisecond, nil)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "timeout")
 	require.NotNil(t, replacement, "a timeout must still say what the rollout was doing")
@@ -527,7 +528,7 @@ func TestWaitForReplacement_PollsAtLeastOnce(t *testing.T) {
 		fmt.Fprint(w, `{"candidateArtifactId":"art-2","status":"completed"}`)
 	})
 
-	replacement, err := WaitForReplacement("wl-1", nil, time.Millisecond, 0, nil)
+	replacement, err := WaitForReplacement(context.Background(), "wl-1", nil, time.Millisecond, 0, nil)
 	require.NoError(t, err)
 	assert.Equal(t, ReplacementStatusCompleted, replacement.Status)
 	assert.Equal(t, int32(1), atomic.LoadInt32(&hits), "a zero budget still buys one look")
@@ -544,7 +545,7 @@ func TestWaitForReplacement_NonPositiveIntervalDoesNotSpin(t *testing.T) {
 		fmt.Fprint(w, `{"candidateArtifactId":"art-2","status":"switching"}`)
 	})
 
-	_, err := WaitForReplacement("wl-1", nil, 0, 10*time.Millisecond, nil)
+	_, err := WaitForReplacement(context.Background(), "wl-1", nil, 0, 10*time.Millisecond, nil)
 	require.Error(t, err)
 	assert.LessOrEqual(t, atomic.LoadInt32(&hits), int32(2),
 		"a non-positive interval must fall back to the default, not busy-spin")
@@ -564,7 +565,7 @@ func TestWaitForReplacement_OnTickSeesEveryPoll(t *testing.T) {
 
 	var seen []string
 
-	_, err := WaitForReplacement("wl-1", nil, time.Millisecond, time.Second, func(r *Replacement) {
+	_, err := WaitForReplacement(context.Background(), "wl-1", nil, time.Millisecond, time.Second, func(r *Replacement) {
 		require.NotNil(t, r, "onTick is never handed a nil")
 
 		seen = append(seen, r.Status)
@@ -575,11 +576,13 @@ func TestWaitForReplacement_OnTickSeesEveryPoll(t *testing.T) {
 }
 
 func TestWaitForReplacement_PropagatesPollFailure(t *testing.T) {
+	disableRetries(t)
+
 	serveReplacement(t, func(w http.ResponseWriter, _ *http.Request) {
 		w.WriteHeader(http.StatusBadGateway)
 	})
 
-	_, err := WaitForReplacement("wl-1", nil, time.Millisecond, time.Second, nil)
+	_, err := WaitForReplacement(context.Background(), "wl-1", nil, time.Millisecond, time.Second, nil)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "poll replacement")
 }
@@ -15,6 +15,7 @@
 package workload
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 
@@ -38,7 +39,7 @@ import (
 // only starts the work, and the caller has to follow the replacement to know
 // whether the new sizing was promoted. The workload's own status is no help,
 // since it stays running throughout.
-func UpdateWorkloadSettings(workloadID string, runtime json.RawMessage) (*Replacement, error) {
+func UpdateWorkloadSettings(ctx context.Context, workloadID string, runtime json.RawMessage) (*Replacement, error) {
 	if len(runtime) == 0 {
 		return nil, errors.New("no runtime settings to update")
 	}
@@ -52,7 +53,7 @@ func UpdateWorkloadSettings(workloadID string, runtime json.RawMessage) (*Replac
 
 	var started Replacement
 
-	if err := drapi.PatchJSON(url, "workload settings", body, &started); err != nil {
+	if err := drapi.PatchJSON(ctx, url, "workload settings", body, &started); err != nil {
 		return nil, err
 	}
 
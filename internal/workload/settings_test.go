@@ -15,6 +15,7 @@
 package workload
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -43,7 +44,7 @@ func TestUpdateWorkloadSettings_PatchesTheRuntimeBlock(t *testing.T) {
 		fmt.Fprint(w, `{"id":"rep-9","workloadId":"wl-1","candidateArtifactId":"art-1","status":"pending"}`)
 	}))
 
-	started, err := UpdateWorkloadSettings("wl-1", json.RawMessage(`{"replicaCount":3}`))
+	started, err := UpdateWorkloadSettings(context.Background(), "wl-1", json.RawMessage(`{"replicaCount":3}`))
 	require.NoError(t, err)
 	assert.Equal(t, "rep-9", started.ID, "the replacement is the handle the caller has to follow")
 	assert.Equal(t, "wl-1", started.WorkloadID)
@@ -59,7 +60,7 @@ func TestUpdateWorkloadSettings_AcceptsTheAcceptedStatus(t *testing.T) {
 		fmt.Fprint(w, `{"id":"rep-9","workloadId":"wl-1","status":"pending"}`)
 	}))
 
-	started, err := UpdateWorkloadSettings("wl-1", json.RawMessage(`{"replicaCount":3}`))
+	started, err := UpdateWorkloadSettings(context.Background(), "wl-1", json.RawMessage(`{"replicaCount":3}`))
 	require.NoError(t, err, "202 is how this route says yes")
 	assert.Equal(t, "rep-9", started.ID)
 }
@@ -72,7 +73,7 @@ func TestUpdateWorkloadSettings_EscapesTheID(t *testing.T) {
 		fmt.Fprint(w, `{"id":"a/b"}`)
 	}))
 
-	_, err := UpdateWorkloadSettings("a/b", json.RawMessage(`{"replicaCount":1}`))
+	_, err := UpdateWorkloadSettings(context.Background(), "a/b", json.RawMessage(`{"replicaCount":1}`))
 	require.NoError(t, err)
 }
 
@@ -83,7 +84,7 @@ func TestUpdateWorkloadSettings_RefusesAnEmptyRuntime(t *testing.T) {
 		t.Fatal("nothing to change must not reach the platform")
 	}))
 
-	_, err := UpdateWorkloadSettings("wl-1", nil)
+	_, err := UpdateWorkloadSettings(context.Background(), "wl-1", nil)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "no runtime settings")
 }
@@ -94,7 +95,7 @@ func TestUpdateWorkloadSettings_ErrorCarriesTheServersReason(t *testing.T) {
 		fmt.Fprint(w, `{"detail":"replicaCount and autoscaling are mutually exclusive"}`)
 	}))
 
-	_, err := UpdateWorkloadSettings("wl-1", json.RawMessage(`{"replicaCount":3}`))
+	_, err := UpdateWorkloadSettings(context.Background(), "wl-1", json.RawMessage(`{"replicaCount":3}`))
 	require.Error(t, err)
 
 	var httpErr *drapi.HTTPError
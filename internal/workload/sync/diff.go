@@ -53,6 +53,7 @@ func Diff(base, local, remote BaseManifest) *SyncPlan {
 			RemoteSize:     r.Size,
 			LocalHash:      l.Hash,
 			RemoteHash:     r.Hash,
+			BaseHash:       b.Hash,
 		})
 	}
 
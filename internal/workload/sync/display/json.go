@@ -39,6 +39,8 @@ type FileActionJSON struct {
 	RemoteSize     int64  `json:"remoteSize,omitempty"`
 	LocalHash      string `json:"localHash,omitempty"`
 	RemoteHash     string `json:"remoteHash,omitempty"`
+	Resolution     string `json:"resolution,omitempty"`
+	ConflictHunks  int    `json:"conflictHunks,omitempty"`
 }
 
 type PlanStatsJSON struct {
@@ -91,6 +93,8 @@ type ResultJSON struct {
 	DeletedCount    int      `json:"deletedCount"`
 	ConflictCount   int      `json:"conflictCount"`
 	ConflictCopies  []string `json:"conflictCopies,omitempty"`
+	Merged          []string `json:"merged,omitempty"`
+	Unresolved      []string `json:"unresolved,omitempty"`
 	DurationMS      int64    `json:"durationMs"`
 }
 
@@ -111,6 +115,8 @@ func RenderResultJSON(w io.Writer, r *sync.Result) error {
 		DeletedCount:    r.DeletedCount,
 		ConflictCount:   r.ConflictCount,
 		ConflictCopies:  r.ConflictCopies,
+		Merged:          r.Merged,
+		Unresolved:      r.Unresolved,
 		DurationMS:      r.Duration.Milliseconds(),
 	}
 
@@ -133,6 +139,11 @@ func actionsJSON(in []sync.FileAction) []FileActionJSON {
 			LocalHash:      fa.LocalHash,
 			RemoteHash:     fa.RemoteHash,
 		}
+
+		if fa.Classification.IsConflict() {
+			out[i].Resolution = fa.Resolution.String()
+			out[i].ConflictHunks = fa.ConflictHunks
+		}
 	}
 
 	return out
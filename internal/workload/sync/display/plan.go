@@ -44,11 +44,59 @@ func PrintPlan(w io.Writer, plan *sync.SyncPlan) error {
 		return err
 	}
 
-	if err := printGroup(w, "⚠ CONFLICT (remote wins)", plan.Conflicts, markerForConflict); err != nil {
+	return printConflicts(w, plan.Conflicts)
+}
+
+// printConflicts is printGroup for the conflict rows, which also say how
+// each conflict will be settled.
+func printConflicts(w io.Writer, files []sync.FileAction) error {
+	if len(files) == 0 {
+		return nil
+	}
+
+	if _, err := fmt.Fprintf(w, "  ⚠ CONFLICT (%d):\n", len(files)); err != nil {
 		return err
 	}
 
-	return nil
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	for _, fa := range files {
+		size := formatSize(displaySize(fa))
+
+		if _, err := fmt.Fprintf(tw, "    %s\t%s\t%s\t%s\n", markerForConflict(fa), fa.Path, size, ResolutionNote(fa)); err != nil {
+			return err
+		}
+	}
+
+	return tw.Flush()
+}
+
+// ResolutionNote says in a few words what the sync does with a conflict row.
+func ResolutionNote(fa sync.FileAction) string {
+	deleted := fa.Classification == sync.ClsDelEditConflict
+
+	switch fa.Resolution {
+	case sync.ResolveRemoteCopy:
+		if deleted {
+			return "deleted remotely; local saved as .LOCAL copy"
+		}
+
+		return "remote wins; local saved as .LOCAL copy"
+	case sync.ResolveTheirs:
+		if deleted {
+			return "delete local (deleted remotely)"
+		}
+
+		return "take remote"
+	case sync.ResolveOurs:
+		return "keep local"
+	case sync.ResolveMerged:
+		return "merged cleanly"
+	case sync.ResolveMarkers:
+		return fmt.Sprintf("%d conflict(s) marked in file", fa.ConflictHunks)
+	}
+
+	return ""
 }
 
 func printGroup(w io.Writer, header string, files []sync.FileAction, marker func(sync.FileAction) string) error {
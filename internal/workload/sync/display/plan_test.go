@@ -45,7 +45,8 @@ func TestPrintPlan_Full(t *testing.T) {
 			{Path: "old.py", Classification: sync.ClsLocalDeleted, Action: sync.ActUploadDelete, LocalSize: 0},
 		},
 		Conflicts: []sync.FileAction{
-			{Path: "shared.py", Classification: sync.ClsConflict, Action: sync.ActConflictCopy, RemoteSize: 100},
+			{Path: "shared.py", Classification: sync.ClsConflict, Action: sync.ActConflictCopy, RemoteSize: 100, Resolution: sync.ResolveMarkers, ConflictHunks: 2},
+			{Path: "notes.md", Classification: sync.ClsConflict, Action: sync.ActConflictCopy, RemoteSize: 10, Resolution: sync.ResolveMerged},
 		},
 	}
 
@@ -60,8 +61,26 @@ func TestPrintPlan_Full(t *testing.T) {
 	assert.Contains(t, out, "A  new.py")
 	assert.Contains(t, out, "↓ DOWNLOAD (1):")
 	assert.Contains(t, out, "✕ DELETE (1):")
-	assert.Contains(t, out, "⚠ CONFLICT (remote wins) (1):")
-	assert.Contains(t, out, "shared.py")
+	assert.Contains(t, out, "⚠ CONFLICT (2):")
+	assert.Regexp(t, `shared\.py +100 B +2 conflict\(s\) marked in file`, out)
+	assert.Regexp(t, `notes\.md +10 B +merged cleanly`, out)
+}
+
+func TestResolutionNote(t *testing.T) {
+	tests := []struct {
+		fa   sync.FileAction
+		want string
+	}{
+		{sync.FileAction{Classification: sync.ClsConflict, Resolution: sync.ResolveRemoteCopy}, "remote wins; local saved as .LOCAL copy"},
+		{sync.FileAction{Classification: sync.ClsDelEditConflict, Resolution: sync.ResolveRemoteCopy}, "deleted remotely; local saved as .LOCAL copy"},
+		{sync.FileAction{Classification: sync.ClsConflict, Resolution: sync.ResolveTheirs}, "take remote"},
+		{sync.FileAction{Classification: sync.ClsDelEditConflict, Resolution: sync.ResolveTheirs}, "delete local (deleted remotely)"},
+		{sync.FileAction{Classification: sync.ClsAddConflict, Resolution: sync.ResolveOurs}, "keep local"},
+	}
+
+	for _, tc := range tests {
+		assert.Equal(t, tc.want, ResolutionNote(tc.fa))
+	}
 }
 
 func TestPrintResult_FullCounts(t *testing.T) {
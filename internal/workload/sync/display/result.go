@@ -30,16 +30,7 @@ func PrintResult(w io.Writer, r *sync.Result) error {
 		return nil
 	}
 
-	old := sync.ShortVer(r.OldVersion)
-	if old == "" {
-		old = "∅"
-	}
-
-	newVer := sync.ShortVer(r.NewVersion)
-	counts := formatCounts(r)
-
-	line := fmt.Sprintf("Sync complete: %s → %s  %s", old, newVer, counts)
-	styled := tui.SuccessStyle.Render(line)
+	styled := tui.SuccessStyle.Render("Sync complete: " + ResultSummary(r))
 
 	if _, err := fmt.Fprintln(w, styled); err != nil {
 		return err
@@ -53,9 +44,36 @@ func PrintResult(w io.Writer, r *sync.Result) error {
 		}
 	}
 
+	if len(r.Merged) > 0 {
+		_, _ = fmt.Fprintln(w, tui.DimStyle.Render("Merged and uploaded:"))
+
+		for _, p := range r.Merged {
+			_, _ = fmt.Fprintln(w, tui.DimStyle.Render("  "+p))
+		}
+	}
+
+	if len(r.Unresolved) > 0 {
+		_, _ = fmt.Fprintln(w, tui.WarnStyle.Render("Conflict markers left in (resolve them, then sync again):"))
+
+		for _, p := range r.Unresolved {
+			_, _ = fmt.Fprintln(w, tui.WarnStyle.Render("  "+p))
+		}
+	}
+
 	return nil
 }
 
+// ResultSummary is the version step and counts of a sync, e.g.
+// "1a2b3c4d → 5e6f7a8b  (↑2 ↓1)", for callers that print their own line.
+func ResultSummary(r *sync.Result) string {
+	old := sync.ShortVer(r.OldVersion)
+	if old == "" {
+		old = "∅"
+	}
+
+	return fmt.Sprintf("%s → %s  %s", old, sync.ShortVer(r.NewVersion), formatCounts(r))
+}
+
 func formatCounts(r *sync.Result) string {
 	parts := make([]string, 0, 4)
 
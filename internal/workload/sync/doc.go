@@ -15,5 +15,6 @@
 // Package sync is the engine behind `dr artifact code sync`. It walks the
 // project, builds a three-way diff against base and remote manifests,
 // executes the plan with rollback safety, and updates the local state on
-// success. The `dr artifact code sync` CLI command wires it in.
+// success. The `dr artifact code sync` CLI command wires it in, and
+// `dr artifact code revert` runs the same engine with Options.RevertTo.
 package sync
@@ -15,6 +15,7 @@
 package sync
 
 import (
+	"context"
 	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
@@ -31,7 +32,7 @@ import (
 // download streams to disk and computes SHA-256 in the same pass so
 // post-download verification is free. The first error closes done to
 // stop other workers from picking up the next file.
-func downloadFiles(e *Engine, catalogID, versionID string, files []FileAction) error {
+func downloadFiles(ctx context.Context, e *Engine, catalogID, versionID string, files []FileAction) error {
 	if len(files) == 0 {
 		return nil
 	}
@@ -65,7 +66,7 @@ func downloadFiles(e *Engine, catalogID, versionID string, files []FileAction) e
 
 			defer func() { <-sem }()
 
-			if err := downloadOne(e, catalogID, versionID, fa); err != nil {
+			if err := downloadOne(ctx, e, catalogID, versionID, fa); err != nil {
 				select {
 				case errCh <- err:
 					cancel()
@@ -87,7 +88,7 @@ func downloadFiles(e *Engine, catalogID, versionID string, files []FileAction) e
 
 // downloadOne streams the remote file to disk, hashing as it writes.
 // Empty RemoteHash skips verification (e.g. synthesized EDIT_DEL_CONFLICT).
-func downloadOne(e *Engine, catalogID, versionID string, fa FileAction) error {
+func downloadOne(ctx context.Context, e *Engine, catalogID, versionID string, fa FileAction) error {
 	// phase5Execute already rejected unsafe server paths up front via
 	// validateServerPaths; re-check here so the per-call-site invariant
 	// survives future refactors that might bypass the phase entry point.
@@ -109,7 +110,7 @@ func downloadOne(e *Engine, catalogID, versionID string, fa FileAction) error {
 	h := sha256.New()
 	mw := io.MultiWriter(out, h)
 
-	_, n, err := e.files.DownloadFile(catalogID, versionID, fa.Path, mw)
+	_, n, err := e.files.DownloadFile(ctx, catalogID, versionID, fa.Path, mw)
 
 	closeErr := out.Close()
 
@@ -15,6 +15,7 @@
 package sync
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"time"
@@ -30,6 +31,18 @@ type Options struct {
 	DryRun    bool
 	ShowDiffs bool
 	Yes       bool
+
+	// Strategy settles conflicts; the zero value is StrategyMerge.
+	Strategy Strategy
+
+	// Lock is a SyncLock the caller already holds, as watch mode does for
+	// its whole session. The engine runs under it instead of acquiring its
+	// own and leaves releasing it to the caller.
+	Lock *SyncLock
+
+	// RevertTo is a catalog version ID. When set, Plan builds the plan that
+	// publishes that version's files as a new version instead of a sync plan.
+	RevertTo string
 }
 
 // Result is the outcome of a successful sync.
@@ -41,27 +54,33 @@ type Result struct {
 	DeletedCount    int
 	ConflictCount   int
 	ConflictCopies  []string // *.LOCAL.<ts> paths created during sync
+	Merged          []string // conflicts merged cleanly and uploaded
+	Unresolved      []string // conflicts left with markers in the file
 	Duration        time.Duration
 }
 
 var ErrNoPlan = errors.New("sync engine: Execute called before Plan")
 
+// ErrUnresolvedConflicts is returned by Plan while a file the previous sync
+// left with conflict markers still has them.
+var ErrUnresolvedConflicts = errors.New("unresolved merge conflicts")
+
 // artifactStore is the small surface of the workload artifact API the
 // engine depends on. The default implementation delegates to the
 // workload package; tests inject a fake.
 type artifactStore interface {
-	Get(artifactID string) (*workload.Artifact, error)
-	PatchCodeRef(artifactID, catalogID, catalogVersionID string) error
+	Get(ctx context.Context, artifactID string) (*workload.Artifact, error)
+	PatchCodeRef(ctx context.Context, artifactID, catalogID, catalogVersionID string) error
 }
 
 type workloadArtifactStore struct{}
 
-func (workloadArtifactStore) Get(id string) (*workload.Artifact, error) {
-	return workload.GetArtifact(id)
+func (workloadArtifactStore) Get(ctx context.Context, id string) (*workload.Artifact, error) {
+	return workload.GetArtifact(ctx, id)
 }
 
-func (workloadArtifactStore) PatchCodeRef(artifactID, catalogID, catalogVersionID string) error {
-	return workload.PatchArtifactCodeRef(artifactID, catalogID, catalogVersionID)
+func (workloadArtifactStore) PatchCodeRef(ctx context.Context, artifactID, catalogID, catalogVersionID string) error {
+	return workload.PatchArtifactCodeRef(ctx, artifactID, catalogID, catalogVersionID)
 }
 
 // Deps are the external dependencies injected into an Engine. Use
@@ -100,12 +119,16 @@ type Engine struct {
 	drifted        bool
 	local          LocalManifest
 	remote         RemoteManifest
+	target         RemoteManifest
+	unresolved     []string
 	plan           *SyncPlan
 	lock           *SyncLock
 	rollback       *Rollback
 	newCatalogID   string
 	newVersionID   string
 	conflictCopies []string
+	mergedPaths    []string
+	markedPaths    []string
 	result         *Result
 	startedAt      time.Time
 	staleNote      bool
@@ -143,22 +166,29 @@ func newWithDeps(projectDir string, opts Options, deps Deps) (*Engine, error) {
 }
 
 // Plan runs phases 0-4 and returns the SyncPlan. The lock acquired in
-// Phase 0 is held until Close, Execute, or Run releases it.
-func (e *Engine) Plan() (*SyncPlan, error) {
+// Phase 0 is held until Close, Execute, or Run releases it. Cancelling
+// ctx aborts in-flight API calls and stops before the next phase.
+// With Options.RevertTo set it runs the revert phases instead.
+func (e *Engine) Plan(ctx context.Context) (*SyncPlan, error) {
 	e.startedAt = e.nowFn()
 
-	err := runPhases(
-		e,
-		phase{name: "preflight", run: phase0Preflight},
-		phase{name: "gather", run: phase1Gather},
+	phases := []phase{
+		{name: "preflight", run: phase0Preflight},
+		{name: "gather", run: phase1Gather},
 		// Before the manifest walk so a generated uv.lock is collected,
 		// diffed, and uploaded by the normal pipeline.
-		phase{name: "lockfile", run: phaseLockfile},
-		phase{name: "manifests", run: phase2Manifests},
-		phase{name: "diff", run: phase3Diff},
-		phase{name: "preview", run: phase4Preview},
-	)
-	if err != nil {
+		{name: "lockfile", run: phaseLockfile},
+		{name: "manifests", run: phase2Manifests},
+		{name: "diff", run: phase3Diff},
+		{name: "merge", run: phase3Merge},
+		{name: "preview", run: phase4Preview},
+	}
+
+	if e.opts.RevertTo != "" {
+		phases = revertPlanPhases
+	}
+
+	if err := runPhases(ctx, e, phases...); err != nil {
 		return nil, e.joinReleaseErr(err)
 	}
 
@@ -168,7 +198,8 @@ func (e *Engine) Plan() (*SyncPlan, error) {
 // Execute runs phases 5-6 against the plan returned by Plan. The lock
 // is released on completion (success or error). A failure to release
 // the lock is joined into the returned error so callers see both.
-func (e *Engine) Execute(plan *SyncPlan) (_ *Result, retErr error) {
+// A revert plan runs the revert phases instead.
+func (e *Engine) Execute(ctx context.Context, plan *SyncPlan) (_ *Result, retErr error) {
 	if e.plan == nil || plan == nil {
 		return nil, e.joinReleaseErr(ErrNoPlan)
 	}
@@ -183,11 +214,16 @@ func (e *Engine) Execute(plan *SyncPlan) (_ *Result, retErr error) {
 		retErr = e.joinReleaseErr(retErr)
 	}()
 
-	if err := runPhases(
-		e,
-		phase{name: "execute", run: phase5Execute},
-		phase{name: "state", run: phase6State},
-	); err != nil {
+	phases := []phase{
+		{name: "execute", run: phase5Execute},
+		{name: "state", run: phase6State},
+	}
+
+	if e.opts.RevertTo != "" {
+		phases = revertExecutePhases
+	}
+
+	if err := runPhases(ctx, e, phases...); err != nil {
 		return nil, err
 	}
 
@@ -195,8 +231,8 @@ func (e *Engine) Execute(plan *SyncPlan) (_ *Result, retErr error) {
 }
 
 // Run is Plan + Execute. With DryRun or ShowDiffs it stops after Plan.
-func (e *Engine) Run() (*Result, error) {
-	plan, err := e.Plan()
+func (e *Engine) Run(ctx context.Context) (*Result, error) {
+	plan, err := e.Plan(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -212,7 +248,7 @@ func (e *Engine) Run() (*Result, error) {
 		}, nil
 	}
 
-	return e.Execute(plan)
+	return e.Execute(ctx, plan)
 }
 
 // Close releases the project lock. Idempotent.
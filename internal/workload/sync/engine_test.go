@@ -15,10 +15,12 @@
 package sync
 
 import (
+	"context"
 	"errors"
 	"io"
 	"os"
 	"path/filepath"
+	"runtime"
 	stdsync "sync"
 	"testing"
 	"time"
@@ -39,7 +41,7 @@ type fakeArtifactStore struct {
 	PatchFn func(artifactID, catalogID, catalogVersionID string) error
 }
 
-func (f *fakeArtifactStore) Get(id string) (*workload.Artifact, error) {
+func (f *fakeArtifactStore) Get(_ context.Context, id string) (*workload.Artifact, error) {
 	if f.GetFn == nil {
 		return nil, errors.New("fakeArtifactStore.Get: no GetFn configured")
 	}
@@ -47,7 +49,7 @@ func (f *fakeArtifactStore) Get(id string) (*workload.Artifact, error) {
 	return f.GetFn(id)
 }
 
-func (f *fakeArtifactStore) PatchCodeRef(artifactID, catalogID, catalogVersionID string) error {
+func (f *fakeArtifactStore) PatchCodeRef(_ context.Context, artifactID, catalogID, catalogVersionID string) error {
 	if f.PatchFn == nil {
 		return nil
 	}
@@ -67,7 +69,7 @@ type fakeFilesClient struct {
 	mu            stdsync.Mutex
 }
 
-func (f *fakeFilesClient) CreateCatalog() (*filesapi.CatalogResp, error) {
+func (f *fakeFilesClient) CreateCatalog(_ context.Context) (*filesapi.CatalogResp, error) {
 	if f.catalogID == "" {
 		return nil, errors.New("fakeFilesClient.CreateCatalog: no catalogID configured")
 	}
@@ -75,7 +77,7 @@ func (f *fakeFilesClient) CreateCatalog() (*filesapi.CatalogResp, error) {
 	return &filesapi.CatalogResp{CatalogID: f.catalogID, CatalogVersionID: ""}, nil
 }
 
-func (f *fakeFilesClient) CreateStage(_ string) (*filesapi.StageResp, error) {
+func (f *fakeFilesClient) CreateStage(_ context.Context, _ string) (*filesapi.StageResp, error) {
 	if f.stageID == "" {
 		return nil, errors.New("fakeFilesClient.CreateStage: no stageID configured")
 	}
@@ -83,7 +85,7 @@ func (f *fakeFilesClient) CreateStage(_ string) (*filesapi.StageResp, error) {
 	return &filesapi.StageResp{CatalogID: f.catalogID, StageID: f.stageID}, nil
 }
 
-func (f *fakeFilesClient) UploadToStage(_, _, name string, _ int64, body io.Reader) error {
+func (f *fakeFilesClient) UploadToStage(_ context.Context, _, _, name string, _ int64, body io.Reader) error {
 	data, err := io.ReadAll(body)
 	if err != nil {
 		return err
@@ -101,7 +103,7 @@ func (f *fakeFilesClient) UploadToStage(_, _, name string, _ int64, body io.Read
 	return nil
 }
 
-func (f *fakeFilesClient) ApplyStage(_, _, _ string) (*filesapi.ApplyStageResp, error) {
+func (f *fakeFilesClient) ApplyStage(_ context.Context, _, _, _ string) (*filesapi.ApplyStageResp, error) {
 	if f.versionID == "" {
 		return nil, errors.New("fakeFilesClient.ApplyStage: no versionID configured")
 	}
@@ -113,32 +115,32 @@ func (f *fakeFilesClient) ApplyStage(_, _, _ string) (*filesapi.ApplyStageResp,
 	}, nil
 }
 
-func (f *fakeFilesClient) UploadFromZipNew(_ string, _ int64, _ io.Reader) (*filesapi.FromFileResp, error) {
+func (f *fakeFilesClient) UploadFromZipNew(_ context.Context, _ string, _ int64, _ io.Reader) (*filesapi.FromFileResp, error) {
 	return nil, errors.New("fakeFilesClient: UploadFromZipNew not expected")
 }
 
-func (f *fakeFilesClient) UploadFromZipExisting(_, _, _ string, _ int64, _ io.Reader) (*filesapi.FromFileResp, error) {
+func (f *fakeFilesClient) UploadFromZipExisting(_ context.Context, _, _, _ string, _ int64, _ io.Reader) (*filesapi.FromFileResp, error) {
 	return nil, errors.New("fakeFilesClient: UploadFromZipExisting not expected")
 }
 
-func (f *fakeFilesClient) PollStatus(_ string) (*filesapi.StatusResp, error) {
+func (f *fakeFilesClient) PollStatus(_ context.Context, _ string) (*filesapi.StatusResp, error) {
 	return nil, errors.New("fakeFilesClient: PollStatus not expected")
 }
 
-func (f *fakeFilesClient) AllFiles(_, _ string) (map[string]filesapi.FileMeta, error) {
+func (f *fakeFilesClient) AllFiles(_ context.Context, _, _ string) (map[string]filesapi.FileMeta, error) {
 	return f.allFiles, nil
 }
 
-func (f *fakeFilesClient) DownloadFile(_, _, _ string, _ io.Writer) (string, int64, error) {
+func (f *fakeFilesClient) DownloadFile(_ context.Context, _, _, _ string, _ io.Writer) (string, int64, error) {
 	return "", 0, errors.New("fakeFilesClient: DownloadFile not expected")
 }
 
-func (f *fakeFilesClient) DeleteFiles(_ string, paths []string) (*filesapi.DeleteFilesResp, error) {
+func (f *fakeFilesClient) DeleteFiles(_ context.Context, _ string, paths []string) (*filesapi.DeleteFilesResp, error) {
 	f.deletedPaths = append(f.deletedPaths, paths...)
 	return &filesapi.DeleteFilesResp{}, nil
 }
 
-func (f *fakeFilesClient) ListVersions(_ string, _ int) ([]filesapi.CatalogVersion, error) {
+func (f *fakeFilesClient) ListVersions(_ context.Context, _ string, _ int) ([]filesapi.CatalogVersion, error) {
 	return nil, errors.New("fakeFilesClient: ListVersions not expected")
 }
 
@@ -199,7 +201,7 @@ func TestEngine_Plan_FirstSyncEmptyArtifact(t *testing.T) {
 
 	t.Cleanup(func() { _ = e.Close() })
 
-	plan, err := e.Plan()
+	plan, err := e.Plan(context.Background())
 	require.NoError(t, err)
 
 	uploadPaths := make([]string, 0, len(plan.Uploads))
@@ -257,7 +259,7 @@ func TestEngine_Plan_FastPathUpToDate(t *testing.T) {
 
 	t.Cleanup(func() { _ = e.Close() })
 
-	plan, err := e.Plan()
+	plan, err := e.Plan(context.Background())
 	require.NoError(t, err)
 	assert.True(t, plan.IsEmpty(), "plan should be empty when local == base == remote: %+v", plan)
 	assert.False(t, calledAllFiles, "AllFiles must not be called when not drifted")
@@ -279,7 +281,7 @@ func TestEngine_Plan_LockedArtifactRejected(t *testing.T) {
 
 	t.Cleanup(func() { _ = e.Close() })
 
-	_, err = e.Plan()
+	_, err = e.Plan(context.Background())
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "locked")
 }
@@ -290,7 +292,7 @@ func TestEngine_Plan_NotLinked(t *testing.T) {
 	e, err := New(dir, Options{})
 	require.NoError(t, err)
 
-	_, err = e.Plan()
+	_, err = e.Plan(context.Background())
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "not linked")
 }
@@ -325,7 +327,7 @@ func TestEngine_Run_FirstSyncStagePath(t *testing.T) {
 
 	t.Cleanup(func() { _ = e.Close() })
 
-	result, err := e.Run()
+	result, err := e.Run(context.Background())
 	require.NoError(t, err)
 	require.NotNil(t, result)
 
@@ -359,9 +361,9 @@ type trackingFilesClient struct {
 	allFilesCalled *bool
 }
 
-func (t *trackingFilesClient) AllFiles(cid, vid string) (map[string]filesapi.FileMeta, error) {
+func (t *trackingFilesClient) AllFiles(ctx context.Context, cid, vid string) (map[string]filesapi.FileMeta, error) {
 	*t.allFilesCalled = true
-	return t.fakeFilesClient.AllFiles(cid, vid)
+	return t.fakeFilesClient.AllFiles(ctx, cid, vid)
 }
 
 func hashLocal(t *testing.T, dir, rel string) (string, int64, error) {
@@ -371,3 +373,32 @@ func hashLocal(t *testing.T, dir, rel string) (string, int64, error) {
 
 	return fileops.HashFile(abs)
 }
+
+func TestEngine_Plan_UsesCallerLock(t *testing.T) {
+	dir := initProject(t, map[string]string{"agent.py": "x"})
+
+	lock, err := AcquireSyncLock(dir)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { _ = lock.Release() })
+
+	e, err := newWithDeps(dir, Options{Lock: lock}, Deps{
+		Files: &fakeFilesClient{},
+		Artifacts: &fakeArtifactStore{
+			GetFn: func(id string) (*workload.Artifact, error) {
+				return draftArtifact(id, "", ""), nil
+			},
+		},
+		Now: time.Now,
+	})
+	require.NoError(t, err)
+
+	_, err = e.Plan(context.Background())
+	require.NoError(t, err, "the engine must run under the caller's lock, not try to take its own")
+	require.NoError(t, e.Close())
+
+	if runtime.GOOS != "windows" {
+		_, err = AcquireSyncLock(dir)
+		require.Error(t, err, "Close must leave the caller's lock held")
+	}
+}
@@ -16,6 +16,7 @@ package sync
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"os"
@@ -24,12 +25,14 @@ import (
 
 // Fetcher returns a content fetcher backed by the engine's project
 // directory and FilesAPI client. Only valid while the engine is alive.
-func (e *Engine) Fetcher() *engineFetcher {
-	return &engineFetcher{e: e}
+// Remote downloads run under ctx.
+func (e *Engine) Fetcher(ctx context.Context) *engineFetcher {
+	return &engineFetcher{ctx: ctx, e: e}
 }
 
 type engineFetcher struct {
-	e *Engine
+	ctx context.Context //nolint:containedctx // bound for the fetcher's short, single-diff lifetime
+	e   *Engine
 }
 
 func (f *engineFetcher) LocalContent(path string) ([]byte, error) {
@@ -51,7 +54,7 @@ func (f *engineFetcher) RemoteContent(path string) ([]byte, error) {
 
 	var buf bytes.Buffer
 
-	_, _, err := f.e.files.DownloadFile(codeRef.CatalogID, codeRef.CatalogVersionID, path, &buf)
+	_, _, err := f.e.files.DownloadFile(f.ctx, codeRef.CatalogID, codeRef.CatalogVersionID, path, &buf)
 	if err != nil {
 		return nil, fmt.Errorf("download remote %s: %w", path, err)
 	}
@@ -0,0 +1,166 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sync
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/datarobot/cli/internal/log"
+	"github.com/datarobot/cli/internal/workload/fileops"
+	"github.com/datarobot/cli/internal/workload/wapi"
+)
+
+// racyWindow is how close to the start of a scan a file may have been
+// written and still have its hash cached. A write landing in the same
+// timestamp tick as the one the hash was computed after leaves the stat
+// unchanged, so such an entry could not be trusted later; two seconds covers
+// the coarsest mtime resolution in common use (FAT). Those files are simply
+// hashed again next time, until they age out of the window. A var so tests
+// need not sleep through it.
+var racyWindow = 2 * time.Second
+
+// hashFileFn is swapped by tests to count how many files a scan reads.
+var hashFileFn = fileops.HashFile
+
+// hashEntries hashes each entry, reusing a cached hash wherever the file's
+// stat is unchanged, on a pool of at most HashConcurrency workers. It returns
+// the LOCAL manifest and the cache entries for every file it hashed or
+// reused. The first error in walk order is returned, and stops the pool from
+// starting any further files.
+func hashEntries(entries []fileops.Entry, cached map[string]wapi.HashCacheEntry) (LocalManifest, map[string]wapi.HashCacheEntry, error) {
+	results := make([]wapi.HashCacheEntry, len(entries))
+	errs := make([]error, len(entries))
+	jobs := make(chan int)
+
+	var (
+		failed atomic.Bool
+		wg     sync.WaitGroup
+	)
+
+	for range min(HashConcurrency, runtime.GOMAXPROCS(0), max(len(entries), 1)) {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for i := range jobs {
+				if failed.Load() {
+					continue
+				}
+
+				results[i], errs[i] = hashEntry(entries[i], cached)
+				if errs[i] != nil {
+					failed.Store(true)
+				}
+			}
+		}()
+	}
+
+	for i := range entries {
+		jobs <- i
+	}
+
+	close(jobs)
+	wg.Wait()
+
+	if err := firstError(errs); err != nil {
+		return nil, nil, err
+	}
+
+	out := make(LocalManifest, len(entries))
+	fresh := make(map[string]wapi.HashCacheEntry, len(entries))
+
+	for i, ent := range entries {
+		out[ent.RelPath] = FileEntry{Hash: results[i].Hash, Size: results[i].Size}
+		fresh[ent.RelPath] = results[i]
+	}
+
+	return out, fresh, nil
+}
+
+// hashEntry answers from the cache when the stat matches, and reads the
+// file otherwise. The stat is taken before the read, so a file that changes
+// while it is being hashed is recorded under a stat it no longer has and is
+// read again on the next scan.
+func hashEntry(ent fileops.Entry, cached map[string]wapi.HashCacheEntry) (wapi.HashCacheEntry, error) {
+	key, err := fileops.Stat(ent.AbsPath)
+	if err != nil {
+		return wapi.HashCacheEntry{}, fmt.Errorf("hash %s: %w", ent.RelPath, err)
+	}
+
+	if hit, ok := cached[ent.RelPath]; ok && hit.StatKey == key && hit.Hash != "" {
+		return hit, nil
+	}
+
+	hash, size, err := hashFileFn(ent.AbsPath)
+	if err != nil {
+		return wapi.HashCacheEntry{}, fmt.Errorf("hash %s: %w", ent.RelPath, err)
+	}
+
+	key.Size = size
+
+	return wapi.HashCacheEntry{StatKey: key, Hash: hash}, nil
+}
+
+func firstError(errs []error) error {
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// loadHashCache returns the cached hashes for projectDir, or none. The cache
+// only ever saves work, so an unlinked project or an unreadable file means
+// hashing everything, never a failed scan.
+func loadHashCache(projectDir string) map[string]wapi.HashCacheEntry {
+	c, err := wapi.LoadHashCache(projectDir)
+	if err != nil && !errors.Is(err, wapi.ErrNotInitialized) {
+		log.Debug("ignoring the hash cache", "err", err)
+	}
+
+	return c.Files
+}
+
+// saveHashCache records the hashes of a scan that began at scannedAt,
+// leaving out files written within racyWindow of it. Failing to save costs
+// the next scan some time and nothing else, so it is logged, not returned.
+func saveHashCache(projectDir string, scannedAt time.Time, fresh map[string]wapi.HashCacheEntry) {
+	if !wapi.Exists(projectDir) {
+		return
+	}
+
+	cutoff := scannedAt.Add(-racyWindow).UnixNano()
+	files := make(map[string]wapi.HashCacheEntry, len(fresh))
+
+	for rel, entry := range fresh {
+		if entry.MtimeNs < cutoff && entry.CtimeNs < cutoff {
+			files[rel] = entry
+		}
+	}
+
+	c := wapi.HashCache{ScannedAt: scannedAt.UTC(), Files: files}
+
+	if err := wapi.SaveHashCache(projectDir, c); err != nil {
+		log.Debug("could not save the hash cache", "err", err)
+	}
+}
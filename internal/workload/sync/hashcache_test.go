@@ -0,0 +1,243 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sync
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/datarobot/cli/internal/workload/fileops"
+	"github.com/datarobot/cli/internal/workload/wapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// countHashes swaps hashFileFn for one that counts its calls, and drops the
+// racy window so files written by the test are cacheable straight away.
+func countHashes(t *testing.T) *atomic.Int64 {
+	t.Helper()
+
+	origHash, origWindow := hashFileFn, racyWindow
+
+	t.Cleanup(func() { hashFileFn, racyWindow = origHash, origWindow })
+
+	var calls atomic.Int64
+
+	hashFileFn = func(path string) (string, int64, error) {
+		calls.Add(1)
+
+		return fileops.HashFile(path)
+	}
+	racyWindow = 0
+
+	return &calls
+}
+
+// cacheProject links a directory and writes n files into it.
+func cacheProject(t testing.TB, n, size int) string {
+	t.Helper()
+
+	dir := t.TempDir()
+
+	require.NoError(t, wapi.Initialize(dir, wapi.InitOptions{ArtifactID: "art-1"}))
+
+	body := make([]byte, size)
+
+	for i := range n {
+		path := filepath.Join(dir, fmt.Sprintf("pkg%02d", i%50), fmt.Sprintf("file%05d.txt", i))
+
+		copy(body, fmt.Sprintf("file %d\n", i))
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, body, 0o644))
+	}
+
+	return dir
+}
+
+func TestScanLocal_SecondScanReadsNothing(t *testing.T) {
+	calls := countHashes(t)
+	dir := cacheProject(t, 20, 64)
+
+	first, err := ScanLocal(dir)
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(first)), calls.Load())
+
+	calls.Store(0)
+
+	second, err := ScanLocal(dir)
+	require.NoError(t, err)
+	assert.Equal(t, first, second)
+	assert.Zero(t, calls.Load())
+}
+
+func TestScanLocal_ChangedFileIsRehashed(t *testing.T) {
+	calls := countHashes(t)
+	dir := cacheProject(t, 5, 16)
+	path := filepath.Join(dir, "pkg00", "file00000.txt")
+
+	mtime := time.Now().Add(-time.Hour)
+	require.NoError(t, os.Chtimes(path, mtime, mtime))
+
+	first, err := ScanLocal(dir)
+	require.NoError(t, err)
+
+	// Same size, mtime put back: only the inode or change time can tell.
+	require.NoError(t, os.WriteFile(path, []byte("a different body"), 0o644))
+	require.NoError(t, os.Chtimes(path, mtime, mtime))
+
+	calls.Store(0)
+
+	second, err := ScanLocal(dir)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), calls.Load())
+	assert.NotEqual(t, first["pkg00/file00000.txt"].Hash, second["pkg00/file00000.txt"].Hash)
+
+	want, _, err := fileops.HashFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, want, second["pkg00/file00000.txt"].Hash)
+}
+
+func TestScanLocal_RacyFilesAreNotCached(t *testing.T) {
+	calls := countHashes(t)
+	dir := cacheProject(t, 3, 16)
+
+	racyWindow = time.Hour
+
+	_, err := ScanLocal(dir)
+	require.NoError(t, err)
+
+	calls.Store(0)
+
+	_, err = ScanLocal(dir)
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), calls.Load(), "three files and .wapiignore, all written just now")
+}
+
+func TestScanLocal_DeletedFilesLeaveTheCache(t *testing.T) {
+	countHashes(t)
+
+	dir := cacheProject(t, 2, 16)
+
+	_, err := ScanLocal(dir)
+	require.NoError(t, err)
+	require.NoError(t, os.Remove(filepath.Join(dir, "pkg01", "file00001.txt")))
+
+	_, err = ScanLocal(dir)
+	require.NoError(t, err)
+
+	c, err := wapi.LoadHashCache(dir)
+	require.NoError(t, err)
+	assert.Contains(t, c.Files, "pkg00/file00000.txt")
+	assert.NotContains(t, c.Files, "pkg01/file00001.txt")
+}
+
+func TestScanLocal_CorruptCacheIsIgnored(t *testing.T) {
+	countHashes(t)
+
+	dir := cacheProject(t, 2, 16)
+
+	require.NoError(t, os.WriteFile(filepath.Join(wapi.Dir(dir), "hashcache.json"), []byte("{"), 0o644))
+
+	local, err := ScanLocal(dir)
+	require.NoError(t, err)
+	assert.Len(t, local, 3, "two files and .wapiignore")
+}
+
+func TestScanLocal_UnlinkedDirectoryHasNoCache(t *testing.T) {
+	countHashes(t)
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.txt"), []byte("a"), 0o644))
+
+	local, err := ScanLocal(dir)
+	require.NoError(t, err)
+	assert.Len(t, local, 1)
+	assert.NoDirExists(t, filepath.Join(dir, wapi.RootDirName))
+}
+
+func TestHashEntries_FirstErrorInWalkOrder(t *testing.T) {
+	countHashes(t)
+
+	dir := t.TempDir()
+	entries := make([]fileops.Entry, 0, 40)
+
+	for i := range 40 {
+		rel := fmt.Sprintf("f%02d", i)
+		entries = append(entries, fileops.Entry{AbsPath: filepath.Join(dir, rel), RelPath: rel})
+	}
+
+	_, _, err := hashEntries(entries, nil)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "hash f00")
+	assert.True(t, errors.Is(err, os.ErrNotExist))
+}
+
+// BenchmarkScanLocal compares a scan that reads every file with one the
+// cache answers. Run with:
+//
+//	go test ./internal/workload/sync -run '^$' -bench ScanLocal -benchmem
+func BenchmarkScanLocal(b *testing.B) {
+	const (
+		files    = 2000
+		fileSize = 32 * 1024
+	)
+
+	origWindow := racyWindow
+	b.Cleanup(func() { racyWindow = origWindow })
+
+	racyWindow = 0
+
+	dir := cacheProject(b, files, fileSize)
+	cachePath := filepath.Join(wapi.Dir(dir), "hashcache.json")
+
+	b.Run("Cold", func(b *testing.B) {
+		for b.Loop() {
+			b.StopTimer()
+			require.NoError(b, os.RemoveAll(cachePath))
+			b.StartTimer()
+
+			_, err := ScanLocal(dir)
+			require.NoError(b, err)
+		}
+	})
+
+	b.Run("Warm", func(b *testing.B) {
+		_, err := ScanLocal(dir)
+		require.NoError(b, err)
+
+		for b.Loop() {
+			_, err := ScanLocal(dir)
+			require.NoError(b, err)
+		}
+	})
+}
+
+func TestScanLocal_UnreadableIgnoreFileFails(t *testing.T) {
+	dir := cacheProject(t, 1, 16)
+
+	// A directory where the file should be cannot be read as one.
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "pkg00", ".wapiignore"), 0o755))
+
+	_, err := ScanLocal(dir)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), ".wapiignore")
+}
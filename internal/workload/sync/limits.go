@@ -20,6 +20,11 @@ const (
 	UploadConcurrency   = 4
 	DownloadConcurrency = 6
 
+	// HashConcurrency caps the workers hashing local files, further bounded
+	// by GOMAXPROCS. Hashing is CPU-bound once the page cache is warm, so
+	// more workers than cores only add contention.
+	HashConcurrency = 8
+
 	// DiskSpaceMarginMB is the headroom required on top of the download
 	// size before Phase 5 begins, to prevent disk-full mid-sync.
 	DiskSpaceMarginMB = 100
@@ -0,0 +1,223 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package merge
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/aymanbagabas/go-udiff/lcs"
+)
+
+// Conflict markers use git's layout so editors that highlight git conflicts
+// highlight these too.
+const (
+	MarkerLocal     = "<<<<<<<"
+	MarkerSeparator = "======="
+	MarkerRemote    = ">>>>>>>"
+)
+
+// MaxSize is the largest file IsText accepts. Bigger files are left to the
+// caller's binary handling rather than merged line by line.
+const MaxSize = 4 * 1024 * 1024
+
+// Labels name the two sides on the conflict marker lines.
+type Labels struct {
+	Local  string
+	Remote string
+}
+
+// Result is the outcome of Merge.
+type Result struct {
+	// Content is the merged file. When Conflicts is non-zero it holds a
+	// conflict block for every hunk the two sides changed differently.
+	Content []byte
+
+	// Conflicts counts the conflict blocks in Content.
+	Conflicts int
+}
+
+// Clean reports whether the merge resolved every hunk on its own.
+func (r Result) Clean() bool {
+	return r.Conflicts == 0
+}
+
+// IsText reports whether data can be merged line by line: valid UTF-8, no
+// NUL bytes, and no larger than MaxSize.
+func IsText(data []byte) bool {
+	return len(data) <= MaxSize && bytes.IndexByte(data, 0) < 0 && utf8.Valid(data)
+}
+
+// HasConflictMarkers reports whether data still holds a complete conflict
+// block of the kind Merge writes.
+func HasConflictMarkers(data []byte) bool {
+	return CountConflicts(data) > 0
+}
+
+// CountConflicts counts the complete conflict blocks in data.
+func CountConflicts(data []byte) int {
+	count, state := 0, 0
+
+	for _, line := range splitLines(string(data)) {
+		line = strings.TrimRight(line, "\r\n")
+
+		switch {
+		case strings.HasPrefix(line, MarkerLocal):
+			state = 1
+		case state == 1 && line == MarkerSeparator:
+			state = 2
+		case state == 2 && strings.HasPrefix(line, MarkerRemote):
+			count++
+			state = 0
+		}
+	}
+
+	return count
+}
+
+// Merge applies the changes local and remote each made to base. A hunk only
+// one side changed, or both changed the same way, is taken as it is; a hunk
+// the two changed differently becomes a conflict block holding both versions.
+func Merge(base, local, remote []byte, labels Labels) Result {
+	baseLines := splitLines(string(base))
+	localLines := splitLines(string(local))
+	remoteLines := splitLines(string(remote))
+
+	toLocal := matchLines(baseLines, localLines)
+	toRemote := matchLines(baseLines, remoteLines)
+
+	var (
+		out       bytes.Buffer
+		conflicts int
+	)
+
+	b, l, r := 0, 0, 0
+
+	for {
+		// The next base line both sides kept is a stable point: everything
+		// before it on each side is one chunk to settle.
+		next := b
+		for next < len(baseLines) && (toLocal[next] < 0 || toRemote[next] < 0) {
+			next++
+		}
+
+		nextLocal, nextRemote := len(localLines), len(remoteLines)
+		if next < len(baseLines) {
+			nextLocal, nextRemote = toLocal[next], toRemote[next]
+		}
+
+		conflicts += writeChunk(&out, baseLines[b:next], localLines[l:nextLocal], remoteLines[r:nextRemote], labels)
+
+		if next == len(baseLines) {
+			break
+		}
+
+		out.WriteString(baseLines[next])
+
+		b, l, r = next+1, nextLocal+1, nextRemote+1
+	}
+
+	return Result{Content: out.Bytes(), Conflicts: conflicts}
+}
+
+// writeChunk writes the settled form of one unstable chunk and returns 1 if
+// it had to write a conflict block.
+func writeChunk(out *bytes.Buffer, base, local, remote []string, labels Labels) int {
+	switch {
+	case slices.Equal(local, base):
+		writeLines(out, remote)
+	case slices.Equal(remote, base), slices.Equal(local, remote):
+		writeLines(out, local)
+	default:
+		writeMarker(out, MarkerLocal, labels.Local)
+		writeLines(out, local)
+		endLine(out)
+		writeMarker(out, MarkerSeparator, "")
+		writeLines(out, remote)
+		endLine(out)
+		writeMarker(out, MarkerRemote, labels.Remote)
+
+		return 1
+	}
+
+	return 0
+}
+
+func writeMarker(out *bytes.Buffer, marker, label string) {
+	out.WriteString(marker)
+
+	if label != "" {
+		out.WriteString(" " + label)
+	}
+
+	out.WriteString("\n")
+}
+
+func writeLines(out *bytes.Buffer, lines []string) {
+	for _, line := range lines {
+		out.WriteString(line)
+	}
+}
+
+// endLine terminates a last line that had no newline of its own, so the
+// marker after it starts on a line of its own.
+func endLine(out *bytes.Buffer) {
+	if out.Len() > 0 && out.Bytes()[out.Len()-1] != '\n' {
+		out.WriteString("\n")
+	}
+}
+
+// matchLines maps each line of base to the index of the same line in other,
+// or -1 where the diff between them replaces it.
+func matchLines(base, other []string) []int {
+	out := make([]int, len(base))
+
+	b, o := 0, 0
+
+	for _, d := range lcs.DiffLines(base, other) {
+		for ; b < d.Start; b, o = b+1, o+1 {
+			out[b] = o
+		}
+
+		for ; b < d.End; b++ {
+			out[b] = -1
+		}
+
+		o = d.ReplEnd
+	}
+
+	for ; b < len(base); b, o = b+1, o+1 {
+		out[b] = o
+	}
+
+	return out
+}
+
+// splitLines splits s after every newline, keeping the terminators so the
+// merged output reproduces the input's line endings exactly.
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+
+	lines := strings.SplitAfter(s, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	return lines
+}
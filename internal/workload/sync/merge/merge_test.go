@@ -0,0 +1,113 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package merge
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var labels = Labels{Local: "local", Remote: "remote"}
+
+func TestMerge_NonOverlappingChangesMergeCleanly(t *testing.T) {
+	base := "one\ntwo\nthree\nfour\nfive\n"
+	local := "ONE\ntwo\nthree\nfour\nfive\n"
+	remote := "one\ntwo\nthree\nfour\nFIVE\nsix\n"
+
+	res := Merge([]byte(base), []byte(local), []byte(remote), labels)
+
+	assert.True(t, res.Clean())
+	assert.Equal(t, "ONE\ntwo\nthree\nfour\nFIVE\nsix\n", string(res.Content))
+}
+
+func TestMerge_IdenticalChangesAreTakenOnce(t *testing.T) {
+	base := "a\nb\nc\n"
+	both := "a\nB\nc\n"
+
+	res := Merge([]byte(base), []byte(both), []byte(both), labels)
+
+	assert.True(t, res.Clean())
+	assert.Equal(t, both, string(res.Content))
+}
+
+func TestMerge_OneSideDeletesLines(t *testing.T) {
+	base := "a\nb\nc\nd\n"
+	local := "a\nd\n"
+	remote := "a\nb\nc\nd\ne\n"
+
+	res := Merge([]byte(base), []byte(local), []byte(remote), labels)
+
+	assert.True(t, res.Clean())
+	assert.Equal(t, "a\nd\ne\n", string(res.Content))
+}
+
+func TestMerge_OverlappingChangesConflict(t *testing.T) {
+	base := "a\nb\nc\n"
+	local := "a\nmine\nc\n"
+	remote := "a\ntheirs\nc\n"
+
+	res := Merge([]byte(base), []byte(local), []byte(remote), labels)
+
+	assert.Equal(t, 1, res.Conflicts)
+	assert.Equal(t, "a\n<<<<<<< local\nmine\n=======\ntheirs\n>>>>>>> remote\nc\n", string(res.Content))
+	assert.True(t, HasConflictMarkers(res.Content))
+}
+
+func TestMerge_ConflictWithoutTrailingNewline(t *testing.T) {
+	res := Merge([]byte("a\nb"), []byte("a\nmine"), []byte("a\ntheirs"), labels)
+
+	assert.Equal(t, 1, res.Conflicts)
+	assert.Equal(t, "a\n<<<<<<< local\nmine\n=======\ntheirs\n>>>>>>> remote\n", string(res.Content))
+}
+
+func TestMerge_EmptyBaseConflictsWhenAddsDiffer(t *testing.T) {
+	res := Merge(nil, []byte("same\n"), []byte("same\nextra\n"), labels)
+
+	assert.Equal(t, 1, res.Conflicts)
+	assert.Equal(t, "<<<<<<< local\nsame\n=======\nsame\nextra\n>>>>>>> remote\n", string(res.Content))
+}
+
+func TestMerge_KeepsCRLFLineEndings(t *testing.T) {
+	base := "a\r\nb\r\nc\r\n"
+	local := "A\r\nb\r\nc\r\n"
+	remote := "a\r\nb\r\nC\r\n"
+
+	res := Merge([]byte(base), []byte(local), []byte(remote), labels)
+
+	assert.True(t, res.Clean())
+	assert.Equal(t, "A\r\nb\r\nC\r\n", string(res.Content))
+}
+
+func TestIsText(t *testing.T) {
+	assert.True(t, IsText([]byte("plain text\n")))
+	assert.True(t, IsText(nil))
+	assert.False(t, IsText([]byte{'a', 0, 'b'}))
+	assert.False(t, IsText([]byte{0xff, 0xfe}))
+	assert.False(t, IsText(make([]byte, MaxSize+1)))
+}
+
+func TestHasConflictMarkers(t *testing.T) {
+	assert.False(t, HasConflictMarkers([]byte("a\n=======\nb\n")))
+	assert.False(t, HasConflictMarkers([]byte("<<<<<<< local\nmine\n")))
+	assert.True(t, HasConflictMarkers([]byte("<<<<<<< local\r\nmine\r\n=======\r\ntheirs\r\n>>>>>>> remote\r\n")))
+}
+
+func TestCountConflicts(t *testing.T) {
+	res := Merge([]byte("a\nb\nc\nd\ne\n"), []byte("A\nb\nc\nd\nE\n"), []byte("1\nb\nc\nd\n5\n"), labels)
+
+	assert.Equal(t, 2, res.Conflicts)
+	assert.Equal(t, 2, CountConflicts(res.Content))
+}
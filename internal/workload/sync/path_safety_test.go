@@ -15,6 +15,7 @@
 package sync
 
 import (
+	"context"
 	"os"
 	"path/filepath"
 	"testing"
@@ -48,7 +49,7 @@ func TestDownloadOne_RejectsUnsafeServerPath(t *testing.T) {
 			// is the unsafe-path wrapper, proving no remote call ran.
 			e := &Engine{projectDir: t.TempDir(), files: &fakeFilesClient{}}
 
-			err := downloadOne(e, "cid", "vid", FileAction{Path: bad})
+			err := downloadOne(context.Background(), e, "cid", "vid", FileAction{Path: bad})
 			require.Error(t, err)
 			assert.Contains(t, err.Error(), "server returned unsafe download path")
 			assert.NotContains(t, err.Error(), "DownloadFile not expected")
@@ -194,7 +195,7 @@ func TestPhase5Execute_RejectsUnsafePathBeforeAnyFilesystemOp(t *testing.T) {
 		},
 	}
 
-	err := phase5Execute(e)
+	err := phase5Execute(context.Background(), e)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "server returned unsafe download path")
 
@@ -15,6 +15,7 @@
 package sync
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
@@ -25,11 +26,13 @@ import (
 // the project is linked, and acquires the project lock. Migration runs first
 // so everything after it resolves one state directory; recovery runs before
 // the lock so a crashed-mid-sync process gets cleaned up by whoever runs next.
+// A lock passed in Options is the caller's; e.lock stays nil so the engine
+// never releases it.
 //
 // The preview modes skip the migration: --dry-run and --diff should not move
 // anything on disk, and they read fine either way because the path helpers
 // fall back to the legacy location on their own.
-func phase0Preflight(e *Engine) error {
+func phase0Preflight(_ context.Context, e *Engine) error {
 	if !e.opts.DryRun && !e.opts.ShowDiffs {
 		e.migrationNote = wapi.EnsureMigrated(e.projectDir)
 	}
@@ -45,6 +48,10 @@ func phase0Preflight(e *Engine) error {
 		return errors.New("not linked: run 'dr artifact code init <artifact-id>' first")
 	}
 
+	if e.opts.Lock != nil {
+		return nil
+	}
+
 	lock, err := AcquireSyncLock(e.projectDir)
 	if err != nil {
 		return err
@@ -15,6 +15,7 @@
 package sync
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
@@ -24,7 +25,7 @@ import (
 
 // phase1Gather loads on-disk state, fetches the artifact, and computes
 // the drift flag that decides whether Phase 2 calls allFiles or fast-paths.
-func phase1Gather(e *Engine) error {
+func phase1Gather(ctx context.Context, e *Engine) error {
 	cfg, err := wapi.LoadConfig(e.projectDir)
 	if err != nil {
 		return fmt.Errorf("read %s: %w", wapi.ConfigPath(e.projectDir), err)
@@ -42,8 +43,9 @@ func phase1Gather(e *Engine) error {
 	}
 
 	e.base = baseFromManifest(manifest)
+	e.unresolved = manifest.Unresolved
 
-	art, err := e.artifacts.Get(cfg.ArtifactID)
+	art, err := e.artifacts.Get(ctx, cfg.ArtifactID)
 	if err != nil {
 		return fmt.Errorf("fetch artifact %s: %w", cfg.ArtifactID, err)
 	}
@@ -15,7 +15,9 @@
 package sync
 
 import (
+	"context"
 	"fmt"
+	"time"
 
 	"github.com/datarobot/cli/internal/workload/fileops"
 	"github.com/datarobot/cli/internal/workload/ignore"
@@ -24,7 +26,7 @@ import (
 // phase2Manifests builds the LOCAL manifest by walking + hashing the
 // project, and either fetches REMOTE from FilesAPI (when drifted) or
 // copies it from BASE (the solo-developer fast path).
-func phase2Manifests(e *Engine) error {
+func phase2Manifests(ctx context.Context, e *Engine) error {
 	matcher, err := ignore.New(e.projectDir)
 	if err != nil {
 		return fmt.Errorf("load .wapiignore: %w", err)
@@ -32,18 +34,7 @@ func phase2Manifests(e *Engine) error {
 
 	warnIfLockfileIgnored(e, matcher)
 
-	var skippedSymlinks []string
-
-	walkOnSymlink := func(rel, _ string) {
-		skippedSymlinks = append(skippedSymlinks, rel)
-	}
-
-	entries, err := fileops.Walk(e.projectDir, matcher.Match, walkOnSymlink)
-	if err != nil {
-		return fmt.Errorf("walk project directory: %w", err)
-	}
-
-	local, err := hashEntries(entries)
+	local, err := scanLocal(e.projectDir, matcher)
 	if err != nil {
 		return err
 	}
@@ -70,7 +61,7 @@ func phase2Manifests(e *Engine) error {
 		return nil
 	}
 
-	remote, err := e.files.AllFiles(codeRef.CatalogID, e.remoteVer)
+	remote, err := e.files.AllFiles(ctx, codeRef.CatalogID, e.remoteVer)
 	if err != nil {
 		return fmt.Errorf("fetch remote manifest: %w", err)
 	}
@@ -80,22 +71,45 @@ func phase2Manifests(e *Engine) error {
 	return nil
 }
 
-// hashEntries hashes each entry sequentially. Concurrency would help
-// only marginally for typical projects since Phase 5 network is the
-// real bottleneck.
-func hashEntries(entries []fileops.Entry) (LocalManifest, error) {
-	out := make(LocalManifest, len(entries))
+// ScanLocal builds the LOCAL manifest the way a sync does: every file
+// .wapiignore leaves in, hashed. It is exported for the commands that report
+// local state without syncing, so what they call modified is exactly what a
+// sync would upload.
+func ScanLocal(projectDir string) (LocalManifest, error) {
+	matcher, err := ignore.New(projectDir)
+	if err != nil {
+		return nil, fmt.Errorf("load .wapiignore: %w", err)
+	}
 
-	for _, ent := range entries {
-		hash, size, err := fileops.HashFile(ent.AbsPath)
-		if err != nil {
-			return nil, fmt.Errorf("hash %s: %w", ent.RelPath, err)
-		}
+	return scanLocal(projectDir, matcher)
+}
+
+// scanLocal walks and hashes the project. Symlinks are skipped by the walk
+// and never reach the manifest. Files whose stat matches the hash cache are
+// not read, and the cache is rewritten from this scan, so entries for files
+// that have gone drop out with them.
+func scanLocal(projectDir string, matcher *ignore.Matcher) (LocalManifest, error) {
+	scannedAt := time.Now()
+
+	entries, err := fileops.Walk(projectDir, matcher.Match, nil)
+	if err != nil {
+		return nil, fmt.Errorf("walk project directory: %w", err)
+	}
 
-		out[ent.RelPath] = FileEntry{Hash: hash, Size: size}
+	// An ignore file the walk could not read would otherwise upload
+	// everything it was there to keep out.
+	if err := matcher.Err(); err != nil {
+		return nil, err
 	}
 
-	return out, nil
+	local, fresh, err := hashEntries(entries, loadHashCache(projectDir))
+	if err != nil {
+		return nil, err
+	}
+
+	saveHashCache(projectDir, scannedAt, fresh)
+
+	return local, nil
 }
 
 func caseCollisionsFromManifest(m LocalManifest) []fileops.CaseCollision {
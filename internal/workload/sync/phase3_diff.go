@@ -14,8 +14,10 @@
 
 package sync
 
+import "context"
+
 // phase3Diff turns the three manifests into a SyncPlan.
-func phase3Diff(e *Engine) error {
+func phase3Diff(_ context.Context, e *Engine) error {
 	plan := Diff(e.base, e.local, e.remote)
 	plan.OldVersionShort = ShortVer(ptrOrEmpty(e.config.LastSyncedVersionID))
 	e.plan = plan
@@ -0,0 +1,200 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sync
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/datarobot/cli/internal/log"
+	"github.com/datarobot/cli/internal/workload/fileops"
+	"github.com/datarobot/cli/internal/workload/sync/merge"
+)
+
+// phase3Merge settles every conflict row according to the strategy. With
+// StrategyMerge it fetches the BASE and remote content of each text conflict
+// and three-way merges it against the local file; nothing is written until
+// Phase 5.
+//
+// It first refuses to go on while a file the previous sync left with
+// conflict markers still has them, so the markers are never uploaded. The
+// preview modes skip that check: they only report.
+func phase3Merge(ctx context.Context, e *Engine) error {
+	if !e.opts.DryRun && !e.opts.ShowDiffs {
+		if err := checkUnresolved(e); err != nil {
+			return err
+		}
+	}
+
+	strategy := e.opts.Strategy
+	if strategy == "" {
+		strategy = StrategyMerge
+	}
+
+	for i := range e.plan.Conflicts {
+		if err := settleConflict(ctx, e, &e.plan.Conflicts[i], strategy); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkUnresolved fails when a path recorded as unresolved by the last sync
+// still holds conflict markers.
+func checkUnresolved(e *Engine) error {
+	var pending []string
+
+	for _, path := range e.unresolved {
+		if _, ok := e.local[path]; !ok {
+			continue
+		}
+
+		data, err := os.ReadFile(filepath.Join(e.projectDir, filepath.FromSlash(path)))
+		if err != nil {
+			return fmt.Errorf("read %s: %w", path, err)
+		}
+
+		if merge.HasConflictMarkers(data) {
+			pending = append(pending, path)
+		}
+	}
+
+	if len(pending) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("%w: %s. Edit the conflict markers out and sync again",
+		ErrUnresolvedConflicts, strings.Join(pending, ", "))
+}
+
+func settleConflict(ctx context.Context, e *Engine, fa *FileAction, strategy Strategy) error {
+	switch strategy {
+	case StrategyOurs:
+		fa.Resolution = ResolveOurs
+
+		return nil
+	case StrategyTheirs:
+		fa.Resolution = ResolveTheirs
+
+		return nil
+	case StrategyMerge:
+	}
+
+	fa.Resolution = ResolveRemoteCopy
+
+	if fa.Classification == ClsDelEditConflict {
+		return nil
+	}
+
+	local, err := os.ReadFile(filepath.Join(e.projectDir, filepath.FromSlash(fa.Path)))
+	if err != nil {
+		return fmt.Errorf("read local %s: %w", fa.Path, err)
+	}
+
+	if !merge.IsText(local) {
+		return nil
+	}
+
+	codeRef := codeRefOrEmpty(e)
+
+	remote, err := fetchVerified(ctx, e, codeRef.CatalogID, codeRef.CatalogVersionID, fa.Path, fa.RemoteHash)
+	if err != nil {
+		return err
+	}
+
+	if !merge.IsText(remote) {
+		return nil
+	}
+
+	base := fetchBase(ctx, e, codeRef.CatalogID, *fa)
+
+	res := merge.Merge(base, local, remote, merge.Labels{
+		Local:  "local",
+		Remote: "remote " + ShortVer(codeRef.CatalogVersionID),
+	})
+
+	switch {
+	case bytes.Equal(res.Content, remote):
+		fa.Resolution = ResolveTheirs
+	case bytes.Equal(res.Content, local):
+		fa.Resolution = ResolveOurs
+	case res.Clean():
+		fa.Resolution = ResolveMerged
+		fa.Merged = res.Content
+	default:
+		fa.Resolution = ResolveMarkers
+		fa.Merged = res.Content
+		fa.ConflictHunks = res.Conflicts
+	}
+
+	return nil
+}
+
+// fetchBase returns the BASE content of a conflict: the file as the last
+// sync left it, downloaded from the catalog version that sync recorded.
+// Without it (ADD_CONFLICT, or the version is gone) the merge runs against
+// an empty base, which marks every differing region as a conflict.
+func fetchBase(ctx context.Context, e *Engine, catalogID string, fa FileAction) []byte {
+	baseVersion := ptrOrEmpty(e.config.LastSyncedVersionID)
+	if fa.BaseHash == "" || baseVersion == "" {
+		return nil
+	}
+
+	base, err := fetchVerified(ctx, e, catalogID, baseVersion, fa.Path, fa.BaseHash)
+	if err != nil {
+		log.Debug("merging without base content", "path", fa.Path, "err", err)
+
+		return nil
+	}
+
+	if !merge.IsText(base) {
+		return nil
+	}
+
+	return base
+}
+
+// fetchVerified downloads one file into memory and checks it against the
+// hash the manifest recorded for it.
+func fetchVerified(ctx context.Context, e *Engine, catalogID, versionID, path, wantHash string) ([]byte, error) {
+	if catalogID == "" || versionID == "" {
+		return nil, fmt.Errorf("no catalog version to read %s from", path)
+	}
+
+	// Server-controlled path; see validateServerPaths.
+	if err := fileops.SafeRelPath(path); err != nil {
+		return nil, fmt.Errorf("server returned unsafe conflict path %q: %w", path, err)
+	}
+
+	var buf bytes.Buffer
+
+	if _, _, err := e.files.DownloadFile(ctx, catalogID, versionID, path, &buf); err != nil {
+		return nil, fmt.Errorf("download %s: %w", path, err)
+	}
+
+	sum := sha256.Sum256(buf.Bytes())
+	if got := hex.EncodeToString(sum[:]); wantHash != "" && got != wantHash {
+		return nil, fmt.Errorf("checksum mismatch on %s: expected %s, got %s", path, wantHash, got)
+	}
+
+	return buf.Bytes(), nil
+}
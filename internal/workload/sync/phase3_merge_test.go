@@ -0,0 +1,320 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sync
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/datarobot/cli/internal/drapi/filesapi"
+	"github.com/datarobot/cli/internal/workload"
+	"github.com/datarobot/cli/internal/workload/wapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// versionedFilesClient serves file content per catalog version, so a test
+// can give BASE (the last synced version) and remote different bodies.
+type versionedFilesClient struct {
+	fakeFilesClient
+	versions  map[string]map[string]string
+	downloads int
+}
+
+func (v *versionedFilesClient) AllFiles(_ context.Context, _, versionID string) (map[string]filesapi.FileMeta, error) {
+	out := map[string]filesapi.FileMeta{}
+	for path, body := range v.versions[versionID] {
+		out[path] = filesapi.FileMeta{Hash: hashOf(body), Size: int64(len(body))}
+	}
+
+	return out, nil
+}
+
+func (v *versionedFilesClient) DownloadFile(_ context.Context, _, versionID, path string, w io.Writer) (string, int64, error) {
+	v.mu.Lock()
+	v.downloads++
+	v.mu.Unlock()
+
+	body, ok := v.versions[versionID][path]
+	if !ok {
+		return "", 0, errors.New("no such file")
+	}
+
+	n, err := io.WriteString(w, body)
+
+	return "", int64(n), err
+}
+
+func hashOf(body string) string {
+	sum := sha256.Sum256([]byte(body))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// conflictProject links a project to cid-1 at base version ver-1 and gives
+// the remote ver-2 and the local tree diverging edits of the same files.
+func conflictProject(t *testing.T, base, local, remote map[string]string) (string, *versionedFilesClient, Deps) {
+	t.Helper()
+
+	dir := initProject(t, local)
+
+	ignore, err := os.ReadFile(filepath.Join(dir, ".wapiignore"))
+	require.NoError(t, err)
+
+	base[".wapiignore"] = string(ignore)
+	remote[".wapiignore"] = string(ignore)
+
+	cfg, err := wapi.LoadConfig(dir)
+	require.NoError(t, err)
+
+	cid, ver := "cid-1", "ver-1"
+	cfg.CatalogID = &cid
+	cfg.LastSyncedVersionID = &ver
+	require.NoError(t, wapi.SaveConfig(dir, cfg))
+
+	synced := time.Now().UTC()
+	manifest := wapi.Manifest{
+		Version:         wapi.ManifestVersion,
+		SyncedAt:        &synced,
+		SyncedVersionID: &ver,
+		Files:           map[string]wapi.FileMeta{},
+	}
+
+	for path, body := range base {
+		manifest.Files[path] = wapi.FileMeta{Hash: hashOf(body), Size: int64(len(body))}
+	}
+
+	require.NoError(t, wapi.SaveManifest(dir, manifest))
+
+	files := &versionedFilesClient{
+		fakeFilesClient: fakeFilesClient{catalogID: cid, stageID: "stage-1", versionID: "ver-3"},
+		versions:        map[string]map[string]string{"ver-1": base, "ver-2": remote},
+	}
+
+	deps := Deps{
+		Files: files,
+		Artifacts: &fakeArtifactStore{
+			GetFn: func(id string) (*workload.Artifact, error) {
+				return draftArtifact(id, cid, "ver-2"), nil
+			},
+		},
+		Now: time.Now,
+	}
+
+	return dir, files, deps
+}
+
+func mergeFixture(t *testing.T) (string, *versionedFilesClient, Deps) {
+	t.Helper()
+
+	return conflictProject(t,
+		map[string]string{"agent.py": "a\nb\nc\n", "shared.py": "x\n"},
+		map[string]string{"agent.py": "A\nb\nc\n", "shared.py": "mine\n"},
+		map[string]string{"agent.py": "a\nb\nC\n", "shared.py": "theirs\n"},
+	)
+}
+
+func conflictByPath(t *testing.T, plan *SyncPlan, path string) FileAction {
+	t.Helper()
+
+	for _, fa := range plan.Conflicts {
+		if fa.Path == path {
+			return fa
+		}
+	}
+
+	t.Fatalf("no conflict row for %s", path)
+
+	return FileAction{}
+}
+
+func TestEngine_Plan_MergesTextConflicts(t *testing.T) {
+	dir, _, deps := mergeFixture(t)
+
+	e, err := newWithDeps(dir, Options{}, deps)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { _ = e.Close() })
+
+	plan, err := e.Plan(context.Background())
+	require.NoError(t, err)
+	require.Len(t, plan.Conflicts, 2)
+
+	agent := conflictByPath(t, plan, "agent.py")
+	assert.Equal(t, ResolveMerged, agent.Resolution)
+	assert.Equal(t, "A\nb\nC\n", string(agent.Merged))
+
+	shared := conflictByPath(t, plan, "shared.py")
+	assert.Equal(t, ResolveMarkers, shared.Resolution)
+	assert.Equal(t, 1, shared.ConflictHunks)
+	assert.Equal(t, "<<<<<<< local\nmine\n=======\ntheirs\n>>>>>>> remote ver-2\n", string(shared.Merged))
+	assert.True(t, shared.NeedsDecision())
+	assert.False(t, agent.NeedsDecision())
+}
+
+func TestEngine_Plan_StrategySkipsMerge(t *testing.T) {
+	for _, tc := range []struct {
+		strategy Strategy
+		want     Resolution
+	}{
+		{StrategyOurs, ResolveOurs},
+		{StrategyTheirs, ResolveTheirs},
+	} {
+		t.Run(string(tc.strategy), func(t *testing.T) {
+			dir, files, deps := mergeFixture(t)
+
+			e, err := newWithDeps(dir, Options{Strategy: tc.strategy}, deps)
+			require.NoError(t, err)
+
+			t.Cleanup(func() { _ = e.Close() })
+
+			plan, err := e.Plan(context.Background())
+			require.NoError(t, err)
+
+			for _, fa := range plan.Conflicts {
+				assert.Equal(t, tc.want, fa.Resolution, fa.Path)
+			}
+
+			assert.Zero(t, files.downloads, "an explicit strategy needs no content")
+		})
+	}
+}
+
+func TestEngine_Plan_BinaryConflictKeepsRemoteCopy(t *testing.T) {
+	dir, _, deps := conflictProject(t,
+		map[string]string{"model.bin": "\x00base"},
+		map[string]string{"model.bin": "\x00mine"},
+		map[string]string{"model.bin": "\x00theirs"},
+	)
+
+	e, err := newWithDeps(dir, Options{}, deps)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { _ = e.Close() })
+
+	plan, err := e.Plan(context.Background())
+	require.NoError(t, err)
+
+	fa := conflictByPath(t, plan, "model.bin")
+	assert.Equal(t, ResolveRemoteCopy, fa.Resolution)
+	assert.True(t, fa.NeedsDecision())
+}
+
+func TestEngine_Run_AppliesMergeAndBlocksOnMarkers(t *testing.T) {
+	dir, files, deps := mergeFixture(t)
+
+	e, err := newWithDeps(dir, Options{Yes: true}, deps)
+	require.NoError(t, err)
+
+	result, err := e.Run(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"agent.py"}, result.Merged)
+	assert.Equal(t, []string{"shared.py"}, result.Unresolved)
+	assert.Empty(t, result.ConflictCopies)
+
+	assert.Equal(t, "A\nb\nC\n", readFile(t, dir, "agent.py"))
+	assert.Equal(t, "A\nb\nC\n", string(files.uploadedFiles["agent.py"]))
+	assert.NotContains(t, files.uploadedFiles, "shared.py", "conflict markers must not be uploaded")
+	assert.Contains(t, readFile(t, dir, "shared.py"), "<<<<<<< local\n")
+
+	matches, err := filepath.Glob(filepath.Join(dir, "*.LOCAL.*"))
+	require.NoError(t, err)
+	assert.Empty(t, matches)
+
+	manifest, err := wapi.LoadManifest(dir)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"shared.py"}, manifest.Unresolved)
+	assert.Equal(t, hashOf("A\nb\nC\n"), manifest.Files["agent.py"].Hash)
+	assert.Equal(t, hashOf("theirs\n"), manifest.Files["shared.py"].Hash)
+
+	// The next sync refuses to upload the markers.
+	deps.Artifacts = &fakeArtifactStore{
+		GetFn: func(id string) (*workload.Artifact, error) {
+			return draftArtifact(id, "cid-1", "ver-3"), nil
+		},
+	}
+
+	next, err := newWithDeps(dir, Options{Yes: true}, deps)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { _ = next.Close() })
+
+	_, err = next.Plan(context.Background())
+	require.ErrorIs(t, err, ErrUnresolvedConflicts)
+	assert.ErrorContains(t, err, "shared.py")
+}
+
+func TestEngine_Run_ResolveOverridesMerge(t *testing.T) {
+	dir, files, deps := mergeFixture(t)
+
+	e, err := newWithDeps(dir, Options{Yes: true}, deps)
+	require.NoError(t, err)
+
+	plan, err := e.Plan(context.Background())
+	require.NoError(t, err)
+
+	require.NoError(t, plan.Resolve("shared.py", ResolveOurs, nil))
+	require.NoError(t, plan.Resolve("agent.py", ResolveTheirs, nil))
+
+	result, err := e.Execute(context.Background(), plan)
+	require.NoError(t, err)
+	assert.Empty(t, result.Unresolved)
+
+	assert.Equal(t, "mine\n", string(files.uploadedFiles["shared.py"]))
+	assert.Equal(t, "a\nb\nC\n", readFile(t, dir, "agent.py"))
+
+	manifest, err := wapi.LoadManifest(dir)
+	require.NoError(t, err)
+	assert.Empty(t, manifest.Unresolved)
+	assert.Equal(t, hashOf("mine\n"), manifest.Files["shared.py"].Hash)
+	assert.Equal(t, hashOf("a\nb\nC\n"), manifest.Files["agent.py"].Hash)
+}
+
+func TestSyncPlan_Resolve(t *testing.T) {
+	plan := &SyncPlan{Conflicts: []FileAction{
+		{Path: "gone.py", Classification: ClsDelEditConflict},
+	}}
+
+	require.ErrorContains(t, plan.Resolve("missing.py", ResolveOurs, nil), "no conflict at missing.py")
+	require.ErrorContains(t, plan.Resolve("gone.py", ResolveMerged, []byte("x")), "deleted remotely")
+	require.NoError(t, plan.Resolve("gone.py", ResolveOurs, nil))
+	assert.Equal(t, ResolveOurs, plan.Conflicts[0].Resolution)
+}
+
+func TestParseStrategy(t *testing.T) {
+	got, err := ParseStrategy("Theirs")
+	require.NoError(t, err)
+	assert.Equal(t, StrategyTheirs, got)
+
+	_, err = ParseStrategy("both")
+	require.ErrorContains(t, err, "use one of ours, theirs, merge")
+}
+
+func readFile(t *testing.T, dir, rel string) string {
+	t.Helper()
+
+	data, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(rel)))
+	require.NoError(t, err)
+
+	return string(data)
+}
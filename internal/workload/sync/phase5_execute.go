@@ -15,6 +15,7 @@
 package sync
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
@@ -27,7 +28,7 @@ import (
 // rollback dir, conflict copies, downloads + local-side deletes, remote
 // deletes, uploads. Any error after the rollback dir is created triggers
 // a Restore to pre-sync state.
-func phase5Execute(e *Engine) error {
+func phase5Execute(ctx context.Context, e *Engine) error {
 	if e.plan == nil || e.plan.IsEmpty() {
 		return nil
 	}
@@ -52,7 +53,7 @@ func phase5Execute(e *Engine) error {
 		return err
 	}
 
-	if err := executePlan(e, rb); err != nil {
+	if err := executePlan(ctx, e, rb); err != nil {
 		_ = rb.Restore()
 		return err
 	}
@@ -64,14 +65,18 @@ func phase5Execute(e *Engine) error {
 	return nil
 }
 
-func executePlan(e *Engine, rb *Rollback) error {
+func executePlan(ctx context.Context, e *Engine, rb *Rollback) error {
 	if err := applyConflictCopies(e, rb); err != nil {
 		return fmt.Errorf("conflict copies: %w", err)
 	}
 
+	if err := applyResolutions(e, rb); err != nil {
+		return fmt.Errorf("conflict resolutions: %w", err)
+	}
+
 	codeRef := codeRefOrEmpty(e)
 
-	if err := applyDownloads(e, rb, codeRef); err != nil {
+	if err := applyDownloads(ctx, e, rb, codeRef); err != nil {
 		return fmt.Errorf("downloads: %w", err)
 	}
 
@@ -79,7 +84,7 @@ func executePlan(e *Engine, rb *Rollback) error {
 		return fmt.Errorf("local deletes: %w", err)
 	}
 
-	newCatalogID, newVersionID, err := applyRemoteDeletesAndUploads(e, codeRef)
+	newCatalogID, newVersionID, err := applyRemoteDeletesAndUploads(ctx, e, codeRef)
 	if err != nil {
 		return err
 	}
@@ -90,14 +95,14 @@ func executePlan(e *Engine, rb *Rollback) error {
 	return nil
 }
 
-// applyConflictCopies renames each conflict's local file to
-// <path>.LOCAL.<ISO8601Z>. EDIT_DEL_CONFLICT is excluded since the user
-// already deleted that file.
+// applyConflictCopies renames the local file of each ResolveRemoteCopy
+// conflict to <path>.LOCAL.<ISO8601Z>. EDIT_DEL_CONFLICT is excluded since
+// the user already deleted that file.
 func applyConflictCopies(e *Engine, rb *Rollback) error {
 	stamp := e.nowFn().UTC().Format("20060102T150405Z")
 
 	for _, fa := range e.plan.Conflicts {
-		if fa.Classification == ClsEditDelConflict {
+		if fa.Classification == ClsEditDelConflict || fa.Resolution != ResolveRemoteCopy {
 			continue
 		}
 
@@ -119,10 +124,83 @@ func applyConflictCopies(e *Engine, rb *Rollback) error {
 	return nil
 }
 
+// applyResolutions carries out every other conflict resolution. Merged
+// content is written over the local file; local and merged content joins
+// the upload list; a remote delete the user accepted removes the local file.
+// ResolveTheirs rows otherwise download with the rest in applyDownloads.
+func applyResolutions(e *Engine, rb *Rollback) error {
+	for _, fa := range e.plan.Conflicts {
+		abs := filepath.Join(e.projectDir, filepath.FromSlash(fa.Path))
+
+		switch fa.Resolution {
+		case ResolveRemoteCopy:
+		case ResolveTheirs:
+			if err := rb.Backup(fa.Path); err != nil {
+				return err
+			}
+
+			if fa.Classification != ClsDelEditConflict {
+				continue
+			}
+
+			if err := os.Remove(abs); err != nil && !errors.Is(err, os.ErrNotExist) {
+				return fmt.Errorf("remove %s: %w", fa.Path, err)
+			}
+		case ResolveOurs:
+			e.plan.Uploads = append(e.plan.Uploads, resolvedUpload(fa, fa.LocalHash, fa.LocalSize))
+		case ResolveMerged:
+			hash, size, err := writeResolved(rb, fa, abs)
+			if err != nil {
+				return err
+			}
+
+			e.plan.Uploads = append(e.plan.Uploads, resolvedUpload(fa, hash, size))
+			e.mergedPaths = append(e.mergedPaths, fa.Path)
+		case ResolveMarkers:
+			if _, _, err := writeResolved(rb, fa, abs); err != nil {
+				return err
+			}
+
+			e.markedPaths = append(e.markedPaths, fa.Path)
+		}
+	}
+
+	return nil
+}
+
+// writeResolved backs up the local file, replaces it with fa.Merged, and
+// returns the hash and size the upload and the new BASE record for it.
+func writeResolved(rb *Rollback, fa FileAction, abs string) (string, int64, error) {
+	if err := rb.Backup(fa.Path); err != nil {
+		return "", 0, err
+	}
+
+	// os.WriteFile keeps the mode of the existing file.
+	if err := os.WriteFile(abs, fa.Merged, 0o644); err != nil { //nolint:gosec // project files are not secrets
+		return "", 0, fmt.Errorf("write %s: %w", fa.Path, err)
+	}
+
+	return fileops.HashFile(abs)
+}
+
+// resolvedUpload turns a conflict row into the upload of its settled
+// content. A DEL_EDIT_CONFLICT is an add: the remote no longer has the file.
+func resolvedUpload(fa FileAction, hash string, size int64) FileAction {
+	fa.Action = ActUploadModify
+	if fa.RemoteHash == "" {
+		fa.Action = ActUploadAdd
+	}
+
+	fa.LocalHash = hash
+	fa.LocalSize = size
+
+	return fa
+}
+
 // applyDownloads runs the download list plus the conflict-copy follow-up
 // downloads (remote wins, so remote bytes land at the original path).
 // REMOTE_DELETED files are removed locally instead.
-func applyDownloads(e *Engine, rb *Rollback, codeRef codeRefRef) error {
+func applyDownloads(ctx context.Context, e *Engine, rb *Rollback, codeRef codeRefRef) error {
 	if codeRef.CatalogID == "" || codeRef.CatalogVersionID == "" {
 		return nil
 	}
@@ -136,7 +214,7 @@ func applyDownloads(e *Engine, rb *Rollback, codeRef codeRefRef) error {
 	}
 
 	pulls := pullList(e.plan)
-	if err := downloadFiles(e, codeRef.CatalogID, codeRef.CatalogVersionID, pulls); err != nil {
+	if err := downloadFiles(ctx, e, codeRef.CatalogID, codeRef.CatalogVersionID, pulls); err != nil {
 		return err
 	}
 
@@ -224,9 +302,10 @@ func validateServerPaths(plan *SyncPlan) error {
 	return nil
 }
 
-// pullList collects FileActions that need remote bytes pulled to disk.
+// pullList collects FileActions that need remote bytes pulled to disk:
+// the downloads, and the conflicts that resolve to the remote version.
 // DEL_EDIT_CONFLICT is skipped because the remote deleted that file;
-// only the local rename to .LOCAL.<ts> needs to happen.
+// only the local rename or removal needs to happen.
 func pullList(plan *SyncPlan) []FileAction {
 	out := make([]FileAction, 0, len(plan.Downloads)+len(plan.Conflicts))
 	out = append(out, plan.Downloads...)
@@ -236,6 +315,10 @@ func pullList(plan *SyncPlan) []FileAction {
 			continue
 		}
 
+		if fa.Resolution != ResolveRemoteCopy && fa.Resolution != ResolveTheirs {
+			continue
+		}
+
 		out = append(out, fa)
 	}
 
@@ -251,12 +334,12 @@ func applyLocalDeletes(_ *Engine, _ *Rollback) error {
 // applyRemoteDeletesAndUploads sends LOCAL_DELETED paths to FilesAPI,
 // runs the chosen Uploader for LOCAL_MODIFIED + LOCAL_ADDED, and PATCHes
 // the artifact's codeRef so the workload picks up the new version.
-func applyRemoteDeletesAndUploads(e *Engine, codeRef codeRefRef) (string, string, error) {
+func applyRemoteDeletesAndUploads(ctx context.Context, e *Engine, codeRef codeRefRef) (string, string, error) {
 	catalogID := codeRef.CatalogID
 	newCatalogID := catalogID
 	newVersionID := codeRef.CatalogVersionID
 
-	if vid, err := applyDeletes(e, catalogID); err != nil {
+	if vid, err := applyDeletes(ctx, e, catalogID); err != nil {
 		return "", "", err
 	} else if vid != "" {
 		newVersionID = vid
@@ -265,7 +348,7 @@ func applyRemoteDeletesAndUploads(e *Engine, codeRef codeRefRef) (string, string
 	if len(e.plan.Uploads) > 0 {
 		uploader := ChooseUploader(e.plan)
 
-		cid, vid, err := uploader.ApplyUploads(e, e.plan.Uploads)
+		cid, vid, err := uploader.ApplyUploads(ctx, e, e.plan.Uploads)
 		if err != nil {
 			return "", "", err
 		}
@@ -275,7 +358,7 @@ func applyRemoteDeletesAndUploads(e *Engine, codeRef codeRefRef) (string, string
 	}
 
 	if newVersionID != "" && newVersionID != codeRef.CatalogVersionID {
-		if err := e.artifacts.PatchCodeRef(e.config.ArtifactID, newCatalogID, newVersionID); err != nil {
+		if err := e.artifacts.PatchCodeRef(ctx, e.config.ArtifactID, newCatalogID, newVersionID); err != nil {
 			return "", "", fmt.Errorf("update artifact codeRef: %w", err)
 		}
 	}
@@ -285,7 +368,7 @@ func applyRemoteDeletesAndUploads(e *Engine, codeRef codeRefRef) (string, string
 
 // applyDeletes runs the LOCAL_DELETED to remote-delete step. Returns the
 // new catalog version ID, or "" when nothing to delete or no catalog yet.
-func applyDeletes(e *Engine, catalogID string) (string, error) {
+func applyDeletes(ctx context.Context, e *Engine, catalogID string) (string, error) {
 	deletePaths := make([]string, 0)
 
 	for _, fa := range e.plan.Deletes {
@@ -298,7 +381,7 @@ func applyDeletes(e *Engine, catalogID string) (string, error) {
 		return "", nil
 	}
 
-	resp, err := e.files.DeleteFiles(catalogID, deletePaths)
+	resp, err := e.files.DeleteFiles(ctx, catalogID, deletePaths)
 	if err != nil {
 		return "", fmt.Errorf("delete remote files: %w", err)
 	}
@@ -15,6 +15,7 @@
 package sync
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -24,7 +25,7 @@ import (
 // phase6State writes the new BASE manifest, config, history entry, and
 // discards the rollback. Failures here do NOT roll back Phase 5 since
 // the remote has already advanced; the next sync will reconcile.
-func phase6State(e *Engine) error {
+func phase6State(_ context.Context, e *Engine) error {
 	if e.plan == nil {
 		return nil
 	}
@@ -90,7 +91,8 @@ func buildNewBaseManifest(e *Engine, syncedVersionID string, syncedAt time.Time)
 	}
 
 	for _, fa := range e.plan.Conflicts {
-		if fa.RemoteHash == "" {
+		// Kept and merged content was uploaded; its Uploads row is the BASE.
+		if fa.RemoteHash == "" || fa.Resolution == ResolveOurs || fa.Resolution == ResolveMerged {
 			continue
 		}
 
@@ -105,6 +107,7 @@ func buildNewBaseManifest(e *Engine, syncedVersionID string, syncedAt time.Time)
 		SyncedAt:        &syncedAtCopy,
 		SyncedVersionID: &versionCopy,
 		Files:           files,
+		Unresolved:      e.markedPaths,
 	}
 }
 
@@ -125,6 +128,14 @@ func syncHistoryEntry(e *Engine, now time.Time) wapi.HistoryEntry {
 		entry["conflict_files"] = e.plan.ConflictPaths()
 	}
 
+	if len(e.mergedPaths) > 0 {
+		entry["merged_files"] = e.mergedPaths
+	}
+
+	if len(e.markedPaths) > 0 {
+		entry["unresolved_files"] = e.markedPaths
+	}
+
 	return entry
 }
 
@@ -137,6 +148,8 @@ func (e *Engine) populateResult(versionForState string) {
 		DeletedCount:    len(e.plan.Deletes),
 		ConflictCount:   len(e.plan.Conflicts),
 		ConflictCopies:  e.conflictCopies,
+		Merged:          e.mergedPaths,
+		Unresolved:      e.markedPaths,
 		Duration:        e.nowFn().Sub(e.startedAt),
 	}
 
@@ -54,7 +54,7 @@ type LockfileRunner func(dir string) error
 // missing or resolution fails it logs a WARN with the fix and lets the
 // sync proceed (lockfileHint doubles as the once-only guard so phase 2's
 // .wapiignore check doesn't stack a second warning).
-func phaseLockfile(e *Engine) error {
+func phaseLockfile(_ context.Context, e *Engine) error {
 	if !fileExistsIn(e.projectDir, pyprojectFile) {
 		return nil
 	}
@@ -15,6 +15,7 @@
 package sync
 
 import (
+	"context"
 	"errors"
 	"os"
 	"path/filepath"
@@ -69,7 +70,7 @@ func TestEngine_Plan_GeneratesLockfileWhenMissing(t *testing.T) {
 
 	e := lockfileEngine(t, dir, runner)
 
-	plan, err := e.Plan()
+	plan, err := e.Plan(context.Background())
 	require.NoError(t, err)
 
 	// The generated lock exists before the walk, so it flows through the
@@ -96,7 +97,7 @@ func TestEngine_Plan_LeavesExistingLockfileAlone(t *testing.T) {
 
 	e := lockfileEngine(t, dir, runner)
 
-	_, err := e.Plan()
+	_, err := e.Plan(context.Background())
 	require.NoError(t, err)
 
 	assert.False(t, runnerCalled, "runner must not run when uv.lock already exists")
@@ -116,7 +117,7 @@ func TestEngine_Plan_SkipsLockfileWithoutPyproject(t *testing.T) {
 
 	e := lockfileEngine(t, dir, runner)
 
-	_, err := e.Plan()
+	_, err := e.Plan(context.Background())
 	require.NoError(t, err)
 
 	assert.False(t, runnerCalled, "runner must not run for non-Python projects")
@@ -130,7 +131,7 @@ func TestEngine_Plan_UvMissingHintsWithoutBlocking(t *testing.T) {
 
 	e := lockfileEngine(t, dir, func(string) error { return errUvNotFound })
 
-	plan, err := e.Plan()
+	plan, err := e.Plan(context.Background())
 	require.NoError(t, err, "missing uv must not fail the sync")
 
 	assert.NotContains(t, uploadPathsOf(plan), "uv.lock")
@@ -148,7 +149,7 @@ func TestEngine_Plan_LockGenerationFailureHintsWithoutBlocking(t *testing.T) {
 		return errors.New("uv lock failed: no wheels for left-pad")
 	})
 
-	plan, err := e.Plan()
+	plan, err := e.Plan(context.Background())
 	require.NoError(t, err, "lock-generation failure must not fail the sync")
 
 	assert.NotContains(t, uploadPathsOf(plan), "uv.lock")
@@ -165,7 +166,7 @@ func TestEngine_Plan_RunnerSucceedsButLockfileAbsentHints(t *testing.T) {
 
 	e := lockfileEngine(t, dir, func(string) error { return nil }) // exits 0, writes nothing
 
-	plan, err := e.Plan()
+	plan, err := e.Plan(context.Background())
 	require.NoError(t, err)
 
 	assert.NotContains(t, uploadPathsOf(plan), "uv.lock")
@@ -188,7 +189,7 @@ func TestEngine_Plan_WarnsWhenWapiignoreExcludesLockfile(t *testing.T) {
 		return nil
 	})
 
-	plan, err := e.Plan()
+	plan, err := e.Plan(context.Background())
 	require.NoError(t, err)
 
 	assert.False(t, runnerCalled, "lock exists; generation must not run")
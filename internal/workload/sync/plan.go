@@ -25,6 +25,14 @@ type FileAction struct {
 	RemoteSize     int64
 	LocalHash      string
 	RemoteHash     string
+	BaseHash       string
+
+	// Resolution, Merged and ConflictHunks apply to Conflicts rows only: how
+	// Phase 5 settles the conflict, the content it writes for ResolveMerged
+	// and ResolveMarkers, and how many conflict blocks that content holds.
+	Resolution    Resolution
+	Merged        []byte
+	ConflictHunks int
 }
 
 // SyncPlan is the blueprint Phase 5 executes and the structure the display
@@ -52,8 +60,8 @@ func (p *SyncPlan) Append(fa FileAction) {
 	case ActUploadDelete, ActDownloadDelete:
 		p.Deletes = append(p.Deletes, fa)
 	case ActConflictCopy:
-		// Conflicts also need the remote download (remote wins); the
-		// executor issues that download alongside the conflict copy.
+		// The merge phase settles each conflict; until then it is the
+		// remote-wins copy, which needs the remote download.
 		p.Conflicts = append(p.Conflicts, fa)
 	}
 }
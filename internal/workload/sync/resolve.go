@@ -0,0 +1,134 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sync
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/datarobot/cli/internal/workload/sync/merge"
+)
+
+// Strategy is how the engine settles conflict rows before Phase 5.
+type Strategy string
+
+const (
+	// StrategyMerge three-way merges text conflicts. A clean merge is written
+	// and uploaded; a merge with conflicting hunks is written with conflict
+	// markers and left for the user. Conflicts the merge cannot represent
+	// (binary files, an edit against a remote delete) keep the remote version
+	// and save the local one as a *.LOCAL.<ts> copy. The zero Strategy means
+	// StrategyMerge.
+	StrategyMerge Strategy = "merge"
+	// StrategyOurs keeps every local version and uploads it.
+	StrategyOurs Strategy = "ours"
+	// StrategyTheirs takes every remote version, discarding the local edit.
+	StrategyTheirs Strategy = "theirs"
+)
+
+// Strategies lists the values ParseStrategy accepts, in the order help text
+// shows them.
+var Strategies = []Strategy{StrategyOurs, StrategyTheirs, StrategyMerge}
+
+// ParseStrategy maps a --strategy value to a Strategy.
+func ParseStrategy(s string) (Strategy, error) {
+	for _, strategy := range Strategies {
+		if strings.EqualFold(s, string(strategy)) {
+			return strategy, nil
+		}
+	}
+
+	names := make([]string, len(Strategies))
+	for i, strategy := range Strategies {
+		names[i] = string(strategy)
+	}
+
+	return "", fmt.Errorf("invalid strategy %q: use one of %s", s, strings.Join(names, ", "))
+}
+
+// Resolution is how Phase 5 settles one conflict row.
+type Resolution int
+
+const (
+	// ResolveRemoteCopy takes the remote version and keeps the local one as
+	// a *.LOCAL.<ts> copy.
+	ResolveRemoteCopy Resolution = iota
+	// ResolveTheirs takes the remote version; for DEL_EDIT_CONFLICT that
+	// means deleting the local file.
+	ResolveTheirs
+	// ResolveOurs keeps the local version and uploads it.
+	ResolveOurs
+	// ResolveMerged writes FileAction.Merged and uploads it.
+	ResolveMerged
+	// ResolveMarkers writes FileAction.Merged, conflict markers and all, and
+	// uploads nothing: the next sync uploads the file once the user has
+	// removed the markers.
+	ResolveMarkers
+)
+
+var resolutionNames = map[Resolution]string{
+	ResolveRemoteCopy: "remote-copy",
+	ResolveTheirs:     "theirs",
+	ResolveOurs:       "ours",
+	ResolveMerged:     "merged",
+	ResolveMarkers:    "conflict-markers",
+}
+
+func (r Resolution) String() string {
+	if name, ok := resolutionNames[r]; ok {
+		return name
+	}
+
+	return "unknown"
+}
+
+// NeedsDecision reports whether a conflict row was left unsettled by the
+// strategy: a merge with conflicting hunks, or a conflict the merge could
+// not represent. These are the rows an interactive caller asks about.
+func (fa FileAction) NeedsDecision() bool {
+	return fa.Classification.IsConflict() &&
+		(fa.Resolution == ResolveMarkers || fa.Resolution == ResolveRemoteCopy)
+}
+
+// Resolve overrides how Phase 5 settles the conflict at path. content is
+// required for ResolveMerged and ResolveMarkers and ignored otherwise.
+func (p *SyncPlan) Resolve(path string, r Resolution, content []byte) error {
+	for i := range p.Conflicts {
+		fa := &p.Conflicts[i]
+		if fa.Path != path {
+			continue
+		}
+
+		if fa.Classification == ClsDelEditConflict && (r == ResolveMerged || r == ResolveMarkers) {
+			return fmt.Errorf("%s was deleted remotely; keep one version instead", path)
+		}
+
+		fa.Resolution = r
+		fa.Merged = nil
+		fa.ConflictHunks = 0
+
+		if r == ResolveMerged || r == ResolveMarkers {
+			fa.Merged = content
+		}
+
+		if r == ResolveMarkers {
+			fa.ConflictHunks = merge.CountConflicts(content)
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("no conflict at %s", path)
+}
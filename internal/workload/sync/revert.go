@@ -0,0 +1,294 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/datarobot/cli/internal/workload/fileops"
+	"github.com/datarobot/cli/internal/workload/wapi"
+)
+
+// revertPlanPhases replace phases 2-4 for Options.RevertTo. The plan
+// diffs the current remote against the target version rather than three
+// ways, so the lockfile and merge phases have nothing to do.
+var revertPlanPhases = []phase{
+	{name: "preflight", run: phase0Preflight},
+	{name: "gather", run: phase1Gather},
+	{name: "revert check", run: phaseRevertCheck},
+	{name: "manifests", run: phase2Manifests},
+	{name: "revert diff", run: phaseRevertDiff},
+	{name: "preview", run: phase4Preview},
+}
+
+// revertExecutePhases replace phases 5-6 for Options.RevertTo.
+var revertExecutePhases = []phase{
+	{name: "revert execute", run: phaseRevertExecute},
+	{name: "revert state", run: phaseRevertState},
+}
+
+// phaseRevertCheck refuses a revert the plan could not describe. A revert
+// is computed against the version last synced, so a remote that moved on
+// since has to be synced first; otherwise its changes would be silently
+// dropped from the new version.
+func phaseRevertCheck(_ context.Context, e *Engine) error {
+	if codeRefOrEmpty(e).CatalogID == "" || e.remoteVer == "" {
+		return errors.New("no code has been synced yet. Run 'dr artifact code sync' first")
+	}
+
+	if e.drifted {
+		return errors.New("the artifact has changed since the last sync. Run 'dr artifact code sync' first, then revert")
+	}
+
+	if e.opts.RevertTo == e.remoteVer {
+		return fmt.Errorf("version %s is already the current version", ShortVer(e.opts.RevertTo))
+	}
+
+	return nil
+}
+
+// phaseRevertDiff fetches the target version's manifest and plans the
+// change from the current remote to it. The working tree is rewritten to
+// match, so it must hold nothing the last sync did not.
+func phaseRevertDiff(ctx context.Context, e *Engine) error {
+	if changed := Diff(e.base, e.local, e.remote); !changed.IsEmpty() {
+		n := len(changed.Uploads) + len(changed.Deletes)
+
+		return fmt.Errorf("%d local change(s) since the last sync. Sync or discard them before reverting", n)
+	}
+
+	target, err := e.files.AllFiles(ctx, codeRefOrEmpty(e).CatalogID, e.opts.RevertTo)
+	if err != nil {
+		return fmt.Errorf("fetch manifest of version %s: %w", ShortVer(e.opts.RevertTo), err)
+	}
+
+	e.target = FromFilesAPI(target)
+
+	plan := RevertPlan(e.remote, e.target)
+	plan.OldVersionShort = ShortVer(ptrOrEmpty(e.config.LastSyncedVersionID))
+	e.plan = plan
+
+	return nil
+}
+
+// RevertPlan produces the plan that turns current into target: files the
+// target has with different content are uploaded with the target's bytes,
+// and files it lacks are deleted. The rows read as local changes, with the
+// target in the Local fields, so the plan renders like a sync that pushes.
+func RevertPlan(current, target RemoteManifest) *SyncPlan {
+	plan := &SyncPlan{}
+
+	for path, t := range target {
+		c, ok := current[path]
+		if ok && c.Hash == t.Hash {
+			continue
+		}
+
+		fa := FileAction{
+			Path:           path,
+			Classification: ClsLocalModified,
+			Action:         ActUploadModify,
+			LocalSize:      t.Size,
+			RemoteSize:     c.Size,
+			LocalHash:      t.Hash,
+			RemoteHash:     c.Hash,
+			BaseHash:       c.Hash,
+		}
+
+		if !ok {
+			fa.Classification = ClsLocalAdded
+			fa.Action = ActUploadAdd
+		}
+
+		plan.Append(fa)
+	}
+
+	for path, c := range current {
+		if _, ok := target[path]; ok {
+			continue
+		}
+
+		plan.Append(FileAction{
+			Path:           path,
+			Classification: ClsLocalDeleted,
+			Action:         ActUploadDelete,
+			RemoteSize:     c.Size,
+			RemoteHash:     c.Hash,
+			BaseHash:       c.Hash,
+		})
+	}
+
+	return plan
+}
+
+// phaseRevertExecute writes the target's files into the working tree and
+// then pushes them through the usual remote-delete and upload step, which
+// reads from the working tree. Any error restores the working tree.
+func phaseRevertExecute(ctx context.Context, e *Engine) error {
+	if e.plan == nil || e.plan.IsEmpty() {
+		return nil
+	}
+
+	// Every path in a revert plan came from the server.
+	for _, group := range [][]FileAction{e.plan.Uploads, e.plan.Deletes} {
+		for _, fa := range group {
+			if err := fileops.SafeRelPath(fa.Path); err != nil {
+				return fmt.Errorf("server returned unsafe path %q: %w", fa.Path, err)
+			}
+		}
+	}
+
+	if len(e.plan.Uploads)+len(e.plan.Deletes) > RollbackMaxFiles {
+		return fmt.Errorf("plan exceeds RollbackMaxFiles=%d; refusing to run", RollbackMaxFiles)
+	}
+
+	if err := EnsureSpaceFor(e.projectDir, e.plan.TotalUploadBytes()); err != nil {
+		return err
+	}
+
+	rb, err := NewRollback(e.projectDir)
+	if err != nil {
+		return err
+	}
+
+	if err := executeRevert(ctx, e, rb); err != nil {
+		_ = rb.Restore()
+		return err
+	}
+
+	e.rollback = rb
+
+	return nil
+}
+
+func executeRevert(ctx context.Context, e *Engine, rb *Rollback) error {
+	codeRef := codeRefOrEmpty(e)
+	pulls := make([]FileAction, 0, len(e.plan.Uploads))
+
+	for _, fa := range e.plan.Uploads {
+		if err := rb.Backup(fa.Path); err != nil {
+			return err
+		}
+
+		pulls = append(pulls, FileAction{Path: fa.Path, RemoteHash: fa.LocalHash, RemoteSize: fa.LocalSize})
+	}
+
+	for _, fa := range e.plan.Deletes {
+		if err := rb.Backup(fa.Path); err != nil {
+			return err
+		}
+	}
+
+	if err := downloadFiles(ctx, e, codeRef.CatalogID, e.opts.RevertTo, pulls); err != nil {
+		return fmt.Errorf("downloads: %w", err)
+	}
+
+	for _, fa := range pulls {
+		rb.TrackCreated(filepath.Join(e.projectDir, filepath.FromSlash(fa.Path)))
+	}
+
+	for _, fa := range e.plan.Deletes {
+		abs := filepath.Join(e.projectDir, filepath.FromSlash(fa.Path))
+		if err := os.Remove(abs); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("remove %s: %w", fa.Path, err)
+		}
+	}
+
+	newCatalogID, newVersionID, err := applyRemoteDeletesAndUploads(ctx, e, codeRef)
+	if err != nil {
+		return err
+	}
+
+	e.newCatalogID = newCatalogID
+	e.newVersionID = newVersionID
+
+	return nil
+}
+
+// phaseRevertState records the new version as synced with the target's
+// files as BASE, and logs the revert. As with phase6State, a failure here
+// does not undo the remote.
+func phaseRevertState(_ context.Context, e *Engine) error {
+	if e.plan == nil {
+		return nil
+	}
+
+	now := e.nowFn().UTC()
+	cfg := e.config
+
+	if e.newCatalogID != "" {
+		cid := e.newCatalogID
+		cfg.CatalogID = &cid
+	}
+
+	version := e.newVersionID
+	if version == "" {
+		version = e.remoteVer
+	}
+
+	cfg.LastSyncedVersionID = &version
+
+	if err := wapi.SaveConfig(e.projectDir, cfg); err != nil {
+		return fmt.Errorf("save config: %w", err)
+	}
+
+	files := make(map[string]wapi.FileMeta, len(e.target))
+	for path, fe := range e.target {
+		files[path] = wapi.FileMeta{Hash: fe.Hash, Size: fe.Size}
+	}
+
+	manifest := wapi.Manifest{
+		Version:         wapi.ManifestVersion,
+		SyncedAt:        &now,
+		SyncedVersionID: &version,
+		Files:           files,
+	}
+
+	if err := wapi.SaveManifest(e.projectDir, manifest); err != nil {
+		return fmt.Errorf("save manifest: %w", err)
+	}
+
+	if err := wapi.AppendHistory(e.projectDir, revertHistoryEntry(e, now)); err != nil {
+		return fmt.Errorf("append history: %w", err)
+	}
+
+	if e.rollback != nil {
+		_ = e.rollback.Discard()
+		e.rollback = nil
+	}
+
+	e.config = cfg
+	e.populateResult(version)
+
+	return nil
+}
+
+// revertHistoryEntry assembles the history.log line for a revert.
+func revertHistoryEntry(e *Engine, now time.Time) wapi.HistoryEntry {
+	return wapi.HistoryEntry{
+		"ts":       now.Format(time.RFC3339),
+		"op":       "revert",
+		"version":  fmt.Sprintf("%s→%s", ShortVer(e.plan.OldVersionShort), ShortVer(e.newVersionID)),
+		"target":   ShortVer(e.opts.RevertTo),
+		"uploaded": len(e.plan.Uploads),
+		"deleted":  len(e.plan.Deletes),
+		"duration": e.nowFn().Sub(e.startedAt).Round(time.Millisecond).String(),
+	}
+}
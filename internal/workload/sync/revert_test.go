@@ -0,0 +1,194 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sync
+
+import (
+	"context"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/datarobot/cli/internal/drapi/filesapi"
+	"github.com/datarobot/cli/internal/workload"
+	"github.com/datarobot/cli/internal/workload/wapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// revertProject links a project synced at ver-2 whose working tree holds
+// current, and returns it with a files client that also knows ver-1.
+func revertProject(t *testing.T, current, previous map[string]string) (string, *versionedFilesClient) {
+	t.Helper()
+
+	dir := initProject(t, current)
+
+	ignoreBody, err := os.ReadFile(filepath.Join(dir, ".wapiignore"))
+	require.NoError(t, err)
+
+	current[".wapiignore"] = string(ignoreBody)
+	previous[".wapiignore"] = string(ignoreBody)
+
+	cfg, err := wapi.LoadConfig(dir)
+	require.NoError(t, err)
+
+	cid, ver := "cid-1", "ver-2"
+	cfg.CatalogID = &cid
+	cfg.LastSyncedVersionID = &ver
+	require.NoError(t, wapi.SaveConfig(dir, cfg))
+
+	synced := time.Now().UTC()
+	manifest := wapi.Manifest{
+		Version:         wapi.ManifestVersion,
+		SyncedAt:        &synced,
+		SyncedVersionID: &ver,
+		Files:           map[string]wapi.FileMeta{},
+	}
+
+	for path, body := range current {
+		manifest.Files[path] = wapi.FileMeta{Hash: hashOf(body), Size: int64(len(body))}
+	}
+
+	require.NoError(t, wapi.SaveManifest(dir, manifest))
+
+	files := &versionedFilesClient{
+		fakeFilesClient: fakeFilesClient{catalogID: cid, stageID: "stage-1", versionID: "ver-3"},
+		versions:        map[string]map[string]string{"ver-1": previous, "ver-2": current},
+	}
+
+	return dir, files
+}
+
+func newRevertEngine(t *testing.T, dir string, files filesapi.Client, remoteVer string, patched *string) *Engine {
+	t.Helper()
+
+	e, err := newWithDeps(dir, Options{Yes: true, RevertTo: "ver-1"}, Deps{
+		Files: files,
+		Artifacts: &fakeArtifactStore{
+			GetFn: func(id string) (*workload.Artifact, error) {
+				return draftArtifact(id, "cid-1", remoteVer), nil
+			},
+			PatchFn: func(_, _, catalogVersionID string) error {
+				*patched = catalogVersionID
+
+				return nil
+			},
+		},
+		Now: time.Now,
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(func() { _ = e.Close() })
+
+	return e
+}
+
+func planPaths(files []FileAction) []string {
+	out := make([]string, 0, len(files))
+	for _, fa := range files {
+		out = append(out, fa.Path)
+	}
+
+	return out
+}
+
+func TestRevert_PublishesTargetAsNewVersion(t *testing.T) {
+	dir, files := revertProject(t,
+		map[string]string{"agent.py": "v2\n", "new.py": "added later\n", "same.py": "same\n"},
+		map[string]string{"agent.py": "v1\n", "old.py": "removed later\n", "same.py": "same\n"},
+	)
+
+	var patched string
+
+	e := newRevertEngine(t, dir, files, "ver-2", &patched)
+
+	plan, err := e.Plan(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"agent.py", "old.py"}, planPaths(plan.Uploads))
+	assert.Equal(t, ClsLocalModified, plan.Uploads[0].Classification)
+	assert.Equal(t, ClsLocalAdded, plan.Uploads[1].Classification)
+	assert.Equal(t, []string{"new.py"}, planPaths(plan.Deletes))
+	assert.Empty(t, plan.Downloads)
+
+	result, err := e.Execute(context.Background(), plan)
+	require.NoError(t, err)
+
+	assert.Equal(t, "ver-3", result.NewVersion)
+	assert.Equal(t, "ver-3", patched)
+	assert.Equal(t, []byte("v1\n"), files.uploadedFiles["agent.py"])
+	assert.Equal(t, []byte("removed later\n"), files.uploadedFiles["old.py"])
+	assert.Equal(t, []string{"new.py"}, files.deletedPaths)
+
+	body, err := os.ReadFile(filepath.Join(dir, "agent.py"))
+	require.NoError(t, err)
+	assert.Equal(t, "v1\n", string(body))
+	assert.NoFileExists(t, filepath.Join(dir, "new.py"))
+	assert.FileExists(t, filepath.Join(dir, "old.py"))
+
+	cfg, err := wapi.LoadConfig(dir)
+	require.NoError(t, err)
+	assert.Equal(t, "ver-3", *cfg.LastSyncedVersionID)
+
+	manifest, err := wapi.LoadManifest(dir)
+	require.NoError(t, err)
+	assert.Equal(t, "ver-3", *manifest.SyncedVersionID)
+	assert.ElementsMatch(t, []string{".wapiignore", "agent.py", "old.py", "same.py"}, slices.Collect(maps.Keys(manifest.Files)))
+
+	history, err := wapi.ReadHistory(dir)
+	require.NoError(t, err)
+	require.NotEmpty(t, history)
+
+	last := history[len(history)-1]
+	assert.Equal(t, "revert", last["op"])
+	assert.Equal(t, "ver-1", last["target"])
+	assert.Equal(t, "ver-2→ver-3", last["version"])
+}
+
+func TestRevert_RefusesLocalChanges(t *testing.T) {
+	dir, files := revertProject(t,
+		map[string]string{"agent.py": "v2\n"},
+		map[string]string{"agent.py": "v1\n"},
+	)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "agent.py"), []byte("edited\n"), 0o644))
+
+	var patched string
+
+	_, err := newRevertEngine(t, dir, files, "ver-2", &patched).Plan(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "1 local change(s)")
+}
+
+func TestRevert_RefusesRemoteDrift(t *testing.T) {
+	dir, files := revertProject(t,
+		map[string]string{"agent.py": "v2\n"},
+		map[string]string{"agent.py": "v1\n"},
+	)
+
+	var patched string
+
+	_, err := newRevertEngine(t, dir, files, "ver-9", &patched).Plan(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "dr artifact code sync")
+}
+
+func TestRevertPlan_IdenticalVersionsIsEmpty(t *testing.T) {
+	m := RemoteManifest{"a.py": {Hash: "h", Size: 1}}
+
+	assert.True(t, RevertPlan(m, m).IsEmpty())
+}
@@ -15,6 +15,7 @@
 package sync
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -28,22 +29,22 @@ import (
 type StageUploader struct{}
 
 // ApplyUploads pushes files via the stage workflow.
-func (StageUploader) ApplyUploads(e *Engine, files []FileAction) (string, string, error) {
-	catalogID, err := ensureCatalog(e)
+func (StageUploader) ApplyUploads(ctx context.Context, e *Engine, files []FileAction) (string, string, error) {
+	catalogID, err := ensureCatalog(ctx, e)
 	if err != nil {
 		return "", "", err
 	}
 
-	stage, err := e.files.CreateStage(catalogID)
+	stage, err := e.files.CreateStage(ctx, catalogID)
 	if err != nil {
 		return "", "", fmt.Errorf("create stage: %w", err)
 	}
 
-	if err := uploadFilesParallel(e, catalogID, stage.StageID, files); err != nil {
+	if err := uploadFilesParallel(ctx, e, catalogID, stage.StageID, files); err != nil {
 		return "", "", err
 	}
 
-	apply, err := e.files.ApplyStage(catalogID, stage.StageID, filesapi.OverwriteReplace)
+	apply, err := e.files.ApplyStage(ctx, catalogID, stage.StageID, filesapi.OverwriteReplace)
 	if err != nil {
 		return "", "", fmt.Errorf("apply stage: %w", err)
 	}
@@ -53,12 +54,12 @@ func (StageUploader) ApplyUploads(e *Engine, files []FileAction) (string, string
 
 // ensureCatalog returns the catalog ID, creating a new one when neither
 // config nor artifact has one (first-sync against an empty artifact).
-func ensureCatalog(e *Engine) (string, error) {
+func ensureCatalog(ctx context.Context, e *Engine) (string, error) {
 	if id := resolveExistingCatalogID(e); id != "" {
 		return id, nil
 	}
 
-	cat, err := e.files.CreateCatalog()
+	cat, err := e.files.CreateCatalog(ctx)
 	if err != nil {
 		return "", fmt.Errorf("create catalog: %w", err)
 	}
@@ -69,7 +70,7 @@ func ensureCatalog(e *Engine) (string, error) {
 // uploadFilesParallel uploads files up to UploadConcurrency. The first
 // error closes done to stop other workers from starting; in-flight
 // workers still finish their current upload before the function returns.
-func uploadFilesParallel(e *Engine, catalogID, stageID string, files []FileAction) error {
+func uploadFilesParallel(ctx context.Context, e *Engine, catalogID, stageID string, files []FileAction) error {
 	if len(files) == 0 {
 		return nil
 	}
@@ -103,7 +104,7 @@ func uploadFilesParallel(e *Engine, catalogID, stageID string, files []FileActio
 
 			defer func() { <-sem }()
 
-			if err := uploadOneToStage(e, catalogID, stageID, fa); err != nil {
+			if err := uploadOneToStage(ctx, e, catalogID, stageID, fa); err != nil {
 				select {
 				case errCh <- err:
 					cancel()
@@ -123,7 +124,7 @@ func uploadFilesParallel(e *Engine, catalogID, stageID string, files []FileActio
 	return nil
 }
 
-func uploadOneToStage(e *Engine, catalogID, stageID string, fa FileAction) error {
+func uploadOneToStage(ctx context.Context, e *Engine, catalogID, stageID string, fa FileAction) error {
 	abs := filepath.Join(e.projectDir, filepath.FromSlash(fa.Path))
 
 	f, err := os.Open(abs)
@@ -133,7 +134,7 @@ func uploadOneToStage(e *Engine, catalogID, stageID string, fa FileAction) error
 
 	defer func() { _ = f.Close() }()
 
-	if err := e.files.UploadToStage(catalogID, stageID, fa.Path, fa.LocalSize, f); err != nil {
+	if err := e.files.UploadToStage(ctx, catalogID, stageID, fa.Path, fa.LocalSize, f); err != nil {
 		return fmt.Errorf("upload %s: %w", fa.Path, err)
 	}
 
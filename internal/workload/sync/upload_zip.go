@@ -16,6 +16,7 @@ package sync
 
 import (
 	"archive/zip"
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -31,7 +32,7 @@ import (
 type ZipUploader struct{}
 
 // ApplyUploads zips the files, POSTs, and polls until done.
-func (ZipUploader) ApplyUploads(e *Engine, files []FileAction) (string, string, error) {
+func (ZipUploader) ApplyUploads(ctx context.Context, e *Engine, files []FileAction) (string, string, error) {
 	zipPath, err := buildZip(e.projectDir, files)
 	if err != nil {
 		return "", "", err
@@ -51,7 +52,7 @@ func (ZipUploader) ApplyUploads(e *Engine, files []FileAction) (string, string,
 		return "", "", fmt.Errorf("stat built zip: %w", err)
 	}
 
-	resp, err := postZip(e, zipFile, stat.Size())
+	resp, err := postZip(ctx, e, zipFile, stat.Size())
 	if err != nil {
 		return "", "", err
 	}
@@ -59,7 +60,7 @@ func (ZipUploader) ApplyUploads(e *Engine, files []FileAction) (string, string,
 	// Small archives complete inline (201, no statusId); larger ones
 	// come back 202 with a statusId we then poll.
 	if resp.StatusID != "" {
-		if err := waitForCompletion(e, resp.StatusID); err != nil {
+		if err := waitForCompletion(ctx, e, resp.StatusID); err != nil {
 			return "", "", err
 		}
 	}
@@ -122,17 +123,17 @@ func addToZip(zw *zip.Writer, src, archivePath string) error {
 
 // postZip dispatches to UploadFromZipNew (first-sync, no catalog) or
 // UploadFromZipExisting (subsequent syncs).
-func postZip(e *Engine, body io.Reader, size int64) (*filesapi.FromFileResp, error) {
+func postZip(ctx context.Context, e *Engine, body io.Reader, size int64) (*filesapi.FromFileResp, error) {
 	if id := resolveExistingCatalogID(e); id != "" {
-		return e.files.UploadFromZipExisting(id, "wapi-sync.zip", filesapi.OverwriteReplace, size, body)
+		return e.files.UploadFromZipExisting(ctx, id, "wapi-sync.zip", filesapi.OverwriteReplace, size, body)
 	}
 
-	return e.files.UploadFromZipNew("wapi-sync.zip", size, body)
+	return e.files.UploadFromZipNew(ctx, "wapi-sync.zip", size, body)
 }
 
 // waitForCompletion polls until terminal status or ZipPollTimeoutSecs
 // elapses.
-func waitForCompletion(e *Engine, statusID string) error {
+func waitForCompletion(ctx context.Context, e *Engine, statusID string) error {
 	deadline := time.Now().Add(time.Duration(ZipPollTimeoutSecs) * time.Second)
 
 	for {
@@ -140,7 +141,7 @@ func waitForCompletion(e *Engine, statusID string) error {
 			return errors.New("timeout waiting for archive extract")
 		}
 
-		resp, err := e.files.PollStatus(statusID)
+		resp, err := e.files.PollStatus(ctx, statusID)
 		if err != nil {
 			return fmt.Errorf("poll status %s: %w", statusID, err)
 		}
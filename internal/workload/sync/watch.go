@@ -0,0 +1,248 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/datarobot/cli/internal/log"
+	"github.com/datarobot/cli/internal/workload/ignore"
+	"github.com/fsnotify/fsnotify"
+)
+
+// Watcher reports local edits under a project directory in debounced
+// batches, for `dr artifact code sync --watch`. Paths .wapiignore excludes
+// never show up, and neither do the state directory's own writes.
+//
+// fsnotify watches single directories, so every directory the matcher lets
+// through is added up front and new ones are added as they appear.
+type Watcher struct {
+	root     string
+	debounce time.Duration
+	fs       *fsnotify.Watcher
+	matcher  *ignore.Matcher
+
+	batches chan []string
+	errs    chan error
+	done    chan struct{}
+}
+
+// NewWatcher starts watching projectDir. A batch is ready once no event has
+// arrived for debounce, so an editor's save burst or a branch switch is one
+// batch rather than dozens.
+func NewWatcher(projectDir string, debounce time.Duration) (*Watcher, error) {
+	matcher, err := ignore.New(projectDir)
+	if err != nil {
+		return nil, fmt.Errorf("load .wapiignore: %w", err)
+	}
+
+	fsw, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, fmt.Errorf("watch %s: %w", projectDir, err)
+	}
+
+	w := &Watcher{
+		root:     projectDir,
+		debounce: debounce,
+		fs:       fsw,
+		matcher:  matcher,
+		batches:  make(chan []string),
+		errs:     make(chan error, 1),
+		done:     make(chan struct{}),
+	}
+
+	if err := w.addTree(projectDir); err != nil {
+		_ = fsw.Close()
+
+		return nil, err
+	}
+
+	go w.loop()
+
+	return w, nil
+}
+
+// Wait blocks until the next batch of changed paths, slash-separated and
+// relative to the project, or until ctx is done.
+func (w *Watcher) Wait(ctx context.Context) ([]string, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case batch := <-w.batches:
+		return batch, nil
+	case err := <-w.errs:
+		return nil, err
+	}
+}
+
+// Close stops watching. It must be called exactly once.
+func (w *Watcher) Close() error {
+	close(w.done)
+
+	return w.fs.Close()
+}
+
+// addTree watches dir and every directory below it the matcher keeps.
+func (w *Watcher) addTree(dir string) error {
+	return filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			// A directory removed mid-walk is not an error worth stopping for.
+			if errors.Is(err, fs.ErrNotExist) && path != dir {
+				return nil
+			}
+
+			return err
+		}
+
+		if !d.IsDir() {
+			return nil
+		}
+
+		if rel := w.rel(path); rel != "" && w.matcher.Match(rel, true) {
+			return filepath.SkipDir
+		}
+
+		if err := w.fs.Add(path); err != nil {
+			return fmt.Errorf("watch %s: %w", path, err)
+		}
+
+		return nil
+	})
+}
+
+// rel returns path relative to the project root with forward slashes, or
+// "" for the root itself or a path outside it.
+func (w *Watcher) rel(path string) string {
+	rel, err := filepath.Rel(w.root, path)
+	if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return ""
+	}
+
+	return filepath.ToSlash(rel)
+}
+
+// loop collects events into a pending set and hands it to Wait once the
+// debounce timer fires. While nobody is waiting (a sync is running) events
+// keep accumulating, so edits made during a sync are the next batch.
+func (w *Watcher) loop() {
+	pending := map[string]struct{}{}
+
+	timer := time.NewTimer(w.debounce)
+	timer.Stop()
+
+	ready := false
+
+	for {
+		var (
+			out   chan []string
+			batch []string
+		)
+
+		if ready && len(pending) > 0 {
+			out = w.batches
+			batch = sortedKeys(pending)
+		}
+
+		select {
+		case <-w.done:
+			timer.Stop()
+
+			return
+		case ev, ok := <-w.fs.Events:
+			if !ok {
+				return
+			}
+
+			if w.note(ev) {
+				pending[w.rel(ev.Name)] = struct{}{}
+				ready = false
+
+				timer.Reset(w.debounce)
+			}
+		case err, ok := <-w.fs.Errors:
+			if !ok {
+				return
+			}
+
+			select {
+			case w.errs <- err:
+			default:
+			}
+		case <-timer.C:
+			ready = true
+		case out <- batch:
+			pending = map[string]struct{}{}
+			ready = false
+		}
+	}
+}
+
+// note reports whether ev is a change sync cares about, and starts watching
+// directories as they are created. An edit to any .wapiignore or .gitignore
+// reloads the matcher before anything else is judged against it.
+func (w *Watcher) note(ev fsnotify.Event) bool {
+	rel := w.rel(ev.Name)
+	if rel == "" || ev.Op == fsnotify.Chmod {
+		return false
+	}
+
+	if base := filepath.Base(ev.Name); base == ".wapiignore" || base == ".gitignore" {
+		if matcher, err := ignore.New(w.root); err == nil {
+			w.matcher = matcher
+		} else {
+			log.Warn("could not reload "+base, "err", err)
+		}
+	}
+
+	isDir := false
+
+	if ev.Has(fsnotify.Create) {
+		if info, err := os.Lstat(ev.Name); err == nil && info.IsDir() {
+			isDir = true
+		}
+	}
+
+	if w.matcher.Match(rel, isDir) {
+		return false
+	}
+
+	if isDir {
+		if err := w.addTree(ev.Name); err != nil {
+			log.Debug("could not watch new directory", "path", rel, "err", err)
+		}
+	}
+
+	return true
+}
+
+func sortedKeys(set map[string]struct{}) []string {
+	keys := make([]string, 0, len(set))
+
+	for k := range set {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
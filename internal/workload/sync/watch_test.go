@@ -0,0 +1,89 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sync
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func waitBatch(t *testing.T, w *Watcher) []string {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	batch, err := w.Wait(ctx)
+	require.NoError(t, err)
+
+	return batch
+}
+
+func TestWatcher_DebouncesAndIgnores(t *testing.T) {
+	dir := initProject(t, map[string]string{
+		".wapiignore": "*.log\nbuild/\n",
+		"agent.py":    "x\n",
+	})
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "build"), 0o755))
+
+	w, err := NewWatcher(dir, 50*time.Millisecond)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { _ = w.Close() })
+
+	for i := range 3 {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "agent.py"), []byte{byte('a' + i)}, 0o644))
+	}
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "debug.log"), []byte("x"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "build", "out.bin"), []byte("x"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".datarobot", "workload", "manifest.json"), []byte("{}"), 0o644))
+
+	assert.Equal(t, []string{"agent.py"}, waitBatch(t, w), "three writes, one batch; ignored paths never appear")
+}
+
+func TestWatcher_NewDirectoriesAreWatched(t *testing.T) {
+	dir := initProject(t, nil)
+
+	w, err := NewWatcher(dir, 50*time.Millisecond)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { _ = w.Close() })
+
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "pkg"), 0o755))
+	assert.Equal(t, []string{"pkg"}, waitBatch(t, w))
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "pkg", "mod.py"), []byte("x"), 0o644))
+	assert.Equal(t, []string{"pkg/mod.py"}, waitBatch(t, w))
+}
+
+func TestWatcher_WaitHonoursContext(t *testing.T) {
+	w, err := NewWatcher(initProject(t, nil), time.Second)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { _ = w.Close() })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = w.Wait(ctx)
+	require.ErrorIs(t, err, context.Canceled)
+}
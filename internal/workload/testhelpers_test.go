@@ -21,6 +21,7 @@ import (
 
 	"github.com/datarobot/cli/internal/config"
 	"github.com/datarobot/cli/internal/config/viperx"
+	"github.com/datarobot/cli/internal/drapi"
 )
 
 // serveAPI stands up an httptest server and points the CLI's endpoint at it,
@@ -74,3 +75,13 @@ func installEndpoint(t *testing.T, url string) {
 		viperx.Set(config.DataRobotURL, prev)
 	})
 }
+
+// disableRetries turns off drapi's transport-level retries so a test that
+// answers with a transient status sees exactly one request per call. The
+// poll loops under test have their own retry rules, and counting server hits
+// against those would otherwise include the transport's attempts too.
+func disableRetries(t *testing.T) {
+	t.Helper()
+
+	t.Cleanup(drapi.SetRetryPolicyForTest(drapi.RetryPolicy{MaxAttempts: 1}))
+}
@@ -15,11 +15,12 @@
 package up
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/datarobot/cli/internal/workload"
-	"github.com/datarobot/cli/internal/workload/manifest"
 	"github.com/datarobot/cli/internal/workload/sync"
 	"github.com/datarobot/cli/internal/workload/wapi"
 )
@@ -36,20 +37,20 @@ import (
 // of starting again. A workload created up front would instead sit there
 // pointing at an image that does not exist yet, visible in the UI and unable
 // to start, for as long as the build took.
-func buildAndCreate(loaded Loaded, code CodeChange, result Result, opts Options, report *reporter) (Result, error) {
-	artifactID, fresh, err := ensureArtifact(loaded, report)
+func buildAndCreate(ctx context.Context, loaded Loaded, code CodeChange, result Result, opts Options, report *reporter) (Result, error) {
+	artifactID, fresh, err := ensureArtifact(ctx, loaded, report)
 	if err != nil {
 		return result, err
 	}
 
 	result.ArtifactID = artifactID
 
-	synced, err := syncCode(loaded.ProjectDir, report)
+	synced, err := syncCode(ctx, loaded.ProjectDir, report)
 	if err != nil {
 		return result, err
 	}
 
-	buildID, err := maybeBuild(artifactID, fresh, code, synced, opts, report)
+	buildID, err := maybeBuild(ctx, artifactID, fresh, code, synced, opts, report)
 
 	// Recorded before the error check: a failed build is still a build, and
 	// the caller's envelope should be able to name the one to go and read.
@@ -64,12 +65,12 @@ func buildAndCreate(loaded Loaded, code CodeChange, result Result, opts Options,
 		return result, err
 	}
 
-	result, err = create(loaded, payload, result, opts, report)
+	result, err = create(ctx, loaded, payload, result, opts, report)
 	if err != nil && !fresh {
 		// The artifact was not minted by this run, so a refusal to create a
 		// workload on it is most likely the platform's one-per-draft-artifact
 		// rule, and the link that chose it is the thing to explain.
-		err = reusedArtifactConflict(err, artifactID, loaded.ProjectDir)
+		err = reusedArtifactConflict(ctx, err, artifactID, loaded.ProjectDir, loaded.bindingFile())
 	}
 
 	return result, err
@@ -83,7 +84,7 @@ func buildAndCreate(loaded Loaded, code CodeChange, result Result, opts Options,
 // up new ones. When it goes stale, the platform's own message names an
 // artifact id and nothing about where that id came from, which leaves the
 // reader with a conflict and no idea what chose the thing that conflicted.
-func reusedArtifactConflict(createErr error, artifactID, projectDir string) error {
+func reusedArtifactConflict(ctx context.Context, createErr error, artifactID, projectDir, bindingFile string) error {
 	if !isConflict(createErr) {
 		return createErr
 	}
@@ -92,7 +93,7 @@ func reusedArtifactConflict(createErr error, artifactID, projectDir string) erro
 	// likely to be a duplicate workload name, which create() has already
 	// explained accurately; replacing that with advice to delete the link
 	// directory would send the user to orphan an artifact for no reason.
-	owner := workloadOn(artifactID)
+	owner := workloadOn(ctx, artifactID)
 	if owner == "" {
 		return createErr
 	}
@@ -106,14 +107,14 @@ func reusedArtifactConflict(createErr error, artifactID, projectDir string) erro
 			"  To deploy a separate workload from this directory, delete %s: the next run then creates "+
 			"an artifact of its own.\n"+
 			"  %w",
-		artifactID, owner, wapi.Dir(projectDir), owner, manifest.FileName, wapi.Dir(projectDir), createErr)
+		artifactID, owner, wapi.Dir(projectDir), owner, bindingFile, wapi.Dir(projectDir), createErr)
 }
 
 // workloadOn names the workload already using artifactID, "" when the lookup
 // cannot say. It turns the advice from "find out what owns this" into a line
 // the reader can paste.
-func workloadOn(artifactID string) string {
-	existing, err := listWorkloadsFn(conflictSearchLimit, nil, "")
+func workloadOn(ctx context.Context, artifactID string) string {
+	existing, err := listWorkloadsFn(ctx, conflictSearchLimit, nil, "")
 	if err != nil {
 		return ""
 	}
@@ -136,14 +137,14 @@ func workloadOn(artifactID string) string {
 // manifest. The manifest describes what to deploy and is committed; which
 // artifact a particular checkout has been pushing to is local, and writing it
 // into a shared file would have two developers fighting over one draft.
-func ensureArtifact(loaded Loaded, report *reporter) (string, bool, error) {
+func ensureArtifact(ctx context.Context, loaded Loaded, report *reporter) (string, bool, error) {
 	if projectLinkedFn(loaded.ProjectDir) {
 		cfg, err := loadProjectFn(loaded.ProjectDir)
 		if err != nil {
 			return "", false, fmt.Errorf("cannot read which artifact %s is linked to: %w", loaded.ProjectDir, err)
 		}
 
-		if err := usableArtifact(loaded.ProjectDir, cfg.ArtifactID); err != nil {
+		if err := usableArtifact(ctx, loaded.ProjectDir, cfg.ArtifactID); err != nil {
 			return "", false, err
 		}
 
@@ -158,7 +159,7 @@ func ensureArtifact(loaded Loaded, report *reporter) (string, bool, error) {
 			return payloadErr
 		}
 
-		artifact, createErr := createArtifactFn(payload)
+		artifact, createErr := createArtifactFn(ctx, payload)
 		created = artifact
 
 		return createErr
@@ -184,8 +185,8 @@ func ensureArtifact(loaded Loaded, report *reporter) (string, bool, error) {
 // way. Locking is one-way and makes the artifact immutable, so neither the
 // code push nor the build that follows can land; the platform would refuse
 // the PATCH halfway through with a 403 that says nothing about what to do.
-func usableArtifact(projectDir, artifactID string) error {
-	artifact, err := getArtifactFn(artifactID)
+func usableArtifact(ctx context.Context, projectDir, artifactID string) error {
+	artifact, err := getArtifactFn(ctx, artifactID)
 	if err != nil {
 		return fmt.Errorf("cannot read artifact %s, which this project is linked to: %w", artifactID, err)
 	}
@@ -205,11 +206,11 @@ func usableArtifact(projectDir, artifactID string) error {
 // does the whole of it: lock the project, diff against what was last synced,
 // upload, and patch the artifact's codeRef to the version it minted, which is
 // what points the next build at this code.
-func syncCode(projectDir string, report *reporter) (*sync.Result, error) {
+func syncCode(ctx context.Context, projectDir string, report *reporter) (*sync.Result, error) {
 	var result *sync.Result
 
 	err := report.run("Syncing code", func() error {
-		r, syncErr := syncProjectFn(projectDir)
+		r, syncErr := syncProjectFn(ctx, projectDir)
 		result = r
 
 		return syncErr
@@ -218,12 +219,25 @@ func syncCode(projectDir string, report *reporter) (*sync.Result, error) {
 		return nil, fmt.Errorf("cannot sync the project's code: %w", err)
 	}
 
-	// A conflict is not a failure: the engine keeps the local file and parks
-	// a copy of the remote one beside it. It still has to be said out loud,
-	// because what was just uploaded is not what the user last saw.
+	// A conflict is not a failure: the engine merges what it can, writes
+	// conflict markers where it cannot, and parks a local copy of what it
+	// cannot merge at all. It still has to be said out loud, because what was
+	// just uploaded is not what the user last saw.
 	if result != nil && result.ConflictCount > 0 {
-		report.say("  %d file(s) differed from the last sync; local copies kept as %v.\n",
-			result.ConflictCount, result.ConflictCopies)
+		report.say("  %d file(s) differed from the last sync.\n", result.ConflictCount)
+
+		if len(result.Merged) > 0 {
+			report.say("  Merged both sides of %s.\n", strings.Join(result.Merged, ", "))
+		}
+
+		if len(result.Unresolved) > 0 {
+			report.say("  Conflict markers left in %s; the code built here is the remote version of those.\n",
+				strings.Join(result.Unresolved, ", "))
+		}
+
+		if len(result.ConflictCopies) > 0 {
+			report.say("  Local copies kept as %s.\n", strings.Join(result.ConflictCopies, ", "))
+		}
 	}
 
 	return result, nil
@@ -243,6 +257,7 @@ func syncCode(projectDir string, report *reporter) (*sync.Result, error) {
 // the platform rather than assumed, because the common way to arrive here is
 // a first deploy that failed after the sync.
 func maybeBuild(
+	ctx context.Context,
 	artifactID string,
 	fresh bool,
 	code CodeChange,
@@ -251,7 +266,7 @@ func maybeBuild(
 	report *reporter,
 ) (string, error) {
 	if !fresh && !opts.ForceBuild && !changed(code, synced) {
-		built, err := hasImage(artifactID)
+		built, err := hasImage(ctx, artifactID)
 		if err != nil {
 			return "", err
 		}
@@ -263,7 +278,7 @@ func maybeBuild(
 		}
 	}
 
-	return buildImage(artifactID, opts, report)
+	return buildImage(ctx, artifactID, opts, report)
 }
 
 // changed reports whether anything moved. The sync's own count is preferred
@@ -285,8 +300,8 @@ func changed(code CodeChange, synced *sync.Result) bool {
 // The exception is code pushed by `dr artifact code sync` between deploys,
 // which leaves a completed build of the previous version and a tree that
 // looks unchanged to `up`. That is what --force-build is for.
-func hasImage(artifactID string) (bool, error) {
-	builds, err := listBuildsFn(artifactID, buildHistoryLimit)
+func hasImage(ctx context.Context, artifactID string) (bool, error) {
+	builds, err := listBuildsFn(ctx, artifactID, buildHistoryLimit)
 	if err != nil {
 		return false, fmt.Errorf("cannot tell whether artifact %s has been built: %w", artifactID, err)
 	}
@@ -308,11 +323,11 @@ const buildHistoryLimit = 20
 // optional even under --detach: a workload created against an artifact with
 // no image would come up unable to start, and --detach promises not to wait
 // for the workload, not to deploy something that cannot run.
-func buildImage(artifactID string, opts Options, report *reporter) (string, error) {
+func buildImage(ctx context.Context, artifactID string, opts Options, report *reporter) (string, error) {
 	var built *workload.Build
 
 	err := report.run("Building the image", func() error {
-		buildID, triggerErr := triggerBuild(artifactID)
+		buildID, triggerErr := triggerBuild(ctx, artifactID)
 		if triggerErr != nil {
 			return triggerErr
 		}
@@ -320,7 +335,7 @@ func buildImage(artifactID string, opts Options, report *reporter) (string, erro
 		// WaitForBuild hands the build back alongside its error when the
 		// build ends badly or the wait runs out, so the id is taken from it
 		// before the error is looked at: it is the only way to the logs.
-		b, waitErr := waitBuildFn(artifactID, buildID, opts.PollInterval, opts.PollTimeout, nil)
+		b, waitErr := waitBuildFn(ctx, artifactID, buildID, opts.PollInterval, opts.PollTimeout, nil)
 		built = b
 
 		return waitErr
@@ -346,8 +361,8 @@ func buildImage(artifactID string, opts Options, report *reporter) (string, erro
 // triggerBuild starts one build and names it. The endpoint answers with a
 // list because an artifact can have several containers to build; the deploy
 // follows the first, since the CLI only ever describes one.
-func triggerBuild(artifactID string) (string, error) {
-	triggered, err := triggerBuildFn(artifactID)
+func triggerBuild(ctx context.Context, artifactID string) (string, error) {
+	triggered, err := triggerBuildFn(ctx, artifactID)
 	if err != nil {
 		return "", err
 	}
@@ -363,7 +378,7 @@ func triggerBuild(artifactID string) (string, error) {
 // has already printed its plan and been confirmed, if confirmation was ever
 // going to happen; a second prompt from inside a phase would be a surprise,
 // and in CI it would be a hang.
-func defaultSync(projectDir string) (*sync.Result, error) {
+func defaultSync(ctx context.Context, projectDir string) (*sync.Result, error) {
 	engine, err := sync.New(projectDir, sync.Options{Yes: true})
 	if err != nil {
 		return nil, err
@@ -371,5 +386,5 @@ func defaultSync(projectDir string) (*sync.Result, error) {
 
 	defer func() { _ = engine.Close() }()
 
-	return engine.Run()
+	return engine.Run(ctx)
 }
@@ -15,6 +15,7 @@
 package up
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -41,7 +42,7 @@ const credentialSearchLimit = 200
 // from a file the user is about to edit, and finding out about the second bad
 // id only after fixing the first is two round trips through a build pipeline
 // for what is one edit.
-func verifyCredentials(refs []manifest.CredentialRef, workloadName string) error {
+func verifyCredentials(ctx context.Context, refs []manifest.CredentialRef, workloadName string) error {
 	var (
 		problems []string
 		seen     = make(map[string]bool, len(refs))
@@ -49,7 +50,7 @@ func verifyCredentials(refs []manifest.CredentialRef, workloadName string) error
 
 	for _, ref := range refs {
 		if ref.CredentialID == manifest.CredentialPlaceholder {
-			problems = append(problems, placeholderProblem(ref, workloadName))
+			problems = append(problems, placeholderProblem(ctx, ref, workloadName))
 
 			continue
 		}
@@ -62,7 +63,7 @@ func verifyCredentials(refs []manifest.CredentialRef, workloadName string) error
 
 		seen[ref.CredentialID] = true
 
-		if _, err := getCredentialFn(ref.CredentialID); err != nil {
+		if _, err := getCredentialFn(ctx, ref.CredentialID); err != nil {
 			if !isNotFound(err) {
 				// A transport failure means the references were not checked,
 				// which is not the same as their being wrong. Saying so and
@@ -88,26 +89,26 @@ func verifyCredentials(refs []manifest.CredentialRef, workloadName string) error
 // finished. The wizard writes the placeholder in full, rather than commenting
 // the entry out, precisely so it fails here instead of deploying a container
 // without its secret.
-func placeholderProblem(ref manifest.CredentialRef, workloadName string) string {
+func placeholderProblem(ctx context.Context, ref manifest.CredentialRef, workloadName string) string {
 	// The commonest reason setup left a placeholder is that the name it wanted
 	// was taken, which means the id the file needs already exists. Saying so
 	// with the id in hand turns the message into an edit rather than an
 	// errand. A lookup that fails changes nothing: the entry is still wrong.
 	name := wizard.CredentialName(workloadName, ref.EnvName)
 
-	if existing, err := findCredentialFn(name, credentialSearchLimit); err == nil && existing != nil {
+	if existing, err := findCredentialFn(ctx, name, credentialSearchLimit); err == nil && existing != nil {
 		return fmt.Sprintf(
 			"%s:%d  %s still says %s. A credential named %s already exists, so replacing %s with %s "+
 				"is probably what you want, having checked that credential holds this variable's value: "+
 				"a name is tenant-wide and says nothing about what is stored under it",
-			manifest.FileName, ref.Line, ref.EnvName, manifest.CredentialPlaceholder,
+			ref.SourceFile(), ref.Line, ref.EnvName, manifest.CredentialPlaceholder,
 			name, manifest.CredentialPlaceholder, existing.CredentialID)
 	}
 
 	return fmt.Sprintf(
 		"%s:%d  %s still says %s, so its value would never reach the container. "+
 			"Store the value as a credential, then replace %s with that credential's id",
-		manifest.FileName, ref.Line, ref.EnvName, manifest.CredentialPlaceholder,
+		ref.SourceFile(), ref.Line, ref.EnvName, manifest.CredentialPlaceholder,
 		manifest.CredentialPlaceholder)
 }
 
@@ -116,6 +117,6 @@ func placeholderProblem(ref manifest.CredentialRef, workloadName string) string
 // has to go looking for in the file.
 func missingProblem(ref manifest.CredentialRef) string {
 	return fmt.Sprintf(
-		"line %d: %s references credential %s, which does not exist or is not visible to this account",
-		ref.Line, ref.EnvName, ref.CredentialID)
+		"line %d of %s: %s references credential %s, which does not exist or is not visible to this account",
+		ref.Line, ref.SourceFile(), ref.EnvName, ref.CredentialID)
 }
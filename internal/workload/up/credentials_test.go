@@ -15,6 +15,7 @@
 package up
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"testing"
@@ -33,7 +34,7 @@ func stubCredentials(t *testing.T, present map[string]bool, failWith error) *[]s
 
 	asked := make([]string, 0, 4)
 
-	force(t, &getCredentialFn, func(id string) (*workload.Credential, error) {
+	force(t, &getCredentialFn, func(_ context.Context, id string) (*workload.Credential, error) {
 		asked = append(asked, id)
 
 		if failWith != nil {
@@ -53,7 +54,7 @@ func stubCredentials(t *testing.T, present map[string]bool, failWith error) *[]s
 func TestVerifyCredentials_NoRefsAsksNothing(t *testing.T) {
 	asked := stubCredentials(t, nil, nil)
 
-	require.NoError(t, verifyCredentials(nil, "my-app"))
+	require.NoError(t, verifyCredentials(context.Background(), nil, "my-app"))
 	assert.Empty(t, *asked, "a manifest with no references must not touch the network")
 }
 
@@ -166,7 +167,7 @@ func TestVerifyCredentials_NonHTTPErrorPropagates(t *testing.T) {
 // otherwise have to repeat. The name only shapes the lookup behind a
 // placeholder, which is its own test.
 func verifyRefs(refs []manifest.CredentialRef) error {
-	return verifyCredentials(refs, "my-app")
+	return verifyCredentials(context.Background(), refs, "my-app")
 }
 
 // The commonest reason setup leaves a placeholder is that the name it wanted
@@ -177,7 +178,7 @@ func TestVerifyCredentials_PlaceholderNamesTheCredentialThatExists(t *testing.T)
 	var asked string
 
 	restore := findCredentialFn
-	findCredentialFn = func(name string, _ int) (*workload.Credential, error) {
+	findCredentialFn = func(_ context.Context, name string, _ int) (*workload.Credential, error) {
 		asked = name
 
 		return &workload.Credential{CredentialID: "66f0aaaa0000000000000001", Name: name}, nil
@@ -185,7 +186,7 @@ func TestVerifyCredentials_PlaceholderNamesTheCredentialThatExists(t *testing.T)
 
 	t.Cleanup(func() { findCredentialFn = restore })
 
-	err := verifyCredentials([]manifest.CredentialRef{
+	err := verifyCredentials(context.Background(), []manifest.CredentialRef{
 		{CredentialID: manifest.CredentialPlaceholder, Key: "apiToken", EnvName: "OPENAI_API_KEY", Line: 22},
 	}, "my-app")
 
@@ -199,7 +200,7 @@ func TestVerifyCredentials_PlaceholderNamesTheCredentialThatExists(t *testing.T)
 // With nothing of that name, the message says what to do rather than pointing
 // at a credential that does not exist.
 func TestVerifyCredentials_PlaceholderWithNoMatchStillSaysWhatToDo(t *testing.T) {
-	err := verifyCredentials([]manifest.CredentialRef{
+	err := verifyCredentials(context.Background(), []manifest.CredentialRef{
 		{CredentialID: manifest.CredentialPlaceholder, Key: "apiToken", EnvName: "OPENAI_API_KEY", Line: 22},
 	}, "my-app")
 
@@ -0,0 +1,485 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package up
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"path/filepath"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/datarobot/cli/internal/workload/manifest"
+	"github.com/datarobot/cli/tui"
+)
+
+// Where each half of the live workload sits in the file. Subset and Extra
+// report paths relative to the block they were handed, and a reader needs the
+// whole path to find the line.
+const (
+	specPrefix    = "artifact.spec."
+	runtimePrefix = "runtime."
+
+	// keyEnvironmentVars is the list whose values are never shown.
+	keyEnvironmentVars = "environmentVars"
+)
+
+// Drift is the manifest measured against the live workload, both ways.
+//
+// Changes is the plan's own question: what the file asks for that the
+// workload does not have. Extra is the one a plan never acts on: what the
+// workload holds in a list the file manages that the file does not name. `up`
+// leaves those alone, which is exactly why they are worth a report, since
+// nothing else will ever mention them: an environment variable added in the UI
+// stays there, unreviewed, for as long as nobody looks.
+type Drift struct {
+	Summary Summary
+	State   State
+
+	// File is the manifest compared, Environment the overlay merged onto
+	// it, "" when there was none.
+	File        string
+	Environment string
+
+	Changes []Drifted
+	Extra   []Drifted
+}
+
+// Drifted is one difference, with the line in the files it concerns.
+type Drifted struct {
+	Change
+
+	// Position is where the file sets the value, or the nearest enclosing key
+	// when it does not: an addition has no line of its own, and an extra is
+	// by definition something the file does not name.
+	Position manifest.Position
+}
+
+// InSync reports that the file and the workload agree in both directions.
+func (d Drift) InSync() bool {
+	return len(d.Changes) == 0 && len(d.Extra) == 0
+}
+
+// Diff compares the manifest at or above dir, with env's overlay merged onto
+// it, against the workload it is bound to. It changes nothing and builds
+// nothing, so it is safe on a schedule.
+//
+// The working tree is not part of the answer. Whether the code differs from
+// what was last synced is a question for the project's sync state, and a
+// drift report that needed the project lock would fight the deploy it is
+// meant to be watching.
+func Diff(ctx context.Context, dir, env string) (Drift, error) {
+	loaded, err := Load(dir, env)
+	if err != nil {
+		return Drift{}, err
+	}
+
+	drift := Drift{File: loaded.Path, Environment: env}
+
+	workloadID := loaded.WorkloadID()
+	if workloadID == "" {
+		return drift, fmt.Errorf(
+			"%s is not bound to a workload yet, so there is nothing to compare it with. "+
+				"'dr workload up%s' creates one", loaded.bindingFile(), envFlag(env))
+	}
+
+	live, err := Look(ctx, workloadID)
+	if err != nil {
+		return drift, err
+	}
+
+	drift.State = live.State
+	drift.Summary = Summary{Name: name(loaded, live), WorkloadID: workloadID}
+
+	if live.State == StateMissing || live.State == StateTerminated {
+		return drift, fmt.Errorf(
+			"workload %s, which %s is bound to, is %s; there is nothing left to compare with",
+			workloadID, loaded.bindingFile(), live.State)
+	}
+
+	plan, err := Build(loaded, live, CodeChange{})
+	if err != nil {
+		return drift, err
+	}
+
+	extra, err := extraPaths(loaded, live)
+	if err != nil {
+		return drift, err
+	}
+
+	m := loaded.Manifest
+
+	for _, c := range plan.Artifact {
+		c.Path = specPath(c.Path)
+		drift.Changes = append(drift.Changes, Drifted{Change: c, Position: m.PositionOf(c.Path)})
+	}
+
+	for _, c := range plan.Runtime {
+		c.Path = runtimePrefix + c.Path
+		drift.Changes = append(drift.Changes, Drifted{Change: c, Position: m.PositionOf(c.Path)})
+	}
+
+	for _, path := range extra {
+		drift.Extra = append(drift.Extra, Drifted{Change: Change{Path: path}, Position: m.PositionOf(path)})
+	}
+
+	return drift, nil
+}
+
+// specPath is a spec change's path in the file. The two changes the plan adds
+// beside the spec walk already carry theirs.
+func specPath(path string) string {
+	if path == keyArtifactID || path == keyArtifactType {
+		return path
+	}
+
+	return specPrefix + path
+}
+
+// extraPaths is everything the workload holds in a list the file manages and
+// does not name. A block the file leaves out entirely is skipped rather than
+// walked: a file bound to an artifact by id describes no spec, and every
+// container the workload runs would otherwise read as one the file dropped.
+func extraPaths(loaded Loaded, live Live) ([]string, error) {
+	spec, err := loaded.Spec()
+	if err != nil {
+		return nil, err
+	}
+
+	runtime, err := loaded.Runtime()
+	if err != nil {
+		return nil, err
+	}
+
+	var paths []string
+
+	if spec != nil {
+		for _, path := range Extra(spec, live.Spec) {
+			paths = append(paths, specPrefix+path)
+		}
+	}
+
+	if runtime != nil {
+		for _, path := range Extra(runtime, live.Runtime) {
+			paths = append(paths, runtimePrefix+path)
+		}
+	}
+
+	return paths, nil
+}
+
+// envFlag is the --env a suggested command needs to reach the same workload.
+func envFlag(env string) string {
+	if env == "" {
+		return ""
+	}
+
+	return " --env " + env
+}
+
+// The diff's palette, the one every unified diff uses: what `up` would take
+// away in red, what it would put in its place in green, and the hunk headers
+// that say where in cyan.
+var (
+	diffRemoveStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("1"))
+	diffAddStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("2"))
+	diffHunkStyle   = lipgloss.NewStyle().Foreground(lipgloss.Color("6"))
+)
+
+// RenderDrift writes the drift report as a unified diff: live on the minus
+// side, the file on the plus side, so every hunk reads as what `up` would do
+// to make the file true. Extras come last, minus lines with nothing to replace
+// them, and a note that `up` will leave them where they are.
+func RenderDrift(w io.Writer, d Drift) error {
+	var b strings.Builder
+
+	b.WriteString(planTitleStyle.Render(fmt.Sprintf("--- workload %s", driftTarget(d))) + "\n")
+	b.WriteString(planTitleStyle.Render(fmt.Sprintf("+++ %s", driftSource(d))) + "\n")
+
+	if d.InSync() {
+		b.WriteString("\n" + tui.SuccessStyle.Render("✓ In sync") + "\n")
+
+		_, err := io.WriteString(w, b.String())
+
+		return err
+	}
+
+	for _, c := range d.Changes {
+		b.WriteString(hunk(c))
+
+		if !c.Absent {
+			b.WriteString(diffRemoveStyle.Render("- "+diffValue(c.Path, c.Have)) + "\n")
+		}
+
+		b.WriteString(diffAddStyle.Render("+ "+diffValue(c.Path, c.Want)) + "\n")
+	}
+
+	for _, c := range d.Extra {
+		b.WriteString(hunk(c))
+		b.WriteString(diffRemoveStyle.Render("- "+lastSegment(c.Path)) + "\n")
+		b.WriteString(tui.HintStyle.Render("  held by the workload, not named by the file; 'dr workload up' leaves it") + "\n")
+	}
+
+	b.WriteString("\n" + tui.WarnStyle.Render(driftSummary(d)) + "\n")
+
+	_, err := io.WriteString(w, b.String())
+
+	return err
+}
+
+// hunk is the header line naming the path and where the file says it.
+func hunk(c Drifted) string {
+	return diffHunkStyle.Render(fmt.Sprintf("@@ %s @@", c.Path)) +
+		tui.HintStyle.Render(positionNote(c.Position)) + "\n"
+}
+
+// positionNote places a hunk in the files. A line that belongs to the
+// enclosing key rather than the path itself says so, because the reader
+// looking for the field there will not find it.
+func positionNote(p manifest.Position) string {
+	if p.Line == 0 {
+		return ""
+	}
+
+	file := manifest.FileName
+	if p.File != "" {
+		file = filepath.Base(p.File)
+	}
+
+	if !p.Exact {
+		return fmt.Sprintf("  %s:%d (enclosing)", file, p.Line)
+	}
+
+	return fmt.Sprintf("  %s:%d", file, p.Line)
+}
+
+// driftTarget names the workload side.
+func driftTarget(d Drift) string {
+	name := d.Summary.Name
+	if name == "" {
+		name = "workload"
+	}
+
+	return fmt.Sprintf("%s (%s), %s", name, shortID(d.Summary.WorkloadID), d.State)
+}
+
+// driftSource names the file side.
+func driftSource(d Drift) string {
+	file := manifest.FileName
+	if d.File != "" {
+		file = filepath.Base(d.File)
+	}
+
+	if d.Environment != "" {
+		return fmt.Sprintf("%s (environment %s)", file, d.Environment)
+	}
+
+	return file
+}
+
+// driftSummary counts both kinds of difference.
+func driftSummary(d Drift) string {
+	parts := make([]string, 0, 2)
+
+	if n := len(d.Changes); n > 0 {
+		parts = append(parts, fmt.Sprintf("%d %s the file sets differently", n, plural(n, "field", "fields")))
+	}
+
+	if n := len(d.Extra); n > 0 {
+		parts = append(parts, fmt.Sprintf("%d %s the file does not name", n, plural(n, "item", "items")))
+	}
+
+	return "Drift: " + strings.Join(parts, ", ")
+}
+
+// diffValue renders a value in full, because a diff is read to find out
+// exactly what differs, unlike the plan, which summarises. Composite values
+// are compact JSON; environment variable values are never shown.
+func diffValue(path string, v any) string {
+	if redacted(path) {
+		return "(value hidden)"
+	}
+
+	switch value := scrub(path, v).(type) {
+	case nil:
+		return "unset"
+	case string:
+		return value
+	case map[string]any, []any:
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return format(value)
+		}
+
+		return string(encoded)
+	default:
+		return fmt.Sprintf("%v", value)
+	}
+}
+
+// hiddenValue stands in for an environment variable's value inside a
+// composite one.
+const hiddenValue = "(hidden)"
+
+// scrub is v with every environment variable value inside it hidden.
+//
+// Redacting by path is not enough once whole values are shown: a container
+// the workload lacks is one change whose value is the entire container,
+// variables and all. The copy is made only where there is something to hide.
+func scrub(path string, v any) any {
+	if list, ok := v.([]any); ok && strings.HasSuffix(path, "."+keyEnvironmentVars) {
+		return scrubVars(list)
+	}
+
+	switch value := v.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(value))
+
+		for key, child := range value {
+			if vars, ok := child.([]any); ok && key == keyEnvironmentVars {
+				out[key] = scrubVars(vars)
+
+				continue
+			}
+
+			out[key] = scrub("", child)
+		}
+
+		return out
+
+	case []any:
+		out := make([]any, len(value))
+		for i, child := range value {
+			out[i] = scrub("", child)
+		}
+
+		return out
+
+	default:
+		return v
+	}
+}
+
+// scrubVars hides the value of each variable in a list, keeping the names and
+// the credential references, which are ids rather than secrets.
+func scrubVars(vars []any) []any {
+	out := make([]any, len(vars))
+
+	for i, item := range vars {
+		entry, ok := item.(map[string]any)
+		if !ok {
+			out[i] = hiddenValue
+
+			continue
+		}
+
+		copied := maps.Clone(entry)
+
+		if _, ok := copied["value"].(string); ok {
+			copied["value"] = hiddenValue
+		}
+
+		out[i] = copied
+	}
+
+	return out
+}
+
+// lastSegment is the item an extra path ends on, which is all the minus line
+// needs: the hunk header above it already says where.
+func lastSegment(path string) string {
+	if at := strings.LastIndexByte(path, '['); at >= 0 && strings.HasSuffix(path, "]") {
+		return path[at+1 : len(path)-1]
+	}
+
+	return path
+}
+
+// DriftJSON is the drift report as --output-format json emits it.
+type DriftJSON struct {
+	WorkloadID  string            `json:"workloadId"`
+	Name        string            `json:"name"`
+	State       string            `json:"state"`
+	File        string            `json:"file"`
+	Environment *string           `json:"environment"`
+	InSync      bool              `json:"inSync"`
+	Changes     []DriftChangeJSON `json:"changes"`
+	Extra       []DriftChangeJSON `json:"extra"`
+}
+
+// DriftChangeJSON is one difference. Want and Have are the values themselves
+// rather than the plan's rendered strings, so a consumer can compare them;
+// inside environmentVars both are null and Redacted is set, for the same
+// reason the plan never prints them. Line is 0 and File "" when the file
+// does not reach the path at all.
+type DriftChangeJSON struct {
+	Path     string `json:"path"`
+	Want     any    `json:"want"`
+	Have     any    `json:"have"`
+	Absent   bool   `json:"absent"`
+	Redacted bool   `json:"redacted"`
+	File     string `json:"file"`
+	Line     int    `json:"line"`
+	Exact    bool   `json:"exact"`
+}
+
+// JSON projects the report into its machine-readable shape. The lists are
+// never null, so "no drift" is [] on both.
+func (d Drift) JSON() DriftJSON {
+	out := DriftJSON{
+		WorkloadID: d.Summary.WorkloadID,
+		Name:       d.Summary.Name,
+		State:      d.State.String(),
+		File:       d.File,
+		InSync:     d.InSync(),
+		Changes:    driftChangesJSON(d.Changes),
+		Extra:      driftChangesJSON(d.Extra),
+	}
+
+	if d.Environment != "" {
+		out.Environment = &d.Environment
+	}
+
+	return out
+}
+
+func driftChangesJSON(changes []Drifted) []DriftChangeJSON {
+	out := make([]DriftChangeJSON, 0, len(changes))
+
+	for _, c := range changes {
+		entry := DriftChangeJSON{
+			Path:   c.Path,
+			Want:   c.Want,
+			Have:   c.Have,
+			Absent: c.Absent,
+			File:   c.Position.File,
+			Line:   c.Position.Line,
+			Exact:  c.Position.Exact,
+		}
+
+		if redacted(c.Path) {
+			entry.Want, entry.Have, entry.Redacted = nil, nil, true
+		} else {
+			entry.Want, entry.Have = scrub(c.Path, c.Want), scrub(c.Path, c.Have)
+		}
+
+		out = append(out, entry)
+	}
+
+	return out
+}
@@ -0,0 +1,219 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package up
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/datarobot/cli/internal/workload"
+	"github.com/datarobot/cli/internal/workload/manifest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// diffIn writes content as the manifest and compares it with whatever the
+// installed fakes say is live.
+func diffIn(t *testing.T, content string) (Drift, error) {
+	t.Helper()
+
+	dir := t.TempDir()
+	writeManifest(t, dir, content)
+
+	return Diff(context.Background(), dir, "")
+}
+
+// withLiveVar adds an environment variable to the running container that no
+// file names, the way an edit in the UI would.
+func withLiveVar(f fakes) fakes {
+	f.artifactD = func(context.Context, string) (workload.Document, error) {
+		d := docOf(strings.Replace(liveImageArtifactJSON,
+			`"imageUri": "registry/team/app:v1"`,
+			`"imageUri": "registry/team/app:v1", "environmentVars": [{"name": "DEBUG", "value": "s3cret"}]`, 1))
+
+		return d, nil
+	}
+
+	return f
+}
+
+func TestDiff_InSync(t *testing.T) {
+	install(t, liveImage(fakes{}))
+
+	drift, err := diffIn(t, boundImageManifest)
+	require.NoError(t, err)
+
+	assert.True(t, drift.InSync())
+	assert.Equal(t, StateRunning, drift.State)
+	assert.Equal(t, "my-app", drift.Summary.Name)
+}
+
+func TestDiff_ChangeCarriesItsWholePathAndLine(t *testing.T) {
+	install(t, liveImage(fakes{}))
+
+	drift, err := diffIn(t, newImage())
+	require.NoError(t, err)
+
+	require.Len(t, drift.Changes, 1)
+	assert.Empty(t, drift.Extra)
+
+	c := drift.Changes[0]
+	assert.Equal(t, "artifact.spec.containerGroups[default].containers[primary].imageUri", c.Path)
+	assert.Equal(t, "registry/team/app:v2", c.Want)
+	assert.Equal(t, "registry/team/app:v1", c.Have)
+	assert.Equal(t, 14, c.Position.Line)
+	assert.True(t, c.Position.Exact)
+	assert.Equal(t, manifest.FileName, filepath.Base(c.Position.File))
+}
+
+func TestDiff_RuntimeChangesArePrefixed(t *testing.T) {
+	install(t, liveImage(fakes{}))
+
+	drift, err := diffIn(t, strings.Replace(boundImageManifest, "replicaCount: 1", "replicaCount: 3", 1))
+	require.NoError(t, err)
+
+	require.Len(t, drift.Changes, 1)
+	assert.Equal(t, "runtime.containerGroups[default].replicaCount", drift.Changes[0].Path)
+	assert.Equal(t, 18, drift.Changes[0].Position.Line)
+}
+
+// TestDiff_FlagsWhatTheFileDoesNotName is the half a plan never reports:
+// `up` leaves an item the file does not name alone, so only this command
+// will ever mention it.
+func TestDiff_FlagsWhatTheFileDoesNotName(t *testing.T) {
+	install(t, withLiveVar(liveImage(fakes{})))
+
+	drift, err := diffIn(t, boundImageManifest)
+	require.NoError(t, err)
+
+	assert.False(t, drift.InSync())
+	assert.Empty(t, drift.Changes)
+	require.Len(t, drift.Extra, 1)
+	assert.Equal(t, "artifact.spec.containerGroups[default].containers[primary].environmentVars[DEBUG]",
+		drift.Extra[0].Path)
+	assert.Equal(t, 11, drift.Extra[0].Position.Line, "the nearest block the file does name")
+	assert.False(t, drift.Extra[0].Position.Exact)
+}
+
+// TestDiff_ArtifactBoundFileHasNoExtras covers a file that describes no spec:
+// it manages none of the containers, so none of them are missing from it.
+func TestDiff_ArtifactBoundFileHasNoExtras(t *testing.T) {
+	install(t, withLiveVar(liveImage(fakes{})))
+
+	drift, err := diffIn(t, `workloadId: 68b0c1d2e3f4a5b6c7d8e9f0
+name: my-app
+artifactId: 68a0000000000000000000a1
+`)
+	require.NoError(t, err)
+	assert.True(t, drift.InSync())
+}
+
+func TestDiff_UnboundIsAnError(t *testing.T) {
+	install(t, fakes{})
+
+	_, err := diffIn(t, unboundImageManifest)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not bound to a workload")
+}
+
+func TestDiff_MissingWorkloadIsAnError(t *testing.T) {
+	install(t, fakes{
+		workloadD: func(context.Context, string) (workload.Document, error) {
+			return nil, notFound()
+		},
+	})
+
+	_, err := diffIn(t, boundImageManifest)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "missing")
+}
+
+func TestDiff_ReadsTheEnvironment(t *testing.T) {
+	install(t, liveImage(fakes{}))
+
+	dir := t.TempDir()
+	path := writeManifest(t, dir, unboundImageManifest)
+	overlay := manifest.EnvironmentPath(path, "staging")
+	require.NoError(t, os.WriteFile(overlay,
+		[]byte("workloadId: 68b0c1d2e3f4a5b6c7d8e9f0\nruntime:\n  containerGroups:\n    - name: default\n      replicaCount: 2\n"),
+		0o600))
+
+	drift, err := Diff(context.Background(), dir, "staging")
+	require.NoError(t, err)
+
+	require.Len(t, drift.Changes, 1)
+	assert.Equal(t, overlay, drift.Changes[0].Position.File)
+	assert.Equal(t, 5, drift.Changes[0].Position.Line)
+}
+
+func TestRenderDrift_IsAUnifiedDiff(t *testing.T) {
+	install(t, withLiveVar(liveImage(fakes{})))
+
+	drift, err := diffIn(t, newImage())
+	require.NoError(t, err)
+
+	var out bytes.Buffer
+	require.NoError(t, RenderDrift(&out, drift))
+
+	text := out.String()
+	assert.Contains(t, text, "--- workload my-app (68b0c1d2), running")
+	assert.Contains(t, text, "+++ .datarobot.yaml")
+	assert.Contains(t, text, "@@ artifact.spec.containerGroups[default].containers[primary].imageUri @@  .datarobot.yaml:14")
+	assert.Contains(t, text, "- registry/team/app:v1\n")
+	assert.Contains(t, text, "+ registry/team/app:v2\n")
+	assert.Contains(t, text, "- DEBUG\n")
+	assert.Contains(t, text, "Drift: 1 field the file sets differently, 1 item the file does not name")
+	assert.NotContains(t, text, "s3cret")
+}
+
+func TestRenderDrift_InSync(t *testing.T) {
+	var out bytes.Buffer
+	require.NoError(t, RenderDrift(&out, Drift{State: StateRunning}))
+
+	assert.Contains(t, out.String(), "In sync")
+}
+
+// TestDrift_JSONNeverCarriesAVariableValue holds the redaction rule for whole
+// values too: a container the workload lacks arrives as one change whose
+// value holds every variable it sets.
+func TestDrift_JSONNeverCarriesAVariableValue(t *testing.T) {
+	drift := Drift{Changes: []Drifted{
+		{Change: Change{
+			Path: "artifact.spec.containerGroups[default].containers[sidecar]",
+			Want: map[string]any{
+				"name":            "sidecar",
+				"environmentVars": []any{map[string]any{"name": "TOKEN", "value": "s3cret"}},
+			},
+			Absent: true,
+		}},
+		{Change: Change{
+			Path: "artifact.spec.containerGroups[default].containers[primary].environmentVars[TOKEN].value",
+			Want: "s3cret", Have: "old",
+		}},
+	}}
+
+	encoded, err := json.Marshal(drift.JSON())
+	require.NoError(t, err)
+
+	assert.NotContains(t, string(encoded), "s3cret")
+	assert.Contains(t, string(encoded), `"redacted":true`)
+	assert.Contains(t, string(encoded), "TOKEN", "names are not secrets")
+	assert.Contains(t, string(encoded), `"extra":[]`)
+}
@@ -0,0 +1,181 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package up
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/datarobot/cli/internal/workload"
+)
+
+// listVersionsFn is the repository read behind history and rollback, swapped
+// by this package's tests like the seams in run.go.
+var listVersionsFn = workload.ListRepositoryArtifacts
+
+// HistoryEntry is one version in the repository a workload runs from.
+type HistoryEntry struct {
+	Artifact workload.Artifact
+
+	// Build is the build that made the version's image: the newest one that
+	// completed, or the newest attempt when none did. Nil for a version that
+	// runs a published image, which the platform never builds.
+	Build *workload.Build
+
+	// Serving marks the version the workload is running now.
+	Serving bool
+}
+
+// LockedAt is when the version was locked, nil for a draft.
+//
+// It is also the closest thing to when the version went out. The platform
+// keeps no record of past rollouts that can be read from here, but a locked
+// artifact is immutable, so its last update is the lock, and a locked version
+// is locked either just before it is rolled in or, under --lock, just after
+// it first serves. Either way the two are a rollout apart.
+func (e HistoryEntry) LockedAt() *time.Time {
+	if !e.Artifact.IsLocked() {
+		return nil
+	}
+
+	at := e.Artifact.UpdatedAt
+
+	return &at
+}
+
+// History lists the versions in the repository workloadID runs from, newest
+// first, with the build behind each and which one is serving.
+//
+// A workload whose artifact has no repository has exactly one version, the
+// one it runs, and is reported as that rather than as an error: it is what
+// every workload created before repositories existed looks like.
+func History(ctx context.Context, workloadID string, limit int) (Live, []HistoryEntry, error) {
+	live, err := lookRunnable(ctx, workloadID)
+	if err != nil {
+		return live, nil, err
+	}
+
+	versions, err := repositoryVersions(ctx, live, limit)
+	if err != nil {
+		return live, nil, err
+	}
+
+	entries := make([]HistoryEntry, 0, len(versions))
+
+	for _, artifact := range versions {
+		build, buildErr := imageBuild(ctx, artifact.ID)
+		if buildErr != nil {
+			return live, nil, buildErr
+		}
+
+		entries = append(entries, HistoryEntry{
+			Artifact: artifact,
+			Build:    build,
+			Serving:  artifact.ID == live.ArtifactID,
+		})
+	}
+
+	return live, entries, nil
+}
+
+// lookRunnable reads the live workload, turning the two states with no
+// versions to speak of into errors. Look reports them as facts because `up`
+// has something to do about each; a history of nothing has not.
+func lookRunnable(ctx context.Context, workloadID string) (Live, error) {
+	live, err := Look(ctx, workloadID)
+	if err != nil {
+		return live, err
+	}
+
+	switch {
+	case live.State == StateMissing:
+		return live, fmt.Errorf("workload %s not found", workloadID)
+
+	case live.ArtifactID == "":
+		return live, fmt.Errorf("workload %s is not running an artifact yet", workloadID)
+
+	default:
+		return live, nil
+	}
+}
+
+// repositoryVersions is every version in the live artifact's repository,
+// newest first. The running version is always among them, even when limit
+// cut it off, so the history never omits the one thing a reader came for.
+func repositoryVersions(ctx context.Context, live Live, limit int) ([]workload.Artifact, error) {
+	var versions []workload.Artifact
+
+	if live.ArtifactRepositoryID != "" {
+		listed, err := listVersionsFn(ctx, live.ArtifactRepositoryID, limit)
+		if err != nil {
+			return nil, fmt.Errorf("cannot list the versions in repository %s: %w", live.ArtifactRepositoryID, err)
+		}
+
+		versions = listed
+	}
+
+	if !slices.ContainsFunc(versions, func(a workload.Artifact) bool { return a.ID == live.ArtifactID }) {
+		current, err := getArtifactFn(ctx, live.ArtifactID)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read artifact %s: %w", live.ArtifactID, err)
+		}
+
+		versions = append(versions, *current)
+	}
+
+	sort.SliceStable(versions, func(i, j int) bool {
+		return versions[i].CreatedAt.After(versions[j].CreatedAt)
+	})
+
+	return versions, nil
+}
+
+// imageBuild is the build a version's image came from. See HistoryEntry.Build.
+func imageBuild(ctx context.Context, artifactID string) (*workload.Build, error) {
+	builds, err := listBuildsFn(ctx, artifactID, buildHistoryLimit)
+	if err != nil {
+		// A version that has never been built has no builds collection to
+		// list, which is an answer rather than a failure.
+		if isNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("cannot list the builds of artifact %s: %w", artifactID, err)
+	}
+
+	var newest, completed *workload.Build
+
+	for i := range builds {
+		build := &builds[i]
+
+		if newest == nil || build.CreatedAt.After(newest.CreatedAt) {
+			newest = build
+		}
+
+		if build.Status == workload.BuildStatusCompleted &&
+			(completed == nil || build.CreatedAt.After(completed.CreatedAt)) {
+			completed = build
+		}
+	}
+
+	if completed != nil {
+		return completed, nil
+	}
+
+	return newest, nil
+}
@@ -36,6 +36,10 @@ type Loaded struct {
 	// Path is the manifest itself.
 	Path string
 
+	// BindingPath is the file the workloadId is read from and written back
+	// to: the environment's overlay when one was selected, Path otherwise.
+	BindingPath string
+
 	// ProjectDir is the directory holding it, which is the root of the code
 	// that gets synced and where the local state directory lives. It is not
 	// necessarily the directory `up` was pointed at: the manifest is found by
@@ -59,6 +63,16 @@ func (l Loaded) WorkloadID() string {
 	return l.Compiled.WorkloadID
 }
 
+// bindingFile names the file holding the binding, for messages that tell the
+// user to edit it.
+func (l Loaded) bindingFile() string {
+	if l.BindingPath == "" {
+		return manifest.FileName
+	}
+
+	return filepath.Base(l.BindingPath)
+}
+
 // Spec is the artifact spec the file asks for, in the same shape the live
 // artifact comes back in, so the two can be compared directly. Reading it off
 // the compiled payload rather than the file is what makes the comparison fair:
@@ -125,7 +139,11 @@ func (l Loaded) payload() (map[string]any, error) {
 // as *manifest.ValidationError, whose findings each carry the line they sit
 // on, so the caller can print the file's own coordinates rather than a
 // paraphrase.
-func Load(dir string) (Loaded, error) {
+//
+// A non-empty env merges that environment's overlay onto the manifest first.
+// Everything after, validation included, sees the merged document, and each
+// finding names the file that set the value it is about.
+func Load(dir, env string) (Loaded, error) {
 	path, err := manifest.Locate(dir)
 	if err != nil {
 		if errors.Is(err, manifest.ErrNotFound) {
@@ -138,7 +156,7 @@ func Load(dir string) (Loaded, error) {
 		return Loaded{}, err
 	}
 
-	m, err := manifest.Load(path)
+	m, err := manifest.LoadEnvironment(path, env)
 	if err != nil {
 		return Loaded{}, err
 	}
@@ -153,9 +171,10 @@ func Load(dir string) (Loaded, error) {
 	}
 
 	return Loaded{
-		Path:       path,
-		ProjectDir: filepath.Dir(path),
-		Manifest:   m,
-		Compiled:   compiled,
+		Path:        path,
+		BindingPath: m.BindingPath(),
+		ProjectDir:  filepath.Dir(path),
+		Manifest:    m,
+		Compiled:    compiled,
 	}, nil
 }
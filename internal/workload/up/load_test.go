@@ -70,7 +70,7 @@ func TestLoad_ReadsValidatesAndCompiles(t *testing.T) {
 	dir := t.TempDir()
 	path := writeManifest(t, dir, boundManifest)
 
-	loaded, err := Load(dir)
+	loaded, err := Load(dir, "")
 	require.NoError(t, err)
 
 	assert.Equal(t, path, loaded.Path)
@@ -91,7 +91,7 @@ func TestLoad_WalksUpward(t *testing.T) {
 	nested := filepath.Join(root, "services", "api")
 	require.NoError(t, os.MkdirAll(nested, 0o750))
 
-	loaded, err := Load(nested)
+	loaded, err := Load(nested, "")
 	require.NoError(t, err)
 	assert.Equal(t, root, loaded.ProjectDir, "the project root is where the manifest is, not where up was run")
 }
@@ -100,7 +100,7 @@ func TestLoad_WalksUpward(t *testing.T) {
 // command. Returning a plain error here would force every caller to match on
 // a message.
 func TestLoad_MissingIsASentinel(t *testing.T) {
-	_, err := Load(t.TempDir())
+	_, err := Load(t.TempDir(), "")
 	require.Error(t, err)
 	assert.ErrorIs(t, err, ErrNoManifest)
 }
@@ -131,7 +131,7 @@ runtime:
           resourceAllocation: {cpu: 0.5, memory: 512MB}
 `)
 
-	_, err := Load(dir)
+	_, err := Load(dir, "")
 	require.Error(t, err)
 
 	var invalid *manifest.ValidationError
@@ -145,7 +145,7 @@ func TestLoad_ForeignFileIsRejected(t *testing.T) {
 	dir := t.TempDir()
 	writeManifest(t, dir, "version: 3\nservices:\n  web:\n    image: nginx\n")
 
-	_, err := Load(dir)
+	_, err := Load(dir, "")
 	require.Error(t, err)
 	assert.NotErrorIs(t, err, ErrNoManifest, "a file that is there but wrong is not the same as no file")
 }
@@ -158,7 +158,7 @@ func TestLoaded_SpecAndRuntimeComeFromTheCompiledPayload(t *testing.T) {
 	dir := t.TempDir()
 	writeManifest(t, dir, boundManifest)
 
-	loaded, err := Load(dir)
+	loaded, err := Load(dir, "")
 	require.NoError(t, err)
 
 	spec, err := loaded.Spec()
@@ -196,7 +196,7 @@ func TestLoaded_WorkloadIDIsStrippedFromThePayload(t *testing.T) {
 	dir := t.TempDir()
 	writeManifest(t, dir, boundManifest)
 
-	loaded, err := Load(dir)
+	loaded, err := Load(dir, "")
 	require.NoError(t, err)
 
 	assert.Equal(t, "68b0aaaa0000000000000001", loaded.WorkloadID())
@@ -226,7 +226,7 @@ runtime:
           resourceAllocation: {cpu: 0.5, memory: 512MB}
 `)
 
-	loaded, err := Load(dir)
+	loaded, err := Load(dir, "")
 	require.NoError(t, err)
 	assert.Empty(t, loaded.WorkloadID())
 }
@@ -252,7 +252,7 @@ func TestLoad_ADirectoryAtThePathIsNotAManifest(t *testing.T) {
 	dir := t.TempDir()
 	require.NoError(t, os.Mkdir(filepath.Join(dir, manifest.FileName), 0o750))
 
-	_, err := Load(dir)
+	_, err := Load(dir, "")
 	require.Error(t, err)
 	assert.ErrorIs(t, err, ErrNoManifest)
 }
@@ -15,6 +15,7 @@
 package up
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -108,12 +109,12 @@ type Live struct {
 // ordinary situations with different right answers, and choosing between them
 // belongs to the apply step, which is the part that knows whether it is
 // allowed to create something.
-func Look(workloadID string) (Live, error) {
+func Look(ctx context.Context, workloadID string) (Live, error) {
 	if workloadID == "" {
 		return Live{State: StateUnbound}, nil
 	}
 
-	workloadDoc, err := getWorkloadDocFn(workloadID)
+	workloadDoc, err := getWorkloadDocFn(ctx, workloadID)
 	if err != nil {
 		if isNotFound(err) {
 			return Live{State: StateMissing}, nil
@@ -124,7 +125,7 @@ func Look(workloadID string) (Live, error) {
 
 	artifactID := workloadDoc.String(keyArtifactID)
 
-	artifactDoc, err := artifactFor(artifactID)
+	artifactDoc, err := artifactFor(ctx, artifactID)
 	if err != nil {
 		return Live{}, err
 	}
@@ -147,12 +148,12 @@ func Look(workloadID string) (Live, error) {
 // possible only in the moments around creation, and it is not a failure: the
 // spec simply compares as absent, which reads as "everything the file says is
 // an addition", which is exactly right for something not yet built.
-func artifactFor(artifactID string) (workload.Document, error) {
+func artifactFor(ctx context.Context, artifactID string) (workload.Document, error) {
 	if artifactID == "" {
 		return nil, nil
 	}
 
-	doc, err := getArtifactDocFn(artifactID)
+	doc, err := getArtifactDocFn(ctx, artifactID)
 	if err != nil {
 		if isNotFound(err) {
 			return nil, nil
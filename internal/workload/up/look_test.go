@@ -15,6 +15,7 @@
 package up
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
@@ -98,8 +99,8 @@ func stubLive(t *testing.T, workloadJSON, artifactJSON string) {
 
 	prevWorkload, prevArtifact := getWorkloadDocFn, getArtifactDocFn
 
-	getWorkloadDocFn = func(string) (workload.Document, error) { return doc(t, workloadJSON), nil }
-	getArtifactDocFn = func(string) (workload.Document, error) { return doc(t, artifactJSON), nil }
+	getWorkloadDocFn = func(context.Context, string) (workload.Document, error) { return doc(t, workloadJSON), nil }
+	getArtifactDocFn = func(context.Context, string) (workload.Document, error) { return doc(t, artifactJSON), nil }
 
 	t.Cleanup(func() {
 		getWorkloadDocFn, getArtifactDocFn = prevWorkload, prevArtifact
@@ -112,8 +113,8 @@ func stubLiveErr(t *testing.T, err error) {
 
 	prevWorkload, prevArtifact := getWorkloadDocFn, getArtifactDocFn
 
-	getWorkloadDocFn = func(string) (workload.Document, error) { return nil, err }
-	getArtifactDocFn = func(string) (workload.Document, error) { return nil, err }
+	getWorkloadDocFn = func(context.Context, string) (workload.Document, error) { return nil, err }
+	getArtifactDocFn = func(context.Context, string) (workload.Document, error) { return nil, err }
 
 	t.Cleanup(func() {
 		getWorkloadDocFn, getArtifactDocFn = prevWorkload, prevArtifact
@@ -130,7 +131,7 @@ func notFoundErr() error {
 func TestLook_UnboundNeedsNoRequests(t *testing.T) {
 	stubLiveErr(t, errors.New("no request should have been made"))
 
-	live, err := Look("")
+	live, err := Look(context.Background(), "")
 	require.NoError(t, err)
 	assert.Equal(t, StateUnbound, live.State)
 	assert.Empty(t, live.ArtifactID)
@@ -139,7 +140,7 @@ func TestLook_UnboundNeedsNoRequests(t *testing.T) {
 func TestLook_ReadsBothDocuments(t *testing.T) {
 	stubLive(t, liveWorkloadJSON, liveArtifactJSON)
 
-	live, err := Look("68b0c1d2e3f4a5b6c7d8e9f0")
+	live, err := Look(context.Background(), "68b0c1d2e3f4a5b6c7d8e9f0")
 	require.NoError(t, err)
 
 	assert.Equal(t, StateRunning, live.State)
@@ -160,7 +161,7 @@ func TestLook_ReadsBothDocuments(t *testing.T) {
 func TestLook_StripsBuildOutputsFromTheSpec(t *testing.T) {
 	stubLive(t, liveWorkloadJSON, liveArtifactJSON)
 
-	live, err := Look("wl-1")
+	live, err := Look(context.Background(), "wl-1")
 	require.NoError(t, err)
 
 	primary := primaryContainer(t, live.Spec)
@@ -178,7 +179,7 @@ func TestLook_StripsBuildOutputsFromTheSpec(t *testing.T) {
 func TestLook_SpecSurvivesUnknownBlocks(t *testing.T) {
 	stubLive(t, liveWorkloadJSON, liveArtifactJSON)
 
-	live, err := Look("wl-1")
+	live, err := Look(context.Background(), "wl-1")
 	require.NoError(t, err)
 
 	groups, _ := live.Spec["containerGroups"].([]any)
@@ -202,7 +203,7 @@ func TestLook_SpecSurvivesUnknownBlocks(t *testing.T) {
 func TestLook_MissingWorkloadIsAStateNotAnError(t *testing.T) {
 	stubLiveErr(t, notFoundErr())
 
-	live, err := Look("wl-gone")
+	live, err := Look(context.Background(), "wl-gone")
 	require.NoError(t, err)
 	assert.Equal(t, StateMissing, live.State)
 	assert.Empty(t, live.Endpoint)
@@ -211,7 +212,7 @@ func TestLook_MissingWorkloadIsAStateNotAnError(t *testing.T) {
 func TestLook_TransportFailurePropagates(t *testing.T) {
 	stubLiveErr(t, &drapi.HTTPError{StatusCode: http.StatusInternalServerError})
 
-	_, err := Look("wl-1")
+	_, err := Look(context.Background(), "wl-1")
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "cannot read workload wl-1")
 }
@@ -223,12 +224,12 @@ func TestLook_TransportFailurePropagates(t *testing.T) {
 func TestLook_MissingArtifactIsNotFatal(t *testing.T) {
 	prevWorkload, prevArtifact := getWorkloadDocFn, getArtifactDocFn
 
-	getWorkloadDocFn = func(string) (workload.Document, error) { return doc(t, liveWorkloadJSON), nil }
-	getArtifactDocFn = func(string) (workload.Document, error) { return nil, notFoundErr() }
+	getWorkloadDocFn = func(context.Context, string) (workload.Document, error) { return doc(t, liveWorkloadJSON), nil }
+	getArtifactDocFn = func(context.Context, string) (workload.Document, error) { return nil, notFoundErr() }
 
 	t.Cleanup(func() { getWorkloadDocFn, getArtifactDocFn = prevWorkload, prevArtifact })
 
-	live, err := Look("wl-1")
+	live, err := Look(context.Background(), "wl-1")
 	require.NoError(t, err)
 	assert.Equal(t, StateRunning, live.State)
 	assert.Empty(t, live.Spec)
@@ -239,14 +240,14 @@ func TestLook_MissingArtifactIsNotFatal(t *testing.T) {
 func TestLook_ArtifactFailurePropagates(t *testing.T) {
 	prevWorkload, prevArtifact := getWorkloadDocFn, getArtifactDocFn
 
-	getWorkloadDocFn = func(string) (workload.Document, error) { return doc(t, liveWorkloadJSON), nil }
-	getArtifactDocFn = func(string) (workload.Document, error) {
+	getWorkloadDocFn = func(context.Context, string) (workload.Document, error) { return doc(t, liveWorkloadJSON), nil }
+	getArtifactDocFn = func(context.Context, string) (workload.Document, error) {
 		return nil, &drapi.HTTPError{StatusCode: http.StatusBadGateway}
 	}
 
 	t.Cleanup(func() { getWorkloadDocFn, getArtifactDocFn = prevWorkload, prevArtifact })
 
-	_, err := Look("wl-1")
+	_, err := Look(context.Background(), "wl-1")
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "cannot read artifact")
 }
@@ -256,10 +257,10 @@ func TestLook_WorkloadWithNoArtifactSkipsTheSecondRead(t *testing.T) {
 
 	prevWorkload, prevArtifact := getWorkloadDocFn, getArtifactDocFn
 
-	getWorkloadDocFn = func(string) (workload.Document, error) {
+	getWorkloadDocFn = func(context.Context, string) (workload.Document, error) {
 		return doc(t, `{"id":"wl-1","name":"fresh","status":"submitted"}`), nil
 	}
-	getArtifactDocFn = func(string) (workload.Document, error) {
+	getArtifactDocFn = func(context.Context, string) (workload.Document, error) {
 		artifactReads++
 
 		return nil, nil
@@ -267,7 +268,7 @@ func TestLook_WorkloadWithNoArtifactSkipsTheSecondRead(t *testing.T) {
 
 	t.Cleanup(func() { getWorkloadDocFn, getArtifactDocFn = prevWorkload, prevArtifact })
 
-	live, err := Look("wl-1")
+	live, err := Look(context.Background(), "wl-1")
 	require.NoError(t, err)
 	assert.Equal(t, StateSettling, live.State)
 	assert.Zero(t, artifactReads, "there is no artifact id to look up")
@@ -281,7 +282,7 @@ func TestLook_LockedIsCaseInsensitive(t *testing.T) {
 		t.Run(status, func(t *testing.T) {
 			stubLive(t, liveWorkloadJSON, `{"id":"art-1","status":"`+status+`","spec":{"type":"service"}}`)
 
-			live, err := Look("wl-1")
+			live, err := Look(context.Background(), "wl-1")
 			require.NoError(t, err)
 			assert.True(t, live.Locked)
 		})
@@ -289,7 +290,7 @@ func TestLook_LockedIsCaseInsensitive(t *testing.T) {
 
 	stubLive(t, liveWorkloadJSON, `{"id":"art-1","status":"draft","spec":{"type":"service"}}`)
 
-	live, err := Look("wl-1")
+	live, err := Look(context.Background(), "wl-1")
 	require.NoError(t, err)
 	assert.False(t, live.Locked)
 }
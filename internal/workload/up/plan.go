@@ -26,6 +26,11 @@ const (
 	ActionUpdated   = "updated"
 	ActionStarted   = "started"
 	ActionUnchanged = "unchanged"
+
+	// ActionRolledBack is what Rollback reports. No plan produces it: a
+	// rollback promotes a version that already exists, and the file has no
+	// say in which.
+	ActionRolledBack = "rolled-back"
 )
 
 // CodeChange is the working tree measured against what the platform already
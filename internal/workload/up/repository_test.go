@@ -15,6 +15,7 @@
 package up
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
@@ -53,12 +54,12 @@ func asType(f fakes, artifactType string) fakes {
 func liveArtifact(f fakes, edit func(workload.Document)) fakes {
 	previous := f.artifactD
 
-	f.artifactD = func(id string) (workload.Document, error) {
+	f.artifactD = func(ctx context.Context, id string) (workload.Document, error) {
 		d := docOf(liveImageArtifactJSON)
 
 		if previous != nil {
 			var err error
-			if d, err = previous(id); err != nil {
+			if d, err = previous(ctx, id); err != nil {
 				return nil, err
 			}
 		}
@@ -155,7 +156,7 @@ func TestRun_RepositoryRefusedRollsIntoANewOneInstead(t *testing.T) {
 
 	var sent []string
 
-	f.newArtifact = func(payload any) (*workload.Artifact, error) {
+	f.newArtifact = func(_ context.Context, payload any) (*workload.Artifact, error) {
 		sent = append(sent, sentRepository(t, payload))
 
 		if len(sent) == 1 {
@@ -187,7 +188,7 @@ func TestRun_NotFoundWithNoRepositorySentIsNotRetried(t *testing.T) {
 	f := wiredRoll(&tr)
 
 	calls := 0
-	f.newArtifact = func(any) (*workload.Artifact, error) {
+	f.newArtifact = func(context.Context, any) (*workload.Artifact, error) {
 		calls++
 
 		return nil, notFound()
@@ -211,7 +212,7 @@ func TestRun_RepositoryTypeRefusedRollsIntoANewOneInstead(t *testing.T) {
 
 	var sent []string
 
-	f.newArtifact = func(payload any) (*workload.Artifact, error) {
+	f.newArtifact = func(_ context.Context, payload any) (*workload.Artifact, error) {
 		sent = append(sent, sentRepository(t, payload))
 
 		if len(sent) == 1 {
@@ -243,7 +244,7 @@ func TestRun_RepositoryFallbackDoesNotSwallowOtherFailures(t *testing.T) {
 	f := inRepository(wiredRoll(&tr))
 
 	calls := 0
-	f.newArtifact = func(any) (*workload.Artifact, error) {
+	f.newArtifact = func(context.Context, any) (*workload.Artifact, error) {
 		calls++
 
 		return nil, &drapi.HTTPError{StatusCode: http.StatusUnprocessableEntity, Detail: "spec is incomplete"}
@@ -265,7 +266,7 @@ func TestRun_RepositoryFallbackLeavesPlainErrorsAlone(t *testing.T) {
 	f := inRepository(wiredRoll(&tr))
 
 	calls := 0
-	f.newArtifact = func(any) (*workload.Artifact, error) {
+	f.newArtifact = func(context.Context, any) (*workload.Artifact, error) {
 		calls++
 
 		return nil, errors.New("connection reset")
@@ -304,7 +305,7 @@ func builtRollInRepository(tr *track, draftRepositoryID string) fakes {
 	f := builtRoll(tr)
 	f.linked = func(string) bool { return true }
 	f.project = syncedProject("art-abandoned")
-	f.getArtifact = func(id string) (*workload.Artifact, error) {
+	f.getArtifact = func(_ context.Context, id string) (*workload.Artifact, error) {
 		return &workload.Artifact{
 			ID:                   id,
 			Status:               workload.ArtifactStatusDraft,
@@ -315,8 +316,8 @@ func builtRollInRepository(tr *track, draftRepositoryID string) fakes {
 	// The leftover says what the file says, so only the repository is left to
 	// decide whether it can be reused.
 	docs := artifactDocs("art-abandoned", 9000)
-	f.artifactD = func(id string) (workload.Document, error) {
-		d, err := docs(id)
+	f.artifactD = func(ctx context.Context, id string) (workload.Document, error) {
+		d, err := docs(ctx, id)
 		if err != nil {
 			return nil, err
 		}
@@ -355,7 +356,7 @@ func TestRun_LeftoverDraftInTheSameRepositoryIsStillReused(t *testing.T) {
 	var tr track
 
 	f := builtRollInRepository(&tr, liveRepositoryID)
-	f.newArtifact = func(any) (*workload.Artifact, error) {
+	f.newArtifact = func(context.Context, any) (*workload.Artifact, error) {
 		t.Fatal("a draft in the right lineage is already the version to roll onto")
 
 		return nil, nil
@@ -418,7 +419,7 @@ func TestRun_RepositoryFallbackDropsTheFilesRepositoryToo(t *testing.T) {
 
 	var sent []string
 
-	f.newArtifact = func(payload any) (*workload.Artifact, error) {
+	f.newArtifact = func(_ context.Context, payload any) (*workload.Artifact, error) {
 		sent = append(sent, sentRepository(t, payload))
 
 		if len(sent) == 1 {
@@ -452,7 +453,7 @@ func builtRollLeavingTheLineage(tr *track) fakes {
 	f := builtRoll(tr)
 	f.linked = func(string) bool { return true }
 	f.project = syncedProject("art-abandoned")
-	f.getArtifact = func(id string) (*workload.Artifact, error) {
+	f.getArtifact = func(_ context.Context, id string) (*workload.Artifact, error) {
 		return &workload.Artifact{
 			ID:                   id,
 			Status:               workload.ArtifactStatusDraft,
@@ -461,8 +462,8 @@ func builtRollLeavingTheLineage(tr *track) fakes {
 	}
 
 	docs := artifactDocs("art-abandoned", 9000)
-	f.artifactD = func(id string) (workload.Document, error) {
-		d, err := docs(id)
+	f.artifactD = func(ctx context.Context, id string) (workload.Document, error) {
+		d, err := docs(ctx, id)
 		if err != nil {
 			return nil, err
 		}
@@ -490,7 +491,7 @@ func TestRun_LeftoverDraftIsReusedWhenTheRollStartsItsOwnRepository(t *testing.T
 	var tr track
 
 	f := builtRollLeavingTheLineage(&tr)
-	f.newArtifact = func(any) (*workload.Artifact, error) {
+	f.newArtifact = func(context.Context, any) (*workload.Artifact, error) {
 		t.Error("the draft the last attempt left behind is the version to roll onto")
 
 		return nil, errors.New("nothing should be created")
@@ -15,6 +15,7 @@
 package up
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -39,7 +40,7 @@ import (
 // the same block the plan was computed from, the platform takes it as a unit,
 // and sending a subset would leave the reader guessing which half of the file
 // is now live.
-func retune(loaded Loaded, result Result, opts Options, report *reporter) (Result, error) {
+func retune(ctx context.Context, loaded Loaded, result Result, opts Options, report *reporter) (Result, error) {
 	runtime, err := loaded.Compiled.RuntimePayload()
 	if err != nil {
 		return result, err
@@ -48,7 +49,7 @@ func retune(loaded Loaded, result Result, opts Options, report *reporter) (Resul
 	var started *workload.Replacement
 
 	err = report.run("Updating the runtime settings", func() error {
-		replacement, updateErr := updateSettingsFn(result.WorkloadID, runtime)
+		replacement, updateErr := updateSettingsFn(ctx, result.WorkloadID, runtime)
 		started = replacement
 
 		return updateErr
@@ -65,21 +66,21 @@ func retune(loaded Loaded, result Result, opts Options, report *reporter) (Resul
 		return result, nil
 	}
 
-	if err := awaitResize(result.WorkloadID, started, opts, report); err != nil {
+	if err := awaitResize(ctx, result.WorkloadID, started, opts, report); err != nil {
 		return result, err
 	}
 
-	return settle(result.WorkloadID, result, opts, report)
+	return settle(ctx, result.WorkloadID, result, opts, report)
 }
 
 // awaitResize follows the replacement a settings change starts. A failed one
 // leaves the workload on the sizing it had, so it is the difference between a
 // resize that happened and one that was merely accepted.
-func awaitResize(workloadID string, started *workload.Replacement, opts Options, report *reporter) error {
+func awaitResize(ctx context.Context, workloadID string, started *workload.Replacement, opts Options, report *reporter) error {
 	var settled *workload.Replacement
 
 	err := report.run("Waiting for the new settings", func() error {
-		replacement, waitErr := waitReplacementFn(workloadID, started, opts.PollInterval, opts.PollTimeout, nil)
+		replacement, waitErr := waitReplacementFn(ctx, workloadID, started, opts.PollInterval, opts.PollTimeout, nil)
 		settled = replacement
 
 		return waitErr
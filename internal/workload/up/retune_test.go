@@ -15,6 +15,7 @@
 package up
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"strings"
@@ -38,15 +39,15 @@ func retuned(from, to string) string {
 // replacement the platform starts to apply the sizing, then the workload.
 func wiredRetune(tr *track, sent *json.RawMessage) fakes {
 	return fakes{
-		workloadD: func(string) (workload.Document, error) { return docOf(liveWorkloadJSON), nil },
-		artifactD: func(string) (workload.Document, error) { return docOf(liveArtifactJSON), nil },
-		settings: func(workloadID string, runtime json.RawMessage) (*workload.Replacement, error) {
+		workloadD: func(context.Context, string) (workload.Document, error) { return docOf(liveWorkloadJSON), nil },
+		artifactD: func(context.Context, string) (workload.Document, error) { return docOf(liveArtifactJSON), nil },
+		settings: func(_ context.Context, workloadID string, runtime json.RawMessage) (*workload.Replacement, error) {
 			tr.steps = append(tr.steps, "settings:"+workloadID)
 			*sent = runtime
 
 			return &workload.Replacement{ID: "rep-1", WorkloadID: workloadID}, nil
 		},
-		waitReplace: func(_ string, started *workload.Replacement, _, _ time.Duration,
+		waitReplace: func(_ context.Context, _ string, started *workload.Replacement, _, _ time.Duration,
 			_ func(*workload.Replacement),
 		) (*workload.Replacement, error) {
 			tr.steps = append(tr.steps, "await-resize")
@@ -54,7 +55,7 @@ func wiredRetune(tr *track, sent *json.RawMessage) fakes {
 
 			return started, nil
 		},
-		wait: func(id string, _, _ time.Duration, _ func(*workload.Workload)) (*workload.Workload, error) {
+		wait: func(_ context.Context, id string, _, _ time.Duration, _ func(*workload.Workload)) (*workload.Workload, error) {
 			tr.steps = append(tr.steps, "settle")
 
 			return &workload.Workload{
@@ -75,12 +76,12 @@ func TestRun_RuntimeOnlyChangeIsAppliedInPlace(t *testing.T) {
 	)
 
 	f := wiredRetune(&tr, &sent)
-	f.newArtifact = func(any) (*workload.Artifact, error) {
+	f.newArtifact = func(context.Context, any) (*workload.Artifact, error) {
 		t.Fatal("a sizing change mints no version")
 
 		return nil, nil
 	}
-	f.replace = func(string, string, json.RawMessage) (*workload.Replacement, error) {
+	f.replace = func(context.Context, string, string, json.RawMessage) (*workload.Replacement, error) {
 		t.Fatal("a sizing change swaps nothing")
 
 		return nil, nil
@@ -136,7 +137,7 @@ func TestRun_RetuneDoesNotVerifyCredentials(t *testing.T) {
 	)
 
 	f := wiredRetune(&tr, &sent)
-	f.cred = func(string) (*workload.Credential, error) {
+	f.cred = func(context.Context, string) (*workload.Credential, error) {
 		t.Fatal("a settings update sends no environment")
 
 		return nil, nil
@@ -156,10 +157,10 @@ func TestRun_RetuneFailureNamesTheWorkload(t *testing.T) {
 	)
 
 	f := wiredRetune(&tr, &sent)
-	f.settings = func(string, json.RawMessage) (*workload.Replacement, error) {
+	f.settings = func(context.Context, string, json.RawMessage) (*workload.Replacement, error) {
 		return nil, errors.New("replicaCount and autoscaling are mutually exclusive")
 	}
-	f.wait = func(string, time.Duration, time.Duration, func(*workload.Workload)) (*workload.Workload, error) {
+	f.wait = func(context.Context, string, time.Duration, time.Duration, func(*workload.Workload)) (*workload.Workload, error) {
 		t.Fatal("a settings call that failed changed nothing to wait for")
 
 		return nil, nil
@@ -184,14 +185,14 @@ func TestRun_RetuneReportsAReplacementThatFailed(t *testing.T) {
 	)
 
 	f := wiredRetune(&tr, &sent)
-	f.waitReplace = func(_ string, started *workload.Replacement, _, _ time.Duration,
+	f.waitReplace = func(_ context.Context, _ string, started *workload.Replacement, _, _ time.Duration,
 		_ func(*workload.Replacement),
 	) (*workload.Replacement, error) {
 		started.Status = workload.ReplacementStatusFailed
 
 		return started, errors.New("replacement ended as FAILED")
 	}
-	f.wait = func(string, time.Duration, time.Duration, func(*workload.Workload)) (*workload.Workload, error) {
+	f.wait = func(context.Context, string, time.Duration, time.Duration, func(*workload.Workload)) (*workload.Workload, error) {
 		t.Fatal("a resize that failed leaves nothing to settle")
 
 		return nil, nil
@@ -235,7 +236,7 @@ func TestRun_RollCarriesTheRuntimeWithTheSwap(t *testing.T) {
 	f := builtRoll(&tr)
 	f.linked = func(string) bool { return true }
 	f.project = syncedProject("68a0000000000000000000a1")
-	f.settings = func(string, json.RawMessage) (*workload.Replacement, error) {
+	f.settings = func(context.Context, string, json.RawMessage) (*workload.Replacement, error) {
 		t.Fatal("the swap carries the sizing; a second call would apply it twice")
 
 		return nil, nil
@@ -286,8 +287,8 @@ func TestRun_RetuneWithLockLocksTheServingArtifact(t *testing.T) {
 	f := wiredRetune(&tr, &sent)
 	// The shared fixture is already locked, which is the other branch: an
 	// artifact cannot be locked twice.
-	f.artifactD = func(string) (workload.Document, error) { return unlockedArtifact(t), nil }
-	f.lock = func(id string) (*workload.Artifact, error) {
+	f.artifactD = func(context.Context, string) (workload.Document, error) { return unlockedArtifact(t), nil }
+	f.lock = func(_ context.Context, id string) (*workload.Artifact, error) {
 		tr.steps = append(tr.steps, "lock:"+id)
 
 		return &workload.Artifact{ID: id, Status: workload.ArtifactStatusLocked}, nil
@@ -15,6 +15,7 @@
 package up
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -41,12 +42,12 @@ import (
 // locked for a rollout that is then refused can be neither unlocked nor
 // deleted. Taking the lock only once nothing is left that can say no is what
 // keeps a lost race from leaving one behind.
-func roll(loaded Loaded, live Live, plan Plan, result Result, opts Options, report *reporter) (Result, error) {
-	if err := guardReplacementFn(live.WorkloadID); err != nil {
+func roll(ctx context.Context, loaded Loaded, live Live, plan Plan, result Result, opts Options, report *reporter) (Result, error) {
+	if err := guardReplacementFn(ctx, live.WorkloadID); err != nil {
 		return result, err
 	}
 
-	made, err := candidateArtifact(loaded, live, plan.Code, opts, report)
+	made, err := candidateArtifact(ctx, loaded, live, plan.Code, opts, report)
 
 	// Recorded before the error check: a failed build is still a build, and
 	// the caller's envelope should be able to name the one to go and read.
@@ -76,7 +77,7 @@ func roll(loaded Loaded, live Live, plan Plan, result Result, opts Options, repo
 		return result, err
 	}
 
-	return replace(live.WorkloadID, made, lock, runtime, result, opts, report)
+	return replace(ctx, live.WorkloadID, made, lock, runtime, result, opts, report)
 }
 
 // candidateArtifact is the version to roll onto.
@@ -91,6 +92,7 @@ func roll(loaded Loaded, live Live, plan Plan, result Result, opts Options, repo
 // workload deployed ten times reads as ten versions of one thing rather than
 // ten artifacts that happen to share a name.
 func candidateArtifact(
+	ctx context.Context,
 	loaded Loaded,
 	live Live,
 	code CodeChange,
@@ -104,10 +106,10 @@ func candidateArtifact(
 	repository := sameRepository(loaded, live)
 
 	if mode := loaded.Manifest.BuildMode(); mode == manifest.BuildModeDockerfile || mode == manifest.BuildModeGenerated {
-		return buildVersion(loaded, live, code, repository, opts, report)
+		return buildVersion(ctx, loaded, live, code, repository, opts, report)
 	}
 
-	return createVersion(loaded, repository, report)
+	return createVersion(ctx, loaded, repository, report)
 }
 
 // sameRepository is the repository the new version joins, "" when it should
@@ -184,12 +186,12 @@ func confirmLock(live Live, workloadName string, opts Options) (bool, error) {
 // whole point: the platform wants the lock in place before the replacement is
 // POSTed, and by then everything that could still refuse the rollout has had
 // its say, so a lock taken here is one the run is committed to using.
-func matchLock(made version, report *reporter) (bool, error) {
+func matchLock(ctx context.Context, made version, report *reporter) (bool, error) {
 	// A candidate the file named, or one left by an earlier attempt, may
 	// already be locked, and locking twice is not a no-op at the platform.
 	// One created by this run is always a draft.
 	if !made.Fresh {
-		already, lockedErr := lockedAlready(made.ID)
+		already, lockedErr := lockedAlready(ctx, made.ID)
 		if lockedErr != nil {
 			return false, lockedErr
 		}
@@ -200,7 +202,7 @@ func matchLock(made version, report *reporter) (bool, error) {
 	}
 
 	err := report.run("Locking the new version", func() error {
-		_, lockErr := lockArtifactFn(made.ID)
+		_, lockErr := lockArtifactFn(ctx, made.ID)
 
 		return lockErr
 	})
@@ -214,8 +216,8 @@ func matchLock(made version, report *reporter) (bool, error) {
 }
 
 // lockedAlready reports whether the candidate is already immutable.
-func lockedAlready(artifactID string) (bool, error) {
-	artifact, err := getArtifactFn(artifactID)
+func lockedAlready(ctx context.Context, artifactID string) (bool, error) {
+	artifact, err := getArtifactFn(ctx, artifactID)
 	if err != nil {
 		return false, fmt.Errorf("cannot read artifact %s to see whether it is already locked: %w", artifactID, err)
 	}
@@ -256,6 +258,7 @@ func confirmRoll(workloadName string, opts Options) (bool, error) {
 // replace starts the rollout and follows it to the end. runtime is nil unless
 // the sizing changed too, in which case it travels with the swap.
 func replace(
+	ctx context.Context,
 	workloadID string,
 	made version,
 	lock bool,
@@ -267,12 +270,12 @@ func replace(
 	// The guard that actually holds. The live state can have changed since
 	// the one at the top, and this is the last moment before a swap that
 	// cannot be taken back by refusing it.
-	if err := guardReplacementFn(workloadID); err != nil {
+	if err := guardReplacementFn(ctx, workloadID); err != nil {
 		return result, err
 	}
 
 	if lock {
-		locked, err := matchLock(made, report)
+		locked, err := matchLock(ctx, made, report)
 		if err != nil {
 			return result, err
 		}
@@ -283,7 +286,7 @@ func replace(
 	var started *workload.Replacement
 
 	err := report.run("Rolling out the new version", func() error {
-		replacement, startErr := startReplacementFn(workloadID, made.ID, runtime)
+		replacement, startErr := startReplacementFn(ctx, workloadID, made.ID, runtime)
 		started = replacement
 
 		return startErr
@@ -300,22 +303,22 @@ func replace(
 		return result, nil
 	}
 
-	if err := awaitRollout(workloadID, started, opts, report); err != nil {
+	if err := awaitRollout(ctx, workloadID, started, opts, report); err != nil {
 		return result, err
 	}
 
-	return settle(workloadID, result, opts, report)
+	return settle(ctx, workloadID, result, opts, report)
 }
 
 // awaitRollout waits for the swap itself, before the wait for the workload.
 // They are two questions: the rollout says whether the new version was
 // promoted, and a failed one leaves the old version serving, so reporting the
 // workload as healthy afterwards would be true and completely misleading.
-func awaitRollout(workloadID string, started *workload.Replacement, opts Options, report *reporter) error {
+func awaitRollout(ctx context.Context, workloadID string, started *workload.Replacement, opts Options, report *reporter) error {
 	var settled *workload.Replacement
 
 	err := report.run("Waiting for the rollout", func() error {
-		replacement, waitErr := waitReplacementFn(workloadID, started, opts.PollInterval, opts.PollTimeout, nil)
+		replacement, waitErr := waitReplacementFn(ctx, workloadID, started, opts.PollInterval, opts.PollTimeout, nil)
 		settled = replacement
 
 		return waitErr
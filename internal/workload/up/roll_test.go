@@ -15,6 +15,7 @@
 package up
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -83,8 +84,8 @@ func newImage() string {
 
 // liveImage points the two reads at the running pair.
 func liveImage(f fakes) fakes {
-	f.workloadD = func(string) (workload.Document, error) { return docOf(liveImageWorkloadJSON), nil }
-	f.artifactD = func(string) (workload.Document, error) { return docOf(liveImageArtifactJSON), nil }
+	f.workloadD = func(context.Context, string) (workload.Document, error) { return docOf(liveImageWorkloadJSON), nil }
+	f.artifactD = func(context.Context, string) (workload.Document, error) { return docOf(liveImageArtifactJSON), nil }
 
 	return f
 }
@@ -105,29 +106,29 @@ func docOf(raw string) workload.Document {
 // happened in. Order is most of what this path has to get right.
 func wiredRoll(tr *track) fakes {
 	return liveImage(fakes{
-		guard: func(string) error {
+		guard: func(context.Context, string) error {
 			tr.steps = append(tr.steps, "guard")
 
 			return nil
 		},
-		newArtifact: func(payload any) (*workload.Artifact, error) {
+		newArtifact: func(_ context.Context, payload any) (*workload.Artifact, error) {
 			tr.steps = append(tr.steps, "create-artifact")
 			tr.artifactIn = payload
 
 			return &workload.Artifact{ID: "art-2", Status: workload.ArtifactStatusDraft}, nil
 		},
-		lock: func(id string) (*workload.Artifact, error) {
+		lock: func(_ context.Context, id string) (*workload.Artifact, error) {
 			tr.steps = append(tr.steps, "lock:"+id)
 
 			return &workload.Artifact{ID: id, Status: workload.ArtifactStatusLocked}, nil
 		},
-		replace: func(workloadID, artifactID string, runtime json.RawMessage) (*workload.Replacement, error) {
+		replace: func(_ context.Context, workloadID, artifactID string, runtime json.RawMessage) (*workload.Replacement, error) {
 			tr.steps = append(tr.steps, "replace:"+artifactID)
 			tr.rolledRuntime = runtime
 
 			return &workload.Replacement{ID: "rep-1", WorkloadID: workloadID, ArtifactID: artifactID}, nil
 		},
-		waitReplace: func(_ string, started *workload.Replacement, _, _ time.Duration,
+		waitReplace: func(_ context.Context, _ string, started *workload.Replacement, _, _ time.Duration,
 			_ func(*workload.Replacement),
 		) (*workload.Replacement, error) {
 			tr.steps = append(tr.steps, "await-rollout")
@@ -135,7 +136,7 @@ func wiredRoll(tr *track) fakes {
 
 			return started, nil
 		},
-		wait: func(id string, _, _ time.Duration, _ func(*workload.Workload)) (*workload.Workload, error) {
+		wait: func(_ context.Context, id string, _, _ time.Duration, _ func(*workload.Workload)) (*workload.Workload, error) {
 			tr.steps = append(tr.steps, "settle")
 
 			return &workload.Workload{
@@ -148,7 +149,7 @@ func wiredRoll(tr *track) fakes {
 
 // locked makes the running version production.
 func lockedLive(f fakes) fakes {
-	f.artifactD = func(string) (workload.Document, error) {
+	f.artifactD = func(context.Context, string) (workload.Document, error) {
 		d := docOf(liveImageArtifactJSON)
 		d["status"] = workload.ArtifactStatusLocked
 
@@ -191,7 +192,7 @@ func TestRun_RollUsesTheArtifactTheFileNames(t *testing.T) {
 	var tr track
 
 	f := wiredRoll(&tr)
-	f.newArtifact = func(any) (*workload.Artifact, error) {
+	f.newArtifact = func(context.Context, any) (*workload.Artifact, error) {
 		t.Fatal("the file named the version to roll onto")
 
 		return nil, nil
@@ -214,7 +215,9 @@ func TestRun_ReplacementInFlightStopsBeforeAnythingIsMade(t *testing.T) {
 	var tr track
 
 	f := wiredRoll(&tr)
-	f.guard = func(string) error { return errors.New("workload wl-1 already has a replacement in progress") }
+	f.guard = func(context.Context, string) error {
+		return errors.New("workload wl-1 already has a replacement in progress")
+	}
 
 	install(t, f)
 
@@ -261,12 +264,12 @@ func TestRun_LockedRollDoesNotRelockAnArtifactTheFileNamed(t *testing.T) {
 	var tr track
 
 	f := lockedLive(wiredRoll(&tr))
-	f.newArtifact = func(any) (*workload.Artifact, error) {
+	f.newArtifact = func(context.Context, any) (*workload.Artifact, error) {
 		t.Fatal("the file named the version to roll onto")
 
 		return nil, nil
 	}
-	f.getArtifact = func(id string) (*workload.Artifact, error) {
+	f.getArtifact = func(_ context.Context, id string) (*workload.Artifact, error) {
 		tr.steps = append(tr.steps, "read:"+id)
 
 		return &workload.Artifact{ID: id, Status: workload.ArtifactStatusLocked}, nil
@@ -295,7 +298,7 @@ func TestRun_LockedRollLocksANamedDraft(t *testing.T) {
 	var tr track
 
 	f := lockedLive(wiredRoll(&tr))
-	f.getArtifact = func(id string) (*workload.Artifact, error) {
+	f.getArtifact = func(_ context.Context, id string) (*workload.Artifact, error) {
 		tr.steps = append(tr.steps, "read:"+id)
 
 		return &workload.Artifact{ID: id, Status: workload.ArtifactStatusDraft}, nil
@@ -330,7 +333,7 @@ func TestRun_LockIsNotTakenWhenTheFinalGuardRefuses(t *testing.T) {
 
 	// The first guard passes and the second finds a rollout someone else
 	// started meanwhile, which is the race this ordering exists for.
-	f.guard = func(string) error {
+	f.guard = func(context.Context, string) error {
 		tr.steps = append(tr.steps, "guard")
 		calls++
 
@@ -441,14 +444,14 @@ func TestRun_FailedRolloutSaysTheOldVersionIsStillServing(t *testing.T) {
 	var tr track
 
 	f := wiredRoll(&tr)
-	f.waitReplace = func(_ string, started *workload.Replacement, _, _ time.Duration,
+	f.waitReplace = func(_ context.Context, _ string, started *workload.Replacement, _, _ time.Duration,
 		_ func(*workload.Replacement),
 	) (*workload.Replacement, error) {
 		started.Status = workload.ReplacementStatusFailed
 
 		return started, errors.New("replacement rep-1 ended with status failed")
 	}
-	f.wait = func(string, time.Duration, time.Duration, func(*workload.Workload)) (*workload.Workload, error) {
+	f.wait = func(context.Context, string, time.Duration, time.Duration, func(*workload.Workload)) (*workload.Workload, error) {
 		t.Fatal("a failed rollout has nothing to settle")
 
 		return nil, nil
@@ -484,7 +487,7 @@ func TestRun_StoppedWorkloadWithANewVersionSaysStartItFirst(t *testing.T) {
 	var tr track
 
 	f := wiredRoll(&tr)
-	f.workloadD = func(string) (workload.Document, error) {
+	f.workloadD = func(context.Context, string) (workload.Document, error) {
 		d := docOf(liveImageWorkloadJSON)
 		d["status"] = workload.WorkloadStatusStopped
 
@@ -506,8 +509,8 @@ func TestRun_StoppedWorkloadWithANewVersionSaysStartItFirst(t *testing.T) {
 func builtRoll(tr *track) fakes {
 	f := wiredRoll(tr)
 
-	f.workloadD = func(string) (workload.Document, error) { return docOf(liveWorkloadJSON), nil }
-	f.artifactD = func(string) (workload.Document, error) {
+	f.workloadD = func(context.Context, string) (workload.Document, error) { return docOf(liveWorkloadJSON), nil }
+	f.artifactD = func(context.Context, string) (workload.Document, error) {
 		d := docOf(liveArtifactJSON)
 		d["status"] = workload.ArtifactStatusDraft
 
@@ -526,23 +529,23 @@ func builtRoll(tr *track) fakes {
 
 		return nil
 	}
-	f.codeRef = func(artifactID, catalogID, versionID string) error {
+	f.codeRef = func(_ context.Context, artifactID, catalogID, versionID string) error {
 		tr.steps = append(tr.steps, "carry-code")
 		tr.carried = []string{artifactID, catalogID, versionID}
 
 		return nil
 	}
-	f.sync = func(string) (*sync.Result, error) {
+	f.sync = func(context.Context, string) (*sync.Result, error) {
 		tr.steps = append(tr.steps, "sync")
 
 		return &sync.Result{UploadedCount: 2, NewVersion: "ver-2"}, nil
 	}
-	f.build = func(string) (*workload.BuildTriggerResponse, error) {
+	f.build = func(context.Context, string) (*workload.BuildTriggerResponse, error) {
 		tr.steps = append(tr.steps, "build")
 
 		return &workload.BuildTriggerResponse{BuildIDs: []string{"bld-2"}}, nil
 	}
-	f.waitBuild = func(_, id string, _, _ time.Duration, _ func(*workload.Build)) (*workload.Build, error) {
+	f.waitBuild = func(_ context.Context, _, id string, _, _ time.Duration, _ func(*workload.Build)) (*workload.Build, error) {
 		return &workload.Build{ID: id, Status: workload.BuildStatusCompleted}, nil
 	}
 
@@ -624,13 +627,13 @@ func TestRun_RollReusesTheVersionAnEarlierAttemptLeft(t *testing.T) {
 	f := builtRoll(&tr)
 	f.linked = func(string) bool { return true }
 	f.project = syncedProject("art-abandoned")
-	f.getArtifact = func(id string) (*workload.Artifact, error) {
+	f.getArtifact = func(_ context.Context, id string) (*workload.Artifact, error) {
 		return &workload.Artifact{ID: id, Status: workload.ArtifactStatusDraft}, nil
 	}
 	// The leftover was made from the file this run is reading, so it says
 	// what the file says. That is what makes it the version to roll onto.
 	f.artifactD = artifactDocs("art-abandoned", 9000)
-	f.newArtifact = func(any) (*workload.Artifact, error) {
+	f.newArtifact = func(context.Context, any) (*workload.Artifact, error) {
 		t.Fatal("an unpromoted draft is already the version to roll onto")
 
 		return nil, nil
@@ -658,7 +661,7 @@ func TestRun_RollDoesNotReuseADraftTheFileHasMovedPast(t *testing.T) {
 	f := builtRoll(&tr)
 	f.linked = func(string) bool { return true }
 	f.project = syncedProject("art-abandoned")
-	f.getArtifact = func(id string) (*workload.Artifact, error) {
+	f.getArtifact = func(_ context.Context, id string) (*workload.Artifact, error) {
 		return &workload.Artifact{ID: id, Status: workload.ArtifactStatusDraft}, nil
 	}
 	// The leftover still carries the port the file had when it was made; the
@@ -686,13 +689,13 @@ func TestRun_RollDoesNotReuseADraftCarryingWhatTheFileDeleted(t *testing.T) {
 	f := builtRoll(&tr)
 	f.linked = func(string) bool { return true }
 	f.project = syncedProject("art-abandoned")
-	f.getArtifact = func(id string) (*workload.Artifact, error) {
+	f.getArtifact = func(_ context.Context, id string) (*workload.Artifact, error) {
 		return &workload.Artifact{ID: id, Status: workload.ArtifactStatusDraft}, nil
 	}
 
 	// The leftover agrees with the file about everything the file still says,
 	// and carries one variable the file no longer does.
-	f.artifactD = func(id string) (workload.Document, error) {
+	f.artifactD = func(_ context.Context, id string) (workload.Document, error) {
 		d := docOf(liveArtifactJSON)
 		d["status"] = workload.ArtifactStatusDraft
 
@@ -730,8 +733,8 @@ func TestRun_RollDoesNotReuseADraftCarryingWhatTheFileDeleted(t *testing.T) {
 // the file has never heard of, and that is right for the live one: an
 // unmentioned sidecar is not drift. A leftover cannot have one, because the
 // only thing that could have made it is this file.
-func artifactDocs(abandonedID string, port int) func(string) (workload.Document, error) {
-	return func(id string) (workload.Document, error) {
+func artifactDocs(abandonedID string, port int) func(context.Context, string) (workload.Document, error) {
+	return func(_ context.Context, id string) (workload.Document, error) {
 		d := docOf(liveArtifactJSON)
 		d["status"] = workload.ArtifactStatusDraft
 
@@ -766,7 +769,7 @@ func TestRun_RollDoesNotReuseALockedLeftover(t *testing.T) {
 	f := builtRoll(&tr)
 	f.linked = func(string) bool { return true }
 	f.project = syncedProject("art-abandoned")
-	f.getArtifact = func(id string) (*workload.Artifact, error) {
+	f.getArtifact = func(_ context.Context, id string) (*workload.Artifact, error) {
 		return &workload.Artifact{ID: id, Status: workload.ArtifactStatusLocked}, nil
 	}
 
@@ -788,7 +791,7 @@ func TestRun_SpecOnlyRollCarriesTheCodeOver(t *testing.T) {
 	f := builtRoll(&tr)
 	f.linked = func(string) bool { return true }
 	f.project = syncedProject("68a0000000000000000000a1")
-	f.sync = func(string) (*sync.Result, error) {
+	f.sync = func(context.Context, string) (*sync.Result, error) {
 		tr.steps = append(tr.steps, "sync")
 
 		return &sync.Result{}, nil
@@ -818,7 +821,7 @@ func TestRun_SpecOnlyRollWithNoCodeToCarryStopsBeforeTheBuild(t *testing.T) {
 	f.project = func(string) (wapi.Config, error) {
 		return wapi.Config{ArtifactID: "68a0000000000000000000a1"}, nil
 	}
-	f.sync = func(string) (*sync.Result, error) {
+	f.sync = func(context.Context, string) (*sync.Result, error) {
 		tr.steps = append(tr.steps, "sync")
 
 		return &sync.Result{}, nil
@@ -864,7 +867,7 @@ func TestRun_FailedBuildOnARollLeavesTheOldVersionServing(t *testing.T) {
 	f.project = syncedProject("68a0000000000000000000a1")
 	// WaitForBuild's own contract: a terminal failure comes back as the build
 	// and an error together.
-	f.waitBuild = func(_, id string, _, _ time.Duration, _ func(*workload.Build)) (*workload.Build, error) {
+	f.waitBuild = func(_ context.Context, _, id string, _, _ time.Duration, _ func(*workload.Build)) (*workload.Build, error) {
 		return &workload.Build{ID: id, Status: workload.BuildStatusFailed},
 			fmt.Errorf("build %s ended with status %s", id, workload.BuildStatusFailed)
 	}
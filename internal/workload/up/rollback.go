@@ -0,0 +1,244 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package up
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/datarobot/cli/internal/workload"
+)
+
+// versionSearchLimit bounds how far back a rollback looks for the version
+// before the one serving. A repository this deep has a rollback target well
+// inside it; one that is not would be better served by --to.
+const versionSearchLimit = 100
+
+// Rollback puts workloadID back on an earlier locked version, through the
+// same guarded swap a roll takes. Nothing is built, minted or locked: the
+// version being promoted already exists and is already immutable, which is
+// the whole reason it is safe to go back to.
+//
+// to names the artifact to promote. Empty means the newest locked version
+// numbered below the one serving, which is the version that was live before
+// the last locked deploy.
+//
+// Only a locked workload can be rolled back. The platform replaces locked
+// with locked and draft with draft, and a draft is not a version anyone can
+// return to: it stays editable, so what it held when it served is gone.
+func Rollback(ctx context.Context, workloadID, to string, opts Options) (Result, error) {
+	live, err := lookRunnable(ctx, workloadID)
+	if err != nil {
+		return Result{}, err
+	}
+
+	result := Result{
+		WorkloadID: live.WorkloadID,
+		Name:       live.Name,
+		Status:     live.Status,
+		Endpoint:   live.Endpoint,
+		ArtifactID: live.ArtifactID,
+		Locked:     live.Locked,
+	}
+
+	if err := rollbackable(live, result.Name); err != nil {
+		return result, err
+	}
+
+	// The early guard, for the same reason roll has one: a refusal costs
+	// nothing here and a confirmation typed for nothing later.
+	if err := guardReplacementFn(ctx, workloadID); err != nil {
+		return result, err
+	}
+
+	current, err := getArtifactFn(ctx, live.ArtifactID)
+	if err != nil {
+		return result, fmt.Errorf("cannot read artifact %s: %w", live.ArtifactID, err)
+	}
+
+	target, err := rollbackTarget(ctx, live, *current, to)
+	if err != nil {
+		return result, err
+	}
+
+	if err := confirmRollback(result.Name, *current, target, opts); err != nil {
+		return result, err
+	}
+
+	report := newReporter(opts.Stderr, opts.Spinner)
+
+	report.say("  Rolling %s back from %s to %s.\n", result.Name, describeVersion(*current), describeVersion(target))
+
+	// The target is locked already, so replace is told not to lock it:
+	// locking twice is not a no-op at the platform.
+	rolled, err := replace(ctx, workloadID, version{ID: target.ID}, false, nil, result, opts, report)
+	if rolled.Action == ActionRolled {
+		rolled.Action = ActionRolledBack
+	}
+
+	return rolled, err
+}
+
+// rollbackable refuses the workloads a rollback cannot help, one message each.
+//
+// Errored is deliberately not among them, unlike for `up`. A version that
+// went out healthy and then started failing is exactly what a rollback is
+// for, and the platform is the one to say whether it will take a swap.
+func rollbackable(live Live, workloadName string) error {
+	switch live.State {
+	case StateTerminated:
+		return fmt.Errorf("workload %s is terminated and cannot be rolled back", workloadName)
+
+	case StateStopped:
+		return fmt.Errorf(
+			"workload %s is stopped. Start it with 'dr workload start %s' and roll it back once it is running",
+			workloadName, live.WorkloadID)
+
+	case StateSettling:
+		return fmt.Errorf("workload %s is still settling. Wait for it to finish, then roll back", workloadName)
+
+	case StateUnbound, StateMissing, StateErrored, StateRunning:
+	}
+
+	if !live.Locked {
+		return fmt.Errorf(
+			"workload %s is running a draft, and only a locked workload can be rolled back: the platform "+
+				"replaces a draft only with another draft, and a draft keeps no record of what it once held. "+
+				"Deploy the change you want with 'dr workload up'",
+			workloadName)
+	}
+
+	if live.ArtifactRepositoryID == "" {
+		return fmt.Errorf(
+			"workload %s runs an artifact that belongs to no repository, so it has no earlier versions to roll back to",
+			workloadName)
+	}
+
+	return nil
+}
+
+// rollbackTarget is the version to promote: the one --to names, or the one
+// before current.
+func rollbackTarget(ctx context.Context, live Live, current workload.Artifact, to string) (workload.Artifact, error) {
+	if to == "" {
+		return previousVersion(ctx, live, current)
+	}
+
+	if to == current.ID {
+		return workload.Artifact{}, fmt.Errorf("workload %s is already running artifact %s", live.Name, to)
+	}
+
+	target, err := getArtifactFn(ctx, to)
+	if err != nil {
+		if isNotFound(err) {
+			return workload.Artifact{}, fmt.Errorf("artifact %s not found", to)
+		}
+
+		return workload.Artifact{}, fmt.Errorf("cannot read artifact %s: %w", to, err)
+	}
+
+	if target.ArtifactRepositoryID != live.ArtifactRepositoryID {
+		return workload.Artifact{}, fmt.Errorf(
+			"artifact %s is not a version of what workload %s runs: it belongs to another repository. "+
+				"'dr workload history %s' lists the versions it can be rolled back to",
+			to, live.Name, live.WorkloadID)
+	}
+
+	if !target.IsLocked() {
+		return workload.Artifact{}, fmt.Errorf(
+			"artifact %s is a draft, and a locked workload can only be rolled onto a locked version", to)
+	}
+
+	return *target, nil
+}
+
+// previousVersion is the newest locked version numbered below current.
+// Numbers, not dates: the platform numbers a version when it locks it, so the
+// order of the numbers is the order the versions were made permanent in.
+func previousVersion(ctx context.Context, live Live, current workload.Artifact) (workload.Artifact, error) {
+	versions, err := listVersionsFn(ctx, live.ArtifactRepositoryID, versionSearchLimit)
+	if err != nil {
+		return workload.Artifact{}, fmt.Errorf(
+			"cannot list the versions in repository %s: %w", live.ArtifactRepositoryID, err)
+	}
+
+	var previous *workload.Artifact
+
+	for i := range versions {
+		candidate := &versions[i]
+
+		if candidate.ID == current.ID || !candidate.IsLocked() || candidate.Version == nil {
+			continue
+		}
+
+		if current.Version != nil && *candidate.Version >= *current.Version {
+			continue
+		}
+
+		if previous == nil || *candidate.Version > *previous.Version {
+			previous = candidate
+		}
+	}
+
+	if previous == nil {
+		return workload.Artifact{}, fmt.Errorf(
+			"workload %s is running the oldest locked version in its repository, so there is nothing to roll back to",
+			live.Name)
+	}
+
+	return *previous, nil
+}
+
+// confirmRollback puts the same question confirmRoll does, for the same
+// reason: a locked workload is production. A run that cannot ask is refused
+// rather than waved through.
+func confirmRollback(workloadName string, from, to workload.Artifact, opts Options) error {
+	if opts.Confirm == nil {
+		if opts.NonInteractive {
+			return nil
+		}
+
+		return errors.New(
+			"this rolls back a locked, production version and there is no terminal to confirm on. " +
+				"Re-run with --yes to say so explicitly")
+	}
+
+	agreed, err := opts.Confirm(fmt.Sprintf(
+		"Workload %s is running a locked version, which means production.\n"+
+			"It will be rolled back from %s to %s.\n"+
+			"Type the workload name to roll it back, anything else to stop: ",
+		workloadName, describeVersion(from), describeVersion(to)), workloadName)
+	if err != nil {
+		return err
+	}
+
+	if !agreed {
+		return fmt.Errorf("cancelled: workload %s is still running the version it was", workloadName)
+	}
+
+	return nil
+}
+
+// describeVersion names a version the way a person reading the history would
+// look for it: by number where it has one, always with the artifact id.
+func describeVersion(artifact workload.Artifact) string {
+	if artifact.Version == nil {
+		return "artifact " + artifact.ID
+	}
+
+	return "version " + strconv.Itoa(*artifact.Version) + " (" + artifact.ID + ")"
+}
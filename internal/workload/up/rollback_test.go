@@ -0,0 +1,303 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package up
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/datarobot/cli/internal/workload"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const liveWorkloadID = "68b0c1d2e3f4a5b6c7d8e9f0"
+
+// The running version in these fixtures is version 3 of liveRepositoryID.
+// Version 2 is the one before it, version 1 the one before that, and the
+// draft is what a roll in progress left behind.
+func lineage() []workload.Artifact {
+	at := func(day int) time.Time { return time.Date(2026, 10, day, 12, 0, 0, 0, time.UTC) }
+
+	return []workload.Artifact{
+		numbered("68a0000000000000000000a0", 1, at(1)),
+		numbered("68a0000000000000000000a1", 3, at(3)),
+		numbered("68a0000000000000000000b2", 2, at(2)),
+		{
+			ID: "68a0000000000000000000d4", Status: workload.ArtifactStatusDraft,
+			ArtifactRepositoryID: liveRepositoryID, CreatedAt: at(4), UpdatedAt: at(4),
+		},
+	}
+}
+
+func numbered(id string, number int, created time.Time) workload.Artifact {
+	return workload.Artifact{
+		ID: id, Status: workload.ArtifactStatusLocked, ArtifactRepositoryID: liveRepositoryID,
+		Version: &number, CreatedAt: created, UpdatedAt: created.Add(time.Hour),
+	}
+}
+
+// versionOf finds id in lineage, for the artifact read.
+func versionOf(t *testing.T, id string) *workload.Artifact {
+	t.Helper()
+
+	for _, artifact := range lineage() {
+		if artifact.ID == id {
+			return &artifact
+		}
+	}
+
+	t.Fatalf("artifact %s is not in the lineage", id)
+
+	return nil
+}
+
+// lockedInRepository is production running version 3, with every read of the
+// repository answered from lineage.
+func lockedInRepository(t *testing.T, tr *track) fakes {
+	t.Helper()
+
+	f := inRepository(lockedLive(wiredRoll(tr)))
+
+	f.getArtifact = func(_ context.Context, id string) (*workload.Artifact, error) {
+		return versionOf(t, id), nil
+	}
+	f.versions = func(_ context.Context, repositoryID string, _ int) ([]workload.Artifact, error) {
+		assert.Equal(t, liveRepositoryID, repositoryID)
+
+		return lineage(), nil
+	}
+	f.lock = func(_ context.Context, id string) (*workload.Artifact, error) {
+		t.Fatalf("a rollback locked %s; the version it promotes is locked already", id)
+
+		return nil, nil
+	}
+	f.builds = func(context.Context, string, int) ([]workload.Build, error) { return nil, nil }
+
+	return f
+}
+
+func rollback(t *testing.T, to string, opts Options) (Result, string, error) {
+	t.Helper()
+
+	var stderr bytes.Buffer
+
+	opts.Stderr = &stderr
+
+	result, err := Rollback(context.Background(), liveWorkloadID, to, opts)
+
+	return result, stderr.String(), err
+}
+
+func TestRollback_GoesBackToTheVersionBeforeTheOneServing(t *testing.T) {
+	var tr track
+
+	install(t, lockedInRepository(t, &tr))
+
+	result, stderr, err := rollback(t, "", Options{NonInteractive: true})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"guard", "guard", "replace:68a0000000000000000000b2", "await-rollout", "settle"}, tr.steps,
+		"nothing is built, minted or locked on the way back")
+	assert.Equal(t, ActionRolledBack, result.Action)
+	assert.True(t, result.Locked)
+	assert.Contains(t, stderr, "from version 3 (68a0000000000000000000a1) to version 2 (68a0000000000000000000b2)")
+}
+
+func TestRollback_ToNamesTheVersion(t *testing.T) {
+	var tr track
+
+	install(t, lockedInRepository(t, &tr))
+
+	_, _, err := rollback(t, "68a0000000000000000000a0", Options{NonInteractive: true})
+	require.NoError(t, err)
+
+	assert.Contains(t, tr.steps, "replace:68a0000000000000000000a0")
+}
+
+func TestRollback_RefusesTargetsThatAreNotEarlierLockedVersions(t *testing.T) {
+	stranger := workload.Artifact{
+		ID: "68a0000000000000000000e5", Status: workload.ArtifactStatusLocked, ArtifactRepositoryID: "other",
+	}
+
+	cases := []struct {
+		name string
+		to   string
+		want string
+	}{
+		{"the one serving", "68a0000000000000000000a1", "already running"},
+		{"a draft", "68a0000000000000000000d4", "is a draft"},
+		{"another lineage", stranger.ID, "another repository"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var tr track
+
+			f := lockedInRepository(t, &tr)
+			f.getArtifact = func(_ context.Context, id string) (*workload.Artifact, error) {
+				if id == stranger.ID {
+					return &stranger, nil
+				}
+
+				return versionOf(t, id), nil
+			}
+
+			install(t, f)
+
+			_, _, err := rollback(t, c.to, Options{NonInteractive: true})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), c.want)
+			assert.NotContains(t, tr.steps, "replace:"+c.to)
+		})
+	}
+}
+
+func TestRollback_NothingOlderToGoBackTo(t *testing.T) {
+	var tr track
+
+	f := lockedInRepository(t, &tr)
+	f.versions = func(context.Context, string, int) ([]workload.Artifact, error) {
+		return lineage()[1:2], nil
+	}
+
+	install(t, f)
+
+	_, _, err := rollback(t, "", Options{NonInteractive: true})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "oldest locked version")
+}
+
+// A draft keeps no record of what it held when it served, so there is no
+// version to return to and the platform would refuse the swap anyway.
+func TestRollback_RefusesADraftWorkload(t *testing.T) {
+	var tr track
+
+	f := inRepository(wiredRoll(&tr))
+	install(t, f)
+
+	_, _, err := rollback(t, "", Options{NonInteractive: true})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "running a draft")
+	assert.Empty(t, tr.steps)
+}
+
+func TestRollback_AsksBeforeRollingProductionBack(t *testing.T) {
+	var tr track
+
+	install(t, lockedInRepository(t, &tr))
+
+	var asked string
+
+	_, _, err := rollback(t, "", Options{Confirm: func(question, want string) (bool, error) {
+		asked = question
+
+		assert.Equal(t, "my-app", want)
+
+		return false, nil
+	}})
+	require.Error(t, err)
+
+	assert.Contains(t, err.Error(), "cancelled")
+	assert.Contains(t, asked, "to version 2")
+	assert.Equal(t, []string{"guard"}, tr.steps, "a refused rollback swaps nothing")
+}
+
+func TestRollback_RefusesWithNoTerminalAndNoYes(t *testing.T) {
+	var tr track
+
+	install(t, lockedInRepository(t, &tr))
+
+	_, _, err := rollback(t, "", Options{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--yes")
+}
+
+func TestRollback_InFlightReplacementStopsItFirst(t *testing.T) {
+	var tr track
+
+	f := lockedInRepository(t, &tr)
+	f.guard = func(context.Context, string) error { return errors.New("a replacement is already in progress") }
+	install(t, f)
+
+	_, _, err := rollback(t, "", Options{NonInteractive: true})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "already in progress")
+}
+
+func TestHistory_ListsTheRepositoryNewestFirst(t *testing.T) {
+	var tr track
+
+	f := lockedInRepository(t, &tr)
+	f.builds = func(_ context.Context, id string, _ int) ([]workload.Build, error) {
+		if id != "68a0000000000000000000b2" {
+			return nil, nil
+		}
+
+		return []workload.Build{
+			{ID: "bld-failed", Status: "FAILED", CreatedAt: time.Date(2026, 10, 2, 13, 0, 0, 0, time.UTC)},
+			{ID: "bld-ok", Status: workload.BuildStatusCompleted, CreatedAt: time.Date(2026, 10, 2, 12, 0, 0, 0, time.UTC)},
+		}, nil
+	}
+
+	install(t, f)
+
+	_, entries, err := History(context.Background(), liveWorkloadID, 20)
+	require.NoError(t, err)
+
+	ids := make([]string, 0, len(entries))
+	for _, e := range entries {
+		ids = append(ids, e.Artifact.ID)
+	}
+
+	assert.Equal(t, []string{
+		"68a0000000000000000000d4", "68a0000000000000000000a1", "68a0000000000000000000b2", "68a0000000000000000000a0",
+	}, ids)
+
+	assert.True(t, entries[1].Serving)
+	assert.False(t, entries[2].Serving)
+	assert.Nil(t, entries[0].LockedAt(), "a draft was never locked")
+	require.NotNil(t, entries[1].LockedAt())
+	require.NotNil(t, entries[2].Build)
+	assert.Equal(t, "bld-ok", entries[2].Build.ID, "the build behind the image is the one that completed")
+	assert.Nil(t, entries[1].Build, "a published image was never built")
+}
+
+// Before repositories, a workload's artifact stood alone. Its history is that
+// one version, not an error.
+func TestHistory_WithoutARepositoryIsTheRunningVersion(t *testing.T) {
+	var tr track
+
+	f := lockedLive(wiredRoll(&tr))
+	f.getArtifact = func(_ context.Context, id string) (*workload.Artifact, error) {
+		return &workload.Artifact{ID: id, Status: workload.ArtifactStatusLocked}, nil
+	}
+	f.versions = func(context.Context, string, int) ([]workload.Artifact, error) {
+		t.Fatal("there is no repository to list")
+
+		return nil, nil
+	}
+	f.builds = func(context.Context, string, int) ([]workload.Build, error) { return nil, nil }
+
+	install(t, f)
+
+	_, entries, err := History(context.Background(), liveWorkloadID, 20)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.True(t, entries[0].Serving)
+}
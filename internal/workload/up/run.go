@@ -15,6 +15,7 @@
 package up
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -66,6 +67,10 @@ type Options struct {
 	// upward from here.
 	Dir string
 
+	// Environment selects the overlay merged onto the manifest, and with it
+	// the workload that overlay is bound to. "" deploys the manifest alone.
+	Environment string
+
 	// NonInteractive forbids prompting. With no manifest it turns the setup
 	// wizard into an error naming the command that writes one.
 	NonInteractive bool
@@ -133,23 +138,23 @@ type Result struct {
 }
 
 // Run reads, plans, and applies as much of the plan as this release can.
-func Run(opts Options) (Result, error) {
+func Run(ctx context.Context, opts Options) (Result, error) {
 	dir, err := filepath.Abs(opts.Dir)
 	if err != nil {
 		return Result{}, fmt.Errorf("cannot resolve %s: %w", opts.Dir, err)
 	}
 
-	loaded, err := load(dir, opts)
+	loaded, err := load(ctx, dir, opts)
 	if err != nil {
 		return Result{}, err
 	}
 
-	live, err := Look(loaded.WorkloadID())
+	live, err := Look(ctx, loaded.WorkloadID())
 	if err != nil {
 		return Result{}, err
 	}
 
-	code, err := codeChangeFn(loaded, live)
+	code, err := codeChangeFn(ctx, loaded, live)
 	if err != nil {
 		return Result{}, err
 	}
@@ -180,7 +185,7 @@ func Run(opts Options) (Result, error) {
 		return result, nil
 	}
 
-	return apply(loaded, live, plan, result, opts)
+	return apply(ctx, loaded, live, plan, result, opts)
 }
 
 // noteUnusedForce reports a --force-build that is about to do nothing,
@@ -212,8 +217,8 @@ func noteUnusedForce(plan Plan, opts Options) {
 // person is there to answer it. In CI there is nobody, so a missing manifest
 // is an error that names the command which writes one: deploying by guessing
 // is the one thing this command must never do.
-func load(dir string, opts Options) (Loaded, error) {
-	loaded, err := Load(dir)
+func load(ctx context.Context, dir string, opts Options) (Loaded, error) {
+	loaded, err := Load(dir, opts.Environment)
 	if err == nil || !errors.Is(err, ErrNoManifest) {
 		return loaded, err
 	}
@@ -223,11 +228,11 @@ func load(dir string, opts Options) (Loaded, error) {
 			"%w. Run 'dr workload config' to create one, then deploy", err)
 	}
 
-	if _, err := runWizardFn(wizard.Options{Dir: dir, Stderr: opts.Stderr}); err != nil {
+	if _, err := runWizardFn(ctx, wizard.Options{Dir: dir, Stderr: opts.Stderr}); err != nil {
 		return Loaded{}, err
 	}
 
-	return Load(dir)
+	return Load(dir, opts.Environment)
 }
 
 // name is what to call this workload: the live one's name once it exists, the
@@ -241,8 +246,8 @@ func name(loaded Loaded, live Live) string {
 }
 
 // apply carries out the plan, or explains why it cannot.
-func apply(loaded Loaded, live Live, plan Plan, result Result, opts Options) (Result, error) {
-	if err := deployable(live, result.Name); err != nil {
+func apply(ctx context.Context, loaded Loaded, live Live, plan Plan, result Result, opts Options) (Result, error) {
+	if err := deployable(live, result.Name, loaded.bindingFile()); err != nil {
 		return result, err
 	}
 
@@ -255,11 +260,11 @@ func apply(loaded Loaded, live Live, plan Plan, result Result, opts Options) (Re
 		// A start is when the container resolves its references, so a
 		// credential deleted while the workload was off would otherwise fail
 		// minutes later as a container that will not come up.
-		if err := verifyCredentials(loaded.Compiled.CredentialRefs, result.Name); err != nil {
+		if err := verifyCredentials(ctx, loaded.Compiled.CredentialRefs, result.Name); err != nil {
 			return result, err
 		}
 
-		return start(live, result, opts)
+		return start(ctx, live, result, opts)
 	}
 
 	// Everything below this changes a workload that is running: a rollout
@@ -279,12 +284,12 @@ func apply(loaded Loaded, live Live, plan Plan, result Result, opts Options) (Re
 	// every variable it already has, so a credential this run does not touch
 	// must not be able to refuse a resize.
 	if !plan.Creates && !plan.RollsArtifact() {
-		return retune(loaded, result, opts, newReporter(opts.Stderr, opts.Spinner))
+		return retune(ctx, loaded, result, opts, newReporter(opts.Stderr, opts.Spinner))
 	}
 
 	// Last check before the first mutation, and the only one that needs the
 	// network: a credential id is the one thing the local ledger cannot judge.
-	if err := verifyCredentials(loaded.Compiled.CredentialRefs, result.Name); err != nil {
+	if err := verifyCredentials(ctx, loaded.Compiled.CredentialRefs, result.Name); err != nil {
 		return result, err
 	}
 
@@ -293,17 +298,17 @@ func apply(loaded Loaded, live Live, plan Plan, result Result, opts Options) (Re
 	// A workload that already exists is replaced rather than created: the
 	// endpoint has to survive, and something is serving on it meanwhile.
 	if plan.RollsArtifact() {
-		return roll(loaded, live, plan, result, opts, report)
+		return roll(ctx, loaded, live, plan, result, opts, report)
 	}
 
 	// A published image is one POST. Anything the platform builds has to be
 	// given somewhere to put the code and time to turn it into an image
 	// first, which is a different shape of deploy rather than a longer one.
 	if plan.Code.Applies {
-		return buildAndCreate(loaded, plan.Code, result, opts, report)
+		return buildAndCreate(ctx, loaded, plan.Code, result, opts, report)
 	}
 
-	return create(loaded, loaded.Compiled.Payload, result, opts, report)
+	return create(ctx, loaded, loaded.Compiled.Payload, result, opts, report)
 }
 
 // deployable refuses the live states that cannot take a deploy, one message
@@ -313,14 +318,14 @@ func apply(loaded Loaded, live Live, plan Plan, result Result, opts Options) (Re
 // Stopped is not among them. A stopped workload is one POST away from being
 // deployable, and `up` means "make the file true", which for something that
 // is not running means starting it.
-func deployable(live Live, workloadName string) error {
+func deployable(live Live, workloadName, bindingFile string) error {
 	switch live.State {
 	case StateMissing, StateTerminated:
 		return fmt.Errorf(
 			"the workload this manifest is bound to is %s. "+
 				"Bind to a live one with 'dr workload config --workload-id <id>', or remove workloadId from %s "+
 				"to create a new workload on the next deploy",
-			live.State, manifest.FileName)
+			live.State, bindingFile)
 
 	case StateErrored:
 		return fmt.Errorf(
@@ -368,6 +373,7 @@ func startable(live Live, workloadName string) error {
 // else can fail, so a run that dies during the wait still leaves the next one
 // able to find what it made.
 func create(
+	ctx context.Context,
 	loaded Loaded,
 	payload json.RawMessage,
 	result Result,
@@ -377,9 +383,9 @@ func create(
 	var created *workload.Workload
 
 	err := report.run("Creating workload", func() error {
-		wl, createErr := createWorkloadFn(payload)
+		wl, createErr := createWorkloadFn(ctx, payload)
 		if createErr != nil {
-			return nameTaken(createErr, result.Name, loaded.Path)
+			return nameTaken(ctx, createErr, result.Name, loaded.BindingPath)
 		}
 
 		created = wl
@@ -396,11 +402,14 @@ func create(
 	result.Status = created.Status
 	result.Action = ActionCreated
 
-	if err := writeWorkloadIDFn(loaded.Path, created.ID); err != nil {
+	// An environment's binding goes to its overlay, never the base file: the
+	// base is shared by every environment, and a binding there would be
+	// inherited by none of them and overwritten by each.
+	if err := writeWorkloadIDFn(loaded.BindingPath, created.ID); err != nil {
 		return result, fmt.Errorf(
 			"workload %s was created but its id could not be written to %s. "+
 				"Add 'workloadId: %s' by hand, or the next deploy will create a second workload: %w",
-			created.ID, loaded.Path, created.ID, err)
+			created.ID, loaded.BindingPath, created.ID, err)
 	}
 
 	if opts.Detach {
@@ -409,7 +418,7 @@ func create(
 		return result, nil
 	}
 
-	return settle(created.ID, result, opts, report)
+	return settle(ctx, created.ID, result, opts, report)
 }
 
 // start brings a stopped workload back up. It is the whole apply for a plan
@@ -420,13 +429,13 @@ func create(
 // Nothing is written back and nothing is compiled here, because nothing is
 // being changed. The workload keeps the artifact it was stopped on, which is
 // the version this run just confirmed the file still describes.
-func start(live Live, result Result, opts Options) (Result, error) {
+func start(ctx context.Context, live Live, result Result, opts Options) (Result, error) {
 	report := newReporter(opts.Stderr, opts.Spinner)
 
 	var ack *workload.WorkloadOperationResponse
 
 	err := report.run("Starting workload", func() error {
-		response, startErr := startWorkloadFn(result.WorkloadID)
+		response, startErr := startWorkloadFn(ctx, result.WorkloadID)
 		ack = response
 
 		return startErr
@@ -453,7 +462,7 @@ func start(live Live, result Result, opts Options) (Result, error) {
 		return result, nil
 	}
 
-	return settle(result.WorkloadID, result, opts, report)
+	return settle(ctx, result.WorkloadID, result, opts, report)
 }
 
 // startingStatus is what to report for a start nobody waited for. An
@@ -485,12 +494,12 @@ func startingStatus(was string) string {
 //
 // So the run stops and hands the choice back, which costs one line in the
 // manifest in the case where adopting would have been right.
-func nameTaken(createErr error, workloadName, path string) error {
+func nameTaken(ctx context.Context, createErr error, workloadName, path string) error {
 	if !isConflict(createErr) || workloadName == "" {
 		return createErr
 	}
 
-	existing, err := listWorkloadsFn(conflictSearchLimit, nil, "")
+	existing, err := listWorkloadsFn(ctx, conflictSearchLimit, nil, "")
 	if err != nil {
 		// The conflict is the real story; a failure to look up what caused it
 		// is a footnote that should not replace it.
@@ -525,11 +534,11 @@ func isConflict(err error) bool {
 }
 
 // settle waits for the workload to come up and records where it landed.
-func settle(workloadID string, result Result, opts Options, report *reporter) (Result, error) {
+func settle(ctx context.Context, workloadID string, result Result, opts Options, report *reporter) (Result, error) {
 	var final *workload.Workload
 
 	err := report.run("Waiting for the workload to run", func() error {
-		wl, waitErr := waitWorkloadFn(workloadID, opts.PollInterval, opts.PollTimeout, nil)
+		wl, waitErr := waitWorkloadFn(ctx, workloadID, opts.PollInterval, opts.PollTimeout, nil)
 		final = wl
 
 		return waitErr
@@ -554,14 +563,14 @@ func settle(workloadID string, result Result, opts Options, report *reporter) (R
 		return result, nil
 	}
 
-	return lock(result, report)
+	return lock(ctx, result, report)
 }
 
 // lock makes the live artifact permanent. It runs only after the workload is
 // serving, because locking is one-way: locking an artifact that never came up
 // would leave something undeletable behind and a workload that cannot be
 // rolled off it.
-func lock(result Result, report *reporter) (Result, error) {
+func lock(ctx context.Context, result Result, report *reporter) (Result, error) {
 	// Already locked, either by a roll onto locked production or before the
 	// workload was stopped. Locking twice is not a no-op at the platform.
 	if result.Locked {
@@ -573,7 +582,7 @@ func lock(result Result, report *reporter) (Result, error) {
 	}
 
 	err := report.run("Locking the artifact", func() error {
-		_, lockErr := lockArtifactFn(result.ArtifactID)
+		_, lockErr := lockArtifactFn(ctx, result.ArtifactID)
 
 		return lockErr
 	})
@@ -593,7 +602,7 @@ func lock(result Result, report *reporter) (Result, error) {
 // The engine acquires the project lock between planning and executing, so
 // this closes it immediately: nothing here is going to sync, and holding the
 // lock across a build would block the very command that comes next.
-func defaultCodeChange(loaded Loaded, _ Live) (change CodeChange, err error) {
+func defaultCodeChange(ctx context.Context, loaded Loaded, _ Live) (change CodeChange, err error) {
 	mode := loaded.Manifest.BuildMode()
 	if mode != manifest.BuildModeDockerfile && mode != manifest.BuildModeGenerated {
 		// A published image has no code to sync, and a file bound by artifact
@@ -620,7 +629,7 @@ func defaultCodeChange(loaded Loaded, _ Live) (change CodeChange, err error) {
 		}
 	}()
 
-	plan, err := engine.Plan()
+	plan, err := engine.Plan(ctx)
 	if err != nil {
 		return CodeChange{}, fmt.Errorf("cannot compare the working tree with the last deploy: %w", err)
 	}
@@ -16,6 +16,7 @@ package up
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -29,6 +30,7 @@ import (
 
 	"github.com/datarobot/cli/internal/drapi"
 	"github.com/datarobot/cli/internal/workload"
+	"github.com/datarobot/cli/internal/workload/manifest"
 	"github.com/datarobot/cli/internal/workload/sync"
 	"github.com/datarobot/cli/internal/workload/wapi"
 	"github.com/datarobot/cli/internal/workload/wizard"
@@ -133,41 +135,44 @@ runtime:
 // reach the network by omission and a test only wires what it means to
 // exercise.
 type fakes struct {
-	wizard         func(wizard.Options) (wizard.Result, error)
-	create         func(any) (*workload.Workload, error)
-	wait           func(string, time.Duration, time.Duration, func(*workload.Workload)) (*workload.Workload, error)
-	list           func(int, []string, string) ([]workload.Workload, error)
-	start          func(string) (*workload.WorkloadOperationResponse, error)
-	lock           func(string) (*workload.Artifact, error)
-	cred           func(string) (*workload.Credential, error)
-	findCredential func(string, int) (*workload.Credential, error)
+	wizard         func(context.Context, wizard.Options) (wizard.Result, error)
+	create         func(context.Context, any) (*workload.Workload, error)
+	wait           func(context.Context, string, time.Duration, time.Duration, func(*workload.Workload)) (*workload.Workload, error)
+	list           func(context.Context, int, []string, string) ([]workload.Workload, error)
+	start          func(context.Context, string) (*workload.WorkloadOperationResponse, error)
+	lock           func(context.Context, string) (*workload.Artifact, error)
+	cred           func(context.Context, string) (*workload.Credential, error)
+	findCredential func(context.Context, string, int) (*workload.Credential, error)
 	writeID        func(string, string) error
-	code           func(Loaded, Live) (CodeChange, error)
-	workloadD      func(string) (workload.Document, error)
-	artifactD      func(string) (workload.Document, error)
+	code           func(context.Context, Loaded, Live) (CodeChange, error)
+	workloadD      func(context.Context, string) (workload.Document, error)
+	artifactD      func(context.Context, string) (workload.Document, error)
 
 	// The build track: create an artifact, link the project to it, push the
 	// code, turn it into an image.
-	newArtifact func(any) (*workload.Artifact, error)
-	getArtifact func(string) (*workload.Artifact, error)
+	newArtifact func(context.Context, any) (*workload.Artifact, error)
+	getArtifact func(context.Context, string) (*workload.Artifact, error)
 	linked      func(string) bool
 	project     func(string) (wapi.Config, error)
 	link        func(string, wapi.InitOptions) error
 	save        func(string, wapi.Config) error
-	codeRef     func(string, string, string) error
-	sync        func(string) (*sync.Result, error)
-	build       func(string) (*workload.BuildTriggerResponse, error)
-	waitBuild   func(string, string, time.Duration, time.Duration, func(*workload.Build)) (*workload.Build, error)
-	builds      func(string, int) ([]workload.Build, error)
+	codeRef     func(context.Context, string, string, string) error
+	sync        func(context.Context, string) (*sync.Result, error)
+	build       func(context.Context, string) (*workload.BuildTriggerResponse, error)
+	waitBuild   func(context.Context, string, string, time.Duration, time.Duration, func(*workload.Build)) (*workload.Build, error)
+	builds      func(context.Context, string, int) ([]workload.Build, error)
 
 	// The roll track: refuse to queue a second swap, start one, follow it.
-	guard       func(string) error
-	replace     func(string, string, json.RawMessage) (*workload.Replacement, error)
-	waitReplace func(string, *workload.Replacement, time.Duration, time.Duration,
+	guard       func(context.Context, string) error
+	replace     func(context.Context, string, string, json.RawMessage) (*workload.Replacement, error)
+	waitReplace func(context.Context, string, *workload.Replacement, time.Duration, time.Duration,
 		func(*workload.Replacement)) (*workload.Replacement, error)
 
 	// settings is the in-place path: a change that moved only the sizing.
-	settings func(string, json.RawMessage) (*workload.Replacement, error)
+	settings func(context.Context, string, json.RawMessage) (*workload.Replacement, error)
+
+	// versions is the repository read behind history and rollback.
+	versions func(context.Context, string, int) ([]workload.Artifact, error)
 }
 
 // install swaps in the seams the test supplied and restores them afterwards.
@@ -178,12 +183,12 @@ func install(t *testing.T, f fakes) {
 	// test that cares about neither the write-back nor the working tree
 	// should not have to say so, and neither may touch a real project.
 	force(t, &writeWorkloadIDFn, func(string, string) error { return nil })
-	force(t, &codeChangeFn, func(Loaded, Live) (CodeChange, error) { return CodeChange{}, nil })
+	force(t, &codeChangeFn, func(context.Context, Loaded, Live) (CodeChange, error) { return CodeChange{}, nil })
 
 	// swap leaves a seam alone when the test supplied nothing, so without this
 	// a stopped fixture with no start fake POSTs to whatever tenant the
 	// developer is logged into.
-	force(t, &startWorkloadFn, func(id string) (*workload.WorkloadOperationResponse, error) {
+	force(t, &startWorkloadFn, func(_ context.Context, id string) (*workload.WorkloadOperationResponse, error) {
 		t.Fatalf("the run started workload %s, which this test did not wire", id)
 
 		return nil, nil
@@ -193,7 +198,7 @@ func install(t *testing.T, f fakes) {
 	// would list credentials from whatever tenant the developer is logged
 	// into; answering "no such credential" is the quiet default every test
 	// that does not care about the lookup wants.
-	force(t, &findCredentialFn, func(string, int) (*workload.Credential, error) { return nil, nil })
+	force(t, &findCredentialFn, func(context.Context, string, int) (*workload.Credential, error) { return nil, nil })
 	swap(t, &findCredentialFn, f.findCredential)
 
 	swap(t, &runWizardFn, f.wizard)
@@ -216,7 +221,7 @@ func install(t *testing.T, f fakes) {
 	// Unlike the seams below it, this one is reached by the lock path as well
 	// as the build track, so leaving it unset would send a test that gets
 	// there to whatever tenant the developer is logged into.
-	force(t, &getArtifactFn, func(id string) (*workload.Artifact, error) {
+	force(t, &getArtifactFn, func(_ context.Context, id string) (*workload.Artifact, error) {
 		t.Fatalf("the run read artifact %s, which this test did not wire", id)
 
 		return nil, nil
@@ -236,11 +241,12 @@ func install(t *testing.T, f fakes) {
 
 	// Nothing stands in the way of a rollout unless a test says so, because
 	// the quiet answer is the one every other roll test wants.
-	force(t, &guardReplacementFn, func(string) error { return nil })
+	force(t, &guardReplacementFn, func(context.Context, string) error { return nil })
 	swap(t, &guardReplacementFn, f.guard)
 	swap(t, &startReplacementFn, f.replace)
 	swap(t, &waitReplacementFn, f.waitReplace)
 	swap(t, &updateSettingsFn, f.settings)
+	swap(t, &listVersionsFn, f.versions)
 }
 
 // swap installs fake over the seam at target, and does nothing when the test
@@ -286,7 +292,7 @@ func runIn(t *testing.T, content string, opts Options) (Result, string, error) {
 	opts.Dir = dir
 	opts.Stderr = &stderr
 
-	result, err := Run(opts)
+	result, err := Run(context.Background(), opts)
 
 	return result, stderr.String(), err
 }
@@ -302,7 +308,7 @@ func running(id string) *workload.Workload {
 // job from deploying a workload nobody described.
 func TestRun_NoManifestWithoutATerminalNamesTheFix(t *testing.T) {
 	install(t, fakes{
-		wizard: func(wizard.Options) (wizard.Result, error) {
+		wizard: func(context.Context, wizard.Options) (wizard.Result, error) {
 			t.Fatal("the wizard must not run without a terminal")
 
 			return wizard.Result{}, nil
@@ -311,7 +317,7 @@ func TestRun_NoManifestWithoutATerminalNamesTheFix(t *testing.T) {
 
 	var stderr bytes.Buffer
 
-	_, err := Run(Options{Dir: t.TempDir(), NonInteractive: true, Stderr: &stderr})
+	_, err := Run(context.Background(), Options{Dir: t.TempDir(), NonInteractive: true, Stderr: &stderr})
 	require.Error(t, err)
 	require.ErrorIs(t, err, ErrNoManifest)
 	assert.Contains(t, err.Error(), "dr workload config")
@@ -325,22 +331,22 @@ func TestRun_NoManifestOnATerminalRunsTheWizard(t *testing.T) {
 	var asked bool
 
 	install(t, fakes{
-		wizard: func(opts wizard.Options) (wizard.Result, error) {
+		wizard: func(_ context.Context, opts wizard.Options) (wizard.Result, error) {
 			asked = true
 
 			writeManifest(t, dir, unboundImageManifest)
 
 			return wizard.Result{Path: opts.Dir}, nil
 		},
-		create: func(any) (*workload.Workload, error) { return running("wl-new"), nil },
-		wait: func(string, time.Duration, time.Duration, func(*workload.Workload)) (*workload.Workload, error) {
+		create: func(context.Context, any) (*workload.Workload, error) { return running("wl-new"), nil },
+		wait: func(context.Context, string, time.Duration, time.Duration, func(*workload.Workload)) (*workload.Workload, error) {
 			return running("wl-new"), nil
 		},
 	})
 
 	var stderr bytes.Buffer
 
-	result, err := Run(Options{Dir: dir, Stderr: &stderr})
+	result, err := Run(context.Background(), Options{Dir: dir, Stderr: &stderr})
 	require.NoError(t, err)
 	assert.True(t, asked)
 	assert.Equal(t, "wl-new", result.WorkloadID)
@@ -348,7 +354,7 @@ func TestRun_NoManifestOnATerminalRunsTheWizard(t *testing.T) {
 
 func TestRun_DryRunAppliesNothing(t *testing.T) {
 	install(t, fakes{
-		create: func(any) (*workload.Workload, error) {
+		create: func(context.Context, any) (*workload.Workload, error) {
 			t.Fatal("a dry run must not create anything")
 
 			return nil, nil
@@ -367,9 +373,9 @@ func TestRun_DryRunAppliesNothing(t *testing.T) {
 // makes `up` cheap to run on every push.
 func TestRun_AlreadyUpToDate(t *testing.T) {
 	install(t, fakes{
-		workloadD: func(string) (workload.Document, error) { return doc(t, liveWorkloadJSON), nil },
-		artifactD: func(string) (workload.Document, error) { return doc(t, liveArtifactJSON), nil },
-		create: func(any) (*workload.Workload, error) {
+		workloadD: func(context.Context, string) (workload.Document, error) { return doc(t, liveWorkloadJSON), nil },
+		artifactD: func(context.Context, string) (workload.Document, error) { return doc(t, liveArtifactJSON), nil },
+		create: func(context.Context, any) (*workload.Workload, error) {
 			t.Fatal("nothing changed, so nothing should be created")
 
 			return nil, nil
@@ -417,7 +423,7 @@ func TestRun_CreatesFromAPublishedImage(t *testing.T) {
 	)
 
 	install(t, fakes{
-		create: func(p any) (*workload.Workload, error) {
+		create: func(_ context.Context, p any) (*workload.Workload, error) {
 			payload = p
 
 			return running("wl-new"), nil
@@ -427,7 +433,7 @@ func TestRun_CreatesFromAPublishedImage(t *testing.T) {
 
 			return nil
 		},
-		wait: func(string, time.Duration, time.Duration, func(*workload.Workload)) (*workload.Workload, error) {
+		wait: func(context.Context, string, time.Duration, time.Duration, func(*workload.Workload)) (*workload.Workload, error) {
 			return running("wl-new"), nil
 		},
 	})
@@ -445,11 +451,50 @@ func TestRun_CreatesFromAPublishedImage(t *testing.T) {
 	assert.Contains(t, stderr, "✓ Creating workload")
 }
 
+// TestRun_EnvironmentCreatesItsOwnWorkload is the overlay's binding rule end
+// to end: the base is bound, the environment is not, so the environment gets
+// a workload of its own and its id lands in the overlay, not the base.
+func TestRun_EnvironmentCreatesItsOwnWorkload(t *testing.T) {
+	var payload, writtenTo string
+
+	install(t, fakes{
+		create: func(_ context.Context, p any) (*workload.Workload, error) {
+			raw, _ := p.(json.RawMessage)
+			payload = string(raw)
+
+			return running("wl-staging"), nil
+		},
+		writeID: func(path, _ string) error {
+			writtenTo = path
+
+			return nil
+		},
+		wait: func(context.Context, string, time.Duration, time.Duration, func(*workload.Workload)) (*workload.Workload, error) {
+			return running("wl-staging"), nil
+		},
+	})
+
+	dir := t.TempDir()
+	path := writeManifest(t, dir, "workloadId: 68b0aaaa0000000000000001\n"+unboundImageManifest)
+	overlayPath := manifest.EnvironmentPath(path, "staging")
+	require.NoError(t, os.WriteFile(overlayPath, []byte("name: my-app-staging\n"), 0o600))
+
+	result, err := Run(context.Background(), Options{
+		Dir: dir, Environment: "staging", NonInteractive: true, Stderr: &bytes.Buffer{},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, ActionCreated, result.Action, "the base's binding is not the environment's")
+	assert.Equal(t, "wl-staging", result.WorkloadID)
+	assert.Contains(t, payload, "my-app-staging")
+	assert.Equal(t, overlayPath, writtenTo)
+}
+
 // TestRun_WriteBackFailureTellsTheUserHowToRecover is the difference between
 // a recoverable hiccup and a duplicate workload on the next deploy.
 func TestRun_WriteBackFailureTellsTheUserHowToRecover(t *testing.T) {
 	install(t, fakes{
-		create:  func(any) (*workload.Workload, error) { return running("wl-new"), nil },
+		create:  func(context.Context, any) (*workload.Workload, error) { return running("wl-new"), nil },
 		writeID: func(string, string) error { return errors.New("disk full") },
 	})
 
@@ -467,10 +512,10 @@ func TestRun_WriteBackFailureTellsTheUserHowToRecover(t *testing.T) {
 // happened as a success.
 func TestRun_ConflictNamesTheWorkloadThatOwnsTheName(t *testing.T) {
 	install(t, fakes{
-		create: func(any) (*workload.Workload, error) {
+		create: func(context.Context, any) (*workload.Workload, error) {
 			return nil, &drapi.HTTPError{StatusCode: http.StatusConflict}
 		},
-		list: func(int, []string, string) ([]workload.Workload, error) {
+		list: func(context.Context, int, []string, string) ([]workload.Workload, error) {
 			return []workload.Workload{{ID: "wl-other", Name: "someone-else"}, *running("wl-existing")}, nil
 		},
 		writeID: func(string, string) error {
@@ -478,7 +523,7 @@ func TestRun_ConflictNamesTheWorkloadThatOwnsTheName(t *testing.T) {
 
 			return nil
 		},
-		wait: func(string, time.Duration, time.Duration, func(*workload.Workload)) (*workload.Workload, error) {
+		wait: func(context.Context, string, time.Duration, time.Duration, func(*workload.Workload)) (*workload.Workload, error) {
 			t.Fatal("nothing was deployed, so there is nothing to wait for")
 
 			return nil, nil
@@ -501,10 +546,12 @@ func TestRun_ConflictNamesTheWorkloadThatOwnsTheName(t *testing.T) {
 
 func TestRun_ConflictWithNoMatchKeepsTheOriginalError(t *testing.T) {
 	install(t, fakes{
-		create: func(any) (*workload.Workload, error) {
+		create: func(context.Context, any) (*workload.Workload, error) {
 			return nil, &drapi.HTTPError{StatusCode: http.StatusConflict}
 		},
-		list: func(int, []string, string) ([]workload.Workload, error) { return nil, errors.New("list unavailable") },
+		list: func(context.Context, int, []string, string) ([]workload.Workload, error) {
+			return nil, errors.New("list unavailable")
+		},
 	})
 
 	_, _, err := runIn(t, unboundImageManifest, Options{NonInteractive: true})
@@ -518,8 +565,8 @@ func TestRun_ConflictWithNoMatchKeepsTheOriginalError(t *testing.T) {
 
 func TestRun_DetachSkipsTheWait(t *testing.T) {
 	install(t, fakes{
-		create: func(any) (*workload.Workload, error) { return running("wl-new"), nil },
-		wait: func(string, time.Duration, time.Duration, func(*workload.Workload)) (*workload.Workload, error) {
+		create: func(context.Context, any) (*workload.Workload, error) { return running("wl-new"), nil },
+		wait: func(context.Context, string, time.Duration, time.Duration, func(*workload.Workload)) (*workload.Workload, error) {
 			t.Fatal("--detach must not wait")
 
 			return nil, nil
@@ -538,13 +585,13 @@ func TestRun_LockHappensAfterTheWorkloadServes(t *testing.T) {
 	var order []string
 
 	install(t, fakes{
-		create: func(any) (*workload.Workload, error) { return running("wl-new"), nil },
-		wait: func(string, time.Duration, time.Duration, func(*workload.Workload)) (*workload.Workload, error) {
+		create: func(context.Context, any) (*workload.Workload, error) { return running("wl-new"), nil },
+		wait: func(context.Context, string, time.Duration, time.Duration, func(*workload.Workload)) (*workload.Workload, error) {
 			order = append(order, "wait")
 
 			return running("wl-new"), nil
 		},
-		lock: func(id string) (*workload.Artifact, error) {
+		lock: func(_ context.Context, id string) (*workload.Artifact, error) {
 			order = append(order, "lock:"+id)
 
 			return &workload.Artifact{ID: id}, nil
@@ -559,14 +606,14 @@ func TestRun_LockHappensAfterTheWorkloadServes(t *testing.T) {
 
 func TestRun_LockIsNotAttemptedWhenTheWorkloadFailed(t *testing.T) {
 	install(t, fakes{
-		create: func(any) (*workload.Workload, error) { return running("wl-new"), nil },
-		wait: func(string, time.Duration, time.Duration, func(*workload.Workload)) (*workload.Workload, error) {
+		create: func(context.Context, any) (*workload.Workload, error) { return running("wl-new"), nil },
+		wait: func(context.Context, string, time.Duration, time.Duration, func(*workload.Workload)) (*workload.Workload, error) {
 			errored := running("wl-new")
 			errored.Status = workload.WorkloadStatusErrored
 
 			return errored, nil
 		},
-		lock: func(string) (*workload.Artifact, error) {
+		lock: func(context.Context, string) (*workload.Artifact, error) {
 			t.Fatal("a workload that did not come up must not have its artifact locked")
 
 			return nil, nil
@@ -594,11 +641,11 @@ func TestRun_RefusesTheStatesThatCannotTakeADeploy(t *testing.T) {
 	for _, c := range cases {
 		t.Run(c.status, func(t *testing.T) {
 			install(t, fakes{
-				workloadD: func(string) (workload.Document, error) {
+				workloadD: func(context.Context, string) (workload.Document, error) {
 					return workload.Document{"id": "wl-1", "name": "my-app", "status": c.status}, nil
 				},
-				artifactD: func(string) (workload.Document, error) { return nil, nil },
-				create: func(any) (*workload.Workload, error) {
+				artifactD: func(context.Context, string) (workload.Document, error) { return nil, nil },
+				create: func(context.Context, any) (*workload.Workload, error) {
 					t.Fatal("nothing may be created for a workload in this state")
 
 					return nil, nil
@@ -616,7 +663,7 @@ func TestRun_RefusesTheStatesThatCannotTakeADeploy(t *testing.T) {
 
 func TestRun_MissingWorkloadNamesTheRebind(t *testing.T) {
 	install(t, fakes{
-		workloadD: func(string) (workload.Document, error) {
+		workloadD: func(context.Context, string) (workload.Document, error) {
 			return nil, &drapi.HTTPError{StatusCode: http.StatusNotFound}
 		},
 	})
@@ -652,10 +699,10 @@ type track struct {
 // records it. Individual tests override one seam to break one step.
 func wiredBuild(tr *track) fakes {
 	return fakes{
-		code: func(Loaded, Live) (CodeChange, error) {
+		code: func(context.Context, Loaded, Live) (CodeChange, error) {
 			return CodeChange{Applies: true, FirstDeploy: true}, nil
 		},
-		newArtifact: func(payload any) (*workload.Artifact, error) {
+		newArtifact: func(_ context.Context, payload any) (*workload.Artifact, error) {
 			tr.steps = append(tr.steps, "create-artifact")
 			tr.artifactIn = payload
 
@@ -667,26 +714,26 @@ func wiredBuild(tr *track) fakes {
 
 			return nil
 		},
-		sync: func(string) (*sync.Result, error) {
+		sync: func(context.Context, string) (*sync.Result, error) {
 			tr.steps = append(tr.steps, "sync")
 
 			return &sync.Result{UploadedCount: 3, NewVersion: "ver-1"}, nil
 		},
-		build: func(string) (*workload.BuildTriggerResponse, error) {
+		build: func(context.Context, string) (*workload.BuildTriggerResponse, error) {
 			tr.steps = append(tr.steps, "build")
 
 			return &workload.BuildTriggerResponse{BuildIDs: []string{"bld-1"}}, nil
 		},
-		waitBuild: func(_, id string, _, _ time.Duration, _ func(*workload.Build)) (*workload.Build, error) {
+		waitBuild: func(_ context.Context, _, id string, _, _ time.Duration, _ func(*workload.Build)) (*workload.Build, error) {
 			return &workload.Build{ID: id, Status: workload.BuildStatusCompleted}, nil
 		},
-		create: func(payload any) (*workload.Workload, error) {
+		create: func(_ context.Context, payload any) (*workload.Workload, error) {
 			tr.steps = append(tr.steps, "create-workload")
 			tr.workloadIn = payload
 
 			return running("wl-1"), nil
 		},
-		wait: func(id string, _, _ time.Duration, _ func(*workload.Workload)) (*workload.Workload, error) {
+		wait: func(_ context.Context, id string, _, _ time.Duration, _ func(*workload.Workload)) (*workload.Workload, error) {
 			return running(id), nil
 		},
 	}
@@ -744,10 +791,10 @@ func TestRun_LinkedProjectReusesItsArtifact(t *testing.T) {
 	f := wiredBuild(&tr)
 	f.linked = func(string) bool { return true }
 	f.project = func(string) (wapi.Config, error) { return wapi.Config{ArtifactID: "art-existing"}, nil }
-	f.getArtifact = func(id string) (*workload.Artifact, error) {
+	f.getArtifact = func(_ context.Context, id string) (*workload.Artifact, error) {
 		return &workload.Artifact{ID: id, Status: workload.ArtifactStatusDraft}, nil
 	}
-	f.newArtifact = func(any) (*workload.Artifact, error) {
+	f.newArtifact = func(context.Context, any) (*workload.Artifact, error) {
 		t.Fatal("the project is already linked; a second artifact would orphan the first")
 
 		return nil, nil
@@ -772,13 +819,13 @@ func TestRun_ConflictOnAReusedArtifactExplainsTheLink(t *testing.T) {
 	f := wiredBuild(&tr)
 	f.linked = func(string) bool { return true }
 	f.project = func(string) (wapi.Config, error) { return wapi.Config{ArtifactID: "art-existing"}, nil }
-	f.getArtifact = func(id string) (*workload.Artifact, error) {
+	f.getArtifact = func(_ context.Context, id string) (*workload.Artifact, error) {
 		return &workload.Artifact{ID: id, Status: workload.ArtifactStatusDraft}, nil
 	}
-	f.create = func(any) (*workload.Workload, error) {
+	f.create = func(context.Context, any) (*workload.Workload, error) {
 		return nil, &drapi.HTTPError{StatusCode: http.StatusConflict}
 	}
-	f.list = func(int, []string, string) ([]workload.Workload, error) {
+	f.list = func(context.Context, int, []string, string) ([]workload.Workload, error) {
 		return []workload.Workload{{ID: "wl-owner", Name: "someone-else", ArtifactID: "art-existing"}}, nil
 	}
 
@@ -803,14 +850,14 @@ func TestRun_NameConflictOnALinkedProjectKeepsItsOwnMessage(t *testing.T) {
 	f := wiredBuild(&tr)
 	f.linked = func(string) bool { return true }
 	f.project = func(string) (wapi.Config, error) { return wapi.Config{ArtifactID: "art-existing"}, nil }
-	f.getArtifact = func(id string) (*workload.Artifact, error) {
+	f.getArtifact = func(_ context.Context, id string) (*workload.Artifact, error) {
 		return &workload.Artifact{ID: id, Status: workload.ArtifactStatusDraft}, nil
 	}
-	f.create = func(any) (*workload.Workload, error) {
+	f.create = func(context.Context, any) (*workload.Workload, error) {
 		return nil, &drapi.HTTPError{StatusCode: http.StatusConflict}
 	}
 	// Nothing holds the artifact; the name is what collided.
-	f.list = func(int, []string, string) ([]workload.Workload, error) {
+	f.list = func(context.Context, int, []string, string) ([]workload.Workload, error) {
 		return []workload.Workload{{ID: "wl-1", Name: "my-app", ArtifactID: "art-somewhere-else"}}, nil
 	}
 
@@ -829,10 +876,10 @@ func TestRun_ConflictOnAFreshArtifactIsNotBlamedOnTheLink(t *testing.T) {
 	var tr track
 
 	f := wiredBuild(&tr)
-	f.create = func(any) (*workload.Workload, error) {
+	f.create = func(context.Context, any) (*workload.Workload, error) {
 		return nil, &drapi.HTTPError{StatusCode: http.StatusConflict}
 	}
-	f.list = func(int, []string, string) ([]workload.Workload, error) { return nil, nil }
+	f.list = func(context.Context, int, []string, string) ([]workload.Workload, error) { return nil, nil }
 
 	install(t, f)
 
@@ -850,7 +897,7 @@ func TestRun_LockedArtifactStopsBeforeThePush(t *testing.T) {
 	f := wiredBuild(&tr)
 	f.linked = func(string) bool { return true }
 	f.project = func(string) (wapi.Config, error) { return wapi.Config{ArtifactID: "art-locked"}, nil }
-	f.getArtifact = func(id string) (*workload.Artifact, error) {
+	f.getArtifact = func(_ context.Context, id string) (*workload.Artifact, error) {
 		return &workload.Artifact{ID: id, Status: workload.ArtifactStatusLocked}, nil
 	}
 
@@ -891,7 +938,7 @@ func TestRun_FailedBuildStopsAndNamesTheLogs(t *testing.T) {
 	// WaitForBuild's own contract: on a terminal error status it returns the
 	// build and an error together. Stubbing a nil error here would test a
 	// wait that does not exist and hide the id being dropped.
-	f.waitBuild = func(_, id string, _, _ time.Duration, _ func(*workload.Build)) (*workload.Build, error) {
+	f.waitBuild = func(_ context.Context, _, id string, _, _ time.Duration, _ func(*workload.Build)) (*workload.Build, error) {
 		return &workload.Build{ID: id, Status: workload.BuildStatusFailed},
 			fmt.Errorf("build %s ended with status %s", id, workload.BuildStatusFailed)
 	}
@@ -911,7 +958,7 @@ func TestRun_TimedOutBuildKeepsItsID(t *testing.T) {
 	var tr track
 
 	f := wiredBuild(&tr)
-	f.waitBuild = func(_, id string, _, _ time.Duration, _ func(*workload.Build)) (*workload.Build, error) {
+	f.waitBuild = func(_ context.Context, _, id string, _, _ time.Duration, _ func(*workload.Build)) (*workload.Build, error) {
 		return &workload.Build{ID: id, Status: workload.BuildStatusInProgress},
 			fmt.Errorf("timeout waiting for build %s", id)
 	}
@@ -931,7 +978,7 @@ func TestRun_DetachStillWaitsForTheImage(t *testing.T) {
 	var tr track
 
 	f := wiredBuild(&tr)
-	f.wait = func(string, time.Duration, time.Duration, func(*workload.Workload)) (*workload.Workload, error) {
+	f.wait = func(context.Context, string, time.Duration, time.Duration, func(*workload.Workload)) (*workload.Workload, error) {
 		t.Fatal("--detach does not wait for the workload")
 
 		return nil, nil
@@ -952,7 +999,7 @@ func TestRun_UnchangedTreeWithAnImageSkipsTheBuild(t *testing.T) {
 	var tr track
 
 	f := linkedAndSynced(&tr)
-	f.builds = func(string, int) ([]workload.Build, error) {
+	f.builds = func(context.Context, string, int) ([]workload.Build, error) {
 		return []workload.Build{{ID: "bld-0", Status: workload.BuildStatusCompleted}}, nil
 	}
 
@@ -972,7 +1019,7 @@ func TestRun_UnchangedTreeWithNoImageStillBuilds(t *testing.T) {
 	var tr track
 
 	f := linkedAndSynced(&tr)
-	f.builds = func(string, int) ([]workload.Build, error) {
+	f.builds = func(context.Context, string, int) ([]workload.Build, error) {
 		return []workload.Build{{ID: "bld-0", Status: workload.BuildStatusFailed}}, nil
 	}
 
@@ -991,7 +1038,7 @@ func TestRun_ForceBuildRebuildsAnUnchangedTree(t *testing.T) {
 	var tr track
 
 	f := linkedAndSynced(&tr)
-	f.builds = func(string, int) ([]workload.Build, error) {
+	f.builds = func(context.Context, string, int) ([]workload.Build, error) {
 		t.Fatal("--force-build has already answered the question")
 
 		return nil, nil
@@ -1011,13 +1058,13 @@ func TestRun_ForceBuildRebuildsAnUnchangedTree(t *testing.T) {
 func linkedAndSynced(tr *track) fakes {
 	f := wiredBuild(tr)
 
-	f.code = func(Loaded, Live) (CodeChange, error) { return CodeChange{Applies: true}, nil }
+	f.code = func(context.Context, Loaded, Live) (CodeChange, error) { return CodeChange{Applies: true}, nil }
 	f.linked = func(string) bool { return true }
 	f.project = func(string) (wapi.Config, error) { return wapi.Config{ArtifactID: "art-1"}, nil }
-	f.getArtifact = func(id string) (*workload.Artifact, error) {
+	f.getArtifact = func(_ context.Context, id string) (*workload.Artifact, error) {
 		return &workload.Artifact{ID: id, Status: workload.ArtifactStatusDraft}, nil
 	}
-	f.sync = func(string) (*sync.Result, error) {
+	f.sync = func(context.Context, string) (*sync.Result, error) {
 		tr.steps = append(tr.steps, "sync")
 
 		return &sync.Result{NewVersion: "ver-1"}, nil
@@ -1032,10 +1079,16 @@ func TestRun_SyncConflictsAreReported(t *testing.T) {
 	var tr track
 
 	f := wiredBuild(&tr)
-	f.sync = func(string) (*sync.Result, error) {
+	f.sync = func(context.Context, string) (*sync.Result, error) {
 		tr.steps = append(tr.steps, "sync")
 
-		return &sync.Result{UploadedCount: 1, ConflictCount: 1, ConflictCopies: []string{"app.py.LOCAL.170"}}, nil
+		return &sync.Result{
+			UploadedCount:  1,
+			ConflictCount:  3,
+			ConflictCopies: []string{"app.py.LOCAL.170"},
+			Merged:         []string{"util.py"},
+			Unresolved:     []string{"main.py"},
+		}, nil
 	}
 
 	install(t, f)
@@ -1043,14 +1096,16 @@ func TestRun_SyncConflictsAreReported(t *testing.T) {
 	_, stderr, err := runIn(t, unboundDockerfileManifest, Options{NonInteractive: true})
 	require.NoError(t, err)
 	assert.Contains(t, stderr, "app.py.LOCAL.170")
+	assert.Contains(t, stderr, "Merged both sides of util.py.")
+	assert.Contains(t, stderr, "Conflict markers left in main.py;")
 }
 
 // A flag that was asked for and did nothing has to say so, or it reads as a
 // rebuild that quietly happened.
 func TestRun_ForceBuildWithNothingToDoSaysSo(t *testing.T) {
 	install(t, fakes{
-		workloadD: func(string) (workload.Document, error) { return doc(t, liveWorkloadJSON), nil },
-		artifactD: func(string) (workload.Document, error) { return doc(t, liveArtifactJSON), nil },
+		workloadD: func(context.Context, string) (workload.Document, error) { return doc(t, liveWorkloadJSON), nil },
+		artifactD: func(context.Context, string) (workload.Document, error) { return doc(t, liveArtifactJSON), nil },
 	})
 
 	bound := "workloadId: 68b0c1d2e3f4a5b6c7d8e9f0\n" + boundLiveManifest
@@ -1065,11 +1120,11 @@ func TestRun_ForceBuildWithNothingToDoSaysSo(t *testing.T) {
 // anything leaves nothing to suggest the image is not what the flag implied.
 func TestRun_ForceBuildOnAPublishedImageSaysSo(t *testing.T) {
 	install(t, fakes{
-		create: func(any) (*workload.Workload, error) { return running("wl-new"), nil },
-		wait: func(string, time.Duration, time.Duration, func(*workload.Workload)) (*workload.Workload, error) {
+		create: func(context.Context, any) (*workload.Workload, error) { return running("wl-new"), nil },
+		wait: func(context.Context, string, time.Duration, time.Duration, func(*workload.Workload)) (*workload.Workload, error) {
 			return running("wl-new"), nil
 		},
-		build: func(string) (*workload.BuildTriggerResponse, error) {
+		build: func(context.Context, string) (*workload.BuildTriggerResponse, error) {
 			t.Fatal("a published image is not built by the platform")
 
 			return nil, nil
@@ -1105,13 +1160,13 @@ func TestRun_CreatesFromAnArtifactTheManifestNames(t *testing.T) {
 	var payload any
 
 	install(t, fakes{
-		create: func(p any) (*workload.Workload, error) {
+		create: func(_ context.Context, p any) (*workload.Workload, error) {
 			payload = p
 
 			return running("wl-new"), nil
 		},
 		writeID: func(string, string) error { return nil },
-		wait: func(string, time.Duration, time.Duration, func(*workload.Workload)) (*workload.Workload, error) {
+		wait: func(context.Context, string, time.Duration, time.Duration, func(*workload.Workload)) (*workload.Workload, error) {
 			return running("wl-new"), nil
 		},
 	})
@@ -1126,7 +1181,7 @@ func TestRun_CreatesFromAnArtifactTheManifestNames(t *testing.T) {
 
 func TestRun_DryRunOnABuildTrackSucceeds(t *testing.T) {
 	install(t, fakes{
-		code: func(Loaded, Live) (CodeChange, error) {
+		code: func(context.Context, Loaded, Live) (CodeChange, error) {
 			return CodeChange{Applies: true, FirstDeploy: true}, nil
 		},
 	})
@@ -1155,17 +1210,17 @@ func TestRun_StartsAStoppedWorkload(t *testing.T) {
 	var started string
 
 	install(t, fakes{
-		workloadD: func(string) (workload.Document, error) { return stoppedWorkload(t), nil },
-		artifactD: func(string) (workload.Document, error) { return doc(t, liveArtifactJSON), nil },
-		start: func(id string) (*workload.WorkloadOperationResponse, error) {
+		workloadD: func(context.Context, string) (workload.Document, error) { return stoppedWorkload(t), nil },
+		artifactD: func(context.Context, string) (workload.Document, error) { return doc(t, liveArtifactJSON), nil },
+		start: func(_ context.Context, id string) (*workload.WorkloadOperationResponse, error) {
 			started = id
 
 			return &workload.WorkloadOperationResponse{WorkloadID: id, Status: "queued"}, nil
 		},
-		wait: func(id string, _, _ time.Duration, _ func(*workload.Workload)) (*workload.Workload, error) {
+		wait: func(_ context.Context, id string, _, _ time.Duration, _ func(*workload.Workload)) (*workload.Workload, error) {
 			return running(id), nil
 		},
-		create: func(any) (*workload.Workload, error) {
+		create: func(context.Context, any) (*workload.Workload, error) {
 			t.Fatal("the workload exists; starting it is not creating a second one")
 
 			return nil, nil
@@ -1188,9 +1243,9 @@ func TestRun_StartsAStoppedWorkload(t *testing.T) {
 // report a failure, which leaves the user worse off than refusing did.
 func TestRun_StoppedWithDriftIsRefusedWithoutStarting(t *testing.T) {
 	install(t, fakes{
-		workloadD: func(string) (workload.Document, error) { return stoppedWorkload(t), nil },
-		artifactD: func(string) (workload.Document, error) { return doc(t, liveArtifactJSON), nil },
-		start: func(string) (*workload.WorkloadOperationResponse, error) {
+		workloadD: func(context.Context, string) (workload.Document, error) { return stoppedWorkload(t), nil },
+		artifactD: func(context.Context, string) (workload.Document, error) { return doc(t, liveArtifactJSON), nil },
+		start: func(context.Context, string) (*workload.WorkloadOperationResponse, error) {
 			t.Fatal("a refused plan must not have started anything")
 
 			return nil, nil
@@ -1210,12 +1265,12 @@ func TestRun_StoppedWithDriftIsRefusedWithoutStarting(t *testing.T) {
 
 func TestRun_StartFailureNamesTheWorkload(t *testing.T) {
 	install(t, fakes{
-		workloadD: func(string) (workload.Document, error) { return stoppedWorkload(t), nil },
-		artifactD: func(string) (workload.Document, error) { return doc(t, liveArtifactJSON), nil },
-		start: func(string) (*workload.WorkloadOperationResponse, error) {
+		workloadD: func(context.Context, string) (workload.Document, error) { return stoppedWorkload(t), nil },
+		artifactD: func(context.Context, string) (workload.Document, error) { return doc(t, liveArtifactJSON), nil },
+		start: func(context.Context, string) (*workload.WorkloadOperationResponse, error) {
 			return nil, &drapi.HTTPError{StatusCode: http.StatusConflict}
 		},
-		wait: func(string, time.Duration, time.Duration, func(*workload.Workload)) (*workload.Workload, error) {
+		wait: func(context.Context, string, time.Duration, time.Duration, func(*workload.Workload)) (*workload.Workload, error) {
 			t.Fatal("there is nothing to wait for when the start was refused")
 
 			return nil, nil
@@ -1231,12 +1286,12 @@ func TestRun_StartFailureNamesTheWorkload(t *testing.T) {
 
 func TestRun_DetachedStartDoesNotWait(t *testing.T) {
 	install(t, fakes{
-		workloadD: func(string) (workload.Document, error) { return stoppedWorkload(t), nil },
-		artifactD: func(string) (workload.Document, error) { return doc(t, liveArtifactJSON), nil },
-		start: func(id string) (*workload.WorkloadOperationResponse, error) {
+		workloadD: func(context.Context, string) (workload.Document, error) { return stoppedWorkload(t), nil },
+		artifactD: func(context.Context, string) (workload.Document, error) { return doc(t, liveArtifactJSON), nil },
+		start: func(_ context.Context, id string) (*workload.WorkloadOperationResponse, error) {
 			return &workload.WorkloadOperationResponse{WorkloadID: id}, nil
 		},
-		wait: func(string, time.Duration, time.Duration, func(*workload.Workload)) (*workload.Workload, error) {
+		wait: func(context.Context, string, time.Duration, time.Duration, func(*workload.Workload)) (*workload.Workload, error) {
 			t.Fatal("--detach returns as soon as the start is requested")
 
 			return nil, nil
@@ -1258,13 +1313,13 @@ func TestRun_DetachedStartDoesNotWait(t *testing.T) {
 // poll for a transition that is never coming.
 func TestRun_SuspendedIsRefusedRatherThanPolled(t *testing.T) {
 	install(t, fakes{
-		workloadD: func(string) (workload.Document, error) {
+		workloadD: func(context.Context, string) (workload.Document, error) {
 			d := doc(t, liveWorkloadJSON)
 			d["status"] = workload.WorkloadStatusSuspended
 
 			return d, nil
 		},
-		artifactD: func(string) (workload.Document, error) { return doc(t, liveArtifactJSON), nil },
+		artifactD: func(context.Context, string) (workload.Document, error) { return doc(t, liveArtifactJSON), nil },
 	})
 
 	bound := "workloadId: 68b0c1d2e3f4a5b6c7d8e9f0\n" + boundLiveManifest
@@ -1279,19 +1334,19 @@ func TestRun_InterruptedStartsLikeAnyStoppedWorkload(t *testing.T) {
 	var started string
 
 	install(t, fakes{
-		workloadD: func(string) (workload.Document, error) {
+		workloadD: func(context.Context, string) (workload.Document, error) {
 			d := doc(t, liveWorkloadJSON)
 			d["status"] = workload.WorkloadStatusInterrupted
 
 			return d, nil
 		},
-		artifactD: func(string) (workload.Document, error) { return doc(t, liveArtifactJSON), nil },
-		start: func(id string) (*workload.WorkloadOperationResponse, error) {
+		artifactD: func(context.Context, string) (workload.Document, error) { return doc(t, liveArtifactJSON), nil },
+		start: func(_ context.Context, id string) (*workload.WorkloadOperationResponse, error) {
 			started = id
 
 			return &workload.WorkloadOperationResponse{WorkloadID: id}, nil
 		},
-		wait: func(id string, _, _ time.Duration, _ func(*workload.Workload)) (*workload.Workload, error) {
+		wait: func(_ context.Context, id string, _, _ time.Duration, _ func(*workload.Workload)) (*workload.Workload, error) {
 			return running(id), nil
 		},
 	})
@@ -1308,12 +1363,12 @@ func TestRun_InterruptedStartsLikeAnyStoppedWorkload(t *testing.T) {
 // green checkmark alone would be the whole account of it.
 func TestRun_StartAcknowledgementIsPrinted(t *testing.T) {
 	install(t, fakes{
-		workloadD: func(string) (workload.Document, error) { return stoppedWorkload(t), nil },
-		artifactD: func(string) (workload.Document, error) { return doc(t, liveArtifactJSON), nil },
-		start: func(id string) (*workload.WorkloadOperationResponse, error) {
+		workloadD: func(context.Context, string) (workload.Document, error) { return stoppedWorkload(t), nil },
+		artifactD: func(context.Context, string) (workload.Document, error) { return doc(t, liveArtifactJSON), nil },
+		start: func(_ context.Context, id string) (*workload.WorkloadOperationResponse, error) {
 			return &workload.WorkloadOperationResponse{WorkloadID: id, Status: "Proton is already running"}, nil
 		},
-		wait: func(id string, _, _ time.Duration, _ func(*workload.Workload)) (*workload.Workload, error) {
+		wait: func(_ context.Context, id string, _, _ time.Duration, _ func(*workload.Workload)) (*workload.Workload, error) {
 			return running(id), nil
 		},
 	})
@@ -1329,15 +1384,15 @@ func TestRun_StartAcknowledgementIsPrinted(t *testing.T) {
 // and locking twice is not a no-op at the platform.
 func TestRun_StartDoesNotRelockALockedArtifact(t *testing.T) {
 	install(t, fakes{
-		workloadD: func(string) (workload.Document, error) { return stoppedWorkload(t), nil },
-		artifactD: func(string) (workload.Document, error) { return doc(t, liveArtifactJSON), nil },
-		start: func(id string) (*workload.WorkloadOperationResponse, error) {
+		workloadD: func(context.Context, string) (workload.Document, error) { return stoppedWorkload(t), nil },
+		artifactD: func(context.Context, string) (workload.Document, error) { return doc(t, liveArtifactJSON), nil },
+		start: func(_ context.Context, id string) (*workload.WorkloadOperationResponse, error) {
 			return &workload.WorkloadOperationResponse{WorkloadID: id}, nil
 		},
-		wait: func(id string, _, _ time.Duration, _ func(*workload.Workload)) (*workload.Workload, error) {
+		wait: func(_ context.Context, id string, _, _ time.Duration, _ func(*workload.Workload)) (*workload.Workload, error) {
 			return running(id), nil
 		},
-		lock: func(string) (*workload.Artifact, error) {
+		lock: func(context.Context, string) (*workload.Artifact, error) {
 			t.Fatal("the artifact this workload runs is already locked")
 
 			return nil, nil
@@ -1370,19 +1425,19 @@ func TestRun_StartLocksAnUnlockedArtifactOnceItServes(t *testing.T) {
 	var order []string
 
 	install(t, fakes{
-		workloadD: func(string) (workload.Document, error) { return stoppedWorkload(t), nil },
-		artifactD: func(string) (workload.Document, error) { return unlockedArtifact(t), nil },
-		start: func(id string) (*workload.WorkloadOperationResponse, error) {
+		workloadD: func(context.Context, string) (workload.Document, error) { return stoppedWorkload(t), nil },
+		artifactD: func(context.Context, string) (workload.Document, error) { return unlockedArtifact(t), nil },
+		start: func(_ context.Context, id string) (*workload.WorkloadOperationResponse, error) {
 			order = append(order, "start")
 
 			return &workload.WorkloadOperationResponse{WorkloadID: id}, nil
 		},
-		wait: func(id string, _, _ time.Duration, _ func(*workload.Workload)) (*workload.Workload, error) {
+		wait: func(_ context.Context, id string, _, _ time.Duration, _ func(*workload.Workload)) (*workload.Workload, error) {
 			order = append(order, "wait")
 
 			return running(id), nil
 		},
-		lock: func(id string) (*workload.Artifact, error) {
+		lock: func(_ context.Context, id string) (*workload.Artifact, error) {
 			order = append(order, "lock:"+id)
 
 			return &workload.Artifact{ID: id, Status: workload.ArtifactStatusLocked}, nil
@@ -1453,12 +1508,12 @@ const (
 // manifest.
 func TestRun_StartVerifiesCredentialsFirst(t *testing.T) {
 	install(t, fakes{
-		workloadD: func(string) (workload.Document, error) { return doc(t, stoppedCredWorkloadJSON), nil },
-		artifactD: func(string) (workload.Document, error) { return doc(t, credArtifactJSON), nil },
-		cred: func(string) (*workload.Credential, error) {
+		workloadD: func(context.Context, string) (workload.Document, error) { return doc(t, stoppedCredWorkloadJSON), nil },
+		artifactD: func(context.Context, string) (workload.Document, error) { return doc(t, credArtifactJSON), nil },
+		cred: func(context.Context, string) (*workload.Credential, error) {
 			return nil, &drapi.HTTPError{StatusCode: http.StatusNotFound}
 		},
-		start: func(string) (*workload.WorkloadOperationResponse, error) {
+		start: func(context.Context, string) (*workload.WorkloadOperationResponse, error) {
 			t.Fatal("nothing may start before the credentials are known to resolve")
 
 			return nil, nil
@@ -1480,10 +1535,10 @@ func TestRun_StartVerifiesCredentialsFirst(t *testing.T) {
 // start once the deploy has already been paid for.
 func TestRun_BadCredentialStopsBeforeTheCreate(t *testing.T) {
 	install(t, fakes{
-		cred: func(string) (*workload.Credential, error) {
+		cred: func(context.Context, string) (*workload.Credential, error) {
 			return nil, &drapi.HTTPError{StatusCode: http.StatusNotFound}
 		},
-		create: func(any) (*workload.Workload, error) {
+		create: func(context.Context, any) (*workload.Workload, error) {
 			t.Fatal("nothing may be created before the credentials are known to resolve")
 
 			return nil, nil
@@ -1501,7 +1556,7 @@ func TestRun_BadCredentialStopsBeforeTheCreate(t *testing.T) {
 // would make --dry-run fail on a file it was asked only to describe.
 func TestRun_DryRunDoesNotVerifyCredentials(t *testing.T) {
 	install(t, fakes{
-		cred: func(string) (*workload.Credential, error) {
+		cred: func(context.Context, string) (*workload.Credential, error) {
 			t.Fatal("a dry run mutates nothing, so it has nothing to verify against")
 
 			return nil, nil
@@ -1514,11 +1569,11 @@ func TestRun_DryRunDoesNotVerifyCredentials(t *testing.T) {
 
 func TestRun_ResolvedCredentialDeploys(t *testing.T) {
 	install(t, fakes{
-		cred: func(id string) (*workload.Credential, error) {
+		cred: func(_ context.Context, id string) (*workload.Credential, error) {
 			return &workload.Credential{CredentialID: id, Name: "my-app/OPENAI_API_KEY"}, nil
 		},
-		create: func(any) (*workload.Workload, error) { return running("wl-1"), nil },
-		wait: func(string, time.Duration, time.Duration, func(*workload.Workload)) (*workload.Workload, error) {
+		create: func(context.Context, any) (*workload.Workload, error) { return running("wl-1"), nil },
+		wait: func(context.Context, string, time.Duration, time.Duration, func(*workload.Workload)) (*workload.Workload, error) {
 			return running("wl-1"), nil
 		},
 	})
@@ -15,6 +15,7 @@
 package up
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -60,6 +61,7 @@ type version struct {
 // Every step here is recoverable except by leaving a draft artifact behind,
 // which is why the next run looks for one before making another.
 func buildVersion(
+	ctx context.Context,
 	loaded Loaded,
 	live Live,
 	code CodeChange,
@@ -67,7 +69,7 @@ func buildVersion(
 	opts Options,
 	report *reporter,
 ) (version, error) {
-	made, err := versionArtifact(loaded, live, repositoryID, report)
+	made, err := versionArtifact(ctx, loaded, live, repositoryID, report)
 	if err != nil {
 		return made, err
 	}
@@ -76,12 +78,12 @@ func buildVersion(
 		return made, err
 	}
 
-	synced, err := syncCode(loaded.ProjectDir, report)
+	synced, err := syncCode(ctx, loaded.ProjectDir, report)
 	if err != nil {
 		return made, err
 	}
 
-	if err := inheritCode(loaded.ProjectDir, made.ID, synced, report); err != nil {
+	if err := inheritCode(ctx, loaded.ProjectDir, made.ID, synced, report); err != nil {
 		return made, err
 	}
 
@@ -89,7 +91,7 @@ func buildVersion(
 	// Fresh is what stops maybeBuild from skipping the build and promoting an
 	// artifact with nothing to run. One picked up from an earlier attempt may
 	// already have its image, and that is the skip worth having.
-	buildID, err := maybeBuild(made.ID, made.Fresh, code, synced, opts, report)
+	buildID, err := maybeBuild(ctx, made.ID, made.Fresh, code, synced, opts, report)
 	made.BuildID = buildID
 
 	return made, err
@@ -97,21 +99,21 @@ func buildVersion(
 
 // versionArtifact returns the artifact to fill and build, reusing the one an
 // earlier attempt left behind rather than adding to a pile of drafts.
-func versionArtifact(loaded Loaded, live Live, repositoryID string, report *reporter) (version, error) {
-	if id, ok := abandoned(loaded, live, repositoryID); ok {
+func versionArtifact(ctx context.Context, loaded Loaded, live Live, repositoryID string, report *reporter) (version, error) {
+	if id, ok := abandoned(ctx, loaded, live, repositoryID); ok {
 		report.say("  Continuing with artifact %s from an earlier attempt.\n", id)
 
 		return version{ID: id}, nil
 	}
 
-	return createVersion(loaded, repositoryID, report)
+	return createVersion(ctx, loaded, repositoryID, report)
 }
 
 // createVersion mints an artifact from the file's artifact block, into
 // repositoryID when the run has a lineage for it to join. An empty
 // repositoryID leaves the platform to open one, which is what the first deploy
 // of anything wants.
-func createVersion(loaded Loaded, repositoryID string, report *reporter) (version, error) {
+func createVersion(ctx context.Context, loaded Loaded, repositoryID string, report *reporter) (version, error) {
 	var (
 		created  *workload.Artifact
 		fellBack bool
@@ -123,7 +125,7 @@ func createVersion(loaded Loaded, repositoryID string, report *reporter) (versio
 			return payloadErr
 		}
 
-		artifact, forked, createErr := createInRepository(payload, repositoryID)
+		artifact, forked, createErr := createInRepository(ctx, payload, repositoryID)
 		created, fellBack = artifact, forked
 
 		return createErr
@@ -172,7 +174,7 @@ func createVersion(loaded Loaded, repositoryID string, report *reporter) (versio
 // answer is the one reported. The cost of covering the case is one wasted
 // request on a deploy that was going to fail anyway. Reading the message to
 // tell the two apart would be exact until the day the API rewords it.
-func createInRepository(payload json.RawMessage, repositoryID string) (*workload.Artifact, bool, error) {
+func createInRepository(ctx context.Context, payload json.RawMessage, repositoryID string) (*workload.Artifact, bool, error) {
 	// Every request below starts from a block with no repository on it. The
 	// field is the platform's own and the file has no business carrying one,
 	// but the compiler passes keys it does not recognize through untouched, so
@@ -185,7 +187,7 @@ func createInRepository(payload json.RawMessage, repositoryID string) (*workload
 	}
 
 	if repositoryID == "" {
-		artifact, createErr := createArtifactFn(free)
+		artifact, createErr := createArtifactFn(ctx, free)
 
 		return artifact, false, createErr
 	}
@@ -195,7 +197,7 @@ func createInRepository(payload json.RawMessage, repositoryID string) (*workload
 		return nil, false, err
 	}
 
-	artifact, err := createArtifactFn(joined)
+	artifact, err := createArtifactFn(ctx, joined)
 	if err == nil || !repositoryRefused(err) {
 		return artifact, false, err
 	}
@@ -203,7 +205,7 @@ func createInRepository(payload json.RawMessage, repositoryID string) (*workload
 	log.Debug("artifact repository refused; creating the version in a new one",
 		"artifact_repository_id", repositoryID, "err", err)
 
-	artifact, err = createArtifactFn(free)
+	artifact, err = createArtifactFn(ctx, free)
 
 	// A retry that failed too forked nothing, so there is nothing to announce
 	// and the error it came back with is the whole story.
@@ -288,7 +290,7 @@ func withoutRepository(payload json.RawMessage) (json.RawMessage, error) {
 // `dr artifact create` makes, both of which sit in a repository of their own.
 // Minting a fresh version costs the stray draft that reuse exists to avoid;
 // reusing costs the lineage, permanently.
-func abandoned(loaded Loaded, live Live, repositoryID string) (string, bool) {
+func abandoned(ctx context.Context, loaded Loaded, live Live, repositoryID string) (string, bool) {
 	if !projectLinkedFn(loaded.ProjectDir) {
 		return "", false
 	}
@@ -298,7 +300,7 @@ func abandoned(loaded Loaded, live Live, repositoryID string) (string, bool) {
 		return "", false
 	}
 
-	artifact, err := getArtifactFn(cfg.ArtifactID)
+	artifact, err := getArtifactFn(ctx, cfg.ArtifactID)
 	if err != nil || artifact.IsLocked() {
 		return "", false
 	}
@@ -307,7 +309,7 @@ func abandoned(loaded Loaded, live Live, repositoryID string) (string, bool) {
 		return "", false
 	}
 
-	if !describes(loaded, cfg.ArtifactID) {
+	if !describes(ctx, loaded, cfg.ArtifactID) {
 		return "", false
 	}
 
@@ -342,7 +344,7 @@ func joinable(draft *workload.Artifact, repositoryID string) bool {
 // A read that fails answers no. Minting a second draft costs an artifact
 // nobody promotes; promoting one whose spec was never confirmed costs a
 // rollout of the wrong thing.
-func describes(loaded Loaded, artifactID string) bool {
+func describes(ctx context.Context, loaded Loaded, artifactID string) bool {
 	payload, err := loaded.Compiled.ArtifactPayload()
 	if err != nil {
 		return false
@@ -354,7 +356,7 @@ func describes(loaded Loaded, artifactID string) bool {
 		return false
 	}
 
-	doc, err := getArtifactDocFn(artifactID)
+	doc, err := getArtifactDocFn(ctx, artifactID)
 	if err != nil {
 		return false
 	}
@@ -436,7 +438,7 @@ func linkFailure(artifactID, projectDir string, err error) error {
 // code reference at all, and the roll would promote something that cannot
 // start. Pointing it at the version last synced is what makes "a new version
 // of the same code" true.
-func inheritCode(projectDir, artifactID string, synced *sync.Result, report *reporter) error {
+func inheritCode(ctx context.Context, projectDir, artifactID string, synced *sync.Result, report *reporter) error {
 	if synced != nil && synced.NewVersion != "" {
 		return nil
 	}
@@ -455,7 +457,7 @@ func inheritCode(projectDir, artifactID string, synced *sync.Result, report *rep
 	}
 
 	err = report.run("Carrying the code over", func() error {
-		return patchCodeRefFn(artifactID, catalog, lastVersion)
+		return patchCodeRefFn(ctx, artifactID, catalog, lastVersion)
 	})
 	if err != nil {
 		return fmt.Errorf("cannot point artifact %s at the code it should build (%s): %w",
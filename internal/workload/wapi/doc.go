@@ -19,9 +19,10 @@
 //
 // It exposes plain functions that read and write the files inside it:
 // config.json (identity + sync state), manifest.json (the BASE manifest from
-// the last successful sync), .gitignore, and history.log (append-only JSONL
-// with 1 MB rotation). It also drops a .wapiignore template at the project
-// root on Initialize.
+// the last successful sync), .gitignore, history.log (append-only JSONL with
+// 1 MB rotation), and hashcache.json (content hashes keyed by file stat, so
+// unchanged files are not re-read). It also drops a .wapiignore template at
+// the project root on Initialize.
 //
 // This package is pure state management: no HTTP, no sync logic, no ignore
 // parsing, no file locking. Consumers (the workload code CLI commands, the
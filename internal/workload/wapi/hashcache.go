@@ -0,0 +1,108 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/datarobot/cli/internal/fsutil"
+	"github.com/datarobot/cli/internal/workload/fileops"
+)
+
+// HashCacheVersion is the hashcache.json format. A file written in another
+// format is discarded on load rather than migrated: the cache only ever
+// saves work, so starting over is always correct.
+const HashCacheVersion = 1
+
+// HashCacheEntry is a file's content hash together with the stat it was
+// computed under. The hash is reusable while a fresh stat of the same path
+// still equals StatKey.
+type HashCacheEntry struct {
+	fileops.StatKey
+
+	Hash string `json:"hash"`
+}
+
+// HashCache is the parsed hashcache.json: the hashes of the last scan of the
+// project, keyed by normalized relative path. ScannedAt is when that scan
+// began; entries whose mtime was too close to it are never stored, because a
+// write in the same clock tick would leave the stat unchanged.
+type HashCache struct {
+	Version   int                       `json:"version"`
+	ScannedAt time.Time                 `json:"scannedAt"`
+	Files     map[string]HashCacheEntry `json:"files"`
+}
+
+// LoadHashCache reads the project's hashcache.json. A missing file, or one in
+// another format, is an empty cache. Returns ErrNotInitialized if the state
+// directory is missing, and a *CorruptedError if the file cannot be parsed;
+// callers are expected to carry on without the cache in either case.
+func LoadHashCache(projectDir string) (HashCache, error) {
+	empty := HashCache{Version: HashCacheVersion, Files: map[string]HashCacheEntry{}}
+
+	if !Exists(projectDir) {
+		return empty, ErrNotInitialized
+	}
+
+	path := hashCachePath(projectDir)
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return empty, nil
+		}
+
+		return empty, &CorruptedError{Path: path, Err: err}
+	}
+
+	var c HashCache
+
+	if err := json.Unmarshal(data, &c); err != nil {
+		return empty, &CorruptedError{Path: path, Err: err}
+	}
+
+	if c.Version != HashCacheVersion || c.Files == nil {
+		return empty, nil
+	}
+
+	return c, nil
+}
+
+// SaveHashCache atomically replaces hashcache.json. Returns ErrNotInitialized
+// if the state directory does not exist.
+func SaveHashCache(projectDir string, c HashCache) error {
+	if !Exists(projectDir) {
+		return ErrNotInitialized
+	}
+
+	c.Version = HashCacheVersion
+
+	if c.Files == nil {
+		c.Files = map[string]HashCacheEntry{}
+	}
+
+	// Compact rather than indented: on a large tree this file is read on
+	// every scan, and nobody is meant to edit it.
+	data, err := json.Marshal(c)
+	if err != nil {
+		return fmt.Errorf("marshal hash cache: %w", err)
+	}
+
+	return fsutil.AtomicWriteFile(hashCachePath(projectDir), data)
+}
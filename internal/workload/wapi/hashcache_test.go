@@ -0,0 +1,91 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wapi
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/datarobot/cli/internal/workload/fileops"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHashCache_RoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	initWapiDir(t, dir)
+
+	scannedAt := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	want := HashCache{
+		ScannedAt: scannedAt,
+		Files: map[string]HashCacheEntry{
+			"app.py": {StatKey: fileops.StatKey{Size: 3, MtimeNs: 1, Inode: 7, CtimeNs: 2}, Hash: testHash('a')},
+		},
+	}
+
+	require.NoError(t, SaveHashCache(dir, want))
+
+	got, err := LoadHashCache(dir)
+	require.NoError(t, err)
+	assert.Equal(t, HashCacheVersion, got.Version)
+	assert.True(t, got.ScannedAt.Equal(scannedAt))
+	assert.Equal(t, want.Files, got.Files)
+}
+
+func TestHashCache_MissingIsEmpty(t *testing.T) {
+	dir := t.TempDir()
+	initWapiDir(t, dir)
+
+	got, err := LoadHashCache(dir)
+	require.NoError(t, err)
+	assert.Empty(t, got.Files)
+	assert.NotNil(t, got.Files)
+}
+
+func TestHashCache_OtherVersionIsEmpty(t *testing.T) {
+	dir := t.TempDir()
+	initWapiDir(t, dir)
+
+	require.NoError(t, os.WriteFile(hashCachePath(dir),
+		[]byte(`{"version":99,"files":{"a":{"size":1,"mtime":1,"hash":"x"}}}`), 0o644))
+
+	got, err := LoadHashCache(dir)
+	require.NoError(t, err)
+	assert.Empty(t, got.Files)
+}
+
+func TestHashCache_Corrupted(t *testing.T) {
+	dir := t.TempDir()
+	initWapiDir(t, dir)
+
+	require.NoError(t, os.WriteFile(hashCachePath(dir), []byte("{not json"), 0o644))
+
+	got, err := LoadHashCache(dir)
+
+	var corrupted *CorruptedError
+
+	require.ErrorAs(t, err, &corrupted)
+	assert.NotNil(t, got.Files, "callers can carry on with the empty cache")
+}
+
+func TestHashCache_NotInitialized(t *testing.T) {
+	dir := t.TempDir()
+
+	_, err := LoadHashCache(dir)
+	require.ErrorIs(t, err, ErrNotInitialized)
+
+	require.ErrorIs(t, SaveHashCache(dir, HashCache{}), ErrNotInitialized)
+}
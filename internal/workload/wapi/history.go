@@ -15,6 +15,8 @@
 package wapi
 
 import (
+	"bufio"
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -93,3 +95,68 @@ func rotateIfNeeded(path, backup string) error {
 
 	return nil
 }
+
+// ReadHistory returns every entry in history.log, oldest first, starting with
+// the rotated history.log.1 when there is one. A line that does not decode is
+// skipped rather than failing the read: the log is appended without a rename,
+// so a process killed mid-write can leave a torn last line, and one bad record
+// should not hide the rest.
+//
+// Returns ErrNotInitialized if the state directory does not exist. A state
+// directory with no log yet has an empty history.
+func ReadHistory(projectDir string) ([]HistoryEntry, error) {
+	if !Exists(projectDir) {
+		return nil, ErrNotInitialized
+	}
+
+	var entries []HistoryEntry
+
+	for _, path := range []string{historyBackupPath(projectDir), historyPath(projectDir)} {
+		read, err := readHistoryFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, read...)
+	}
+
+	return entries, nil
+}
+
+// readHistoryFile decodes one log file. A missing file has no entries.
+func readHistoryFile(path string) ([]HistoryEntry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("read history log %s: %w", path, err)
+	}
+
+	var entries []HistoryEntry
+
+	sc := bufio.NewScanner(bytes.NewReader(data))
+	sc.Buffer(make([]byte, 0, 64*1024), int(historyRotateBytes))
+
+	for sc.Scan() {
+		line := bytes.TrimSpace(sc.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+
+		var entry HistoryEntry
+
+		if err := json.Unmarshal(line, &entry); err != nil {
+			continue
+		}
+
+		entries = append(entries, entry)
+	}
+
+	if err := sc.Err(); err != nil {
+		return nil, fmt.Errorf("read history log %s: %w", path, err)
+	}
+
+	return entries, nil
+}
@@ -184,3 +184,53 @@ func TestAppendHistory_RotationRenameFailureSurfaces(t *testing.T) {
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "rotate")
 }
+
+func TestReadHistory_NotInitialized(t *testing.T) {
+	_, err := ReadHistory(t.TempDir())
+	assert.ErrorIs(t, err, ErrNotInitialized)
+}
+
+func TestReadHistory_EmptyLog(t *testing.T) {
+	tmp := t.TempDir()
+	initWapiDir(t, tmp)
+
+	entries, err := ReadHistory(tmp)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+// The rotated file holds the older entries, so it is read first and the
+// result stays oldest first across the rotation.
+func TestReadHistory_IncludesTheRotatedFile(t *testing.T) {
+	tmp := t.TempDir()
+	initWapiDir(t, tmp)
+
+	require.NoError(t, os.WriteFile(filepath.Join(Dir(tmp), historyBackupFile),
+		[]byte(`{"op":"init"}`+"\n"+`{"op":"sync"}`+"\n"), 0o644))
+	require.NoError(t, AppendHistory(tmp, HistoryEntry{"op": "checkout"}))
+
+	entries, err := ReadHistory(tmp)
+	require.NoError(t, err)
+
+	ops := make([]any, 0, len(entries))
+	for _, e := range entries {
+		ops = append(ops, e["op"])
+	}
+
+	assert.Equal(t, []any{"init", "sync", "checkout"}, ops)
+}
+
+// A torn line is what a crash mid-append leaves; the records around it are
+// still worth reading.
+func TestReadHistory_SkipsALineThatDoesNotDecode(t *testing.T) {
+	tmp := t.TempDir()
+	initWapiDir(t, tmp)
+
+	require.NoError(t, os.WriteFile(filepath.Join(Dir(tmp), HistoryFile),
+		[]byte(`{"op":"init"}`+"\n"+`{"op":"sy`+"\n"+`{"op":"checkout"}`+"\n"), 0o644))
+
+	entries, err := ReadHistory(tmp)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "checkout", entries[1]["op"])
+}
@@ -39,11 +39,15 @@ type FileMeta struct {
 // empty manifest round-trips with explicit JSON null values; the manifest is
 // redundant with config.json so corruption of one is recoverable from the
 // other.
+//
+// Unresolved lists the files the last sync wrote with conflict markers; the
+// next sync refuses to upload them while the markers remain.
 type Manifest struct {
 	Version         int                 `json:"version" validate:"eq=1"`
 	SyncedAt        *time.Time          `json:"syncedAt"`
 	SyncedVersionID *string             `json:"syncedVersionId" validate:"omitempty,dr_nonempty_ptr,dr_id"`
 	Files           map[string]FileMeta `json:"files" validate:"dive"`
+	Unresolved      []string            `json:"unresolved,omitempty"`
 }
 
 // LoadManifest reads and parses the project's manifest.json. Returns
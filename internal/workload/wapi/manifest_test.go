@@ -42,6 +42,7 @@ func TestManifest_SaveLoadRoundTrip(t *testing.T) {
 			"utils/helper.py":         {Hash: testHash('a'), Size: 567},
 			"models/bert/weights.bin": {Hash: testHash('d'), Size: 45678912},
 		},
+		Unresolved: []string{"agent.py"},
 	}
 
 	err := SaveManifest(tmp, original)
@@ -56,6 +57,7 @@ func TestManifest_SaveLoadRoundTrip(t *testing.T) {
 	require.NotNil(t, got.SyncedVersionID)
 	assert.Equal(t, versionID, *got.SyncedVersionID)
 	assert.Equal(t, original.Files, got.Files)
+	assert.Equal(t, original.Unresolved, got.Unresolved)
 }
 
 func TestManifest_EmptyFilesMap(t *testing.T) {
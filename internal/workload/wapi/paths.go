@@ -50,6 +50,7 @@ const (
 	configFile        = "config.json"
 	manifestFile      = "manifest.json"
 	historyBackupFile = "history.log.1"
+	hashCacheFile     = "hashcache.json"
 	gitignoreFile     = ".gitignore"
 	wapiignoreFile    = ".wapiignore"
 
@@ -124,6 +125,10 @@ func historyBackupPath(projectDir string) string {
 	return filepath.Join(Dir(projectDir), historyBackupFile)
 }
 
+func hashCachePath(projectDir string) string {
+	return filepath.Join(Dir(projectDir), hashCacheFile)
+}
+
 func gitignorePath(projectDir string) string {
 	return filepath.Join(Dir(projectDir), gitignoreFile)
 }
@@ -71,6 +71,12 @@ func validateManifest(m Manifest) error {
 		}
 	}
 
+	for _, path := range m.Unresolved {
+		if err := fileops.SafeRelPath(path); err != nil {
+			return fmt.Errorf("unresolved[%q]: %w", path, err)
+		}
+	}
+
 	return nil
 }
 
@@ -328,6 +328,15 @@ func TestValidateManifest(t *testing.T) {
 				},
 				wantErr: "size must be >= 0",
 			},
+			{
+				name: "unresolved path escapes root",
+				manifest: Manifest{
+					Version:    ManifestVersion,
+					Files:      map[string]FileMeta{},
+					Unresolved: []string{"../escape.py"},
+				},
+				wantErr: "path escapes project root",
+			},
 		}
 
 		for _, tc := range tests {
@@ -15,6 +15,7 @@
 package wizard
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math"
@@ -186,7 +187,7 @@ const (
 // draft answers every question from the flags and the project, and reports
 // what neither could answer. This is the headless path in full: no prompt is
 // ever attempted, and the error names the flag that would have settled it.
-func (a Answers) draft(detected Detected) (manifest.Draft, error) {
+func (a Answers) draft(ctx context.Context, detected Detected) (manifest.Draft, error) {
 	if err := a.check(); err != nil {
 		return manifest.Draft{}, err
 	}
@@ -216,7 +217,7 @@ func (a Answers) draft(detected Detected) (manifest.Draft, error) {
 		draft.Port = detected.Port
 	}
 
-	build, err := a.build(detected)
+	build, err := a.build(ctx, detected)
 	if err != nil {
 		return manifest.Draft{}, err
 	}
@@ -272,7 +273,7 @@ func (a Answers) runtime() manifest.Runtime {
 // build resolves the image source. With no --build-mode the mode follows what
 // the flags imply and then what the project shows, so the Dockerfile track
 // needs no flags at all when there is a Dockerfile to build.
-func (a Answers) build(detected Detected) (manifest.Build, error) {
+func (a Answers) build(ctx context.Context, detected Detected) (manifest.Build, error) {
 	switch a.buildMode(detected) {
 	case manifest.BuildModeDockerfile:
 		if !detected.HasDockerfile {
@@ -290,7 +291,7 @@ func (a Answers) build(detected Detected) (manifest.Build, error) {
 		return manifest.Build{Mode: manifest.BuildModeImage, ImageURI: a.Image}, nil
 
 	case manifest.BuildModeGenerated:
-		return a.generatedBuild()
+		return a.generatedBuild(ctx)
 
 	default:
 		return manifest.Build{}, fmt.Errorf(
@@ -319,7 +320,7 @@ func (a Answers) buildMode(detected Detected) string {
 	}
 }
 
-func (a Answers) generatedBuild() (manifest.Build, error) {
+func (a Answers) generatedBuild(ctx context.Context) (manifest.Build, error) {
 	var missing []string
 
 	if a.ExecutionEnvironment == "" {
@@ -343,7 +344,7 @@ func (a Answers) generatedBuild() (manifest.Build, error) {
 	// Resolved at setup, not at deploy: the file records both ids so the same
 	// manifest builds the same image after the environment moves on, and a
 	// name that does not exist fails now rather than after a sync.
-	id, versionID, err := resolveExecEnvFn(a.ExecutionEnvironment)
+	id, versionID, err := resolveExecEnvFn(ctx, a.ExecutionEnvironment)
 	if err != nil {
 		return manifest.Build{}, err
 	}
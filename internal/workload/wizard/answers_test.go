@@ -15,6 +15,7 @@
 package wizard
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -28,7 +29,7 @@ func stubExecEnv(t *testing.T, id, versionID string, err error) {
 	t.Helper()
 
 	original := resolveExecEnvFn
-	resolveExecEnvFn = func(string) (string, string, error) { return id, versionID, err }
+	resolveExecEnvFn = func(context.Context, string) (string, string, error) { return id, versionID, err }
 
 	t.Cleanup(func() { resolveExecEnvFn = original })
 }
@@ -108,11 +109,11 @@ func TestAnswers_Check(t *testing.T) {
 func TestAnswers_A2AIsCheckedAgainstTheDraftType(t *testing.T) {
 	detected := Detect(writeDockerfile(t, t.TempDir(), "FROM scratch\n"))
 
-	_, err := Answers{Name: "my-app", A2AEnabled: true}.draft(detected)
+	_, err := Answers{Name: "my-app", A2AEnabled: true}.draft(context.Background(), detected)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "--a2a-enabled applies to --type agent")
 
-	_, err = Answers{Name: "my-app", Type: manifest.TypeAgent, A2AEnabled: true}.draft(detected)
+	_, err = Answers{Name: "my-app", Type: manifest.TypeAgent, A2AEnabled: true}.draft(context.Background(), detected)
 	require.NoError(t, err)
 }
 
@@ -121,7 +122,7 @@ func TestAnswers_A2AIsCheckedAgainstTheDraftType(t *testing.T) {
 func TestAnswers_DraftFromDetectionAlone(t *testing.T) {
 	detected := Detect(writeDockerfile(t, t.TempDir(), "FROM scratch\nEXPOSE 3000\n"))
 
-	draft, err := Answers{}.draft(detected)
+	draft, err := Answers{}.draft(context.Background(), detected)
 	require.NoError(t, err)
 
 	assert.Equal(t, manifest.BuildModeDockerfile, draft.Build.Mode)
@@ -147,7 +148,7 @@ func TestAnswers_FlagsWinOverDetection(t *testing.T) {
 		Replicas:   3,
 		CPU:        2,
 		Memory:     "4GB",
-	}.draft(detected)
+	}.draft(context.Background(), detected)
 	require.NoError(t, err)
 
 	assert.Equal(t, "chosen", draft.Name)
@@ -163,7 +164,7 @@ func TestAnswers_FlagsWinOverDetection(t *testing.T) {
 // With nothing to build and nothing said, the error names the flags that
 // would settle it rather than guessing at an image source.
 func TestAnswers_NoDockerfileAndNoFlags(t *testing.T) {
-	_, err := Answers{}.draft(Detect(t.TempDir()))
+	_, err := Answers{}.draft(context.Background(), Detect(t.TempDir()))
 	require.Error(t, err)
 
 	assert.Contains(t, err.Error(), "--build-mode image")
@@ -174,13 +175,13 @@ func TestAnswers_ImageMode(t *testing.T) {
 	detected := Detect(t.TempDir())
 
 	// A lone --image says which track this is; --build-mode is not needed.
-	draft, err := Answers{Image: "registry/team/app:v1"}.draft(detected)
+	draft, err := Answers{Image: "registry/team/app:v1"}.draft(context.Background(), detected)
 	require.NoError(t, err)
 
 	assert.Equal(t, manifest.BuildModeImage, draft.Build.Mode)
 	assert.Equal(t, "registry/team/app:v1", draft.Build.ImageURI)
 
-	_, err = Answers{BuildMode: manifest.BuildModeImage}.draft(detected)
+	_, err = Answers{BuildMode: manifest.BuildModeImage}.draft(context.Background(), detected)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "--image is required")
 }
@@ -194,7 +195,7 @@ func TestAnswers_GeneratedMode(t *testing.T) {
 		BuildMode:            manifest.BuildModeGenerated,
 		ExecutionEnvironment: "[DataRobot] Python 3.12 Applications Base",
 		Entrypoint:           `uvicorn app:app --host "0.0.0.0"`,
-	}.draft(detected)
+	}.draft(context.Background(), detected)
 	require.NoError(t, err)
 
 	assert.Equal(t, "68a1", draft.Build.ExecutionEnvironmentID)
@@ -203,7 +204,7 @@ func TestAnswers_GeneratedMode(t *testing.T) {
 }
 
 func TestAnswers_GeneratedModeMissingFlags(t *testing.T) {
-	_, err := Answers{BuildMode: manifest.BuildModeGenerated}.draft(Detect(t.TempDir()))
+	_, err := Answers{BuildMode: manifest.BuildModeGenerated}.draft(context.Background(), Detect(t.TempDir()))
 	require.Error(t, err)
 
 	assert.Contains(t, err.Error(), "--execution-environment and --entrypoint are required")
@@ -218,7 +219,7 @@ func TestAnswers_GeneratedModeUnknownEnvironment(t *testing.T) {
 		BuildMode:            manifest.BuildModeGenerated,
 		ExecutionEnvironment: "nope",
 		Entrypoint:           "python main.py",
-	}.draft(Detect(t.TempDir()))
+	}.draft(context.Background(), Detect(t.TempDir()))
 
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "not found")
@@ -226,7 +227,7 @@ func TestAnswers_GeneratedModeUnknownEnvironment(t *testing.T) {
 
 // A Dockerfile track asked for explicitly still needs a Dockerfile.
 func TestAnswers_DockerfileModeWithoutOne(t *testing.T) {
-	_, err := Answers{BuildMode: manifest.BuildModeDockerfile}.draft(Detect(t.TempDir()))
+	_, err := Answers{BuildMode: manifest.BuildModeDockerfile}.draft(context.Background(), Detect(t.TempDir()))
 	require.Error(t, err)
 
 	assert.Contains(t, err.Error(), "needs a Dockerfile")
@@ -248,7 +249,7 @@ func TestAnswers_ApplyToKeepsLiveDefaults(t *testing.T) {
 	// mode on its own.
 	detected := Detect(writeDockerfile(t, t.TempDir(), "FROM scratch\n"))
 
-	draft, err := Answers{Port: 8081}.applyTo(defaults, detected)
+	draft, err := Answers{Port: 8081}.applyTo(context.Background(), defaults, detected)
 	require.NoError(t, err)
 
 	assert.Equal(t, 8081, draft.Port)
@@ -258,7 +259,7 @@ func TestAnswers_ApplyToKeepsLiveDefaults(t *testing.T) {
 	assert.Equal(t, manifest.BuildModeImage, draft.Build.Mode)
 
 	// An explicit build flag does switch it.
-	draft, err = Answers{BuildMode: manifest.BuildModeDockerfile}.applyTo(defaults, detected)
+	draft, err = Answers{BuildMode: manifest.BuildModeDockerfile}.applyTo(context.Background(), defaults, detected)
 	require.NoError(t, err)
 	assert.Equal(t, manifest.BuildModeDockerfile, draft.Build.Mode)
 }
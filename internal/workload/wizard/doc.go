@@ -38,6 +38,12 @@
 // in as literals; a secret becomes a credential reference with a placeholder
 // id, since creating the credential needs an API the CLI does not have yet.
 //
+// Export is the same bind with no questions and no project, run over many
+// workloads at once for `dr workload init --from-live`. It differs from a
+// bind in one respect: a live value that looks like a secret is stored as a
+// credential and referenced, because nobody reads a few dozen exported files
+// line by line before committing them.
+//
 // Non-scope: the manifest format itself (that is the manifest package, which
 // renders, validates and writes the file), creating the credentials those
 // placeholders stand in for, and the NIM track.
@@ -0,0 +1,389 @@
+// Copyright 2026 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wizard
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/datarobot/cli/internal/fsutil"
+	"github.com/datarobot/cli/internal/workload"
+	"github.com/datarobot/cli/internal/workload/manifest"
+)
+
+// ActionFailed means the workload could not be exported; Exported.Err says
+// why. The others ran regardless, so one bad workload never costs the rest.
+const ActionFailed = "failed"
+
+// ExportOptions is one `dr workload init --from-live` run.
+type ExportOptions struct {
+	// WorkloadIDs are the workloads to export. Empty means every workload the
+	// filters below select.
+	WorkloadIDs []string
+	// Statuses and Enclave narrow the listing the way `dr workload list` does.
+	Statuses []string
+	Enclave  string
+	// Name is a shell pattern matched against each listed workload's name.
+	// The API has no name filter, so it is applied to the listing here.
+	Name string
+	// Limit caps the listing.
+	Limit int
+	// OutDir is the root of the tree: each workload gets a directory of its
+	// own beneath it, named after the workload.
+	OutDir string
+	// StoreSecrets stores each value that looks like a secret as a
+	// credential and writes a reference to it. Off, the reference carries the
+	// placeholder and the value goes nowhere.
+	StoreSecrets bool
+	// DryRun renders every manifest and writes, and stores, nothing.
+	DryRun bool
+}
+
+// Exported is what became of one workload.
+type Exported struct {
+	WorkloadID string
+	Name       string
+	// Path is the manifest, written, planned or already present. Empty when
+	// the export failed before a directory was chosen.
+	Path string
+	// Action is ActionCreated, ActionUnchanged, ActionPlanned or ActionFailed.
+	Action string
+	// Content is the manifest as written, or as it would be under DryRun.
+	Content []byte
+	// Secrets are the variables written as credential references rather than
+	// as the literals the workload declares, as container/NAME.
+	Secrets []string
+	// Import is what storing those secrets did. A secret in neither of its
+	// lists was not sent anywhere and carries the placeholder.
+	Import Import
+	Err    error
+}
+
+// Pending counts the references still carrying the placeholder, which is
+// what a deploy from the file would refuse.
+func (e Exported) Pending() int {
+	return len(e.Secrets) - len(e.Import.Stored)
+}
+
+// Export writes a manifest for each selected workload from the workload's
+// own live spec, into a tree meant to be committed as the source of truth.
+//
+// It is the bind flow with no questions and no project. The spec is copied
+// as the platform serves it, minus what only the server may set, and the one
+// thing changed on the way is what would otherwise go to git in the clear:
+// a literal value ClassifyEnv calls a secret becomes a credential reference.
+// That is the opposite of what a bind does with the same value, and
+// deliberately so. A bind edits a file the user is about to read on the
+// confirm screen; an export writes dozens nobody will read line by line
+// before the commit.
+//
+// An existing manifest is never overwritten, so re-running an export over
+// its own tree picks up only the workloads created since. The error is for
+// the selection alone; each workload's own failure is on its Exported.
+func Export(ctx context.Context, opts ExportOptions) ([]Exported, error) {
+	root, err := filepath.Abs(opts.OutDir)
+	if err != nil {
+		return nil, fmt.Errorf("cannot resolve %s: %w", opts.OutDir, err)
+	}
+
+	selected, err := selectWorkloads(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// Everything is read before anything is named, because a workload
+	// selected by id has no name until it is, and a directory's name must not
+	// depend on which workloads happened to be read first.
+	results := make([]Exported, len(selected))
+	lives := make([]manifest.Live, 0, len(selected))
+
+	for i, w := range selected {
+		results[i] = Exported{WorkloadID: w.ID, Name: w.Name}
+
+		live, err := readLive(ctx, w.ID)
+		if err != nil {
+			results[i].Action, results[i].Err = ActionFailed, err
+
+			continue
+		}
+
+		results[i].Name = live.Name
+		lives = append(lives, live)
+	}
+
+	dirs := exportDirs(lives)
+
+	for i := range results {
+		if results[i].Err != nil {
+			continue
+		}
+
+		live := lives[0]
+		lives = lives[1:]
+
+		results[i] = exportOne(ctx, live, filepath.Join(root, dirs[live.WorkloadID]), opts)
+		if results[i].Err != nil {
+			results[i].Action = ActionFailed
+		}
+	}
+
+	return results, nil
+}
+
+// selectWorkloads resolves the options to the workloads to export. Ids are
+// taken as given, and a typo fails on its own workload rather than here, so
+// the listing is only read when no id was.
+func selectWorkloads(ctx context.Context, opts ExportOptions) ([]workload.Workload, error) {
+	if len(opts.WorkloadIDs) > 0 {
+		selected := make([]workload.Workload, 0, len(opts.WorkloadIDs))
+
+		for _, id := range opts.WorkloadIDs {
+			if !slices.ContainsFunc(selected, func(w workload.Workload) bool { return w.ID == id }) {
+				selected = append(selected, workload.Workload{ID: id})
+			}
+		}
+
+		return selected, nil
+	}
+
+	listed, err := listWorkloadsFn(ctx, opts.Limit, opts.Statuses, opts.Enclave)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list workloads: %w", err)
+	}
+
+	if opts.Name == "" {
+		return listed, nil
+	}
+
+	if _, err := filepath.Match(opts.Name, ""); err != nil {
+		return nil, fmt.Errorf("invalid name pattern %q: %w", opts.Name, err)
+	}
+
+	matched := make([]workload.Workload, 0, len(listed))
+
+	for _, w := range listed {
+		if ok, _ := filepath.Match(opts.Name, w.Name); ok {
+			matched = append(matched, w)
+		}
+	}
+
+	return matched, nil
+}
+
+// exportDirs names each workload's directory. Names are not unique on the
+// platform, so a name two workloads share gets the id's tail on every one of
+// them rather than on whichever was read second.
+func exportDirs(lives []manifest.Live) map[string]string {
+	taken := make(map[string]int, len(lives))
+
+	for _, live := range lives {
+		taken[sanitizeName(live.Name)]++
+	}
+
+	dirs := make(map[string]string, len(lives))
+
+	for _, live := range lives {
+		dir := sanitizeName(live.Name)
+		if taken[dir] > 1 {
+			dir += "-" + shortID(live.WorkloadID)
+		}
+
+		dirs[live.WorkloadID] = dir
+	}
+
+	return dirs
+}
+
+// shortID is the tail of an id, which is the part that differs between two
+// created in the same second.
+func shortID(id string) string {
+	const keep = 8
+
+	if len(id) <= keep {
+		return id
+	}
+
+	return id[len(id)-keep:]
+}
+
+// exportOne writes one workload's manifest into dir.
+//
+// The order matters for the credential store. Everything that can refuse the
+// export runs before the first secret is sent, so a workload whose file is
+// already there, or whose spec this release cannot write, never leaves a
+// credential behind that no file references.
+func exportOne(ctx context.Context, live manifest.Live, dir string, opts ExportOptions) Exported {
+	result := Exported{WorkloadID: live.WorkloadID, Name: live.Name, Path: manifest.Path(dir)}
+
+	if fsutil.FileExists(result.Path) {
+		result.Action = ActionUnchanged
+
+		return result
+	}
+
+	secrets := liveSecrets(live)
+	for _, v := range secrets {
+		result.Secrets = append(result.Secrets, v.Container+"/"+v.Name)
+	}
+
+	// Rendered and checked with every secret on the placeholder first. The
+	// placeholder is a valid reference, so a file that fails here fails for
+	// a reason no credential id would fix.
+	for _, v := range secrets {
+		live.ReferenceCredential(v.Container, v.Name, "")
+	}
+
+	content, err := renderLive(live, dir)
+	if err != nil {
+		result.Err = err
+
+		return result
+	}
+
+	if opts.StoreSecrets && !opts.DryRun && len(secrets) > 0 {
+		result.Import = storeLiveSecrets(ctx, live, secrets)
+
+		if content, err = renderLive(live, dir); err != nil {
+			result.Err = err
+
+			return result
+		}
+	}
+
+	result.Content = content
+
+	if opts.DryRun {
+		result.Action = ActionPlanned
+
+		return result
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		result.Err = fmt.Errorf("cannot create %s: %w", dir, err)
+
+		return result
+	}
+
+	if err := manifest.Write(result.Path, content); err != nil {
+		result.Err = err
+
+		return result
+	}
+
+	result.Action = ActionCreated
+
+	return result
+}
+
+// readLive downloads a workload and the artifact it runs, worded for an
+// export rather than a bind: there is no new workload to suggest instead.
+func readLive(ctx context.Context, workloadID string) (manifest.Live, error) {
+	workloadDoc, err := getWorkloadFn(ctx, workloadID)
+	if err != nil {
+		return manifest.Live{}, fmt.Errorf("cannot read workload %s: %w", workloadID, err)
+	}
+
+	artifactID := workloadDoc.String("artifactId")
+	if artifactID == "" {
+		return manifest.Live{}, fmt.Errorf("workload %s has no artifact, so there is no spec to export", workloadID)
+	}
+
+	artifactDoc, err := getArtifactFn(ctx, artifactID)
+	if err != nil {
+		return manifest.Live{}, fmt.Errorf("cannot read the artifact of workload %s: %w", workloadID, err)
+	}
+
+	return manifest.NewLive(workloadID, workloadDoc, artifactDoc), nil
+}
+
+// renderLive renders the live view and checks the result the way a bind
+// does, so an export never leaves a file the CLI would refuse to read.
+func renderLive(live manifest.Live, dir string) ([]byte, error) {
+	content, err := live.Render()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkRendered(content, dir, authorLive); err != nil {
+		return nil, err
+	}
+
+	return content, nil
+}
+
+// liveSecrets is the literal values ClassifyEnv calls secrets, in every
+// container. A value it calls local stays as it is: on a running workload it
+// is what the container runs with, whatever it would mean in a .env.
+func liveSecrets(live manifest.Live) []manifest.ContainerEnvVar {
+	var secrets []manifest.ContainerEnvVar
+
+	for _, v := range live.ContainerLiteralEnvVars() {
+		if ClassifyEnv(v.Name, v.Value).Kind == EnvSecret {
+			secrets = append(secrets, v)
+		}
+	}
+
+	return secrets
+}
+
+// storeLiveSecrets stores each secret and points its reference at the
+// credential. One failing does not fail the export, for the reason it does
+// not fail setup: the reference keeps its placeholder, which a deploy refuses
+// by name.
+//
+// The credential is named after the workload, as setup names it. A name two
+// containers both declare with different values needs two credentials, so
+// the second is named after its container as well; with the same value they
+// share one.
+func storeLiveSecrets(ctx context.Context, live manifest.Live, secrets []manifest.ContainerEnvVar) Import {
+	type stored struct{ value, id string }
+
+	var report Import
+
+	byName := make(map[string]stored, len(secrets))
+
+	for _, v := range secrets {
+		label := v.Container + "/" + v.Name
+		name := CredentialName(live.Name, v.Name)
+
+		if earlier, ok := byName[name]; ok {
+			if earlier.value == v.Value {
+				live.ReferenceCredential(v.Container, v.Name, earlier.id)
+				report.Stored = append(report.Stored, label)
+
+				continue
+			}
+
+			name = CredentialName(live.Name+"/"+v.Container, v.Name)
+		}
+
+		cred, err := createCredentialFn(ctx, name, v.Value)
+		if err != nil {
+			report.Failed = append(report.Failed, ImportFailure{Name: label, Reason: importReason(err, name)})
+
+			continue
+		}
+
+		byName[name] = stored{value: v.Value, id: cred.CredentialID}
+
+		live.ReferenceCredential(v.Container, v.Name, cred.CredentialID)
+		report.Stored = append(report.Stored, label)
+	}
+
+	return report
+}
@@ -15,6 +15,8 @@
 package wizard
 
 import (
+	"context"
+
 	"github.com/datarobot/cli/internal/workload"
 	"github.com/datarobot/cli/internal/workload/manifest"
 )
@@ -42,7 +44,7 @@ const execEnvPickLimit = 200
 // Every answer the flags did give survives, including the ones no screen asks
 // about (the runtime sizing) and the binding, which would otherwise be lost
 // and turn a bind into a create.
-func (a Answers) partialDraft(detected Detected) manifest.Draft {
+func (a Answers) partialDraft(ctx context.Context, detected Detected) manifest.Draft {
 	kind := manifest.ArtifactTypeOrDefault(a.Type)
 
 	draft := manifest.Draft{
@@ -63,7 +65,7 @@ func (a Answers) partialDraft(detected Detected) manifest.Draft {
 
 	// A build the flags cannot complete is left to the source screen, which
 	// opens on whatever the project suggests.
-	if build, err := a.build(detected); err == nil {
+	if build, err := a.build(ctx, detected); err == nil {
 		draft.Build = build
 	} else {
 		draft.Build = defaultBuild(detected)
@@ -78,7 +80,7 @@ func (a Answers) partialDraft(detected Detected) manifest.Draft {
 // the headless bind does; a build the flags cannot complete leaves the live
 // build alone, because the source screen is where that gets answered and it
 // opens on what the workload already does.
-func (a Answers) partialApplyTo(defaults manifest.Draft, detected Detected) manifest.Draft {
+func (a Answers) partialApplyTo(ctx context.Context, defaults manifest.Draft, detected Detected) manifest.Draft {
 	draft := defaults
 
 	if a.Name != "" {
@@ -94,7 +96,7 @@ func (a Answers) partialApplyTo(defaults manifest.Draft, detected Detected) mani
 	// carry, and Apply would drop it anyway.
 	draft.A2AEnabled = draft.A2AEnabled && draft.Type == manifest.TypeAgent
 
-	if err := a.applyBuild(&draft, detected); err != nil {
+	if err := a.applyBuild(ctx, &draft, detected); err != nil {
 		draft.Build = defaults.Build
 	}
 
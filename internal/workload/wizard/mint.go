@@ -15,6 +15,7 @@
 package wizard
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -68,7 +69,7 @@ func (i Import) Any() bool {
 // placeholder, which is an entry a deploy refuses by name, so the worst case
 // is the state this whole feature started from rather than a wizard that
 // leaves nothing on disk after asking eight questions.
-func importSecrets(vars []manifest.EnvVar, detected Detected, workloadName string) ([]manifest.EnvVar, Import) {
+func importSecrets(ctx context.Context, vars []manifest.EnvVar, detected Detected, workloadName string) ([]manifest.EnvVar, Import) {
 	values := secretValues(detected)
 	out := make([]manifest.EnvVar, len(vars))
 	copy(out, vars)
@@ -94,7 +95,7 @@ func importSecrets(vars []manifest.EnvVar, detected Detected, workloadName strin
 
 		name := CredentialName(workloadName, v.Name)
 
-		cred, err := createCredentialFn(name, value)
+		cred, err := createCredentialFn(ctx, name, value)
 		if err != nil {
 			report.Failed = append(report.Failed, ImportFailure{Name: v.Name, Reason: importReason(err, name)})
 
@@ -15,6 +15,7 @@
 package wizard
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -47,7 +48,7 @@ func credentialStore(t *testing.T) *[]storedCredential {
 	stored := make([]storedCredential, 0)
 
 	original := createCredentialFn
-	createCredentialFn = func(name, value string) (*workload.Credential, error) {
+	createCredentialFn = func(_ context.Context, name, value string) (*workload.Credential, error) {
 		stored = append(stored, storedCredential{Name: name, Value: value})
 
 		return &workload.Credential{CredentialID: "66f0000000000000000000" + itoa(len(stored)), Name: name}, nil
@@ -63,7 +64,7 @@ func noCredentialStore(t *testing.T, err error) {
 	t.Helper()
 
 	original := createCredentialFn
-	createCredentialFn = func(string, string) (*workload.Credential, error) { return nil, err }
+	createCredentialFn = func(context.Context, string, string) (*workload.Credential, error) { return nil, err }
 
 	t.Cleanup(func() { createCredentialFn = original })
 }
@@ -92,7 +93,7 @@ func TestRun_SecretsBecomeCredentials(t *testing.T) {
 	stored := credentialStore(t)
 	dir := envProject(t)
 
-	result, err := Run(headless(dir, Answers{Name: "my-app"}))
+	result, err := Run(context.Background(), headless(dir, Answers{Name: "my-app"}))
 	require.NoError(t, err)
 
 	require.Len(t, *stored, 1, "only the secret is stored; an ordinary setting is a literal")
@@ -120,7 +121,7 @@ func TestRun_UnreachableStoreLeavesThePlaceholder(t *testing.T) {
 	opts := headless(dir, Answers{Name: "my-app"})
 	opts.Stderr = &stderr
 
-	result, err := Run(opts)
+	result, err := Run(context.Background(), opts)
 	require.NoError(t, err, "one secret failing must not throw away eight answered questions")
 
 	assert.Contains(t, string(result.Content), "dr-credential:PLACEHOLDER/apiToken")
@@ -144,7 +145,7 @@ func TestRun_TakenCredentialNameSaysHowToFinish(t *testing.T) {
 	opts := headless(dir, Answers{Name: "my-app"})
 	opts.Stderr = &stderr
 
-	_, err := Run(opts)
+	_, err := Run(context.Background(), opts)
 	require.NoError(t, err)
 
 	assert.Contains(t, stderr.String(), "already exists")
@@ -160,7 +161,7 @@ func TestRun_DryRunStoresNothing(t *testing.T) {
 	opts := headless(dir, Answers{Name: "my-app"})
 	opts.DryRun = true
 
-	result, err := Run(opts)
+	result, err := Run(context.Background(), opts)
 	require.NoError(t, err)
 
 	assert.Empty(t, *stored, "a dry run must not leave a credential behind")
@@ -173,7 +174,7 @@ func TestRun_SkipEnvStoresNothing(t *testing.T) {
 	stored := credentialStore(t)
 	dir := envProject(t)
 
-	_, err := Run(headless(dir, Answers{Name: "my-app", SkipEnv: true}))
+	_, err := Run(context.Background(), headless(dir, Answers{Name: "my-app", SkipEnv: true}))
 	require.NoError(t, err)
 
 	assert.Empty(t, *stored)
@@ -186,6 +187,7 @@ func TestImportSecrets_NoValueIsReportedNotSent(t *testing.T) {
 	stored := credentialStore(t)
 
 	vars, report := importSecrets(
+		context.Background(),
 		[]manifest.EnvVar{{Name: "API_TOKEN", Secret: true}},
 		Detected{EnvVars: []EnvVar{{Name: "API_TOKEN", Kind: EnvSecret, Value: ""}}},
 		"my-app",
@@ -204,6 +206,7 @@ func TestImportSecrets_AlreadyStoredIsLeftAlone(t *testing.T) {
 	stored := credentialStore(t)
 
 	vars, report := importSecrets(
+		context.Background(),
 		[]manifest.EnvVar{{Name: "API_TOKEN", Secret: true, CredentialID: "66f0aaaa0000000000000001"}},
 		Detected{EnvVars: []EnvVar{{Name: "API_TOKEN", Kind: EnvSecret, Value: "shhh"}}},
 		"my-app",
@@ -232,7 +235,7 @@ func TestFlow_ConfirmStoresTheSecrets(t *testing.T) {
 		[]byte("OPENAI_API_KEY=sk-abcdefghijklmnopqrstuvwxyz01\n"), 0o600))
 
 	// Name, kind, source, readiness, env: the confirm screen is next.
-	model := press(t, pastName(t, newFlow(Detect(dir), nil, Answers{})), "enter", "enter", "enter", "enter")
+	model := press(t, pastName(t, newFlow(context.Background(), Detect(dir), nil, Answers{})), "enter", "enter", "enter", "enter")
 	require.Equal(t, screenConfirm, model.at)
 	assert.Contains(t, string(model.content), "PLACEHOLDER", "nothing has been stored while it can still be cancelled")
 
@@ -254,7 +257,7 @@ func TestFlow_CancelStoresNothing(t *testing.T) {
 	require.NoError(t, os.WriteFile(filepath.Join(dir, EnvFileName),
 		[]byte("OPENAI_API_KEY=sk-abcdefghijklmnopqrstuvwxyz01\n"), 0o600))
 
-	model := press(t, pastName(t, newFlow(Detect(dir), nil, Answers{})), "enter", "enter", "enter", "enter")
+	model := press(t, pastName(t, newFlow(context.Background(), Detect(dir), nil, Answers{})), "enter", "enter", "enter", "enter")
 	require.Equal(t, screenConfirm, model.at)
 
 	_, _, err := press(t, model, "esc").result()
@@ -272,7 +275,7 @@ func TestFlow_DryRunStoresNothing(t *testing.T) {
 	require.NoError(t, os.WriteFile(filepath.Join(dir, EnvFileName),
 		[]byte("OPENAI_API_KEY=sk-abcdefghijklmnopqrstuvwxyz01\n"), 0o600))
 
-	start := newFlow(Detect(dir), nil, Answers{})
+	start := newFlow(context.Background(), Detect(dir), nil, Answers{})
 	start.dryRun = true
 
 	model := press(t, pastName(t, start), "enter", "enter", "enter", "enter")
@@ -296,7 +299,7 @@ func TestFlow_ConfirmKeepsAHandEditWhenSecretsAreStored(t *testing.T) {
 	require.NoError(t, os.WriteFile(filepath.Join(dir, EnvFileName),
 		[]byte("OPENAI_API_KEY=sk-abcdefghijklmnopqrstuvwxyz01\n"), 0o600))
 
-	model := press(t, pastName(t, newFlow(Detect(dir), nil, Answers{})), "enter", "enter", "enter", "enter")
+	model := press(t, pastName(t, newFlow(context.Background(), Detect(dir), nil, Answers{})), "enter", "enter", "enter", "enter")
 	require.Equal(t, screenConfirm, model.at)
 
 	// What the editor left: the rendered file with one line the wizard would
@@ -15,6 +15,7 @@
 package wizard
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math"
@@ -49,6 +50,9 @@ const (
 // flow is the wizard: one model for every screen, because the screens share
 // one answer set and back-navigation between them has to be free.
 type flow struct {
+	// ctx bounds the API calls the screens start: the workload fetch on
+	// bind, the base-image list and the credential import.
+	ctx       context.Context //nolint:containedctx // a tea.Model has no other way to carry it into its commands
 	detected  Detected
 	workloads []workload.Workload
 	// pendingBind is a workload id a flag named, fetched by Init before the
@@ -155,15 +159,15 @@ type secretsStoredMsg struct {
 	report Import
 }
 
-func newFlow(detected Detected, workloads []workload.Workload, answers Answers) flow {
-	f := flow{detected: detected, workloads: workloads, answers: answers, pendingBind: answers.WorkloadID}
+func newFlow(ctx context.Context, detected Detected, workloads []workload.Workload, answers Answers) flow {
+	f := flow{ctx: ctx, detected: detected, workloads: workloads, answers: answers, pendingBind: answers.WorkloadID}
 
-	draft, err := answers.draft(detected)
+	draft, err := answers.draft(ctx, detected)
 	if err != nil {
 		// Interactively an unanswerable flag set is not fatal: the screens
 		// exist to answer exactly this. Everything the flags did settle is
 		// kept.
-		draft = answers.partialDraft(detected)
+		draft = answers.partialDraft(ctx, detected)
 	}
 
 	f.draft = draft
@@ -189,8 +193,8 @@ func newFlow(detected Detected, workloads []workload.Workload, answers Answers)
 
 // defaultDraft is where a run starts when nothing else has been said: the
 // project's own suggestions and the documented defaults.
-func defaultDraft(detected Detected) manifest.Draft {
-	return Answers{}.partialDraft(detected)
+func defaultDraft(ctx context.Context, detected Detected) manifest.Draft {
+	return Answers{}.partialDraft(ctx, detected)
 }
 
 // first picks the opening screen. Binding is skipped when there is nothing to
@@ -214,7 +218,7 @@ func (f flow) Init() tea.Cmd {
 		return nil
 	}
 
-	return fetchLiveCmd(f.pendingBind)
+	return fetchLiveCmd(f.ctx, f.pendingBind)
 }
 
 // resizeComponents refits whatever has been built to the terminal's real
@@ -234,9 +238,9 @@ func (f *flow) resizeComponents() {
 }
 
 // fetchLiveCmd downloads a workload so the file can say everything it says.
-func fetchLiveCmd(workloadID string) tea.Cmd {
+func fetchLiveCmd(ctx context.Context, workloadID string) tea.Cmd {
 	return func() tea.Msg {
-		live, err := fetchLive(workloadID)
+		live, err := fetchLive(ctx, workloadID)
 
 		return liveLoadedMsg{live: live, err: err}
 	}
@@ -530,10 +534,10 @@ func (f *flow) acceptConfirm() (tea.Cmd, error) {
 
 	f.loading = "Storing secrets…"
 
-	vars, detected, name := f.draft.EnvVars, f.detected, f.draft.Name
+	ctx, vars, detected, name := f.ctx, f.draft.EnvVars, f.detected, f.draft.Name
 
 	return func() tea.Msg {
-		stored, report := importSecrets(vars, detected, name)
+		stored, report := importSecrets(ctx, vars, detected, name)
 
 		return secretsStoredMsg{vars: stored, report: report}
 	}, nil
@@ -670,7 +674,7 @@ func (f *flow) acceptBinding() (tea.Cmd, error) {
 		// one must not prefill a copy of it.
 		if f.live != nil {
 			f.live = nil
-			f.draft = defaultDraft(f.detected)
+			f.draft = defaultDraft(f.ctx, f.detected)
 		}
 
 		f.draft.WorkloadID = ""
@@ -682,7 +686,7 @@ func (f *flow) acceptBinding() (tea.Cmd, error) {
 	// everything the running workload says, and only the server knows that.
 	f.loading = "Reading " + item.name + "…"
 
-	return fetchLiveCmd(item.id), nil
+	return fetchLiveCmd(f.ctx, item.id), nil
 }
 
 // acceptName records the name, which has to be typed. There is no default:
@@ -849,9 +853,10 @@ func (f *flow) onEnter(at screen) tea.Cmd {
 		}
 
 		f.loading = "Loading base images…"
+		ctx := f.ctx
 
 		return func() tea.Msg {
-			environments, err := listExecEnvsFn(execEnvPickLimit)
+			environments, err := listExecEnvsFn(ctx, execEnvPickLimit)
 
 			return execEnvsLoadedMsg{environments: environments, err: err}
 		}
@@ -946,7 +951,7 @@ func (f flow) liveLoaded(msg liveLoadedMsg) (tea.Model, tea.Cmd) {
 	// --replicas and --memory were given before the fetch and still stand.
 	// Replacing the draft outright would drop every flag the wizard has no
 	// screen to re-ask, which is the same layering the headless bind does.
-	f.draft = f.answers.partialApplyTo(live.Defaults(), f.detected)
+	f.draft = f.answers.partialApplyTo(f.ctx, live.Defaults(), f.detected)
 	f.pendingBind = ""
 
 	// Only the binding screen has somewhere to go back to; a flag-driven bind
@@ -15,6 +15,7 @@
 package wizard
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -106,7 +107,7 @@ func dockerfileProject(t *testing.T) Detected {
 // With no workloads to bind to there is no binding question, and once the
 // workload is named the Dockerfile path is Enter on every remaining screen.
 func TestFlow_DockerfileHappyPathIsAllEnterAfterTheName(t *testing.T) {
-	model := newFlow(dockerfileProject(t), nil, Answers{})
+	model := newFlow(context.Background(), dockerfileProject(t), nil, Answers{})
 	require.Equal(t, screenName, model.at)
 
 	model = pastName(t, model)
@@ -164,7 +165,7 @@ func TestEditorCommand_FallsBackWhenNothingIsConfigured(t *testing.T) {
 // is a zero value that renders as a blank row and explains nothing, so that
 // half is checked here.
 func TestFlow_EverySettingsFieldIsWiredUp(t *testing.T) {
-	model := press(t, pastName(t, newFlow(dockerfileProject(t), nil, Answers{})), "enter", "enter")
+	model := press(t, pastName(t, newFlow(context.Background(), dockerfileProject(t), nil, Answers{})), "enter", "enter")
 	require.Equal(t, screenSettings, model.at)
 
 	require.Len(t, model.inputs, settingsFieldCount, "one input per field, in the order the fields are named")
@@ -184,7 +185,7 @@ func TestFlow_EverySettingsFieldIsWiredUp(t *testing.T) {
 func TestFlow_BindingScreenLeadsWhenThereAreWorkloads(t *testing.T) {
 	workloads := []workload.Workload{{ID: "68b0", Name: "triage-agent", Status: "running", UpdatedAt: time.Now()}}
 
-	model := newFlow(dockerfileProject(t), workloads, Answers{})
+	model := newFlow(context.Background(), dockerfileProject(t), workloads, Answers{})
 	assert.Equal(t, screenBinding, model.at)
 
 	// The pinned row leads, so Enter means "create a new workload".
@@ -204,7 +205,7 @@ func TestFlow_BindingDownloadsTheLiveSpec(t *testing.T) {
 				 "readinessProbe": {"path": "/alive", "port": 9001}}]}]}}`))
 
 	workloads := []workload.Workload{{ID: "68b0", Name: "triage-agent", Status: "running", UpdatedAt: time.Now()}}
-	model := newFlow(dockerfileProject(t), workloads, Answers{})
+	model := newFlow(context.Background(), dockerfileProject(t), workloads, Answers{})
 
 	model = press(t, model, "down", "enter")
 
@@ -218,7 +219,7 @@ func TestFlow_BindingDownloadsTheLiveSpec(t *testing.T) {
 }
 
 func TestFlow_AgentAsksAboutA2A(t *testing.T) {
-	model := newFlow(dockerfileProject(t), nil, Answers{})
+	model := newFlow(context.Background(), dockerfileProject(t), nil, Answers{})
 
 	model = pastName(t, model)
 	model = press(t, model, "2") // agent
@@ -235,7 +236,7 @@ func TestFlow_AgentAsksAboutA2A(t *testing.T) {
 // Going back returns the answer that was given, not a blank screen, and
 // skipped screens stay skipped in both directions.
 func TestFlow_BackNavigation(t *testing.T) {
-	model := newFlow(dockerfileProject(t), nil, Answers{})
+	model := newFlow(context.Background(), dockerfileProject(t), nil, Answers{})
 
 	model = typeInto(t, model, "chosen-name")
 	model = press(t, model, "enter", "enter") // name, kind
@@ -253,7 +254,7 @@ func TestFlow_BackNavigation(t *testing.T) {
 // esc on the first screen is the same "write nothing" the confirm screen
 // offers, rather than a dead end.
 func TestFlow_EscOnTheFirstScreenCancels(t *testing.T) {
-	model := press(t, newFlow(dockerfileProject(t), nil, Answers{}), "esc")
+	model := press(t, newFlow(context.Background(), dockerfileProject(t), nil, Answers{}), "esc")
 
 	assert.True(t, model.cancelled)
 
@@ -266,7 +267,7 @@ func TestFlow_EscOnTheFirstScreenCancels(t *testing.T) {
 // enough (src, app, cli) that accepting it by reflex would put that name on a
 // deployed workload.
 func TestFlow_NameMustBeTyped(t *testing.T) {
-	model := newFlow(dockerfileProject(t), nil, Answers{})
+	model := newFlow(context.Background(), dockerfileProject(t), nil, Answers{})
 	require.Equal(t, screenName, model.at)
 
 	assert.Empty(t, model.inputs[0].Value(), "nothing to delete before typing")
@@ -290,7 +291,7 @@ func TestFlow_NameMustBeTyped(t *testing.T) {
 
 // A name the user actually gave is a value, and comes back as one.
 func TestFlow_TypedNameComesBackAsAValue(t *testing.T) {
-	model := newFlow(dockerfileProject(t), nil, Answers{})
+	model := newFlow(context.Background(), dockerfileProject(t), nil, Answers{})
 
 	model = typeInto(t, model, "chosen-name")
 	model = press(t, model, "enter")
@@ -326,7 +327,7 @@ func TestFlow_SettingsRules(t *testing.T) {
 
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
-			model := newFlow(dockerfileProject(t), nil, Answers{})
+			model := newFlow(context.Background(), dockerfileProject(t), nil, Answers{})
 			model = press(t, pastName(t, model), "enter", "enter")
 			require.Equal(t, screenSettings, model.at)
 
@@ -346,7 +347,7 @@ func TestFlow_SettingsRules(t *testing.T) {
 
 // The sizing fields reach the file, which is the point of asking for them.
 func TestFlow_SettingsSizingReachesTheFile(t *testing.T) {
-	model := newFlow(dockerfileProject(t), nil, Answers{})
+	model := newFlow(context.Background(), dockerfileProject(t), nil, Answers{})
 	model = press(t, pastName(t, model), "enter", "enter")
 	require.Equal(t, screenSettings, model.at)
 
@@ -371,7 +372,7 @@ func TestFlow_SettingsSizingReachesTheFile(t *testing.T) {
 
 // Coming back shows the answers given here, not the defaults again.
 func TestFlow_SettingsSurviveBackNavigation(t *testing.T) {
-	model := newFlow(dockerfileProject(t), nil, Answers{})
+	model := newFlow(context.Background(), dockerfileProject(t), nil, Answers{})
 	model = press(t, pastName(t, model), "enter", "enter")
 
 	model.inputs[2].SetValue("7")
@@ -386,7 +387,7 @@ func TestFlow_SettingsSurviveBackNavigation(t *testing.T) {
 // Offering to build a Dockerfile that is not there would only fail later, so
 // the screen says so now.
 func TestFlow_DockerfileSourceNeedsADockerfile(t *testing.T) {
-	model := newFlow(Detect(t.TempDir()), nil, Answers{})
+	model := newFlow(context.Background(), Detect(t.TempDir()), nil, Answers{})
 
 	model = press(t, pastName(t, model), "enter") // name, kind
 	require.Equal(t, screenSource, model.at)
@@ -400,7 +401,7 @@ func TestFlow_DockerfileSourceNeedsADockerfile(t *testing.T) {
 
 // With no Dockerfile the published-image option leads instead.
 func TestFlow_PublishedImageLeadsWithoutADockerfile(t *testing.T) {
-	model := newFlow(Detect(t.TempDir()), nil, Answers{})
+	model := newFlow(context.Background(), Detect(t.TempDir()), nil, Answers{})
 
 	assert.Equal(t, manifest.BuildModeImage, model.draft.Build.Mode)
 
@@ -420,7 +421,7 @@ func TestFlow_PublishedImageLeadsWithoutADockerfile(t *testing.T) {
 
 func TestFlow_GeneratedBuildPicksABaseImage(t *testing.T) {
 	original := listExecEnvsFn
-	listExecEnvsFn = func(int) ([]workload.ExecutionEnvironment, error) {
+	listExecEnvsFn = func(context.Context, int) ([]workload.ExecutionEnvironment, error) {
 		return []workload.ExecutionEnvironment{
 			{ID: "68a1", Name: "[DataRobot] Python 3.12", LatestSuccessfulVersion: &workload.EEVersion{ID: "68a2"}},
 		}, nil
@@ -428,7 +429,7 @@ func TestFlow_GeneratedBuildPicksABaseImage(t *testing.T) {
 
 	t.Cleanup(func() { listExecEnvsFn = original })
 
-	model := newFlow(dockerfileProject(t), nil, Answers{})
+	model := newFlow(context.Background(), dockerfileProject(t), nil, Answers{})
 
 	model = press(t, pastName(t, model), "enter") // name, kind
 	model = press(t, model, "2", "enter")         // build from a base image
@@ -451,7 +452,7 @@ func TestFlow_GeneratedBuildPicksABaseImage(t *testing.T) {
 
 // Confirming is the only thing that ends the flow with something to write.
 func TestFlow_ConfirmProducesTheManifest(t *testing.T) {
-	model := newFlow(dockerfileProject(t), nil, Answers{})
+	model := newFlow(context.Background(), dockerfileProject(t), nil, Answers{})
 	model = press(t, pastName(t, model), "enter", "enter", "enter")
 	require.Equal(t, screenConfirm, model.at)
 
@@ -479,7 +480,7 @@ func TestFlow_ConfirmDiffsABoundWorkload(t *testing.T) {
 				 "readinessProbe": {"path": "/alive", "port": 9001}}]}]}}`))
 
 	workloads := []workload.Workload{{ID: "68b0", Name: "triage-agent", Status: "running", UpdatedAt: time.Now()}}
-	model := newFlow(dockerfileProject(t), workloads, Answers{})
+	model := newFlow(context.Background(), dockerfileProject(t), workloads, Answers{})
 
 	model = press(t, model, "down", "enter") // bind
 	model = press(t, model, "enter")         // kind, unchanged
@@ -503,13 +504,13 @@ func TestFlow_ConfirmDiffsABoundWorkload(t *testing.T) {
 
 // An unfinished flow produces nothing to write, whichever way it ended.
 func TestFlow_ResultWithoutConfirming(t *testing.T) {
-	_, _, err := newFlow(dockerfileProject(t), nil, Answers{}).result()
+	_, _, err := newFlow(context.Background(), dockerfileProject(t), nil, Answers{}).result()
 	require.ErrorIs(t, err, ErrCancelled)
 }
 
 // Every screen renders its question, its answer and the keys that move.
 func TestFlow_ViewRendersEveryScreen(t *testing.T) {
-	model := newFlow(dockerfileProject(t), []workload.Workload{{ID: "68b0", Name: "triage", UpdatedAt: time.Now()}}, Answers{})
+	model := newFlow(context.Background(), dockerfileProject(t), []workload.Workload{{ID: "68b0", Name: "triage", UpdatedAt: time.Now()}}, Answers{})
 
 	for _, at := range []screen{
 		screenBinding, screenName, screenKind, screenA2A, screenSource,
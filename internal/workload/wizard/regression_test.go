@@ -16,6 +16,7 @@ package wizard
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -59,7 +60,7 @@ func TestFlow_ResizeIsSafeOnEveryScreen(t *testing.T) {
 		"named by flag":           {Name: "my-app"},
 	} {
 		t.Run(name, func(t *testing.T) {
-			model := newFlow(dockerfileProject(t), nil, answers)
+			model := newFlow(context.Background(), dockerfileProject(t), nil, answers)
 
 			require.NotPanics(t, func() {
 				model.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
@@ -68,7 +69,7 @@ func TestFlow_ResizeIsSafeOnEveryScreen(t *testing.T) {
 	}
 
 	// And on every screen a run can be sitting on when the window changes.
-	model := newFlow(dockerfileProject(t), nil, Answers{})
+	model := newFlow(context.Background(), dockerfileProject(t), nil, Answers{})
 	for at := screenBinding; at <= screenConfirm; at++ {
 		model.at = at
 		model.enter(at)
@@ -85,7 +86,7 @@ func TestFlow_ResizeIsSafeOnEveryScreen(t *testing.T) {
 func TestFlow_WorkloadIDFlagBindsInteractively(t *testing.T) {
 	stubLiveDocs(t)
 
-	model := newFlow(dockerfileProject(t), nil, Answers{WorkloadID: "68b0live"})
+	model := newFlow(context.Background(), dockerfileProject(t), nil, Answers{WorkloadID: "68b0live"})
 
 	require.NotEmpty(t, model.loading, "the bind is in flight before the first screen")
 
@@ -119,7 +120,7 @@ func TestFlow_WorkloadIDFlagBindsInteractively(t *testing.T) {
 func TestFlow_WorkloadIDFlagThatCannotBeRead(t *testing.T) {
 	stubLiveErr(t, errors.New("404 not found"))
 
-	model := newFlow(dockerfileProject(t), nil, Answers{WorkloadID: "nope"})
+	model := newFlow(context.Background(), dockerfileProject(t), nil, Answers{WorkloadID: "nope"})
 
 	updated, _ := model.Update(model.Init()())
 	model, ok := updated.(flow)
@@ -134,7 +135,7 @@ func TestFlow_WorkloadIDFlagThatCannotBeRead(t *testing.T) {
 // A flag set the wizard can still ask about must not take the answers it
 // could use down with it, and the reason has to be visible.
 func TestFlow_RejectedFlagKeepsTheOthers(t *testing.T) {
-	model := newFlow(dockerfileProject(t), nil, Answers{
+	model := newFlow(context.Background(), dockerfileProject(t), nil, Answers{
 		Name: "my-app", Type: "bogus", Replicas: 5, CPU: 2, Memory: "4GB",
 	})
 
@@ -150,7 +151,7 @@ func TestFlow_RejectedFlagKeepsTheOthers(t *testing.T) {
 // Re-confirming an unchanged kind must not withdraw the A2A answer given on
 // the screen after it.
 func TestFlow_BackAndForthKeepsTheA2AAnswer(t *testing.T) {
-	model := newFlow(dockerfileProject(t), nil, Answers{})
+	model := newFlow(context.Background(), dockerfileProject(t), nil, Answers{})
 
 	model = pastName(t, model)            // name
 	model = press(t, model, "2", "enter") // agent
@@ -176,7 +177,7 @@ func TestFlow_BoundKindTheWizardCannotAuthorIsKept(t *testing.T) {
 				{"name": "primary", "primary": true, "port": 8000, "imageUri": "nvcr.io/nim/llama:latest"}]}]}}`))
 
 	workloads := []workload.Workload{{ID: "68b0", Name: "nim-app", UpdatedAt: time.Now()}}
-	model := press(t, newFlow(dockerfileProject(t), workloads, Answers{}), "down", "enter")
+	model := press(t, newFlow(context.Background(), dockerfileProject(t), workloads, Answers{}), "down", "enter")
 
 	require.Equal(t, screenKind, model.at)
 	assert.Equal(t, "nim", model.choice.value(), "the live kind leads, so Enter keeps it")
@@ -192,7 +193,7 @@ func TestFlow_CreateNewAfterLookingAtOneDoesNotClone(t *testing.T) {
 	stubLiveDocs(t)
 
 	workloads := []workload.Workload{{ID: "68b0", Name: "live-app", UpdatedAt: time.Now()}}
-	model := press(t, newFlow(dockerfileProject(t), workloads, Answers{}), "down", "enter")
+	model := press(t, newFlow(context.Background(), dockerfileProject(t), workloads, Answers{}), "down", "enter")
 	require.NotNil(t, model.live)
 
 	model = press(t, model, "esc") // back to the picker
@@ -213,7 +214,7 @@ func TestFlow_RejectedSourceKeepsTheLiveImage(t *testing.T) {
 	stubLiveDocs(t)
 
 	workloads := []workload.Workload{{ID: "68b0", Name: "live-app", UpdatedAt: time.Now()}}
-	model := press(t, newFlow(Detect(t.TempDir()), workloads, Answers{}), "down", "enter")
+	model := press(t, newFlow(context.Background(), Detect(t.TempDir()), workloads, Answers{}), "down", "enter")
 
 	model = press(t, model, "enter") // kind, unchanged
 	require.Equal(t, screenSource, model.at)
@@ -231,14 +232,14 @@ func TestFlow_RejectedSourceKeepsTheLiveImage(t *testing.T) {
 // a workload id as an execution environment.
 func TestFlow_FailedBaseImageListShowsNoStaleRows(t *testing.T) {
 	original := listExecEnvsFn
-	listExecEnvsFn = func(int) ([]workload.ExecutionEnvironment, error) {
+	listExecEnvsFn = func(context.Context, int) ([]workload.ExecutionEnvironment, error) {
 		return nil, errors.New("500 server error")
 	}
 
 	t.Cleanup(func() { listExecEnvsFn = original })
 
 	workloads := []workload.Workload{{ID: "68b0", Name: "triage-agent", UpdatedAt: time.Now()}}
-	model := newFlow(dockerfileProject(t), workloads, Answers{})
+	model := newFlow(context.Background(), dockerfileProject(t), workloads, Answers{})
 
 	model = press(t, model, "enter")      // create new
 	model = pastName(t, model)            // name
@@ -264,7 +265,7 @@ func TestFlow_ConfirmWithNothingRenderedReportsTheRealError(t *testing.T) {
 		documentFrom(t, `{"name": "odd-artifact", "type": "service", "spec": {"containerGroups": []}}`))
 
 	workloads := []workload.Workload{{ID: "68b0", Name: "odd", UpdatedAt: time.Now()}}
-	model := press(t, newFlow(dockerfileProject(t), workloads, Answers{}), "down", "enter")
+	model := press(t, newFlow(context.Background(), dockerfileProject(t), workloads, Answers{}), "down", "enter")
 
 	model = press(t, model, "enter", "enter", "enter") // kind, source, readiness
 	require.Equal(t, screenConfirm, model.at)
@@ -304,7 +305,7 @@ func TestSplitCommand_EscapedArgumentsSurvive(t *testing.T) {
 func TestRun_SizingFlagsApplyToABoundWorkload(t *testing.T) {
 	stubLiveDocs(t)
 
-	result, err := Run(headless(t.TempDir(), Answers{
+	result, err := Run(context.Background(), headless(t.TempDir(), Answers{
 		WorkloadID: "68b0live", Replicas: 9, Memory: "32GB",
 	}))
 	require.NoError(t, err)
@@ -329,19 +330,19 @@ func TestAnswers_A2AFlagSemantics(t *testing.T) {
 				{"name": "primary", "primary": true, "port": 8000, "imageUri": "registry/a:v1"}]}]}}`))
 
 	// Re-asserting the kind it already is must not turn the card off.
-	result, err := Run(headless(t.TempDir(), Answers{WorkloadID: "68b0", Type: manifest.TypeAgent}))
+	result, err := Run(context.Background(), headless(t.TempDir(), Answers{WorkloadID: "68b0", Type: manifest.TypeAgent}))
 	require.NoError(t, err)
 	assert.Contains(t, string(result.Content), "a2aEnabled: true")
 
 	// And --a2a-enabled without --type is an agent, not a contradiction.
 	dir := writeDockerfile(t, t.TempDir(), "FROM scratch\n")
 
-	_, err = Run(headless(dir, Answers{Name: "my-app", A2AEnabled: true, Type: manifest.TypeAgent}))
+	_, err = Run(context.Background(), headless(dir, Answers{Name: "my-app", A2AEnabled: true, Type: manifest.TypeAgent}))
 	require.NoError(t, err)
 
 	// The rule is judged against the kind that will be written, so it runs
 	// once the draft has one. With nothing bound, that is the default.
-	_, err = Answers{A2AEnabled: true}.draft(Detect(dir))
+	_, err = Answers{A2AEnabled: true}.draft(context.Background(), Detect(dir))
 	require.Error(t, err, "with the default kind being service, the pair is still a contradiction")
 	assert.Contains(t, err.Error(), "--a2a-enabled applies to --type agent")
 }
@@ -356,7 +357,7 @@ func TestRun_LiveWorkloadTheReaderRejectsSaysSo(t *testing.T) {
 		documentFrom(t, `{"name": "a", "type": "service", "spec": {"containerGroups": [{"name": "default", "containers": [
 				{"name": "primary", "primary": true, "port": 8000, "imageUri": "registry/a:v1"}]}]}}`))
 
-	_, err := Run(headless(t.TempDir(), Answers{WorkloadID: "68b0"}))
+	_, err := Run(context.Background(), headless(t.TempDir(), Answers{WorkloadID: "68b0"}))
 	require.Error(t, err)
 
 	assert.NotContains(t, err.Error(), "which is a bug", "the user's running workload is not a CLI bug")
@@ -375,7 +376,7 @@ func TestDetect_PrivilegedExposeIsNotAdopted(t *testing.T) {
 	// And the run that follows succeeds rather than blaming the CLI.
 	dir := writeDockerfile(t, t.TempDir(), "FROM nginx:alpine\nEXPOSE 80\n")
 
-	result, err := Run(headless(dir, Answers{Name: "my-app"}))
+	result, err := Run(context.Background(), headless(dir, Answers{Name: "my-app"}))
 	require.NoError(t, err)
 	assert.Contains(t, string(result.Content), "port: 8080")
 }
@@ -428,7 +429,7 @@ func TestRun_EnvKeysAreListedByDefault(t *testing.T) {
 	require.NoError(t, os.WriteFile(filepath.Join(dir, EnvFileName),
 		[]byte("LOG_LEVEL=debug\nOPENAI_API_KEY=sk-abcdefghijklmnopqrstuvwxyz01\n"), 0o600))
 
-	result, err := Run(headless(dir, Answers{Name: "my-app"}))
+	result, err := Run(context.Background(), headless(dir, Answers{Name: "my-app"}))
 	require.NoError(t, err)
 
 	written := string(result.Content)
@@ -464,7 +465,7 @@ func TestRun_SkipEnv(t *testing.T) {
 	dir := writeDockerfile(t, t.TempDir(), "FROM scratch\n")
 	require.NoError(t, os.WriteFile(filepath.Join(dir, EnvFileName), []byte("LOG_LEVEL=debug\n"), 0o600))
 
-	result, err := Run(headless(dir, Answers{Name: "my-app", SkipEnv: true}))
+	result, err := Run(context.Background(), headless(dir, Answers{Name: "my-app", SkipEnv: true}))
 	require.NoError(t, err)
 
 	assert.Equal(t, 0, result.EnvKeysListed)
@@ -481,7 +482,7 @@ func TestFlow_EnvTableIsPerRowAndOverridable(t *testing.T) {
 		[]byte("LOG_LEVEL=debug\nOPENAI_API_KEY=sk-abcdefghijklmnopqrstuvwxyz01\nVITE_DEV_PORT=3000\n"), 0o600))
 
 	// Four Enters reach it: name, kind, source, readiness.
-	model := press(t, pastName(t, newFlow(Detect(dir), nil, Answers{})), "enter", "enter", "enter")
+	model := press(t, pastName(t, newFlow(context.Background(), Detect(dir), nil, Answers{})), "enter", "enter", "enter")
 	require.Equal(t, screenEnv, model.at)
 
 	view := model.View()
@@ -526,7 +527,7 @@ func TestFlow_EnvTableChangesOnlyTheRowUnderTheCursor(t *testing.T) {
 	require.NoError(t, os.WriteFile(filepath.Join(dir, EnvFileName),
 		[]byte("FIRST=one\nSECOND=two\n"), 0o600))
 
-	model := press(t, pastName(t, newFlow(Detect(dir), nil, Answers{})), "enter", "enter", "enter")
+	model := press(t, pastName(t, newFlow(context.Background(), Detect(dir), nil, Answers{})), "enter", "enter", "enter")
 	require.Equal(t, screenEnv, model.at)
 
 	model = press(t, model, "down", " ")
@@ -537,7 +538,7 @@ func TestFlow_EnvTableChangesOnlyTheRowUnderTheCursor(t *testing.T) {
 
 // A project without a .env is never asked.
 func TestFlow_EnvScreenSkippedWithoutAnEnvFile(t *testing.T) {
-	model := press(t, pastName(t, newFlow(dockerfileProject(t), nil, Answers{})), "enter", "enter", "enter")
+	model := press(t, pastName(t, newFlow(context.Background(), dockerfileProject(t), nil, Answers{})), "enter", "enter", "enter")
 
 	assert.Equal(t, screenConfirm, model.at)
 }
@@ -545,7 +546,7 @@ func TestFlow_EnvScreenSkippedWithoutAnEnvFile(t *testing.T) {
 // A name passed as a flag is an answer, not a suggestion, so the screen shows
 // it as an editable value.
 func TestFlow_NameFlagIsShownAsAValue(t *testing.T) {
-	model := newFlow(dockerfileProject(t), nil, Answers{Name: "from-flag"})
+	model := newFlow(context.Background(), dockerfileProject(t), nil, Answers{Name: "from-flag"})
 
 	require.Equal(t, screenName, model.at)
 	assert.Equal(t, "from-flag", model.inputs[0].Value())
@@ -558,7 +559,7 @@ func TestFlow_EnvTableOverridesAreIndependentAndPersist(t *testing.T) {
 	dir := writeDockerfile(t, t.TempDir(), "FROM scratch\n")
 	require.NoError(t, os.WriteFile(filepath.Join(dir, EnvFileName), []byte("SETTING=plain\n"), 0o600))
 
-	atTable := press(t, pastName(t, newFlow(Detect(dir), nil, Answers{})), "enter", "enter", "enter")
+	atTable := press(t, pastName(t, newFlow(context.Background(), Detect(dir), nil, Answers{})), "enter", "enter", "enter")
 	require.Equal(t, screenEnv, atTable.at)
 	require.Equal(t, EnvConfig, atTable.envTable.rows[0].Kind)
 
@@ -578,7 +579,7 @@ func TestFlow_EnvTableOverridesAreIndependentAndPersist(t *testing.T) {
 // than a hand edit. The enum is not verified against a running platform, so
 // the prompt checks it while the reader passes anything through.
 func TestFlow_ImportanceIsAskedAndValidated(t *testing.T) {
-	model := newFlow(dockerfileProject(t), nil, Answers{})
+	model := newFlow(context.Background(), dockerfileProject(t), nil, Answers{})
 	model = press(t, pastName(t, model), "enter", "enter")
 	require.Equal(t, screenSettings, model.at)
 
@@ -608,7 +609,7 @@ func TestFlow_ImportanceIsAskedAndValidated(t *testing.T) {
 // user would say it: relative when it is under the working directory,
 // absolute when it is not.
 func TestFlow_ConfirmNamesTheTargetFile(t *testing.T) {
-	model := newFlow(dockerfileProject(t), nil, Answers{})
+	model := newFlow(context.Background(), dockerfileProject(t), nil, Answers{})
 	model = press(t, pastName(t, model), "enter", "enter", "enter")
 	require.Equal(t, screenConfirm, model.at)
 
@@ -642,7 +643,7 @@ func withField(model flow, i int, value string) flow {
 // Each field explains itself when the cursor reaches it, so the screen stays
 // readable on a narrow terminal instead of running a hint off the edge.
 func TestFlow_SettingsNotesFollowTheCursor(t *testing.T) {
-	model := newFlow(dockerfileProject(t), nil, Answers{})
+	model := newFlow(context.Background(), dockerfileProject(t), nil, Answers{})
 	model = press(t, pastName(t, model), "enter", "enter")
 	require.Equal(t, screenSettings, model.at)
 
@@ -664,7 +665,7 @@ func TestFlow_BaseImageListSurvivesBackNavigation(t *testing.T) {
 	var fetches int
 
 	original := listExecEnvsFn
-	listExecEnvsFn = func(int) ([]workload.ExecutionEnvironment, error) {
+	listExecEnvsFn = func(context.Context, int) ([]workload.ExecutionEnvironment, error) {
 		fetches++
 
 		return []workload.ExecutionEnvironment{
@@ -675,7 +676,7 @@ func TestFlow_BaseImageListSurvivesBackNavigation(t *testing.T) {
 
 	t.Cleanup(func() { listExecEnvsFn = original })
 
-	model := newFlow(dockerfileProject(t), nil, Answers{})
+	model := newFlow(context.Background(), dockerfileProject(t), nil, Answers{})
 	model = press(t, pastName(t, model), "enter") // name, kind
 	model = press(t, model, "2", "enter")         // build from a base image
 
@@ -715,15 +716,15 @@ func TestFlow_DoesNotReportWhatItIsAboutToAsk(t *testing.T) {
 	// No Dockerfile and no flags: the headless path refuses this outright.
 	detected := Detect(t.TempDir())
 
-	_, err := Answers{}.draft(detected)
+	_, err := Answers{}.draft(context.Background(), detected)
 	require.Error(t, err, "the headless path still refuses it")
 
-	model := newFlow(detected, nil, Answers{})
+	model := newFlow(context.Background(), detected, nil, Answers{})
 	require.NoError(t, model.failed, "but the wizard just asks")
 	assert.NotContains(t, model.View(), "cannot be guessed")
 
 	// A flag no screen can fix is still reported on arrival.
-	rejected := newFlow(detected, nil, Answers{Type: "bogus"})
+	rejected := newFlow(context.Background(), detected, nil, Answers{Type: "bogus"})
 	require.Error(t, rejected.failed)
 	assert.Contains(t, rejected.View(), `--type "bogus" is not supported`)
 }
@@ -732,7 +733,7 @@ func TestFlow_DoesNotReportWhatItIsAboutToAsk(t *testing.T) {
 // "Copies its settings" on its own reads as cloning.
 func TestFlow_BindingNoteSaysItIsNotACopy(t *testing.T) {
 	workloads := []workload.Workload{{ID: "68b0", Name: "triage-agent", UpdatedAt: time.Now()}}
-	model := newFlow(dockerfileProject(t), workloads, Answers{})
+	model := newFlow(context.Background(), dockerfileProject(t), workloads, Answers{})
 
 	// The pinned row leads, and says nothing is created yet.
 	assert.Contains(t, model.View(), "Creates a new workload for this repository")
@@ -750,7 +751,7 @@ func TestFlow_BoundScreensSayWhereTheDefaultsCameFrom(t *testing.T) {
 	stubLiveDocs(t)
 
 	workloads := []workload.Workload{{ID: "68b0", Name: "live-app", UpdatedAt: time.Now()}}
-	model := press(t, newFlow(dockerfileProject(t), workloads, Answers{}), "down", "enter")
+	model := press(t, newFlow(context.Background(), dockerfileProject(t), workloads, Answers{}), "down", "enter")
 	require.Equal(t, screenKind, model.at)
 
 	assert.Contains(t, model.View(), "live-app")
@@ -762,7 +763,7 @@ func TestFlow_BoundScreensSayWhereTheDefaultsCameFrom(t *testing.T) {
 	assert.Contains(t, model.View(), "live-app")
 
 	// A fresh run says no such thing, because there is no workload to speak of.
-	fresh := press(t, pastName(t, newFlow(dockerfileProject(t), nil, Answers{})), "enter", "enter")
+	fresh := press(t, pastName(t, newFlow(context.Background(), dockerfileProject(t), nil, Answers{})), "enter", "enter")
 	require.Equal(t, screenSettings, fresh.at)
 	assert.NotContains(t, fresh.View(), "current values")
 }
@@ -801,7 +802,7 @@ func TestFlow_DockerfileConflictOnlyOnTheSourceScreen(t *testing.T) {
 
 	// A project with no Dockerfile, bound to a workload that builds one.
 	workloads := []workload.Workload{{ID: "68b0", Name: "builds-a-dockerfile", UpdatedAt: time.Now()}}
-	model := press(t, newFlow(Detect(t.TempDir()), workloads, Answers{}), "down", "enter")
+	model := press(t, newFlow(context.Background(), Detect(t.TempDir()), workloads, Answers{}), "down", "enter")
 
 	require.Equal(t, screenKind, model.at)
 	assert.NotContains(t, model.View(), "No Dockerfile", "not the kind screen's business")
@@ -815,7 +816,7 @@ func TestFlow_DockerfileConflictOnlyOnTheSourceScreen(t *testing.T) {
 // A missing Dockerfile is a reason the option will not work, so it reads as a
 // warning rather than as a detail alongside the other two sources.
 func TestFlow_MissingDockerfileIsWarned(t *testing.T) {
-	without := press(t, pastName(t, newFlow(Detect(t.TempDir()), nil, Answers{})), "enter")
+	without := press(t, pastName(t, newFlow(context.Background(), Detect(t.TempDir()), nil, Answers{})), "enter")
 	require.Equal(t, screenSource, without.at)
 
 	options := without.choice.options
@@ -824,7 +825,7 @@ func TestFlow_MissingDockerfileIsWarned(t *testing.T) {
 	assert.True(t, options[0].warn, "the note is styled as a warning")
 
 	// With one present it is an ordinary detail.
-	with := press(t, pastName(t, newFlow(dockerfileProject(t), nil, Answers{})), "enter")
+	with := press(t, pastName(t, newFlow(context.Background(), dockerfileProject(t), nil, Answers{})), "enter")
 	require.Equal(t, screenSource, with.at)
 	assert.Equal(t, "Dockerfile detected", with.choice.options[0].note)
 	assert.False(t, with.choice.options[0].warn)
@@ -835,7 +836,7 @@ func TestFlow_MissingDockerfileIsWarned(t *testing.T) {
 // look wrong: re-confirming it overwrites the choice with whatever is first.
 func TestFlow_GeneratedBuildAnswersSurviveBackAndForth(t *testing.T) {
 	original := listExecEnvsFn
-	listExecEnvsFn = func(int) ([]workload.ExecutionEnvironment, error) {
+	listExecEnvsFn = func(context.Context, int) ([]workload.ExecutionEnvironment, error) {
 		return []workload.ExecutionEnvironment{
 			{ID: "68a1", Name: "first", LatestSuccessfulVersion: &workload.EEVersion{ID: "v1"}},
 			{ID: "68b1", Name: "second", LatestSuccessfulVersion: &workload.EEVersion{ID: "v2"}},
@@ -845,7 +846,7 @@ func TestFlow_GeneratedBuildAnswersSurviveBackAndForth(t *testing.T) {
 
 	t.Cleanup(func() { listExecEnvsFn = original })
 
-	model := press(t, pastName(t, newFlow(dockerfileProject(t), nil, Answers{})), "enter")
+	model := press(t, pastName(t, newFlow(context.Background(), dockerfileProject(t), nil, Answers{})), "enter")
 	model = press(t, model, "2", "enter") // build from an execution environment
 	require.Equal(t, screenExecEnv, model.at)
 
@@ -889,7 +890,7 @@ func TestFlow_BindingSelectionSurvivesBackNavigation(t *testing.T) {
 	}
 
 	// Row 2 is live-app; row 0 is the pinned create-new row.
-	model := press(t, newFlow(dockerfileProject(t), workloads, Answers{}), "down", "down", "enter")
+	model := press(t, newFlow(context.Background(), dockerfileProject(t), workloads, Answers{}), "down", "down", "enter")
 	require.NotNil(t, model.live)
 	require.Equal(t, screenKind, model.at)
 
@@ -909,7 +910,7 @@ func TestRun_BoundWorkloadCarriesEnv(t *testing.T) {
 	require.NoError(t, os.WriteFile(filepath.Join(dir, EnvFileName),
 		[]byte("LOG_LEVEL=debug\nAPI_TOKEN=Xk9mPq2vLw8nRt5yBc3z\n"), 0o600))
 
-	result, err := Run(headless(dir, Answers{WorkloadID: "68b0live"}))
+	result, err := Run(context.Background(), headless(dir, Answers{WorkloadID: "68b0live"}))
 	require.NoError(t, err)
 
 	written := string(result.Content)
@@ -939,7 +940,7 @@ func TestRun_BoundWorkloadKeepsItsOwnEnv(t *testing.T) {
 	require.NoError(t, os.WriteFile(filepath.Join(dir, EnvFileName),
 		[]byte("LOG_LEVEL=debug\nEXTRA=added\n"), 0o600))
 
-	result, err := Run(headless(dir, Answers{WorkloadID: "68b0"}))
+	result, err := Run(context.Background(), headless(dir, Answers{WorkloadID: "68b0"}))
 	require.NoError(t, err)
 
 	written := string(result.Content)
@@ -957,7 +958,7 @@ func TestRun_BoundWorkloadWithoutARuntimeBlock(t *testing.T) {
 		documentFrom(t, `{"name": "a", "type": "service", "spec": {"containerGroups": [{"name": "default",
 			"containers": [{"name": "primary", "primary": true, "port": 8000, "imageUri": "registry/a:v1"}]}]}}`))
 
-	result, err := Run(headless(t.TempDir(), Answers{
+	result, err := Run(context.Background(), headless(t.TempDir(), Answers{
 		WorkloadID: "68b0", Replicas: 4, CPU: 2, Memory: "4 gb",
 	}))
 	require.NoError(t, err)
@@ -984,7 +985,7 @@ func TestRun_DryRunIsNotReportedAsCreated(t *testing.T) {
 	opts := headless(dir, Answers{Name: "my-app"})
 	opts.DryRun = true
 
-	result, err := Run(opts)
+	result, err := Run(context.Background(), opts)
 	require.NoError(t, err)
 
 	assert.Equal(t, ActionPlanned, result.Action)
@@ -998,7 +999,7 @@ func TestFlow_EnvRowCycledBackKeepsItsValue(t *testing.T) {
 	dir := writeDockerfile(t, t.TempDir(), "FROM scratch\n")
 	require.NoError(t, os.WriteFile(filepath.Join(dir, EnvFileName), []byte("SETTING=plain\n"), 0o600))
 
-	model := press(t, pastName(t, newFlow(Detect(dir), nil, Answers{})), "enter", "enter", "enter")
+	model := press(t, pastName(t, newFlow(context.Background(), Detect(dir), nil, Answers{})), "enter", "enter", "enter")
 	require.Equal(t, screenEnv, model.at)
 
 	// config -> secret -> local -> config.
@@ -1017,7 +1018,7 @@ func TestRowTable_MarkerFollowsARestoredCursor(t *testing.T) {
 	require.NoError(t, os.WriteFile(filepath.Join(dir, EnvFileName),
 		[]byte("FIRST=1\nSECOND=2\nTHIRD=3\n"), 0o600))
 
-	model := press(t, pastName(t, newFlow(Detect(dir), nil, Answers{})), "enter", "enter", "enter")
+	model := press(t, pastName(t, newFlow(context.Background(), Detect(dir), nil, Answers{})), "enter", "enter", "enter")
 	require.Equal(t, screenEnv, model.at)
 
 	model = press(t, model, "down", "down", " ")
@@ -1083,7 +1084,7 @@ func TestFlow_ResizeRefitsTheActivePicker(t *testing.T) {
 		{ID: "68b1", Name: "billing-service", Status: "stopped", UpdatedAt: time.Now()},
 	}
 
-	model := newFlow(dockerfileProject(t), workloads, Answers{})
+	model := newFlow(context.Background(), dockerfileProject(t), workloads, Answers{})
 	require.Equal(t, screenBinding, model.at)
 
 	model.picker.moveCursor(tea.KeyMsg{Type: tea.KeyDown})
@@ -1118,7 +1119,7 @@ func TestFlow_ConfirmPreviewScrollsALongManifest(t *testing.T) {
 
 	require.NoError(t, os.WriteFile(filepath.Join(dir, EnvFileName), []byte(env.String()), 0o600))
 
-	model := newFlow(Detect(dir), nil, Answers{})
+	model := newFlow(context.Background(), Detect(dir), nil, Answers{})
 	model.width, model.height = 100, 30
 
 	model = press(t, pastName(t, model), "enter", "enter", "enter", "enter")
@@ -1153,7 +1154,7 @@ func TestFlow_ConfirmPreviewScrollsALongManifest(t *testing.T) {
 // A short manifest needs no scrolling, and the legend must not offer a key
 // that does nothing.
 func TestFlow_ConfirmPreviewDoesNotOfferScrollingItDoesNotNeed(t *testing.T) {
-	model := newFlow(dockerfileProject(t), nil, Answers{})
+	model := newFlow(context.Background(), dockerfileProject(t), nil, Answers{})
 	model.width, model.height = 100, 60
 
 	model = press(t, pastName(t, model), "enter", "enter", "enter")
@@ -1169,6 +1170,7 @@ func TestFlow_ConfirmPreviewDoesNotOfferScrollingItDoesNotNeed(t *testing.T) {
 // run anyway, and arriving at the first screen would clear it.
 func TestRunInteractiveFlow_RejectsWorkloadIDWithName(t *testing.T) {
 	_, _, err := runInteractiveFlow(
+		context.Background(),
 		Options{Dir: t.TempDir(), Answers: Answers{WorkloadID: "68b0", Name: "my-app"}},
 		dockerfileProject(t))
 	require.Error(t, err)
@@ -1186,7 +1188,7 @@ func TestFlow_BindKeepsTheFlagsGivenBeforeTheFetch(t *testing.T) {
 		documentFrom(t, `{"name": "triage-agent-artifact", "type": "service", "spec": {"containerGroups": [{"name": "default", "containers": [
 				{"name": "primary", "primary": true, "port": 9001, "imageUri": "registry/triage:v3"}]}]}}`))
 
-	model := newFlow(dockerfileProject(t), nil, Answers{
+	model := newFlow(context.Background(), dockerfileProject(t), nil, Answers{
 		WorkloadID: "68b0c1d2e3f4a5b6c7d8e9f0",
 		Replicas:   9,
 		Memory:     "32GB",
@@ -1206,7 +1208,7 @@ func TestFlow_BindKeepsTheFlagsGivenBeforeTheFetch(t *testing.T) {
 func mustFetchLive(t *testing.T, id string) manifest.Live {
 	t.Helper()
 
-	live, err := fetchLive(id)
+	live, err := fetchLive(context.Background(), id)
 	require.NoError(t, err)
 
 	return live
@@ -1222,14 +1224,14 @@ func TestFlow_SkipEnvSkipsTheEnvScreen(t *testing.T) {
 	detected := Detect(dir)
 	require.True(t, detected.HasEnvFile())
 
-	model := press(t, pastName(t, newFlow(detected, nil, Answers{SkipEnv: true})), "enter", "enter", "enter")
+	model := press(t, pastName(t, newFlow(context.Background(), detected, nil, Answers{SkipEnv: true})), "enter", "enter", "enter")
 
 	assert.Equal(t, screenConfirm, model.at, "the env screen is not a question the user left open")
 	assert.Empty(t, model.draft.EnvVars)
 	assert.NotContains(t, string(model.content), "environmentVars")
 
 	// Without the flag the same project stops at the env screen.
-	withEnv := press(t, pastName(t, newFlow(detected, nil, Answers{})), "enter", "enter", "enter")
+	withEnv := press(t, pastName(t, newFlow(context.Background(), detected, nil, Answers{})), "enter", "enter", "enter")
 	assert.Equal(t, screenEnv, withEnv.at)
 }
 
@@ -1244,7 +1246,7 @@ func TestFlow_AutoscaledBoundWorkloadPassesTheSettingsScreen(t *testing.T) {
 		documentFrom(t, `{"name": "triage-agent-artifact", "type": "service", "spec": {"containerGroups": [{"name": "default", "containers": [
 				{"name": "primary", "primary": true, "port": 9001, "imageUri": "registry/triage:v3"}]}]}}`))
 
-	model := newFlow(dockerfileProject(t), nil, Answers{WorkloadID: "68b0c1d2e3f4a5b6c7d8e9f0"})
+	model := newFlow(context.Background(), dockerfileProject(t), nil, Answers{WorkloadID: "68b0c1d2e3f4a5b6c7d8e9f0"})
 
 	updated, _ := model.Update(liveLoadedMsg{live: mustFetchLive(t, "68b0c1d2e3f4a5b6c7d8e9f0")})
 	model, ok := updated.(flow)
@@ -1275,7 +1277,7 @@ func TestFlow_AutoscaledBoundWorkloadPassesTheSettingsScreen(t *testing.T) {
 func TestFlow_SettingsRejectsNonFiniteCPU(t *testing.T) {
 	for _, text := range []string{"NaN", "+Inf", "-Inf", "inf"} {
 		t.Run(text, func(t *testing.T) {
-			model := press(t, pastName(t, newFlow(dockerfileProject(t), nil, Answers{})), "enter", "enter")
+			model := press(t, pastName(t, newFlow(context.Background(), dockerfileProject(t), nil, Answers{})), "enter", "enter")
 			require.Equal(t, screenSettings, model.at)
 
 			model.inputs[3].SetValue(text)
@@ -1304,7 +1306,7 @@ func TestFlow_BoundEnvCountsOnlyWhatWasAdded(t *testing.T) {
 	require.NoError(t, os.WriteFile(filepath.Join(dir, EnvFileName),
 		[]byte("LOG_LEVEL=debug\nFEATURE_X=on\n"), 0o600))
 
-	model := newFlow(Detect(dir), nil, Answers{WorkloadID: "68b0c1d2e3f4a5b6c7d8e9f0"})
+	model := newFlow(context.Background(), Detect(dir), nil, Answers{WorkloadID: "68b0c1d2e3f4a5b6c7d8e9f0"})
 
 	updated, _ := model.Update(liveLoadedMsg{live: mustFetchLive(t, "68b0c1d2e3f4a5b6c7d8e9f0")})
 	model, ok := updated.(flow)
@@ -15,6 +15,7 @@
 package wizard
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -41,7 +42,7 @@ func SetStdinTerminalForTest(isTerminal bool) func() {
 
 // SetInteractiveFlowForTest replaces the wizard itself, so a command-level
 // test can tell whether it would have run.
-func SetInteractiveFlowForTest(flow func(Options, Detected) ([]byte, manifest.Draft, error)) func() {
+func SetInteractiveFlowForTest(flow func(context.Context, Options, Detected) ([]byte, manifest.Draft, error)) func() {
 	original := runInteractiveFlowFn
 	runInteractiveFlowFn = flow
 
@@ -111,7 +112,7 @@ type Result struct {
 // An existing manifest ends the run untouched. That is the whole of setup's
 // relationship with a configured project: the file is the interface, every
 // later change is a hand edit, and deleting the file re-arms the wizard.
-func Run(opts Options) (Result, error) {
+func Run(ctx context.Context, opts Options) (Result, error) {
 	dir, err := filepath.Abs(opts.Dir)
 	if err != nil {
 		return Result{}, fmt.Errorf("cannot resolve %s: %w", opts.Dir, err)
@@ -130,7 +131,7 @@ func Run(opts Options) (Result, error) {
 		warnUnreadEnvFile(opts.Stderr, detected)
 	}
 
-	content, draft, err := opts.resolve(detected)
+	content, draft, err := opts.resolve(ctx, detected)
 	if err != nil {
 		return Result{}, err
 	}
@@ -200,25 +201,25 @@ func existing(path string) (Result, error) {
 
 // resolve produces the manifest bytes, from flags alone when nothing may
 // prompt and from the wizard otherwise.
-func (o Options) resolve(detected Detected) ([]byte, manifest.Draft, error) {
+func (o Options) resolve(ctx context.Context, detected Detected) ([]byte, manifest.Draft, error) {
 	if o.NonInteractive || !isStdinTerminalFn() {
-		return o.resolveHeadless(detected)
+		return o.resolveHeadless(ctx, detected)
 	}
 
-	return runInteractiveFlowFn(o, detected)
+	return runInteractiveFlowFn(ctx, o, detected)
 }
 
-func (o Options) resolveHeadless(detected Detected) ([]byte, manifest.Draft, error) {
+func (o Options) resolveHeadless(ctx context.Context, detected Detected) ([]byte, manifest.Draft, error) {
 	if o.Answers.WorkloadID != "" {
-		return o.resolveHeadlessBound(detected)
+		return o.resolveHeadlessBound(ctx, detected)
 	}
 
-	draft, err := o.Answers.draft(detected)
+	draft, err := o.Answers.draft(ctx, detected)
 	if err != nil {
 		return nil, manifest.Draft{}, err
 	}
 
-	draft.EnvVars = o.storeSecrets(draft.EnvVars, detected, draft.Name)
+	draft.EnvVars = o.storeSecrets(ctx, draft.EnvVars, detected, draft.Name)
 
 	content, err := draft.Render()
 	if err != nil {
@@ -236,12 +237,12 @@ func (o Options) resolveHeadless(detected Detected) ([]byte, manifest.Draft, err
 // is the only moment both facts are available: which variables the user calls
 // secret, and what they are worth. Nothing is stored under --dry-run, because
 // a run that promises to change nothing must not leave a credential behind.
-func (o Options) storeSecrets(vars []manifest.EnvVar, detected Detected, workloadName string) []manifest.EnvVar {
+func (o Options) storeSecrets(ctx context.Context, vars []manifest.EnvVar, detected Detected, workloadName string) []manifest.EnvVar {
 	if o.DryRun {
 		return vars
 	}
 
-	stored, report := importSecrets(vars, detected, workloadName)
+	stored, report := importSecrets(ctx, vars, detected, workloadName)
 
 	reportImport(o.Stderr, report)
 
@@ -251,13 +252,13 @@ func (o Options) storeSecrets(vars []manifest.EnvVar, detected Detected, workloa
 // resolveHeadlessBound binds to a live workload without prompting: the live
 // spec is downloaded and the flags are applied on top, so a headless bind is
 // the same operation the picker performs.
-func (o Options) resolveHeadlessBound(detected Detected) ([]byte, manifest.Draft, error) {
-	live, err := fetchLive(o.Answers.WorkloadID)
+func (o Options) resolveHeadlessBound(ctx context.Context, detected Detected) ([]byte, manifest.Draft, error) {
+	live, err := fetchLive(ctx, o.Answers.WorkloadID)
 	if err != nil {
 		return nil, manifest.Draft{}, err
 	}
 
-	draft, err := o.Answers.applyTo(live.Defaults(), detected)
+	draft, err := o.Answers.applyTo(ctx, live.Defaults(), detected)
 	if err != nil {
 		return nil, manifest.Draft{}, err
 	}
@@ -268,7 +269,7 @@ func (o Options) resolveHeadlessBound(detected Detected) ([]byte, manifest.Draft
 	// to what reached the file, and keeps a credential from being stored for a
 	// variable the workload is already serving.
 	draft.EnvVars = live.NewEnvVars(draft.EnvVars)
-	draft.EnvVars = o.storeSecrets(draft.EnvVars, detected, draft.Name)
+	draft.EnvVars = o.storeSecrets(ctx, draft.EnvVars, detected, draft.Name)
 
 	applied, err := live.Apply(draft)
 	if err != nil {
@@ -292,7 +293,7 @@ func (o Options) resolveHeadlessBound(detected Detected) ([]byte, manifest.Draft
 // truthful, which for a bound workload is the workload itself. Only fields
 // the user actually passed are overwritten, so binding keeps the live values
 // for everything the command line did not mention.
-func (a Answers) applyTo(defaults manifest.Draft, detected Detected) (manifest.Draft, error) {
+func (a Answers) applyTo(ctx context.Context, defaults manifest.Draft, detected Detected) (manifest.Draft, error) {
 	if err := a.check(); err != nil {
 		return manifest.Draft{}, err
 	}
@@ -312,7 +313,7 @@ func (a Answers) applyTo(defaults manifest.Draft, detected Detected) (manifest.D
 		return manifest.Draft{}, err
 	}
 
-	if err := a.applyBuild(&draft, detected); err != nil {
+	if err := a.applyBuild(ctx, &draft, detected); err != nil {
 		return manifest.Draft{}, err
 	}
 
@@ -373,17 +374,17 @@ func (a Answers) applySizing(draft *manifest.Draft) {
 // generated build's command without demanding its environment be repeated.
 // Switching modes is the other thing entirely, and there the mode's companion
 // flags are required, because nothing in the old build answers for the new one.
-func (a Answers) applyBuild(draft *manifest.Draft, detected Detected) error {
+func (a Answers) applyBuild(ctx context.Context, draft *manifest.Draft, detected Detected) error {
 	mode := a.buildMode(detected)
 	if !a.explicitBuild() || mode == "" {
 		return nil
 	}
 
 	if mode == draft.Build.Mode {
-		return a.mergeBuild(&draft.Build)
+		return a.mergeBuild(ctx, &draft.Build)
 	}
 
-	build, err := a.build(detected)
+	build, err := a.build(ctx, detected)
 	if err != nil {
 		return err
 	}
@@ -394,7 +395,7 @@ func (a Answers) applyBuild(draft *manifest.Draft, detected Detected) error {
 }
 
 // mergeBuild edits a build already in the requested mode, field by field.
-func (a Answers) mergeBuild(build *manifest.Build) error {
+func (a Answers) mergeBuild(ctx context.Context, build *manifest.Build) error {
 	switch build.Mode {
 	case manifest.BuildModeImage:
 		if a.Image != "" {
@@ -412,7 +413,7 @@ func (a Answers) mergeBuild(build *manifest.Build) error {
 		}
 
 		if a.ExecutionEnvironment != "" {
-			id, versionID, err := resolveExecEnvFn(a.ExecutionEnvironment)
+			id, versionID, err := resolveExecEnvFn(ctx, a.ExecutionEnvironment)
 			if err != nil {
 				return err
 			}
@@ -438,8 +439,8 @@ func (a Answers) explicitBuild() bool {
 // fetchLive downloads a workload and the artifact it runs. The workload is
 // fetched by id, so a typo fails here, at setup, rather than at the first
 // deploy.
-func fetchLive(workloadID string) (manifest.Live, error) {
-	workloadDoc, err := getWorkloadFn(workloadID)
+func fetchLive(ctx context.Context, workloadID string) (manifest.Live, error) {
+	workloadDoc, err := getWorkloadFn(ctx, workloadID)
 	if err != nil {
 		return manifest.Live{}, fmt.Errorf("cannot bind to workload %s: %w", workloadID, err)
 	}
@@ -449,7 +450,7 @@ func fetchLive(workloadID string) (manifest.Live, error) {
 		return manifest.Live{}, fmt.Errorf("workload %s has no artifact to copy; create a new workload instead", workloadID)
 	}
 
-	artifactDoc, err := getArtifactFn(artifactID)
+	artifactDoc, err := getArtifactFn(ctx, artifactID)
 	if err != nil {
 		return manifest.Live{}, fmt.Errorf("cannot read the artifact of workload %s: %w", workloadID, err)
 	}
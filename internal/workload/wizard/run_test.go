@@ -16,6 +16,7 @@ package wizard
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"math"
@@ -40,8 +41,8 @@ func stubLive(t *testing.T, workloadDoc, artifactDoc workload.Document) {
 	t.Helper()
 
 	swapLiveFns(t,
-		func(string) (workload.Document, error) { return workloadDoc, nil },
-		func(string) (workload.Document, error) { return artifactDoc, nil })
+		func(context.Context, string) (workload.Document, error) { return workloadDoc, nil },
+		func(context.Context, string) (workload.Document, error) { return artifactDoc, nil })
 }
 
 // stubLiveErr makes the workload fetch fail.
@@ -49,11 +50,11 @@ func stubLiveErr(t *testing.T, err error) {
 	t.Helper()
 
 	swapLiveFns(t,
-		func(string) (workload.Document, error) { return nil, err },
-		func(string) (workload.Document, error) { return nil, nil })
+		func(context.Context, string) (workload.Document, error) { return nil, err },
+		func(context.Context, string) (workload.Document, error) { return nil, nil })
 }
 
-func swapLiveFns(t *testing.T, getWorkload, getArtifact func(string) (workload.Document, error)) {
+func swapLiveFns(t *testing.T, getWorkload, getArtifact func(context.Context, string) (workload.Document, error)) {
 	t.Helper()
 
 	originalWorkload, originalArtifact := getWorkloadFn, getArtifactFn
@@ -76,7 +77,7 @@ func documentFrom(t *testing.T, raw string) workload.Document {
 func TestRun_WritesTheManifest(t *testing.T) {
 	dir := writeDockerfile(t, t.TempDir(), "FROM scratch\nEXPOSE 3000\n")
 
-	result, err := Run(headless(dir, Answers{Name: "my-app"}))
+	result, err := Run(context.Background(), headless(dir, Answers{Name: "my-app"}))
 	require.NoError(t, err)
 
 	assert.Equal(t, ActionCreated, result.Action)
@@ -103,7 +104,7 @@ func TestRun_DryRunWritesNothing(t *testing.T) {
 	opts := headless(dir, Answers{Name: "my-app"})
 	opts.DryRun = true
 
-	result, err := Run(opts)
+	result, err := Run(context.Background(), opts)
 	require.NoError(t, err)
 
 	assert.NotEmpty(t, result.Content)
@@ -122,7 +123,7 @@ func TestRun_ExistingManifestIsLeftAlone(t *testing.T) {
 	original := "name: hand-written\nartifactId: 68b0bbbb0000000000000002\n"
 	require.NoError(t, os.WriteFile(path, []byte(original), 0o600))
 
-	result, err := Run(headless(dir, Answers{Name: "something-else"}))
+	result, err := Run(context.Background(), headless(dir, Answers{Name: "something-else"}))
 	require.NoError(t, err)
 
 	assert.Equal(t, ActionUnchanged, result.Action)
@@ -143,11 +144,11 @@ func TestRun_ExistingManifestIsLeftAlone(t *testing.T) {
 func TestRun_ExistingManifestIsReadBack(t *testing.T) {
 	dir := writeDockerfile(t, t.TempDir(), "FROM scratch\n")
 
-	first, err := Run(headless(dir, Answers{Name: "my-app"}))
+	first, err := Run(context.Background(), headless(dir, Answers{Name: "my-app"}))
 	require.NoError(t, err)
 	require.NoError(t, manifest.WriteWorkloadID(first.Path, "68b0ffff0000000000000009"))
 
-	again, err := Run(headless(dir, Answers{}))
+	again, err := Run(context.Background(), headless(dir, Answers{}))
 	require.NoError(t, err)
 
 	assert.Equal(t, ActionUnchanged, again.Action)
@@ -175,7 +176,7 @@ artifact:
             imageUri: nginx:latest
 `), 0o600))
 
-	_, err := Run(headless(dir, Answers{}))
+	_, err := Run(context.Background(), headless(dir, Answers{}))
 	require.Error(t, err)
 
 	assert.Contains(t, err.Error(), "line 11")
@@ -196,7 +197,7 @@ func TestRun_WarnsAboutAManifestAbove(t *testing.T) {
 	opts := headless(child, Answers{Name: "child-app"})
 	opts.Stderr = stderr
 
-	_, err := Run(opts)
+	_, err := Run(context.Background(), opts)
 	require.NoError(t, err)
 
 	assert.Contains(t, stderr.String(), manifest.Path(parent))
@@ -210,7 +211,7 @@ func TestRun_NoWarningWithoutAManifestAbove(t *testing.T) {
 	opts := headless(dir, Answers{Name: "my-app"})
 	opts.Stderr = stderr
 
-	_, err := Run(opts)
+	_, err := Run(context.Background(), opts)
 	require.NoError(t, err)
 
 	assert.Empty(t, stderr.String())
@@ -219,7 +220,7 @@ func TestRun_NoWarningWithoutAManifestAbove(t *testing.T) {
 func TestRun_ReportsMissingAnswers(t *testing.T) {
 	dir := t.TempDir()
 
-	_, err := Run(headless(dir, Answers{Name: "my-app"}))
+	_, err := Run(context.Background(), headless(dir, Answers{Name: "my-app"}))
 	require.Error(t, err)
 
 	assert.Contains(t, err.Error(), "--build-mode")
@@ -238,7 +239,7 @@ func TestRun_BindsToALiveWorkload(t *testing.T) {
 
 	dir := t.TempDir()
 
-	result, err := Run(headless(dir, Answers{WorkloadID: "68b0c1d2e3f4a5b6c7d8e9f0"}))
+	result, err := Run(context.Background(), headless(dir, Answers{WorkloadID: "68b0c1d2e3f4a5b6c7d8e9f0"}))
 	require.NoError(t, err)
 
 	written := string(result.Content)
@@ -261,7 +262,7 @@ func TestRun_BindsToALiveWorkload(t *testing.T) {
 func TestRun_UnknownWorkloadID(t *testing.T) {
 	stubLiveErr(t, errors.New("404 not found"))
 
-	_, err := Run(headless(t.TempDir(), Answers{WorkloadID: "nope"}))
+	_, err := Run(context.Background(), headless(t.TempDir(), Answers{WorkloadID: "nope"}))
 	require.Error(t, err)
 
 	assert.Contains(t, err.Error(), "cannot bind to workload nope")
@@ -289,7 +290,7 @@ func boundRun(t *testing.T) string {
 	opts := headless(t.TempDir(), Answers{WorkloadID: "68b0c1d2e3f4a5b6c7d8e9f0"})
 	opts.Stderr = stderr
 
-	_, err := Run(opts)
+	_, err := Run(context.Background(), opts)
 	require.NoError(t, err)
 
 	return stderr.String()
@@ -345,7 +346,7 @@ func TestRun_BoundWorkloadIgnoresCredentialReferences(t *testing.T) {
 func TestRun_BoundWorkloadWithoutAnArtifact(t *testing.T) {
 	stubLive(t, documentFrom(t, `{"name": "live-app"}`), nil)
 
-	_, err := Run(headless(t.TempDir(), Answers{WorkloadID: "68b0"}))
+	_, err := Run(context.Background(), headless(t.TempDir(), Answers{WorkloadID: "68b0"}))
 	require.Error(t, err)
 
 	assert.Contains(t, err.Error(), "no artifact to copy")
@@ -354,7 +355,7 @@ func TestRun_BoundWorkloadWithoutAnArtifact(t *testing.T) {
 // Cancelling writes nothing and says so, so `config && up` stops here.
 func TestRun_CancelledWritesNothing(t *testing.T) {
 	original := runInteractiveFlowFn
-	runInteractiveFlowFn = func(Options, Detected) ([]byte, manifest.Draft, error) {
+	runInteractiveFlowFn = func(context.Context, Options, Detected) ([]byte, manifest.Draft, error) {
 		return nil, manifest.Draft{}, ErrCancelled
 	}
 
@@ -367,7 +368,7 @@ func TestRun_CancelledWritesNothing(t *testing.T) {
 
 	dir := writeDockerfile(t, t.TempDir(), "FROM scratch\n")
 
-	_, err := Run(Options{Dir: dir, Stderr: &bytes.Buffer{}})
+	_, err := Run(context.Background(), Options{Dir: dir, Stderr: &bytes.Buffer{}})
 	require.ErrorIs(t, err, ErrCancelled)
 
 	assert.NoFileExists(t, manifest.Path(dir))
@@ -376,7 +377,7 @@ func TestRun_CancelledWritesNothing(t *testing.T) {
 // Without a terminal nothing prompts, even when --yes was not passed.
 func TestRun_NoTerminalNeverPrompts(t *testing.T) {
 	originalFlow := runInteractiveFlowFn
-	runInteractiveFlowFn = func(Options, Detected) ([]byte, manifest.Draft, error) {
+	runInteractiveFlowFn = func(context.Context, Options, Detected) ([]byte, manifest.Draft, error) {
 		t.Fatal("the wizard must not run without a terminal")
 
 		return nil, manifest.Draft{}, nil
@@ -391,7 +392,7 @@ func TestRun_NoTerminalNeverPrompts(t *testing.T) {
 
 	dir := writeDockerfile(t, t.TempDir(), "FROM scratch\n")
 
-	_, err := Run(Options{Dir: dir, Answers: Answers{Name: "my-app"}, Stderr: &bytes.Buffer{}})
+	_, err := Run(context.Background(), Options{Dir: dir, Answers: Answers{Name: "my-app"}, Stderr: &bytes.Buffer{}})
 	require.NoError(t, err)
 }
 
@@ -426,7 +427,7 @@ func liveGeneratedAgent(t *testing.T) {
 func TestRun_BoundBuildKeepsWhatTheFlagsDidNotMention(t *testing.T) {
 	liveGeneratedAgent(t)
 
-	result, err := Run(headless(t.TempDir(), Answers{
+	result, err := Run(context.Background(), headless(t.TempDir(), Answers{
 		WorkloadID: "68b0c1d2e3f4a5b6c7d8e9f0",
 		Entrypoint: "python new.py",
 	}))
@@ -446,7 +447,7 @@ func TestRun_BoundBuildKeepsWhatTheFlagsDidNotMention(t *testing.T) {
 func TestRun_BoundBuildModeSwitchNeedsItsOwnFlags(t *testing.T) {
 	liveGeneratedAgent(t)
 
-	_, err := Run(headless(t.TempDir(), Answers{WorkloadID: "68b0c1d2e3f4a5b6c7d8e9f0", BuildMode: "image"}))
+	_, err := Run(context.Background(), headless(t.TempDir(), Answers{WorkloadID: "68b0c1d2e3f4a5b6c7d8e9f0", BuildMode: "image"}))
 	require.Error(t, err)
 
 	assert.Contains(t, err.Error(), "--image is required")
@@ -455,7 +456,7 @@ func TestRun_BoundBuildModeSwitchNeedsItsOwnFlags(t *testing.T) {
 func TestRun_BoundBuildModeSwitchReplacesTheBuild(t *testing.T) {
 	liveGeneratedAgent(t)
 
-	result, err := Run(headless(t.TempDir(), Answers{
+	result, err := Run(context.Background(), headless(t.TempDir(), Answers{
 		WorkloadID: "68b0c1d2e3f4a5b6c7d8e9f0",
 		BuildMode:  "image",
 		Image:      "registry/new:v1",
@@ -473,7 +474,7 @@ func TestRun_BoundBuildModeSwitchReplacesTheBuild(t *testing.T) {
 func TestRun_BoundAgentTakesA2AWithoutRepeatingTheType(t *testing.T) {
 	liveGeneratedAgent(t)
 
-	result, err := Run(headless(t.TempDir(), Answers{
+	result, err := Run(context.Background(), headless(t.TempDir(), Answers{
 		WorkloadID: "68b0c1d2e3f4a5b6c7d8e9f0",
 		A2AEnabled: true,
 	}))
@@ -491,7 +492,7 @@ func TestRun_BoundServiceRejectsA2A(t *testing.T) {
 		documentFrom(t, `{"name": "live-app-artifact", "type": "service", "spec": {"containerGroups": [{"name": "default", "containers": [
 				{"name": "primary", "primary": true, "port": 8000, "imageUri": "registry/live:v9"}]}]}}`))
 
-	_, err := Run(headless(t.TempDir(), Answers{WorkloadID: "68b0c1d2e3f4a5b6c7d8e9f0", A2AEnabled: true}))
+	_, err := Run(context.Background(), headless(t.TempDir(), Answers{WorkloadID: "68b0c1d2e3f4a5b6c7d8e9f0", A2AEnabled: true}))
 	require.Error(t, err)
 
 	assert.Contains(t, err.Error(), "--a2a-enabled applies to --type agent")
@@ -513,7 +514,7 @@ func TestRun_BoundEnvCountsOnlyWhatWasAdded(t *testing.T) {
 	dir := writeEnvFile(t, t.TempDir(),
 		"LOG_LEVEL=debug\nOPENAI_API_KEY=sk-abcdefghijklmnopqrstuvwxyz012345\nFEATURE_X=on\n")
 
-	result, err := Run(headless(dir, Answers{WorkloadID: "68b0c1d2e3f4a5b6c7d8e9f0"}))
+	result, err := Run(context.Background(), headless(dir, Answers{WorkloadID: "68b0c1d2e3f4a5b6c7d8e9f0"}))
 	require.NoError(t, err)
 
 	assert.Equal(t, 1, result.EnvKeysListed)
@@ -538,7 +539,7 @@ func TestRun_WarnsAboutAnUnreadableEnvFile(t *testing.T) {
 	opts := headless(dir, Answers{Name: "my-app"})
 	opts.Stderr = stderr
 
-	result, err := Run(opts)
+	result, err := Run(context.Background(), opts)
 	require.NoError(t, err)
 
 	assert.Contains(t, stderr.String(), EnvFileName)
@@ -558,7 +559,7 @@ func TestRun_RejectsNonFiniteCPU(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			dir := writeDockerfile(t, t.TempDir(), "FROM scratch\n")
 
-			_, err := Run(headless(dir, Answers{Name: "my-app", CPU: cpu}))
+			_, err := Run(context.Background(), headless(dir, Answers{Name: "my-app", CPU: cpu}))
 			require.Error(t, err)
 
 			assert.Contains(t, err.Error(), "--cpu")
@@ -577,7 +578,7 @@ func TestRun_SkipEnvSilencesTheUnreadableEnvWarning(t *testing.T) {
 	opts := headless(dir, Answers{Name: "my-app", SkipEnv: true})
 	opts.Stderr = stderr
 
-	_, err := Run(opts)
+	_, err := Run(context.Background(), opts)
 	require.NoError(t, err)
 
 	assert.Empty(t, stderr.String())
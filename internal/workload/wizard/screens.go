@@ -15,6 +15,7 @@
 package wizard
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"os"
@@ -51,7 +52,7 @@ const namePlaceholder = "my-app-name"
 // runInteractiveFlow asks the questions and returns the manifest the user
 // agreed to. The workload list is fetched up front because the first screen
 // depends on it: with nothing to bind to, there is no binding question.
-func runInteractiveFlow(opts Options, detected Detected) ([]byte, manifest.Draft, error) {
+func runInteractiveFlow(ctx context.Context, opts Options, detected Detected) ([]byte, manifest.Draft, error) {
 	// No screen can settle this one, so it is not a question the wizard gets
 	// to ask. Started anyway, the error would sit behind the loading view
 	// while the fetch ran, and arriving at the first screen would clear it
@@ -63,7 +64,7 @@ func runInteractiveFlow(opts Options, detected Detected) ([]byte, manifest.Draft
 	var workloads []workload.Workload
 
 	if opts.Answers.WorkloadID == "" && opts.Answers.Name == "" {
-		fetched, err := listWorkloadsFn(workloadPickLimit, nil, "")
+		fetched, err := listWorkloadsFn(ctx, workloadPickLimit, nil, "")
 		if err != nil {
 			return nil, manifest.Draft{}, err
 		}
@@ -76,7 +77,7 @@ func runInteractiveFlow(opts Options, detected Detected) ([]byte, manifest.Draft
 	// screen and every redraw there, so `dr workload config` inside a command
 	// substitution would capture escape sequences ahead of the path.
 	//
-	started := newFlow(detected, workloads, opts.Answers)
+	started := newFlow(ctx, detected, workloads, opts.Answers)
 	// Carried into the flow because the confirm screen is where secrets would
 	// be stored, and --dry-run has to mean the same thing on a terminal as it
 	// does headless: nothing created, here or on the platform.
@@ -16,6 +16,7 @@ package workload
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -311,7 +312,7 @@ func autoscalingEnabled(group map[string]any) bool {
 // verbatim after ValidateWorkloadCreateRequest passed. The server replies
 // 201 with the full workload document inline; endpoint is present from
 // creation (it is a stable gateway URL, not a liveness signal).
-func CreateWorkload(payload any) (*Workload, error) {
+func CreateWorkload(ctx context.Context, payload any) (*Workload, error) {
 	url, err := config.GetEndpointURL("/api/v2/workloads/")
 	if err != nil {
 		return nil, err
@@ -319,7 +320,7 @@ func CreateWorkload(payload any) (*Workload, error) {
 
 	var workload Workload
 
-	err = drapi.PostJSON(url, "workload", payload, &workload)
+	err = drapi.PostJSON(ctx, url, "workload", payload, &workload)
 	if err != nil {
 		return nil, err
 	}
@@ -333,7 +334,7 @@ func escapeID(id string) string {
 	return url.PathEscape(id)
 }
 
-func GetWorkload(workloadID string) (*Workload, error) {
+func GetWorkload(ctx context.Context, workloadID string) (*Workload, error) {
 	url, err := config.GetEndpointURL("/api/v2/workloads/" + escapeID(workloadID) + "/")
 	if err != nil {
 		return nil, err
@@ -341,7 +342,7 @@ func GetWorkload(workloadID string) (*Workload, error) {
 
 	var workload Workload
 
-	err = drapi.GetJSON(url, "workload", &workload)
+	err = drapi.GetJSON(ctx, url, "workload", &workload)
 	if err != nil {
 		return nil, err
 	}
@@ -350,9 +351,9 @@ func GetWorkload(workloadID string) (*Workload, error) {
 }
 
 // WaitForWorkload polls GetWorkload on interval until the workload reaches a
-// terminal status (see IsTerminalWorkloadStatus) or deadline expires. It
-// returns the final Workload in every non-poll-error case so callers can render
-// it:
+// terminal status (see IsTerminalWorkloadStatus), deadline expires, or ctx is
+// cancelled. It returns the final Workload in every non-poll-error case so
+// callers can render it:
 //   - running: success, nil error.
 //   - errored/terminated: the final workload alongside a failure error.
 //   - timeout: the last-seen workload alongside a timeout error (this covers a
@@ -361,6 +362,7 @@ func GetWorkload(workloadID string) (*Workload, error) {
 // onTick may be nil and is invoked after each poll for debug-only observation,
 // mirroring WaitForBuild.
 func WaitForWorkload(
+	ctx context.Context,
 	workloadID string,
 	interval, timeout time.Duration,
 	onTick func(*Workload),
@@ -368,7 +370,7 @@ func WaitForWorkload(
 	deadline := time.Now().Add(timeout)
 
 	for {
-		wl, err := GetWorkload(workloadID)
+		wl, err := GetWorkload(ctx, workloadID)
 		if err != nil {
 			return nil, fmt.Errorf("poll workload %s: %w", workloadID, err)
 		}
@@ -389,7 +391,9 @@ func WaitForWorkload(
 			return wl, fmt.Errorf("timeout waiting for workload %s after %s", workloadID, timeout)
 		}
 
-		time.Sleep(interval)
+		if !sleepInterval(ctx, interval) {
+			return wl, ctx.Err()
+		}
 	}
 }
 
@@ -408,7 +412,7 @@ const maxWorkloadPageSize = 100
 
 // ListWorkloads fetches up to limit workloads, optionally filtered by status
 // and by the name of the Enclave they actually run on.
-func ListWorkloads(limit int, statuses []string, enclave string) ([]Workload, error) {
+func ListWorkloads(ctx context.Context, limit int, statuses []string, enclave string) ([]Workload, error) {
 	if limit <= 0 {
 		return nil, fmt.Errorf("invalid limit %d: must be positive", limit)
 	}
@@ -437,7 +441,7 @@ func ListWorkloads(limit int, statuses []string, enclave string) ([]Workload, er
 	for pageURL != "" {
 		var list WorkloadList
 
-		if err := drapi.GetJSON(pageURL, "workloads", &list); err != nil {
+		if err := drapi.GetJSON(ctx, pageURL, "workloads", &list); err != nil {
 			return nil, err
 		}
 
@@ -464,11 +468,11 @@ func ListWorkloads(limit int, statuses []string, enclave string) ([]Workload, er
 // DeleteWorkload deletes a workload. The server stops the backing proton(s)
 // first, so deleting a running workload is legal; on a proton-stop failure
 // it replies 502 with a "please retry" detail.
-func DeleteWorkload(workloadID string) error {
+func DeleteWorkload(ctx context.Context, workloadID string) error {
 	url, err := config.GetEndpointURL("/api/v2/workloads/" + escapeID(workloadID) + "/")
 	if err != nil {
 		return err
 	}
 
-	return drapi.DeleteJSON(url, "workload", nil, nil)
+	return drapi.DeleteJSON(ctx, url, "workload", nil, nil)
 }
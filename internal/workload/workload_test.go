@@ -15,6 +15,7 @@
 package workload
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -233,7 +234,7 @@ func TestCreateWorkload_PostsSpecVerbatimAndParses201(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	workload, err := CreateWorkload(json.RawMessage(spec))
+	workload, err := CreateWorkload(context.Background(), json.RawMessage(spec))
 	require.NoError(t, err)
 	assertProjection(t, workload, "wl-id-1", "wl-1", "submitted")
 }
@@ -250,7 +251,7 @@ func TestCreateWorkload_422SurfacesServerDetail(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	_, err := CreateWorkload(json.RawMessage(`{"name":"wl","artifactId":"missing"}`))
+	_, err := CreateWorkload(context.Background(), json.RawMessage(`{"name":"wl","artifactId":"missing"}`))
 	require.Error(t, err)
 
 	var httpErr *drapi.HTTPError
@@ -273,7 +274,7 @@ func TestGetWorkload_Success(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	workload, err := GetWorkload("wl-id-1")
+	workload, err := GetWorkload(context.Background(), "wl-id-1")
 	require.NoError(t, err)
 	assertProjection(t, workload, "wl-id-1", "wl-1", "running")
 }
@@ -292,7 +293,7 @@ func TestGetWorkload_EscapesIDInPath(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	_, err := GetWorkload("wl-1?force=true")
+	_, err := GetWorkload(context.Background(), "wl-1?force=true")
 	require.NoError(t, err)
 }
 
@@ -307,7 +308,7 @@ func TestGetWorkload_404PropagatesAsHTTPError(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	_, err := GetWorkload("missing")
+	_, err := GetWorkload(context.Background(), "missing")
 	require.Error(t, err)
 
 	var httpErr *drapi.HTTPError
@@ -346,7 +347,7 @@ func TestListWorkloads_SinglePageWithStatusFilter(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	workloads, err := ListWorkloads(25, []string{"running", "errored"}, "")
+	workloads, err := ListWorkloads(context.Background(), 25, []string{"running", "errored"}, "")
 	require.NoError(t, err)
 	require.Len(t, workloads, 1)
 	assert.Equal(t, "wl-1", workloads[0].ID)
@@ -364,7 +365,7 @@ func TestListWorkloads_EnclaveFilter(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	workloads, err := ListWorkloads(25, nil, "prod-east")
+	workloads, err := ListWorkloads(context.Background(), 25, nil, "prod-east")
 	require.NoError(t, err)
 	require.Len(t, workloads, 1)
 }
@@ -382,7 +383,7 @@ func TestListWorkloads_TrimsEnclave(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	_, err := ListWorkloads(25, nil, "  prod-east  ")
+	_, err := ListWorkloads(context.Background(), 25, nil, "  prod-east  ")
 	require.NoError(t, err)
 }
 
@@ -400,7 +401,7 @@ func TestListWorkloads_NoEnclaveParamWhenUnfiltered(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	_, err := ListWorkloads(25, nil, "")
+	_, err := ListWorkloads(context.Background(), 25, nil, "")
 	require.NoError(t, err)
 }
 
@@ -418,7 +419,7 @@ func TestListWorkloads_ClampsPageSizeToServerMax(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	_, err := ListWorkloads(250, nil, "")
+	_, err := ListWorkloads(context.Background(), 250, nil, "")
 	require.NoError(t, err)
 }
 
@@ -453,7 +454,7 @@ func TestListWorkloads_FollowsNextAndTruncatesToLimit(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	workloads, err := ListWorkloads(3, nil, "")
+	workloads, err := ListWorkloads(context.Background(), 3, nil, "")
 	require.NoError(t, err)
 	assert.Equal(t, 2, calls)
 	require.Len(t, workloads, 3)
@@ -462,7 +463,7 @@ func TestListWorkloads_FollowsNextAndTruncatesToLimit(t *testing.T) {
 
 func TestListWorkloads_RejectsNonPositiveLimit(t *testing.T) {
 	for _, limit := range []int{0, -1} {
-		_, err := ListWorkloads(limit, nil, "")
+		_, err := ListWorkloads(context.Background(), limit, nil, "")
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "must be positive")
 	}
@@ -481,7 +482,7 @@ func TestDeleteWorkload_Success(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	require.NoError(t, DeleteWorkload("wl-1"))
+	require.NoError(t, DeleteWorkload(context.Background(), "wl-1"))
 }
 
 func TestDeleteWorkload_EscapesIDInPath(t *testing.T) {
@@ -497,7 +498,7 @@ func TestDeleteWorkload_EscapesIDInPath(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	require.NoError(t, DeleteWorkload("../other"))
+	require.NoError(t, DeleteWorkload(context.Background(), "../other"))
 }
 
 func TestDeleteWorkload_404PropagatesAsHTTPError(t *testing.T) {
@@ -511,7 +512,7 @@ func TestDeleteWorkload_404PropagatesAsHTTPError(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	err := DeleteWorkload("wl-1")
+	err := DeleteWorkload(context.Background(), "wl-1")
 	require.Error(t, err)
 
 	var httpErr *drapi.HTTPError
@@ -606,7 +607,7 @@ func TestWaitForWorkload_RunningReturnsNil(t *testing.T) {
 
 	var ticks int
 
-	wl, err := WaitForWorkload("wl-1", time.Millisecond, time.Second, func(*Workload) {
+	wl, err := WaitForWorkload(context.Background(), "wl-1", time.Millisecond, time.Second, func(*Workload) {
 		ticks++
 	})
 	require.NoError(t, err)
@@ -625,7 +626,7 @@ func TestWaitForWorkload_ErroredReturnsError(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	wl, err := WaitForWorkload("wl-1", time.Millisecond, time.Second, nil)
+	wl, err := WaitForWorkload(context.Background(), "wl-1", time.Millisecond, time.Second, nil)
 	require.Error(t, err)
 	require.NotNil(t, wl, "errored returns final Workload alongside error")
 	assert.Equal(t, WorkloadStatusErrored, wl.Status)
@@ -654,7 +655,7 @@ func TestWaitForWorkload_PollsThroughStoppedUntilRunning(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	wl, err := WaitForWorkload("wl-1", time.Millisecond, time.Second, nil)
+	wl, err := WaitForWorkload(context.Background(), "wl-1", time.Millisecond, time.Second, nil)
 	require.NoError(t, err)
 	assert.Equal(t, WorkloadStatusRunning, wl.Status)
 }
@@ -670,7 +671,7 @@ func TestWaitForWorkload_Timeout(t *testing.T) {
 
 	installEndpoint(t, srv.URL)
 
-	_, err := WaitForWorkload("wl-1", 5*time.Millisecond, 25*time.Millisecond, nil)
+	_, err := WaitForWorkload(context.Background(), "wl-1", 5*time.Millisecond, 25*time.Millisecond, nil)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "timeout")
 }
@@ -20,6 +20,7 @@ import (
 	"os/signal"
 
 	"github.com/datarobot/cli/cmd"
+	"github.com/datarobot/cli/internal/cli"
 	"github.com/datarobot/cli/internal/log"
 )
 
@@ -35,6 +36,6 @@ func main() {
 
 	if err := cmd.ExecuteContext(ctx); err != nil {
 		log.Stop()
-		cmd.Exit(1)
+		cmd.Exit(cli.ExitCode(err))
 	}
 }
@@ -23,11 +23,23 @@ import (
 // Disables stderr logging while bubbletea program is running
 // Wraps a model in NewInterruptibleModel.
 func Run(model tea.Model, opts ...tea.ProgramOption) (tea.Model, error) {
+	return RunWithCleanup(model, nil, opts...)
+}
+
+// RunWithCleanup is Run with a cleanup called when the program exits, before
+// stderr logging is restored. Models whose commands keep working in the
+// background, and log while they do, use it to stop that work and wait for
+// it, so nothing logs while the logger is being swapped back.
+func RunWithCleanup(model tea.Model, cleanup func(), opts ...tea.ProgramOption) (tea.Model, error) {
 	// Pause stderr logger to prevent breaking of bubbletea program output
 	log.StopStderr()
 
 	defer log.StartStderr()
 
+	if cleanup != nil {
+		defer cleanup()
+	}
+
 	p := tea.NewProgram(NewInterruptibleModel(wrapWithKonamiOverlay(model)), opts...)
 	finalModel, err := p.Run()
 